@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 
 	"github.com/flightctl/flightctl/internal/api/common"
 	"github.com/flightctl/flightctl/internal/quadlet"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/samber/lo"
 )
 
 type applicationValidator interface {
@@ -92,3 +94,170 @@ func (u *unknownAppTypeValidator) ValidateContents(path string, content []byte,
 func (u *unknownAppTypeValidator) Validate() []error {
 	return []error{fmt.Errorf("unsupported application type: %s", u.appType)}
 }
+
+// validateApplicationDependencies returns any errors for the dependencies between the applications of a
+// device spec. Applications may only depend on other applications of the spec that aren't Helm
+// applications, and the dependencies must not form a cycle.
+func validateApplicationDependencies(apps []ApplicationProviderSpec) []error {
+	var allErrs []error
+	appTypes := make(map[string]AppType, len(apps))
+	var names []string
+	for _, app := range apps {
+		name, err := ensureAppName(app)
+		if err != nil {
+			continue
+		}
+		appType, err := app.GetAppType()
+		if err != nil {
+			continue
+		}
+		if _, exists := appTypes[name]; !exists {
+			names = append(names, name)
+		}
+		appTypes[name] = appType
+	}
+
+	graph := make(map[string][]string, len(apps))
+	for _, app := range apps {
+		name, err := ensureAppName(app)
+		if err != nil {
+			continue
+		}
+		dependsOn, err := app.GetDependsOn()
+		if err != nil {
+			continue
+		}
+		seen := make(map[string]struct{}, len(dependsOn))
+		for i, dependency := range dependsOn {
+			path := fmt.Sprintf("spec.applications[%s].dependsOn[%d]", name, i)
+			dependencyType, exists := appTypes[dependency]
+			switch _, duplicate := seen[dependency]; {
+			case dependency == name:
+				allErrs = append(allErrs, fmt.Errorf("%s: application cannot depend on itself", path))
+			case duplicate:
+				allErrs = append(allErrs, fmt.Errorf("%s: duplicate dependency %q", path, dependency))
+			case !exists:
+				allErrs = append(allErrs, fmt.Errorf("%s: unknown application %q", path, dependency))
+			case dependencyType == AppTypeHelm, dependencyType == AppTypeSystemd:
+				allErrs = append(allErrs, fmt.Errorf("%s: application cannot depend on %s application %q", path, dependencyType, dependency))
+			default:
+				graph[name] = append(graph[name], dependency)
+			}
+			seen[dependency] = struct{}{}
+		}
+	}
+
+	if cycle := findDependencyCycle(names, graph); len(cycle) > 0 {
+		allErrs = append(allErrs, fmt.Errorf("spec.applications[%s].dependsOn: dependency cycle: %s", cycle[0], strings.Join(cycle, " -> ")))
+	}
+	return allErrs
+}
+
+// findDependencyCycle returns the first cycle found in the dependency graph, starting and ending with the
+// same application, or nil if the graph has no cycle.
+func findDependencyCycle(names []string, graph map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(names))
+	var stack []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, dependency := range graph[name] {
+			switch state[dependency] {
+			case visiting:
+				start := slices.Index(stack, dependency)
+				return append(slices.Clone(stack[start:]), dependency)
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range names {
+		if state[name] != unvisited {
+			continue
+		}
+		if cycle := visit(name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// validateApplicationIPC returns any errors for applications joining the IPC namespace of another
+// application of the device spec. Only the namespace of a container application that is shareable and
+// runs as the same user can be joined.
+func validateApplicationIPC(apps []ApplicationProviderSpec) []error {
+	type ipcTarget struct {
+		appType AppType
+		ipc     string
+		runAs   Username
+	}
+	targets := make(map[string]ipcTarget, len(apps))
+	joining := make(map[string]ipcTarget)
+	var names []string
+	for _, app := range apps {
+		name, err := ensureAppName(app)
+		if err != nil {
+			continue
+		}
+		appType, err := app.GetAppType()
+		if err != nil {
+			continue
+		}
+		target := ipcTarget{appType: appType}
+		switch appType {
+		case AppTypeContainer:
+			containerApp, err := app.AsContainerApplication()
+			if err != nil {
+				continue
+			}
+			target.ipc, target.runAs = lo.FromPtr(containerApp.Ipc), containerApp.RunAsWithDefault()
+		case AppTypeQuadlet:
+			quadletApp, err := app.AsQuadletApplication()
+			if err != nil {
+				continue
+			}
+			target.ipc, target.runAs = lo.FromPtr(quadletApp.Ipc), quadletApp.RunAsWithDefault()
+		}
+		targets[name] = target
+		if strings.HasPrefix(target.ipc, IpcAppPrefix) {
+			if _, exists := joining[name]; !exists {
+				names = append(names, name)
+			}
+			joining[name] = target
+		}
+	}
+
+	var allErrs []error
+	for _, name := range names {
+		app := joining[name]
+		path := fmt.Sprintf("spec.applications[%s].ipc", name)
+		targetName := strings.TrimPrefix(app.ipc, IpcAppPrefix)
+		target, exists := targets[targetName]
+		switch {
+		case targetName == name:
+			allErrs = append(allErrs, fmt.Errorf("%s: application cannot join its own IPC namespace", path))
+		case !exists:
+			allErrs = append(allErrs, fmt.Errorf("%s: unknown application %q", path, targetName))
+		case target.appType != AppTypeContainer:
+			allErrs = append(allErrs, fmt.Errorf("%s: can only join the IPC namespace of a container application, %q is a %s application", path, targetName, target.appType))
+		case target.ipc != IpcShareable:
+			allErrs = append(allErrs, fmt.Errorf("%s: application %q must set ipc to %q", path, targetName, IpcShareable))
+		case target.runAs != app.runAs:
+			allErrs = append(allErrs, fmt.Errorf("%s: application %q must run as the same user", path, targetName))
+		}
+	}
+	return allErrs
+}
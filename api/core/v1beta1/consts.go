@@ -14,6 +14,9 @@ var (
 const (
 	APIGroup = "flightctl.io"
 
+	CertificateRevocationAPIVersion = "v1beta1"
+	CertificateRevocationListKind   = "CertificateRevocationList"
+
 	CertificateSigningRequestAPIVersion = "v1beta1"
 	CertificateSigningRequestKind       = "CertificateSigningRequest"
 	CertificateSigningRequestListKind   = "CertificateSigningRequestList"
@@ -37,6 +40,8 @@ const (
 	// When this annotation is present, it means that the device has been selected for rollout in a batch
 	DeviceAnnotationSelectedForRollout = "fleet-controller/selectedForRollout"
 	DeviceAnnotationLastRolloutError   = "fleet-controller/lastRolloutError"
+	// This annotation records the labels and annotations of the device that are managed by a ResourceSync
+	DeviceAnnotationResourceSyncManagedMetadata = "resourcesync-controller/managedMetadata"
 
 	// TODO: make configurable
 	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
@@ -48,6 +53,33 @@ const (
 	EnrollmentRequestKind       = "EnrollmentRequest"
 	EnrollmentRequestListKind   = "EnrollmentRequestList"
 
+	// This annotation records the name of the enrollment credential the enrollment request was submitted with
+	EnrollmentRequestAnnotationEnrollmentCredential = "enrollment-controller/enrollmentCredential"
+
+	EnrollmentApprovalPolicyAPIVersion = "v1beta1"
+	EnrollmentApprovalPolicyKind       = "EnrollmentApprovalPolicy"
+	EnrollmentApprovalPolicyListKind   = "EnrollmentApprovalPolicyList"
+
+	RoleAPIVersion = "v1beta1"
+	RoleKind       = "Role"
+	RoleListKind   = "RoleList"
+
+	RoleBindingAPIVersion = "v1beta1"
+	RoleBindingKind       = "RoleBinding"
+	RoleBindingListKind   = "RoleBindingList"
+
+	ServiceAccountAPIVersion = "v1beta1"
+	ServiceAccountKind       = "ServiceAccount"
+	ServiceAccountListKind   = "ServiceAccountList"
+
+	ServiceAccountTokenAPIVersion = "v1beta1"
+	ServiceAccountTokenKind       = "ServiceAccountToken"
+	ServiceAccountTokenListKind   = "ServiceAccountTokenList"
+
+	DeviceBulkOperationAPIVersion = "v1beta1"
+	DeviceBulkOperationKind       = "DeviceBulkOperation"
+	DeviceBulkOperationListKind   = "DeviceBulkOperationList"
+
 	FleetAPIVersion = "v1beta1"
 	FleetKind       = "Fleet"
 	FleetListKind   = "FleetList"
@@ -76,6 +108,10 @@ const (
 	RepositoryKind       = "Repository"
 	RepositoryListKind   = "RepositoryList"
 
+	SecretStoreAPIVersion = "v1beta1"
+	SecretStoreKind       = "SecretStore"
+	SecretStoreListKind   = "SecretStoreList"
+
 	AuthProviderAPIVersion = "v1beta1"
 	AuthProviderKind       = "AuthProvider"
 	AuthProviderListKind   = "AuthProviderList"
@@ -95,6 +131,10 @@ const (
 	EventKind       = "Event"
 	EventListKind   = "EventList"
 
+	AuditEventAPIVersion = "v1beta1"
+	AuditEventKind       = "AuditEvent"
+	AuditEventListKind   = "AuditEventList"
+
 	EventAnnotationDelayDeviceRender = "fleet-controller/delayDeviceRender"
 
 	OrganizationAPIVersion = "v1beta1"
@@ -125,6 +165,12 @@ const (
 
 var KnownExternalRoles = []string{ExternalRoleAdmin, ExternalRoleOrgAdmin, ExternalRoleOperator, ExternalRoleViewer, ExternalRoleInstaller}
 
+// KnownInternalRoles are the built-in roles, whose names cannot be used by custom roles.
+var KnownInternalRoles = []string{RoleAdmin, RoleOrgAdmin, RoleOperator, RoleViewer, RoleInstaller}
+
+// RoleVerbs are the operations a custom role can grant on resources, in addition to the wildcard "*".
+var RoleVerbs = []string{"get", "list", "create", "update", "patch", "delete"}
+
 // UpdateState is an alias to consts.UpdateState for API compatibility.
 type UpdateState = consts.UpdateState
 
@@ -180,8 +226,17 @@ const (
 	// TPMChallengeSucceededReason indicates that a TPM Challenge attempt succeed
 	TPMChallengeSucceededReason = "TPMChallengeSucceeded"
 
+	// Enrollment Request Approval Reasons
+
+	// EnrollmentRequestManuallyApprovedReason indicates that a user approved the enrollment request
+	EnrollmentRequestManuallyApprovedReason = "ManuallyApproved"
+	// EnrollmentRequestPolicyApprovedReason indicates that an EnrollmentApprovalPolicy approved the enrollment request
+	EnrollmentRequestPolicyApprovedReason = "PolicyApproved"
+
 	// ResourceSync New Hash Detected Reason
 	ResourceSyncNewHashDetectedReason = "NewHashDetected"
+	// ResourceSync Dry Run Reason
+	ResourceSyncDryRunReason = "DryRun"
 )
 
 const (
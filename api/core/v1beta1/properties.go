@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"encoding/json"
+	"maps"
 	"strings"
 	"time"
 
@@ -212,3 +213,94 @@ func (c ConditionType) IsServiceConditionType() bool {
 		return false
 	}
 }
+
+const (
+	fleetSelectorSystemInfoPrefix = "status.systemInfo."
+	fleetSelectorCustomInfoPrefix = "status.systemInfo.customInfo."
+)
+
+// fleetSelectorSystemInfoKeys are the keys of status.systemInfo that fleets may select devices by.
+var fleetSelectorSystemInfoKeys = []string{
+	"architecture",
+	"operatingSystem",
+	"agentVersion",
+	"productName",
+	"distroName",
+	"distroVersion",
+	"kernel",
+	"cpuModel",
+	"biosVendor",
+	"biosVersion",
+}
+
+// IsFleetSelectorField() is true if fleets may select devices by the given status field.
+func IsFleetSelectorField(field string) bool {
+	if key, ok := strings.CutPrefix(field, fleetSelectorCustomInfoPrefix); ok {
+		return key != "" && !strings.Contains(key, ".")
+	}
+	key, ok := strings.CutPrefix(field, fleetSelectorSystemInfoPrefix)
+	return ok && lo.Contains(fleetSelectorSystemInfoKeys, key)
+}
+
+// FleetSelectorFieldValue returns the value of a status field that fleets may select devices by,
+// and whether the device reports it.
+func (d *Device) FleetSelectorFieldValue(field string) (string, bool) {
+	if d == nil || d.Status == nil || !IsFleetSelectorField(field) {
+		return "", false
+	}
+	info := d.Status.SystemInfo
+	if key, ok := strings.CutPrefix(field, fleetSelectorCustomInfoPrefix); ok {
+		if info.CustomInfo == nil {
+			return "", false
+		}
+		value, ok := (*info.CustomInfo)[key]
+		return value, ok
+	}
+	var value string
+	switch strings.TrimPrefix(field, fleetSelectorSystemInfoPrefix) {
+	case "architecture":
+		value = info.Architecture
+	case "operatingSystem":
+		value = info.OperatingSystem
+	case "agentVersion":
+		value = info.AgentVersion
+	default:
+		value = info.AdditionalProperties[strings.TrimPrefix(field, fleetSelectorSystemInfoPrefix)]
+	}
+	return value, value != ""
+}
+
+// MatchesFleetFieldSelector() is true if the device reports all fields of the selector with the given values.
+// A nil selector matches all devices.
+func (d *Device) MatchesFleetFieldSelector(s *FleetFieldSelector) bool {
+	if s == nil {
+		return true
+	}
+	for field, expected := range s.MatchFields {
+		if value, ok := d.FleetSelectorFieldValue(field); !ok || value != expected {
+			return false
+		}
+	}
+	return true
+}
+
+// FleetSelectorFieldsChanged() is true if any status field that fleets may select devices by differs between
+// the two devices.
+func FleetSelectorFieldsChanged(oldDevice, newDevice *Device) bool {
+	for _, key := range fleetSelectorSystemInfoKeys {
+		field := fleetSelectorSystemInfoPrefix + key
+		oldValue, _ := oldDevice.FleetSelectorFieldValue(field)
+		newValue, _ := newDevice.FleetSelectorFieldValue(field)
+		if oldValue != newValue {
+			return true
+		}
+	}
+	var oldCustomInfo, newCustomInfo CustomDeviceInfo
+	if oldDevice != nil && oldDevice.Status != nil && oldDevice.Status.SystemInfo.CustomInfo != nil {
+		oldCustomInfo = *oldDevice.Status.SystemInfo.CustomInfo
+	}
+	if newDevice != nil && newDevice.Status != nil && newDevice.Status.SystemInfo.CustomInfo != nil {
+		newCustomInfo = *newDevice.Status.SystemInfo.CustomInfo
+	}
+	return !maps.Equal(oldCustomInfo, newCustomInfo)
+}
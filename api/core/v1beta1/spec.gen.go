@@ -18,394 +18,590 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9jXLcNpYwDN8Ktp+tsj3batnOTJ6MqlLzKLKdaBJbWklO6tnIXwKR6G6M2GAPAUru",
-	"pFz13cN7h++VvIVzABAkAZKtP8cOd6smVhO/BwcH5//8Pkny1ToXTCg52ft9IpMlW1H45z5dHxf5FU9Z",
-	"cbpmif4pZTIp+FrxXEz2mg0Ifr1gklBB9oXkFxkj+6XKV1T3IMcZVfO8WJHH+/vHT8ja9CVJLuZ8URbQ",
-	"ajaZTtZFvmaF4gzWQdf8bZG1pz9bMsKFYoWgGdnfPyb7x4fk7ckPegS1WbPJ3kSqgovF5MN0Qku1zAv+",
-	"G8wRHe5ov1TL56TWmDCRrnMuVHTsJONMqMO0c0xsRA5fdAxxypKCqSHDSGjZHmo6uS64Ykci20z2VFGy",
-	"D9NJyuU6o5s3dMXaQ39XrqjYKRhNqT4t05YIumJknhdELZk7qODKmdAdzd7ntMwUTjxtTPTTkqkl0wNy",
-	"Cafljp9LYgbxJrjI84xRoWewDc/gSwg2ug/J53BuTCie4MH562aiXE32fp5Qup68C2xDJvka0a0+/A9c",
-	"Kj20AT82IyonBft3ySQcAVdsBV1bo5ofaFHQDfydX7Je7INGfVj3YTrRK+CFBv3PdRhN7ZUJoL23Bg9x",
-	"GwjowFFBKr/4F0uU3sP+hcyzUrFjqpbtfZywdcGkpiaaCFDTlsx5xsiaqmX7eq+D42h4uN66iYY5xXFy",
-	"AWgpN1Kx1Yy8yRUjakkVoWJD2HsuFRcLbHrNs4xcMJJfsULfDMWAwLD3dLXO9L52r2ixm+WLXbpez7J8",
-	"EYR0GwZr/iMrJCy1RRWPD803krI5Fxpdloxc4W8sJUhiNVLBXSgsxBBpNRoLglPNyCkrdEcil3mZpZpS",
-	"XrFCkYIl+ULw39xogJJ6mowqJlVFF69oVrIpoSIlK7ohBdPjklJ4I0ATOSOv80IT1Hm+R5ZKreXe7u6C",
-	"q9nlV3LG890kX61KwdVmN8mFKvhFqfJC7qbsimW7ki92aJEsuWKJKgu2S9d8BxYr9KbkbJX+r4LJvCwS",
-	"Jv3rePXsgin6bDKdzDO+WKpEZXqy6uf2ZZ1O3u/o7jtXtNBkSupxqgP50XWtfntlxz7MQ59frtZqoyd6",
-	"v7PId1qXeH+97ic9GvZ0vc4M7fH3CA+s1Nfy3yVNM7hfGoaUC1ZMppMly1aDtwlLOXAjmh/+2w3sWlTj",
-	"m5++g2lwP3aZuhkT8OLQLDuaT/Z+/n3ynwWbT/Ym/2u34gx2DZbtvuIZs50+TLvbnrCMKn6FhEI3rhEs",
-	"/WObvDTW91Jc/UgLJBM1osGqDzRNuW5Ls+Nak9Y51g/vpbjiRS5W+jW9ogWH5++SbXbgOpA15YWcEi70",
-	"ulhK0lIPQ4pSKL5iM6LP/pJt4GJhD0aTJVmVUml6c8HUNWOCPIMGz//2BUmWtKCJYoX0qLnddpjGODAc",
-	"50WAK9C/khVdr/XCuNDP9Yoqcj5Z5lLpj3sOy/Rf5xPymM0Wsyk5n3z19Kune189PZ88qVND87um0VRp",
-	"CjLZm/z/zs/T/9rT//OfofffX6Z5hL6hMnBbDvLVCh9lc0jAX9As8+8N3CcZ4gHdHexCOXtVP0wnIsju",
-	"nNWvKfI59tCe/b////+nflQky8ViSqSihSLXXC0JJRnTkCF5QUS5umAFElcDaiJycq3JoFzThPW/23Zf",
-	"73oQoMmHc72pFRdU5YX+waCB/qclNxEQGdrhDV4jR9FepkG9H5CuSBdNb+qtLfmLdDBEzO/zweGBYV8d",
-	"wD5MJ7lgAyhWYL99hCu4kL5ZAvDp69SEUJP6nZgX8we+4kqGeC38TjJo4Pj1xjtUv0nJugzczeO3OIim",
-	"I0leaHbgFZKTgmnUBRp4QSVLNffVvLB1IvJ09r//FqIUK7bKi0178tfwu5kfLlm+RoJONMNxi5U8/9uX",
-	"q6EMXQvqXQBPciFVQbkYCvXMHWEP+Yqcfd+iTxVVpQyzKfgNGEsiuVhkdRJouOmUXXGkWJZvOS7Ymhpe",
-	"5FRTQPznSSkE/utlUeSawXgrLkV+rW+4vmwZUywdzs/Ud+DP2froLaL1rVpV65NdZutDte7WJ28jdUC/",
-	"laxosyNFKfZl+LUpJYP9WiYRpRb4Gfl//ywMm3/BNKNBSqGFV3KmW3FJRK5wBD0aRakChtF3hguQfhwh",
-	"lwGmlDzmc/v3RcaezMgLlNid9GBWRXEiutDMUVEKqad7vGCCFTTLNqTIc/WE8DksSa5Zwue8Jr17Z15x",
-	"1G8NJPyfd+QlX+/Y+74DEq8GMKguenD+xzwr8Y2veNc6/F8Y+YvCO5+SK+ihd5mSiw0IqF2XNsxCvBX8",
-	"3yUj/pn645rDCFCEFkEsWJJRvjrOM55stqANuPGTWu8mYwFrD3AVvw98Ng9XdMFwohrz0femvc5LoW7Q",
-	"D+aLdn7XfBoDjVqXEk+lQ6fjXw3TuKbO2eo42uqeQeh70sQBp0SbnDB9lSfTCFIv82vvli6pSDNAdYOM",
-	"10uGWJhfa8JY2yxoHVb5Fd5ZS+/NfO+6mXxcNlLJ7rfmTm7bm9Y1i1ylOSuYSFjo0TafLJFL2TrLNywl",
-	"RweHO/poM06FIlxjoGbr9SMzp4kiFzS51KDrnDt07/z19HD28rRcrWixGfiA16UlGX+8v2M0U8vNZDp5",
-	"wRYFTVkafLDf5P5atn+168uvJo028VYTbRN4sOsNgg93vUlzYxrqpVoegKmhTStoTaHXffFdS9ApwG21",
-	"hKgbf03jLjV1C7HzYkGF0d/Kl76uPaRcr7UmtGBWs46ydm3ebmV7F9nUSHhFeQYqk8hmtqCkpVo6+IWI",
-	"aF1edtAPXqxSLV9sBF3x5MgDxb6UfAGqnoC6tq8LofBPCcwRcEp1KFeySKmWnlFLk/WAJgPJfVTn/c/T",
-	"ozdO362RBtojT2aYO+T8/EUQnuojmHNWWB3Pz+eTRZGXa3k+mZLzydPzyTtN234+nySlVPkKf86Lxfnk",
-	"3ZPtjBj+zBq9jws25+/rb1fr1ToDO5Ju6ASm2g6AnXL6qbxY7BjlVOeN0NOflvNh00toOGT6HYBLeHrV",
-	"qwquDUwdHvnUOUWEC7y1DXxXaM+pkKYH60/yjA3E9npTwt6rgiZKkiLXfMS8yFdBjCalBHaiwtTb47ie",
-	"chfQ1aB7G4nfwV+wNvcHo9nqF5okTBost5+3RGipZU6rSauQaK+FRae2ISBRXiz29IxW8frY0vdHe4+e",
-	"zMgJwNHcWctGuKmAOMt1BiqXBk3ZAetbiidhB9JyRV6qxgiLLL+gGWggNV+wAStZltVJ1A3xGPb2UPi7",
-	"DbmO0OnUY4yRVgMSo5Bdw2QNOdwYS1sEXe+zS79q997xnHU/QdPJmhWoR+h4EbFJdAipqOpexCm0iAzQ",
-	"VqyqrbSqAyboH6AbTENG6IbShxiydXcL4lxnF5IUjCqQvsz1bDwvmlyAfUjjZZteDnlRdU/9Lu0MeVqh",
-	"sVHMJF0vnRv1vl/bwSu697fXXr5htCuKQlGO3/9aGffRHSLMK9cdoIgs1+scFJ3kIldLcnT44gAoPPqH",
-	"BB2kbiS8XHIRkCW+5yIlHHAZ4GLMm24n9ik7eXl6RqxRH6ksgsjbdOXAsKIbwsXcKj0NZWaVmwvyuujc",
-	"VF6APcO42Giee0YOqBA5mOnKdUoVS2fkUJADumLZAZXs3t0XwDC5o0EWfk9XTNGUKtp3BEcAo9dMUWA9",
-	"jOZqqICE6rC4UGQO1VuOmaMPj7Vw143LIP4BXmRWEPQfVXl3eOk4t4j82Zr2DuTM8TZ8lNugzxTvwnY4",
-	"jSfeh9RDzOWUrqMY03CAnU4uv5Kxxt9/JRuNc42oz6N0AIh5swtPozydfgaazddMyCWfR03qR2smTnWD",
-	"hi6+yfzV3AcHM4GtFfWxbIE993aJ7KDnrjdOrq998/A05xh1r7S6xCGydkPI9kUUlLObokin4HJ3oklj",
-	"7cPliUbHu5MjWgMPlh+aPWNUoVNeCZ5eVw+nFtTidre4CZ6rxvKOcK7xqf3yQJjn9S2QNb73esk0Vrl1",
-	"WSdYi2f3x1sbLBqqFmjts/vohly4UMvqqCz4JVNWwyGtyqT35jVM/rpvGGCWPwIFClhNYA5YRG22LZ3H",
-	"b6Ox2fJkcHeh4/iGqiSg14OfgVEShGUMwM4FuYCfpWZdBBqoAn4x4U2t6Hu+KlfGy47kBVmzImFCgZlu",
-	"bmxeAFrkgYgxu8OceqphJOjYjQpEZ8WFnnay98xtngvFFpppfAfKwowlhvR2cjb0gmWntrHuWIKm8mxZ",
-	"MLnMs7RvAH9dH2IHcWogGzkQ+7nmiW7RE+CEALxghL1nSalYqqEYPy8ZnW+/Pi7OyJ1GbRCLjril2Ucu",
-	"DrHDs4C6VhVUsUWvx8RJnmV5qU5t8yaqu3FCaH6g9zzXkjo75QvBxeIE+e+A81ysaU36t/w7WuKIefGT",
-	"qm8lBRzsjzL+n0zGj+KQZdilc7i42TDY/a40B9F5wmqEzuZ1nUK06YOpFzpXMIiMxWnHqHb4XNUO3Re4",
-	"7bBR0PUaLFF5KVJCUT+OZoSUHJyeTMkqT1mGngWX5QUrBFNMEp4DMOmaz7y3Q86uns06l9C+Puz9mqPG",
-	"+ZQluUiD/s7QH8NfXHjaFc14ytXG6fa9hehp0ByKfNMXzydtNmo6AWNzV/DOcK64EdWjByZUIXIx5wVb",
-	"ObZaGMNDq+G8ztdlBj9dbODX/eNDIuHGaNhDe5B49I1crUpFLzIWiOFBRApyCGfAlUr25V93mEjylKXk",
-	"+OXr6t/fH5z+r2dP9XJm5LXlypYMXGtnjm/gLAPujPr40MV8IFWoHcnFRrHQxQF2pAjLmociRSRDW73D",
-	"CeyD4S9Aqv5d0gw8gUHoCV7QkgeI3dvDFw9wTt4iJF2EZLe38LtzaEZzHrwJl2xDsJe3fyNucCnLOie3",
-	"nVhnHcS7fcceADANUmixuYYc25G+iJNohVB0rQVsmu2mTHCa7c4pz8oCtSSlu7ywSy/ISkbgTvi8CgEO",
-	"uV5VTSPZBHDINm8+rQBHci1POZgPul2avKIoFAqLs9/QsxPVgN5Nm5HvRX4tSOI1LBjZB9CxdEpeMMH1",
-	"fzWEXlFu4uiHcSp2zF7HO28LQRxoR1kNjimNRRD2qgDbcaJbdIn4qG/hHR+Lz+t1dRcZF/He7z6EAWxP",
-	"ajBcXRcHzXUgQHbgGGgZGGYhfzeN4Xh1g1OmKM8weioXjFBNdZW98UlZFMCEKnClMJHymq6duFfNB0o4",
-	"4lT/Wl0bzUmXwFmSeZ5l+bXmob+vXlI9us9ukreSmZhPDW5Q3KWaVXNeDKB2Y6JcBfRaVKqzggqJwOMx",
-	"Ha5uRxRfMdy2W6tyfVmKfLoGkiGLeiUiV0tUSzrqoxnyHT1WmDOW+v2KJAAhLgGIaUc40mgNI3tU9CIv",
-	"lVmxW17YaeQCnp/0W4gYUsE0CXr3M8tazxauZRV+VEHjmkp4idHVtlzjtD6f+eVfg3xmwagM5mggjy8K",
-	"zuZPCLaoWFk75yM5aKcDxXI7akQMN6NMQ2jjNlGdYSd96I/MqO1zCoiVz8lZUbIpeUUzyabEONj7CmT9",
-	"fTKdQAMvhGBYxEBjdWasxq926MbPbiZ/l5GEDEYPXmEO96VTbzf29ZxMJ2fHr39kBfCtGFBgP+C7CnvW",
-	"j2q7Kehz+UXGmn9YInVMCwlNTzcigX/8qGUn3QJ1k4ea9i8KJvXhv9UitQm3XLPENn1dZoqvM3Z0LVgh",
-	"YV1XPGEvmJamudSyqu407CBeiiLPshUTyvBo3n5b3+rbjbJ53hDRNg6W0RYOyNEW9eWcsHUuucqLTRD0",
-	"GuLRD63z8T+6s3qVMabsKcAfoVPD0/DODn/wTxB/GXqOiOZzvmh6MQxjTb7lKtC91wDu3kFMAXQDhuYG",
-	"s36n1DrUzcCgHVH/B+cpwa/w9jxonZWAuLQBYW0Yv4YPGZdVJHDw3VrnRSijgJ9S5EaxkJCpJCDkFn5Q",
-	"/ZYh8O33EkESZDxDD2MLj+q6pgYI6vlJHBhrAZQYQrgC7Wo7odUnB9s20NalbfE6F5rKWiJUXb/6plfY",
-	"rD9RUqWszonp1K+L8EcPBjV35yFq7wRJTJGLl+/XmpAHWVT9nTDXwMZ5aLTQY6dlBmp4vmJydi70Jk0L",
-	"LsmvfyHm/3/dIzvkNRelYnKP/PqXX8nKqPie7vzt7zOyQ77Ly6L16fkX+tMLutFAe50Ltay3eLbzxTPd",
-	"Ivjp2XOv80+MXTZH/3J2Lk7Rz5ilRB8kVblexI5uuOe0kFRsjOnBOGjrYbggS71kNx67YsUGfnui5/11",
-	"59c9ckLFour1dOerXwFwz56T/df67L8i+6+x9fTXPQLGF9v42fTZc9NaKlBrPHuulmQFMMQ+u7/ukVPF",
-	"1tWydm0fXEyzxym639T38lUFEk1Bv/K6nIuXmEZEQ4483flq+uzLnedfmCMN0tQDCKzDV/1QzPMu/XZT",
-	"HAH1PxrpU4IRejZTkjmA4JRN/aU3CBeIjKD5A8mtHijcuvO48Pbi8Pe6LXu93Eie0MwbbzRX/4nM1RWP",
-	"O1wINn1uYIh+F8XWVt6WUGT3tpnD2OqCpWlXmHUzrwqXxHZyTkh5rhLkycKB1iKejrXSxvgufv3ZRGi6",
-	"iXgKGhequZ8f5nrJkyWokqEnGZy0BLKhBajYGzeLbUOsIiiWJCmgsbmjTDpckqKEsEuTRedwTi4yKi6n",
-	"odMrSmEz6kB2HRjT/ID5NZrZb+482c3QaxRO+vRhGk93Uml+TBOXkqMJtZtnP7HXuseK4LJjaFT1cGla",
-	"qcDc7Zt2Jsdr3f96+ofQGyuxgUWfJSSraOSBCWTUaEhj5mHvvLb+24vaU/tCgU6xlrTsLvSL3flEItrG",
-	"OFRRJI8B8sDTzVcKRYSXiWQLeMsyfQtZGs2ae2Ia2Dy50XH7jJb1eTo3KfMsyu+Yzz7bY/Sm8HOSC8ES",
-	"o2J0hx3ylwTR4fBFmKSZz+Twha+BbswQRgzs+dp74hv47jhPN4t9UC2ph1zsaN3+upb1NKECuBqJxkjw",
-	"46QZ/w2tFC7lLStWXNBs6taMnqS625QwlcSOi6ZVfvIGajZ2NfUAGD9KX4UWSi9odo1cMLUoldYVb35C",
-	"74adjBYLzMrez974SzmDfmHDGQ45bEveOG3a7nwV8LJIPUNrayumlnlav1K+NvytYKD7BV13ovJic8Jk",
-	"bX1dOuWuFXsjdzWrz+qgcKj5goKrzcGSJZcxghRv27y9dZLFbQ+S6C5kzQp9I9Dl6oZvwE7wDajkr+ac",
-	"uKJbkP745m9G+6Mj9RiUtgBmhXU2i9NbIa0uwje3OG3/NngY2kA1U1cbfw3xdm518SbVuttgjZrnDHMS",
-	"Q9HW3V039AkwC6QBUpubIw34x27L4lToDexNtege5ka3drBqv498pdnD1druvTH4FfSsGNdhdvAb3SqT",
-	"fhSPyPLbar26DZxvfDHbixl8NaMPgGdXc/gdvp43uoqNaxHZUuxm9dzh9vWtrt0PVKpTxkTs0bDfmw8F",
-	"oJrUH5SPhTR6/7LoRG0vD8N/oFMDE9ZrT0vKvOGu2YHKDfxxC4hj0A98zpJNkrHv8vzSIo7FgG/YPC98",
-	"M+b+XLHC+xsbnLCLPPdbVD9sgxm1pbSmDrRpriY6jL/A2DjemtvAuZHYk9nedyAwNpW11eB3xS009noz",
-	"RiE0SIwQ+UUyQhBrcwToi2CoQd1AXv9lS5LUWHWTqDQ+11YR+B5aWk+zOnkKRstU3+qhMfj7w+XZ8OYb",
-	"pBQyBoQxxuWPFuMynRjV17ATtLzF3QXHhBxgXjAFlVVeoHdhW3GPirN+gzK2A/1JLTcCWZfFOpf1ekNd",
-	"KwlmPgb7IBcL8P/puCxz/d0Gey+pQsNig90aGhTQgLsHidaChoL7hMk8u+oAt00OAM3DEMc9umwVVJJc",
-	"NyaPRZllmA4efwHtuP5RP25WzxMwXj7QAdu9Bw94XbArnpfy9TYHbc7Y9s02xo6c3vDA0YkjK+Oujd+Z",
-	"bN9JLuYZTxSwj4XZmA8ANHTDbiC/s/0X7OsFi1Rn6ES5xtriKHckw9Fu/levSiQoE0EHRI5OnQI0qnUJ",
-	"+0Gd1QZBJyi0EhXDSufFnIm8Td2EJTw6HbyFH+sqb7uNIPWHLy/4IhpnlsK35ljo8kDkkj7/25d79Ols",
-	"NnsyFDT1STsABZdtydcHSyoWH4eyN9cQvPKCXXdQOcGuDV1Deueom0mZP4y4WdLQMZFtEp5N5IINmSp+",
-	"ceMn5dxdt0Js52fWp4wyJYb6OY36OqxiJeXy8jb9qzpDNxuhGfm0LiduULO6oaDtxnFZ84dDYNeRukqo",
-	"/xMtjIhxUHDFE5oF8vlvIwnVF+qXC2h/rSYPffUWFPpsFxn65vv2u+9QFcNF0oY9qOYQpzChYmO8Eeu6",
-	"ED/1yrtm6UEIpPU+t8KVbAQtVHnVy3G5Zlyud5iC2FwwhIp0Ny9MiK79dUb2FckY1dRYsKqxLTdncgul",
-	"tZpVvzdWvzdhVaHCr9dFnpZgFJwqzoqv5wXUYky9PFzmDtY3GbKG2+XgLqGyVi09jJdfx0ABFVXc7BMj",
-	"pDynCeP8SKUfVVUHiazyvLrQH42XX+Nkz6ZGw7FeUsn+4+tjJlIuoulgG5C62z3C4MP2WEcGb4+XbPMM",
-	"LavPppds8/w/8I/n4Q196CIqcCnkOhcY6NZ5K1pFy6AbisKwTYzqctK9h3wIhdQIgpO9Lz60Lfn1FnEv",
-	"IAdczSpfs4IRkwJpXoIbDQ4UcgNqGfVrU8aJbxf32axQ3uG66NUNGVQ76Aa5SKOhoy3BIHEVS8ILwe83",
-	"WEMwXiM0vezPdEYTxa8q3wVjtN9WdWRdMoJZHuqatq2N8RCWOHAdRoxputY3qIteWu0BN07q9WzOw2HQ",
-	"cFMPFioAj7dInXnz0doRZMPBvuGur6XCY6zxKruSeaEaw1SDrW+mRW1NhkOzjlJwVIhMTVHHoqphALnB",
-	"pwQzYS1Zlu1ItcmwnIGdDNYPs9MF5UIqGxWcbUiW05ThFLCmFX3/AxMLtZzsPf/bl7XqtT8/3fk73flt",
-	"f+d/9s7Pd36ZncP//Xx+/u4/zs93zs//cn7+j3f/9fj/DGv35B+Pz89nP2PD0Of/jOdW7KoLhqrGYZXg",
-	"vIgz08NlhY7RxU7PibavRNgcIb3aXoZ4EtN3RcHYyzP0mExUSbMqePu2tNZI1LVCiR6zvAWFafsLB24Z",
-	"bXvTbT16wxtxeA4IdwqY1hP8Z61nooZkMDqehlRON8z74L84g0h25SoIvgPGKnsjC7t1CrgbSyp5/Obo",
-	"7OUe2gFcMIUppFkwVRailjPlyUDTq/Ho/ZfMxQ5fiLxgzoXXWbVuZIjb8o1yfQYHgAWl/23NAy3MRoJv",
-	"I14GDFC173rT7O2vvSdb33ucLH0ruIrfeGPo2YbwphE/Du+a1yBTJyuTMJXxj9K/S+5OAn5U661Ozke9",
-	"Dv74xi7S3m1b0iK9hrTVwkaOaXnCeOO7DdyP67RZg02EcRfO0wHQ3MwivlUlx7AfzhFEUoeLNvqeDce5",
-	"lqfSo/m85qizf025goB54z2M2RTAYHBMS7mlsby2IW9prW/eagNf6wqg2qe2t0btc22bge9N833tYwgY",
-	"gWZN+FTHWSNrwwL5jmzhcnMbvER07P06l9V7A1Els3PxkiZLSC+W5EUBknqKCV4qMQKvheZnaUYSuqYX",
-	"PONqMzsX/SGBuInarUryLAN7Z2Ubj7JnepFRl339Hu9DcIzx2Q9eQt/cHRnDa6H5H4xJNXBqBSxWI2vU",
-	"CTnWf5Pnihy+2GYojLgc8oS1gjw/QBkGJIII7fAujxylPLWUcuDymlZ4H6AOCu1VTOvHF6dbLUmix8t8",
-	"DS3BLLOigi4qbZLxmJBTwkWSlan+AnWIze9effM0vxZGirPF60wJ8YatyLQ7xYDrXsYKN+Nau8f9pv0/",
-	"9IAtvZFxENd0p85i/vNo8qHf4fNY2+zNnsf2EFu4i1UAc75i67P8BYV8e0elOpqbf3s+gjexitQW6U0R",
-	"+OrPGuzccFasf20ZPnxRs4cts4pVG8YDhkMn0MCFmzP0ZqgKT4D9v1MCrzA59tgNyLjlqsL93nqL9slF",
-	"weilvtGdO7nYkHN/XeeTtuNjhVyyydP+ARZv1tS9cJUrmkWMg/qTF7UbmmlgBjRD/f5I0DHSSxd0muFS",
-	"AKppAFmb59/YcJAacXnZm9xk63wi0z9YQpTgA55U1SjNAPB2c3mJ2W0DuWyiRXxTXoC5a+Mq+Vr9t/HX",
-	"8Mbs3ss6XBf2HZ5VUcKs35SpCcJrqDAbLeqVLtgVy0zJ8vyapXpZpjWSyQITehEOeLo2Wb3aYIAqxN9s",
-	"4koKNAFesg0w7yb4iUA3DWLn21TNfwHLrekxPK3145/3d/6H7vz2dOfv737ecf/+ZXf27i9P/uF9HKBv",
-	"BvX4W+FKtkfKrWDdE4/q2DPyir3bS52WgDkGfKCB7yibAl/3e6ZvVHuZk1K053XnuNX8QR4uTy5ZsV8i",
-	"em9hTsWOxl7RKOipj/no4JAUbMH1aQSdtUu1HJKQ4ijh+7bph+lkTaW8zouI7cd+JWDrvmS4FLOMTaDw",
-	"vyP3btxgnuxYZupaOoaeqXqkGbtHbzpvt0ECXnblFLWI5DLWW5xxKSgwbFvlREM9Y4rNCBA0V3/VCSk2",
-	"Ezj4ulICCQf5lYmoYoXJI4siHEW1dCm4mpEqtZL7EbI275FfJWYpkphzf0p+XeEPmHhI/7DEHyDFEuCP",
-	"Rxb+sffzs52/vzs/T//y5B/n5+nPcrUM04CXIsm1ADYkbpiZtvgmQdg3EHGqaGWQcAfqCulmlAstgUJm",
-	"+8EJKHGqY9PZ/v2NGeSDn4fywFkiGqULXIsdo+vvu03VmKemQxMRA2OGkK+VJLMN21aTjjpAJv+5xkZc",
-	"QKexbMyp9BnnVGqhzXbpldrd77bkTyRzbEiEiTat0n+HdRjuOng2TVJdzHiKBmpT0HbUGrj2kjfZO7ik",
-	"klwwJogdIJyrCX3BusSnHjXsvi0kgSOBgne9zjY2d2c0LVvr8Mw+tzohT/obJODEj7otWfRM2nfink/B",
-	"bc9+P+IQDy8wVSbflX/611TWDn5YBLnt8c2mvyynaTtAoPNGnfpbGpBdv+8IbuDYEQC8O6BZENfCoYzB",
-	"ZvWoxlaTB4tvDM48yKjcZgLGoMfPtbBX+Fnux3TwN+XoaOoa4h1rtX0kbQgTuPQEIipkJIYkVEbKr4gj",
-	"MYO6Tz0DT1XdR2x4EsfpBLTYJ33Jvc6A6HYm+AKUNfmLZv+SuSCPbaK8DufvO32Tbd0J6z4EZZO9Z5pL",
-	"53C0ZILoO+SRSS5DTETkHdfnOQzZItalSMPtaP0g0lsxeTdiGTwHuL7qSz4ut0swzbYurNSuIcNuQfPv",
-	"rFRSWxTtOF3TpIuNWubXRpmhSTDceqxaQ15lfLFU5ECT5DzzkdXLNdLWTlXqm62latCnfZj6wnTJd+wr",
-	"FD72tyc/2NN5e1jdQky7WUp0ZF4X9hX77xOiUQSsxhkXl5jzGeazb2eHof+m6oKY1qABr2qCKAwGoYTV",
-	"S/aghW5WL4pm3viGXtJHGiw/fAPUwKF3vCu5E848eAANvTIeL6ii1TL9aw4aJiD91C5dj0/mPEO94tkP",
-	"p+GLj4u5ZJvORXzPNltNfsk2fXM3L3sEKu0lDjr44SRhAGWwKST1tchveOjevjRS5QVXUZBXbfdt0zj0",
-	"fS7FjUxqNU1jFzgUUIucsH6FgXikacGk87ro3Th5bJnaZS6VluD21nmhBoRIdwDILTZ48pr7DRzzFYpc",
-	"nr7Q2O/ZFTqFU0XyBDzAXa5pdDYLEPNwXFxTSIVkx6CSRlggCS74YgH8GsgRenJUk6O8ArwRxDCyOX+P",
-	"GnDGQb+ih9sjj0GFDY4r+gf5xJvBfKWlyldQt9KGrIQ5vZuKf2kVf95J6/XebKw6uLBfQVIF1OAN0/O5",
-	"Yiyj4Hfngl+kbt0+YleVcLMhZjXzfWo4rk2NuTvU7MYrzMllXqgpWdFkyQWr1mmOH25ZPRdGoxYdXjrP",
-	"4GIdDw6wAqxXt+rA1Hh1KfTsh7fOU7z+S6uhzQzS+MUfsx1UF/m50ePg+G0rRPzg+G0zqPzg+O0b/YBV",
-	"jV5DzH2rL/7c7I6/NkZ4weVlq7/+sdlb/9bo65eCqnkwex9ajs/et2ZI/QsuzYPsJzkMuEA3PJKbP7ts",
-	"Nt6HxqgHEBWuWv5r5ve255rrEPRZc+e5VfU4KwE2sCGSe6k7a1FHWTX9y6G4Mr8dGj/pMyov3cT+j8es",
-	"WFEBUYTeHYiUkrM/Hwpa/2CofVo1qS5au2xctTy/ilx1i/1fTxUt2r+6pdYGMObr5u/fUJUsX3C5xpRE",
-	"ja8GaiyzcG919cf1C+Md6AuuvBMbVGuvBbvqU7D8nv6Ri0WLQNVK8zV/dK3R9/mESZUXkewv2HMQV3CK",
-	"TZ3A3+XG5bFJR1hRE+nJlBha41NyR2osHXrXbx/o1l/WmZZA0VAzgdv/1LCHUebUS98T4FF3XBVcw2ZN",
-	"q/q6aZUnw3CtmzXIFrUsPhiGDOXQ9D87qUOnNrI7r1wPYdli5GYKtVjeo57AvUiWpM6LGBkx3qNjVI8y",
-	"DB226hIed6uF9qyxQZ8GDFjvER7VEIgBo2HL8CiWOA8YxjStxgm8TNFyms2W4VHaT9mAAVudqrG7nrWo",
-	"K2y0iz9u7Q3pxpRg4/ZYveuqNfOkOxsH/Ab82vx0WR+mAyusRgcfFLcbuf7DeneTupuM0SRq/bVeY8i5",
-	"Tc8oFg6t5RhEj/7OvdjaN0THFd+m63ab7qSe23SOEPOth7jVIsLk+sO7rfLYAQ8S8Tmwnxp+Blfhaq33",
-	"5VzgphvmUQDqwNGL4LP1IvDEiaAY4VaBiiEOyV3XuQC5qa0Samjpbed+Ze+W8/Qov928oT2/4plVLMT2",
-	"DB/RGD3noez5SVd/cEAmir1X5PHbs1c7X4GSGd2RKztDNQnEIZlpQqZk3c76I/dbCD336mCEgd5+vDyX",
-	"/uoKckUCTiJZmHnGHkmMLZl6LupG/Q6e6jYBrihXrOAJOXwxIy8wfAvMqeeTIs/V+aSzimFPucJVnrLO",
-	"Fa5ZYRSCRLedkf+bl0BjcM0Y9bzSFGFOVzzjtCB5omhmzdcZo+CU/hsrcptT7+mXf/0rnDJFz5qEr0wH",
-	"rO0V6vPX50+faCKnSp7uSqYW+j+KJ5cbcmH88okrHgKFIjURc4DFgpGNzcBN0fuUJPXgqpcXrmtZymhW",
-	"bYQWJIG91/O8SVXKGGIfWVW6X0MkcRotkyrXS1UyLDqgNrSnIPN/PnFj1362EsE7s8LtYvp8WtXLzPgX",
-	"u7fa/wXkzmbHFDwjfm9HvjnSE4mBA94pQEBM1K9vKWR+UssxgOBPFkAAGLFd0AB2udtAARgzzJq7T3XW",
-	"HFPuPxhrXk03iDXH+zey5p8ra94v3bbizy6gpkLwNYdPwJDUszNUkaoPUwejQ5kcMorMjQKxu3o4tmqG",
-	"9sOWB6YjMBmAj1mRMKGiBR1MM811mXaWf7/BZPMy69tY1fI2m1Nstc6oYp1+0L4wdlbvYJ0fuTRopKUz",
-	"49cI/rt5EH8UX7H0qFR9m4R2MNBt9njjrBXDZ+mqRdKE8dRcxhBqTV3iCA8THK57gBtEFtp6s8+CLnjm",
-	"oBBh+Cg4fRME6DvDfqp+7/DuJsF3COkabmmI20h3iOu+JcD7AB3W7z48tBuGxOCrp5u/iWY48IGNIHXe",
-	"6SYQRGM1g+yXzObUC8L37k63Y2ooYiBStu0BV1DY/rDrhoyHP2Rj+HzQ+2S4oPu/SQ0D08ND19q7Q+At",
-	"bJOCKrYIxMKaMbQYBC2cF0jlBAP5RL+599en/uTc+r1p7nzAMQZjuNpttgvfanEQDdU5xj9908eTGIat",
-	"Sq6PZAUrnzYA1plSp9I/hLfaEREJW+mNgjRbHZYl/6TWGCIHqkIxnRJmraqMh4SRS2a+NurXtZO1NdKD",
-	"3ZsayCuF0srRGNbZNLOt2f1GEbsTo2+MyoOrCUDrKWGgK6dZtiG8kja8q7GkVwx0+RCAgm8kZAgSdMFq",
-	"4R9cEEqulzET1HYxhu7Eb5+KP22lhtymfKsjVYNUXHVqtWVQ47dcBerJtF6sBVcnehnhAGHjnYHBSt9y",
-	"Va+kQjCaZpscdTYznS2cyRf2VlYOIEFurXCf+5+caiinzgtzgEDbTtgV7wqSxq960aUt2dS73la5JLf4",
-	"1qzTWLa96UQM4oMb5Yb6V2PMTebkI7jzXXlxKFSR6xsN2R2Dr0ikYZXyDzKfcf87KaV+zLAn2T8+JI+P",
-	"j07PyK6ffn/3d9Sc/sLTD7swyBOv7tfRfqmWz328NorWQ0xdjH+csqRgmNTpGyp5QiA2U39/e/IDIGIb",
-	"ceNOxfU9NBmnBVfL8iLIMJWFUc6YVJ0Tq8ulaz7DfrMkX02mgUk9IF1QCYG5dStjeCzYM/bVf07JRalI",
-	"QgW5YATzavPfTEIxM8NLoVixLrhkRr/dj0Uq5gj0rcarde6sfcMT+WkCU10Va3g1eetsBjdJRA7hieTx",
-	"urzIeIJdnkzJd2dnx7v6f07hOxQzOj39Dv6Asp25wnJp1SY0/A5sIQcpl+bf71o11ryGPZT7u6rlB3/M",
-	"vqoYrmGnb7sHHpAlatJDAyMHWni989IM9re6o4+3AaT0l6Evk8pJkuUCqWM/6uihp3EE+o5lKy9qZ7jJ",
-	"OFDD7RsqWSAVbKTs74n/4AFtXdJCGT6SS7Jk2covehR8VQCwa5p0V0Z2raosiF4xpZSts3yzstFmthrg",
-	"ZLXZoev1TjVFYH6wbnWk4cCata1yR96zjiOEFubdQlpccFXQgmcbIpiEoFEbbtCsYejA7b/iE7Hg4j08",
-	"iIvJ3uTZ7PkzDPaEjK/oxUAvwAaCS17mUklAAv2vyZ6dwZBPTdHxM7Ifk13zI4rjk2MIjJ18eAc2/ILB",
-	"pg7yUqjJ3he1PAR6g5O9r5464B5kpVSsODwOi1kIr1c8WJTP2jgtUMFDxWUbM2lTvfMmMA64wBQso5Dd",
-	"ErbmZ+UH9hjL3RUpK8gFm+cFxg3v2CKmZsbaUfxs1rpTlS2dbehKX0fzIb9iRcFTJmebVaav5w0r5Mdq",
-	"eAcvfJ5f7iftu964s/POMl1Y4t8Ucl0xFcguesEIe8+SUmFCmEHCgF5bp0Cg+IrlMVPPHzr1KXkkH9Uz",
-	"nz5aPapnPtUo92j56PbZTz+EMmIPc+6vsOOkFPb61n8MpCO9+pEWt8lF9LKq8UuuaMEhUPiSbXbQeWBN",
-	"eQFFNf6Fml6b/rgUGsbB7PJFKaKOpisN6DqG+hU7qNgQWizKFQjvyEBLRUVKixSrNRK5EYq+18jDXYlf",
-	"S6pXJr7AziTJmq9BPb2ABIxTjVEcpIkNloW1iyCl0OSFavZzSXYS9N18H7aOXefF5Qse8anTHzG1uU1S",
-	"jtuF1LaY+bsUwrpimIUOkKzKsNK2fm33tsE11+2YquXRutefrNbn5ft1wUx50951eY3b0eqCMPfZI25M",
-	"4x+UEsmJfhb10TlNQJjmmdznLA2eWmjLrfuURzxfXfz+Y/mE6Pnx7VLg9cuy/Bo9NUHo11uQVHFp/Ezh",
-	"V7f04c4/NV/HAEGOKx+o8fxzWgj0cSaghXdo6UANyqoEg4JuCeZQfv2phmoQR2qyxhbyU52L04vU0hAW",
-	"ndGUIKBJo7OkCLxdmPuZWI/tIs8VOdgP4s/ANOgmvQga3APrGpT+XF7yNcqnP7LCiYaB1DOXfE0KtsoV",
-	"MzoqcuV1CKeUVZkcBIyzH04xJZL1Ex+0dD36JdsMH/2SbYYPnl/GynNWuedvDf0tks93zTWgxnp1A7qV",
-	"l1oqH6i9FLiSYfpLTRWOg2RE/2o1lqgKfoQ8va0vp3Ivra2NdGiWNoWlSKbxsuLvrguuFBO31n4Wbe2n",
-	"VV5SUzd/IxLSoRfFYtChzRcuagPEfk0qk3ylSf5cmUTOlaLqEJVOyMYw8u+SQWmSgq6YYoUkskyWhMo9",
-	"cj7Z1RRxV+W71nvyH9D6a2gdcqLv1LC643t4parFyBhdv6FmDBDGeZbKVu0YV1yqht9txL6pGusOFFJ6",
-	"6oEaKR9QWnj/Drp26aQAPlYTRbNsFlGM8BRLFUUQXI+AyI98aS4yrKpnu2peHP0gjYKo2j7opwtJVpDY",
-	"TN82e02QGwehDR5Ss07L/F5sLLaZOq1EI4bm8GElTBqmHhJ8LVm2RsKqMPOX2ZGrD6vU2iHKrTVxh1qI",
-	"D2jV2pEcN1OvHR0cEmgL8UOF4nOaqKBCbE2Ty0Gli7bRO8D2XuelUD/mWblize3VV49t0ABULXylu2v+",
-	"0ItPihgXHFQ6Y7J1I5yqynWyQi1Vd0/sBNuJQMUOFIXFcZlllS2/Mlkczt/k6hiNxy1Dha2wWrdMPPL7",
-	"PJqRn7SIJ5mCb/vZNd3IRxjHhXDkkqxL8G/Qz+IGVBWNXm/0l1onYNNpVjCabgh7D5o2ESnki3NOps3N",
-	"wKgDCZOGjxtH/9EYS/9kxrMgDWNWwBRhjubDXWHNwHtRW2YsHPZFLSma4Snyueaqjg4Od0B1xalQ7csc",
-	"sA3XcKx3Ux5Kwo5sVr9u4tK/MHQwsEWSDIld0Y0myy4/Jiu8jiLHYhXGhUuTADsYKFCyXL8OkhgreV6s",
-	"ZJvO1W1aA9gau9/gyUEB6JvQZ6wcHUiRZwOAfNpruNjBErq3oCqAr0dbjAsaSLah8RD5oH+fTh2PkZJt",
-	"8jFYJ2HDvJrqiPvlN6OAC6W3eVi3xUB6nZB9nBVFXryO5ZTUs0MLYrJm2QSNVlM4pzwri7Ackxd8wQXN",
-	"XGbXQfkeCqaKzYF9cevLeVML3UByqKi8rMrW6N68pgMaFERRg0Jz5X2nG83d8vAH3c6AdA9nvraT/FFO",
-	"/5pKe/DWFIcumytaXKLycF0Bxrgr3xJFvIUOwZd/XqsB/jyhVgOcef7505kvi4B88s+fvj8NZbNPefj9",
-	"fvl+jaYU24QkGeUrazc1Opd//nQWygdQDnANqlHz3gKdXMoylB/ALRMb+Iu8xRpxsCAa/+v6Ur6Nyb0a",
-	"yOTxP0+P3pCf2AX5nm3IKVNPKlUByJ++gsD4zNi6qObUYNFQ4oE6+30ERNs7R/3rWvVnUzSpEO1uQyj8",
-	"/VeyW0JrNPBy+VLyfXnBCsEUk7tHayZOl3yu3HPbpzahax49Am6onzcDOGy9PfkhHEPG5Tqjm3CMy3eN",
-	"BMrYlji9KlC/OI8wrVwmPPEt5PDxkyu9xiX5/itZgYJLYgYJq8nzYkEF/w0gtS81yqwG0FeN8kfhnijx",
-	"wOT9D1OjjIIPC4tul1/JcHjEBU3eyPDwJ9/sHzRccqr0IuHbUOQZ227/J/UeZoyYLsqK1VYhpXIIX1+j",
-	"AsJ4pOghcd1oQxWQxZT/ZsIFzDdQTaEJBkzBOwXLGJXMczuB/gXzx5XGW9tCpcovihOaXC5zyOSfqGyH",
-	"pisuds7Lp0+/SFwv+JMNSNtfw4GpvXJRfGsdQJBiuCuJzqDd0sJdcerTiYTZhvpVe4QDO36iyYdKoW5o",
-	"NKnVAkQYeIYRo2KLOtv1n1kF1m299Sqa0D/Up5tQKCBY+i6G1dH2RqqY3tUFCF1LiOcJ5yOpJPOUS8VF",
-	"okwxsKkhUIwmS6LZOP1QodlSIYd9Prlkm6+BEzufzM5F3e+NVf48X1fOb8BHL3guvi7lDqNS7TyDKHRW",
-	"fH1Bk0sm0m1c4MzOTr2wptbuoCq9DXwySVfgNzSP5VfgbmPyBln7nUSnsILJMoMPK6qS5Q9YJY2KFP+u",
-	"3EnQvWv/zQuWzsjL1VptdkWZZY3ZJXbTfOrSZMNuREQ1Ru175F4320Ohe7fSW1WGW9G13vjvl2wzhTP+",
-	"gD5Y4cpubZSzSUqC/pn6i8ct2kgw47OyEWrJFDiaWwuN8w/xvbQ05uJxXNGC56V0AVWwDDkj+5VFdkU3",
-	"+L6CjSnH/OS/V7FlU2IX9iGcg4+LMkCzXqMGU+MP92rJ6L8pyfiKOw15lRUC0NvZqNHpj7sivbUifKwA",
-	"TQekiAMI0SvKM80t+qVooLAH/XfJDG5unK1L5SjqOG2qLWVqFKVe8hyKsWAQIcYlkgWIp9Zi9hVa1wR7",
-	"r+xdcSupwH2AYAKrnX63JZdgjoex9LJM/p11jsnwLcjMTuu+Anrf1hlIM1IaBGpJBaFkzq6tyySe6ZpK",
-	"qa+eubRw4jb+Fa2BziMA2DaUomGf9mgbVX14ilxvZiFVkzjnvICa5pA2kk1JKTImJdnkJa6nYAnjDpTG",
-	"JQTKbIm6piXifLCiXHCxOFRsFVGNNJO3XEh9sEIZ5DLrBMDjS68JlAa/8Q4wlZPsQdutgBztelpksdr5",
-	"1BA0SPwHHo+WsoGRqInnbh92UZKUAsplAp4iIPUwFugZmytSCrg8IiX5iivP11Oygmte2zjG+wv18juQ",
-	"x+aRv2AJLSUjHD5DDOSyFOATmVdfAQSmZFZGpWn0pNpPwQzoEAObe8KNOBfQG+3EZrjKsxQkRCrI1bPZ",
-	"s7+RNEedBlPeHIjlmqEQ+hj1JpwjShNv9M7+wqTiK7Cl/wVvG//NRJEmeZahDmFGsFqctGygnrdgQClj",
-	"Y6NJHahB4XxpjQlqSIKb1pvReM7aAkPQn+sMTk6j5SXb+NTTPPnowS9jqYPQozJWJMz5W1YRBEBA4JVt",
-	"VLQ41NzNm1zBf1++14/TZDp5kTP5Jlfwd1BMrsJHAvuqxzKoHCfeRrPW4Bc1CL1Nv2uDXXYxicjAVI6y",
-	"w3PINQ9XsypcHGLXZ23ODosZ2WTnr3PBVd5rZ1ths361hu+oZTr1S8z+6O9C/vVD0rb7OwHP+sH+EG/o",
-	"iqXkCr0iQERoq9ECdm5jiG7ZuW/t4xD3bUCFa02xHdC3tBtVmm/nSFnXdLb221VOxcSIRnYWC7mdgvo0",
-	"0imo1J9Oinnyv7/88nn06PFzu2e7GIPargxDfODujrHN9/UL7v9DHAW6ETqgl/c0yMLo7YcrjbH2JL6q",
-	"UfWxGbTWuKa+7yi2eph2jmmSTx6+6BgC9WJDhokpPqaT64IrdiSyjdMF/QF13M3D61Nz8ya16EwJEiAw",
-	"HTYkD7jGioTs/Zyzgjwura628c1VDkZSFCnN+YdXz+e6zfNYAqNbq9Rlkq+7wjAN3LEZCpTtquO9eh44",
-	"gb47jS9G713WAjoX87xvONtu2Ij6Oh1klK9q1+TnyRqUzwVLf3Hu/O+aFwfsiX6mDufbj9ZOI825BVlp",
-	"DRSZLjB1jkNItkADg7EX/HweWMP55B180Vx9Zv+Q5cX55N2TW3CXTZtCkyJ7B1k/B4/CNijl7QwSR4cv",
-	"DnoeoUaLxhN0+OJg8APU80jooW79RHiDfOoPRA20vc9DF2nXIxni/fbkB4v4LldHkmhOVc4Web7A6PVP",
-	"lZTzNPl4hFxD+ZZk/IEI5T9/OjOPwR+cQBqsvjfqV2VOa9M9903fvboGnmYZWbMC1LdpWAuPSkWjTJTQ",
-	"w6iBsdg5tkUnzwCrLkSuqMsodkMjRdUYtFAm1ssr5N8CSGKKRJ/xFZOKriImXgjb12OZdPTXVJqtpDXl",
-	"VkoV29GNw7mLsXj0tnMZDWKK1aiHz7dgwitI0irFDerhxKlna8n4qXOTJtUoVquYMqmx12QkJMf5usyg",
-	"ZL2FN5beJyeMpju5yDYD02hnt7VRvUYLlakxAA5WaAtCXRnEymEOJmsKMXcJzSQJVWyhuRNGHgNZg19R",
-	"bfjE2TQmN45ow/bhh+ZasKC10iuGQBXJryHNBhaogt+nmsKdQ27/XaRSxiQbsSPULCHBmHdjN/IrsjvZ",
-	"SHrGmUeycry6MpYVDEiI7vNDlCKdxKMK9pvOGn5CuYY2eCw+cXfFJ4bhtDubtPPYawpnrENh3/M2RiRc",
-	"8yMBTKjzQ5oRPTo4tBEkHH0mu/R/aZ5c6msWyRQJX2HqthpO82LbVUT1h+vY5tZsYHjbliE0WwyxhEcJ",
-	"v2Hsq56uisexETvtSJrGkw5hmq9dRTQbyqafhVYIG5ZKr8qIIW7hRHvkHDohbSOFfVbI43WZZU+m5vNP",
-	"Wsbx24DQg42Akq9LuXziA8usxHUOgu0OEjTkFUZ36rBMsw/oEqO3HhFvquPfkGUulb5LU/Lqv1+8gZR7",
-	"h8eEpmmhAQqO4NbtiKzzQlkm998l3cx4Pq0isAqWLqmC31Yb92uSr/b+9vTp0yl59vfns2dffjV7Nntm",
-	"fvl5b+/ZO/h3WH6CnbFA8sXW+UPcL7SG80tyIViCpDmvIUMroHlqRnz34Nkqbh+RnSd8YNyjd3k1xTjS",
-	"HduhagZpOuKJned1jw4k1KyhCHE+3KAdG5Xy/TqXBF17D/SDnWfHGRUsDgAHXtMLKHCRZ2St+31Kzu0B",
-	"b/9bKXfuSW+/LnJ9S8BT7hXPVGj+w7kfTwKPkOkmbU4ALo3zgZXbwO0pZYV1R2o4IFZettaVCPh38uiS",
-	"bR5pav7IOVU+Ah8XmFU3vGQbCNo22QankKTMLMeuhhrvTfK4YAtapOCVZP0HnlTOTcYHyETh4tlIQwt3",
-	"9PLJHECiKfIcvGWUxkmTcYmKSB6Tu1V2rZmQGo+iGq8/rSf/p2d16VKDBR8uT+vV9gMaq3h+1lU8/cMP",
-	"FnHoLHTYh05hP/hmi3p5Tv/rw1XpbM06yNGqdnXGmp2fa83O1iXpROk2Q+/bFdoY3c9XEsdXAj8pl/m1",
-	"rdlQhGHF3qP+MMSwvzTfyOELpz9tLHCAdvGYqmR5gvgD6SjsfenUfmyZeVNv0jBCPrtCU6z6vc4whgfr",
-	"f0P1pIjjZzhv5j4BI9cxhgy5zEZht9HwUvHqqpzQFFznzaJmLeTL113VMJqEo6tiafXNOlMbMmLY2xod",
-	"8UqaYqvgBo9dPGgISF4xenAaBDOGycfsjkqSXHSqkKuWcSocGNXwb+eTBVPnE/0P/VDgv9BOhP9GmoX/",
-	"hgqT+E807eC//2JUWGBAczM82Y5PsxuM6ScMtXbLNjV1cAVQq0e2V+PMfk+GpM0xC5j6IA0hVXWq4XfY",
-	"Qd1Fl3hxwbg2IDGBPEdVu/iw/mDVFJ4xefAz66Fnr9HXW1kIJv9d0jRj6s5LMAzs99Lk7t6iy1sJNrzB",
-	"7QPuzcPzkXcmxetbRHfKpg/vgsp6Z59Kq6JDb5H/eNhMLx0LCUvFN0tbCoqDayodk7VdfUBv1jA0sapC",
-	"OFb5pCqSRqsCDBCsHM7qF3s2231t7Iq1UL/JlbGsUmHS18ETpdtb1Uh+xQovMWxVCUQWyS4XKXs/+5cc",
-	"xo34Gtzgvt1X+2ZaHGkkumxUmZlaTfhwfXKz3kx9cd/hcG2NM/4WQ6ha1S/vEBv1aiCVG6klVL07CWoU",
-	"Zj4Jib5CFRv3IF39xIH9wjX5esSnSLVHH6/DbEj9e10Z4L4ZY+yD6AKKxqSDeBTv9o6KgM9VEdC4Wx2o",
-	"3MrRVA+4rr84PZFVHZFFzu3APFQdCa+9pvoxi1vKXcPbhkz56+stNOKvsK9xbZE95xSpHdtssV0B2frx",
-	"3bKAa32w21Zx3a6Qqo2U3M9YoU5KrGjWZLa9HbRZwWXD8FmvtQyprvXYYYtqGXOifGEviuXW+Ar5RS+X",
-	"Dr1iBV1oUdKoQvILk1TBpCmEiblYzMgrOM+97rpS/RWjuqpFnZ+n/xUrEDWdrDv0OWeY9dGqafK52RGG",
-	"Vxd8sdBUPQRJ9C/FpExXrOCqv0y1f96nphN6XzWLN9sRvWOq7aNumO5FrtpkbTcR87WFM5YZ/4kWAlnu",
-	"g4JDsojJdHIo5vlgrjyylmrgaBNvxmgbXIq36e+DL/6Je8T1G0fYey2GpuSKU9j2/vGhv+kDjb9gZGen",
-	"fKGXaRWu08lLUeRZtmJCVb9hReaJKZs9qYkU1cpON0I/AmetSvP7pVpakT0o8jbiqo3yOvp0HRy/jRKw",
-	"dRkK0p5OXnB5GfX74/Iy3AsD2KPh8NHw9vYL58edD37oIrvpe8a61tXjARmBhH4Mo1H07QMMMzGnrQoc",
-	"Zhh0X49reKl9REJpDWxYCDQihW41I0c2PxD+uoZsPoYScGnVwVvw4M3XLMCKS7paZ1wsILPvFc06Hp8L",
-	"pq4ZE3b/BLrqdT/Ae+JKD3ZUHYwd9dQ/isCOu4g1UIco3dJf6xqImgs5pHoy+YMw+7jJRF+pv3Ks0ANu",
-	"EoYWolHhju29o8T1iWgrKsTaVl/h9bxrjUU19IHJdRTXRmPirN602thMYpaNtExsVA+XpHa7EANmwTge",
-	"fdBcfUdlQCurf7XsE2ZXgsZhxvt+FOgBqMVTpPcCDFpJcAMvNeXaHmBdinQPlNPaEdaW14cdVqP1QHop",
-	"YyXciGTrNxHo+qiZ+nw1Uw062vmEN7RTyqRxfSyfuAcaU+h1ajrixUjRIjQP1iDlolVc7FC3dC2wCFLV",
-	"wbjxmjAZdNIN8Q7omCtyjTq2N9fc2kuaLE1K3vpQGHJpB9AL9hmYKkHpx69aiIX1T9gVDztpnHnBo4Vp",
-	"FYB0V+b7oUQkqC2olShsLLbD7SXwhnfj7Q10dX7/W2rr6M1IcGeJdau0OoD3KJZyzz3nZKmfeWdE1uuI",
-	"ZI+2A3/bEavsBvdCkQNjD8kweAOlo8OmWhTT3Ogu+hPKyQAzsKKCLvSl99NLw5CEN6pW+LyLnTShimb5",
-	"Ykulkt1IpXap/35gR/U2/5F8HGqTB5kzwa6PwjHTQNbYNab6Jo+5q6R1kaHrvCgzcPKxkSuBoAV2xfNS",
-	"dkxgm9xiFsMbvOIsSzvYKcjwaYLXoTC86VfRzYogu3vuIiZgBy6y3ggT+J+ZjUCxfyujagvCu1N9X2NZ",
-	"6/sK3qxYkro2VY20HFAQ5+TVAdF9XZV+CNzoLVGDiQC8GDBXTLkKTmnT55vWZbFpAkMQj1ZadTsLbX67",
-	"qAtT8qWMlHs5ybMsLxUqbDGnetgO4ni0ZX4NvBm0NZUG0EmvwLH6DInfUJUsT01qithTVW/UVo9KVVDF",
-	"FpvhutHGiB3A8Itz1lDV/2wNQmbTZI2/GkYLyHjAwxrfAqR6Z3zF8rI3cY9VAqK43DqmTm4pfLgYQliU",
-	"sK9vynTB+hfRbA8FrCGg+2xZMLnMs3SA86Q12YRdp3C1p/ZkgzfDnjsqMnJu6sggYAxSGpazfjL+nayj",
-	"QuhmnsplVcF5izD6g5pxXa/s9PS7emX2huhS8Cuq2Pdsc0ylXC8LKlm8yD9+R2WuXB67vn+M2v61JfVG",
-	"05udA4CGl+EPIU6MYTdMOkftsCoLYbQHEH5Os8y8uWkuHtny8QQz5nuZYO5Go5K4HAm1FZaLBYN8S+Aq",
-	"Z5aQVBkSuC1vMCVPHd/IWtm2v3ge1NKNKpU7ValEKikOcT2o5ECEo/WXj0j0VIZ9HFY0WXLBolNdLzeN",
-	"CfRBGzbyfPIKCzmeT8x6TD59LquSEmy1VhuTAh8y6NcF26oQxT45gWWSJKMFJg6yHp9ms4DGF6W+Xwxz",
-	"8edXrCh4ykhEGyy7L7KNEXLAI0dQ0WOPnE9O8TE6n5C88Hd672ij2bIdKtIdA9JehiykWTMbN2TCYUCF",
-	"dCFu5RQ8nNP9RPErpkHE4kLaki+WO5neFNG7JVR3wjPFFF9+UBMMCKvIcpqi0MmF+xkLa06mEzsINEhZ",
-	"7c8VhWIFVJi4qLlmEvCTKQcxULRt73LfLqT96cRbcfvrYbWH9sdXdleRCe3G2p9f1HfebvC6BovQqj3o",
-	"tD+/tfCqzvwlBO/3nDlG+NddvODw5zyrHbjN+OByP+wUpTAZ5zIuLuET/sP7QjNOJZy0xBb4D6+Fnpkn",
-	"KA3YGbhAjejE5a6Dn4FD4pjj8AIy9lgsmU62QxQPNC/dvqLfTtxi201+sFuPferqvG+g0/7y2sIr9qlr",
-	"2FML0vanFxWQ2x8PK7C3P37rHUQAwbyjaX/9hoZ7vXXHF4C9fmN8dP4hp2kPMut7PQCVpSovNLLmNIXt",
-	"iFztzPMSiOwFTXckU+aagm0NKGyx8ND3pvTJbeEUV9D8+Qe7ouaHN7l6ZRbY/PQNTU/depsfX5r1N39/",
-	"bffT+tDAO/chQF/eCq4qrrqZ88tRpj4WOPJCNZM6Bh+sOEtls9hoBKiH60AWmtPvrMSSUrbCV5S+/4GJ",
-	"hVpO9p4//etX0aQ322yqSYI/INZtM0Qd7UGivnD9Q1fgGp/wKWzd1By0SUaqZ9yBoyiFsK+xA8CXf617",
-	"99Cd357u/H3n3X8F3UX1ROHVwPUD25KLMJVymc5MJtbzyZNGKJX3sZdHgmnrWFI/Ix/Y0xpKelAMMU1N",
-	"Z8P23uoN6j5GfhJMYpWpo6vQn8xVqIEi23kLNTvfrcNQY/RwnFOgUT3YqdHg4QKeQhMPsmw2r/XoX/K5",
-	"+peELl8fhrdioGp03OiO4+QcbbLhmsNg0L1e5rIawGZvnkO0RN6vEcDxh2zWUZhheQKM4t86ct8yPAjh",
-	"dDfOBgar91VHfvRavWoH3Gsq0VPAC1sfkit9G8+AVgmC4Dls5/3hNmBwbwbny1fsf3LRcDz4IccYj8Ya",
-	"NEx+ywXz8iFK4+cNsx3uv9m3qVf2T17u7/5wdLB/dnj0xlZ11j/W+Rmsg6pPOi9InjAqsDCt7el8FHTj",
-	"NS0UT8qMFkRyfRJcLblx06AFo1PgzA3HR/ahwDbdfcOuf/m/eXE5JS9LjX+7x7Tg1uO+FHR1wRdlXkry",
-	"xU6ypAVNlKaadq9YY1mW63VeKJaSx+eTb1+fYd6St2cHhstskaez/JIJLydQqASZsa0WLmglVNflF55G",
-	"+xv6XZ1GuGh4juQ1ZQsmdth7VdAdRRdIWPJiNdnzpvoQtRTs17KkOgtBLXnqL/DzoqAYbN/tnjBwaXnK",
-	"pvlKX3gts9v1/YLGoJDrxPH3By9xfbbNXa7FTdxYFGz6l7CN3hwXNGmb51H39gsgQ7N6EQA0rAfoX663",
-	"JCQ+qIH5pSx4dI22EXl7ckgeW3rVedKEz2vlfevpdA12P7mrM/B30TiCOiQD3nPw2dZFhgxVXoe7Rdva",
-	"0I11QnrM6AnA17taBgxWm77xCnk4MvXIQJAVQJKGNcB6aZppFs7XHjsim5AZ87RDo3BON1A9xbpjqWsN",
-	"l3jnXzr1P7WBvE+RBHNrXjD5Cw/J8pilVLcwLoX6XeHCRkKFYxt4GgXQ4YsDcvjCQPnxP386ezIjx/ic",
-	"+jXGoZ1Jy84ETyusCtj6Om+Nowve5Qk7z+ovEQKIYGhSvm8YLYLhlSETO3qsnCZLlprY7KZ7UFXDVZpW",
-	"lmzlmi9KSJpfC2OdAR4D+Tfp1/ZXfGW/unz2Cr1kAiJor9PKQZGLevFhqWihvi1owl54Ed9DvW+Ux611",
-	"CqO2XUvoUZPgGkL3/a1kxaGY5x1XXmOZbRa/85Hb+rL7moZLg7wqswyUfr2l1AJChV5qLcHj3aU3DZQR",
-	"azMmto2rHxa2QZcXIT8NlPW7eL3gvfGUJPVTuYrpH7/lyhdPw8WtIkKNHfSdntkr4tya5HW7kLRJs4eV",
-	"poeGWOA4EGRhnAyqGiJA/wznDoPjlZZ1tfAuU8muWHDxflcvZ5buFXl/JumwGz+kH0jKgqvNKdSZgJVf",
-	"AJ2zxTnwr1dWYPznT2eTqoaF+VrND6lMELNjFQfevg1nL61Vc/J8eAl5TdfgDN7Ix1rVY5tZ5NRv2uTf",
-	"JYNgBsRqvRTNIlR3YM2/Z4a10FKoEe0VTeDcoZTdZG+iGF39H5d7fMbzakS9i1fwhZiyBeSM0ZVxGjXV",
-	"PPZ2d2u9W4W5fq4P8e5xqNsTo2pDhDaee0lGC0zihj7zK5BH56hbAemZpQvmPE3166CWjBfkOi8u9Ysi",
-	"Z+cCLNQJM4TS7Gx/TZMlI89nT1ubub6+nlH4PMuLxa7pK3d/ODx4+eb05c7z2dPZUq0ypPsKcLUBpP3j",
-	"w8m0usiTq2cXTNFngC9rJuiaT/YmX8yezp6ZyBNAx13NoO8mzrlwEVItfctUM1t+q+SGc4PRmAnyofFY",
-	"hNSg8BbAhM+fPrU4YcpE0iox4u6/jKcRUtohRSTNLIBwjQfpe733vz776s7mc9rx1lxQj0TkFRFjKUz+",
-	"/O8PMPlZnpPXVGyIUTGg/h6Z/58n9YPDGi546o1spdGjh8jZ3pyokJ6kmss8bGHU+JapY2/ye0SRRq7X",
-	"APQ6s73CIT599gCH+FZYUdmgzp8Sb6eTv93h4cenPrR1BNFEQtB9Ydi1sTWjo3emzgm7hJPkuMjf24qG",
-	"RhNi0/5W4I+VJcHMEKrg7AqzBPtK3vAts0u4z/vVkgtCqN1Y7XipxkvVvFRXNOOp8TUJXqofTQOopVy/",
-	"Ik4N0b4CthewPAVdMcUKCYaqNuscGlXfOrs0xwIvGU2BLbd8na/jtPw7DWRNxzRE93QTu1BC7wS2gVfv",
-	"ISb9hqYWBR/uvp+Z+LRqr+OF/4Ne+N/tw6Yv0Yddp3Bc5702MvYe85KEnlbfiCa3eF0fH++/NkXcnrQN",
-	"HMbCleXXqEcAq5JRJoQJz5kx4HRSnTdeLoSOZ7+UFe0BXYOjPD4MJ75SAo0EPYQIgPRNnm7uDFVqhk59",
-	"1v5Q73eur693NBewUxaZibu68dgfmtv9cI+0tW7tiBIep/S8YyrbO32N2A65fhZx4oIfiEV+5sN63o86",
-	"xuvGflvZh/lQ9duWiLUNNa6DesnlGYFEBc5dCf1osaQh+kWZuwMj6AGg4P+KKuOlUWv0CL0LSvYII9yt",
-	"itAF1oOIa48wpu+yg3Q+89NQ7XtTfBETvKmCJ3XBGoPtXFFraUrb8wKrKTYyN7ArVmzU0tStCS0Uep16",
-	"AfcPtFrMHjC11JGLhYmQygsN4ktGHn39aEoefa3/l4qUPPqPrx9VTruXbPMMS08+m16yzfP/wD+eG9eK",
-	"0E5hxpvtVGPSir7nq3JFhEuwZRHPbdJgSh1BAA2NazdUbpBGVRJDtFp3wud1LIdSEDio7W/wd0UBtoJc",
-	"sCpJB6HSuziQTVCWFxKCiBXeoihm8BW4M1dw6o3dvFfG1SccMSWN0eV9vpxrS1J9+sUDzPoqLy54mjLx",
-	"0dnVh9jtqdHzvxVO19d6Ldcuz++HaYQXPYDqWloODT6P7dcRO9SyCN8P+1WbYhCL9Owe5w5BLR2v8b1f",
-	"46cPcY0PcjHPOFqVR8LRJBzvd6pyeJM6v93iwHd/BwkY6UzGVNCdRf++BcXBDg2K06sA81PzBSfS7CCu",
-	"MSKP3kwOfXCFmOUm/jQU4a8PMOWbXBEMHR1JQoAkxA3rg2/1t0zdy5VeYAaXP/p97uMwxls93uoHlxCo",
-	"SgKOaFAEeoubDe3v5W7DAu/0dg8VW3Zg6v/a0l3Dr579wEreofRlFF4+L6I2yksfn4yWAeYInfy3oKIn",
-	"WGP+XuhoVUzhwQnpfep/Hpp6jhqnkWiPRPtPoeRKqiJ9Eov0WbeMbptztLhfnwE62nG0Ro/W6NEaPVqj",
-	"BxHIKBUZTdOjafqjPb7Rx3SAnXrAixqzWXcV2r0PASY+3wNbs3sWMgoao2l7JDwNEaCD4e+WBwZYwFNj",
-	"AfdpGTE3k1Q0KWQF76JhW+mG+snoaB8f9RejJe0O6EpQO1AwmqLk7cSOpONut2znD0wI7syqDmmP/12y",
-	"Q0xNAqzPxxGBRlox0oo/nvDTaYK/kfADfR+YXIyG+vulT6NcNhqARlHwHslwGWTZwCLf4NoOBnNtxqL/",
-	"wKT4k7D131JV9lGp8aipG1+E8UUYlYNbKAd36Xpd5FcUKlQH35p9aMAIJPETmy7Wv83xo69ZtMO+nfzO",
-	"3huVE1pf8PjejNz/SOtHWv850/qKimuijylUKVRIl7sFkyUmSg6bs0/gu8u7ekElS0ku0CGp8hGiIt3N",
-	"jeOP+zXkK6xHw4o08p6s2Tg6zvSRiGV9CfEEMiOdHJ1Y7p2E1O775B1QjQua2CqeMAbK3pix0tITc+Ut",
-	"hfjQpDfN74609Hia4uXocyutaMToQzr6kI4+pJ+JD2kARy7yPGNUkHlGFxpPTB0jkotsA6tZrWixqdef",
-	"kzPyk94JgConIJzZDPsIFoCkqUOAQ8HNN4P5SXyxCBkAPL8WrHiE2FTD+0cVjJrFyKDiyyMzsB7qEeES",
-	"VhSDm9c2hGUGHvfshoL0dfSuHRmTj8yYDHGlbbAMMb9ZbHavYsVDe8T6s45K9dH99U9HGUIihy9rbJHH",
-	"qZ+MYEtHRrZSOjcGH51SR63q6Gi27W2Pp2vqv7zfMnVnN/cTyc0U5w7Gazte2wdk37udQXuvLjS8s8s7",
-	"+nTeIQEZJYvRhDsKM3dFJ7sSLvWTSeOXeWeE8pPwuNxG7/JwhHHU8YyUeKTEn71aaTdlSb4yZUmjPpCu",
-	"1n1loEL1j9e3rWqqPt6hwqka9JMg6z4URt53pLijxP4R6V+d2AWIYUalkgwLBnaXraZSEd2SKL5iUtHV",
-	"OkK1OtR4P1CpTll/Ob9h6rzouubop/hpqPocTDoY07+2z+VNDvXq9SJGGjPSmI9JYxwNCdCXgomUFVid",
-	"spO+2IaG2QoSkRPT5i5tAqHJrSuVqSB6h+Qk6GUGJOxS5NfCLeRHVtQYvoa7ETQ+qbed/FEtFiP5GoXS",
-	"kWDW3asNUQwQTImz9pFLbKZJ2zZmVLOl0Zg6GlNHtumPYkzd+jp7ptU7u9CjgXVUMo2UbKRktzF3bk3I",
-	"asbPOyNlowl0JF0j6RqFvz+o8GcEPC36MVHkWbZiQiW5mPNFp9RXNa6FuoWEvZeu6QGOuwVRpQNTe2Ew",
-	"7hzyBBAuZVlPIjsjh3NiytikUxeiqwGHYXxLllwS3pfcxUT7yfAkENUHEZRckoRK5gINudXrmSjNJkRm",
-	"5FAQmmUkV0tWQF9cpAdlfyIM1oSVXzDCVmsVDaFMZPHRVHGtgx8p/cik/knobnVzq3QqdSI7rGpWdYcG",
-	"VstqdRgzHIwZDsYMB2OVrC2f7LE61hi//0d8RPtC+UXHkxkL62/1uKcI//Y8DxzsH1nA6BM+xv3/mSlK",
-	"TTMS4NDDjPsWiQG2I0rYK0SUtlJGx6ccUweMcvyosf2kSFQ8b8F2tKWmj70XwvKJOOMMYoVGAjMqCj+O",
-	"jNOZ72C7Kw+d7vnSjw4790N4RvFrZKdGduoe6GtXnoTtyKtxG7pnAvtJuBHdUL/1UWjrqFYb6fpI10dN",
-	"3u1qUQWeivYLYXrdwwvxyVWbam3BVeD62C+FXUi/tnGk3aMG4k9PSesVn+IkdfsAwtvrM2/muz9qNUea",
-	"MtKUj6fVvBUZCOs474MQjJrOUdM5UsBRIv4cNJ23Irkxved9EN1R+zkyfyPz93kLlH4k4pVeSVRoPGGq",
-	"4OyKSUJdEAR2mZ2LcFAMDtgXCPOnibU4zQtF8iJlBcRMmrqGEPugN2RTF9bjXB7pMR6Rx4Jda/o854VU",
-	"0cXB4LVFpTgUxJ7KZDKdMFGuNLpQ+At+fDe9aZwInj+emz4iG+jRF0N0NyUmP+sIqnvVV+hjG2NMxhiT",
-	"j/dYaQwMPFD4YujXaJ4x1hem+Uq36QvNfIUDjeGYYzjmGI75+RacPjRZH2KVpe2mga7EVkJTk1dWnuIg",
-	"H6+QM5Ct8Y0e3+iP9kbDTRlSxrn+DMfCPaHVPYV44tgPHNbpTTr6nI2hnH82olBj3A23XjHuu7/Dfz/s",
-	"KrZaZ1Rp/lDyXMQ5euBGbGvimodY+jPT6seqUa/aO78WyExpJqA1TUTJPfdo1g2Tu4+CxShYjILFmOdF",
-	"k90G3Rq5+5G7/2M+5O1Xe8DLPiAzQ2oyM7Qe4Eg2hsaFufU7f3/PfNOyPnDmMeXDaL4ezdd1ehSUDgpG",
-	"U2SNHV/QS0O+ZWokIA9JQJrQHinJSEk+Kc5mcGqpXp0nNrQ6z62c8upDj1mjxos/Xvy7YCEgb1Pvxf2W",
-	"qTu6tXcYvPTnsHaOZGMkGx/XztmZ/6mXdEC7OyIeY8DT3dGOUY86BjmNVt87IpFdKZx6KaSJXrojGvlJ",
-	"xCdt4ZryYCRx9IIZSfBIgj9Xx5tBKUBAn15FodY165Y+hyXjm4Wa3qt8PIqmo2j6JxZNm0V3hwuqd3WX",
-	"R3F1FFdHIjYSsRsIjwXKhFsyI74keVdEbJQnRx5oJB+fljnfy1+B3uOD8lekXCouEuW8vLGvS8tQUZ+K",
-	"PmzWLJbo4geceQAB0qMYx2tHdgqzMLeIIl/FTHaXXKSdVMimdzBV/oekdtgnc56ZoITmWnKRbTAswUVZ",
-	"E7WkfujBgl8xge2dN/29uOrfwSrRS71vlXfuZl+hG673QfJl3EwmZu/pap1hD1ztS/wFrroxE+9NzI+V",
-	"k7++OZm9BuDNjzlprniRixUT6ut1kadlotALr2ALnouvS7nDqFQ7z/QGOCu+vqDJJRMplm0eRlng8o2u",
-	"9KMr/Ud7oQDv2y+UuQ76acqLBRX8N1jWdhmWaj1nhBxpUofEQ9Y/IsXT1KSUrCBLKglNEiY1uQlnxjiq",
-	"rerPmqbpPnWHPoRHEjWSqAcnUdWLDQlz8saNtxTM/71NyOq9ND0r2DqXXOUFZz0pek5sy01fnp4Tf8wx",
-	"W88YVDsG1Y5BtQOIYkVhxhd2fGE/mhDgnsTNkJQ5gWcxljenanpPyXO8CR44g05z5tGBaEyj86ekFjV2",
-	"u8ZcN7ntbWLUBhEZbF0jMluZ0QKTjCFro3FrNG7dhA50xK0NuszfMnXnN/kTcdPr5iXGqzxe5QcWALpj",
-	"yQZdZ+OmdscXevTVu2OiMsomY3DDKA7dJe3sDDIbRDqNf+CdE89PwkdwW43OwxLMUYM0UumRSn/+Sitj",
-	"7d2IpNdGjE1PNyLptxJXbUcz8WgmHs3Eo5l4IKdQEY7RUDwaij/iK1o9jMNMxYHXMW4srhrfm7nYm+LB",
-	"DcbNuUeGfzQZ/0npRoP/9tntFgO+ndl4EMGxhuMawdlSxRKYaDQejxqA0eJ0M4rQaT4edKnBgHwPN/qT",
-	"MSJ38xfjpR4v9YOLB32G5EEX21hR7+Fqj+bkOycvo+QymipGYeluqWiPSXkQEXVG5Xsgo5+IYXlb3c9D",
-	"E89R2zTS7JFm/ykUXLbsV8y2rAVfaeb0imi1BN6qNti90a6xINZo/jFYbrH2HfRFyy4yDmWRTfYmu3TN",
-	"d6+eTfQTbvo0EfvIYjAmrNJnyoQyG5l59V1qHyZtw7Q3UC7IfqmWx0V+xVNW1N0wvPHWpkHvaAesUHyu",
-	"52anfCG4WJizCA6dVK0lti7cO9c9Dya6Cg6KtXC6R9AAxHaEJsqWAq8NYH7vXclLUeRZtmJCde2UuVaD",
-	"dqjXZ9JdcbEg7EqjoT+c/qF3afVch35/zK62zRJMDiuaFLmUJOXzOSuYCI+OmXG2Gd3PmBIcspaqom/f",
-	"sewTZizPoal/pJiPkhvLe70G7DhhHDYceKHMiJZSfHj34f8LAAD//+EgoJ7R/AIA",
+	"H4sIAAAAAAAC/+z9i3IcN5IwjL4Ktr+NkDTbbEry2J9HJxzz0ZRsc2xJXJKy41tTxwKr0N0YVhd6ABSp",
+	"9oQizjucN/yf5A9kAihUFerSzYssq3ZjLHbhnkgkEnn99yQRq7XIWa7V5Nm/JypZshWFPw/o+liKK54y",
+	"ebpmifmUMpVIvtZc5JNn9QoESy+YIjQnB7niFxkjB4UWK2pakOOM6rmQK/Lw4OD4EVnbtiQR+ZwvCgm1",
+	"ZpPpZC3FmknNGcyDrvkbmTWHP1sywnPNZE4zcnBwTA6Oj8ibk59MD3qzZpNnE6UlzxeTD9MJLfRSSP47",
+	"jNHa3euDQi+fkkplwvJ0LXiuW/tOMs5yfZR29omVyNHzji5OWSKZHtKNgprNrqaTa8k1e51nm8kzLQv2",
+	"YTpJuVpndPOKrliz6x+KFc33JKMpNbtl65KcrhiZC0n0kvmNis6c5aahXfucFpnGgae1gX5ZMr1kpkOu",
+	"YLf89nNFbCfBABdCZIzmZgRX8QxKYrAxbYiYw76xXPMENy6cN8uL1eTZrxNK15O3kWWoRKyZanb/E1fa",
+	"dG3Bj9WIFkSyfxVMwRZwzVbQtNGr/UClpBv4LS5ZL/ZBpT6s+zCdmBlwaUD/axVGU3dkImgfzCFA3BoC",
+	"enCUkBIX/2SJNms4uFAiKzQ7pnrZXMcJW0umWK6BCFBbl8x5xsia6mXzeK+j/Rh4+NamioE5xX5EDmip",
+	"Nkqz1Yy8EpoRvaSa0HxD2HuuNM8XWPWaZxm5YERcMWlOhmZAYNh7ulpnZl37V1TuZ2KxT9frWSYWUUg3",
+	"YZCs2JFSRStlrJTXCOPhyxdEMXkFh4Fqwk1FRRIDkrnBXVNvrpkkVzTjKYXV/HB2drz3+AlJljTLWL5g",
+	"CvtIycUGoEEXLNdN6KZcskQLuWnFujcnPxkEN334yu5DMNcq2JZar9Wz/X2arNje1eOnM7rms4xpxfJE",
+	"btZ6JuRi33cXpRsryltmlIhc00QTqEJomkqmVGVKNElEkWs/b3bFExYlT2aix0K2ENW1kNqg0/WSJ8sS",
+	"jLhkFQP69RIwUKbMjEBouG0z8hwpIBCIrx+bCa3oe74ypOerL7/84svpZMVz/P3ET5bnmi2YbJzpytZF",
+	"T+Ka/8ykguU0MPD4yJaRlM15zhQs7wq/sZTgRY8Q5IpId26RdBpimhMcakZOAQMUUUtRZKnZnysmNZEs",
+	"EYuc/+57g3WbYTKDxLq8na9oVrApoXlKVnRDJDP9kiIPeoAqakZeCskIz+fiGXFItuB6dvm1mnGxn4jV",
+	"qsi53uwbJJH8otBCqv2UXbFsX/HFHpXJkmuW6EKyfbrmezDZ3CxKzVbp/5JMiUImTIWXwtWTC6bpk8l0",
+	"Ms/4YqkTnZnBys/NK2M6eb9nmu9dUWkuS2X6KTfkZ9+0/Pad6/tIxIpfrNZ6YwZ6v7cQew1EPlivT0TG",
+	"kEKfaiGZuS2AP0pTbtZHs+Pg6M9pphqX8EH1ggSSiqyEIsr0SQpl0BoOGg4IlypZMb0UaZO84Hfz139K",
+	"Np88m/yv/ZKf3LdYsV+b9Ets9GE6WZlTXF4kln2Y0PVaioxN6tM/W9q7gOrgyEYmSrgi0HeFpyiBaXpv",
+	"49dMGTl6TgrFUgOhTCwIz6PdIOjaOsLSeFeGDaZmqWuq1LWQae8NbyHt5x6MHqcN635+CQjeep1ZfAiP",
+	"BOyiMlvwr4KmGTAFQJd5zuRkOlmybGXmAHdwOvh8wKQOfd/2w3/7IXyNciT76Qcc0P46dePiUt0KTDuW",
+	"A7GnWfZ6Pnn2azdmfscz5hp9mHbXPWEZ1fwKGR9TucKAmY/NjajN7zlbszxleeJ4n+pdDaXqdYScG+Zd",
+	"RbZMVa9AZChWhdKG6TFc/YZcsLmQDKl80NIcEaWpNEeEHOT1Imwr8oQRruFDkedw4+Up4Ror8JwpRdZS",
+	"XLAp4ebK2EyJKpKEsVRNiZC+gyVVxIA0YzheuAIqGVFarNcsLSdbW6Vesg1B+BCRb8N0f4gfDtf1i/zq",
+	"Zyojm8HKgjiBjYxc3bMX+RWXIl+xXJMrKjk8sC7ZZg+uOrKmXKop4bmZFUtJWphuDJw1X7EZMQf1km0A",
+	"4NiC0WTpN/eC6WvGcvIEKjz98gvDpUiaaCbVbNJYdA8YfmA008tjs5MRWGT8ipmthvI+Yh/0ivWBkFlk",
+	"2bGHvtkLpQ+ShKnI3BO6phc84+Uhqz7v8uI9CevApZimjpPxNC92+mbkMGzptoZmmbiuceZVIUeTcfWs",
+	"9a+TVy/Ofjt4/vLo1eTtcDSfTrCvyBoNdOxIsDpz5RC9lKJYLActc4qYRxX54fXp2d7xwdkPvz47fP3q",
+	"7ODo1YsT+P3212fHL05eHp2eHr1+dfqWXC+ZZCT4RAz1MSTgguceBHJKrpEpnJHKLG8JkoYv3Nd68+b0",
+	"28eTqf95cPjy8TP4sRIpWz2T19tBmq+T+L16dHwIkhO1pom/YPtAy0Es8mAt+RXV7MGUPFBLKpmhFg8A",
+	"GQ0MiIBaVZooyD8FzwnXU/JgKZR+4CU20YmYKlPLN+0KYCDqD+h6/ezVwcsXD/wcTA0/7R44VK4a0031",
+	"3aThXZauKLxczOco75UzfS3kZXwjbGE//GH25SZUoeh6qcDvQS5yhnVy4aoAWKAJXcGCqVuCrTAjr/AP",
+	"ZXdSL2nu+toZ2xtwswCrDz5QqBGQ3eiL2XwlK7pem3uK5wSZWHI+MZAxhc88rM2v8wl5yGaL2ZScT75+",
+	"/PXjZ18/Pp88qsoR7HfzrqBaM2mG+f+en6f/9cz85z9jG9+4HZpvX7KE64wkS5ZcBrBcM8lFyhOaZRtz",
+	"0SpCF5TnSoOsKqTrL97TRGeGAYLtNC/R75meEp2sT0VyyTSQLfaeJX73FIuIX0yNvuvuxXuW+JtyTnlW",
+	"SHaQaPus3+ai/K7SuOztbCmZWoos8lB5VawumDRrTESuWFIYDpeYdixFEAVS4AtmEO0CzpTiKZMstVWr",
+	"qPjFbNIt6UC5zPdM963wB6zmwcNzrjnNnrOMbk5ZIvJUda1JYRUrTquf/ZIDDvlOXCdXZM6l0gYG1cU9",
+	"rizucWxxiGdbzM8xc/paINDFvJxLdfgnj/uBCyy4Ultvu203L7JBWx9URwAv6RVIXiMo8aR/1v5s9SHF",
+	"mavo0ULzFROF3hoj8DKkZrUVkBPToSKi0Fuuoo+uNk9pRfDxSuRNqQdWJJoatUDlbrhesjyYtIG7mpHz",
+	"yQkDvD6fEIl/qQG8bPD4t9Ow3Qx/3Lcv0/bYWccPF4HZCVMAoaa6wXzHG9cSfXtmzidv8stcXOfnE2Ke",
+	"VFkAKPMaNVwwS4mQjthxgJI9MSE0bD+T6eQUEX4yndiJ7wganHXZb7y8HC1e7ucQgdepprpQw+EFX/I6",
+	"PtReUiWlsEOrXe6TCmmbxAhBRhUe7TMeU1mar64XU7VxeisCtpRqtmeOc4yXWDGl6KJNLUpKtSjT5mxV",
+	"Ri3X1Lakchzp0Xeb69wifV0SaDubRjfk7QACpLqxwy8zRBA1BEOcnGDbhdr5hIKCXbvoJ8CgJ/2Wqsiu",
+	"H4rVCvXGdlFwA9IsC5cN0lMVM1PwEteeiUM184SJauTPalyKqeWZzCf/z//v/1+V9ZBM5IspMjLkmhvh",
+	"OMmY1kwSIUkOxxE1L5b8k1yYe0/j66xftezW9XYYZL1ClJtFrXhOtZDmg304ICVBAXALiKx8OOi8Inxu",
+	"bWUrVNuBoLqNu2TZqlrbCbtbGlhBdbWNk4G3KT+wOGzzweOOtcrwQP4wnYicDRBcR2DUJ7+OTL6vSRSm",
+	"fY3qUO2rHwNQ7U47sVq7n/iK6zjhgnKSQQXPuHbfZ+siQgKO32AnhOckEZKpGfkO37mSmRMCstoLCrxD",
+	"3qAL1dft49n//jJ+7ayE3DQHfwnf7fhwlsUaBc+kyLm+wUyefvnVamspgIPqS5FzLWJCchmpUVuSLXF3",
+	"imtBCnPxRmWqxniJmH0wRMuCZOW6AWVBsV4LVFy8gV7WTCYs13TBsIK0mhov3aRrmnC9qcuy2PuErbXH",
+	"FtwWU6kiZ4ONWAVbo5wUjav6UFzaKhUVRbdWqQLCrRUYrn3bnY7f6+CPXOdTwoyagRqscmIRVPTYHXN7",
+	"0HqSupb5HEBenayjeOVx2K2H2oVlZuM7fTsMem/ibOBJA1vrMKMKuUEFJ63PGidZF8ceV+PEp+1cmJFo",
+	"gOmuimkTx+/gakc2wPHWpzDXFuZ6iY9f6Afnck2VW942nLUB/7cbHXsk2ENdqABgwUq5eeZqpiqj8Vx/",
+	"9dfocwGH6oJrz3gRyBrErx15IR14SzpRApvwudNz5iKPw/5KZMWKtcDkOVeXBAXm4TyxTVR0vRWYmmck",
+	"AFh1uyIQbeDNwGPVdVUnIldaUp4Pva8zf/kPfBjUuIY+ShqQlA4qSoni+SKrboXIA1QIZQfHkq2pFQyc",
+	"aio1/nmCyvTJdPJCSiEn00DIcOjU5NsLF3CW4ZiNwmASjbJyVo0iN81GQVSIgUXBQqqAfqOYjPASRX6g",
+	"4gSpUEw6zUpp+Qmfm3YN1kjtgsHTvMiNATA5M7W4OZsaezC9UWWpnJEEcr3kOViQdiuMHnLPHlxk7FFT",
+	"B2NnRXUgqENdgyIPFyxnEtUPQuhHhmqYKak1S/icx6yVqvZgbywkws976pKv9xynuAdWw0yiFXYfzv8M",
+	"5KVqL1OjS9Z6kMJDNLUEydOoPpFA/I37Juf/KkptWUnosF+7GRGKEJGsJBnlq2OR8WSzBW3AhZ9UWteJ",
+	"JMw9Qun+PfCNdrSiC4YDVV7HfQ+il6LI9Q7tYLzWxm/rj6pIpcahtNdPu118eDRs5cGsbxMPt2V+Y7tY",
+	"EaifMHOUJ9MWpF6K6+CULmmeZoDqFhm9fF1co+1T3UZqJa5YRVZsx3vbrbfEafdz7PR2TturxjFrOUpz",
+	"JlmeRPlgW1QqmteZ2LCUvD482gPDLk5zTfgK+CdJzCUzp4kmFzS5dBalrWPHzl04nx5uQ50WqxWVm4EX",
+	"eFWcp9ovbzSKMtbrz9lC0pSl0Qv7lQjnsv2tXZ1+OWhrlWA2rXUiF3a1QvTirlapL8xAvUi5fnFlbSzr",
+	"xsWuDCzDpdGy4W1Ik5BLOjg+AivBJTLeq0Kji4Nx3zKqecAfYA9FxohiSsXdwhIrWOznF3jKcm3eR3At",
+	"r5k07DJLoQznFvcZq9jXd1MyX/PDdHLJ84jK9Ueep2gFhSNYoz5veO9OycmL07Py0Q3sDWJ9WVWVJvnG",
+	"nJ7nc8cIzaVYQS/efcj8cE5jxcUKX/TguqSIFsaCLc8FyJaLtXnRpTNylJNDumLZIVXszg3yDdTVngGZ",
+	"msUfkpqmVNO+LXgNMHrJNDWtRKETseoXxnuUfW0bWFLEVKtZ99HzUpgCFbfEKre18d7NIQg23/UEj/By",
+	"CJGXpjXWZu58EvzYt6fH2tu0zuFVqwYiPD5D5uPsf6PDYfOj4zarOe/jE0JVSL7gOYijDFbHO7YPjbRl",
+	"FcaBh2Alkog0XNFa5MpfZ+V2hQ/pL55O4tYVQCNjdke2yA2TLCk4DdklBdKHdvS4YvIivhhT4nq2u+CR",
+	"IJGMaoY4gOcY/15TnSwdamTMfU5EnrNEx9Gjof/xtM2StuBQTi0ZtvMOsLs8g5Vtil7l/hQarrLrYjHl",
+	"SEMzy3+WZVGV3G4E3HOvcZ63NuYwXte3iVmUjjfGR7gxzHbifbEdxuN+dyPy6/L+aR5kezBqZxl5ppLP",
+	"N30RdmXlyI4n3dkEpT61sqNGUcWwpNDLQzBBbT4Odz1e9nnmXp7dDxZbucu3u4EBQi5obp2e1YvQQT3m",
+	"kV6pDfoj646O2v/KuN0e6l3vZPPquKI8Mz23LWYbcgLeGgi/2Ku5BZ/jWKuXzzc5XfHkdQCKA6X4ArxX",
+	"YhS5pwmh8KcCVhxEY1Uol2rLAi1MbCQI846PKZjgfd/qKP6P09evvJM4ki/KV8iXWWke8hbhJOyrYM6Z",
+	"dPfor+eThRTF2jJTj88nb4mQ5nNSKC1W+FnIxfnk7aPtPP/DkQ16H0s25++rwoq4vyNU9BLyygqASfM8",
+	"gJCLvTaGrz78aTEfNrwq5gOH3wO4xIfXva6IlY6px6OQ9KWIcBHhSg3foXQaIE0P1hvv0YHYXq1K2Hst",
+	"aaIVOI3auzSG0datlpaYenMcN0PuA7padG8i8Vv4BXPzPxjNVr9RoP2Izq54S4RWbE0l9U9wh0TPGlh0",
+	"6ioCEgm5eGZGdM4DD21T8uDZg0czcgJwtGfW8Td+KNT+rzOwzqjRlD0IWZHiTriOjCBZFLrWwyITFzQD",
+	"8wIjCNpYL5tKd2pHPIa13Rf+bkOu43VJGkhCkVZbhpCrGiZT6RaGzswNaHVZfLm1d1xn3VcQGNWj4qjj",
+	"RsQqrV0oTXX3JE6hRksHTbMtvZXN1oAB+jvoBtOQHrqh9KEN2bqbRXGuswnBNys8b/B41q4XQy7A8dDg",
+	"ZZNeDrlRTUtzL+0NuVqhstXEJV03ne/1rm/bwTO687vXHb5htKsVhVo5/rA0eLXiQyjKK9cc46xNmLky",
+	"LoRektdHzw+BwmNQpduTDYxP9Y8u3FXrfqe6EKFQ/7ndIx/G6MPjNnFVtUZVYFW5VO9PZtUc9hbemeNp",
+	"+NMIrmqnpceAn9J1K8bUokZOJ5dfq7bKP36tapWFQdSnrXQAiHm9CU9beTpzDdSrr1mulnzeauT/es3y",
+	"U1OhZnxRZ/4qMfcGM4GNGfWxbJE19zZpWUHPWafrrerXN+/D2yo2VuDjZIlD3trVOpUnCr6z60+RzofL",
+	"7T1NanMf/p6oNby9d0Sj48Hvh3rLNqrQ+V6J7l5XCy8WNM/t7uemFqWpJcK5wqf2vwf69ZphCxuxpJyX",
+	"ixzp8OzueGuLRUPFAo11dm/dkAMXq1lulQO/YtpJOJQTmfSevOoeQds4wBx/ZKrALuEYMInKaFtGXL2J",
+	"xGbLncHVxbbjW6OGbU4DPgOjlBOWMQA7z8kFfFaGdckT1oQiGELHF2XDTVq/PyJkza49CAOEPBCxdpYw",
+	"5mwylAQFtuGG6HS5xL8FYWHGnLlOJ2dDL1h26iq3RDIYOq8PbRtxaiHbsiGuuBI406EnwAkBeMEgAEih",
+	"WWqg2L5fqnW8g2q/OCL3ErVBLDri1geIS3CEDZ40z4HSkmq26DWRPRFZZiIpuOp1VPf9xND8sIyFGlqD",
+	"s0Qy/ZwpbUM/RRxfhjUkKTMuxlJV4676mHQ2hhKGTcN9O8ZIOC7I5bvz4vHjLxJDX+AvNkukfgcdNIsu",
+	"2eaduyusVXfVFtyadUd9gV2l/muoz8S53U3YCrBsP3kYI9Au2Iag/YnlC6NYePr4cSXSzq907/eDvf95",
+	"vPe3t+Wfv8323v7lP4f4BQdOsG220i1bixawO+BEo+GWODHn5vbieWlbO8Cv/Va300zShJ3s7wmDXePf",
+	"xy9eEpYnImVpZZnJkvLcEXFrYFtxSXinMwVIHp3MJdvcbC4hePtmYc5T3ObJeyF0T6LcsX5Ad2KrHbAH",
+	"X4eiZxUbXahvZChL6/LheFoNP5UGvftYU6G/SCuulukEOn28BxJtMIiNOI5s33fz8JtQVTzbpjuDtZUe",
+	"PnTvZb1+137W6u5EYRpG5TVlL5P6eGDg+yEUoO1w32CMECF7j5dfTzlqz+HqCp8frRYcLTNViJov0Uui",
+	"EoP9sBJHX9qa3vQSo3CTQ5FrKTJyeED4nBR5NHobTQaYEFdTAQRPjoGYjI2jnsytypR4895Yz60we+fj",
+	"nr8LRKZLFtYqBa4NEE7JOwOqdxgXXxGusXI120H4svLjgQXpaqgVW3Td3wV9RSscwABVsJ2wTNC0F/3K",
+	"ajX0M0vClxQknsCgA8J4ZdjQYlVasaSKXDDmaUmMcts4Im/yaIiLM+/3mEIwiMjI4KNsI36x1OA1hk0W",
+	"6GOIGjJbm+eLygsjZBK//KrKJD7e+xsyh8/Oz/d+m52fn5//n7fx4Iz93lsV4F6JkqvaIox9tI/A6SSE",
+	"fPX02217UEm34fL9OAcRuGVzAZF1mAQhUJKwtY5tGhL4YfKm5y5GNyOSXYnLGiE3JvV2tlp02tNDrRbf",
+	"F2DESss2O3THat2Q8VlNCc+JCUsqE6oYWbL3NGUJX9EsOrNc6IO5bpsbe7/mVl+reehfEBt2TsBBCQNh",
+	"y3KbuQr2Zl1cZFwtwZbQgrZ+7GDQbUIXSEZVv/YtioMn2BQ6gTUd6J4QC3UEsO2Gz1YxyWmGYdfiY2EN",
+	"LxKa38pW18UC4Sw8gnpYhvB4O5QoxLWrrVWratZotXvTtraOPkiiE209al//tNrXLlISPdF4qghtox4Q",
+	"lgall3DznXx3SL58igmJyqiW/hE5mU5+ZBsTrkGKFcfEHPM5zzjm1AAnphTCX66ZVAw9Tw+ZUlD+ev56",
+	"zaR7WR9LfsUztmC/cL1MJb3Ot2ft6kCoTrWjYn0VHVWjC+yoX1t7a70WsHS0iEGsHSngQPWySzTfWCF+",
+	"JaFUyau8recvqRDwt0YdWPcAt76OwiJZNV/ZVlzWjLzAeOvlhIiQJJyCl3RYXVJaCWdWZbsmT+lf5/87",
+	"+YL97eJJWt45z2pI/eEWWLfrpVC1lSMDjpc2Ociy+q0e8HRhT2GzO2NCduANvM413Dm/6cAiBIzB1Aeo",
+	"yzbeihPQIBFZ3Ii6501wyhc5zxcBorde/9WqFatB70QKHjzEWgpEH7OHB6Nt4GdmG9iKQ06Co3xkjt26",
+	"KWPR3obFYes4vQxys3ork1yt+jEY5eYMtmWWqz2MDPPnwDBHDnDT0VPS9Ro8WESRpzYoyR66H6Tk8PRk",
+	"SlYiZRl6JF4WF0zmDG5uAcA0uU3DG3129WTWOYVYHhAn+WjNUXDigwSkQbYXSAHL9cb7BIRy22HxCsBJ",
+	"rSuP2TY5pioJzkzHhGpErlL3XEZAczCGi9bAeS3WRUaDSAgm3oVNhityrG9WDrqVlQkNc5GxSDozH6Uj",
+	"ztZcUMW++uueU2Ucv3hZ/v3j4en/evLYTGdGXjprjiXq1Gaeb+AsS1FNHOBDF/OBVKGyJRcbHRfZGHZE",
+	"xhnPozy1bGMQC4OlyMLYQN5Aqv5V0AyeRMCnRg9owSPE7s3R83vYp2ASii5iNl8QcVV5TSZQXzQwMxJM",
+	"bBWs3+qWLS9dOwTDEdhFBur2Ob8HwDRi+iM2V5BjO9LXEk2sRCjIqnpFs/2U5Zxm+zY5kIuPIublKoNw",
+	"8aoF7lY+jfm2VVzDaavGz6jtssmbT0vAoVTXw3zQ6TLkFU2oYgH+XRlaD5TPMpfOnfxohM4kCSpKSDEr",
+	"xZURrDxnOWcpQug7zHMzmFNxffY67AdLiOJAM/b74ISnbbkQPkwHt3MpM7doskMww7bklB+mW8d/9dHJ",
+	"t2hbydS6ZcjHtqwIvfEb84zn7a3ffogjg8OqwTjgm/idX0fymg7sYxvFdUOq5HspqY1LtQLO4jkj1NwQ",
+	"PtN7UkgJDLMGd1GbvNzQ4BN/A4dAief5MF/LI06UlgVwwWRurB+vDb//Y3nrm95D1pi8Ucxm2jDgdglD",
+	"KfGemmbZxEg4I7a7VOkzSXOFwGuNwW3qBVoiP1ft29q4WAAkS8LNTHLIL3j7KXBsPcLxPjEwcltFL0Sh",
+	"7Yz99OKOsRdwVabfs9zJRqOrn7lnwGzha5YxdUtoQGhypm04kWIt8kHBsEPxWt0g9+GF5Gz+yMnYPdvt",
+	"xnygBq10oAjB9doiMrC9TGNoEyjX3B520odhCQL8Oqcu9+GZLNiUfAeCZWKjRoaKBFMO4YgykLnbGkOl",
+	"/tXZ2b5qX13Xtc9+pHCVLYYw1gimxBwevqSD1bibfjKdnB2//JlJp3YICpAHsCGYYlXBZp1fZKzzh6NY",
+	"x1Qq1K9s8gT++Nk8+kwNNMY+MhfBQmK0pjdGFmADiq9Z4qq+LDLN1xl7fZ0zqWCSRs78nBkxAFeKCwjt",
+	"PWxXXuRSZNmK5doyl8HiG2XVtbfyp0EXrXU8YFtreIi31qhO54StheJayE0F9GjdeKqFZNEtMTvRWtDY",
+	"t7DQ7+F3GWPa7Q78iO0m7lKwp/gh3Fn8MnR/8SzM+aLuzjmMf/me60jzXk9Af1kiYHfgenYY1eQG3aEZ",
+	"TnGHhq8THmtlQd7Mk/QH58khnsPnxcMPnWuZTr7JHEOI6wERsqGeZR+4KpMKRLmFtZAxm78w4fJOYdVN",
+	"BzExiAzzc2y5E00uBUESZfdj7EjjpBzX1LIVEFRz8cWTiGM08lU8COinB9sm0NZF7Rw4ql4SmOqiba6m",
+	"frPgUp0hXIKnfmlV2Hs0P8IWCa8CIipF/uL9WmLA74jwRoqcMF/BRRAzaGH6TosMFDV8xdTsPDeLtDW4",
+	"Iu/+Quz/v3tG9shLnheaqWfk3V/ekZUVAj/e+/JvM7JHfhCFbBQ9/cIUPacQ2/elyPWyWuPJ3hdPTI1o",
+	"0ZOnQeNfGLus9/7V7NxYmGBWMyLAcESYSeyZis+8nNoI3FA5ZUP/mG54TpZmyr4/dsXkBr49MuO+23v3",
+	"jJzQfFG2erz39TsA3JOn5OCl2fuvycFLrD1994yAes5VfjJ98tTWVpj3/MlTvSQrgCG22X/3jJxqti6n",
+	"te/a4GTqLU7RyKC6lq9LkBgK+nXQ5Dx/gcYfBnLk8d7X0ydf7T39wm5plKYeQshGZJOO8rno0oDUH4Gg",
+	"IHIGVBj70WUFtRsQHbIu4Q464TkiI8iG4b0cTdRVnnmceCRPFHyvWjuslxvFE5q1upuMBg1/aoOG8tEw",
+	"XPRg2+xgqvC2FVsbKaBiMYO3zZLLVhcsTbsC+Eby+rtG3r1dCJ0gTxYP4RtXCtVkYNu4eWLW5G3TGKtq",
+	"KuRNi2VamGTb5aqyuewlg/l6b8y+ed6EXQkni3nmuxKCuzrESQHb0r5FxHW3lBuMKyILiCtq84IdzclF",
+	"RvPLaQyJZJE7N3LIFwZ9UhX4dNbzed16+q6hpzmexs6pX3fYWkyI6X1zO+WGtkqQt7AK9t0TQpUIVuey",
+	"ryk398x3oiV8eCx3m4oihO0JkRGiOuD5uWBzuC+Af7Q+Vdsom9uSGplTHRyYaSnk9ZRu2pl0u0Frq1l7",
+	"BuSlsInd64lue3xWuWWiOklkyOegfsBxAyA1D2F/KxL07jRQLfL0dqh+W2SXL+1FB1I5tqVr2lmQ9sNK",
+	"AjIT1ERhuOE8F9pBO0wf6vLx4phuBvYlYCBEDoKmK5rTRcPcmiSeAcIZRNzVMGVZ0FerE1l5gIKBtbBJ",
+	"z0r2zM5/OzMM7ASivQyYgQXgbQ2umK4BoO1Z0MPgnzWhYxSBWoRzA6fMINxOdddphsRmSa9A07eKvgUU",
+	"0yWsbjDZEpB3Mc8Pnaeq9IZoedJUKiGhMAPn5SFwYaDCicGDEWOrw+qICzVkztu+kNaSy31Fb8KyR67K",
+	"GD5UbfJkKUUuCpVtpj4SEx5ODyZzjiUDqZJ1/0dpvqlvfiONGd9gn+UbrILEuzzIqh3criF5ZASX6iXC",
+	"M6TWUSU8gHNQvxlWqc0Tut+VpiHzGGCL8MsSb7rYTLo7rYHOTrJbZR6BU9zUvqVi1cg+UunezOtbxh7E",
+	"jEfajib1f1qT+shuHy+pajnPa1NkWdYKj1r+QnMdY6ATWFq629vf2u4a54r4dOE2lT6E/mOpPeSmJRKQ",
+	"VWh8ScDa6ZpXjTiOWZ7WE6377ku7jWHGEG2QKQdpq1EO3lYjnFRbHTfZlk2y3AdLn6M1VlPkZnCV50Xb",
+	"VgrlLWLCQIeNLTKFOXuvbahCS83L/bbCHapbRONmdv36KKwHW14J2kvWhVwLhby+2+dOeESTMOO1ge1U",
+	"n3AtXHn05lGCzKkcaHK2liKxG3WD0WsHaosZNG5CvyGRudUhNZBkeLXk9s7JWSX0Z8M/Gdj4oPjttP8u",
+	"joTt8e+ZkFK5twaYT6ZVgM/IgSYZo0qjoaWdQdQ/uc4PlaY5w+7a0JgHkLWy5phYx80GnsYGyxNdWWYV",
+	"d4J1ouSA2+cR2rQGkk6rOKUqtIOtvqRUmX3EG2samvkNjv1kat9B7qLFn9+8LvTr+XPITxpPV1LFg7tZ",
+	"NIwxbNHVR2Ww6Eu2efINcDVPppds8/Q/8MfTtnUNvdpb5VAfphO/qh14t6g8rOxw6AFvETG2VvVPZQNm",
+	"/1Sucw5N7xG8GDtNpL11dCt1bGM3hltH3+p9MfCisH45LcP1DQIk7Hq5mZHXebaxsTul0sR1a1VEmGz0",
+	"Jq8B93aMPAoA4FvDzW8THEl/5K6XLI/ss3XNGgjU1qflAVkaCfael2DXZOWSqSLTDXYnrvNzHPOWkERO",
+	"+255hCnheZIVKcJXKNBsbI2bkGSvEqVbtfkvVOmmg6bIGLngwDnbCHYLSXPnYlcR9XlPvOulcLtNuJ6R",
+	"XwxCKKantTbCIHxA7+Nywtxt7WpLsbGmUm9FjVwgvgsGA1cdzrYkRTXCjZjWOGi3x8m1c+r1q7WxoroS",
+	"QzGtrNTUSO9Vl1LEcWtet+GuTeQ+piTkkUjIYtX4u0hXYW3X3QlTxQoP+arGIq4p6DPTgpkFJiKfZzzR",
+	"VgB8wiTLMVdZDiEvK4MvbERWH2upAhB465VTQQPyYoVm5tjf7k9Ts2+R4aL1arNoqeUn11ru5uwxKTTO",
+	"j3rkR6sFrHo1slWpbwduG3Z7aiDPrhGDfIBei4kVraap4KN1VmWQoAwwMLAJ9abk+atT+AubHR0bRQk8",
+	"J6FpJaxPypKMSjQxmWeMaaLZap1BWUK9RxtZU0lXTJvpqcJYaCjy7t//DlyczHjkwwcTPbVWYvlUxTVU",
+	"iLJJq65sJ8HaIpEgqyG6S07BAcGphPSStXVB+JzkIgSbrEWnDgKLfvXXuM1cGJR5YKiPWvDoNFev6Krt",
+	"vixnF4HBVnfAgDAOZxW3fR++oY49PUGJp5hRAsZAND08QPGnaVftCl0RiVoKqZn0Q0ajQ/hEHV89fuyX",
+	"F9zvfH3gED6+tPqRuBE4yzimW8VKdnqV9sQIRc7/VbQibR0tv2jkRDAJER7+umf/+ov79Ojv/xk3oTIR",
+	"dbeK1eWDD0PznF1/C9YmEfMKcQ2xTp05CmDgpnkMFdJDG/uPljFEPM35308fL99Vjzwlesll6vXsfM7C",
+	"uKw1kPnwaxPTVS+LYg1ewvPdznuEV0HnE7NRsR6X2VslVe+QkFqXNwAG/WiQ1dbHgsEtJqXwYVTBqMVy",
+	"0QaFVmttAOvvKSKku51iIN0i10cHLm8ZhRe2mGprMBh6VYcAA0Tb5qmcC92GxH5U0Nh0j8sVUrAdGePW",
+	"PCQWfcCBqw3FDgPv8qLGLNh8szGTmjxlkqWB/q7uGoQVyBXWaO23e2X1cToXqUTWajtui0MTcuv5C58T",
+	"kecssSoBb8zVXLdCN4yj522RBaGYHD0PfahrI8ziEY2h5ctAYFZ7snvpsh/FPxPs8TbztrFkUC5H1pRL",
+	"NQXO7IKhqaIWhOdcc5rx35HL9MHymTR6h2zq56yFazYlTCdt20VTI3VB4816hOTqqqYBANu38nlNiFwF",
+	"hOvMehRQh1Jp1SvUBzdp7KGmctGfIaQ5lTNoFw/9gF0OW1LQT9O+1EcGwsOizAiNpa2YXoq0aVtQhrll",
+	"4LAMWr9EC7k5Yaoyv/4HVnzGQc9d1aqjeigcGZ5Lcr05XLLksvvOi9Wtn94qyeKuBUlME7Jm0pwIDHC2",
+	"o43nXtTGs/RlqY9Z2kHtaNrZvvjdbDtbe+oJibAFMEusw9AEJhyCcn5dYcAA76K+DR7GFlCO1FUnnEN7",
+	"PT+79irlvJtgbQ0wYQWqbSgq5p0oid+PIKWB3uyONPDg2NaEuURv4PTKSfcYL5vaHlbN+5GvmNJ0ta6w",
+	"kWXnV9CyNJ4fmAlgl1OFsLFb5JwG9Hp1EzjvfDCbkxl8NFsvgCAYhMfv+PHc6SjWjkXLktpOVs8Zbh7f",
+	"8tj9RJU+ZazVnNaV1y8KQDVlCnSIhbT1/GWtAzXjFKU2TDZVOETVQH1Hlt5PoB2DfuJzlmySjP0gxKVD",
+	"HIcB+CoJYmzA2yj4jRVO2IUQYY3ywzaYUZlKY+hInfpsWrsJJ9jWTzDnJnB2evZkrvUtOITUHV/Lzm+L",
+	"W6itdTdGIdZJGyEK02vFINbkCDCAjqUG1egt1S9bkqTarOtEpVZcmUWkPDa1nmpV8tRhMNtmI6vu2S5W",
+	"ban9Hs1f/3Dmr9OJVdcP20HHW9y23Ww1OtNzZmDQYZF5h8aQ8ZlEbSGdShyCU3UcFlRu2ZTskB/NNKyx",
+	"Wzt6RVasEGsTGgruE6ZEdtUBbpfCH6rHIY5rdBUJVUSYyuRhXmSZVXLBFzDuMh/N5ebkPPdp7Rpfe3SD",
+	"15JdcVGol9tstN1j1zbbEGtjsOOGo4d5VrQbFRgVhxUPgordZjLChYUAwKAhsJrJdPJKuL9gXc8ZGlK/",
+	"7XcBCVCuNrd2lHutujTZWFpTRaAkjLw+jXipDIopdVbpBCpZNYAkb05+6hcZtwVmCha1C0v4+nTwEn6u",
+	"irzdMqLUH0qe80VrVPcUyup9oUkmUUv69MuvntHHs9ns0VDQVAftABQctiVf21xRH4Oy1+cQPfI5u+6g",
+	"cjm7tnQN6Z2nbmiZkw4jbo40dAzkqsRHy0XOhgzVfnDbd6oWmmIgYvsgGH3CqGRdDOM0qvNwgpWUq8ub",
+	"tF+xlZCb3XuoQdSsxndqZzcUtN04riqxxRDYVaTGgIBm2F+odD46kmueUCNSfyGlkFuHk41NtBwoVloO",
+	"HisNJhQrdpOMlYXRaX15sWI3S+F2Uy+JSho3Mx1vtIYhlEU+0I16uFdEkLWtOvtnE5ZfcSnyFcv1N2sp",
+	"0gKUglPNmfxmLkWuWZ4287bt7hbhnptuzSUU7s8fAiw3/+Mb6z12+14QA9Z4/+4PH7qIChwKtRa52jbY",
+	"hmuGT2FYZs3KP0A+KA4sq7/40NTkV2u0hzIq7a6NHQWTDL0VlZoXEAsIO5r1e2HVhmwnvl3cZ8N4sj0M",
+	"XGkX2f4MCGq5gyx3i5BZjRXbeBiEVmzt0wlrRU1BY2GkOs0+t5P61I1ZYwsBE46OJUD5DsCMRimODa9E",
+	"1max5wgCTTS/Ko0wrPXBtjIwZ1sSTQ5VFRnu5PMmBs7DvsfqAcxqZNJMrcKJ2MildkesjdxwGNRil8ag",
+	"YHP0dyfw94hZjbpas0Izz9tjNEyMLa7qqkusCWN1MfUmNrmnm0eRc21NfcGKVEj4VxSaqGI+5+/BBJgS",
+	"tWRZtqf0JmNkkYkLNxjMH0YHm3elnUlXtiHGxJHhEKpmcfn0y6+qJpeP9/5G934/2PufZ+fne7/NzuH/",
+	"fj0/f/sf5+d75+d/OT//+9v/evh/htV79PeH5+ezX7FirDhqxNkQUzUIsWYZWzE9lP8+c9UdoqLM9Vhk",
+	"PBnYxZugBfbSfpF2m5A0jUbiepnQfNIZydu2RvqspXm1moo00QXNyjwsN710sHXl7glfDVtQqGYQysgp",
+	"pc2wYVv3Xgu7NvhCK+EcNaFWfbaqO95drbAYmDDL4w7MCWM4lqHMaDw9D932zu1IkhXes4MuqtLSE0w/",
+	"rFJ9JwMJZ9NxO4pw8vDV67MXz1CN4+MKc0VyoYlkupB5JcHco4GacxtV8p9K5Ht8kQtpJStm8k5vt5Me",
+	"dcubOQwLOiy4aFR4s612p3Ee8ZpzwZ8HdFDW77rJ3UGu3KJbUyscLH2Tc91+Nq2ebpvrIm0xwwmOeQUy",
+	"VWI4idPGcCvDs+TPJOBHOd9y50LU63je7BzBMjhtSyrTayoZ8P7WTTFf2G0qZXx3E9nSEWlrFnAbsS0j",
+	"oNnNoKHZRY9dVdOM6jVkaQFZ10JSDFLqxF+hYcqxMM/h9PV8XrGzOrBRVk+YNf7GdE6g7zkGR8utJHyV",
+	"BQVTa5QFs42UVuV3laKmsU2luLLMSHnd+qJSGANGpFodPuV2VsjasJj2r9dYx52GIGsve78Wqrxv4Hlt",
+	"Au7TZAm5WBMhJQhaUus05B9PeCw0k6bjhK7pBc+M59l53h8dHxdROVWJyDJQV9cDfEaYSjPJVo8Lcx8f",
+	"mBrO5SJ6CENrhZY+gho2kEXoTtwax86gTswv4lshtHGI2KIrTD4w5Apr5DvA1M8sV4612+YCfFG2LAOP",
+	"5AusEIfWa1eJnDqKO3CZdWOMcGM8NJuzmFbRoIP+xZbVcXTi31FH1Vz3P05fvwrMdvySKVEljjvkrqRX",
+	"KedZJ8Y2IWVKVPOY1MEaHaUM32tANDUHGVv4Gpg3mnwHAm/nF/OvgknOUpQG1AXczpXQSrJLHmJW4tlM",
+	"saSQzGD6jOWGQqQd6S2qb+SWt2ulkic+VhhoCniC4r5MLEIJ4VzIaypT79zs3+9kQTW7ppsZ8V0TrjB8",
+	"hG1kd3ABT2U/pIVLxeZKzFs6bxrSikXvMfQT+kksvIRrRd+/WRtxyklrMuUVfW/8egm9YtLoxCXVNR8/",
+	"hEkB/ahyuhjmdqOZImsmrcvx1CZCtVDIiYtOj6zuHnl3+Y48vOQXHFo+mpJ3q3fk4Yq5D+DKvnhHHi58",
+	"HfRixvFxeva9k/EVt1H/ilwxXXU1/eqvly2WYmbbB4PzJdbvE6I0BC49XklrqAkCaIjJXWofrIWdCsOu",
+	"QCAb+52opSiy1Jy5VFznVlhmsNhG1Yg4Qth6p5jsqPclh4vxtf1rYtf2fWBLdzImwTndqnFxyI9j97fJ",
+	"j1cWuxs/3uxiC/PiEmBleJEzYUKqTaYTH16tmil0Fy16ZZLBEJHScNRo45pxe7W0oSgPJXI970AfP9S6",
+	"fYKhiZegwIGbMx8h3Eq/wV6sU1BZYnIbizAgVlKKnvaTZ/9uXPAH5EIyemlOdOdKLjbkPJzX+aRpKF8i",
+	"l6o/ov8Ak7dz6p64FppmLcYkpigS8SocaWDsKkv9/kjQwSmlXdCpu9cCqKYRZK3vf23BUWrE1WVvYsGt",
+	"c/lN/2DJCKMXeGLj5pmbGzuAu5urSwIpc5rkYU2N6ipulyiBQ94QUyeYvLPvC/rsXguMEUmkiXslCxj1",
+	"2yK1Tts1brlWg2DuOev2xa4YRFCmlrFLfW0kkxKzExMOeOoiNTbBsJCiWH+7aZeKoskI5O/QwjnLEmjm",
+	"0+wsWTj+BUy3IjgNlIMPfz3Y+x8bgOXXPf/3b/uzt3959PegcIBaD3npnF5Rbg0Po8w0BskJqI7bI+Jb",
+	"+kOdFoA5FnyzMMbOk2ggQp4f9AxP39eGL/LmuH4ftxo/ysOJ5JLJg0Iv26li1PwGG1q1MC30kuU6PFiv",
+	"D4+IZAtudiPq3FPo5ZBkcK8TfuCqGqMdqtS1kC0qdleKsasuGU7FTmNTm2bl5vD9RtDIZRnryUHWM1SP",
+	"2MOtMRguWG2UgBdtOVVCRPKxgBzOeIOZxMVatPFWbUg036B8pPiYXhheCKOGX1kPXCarD0bUg5m34oyU",
+	"aU39R0WoNIk8FWYItWGu8BFpPmDST/NhiR8gvSngT0AW/v7s1yd7f3t7fp7+5dHfz8/TX9VqGacBL/JE",
+	"mAfYkDgTzNbFOwnChAARp5qWGlC/oY4DX2eUG5nnBVXsq78OzrKPQx3bxu73t7aTD2GyfUyfT7MDA2bn",
+	"XLfFWe3oydsPQtRQKKtHz7yGgKFev8IV2J6wdEZ8nhAw/URbEEz5mot8j63WegN1Z+RIOzKqiF6vDrRm",
+	"pospUQLt6GyEYGMFShSTvOT7zLMYzpd1z7GCr7PjlwaTnINK5MrG3jpCw7nxKuHhPGndLbuUmTmaCvYM",
+	"WlnkbYweo+7NjS8tUPpQBGsaQibMIzuBRIeII/64uDalp6GYE3PGhVzQnP8eJINA7OAoUbO6yDFP0meW",
+	"J6kNy7xo7jYyHbUNEvdK7qpd9VNuq3lvjstdExhkddDWwejb/KdN7dO25eg+eVM2IuiLLEWW+mByzm6M",
+	"5pFbwrILjGkbWc3eKoajsEH7S44z6Mw5mKyYxkDMNPRD8RV9Pd/JjBzgzABblXdqvdjghQsHzHRY8byw",
+	"sfJjakwIPr5xLE3I0USIQci0bXc6S37PqHN98aFkEBCJxvJnnng2K3pDW4jjKdJOqWXgJ+b1Jkk5jitd",
+	"8CuGIYXVNEg67YIEx0wagW8LAtFZxwkbA7c+ZNluS9ar4TTTec4qlTHokdvBHpAavrMqk0eIXtkoQFO3",
+	"POB1WJ4KqRisDcwpArgkS8pzF4pVFmZso0gq5hTojDRRf11KGjuUeQ9aoJWbg8b91opiSbOM5fFE59tw",
+	"iIOS+QwjDxHv6Bjn6IgAPiKtcs2t30WMrwSd5zLkk+uBi26YMtV4WZUiLdym9Trb1F9HZaZpnH1kafCu",
+	"3hBNLxlZS5aYjUsYES4urB2hDBxdoTVxqfnK0e9d7nsk/g1ZKnztvkQOvRFuFdzlqvesmevwuZ3aBvX5",
+	"RPrsnlzgXdmGmbZKaAtPPWmEBCrBIxgn0GndPr5WPovXikWb7XK6NpvfbkbXRv/ukA84AK6qS1TQ5hLi",
+	"j0Ngzh8hcVF9KtDD5lyO8tTFrl8yvbQ00J3BJVXkgrG85AkjF9n0vsh7v0rOr3OrHQr0kINUbe1b3dRx",
+	"9Qzat+OBE9BN9/5AD41B7nbfJi/yGz8s9qVr8e0mPl4YPd3WHaBaDHqdhkuKaOimW27BDp5YMVbebdAs",
+	"imt94o6gWpuc46R9h+9YwBGMvKVkw7YcRRqfgUgjvJb7Md1Uw40OKuIZa9R9oFzwJXMUY7FgVEv0m+MX",
+	"L/dAj8RScvzj4en/evK46zXcki+36tQ5wHbbOxGBPdVJX1qCMyC6nakJAGVt5PWZ8SojD50laEfYilu9",
+	"k51iyHnOXfMsC69prryv3ZLl9sHtySRXMSai5R43+zkM2VrsHFsqbkfrB5HeksnbiWWIeqz247LVvvVl",
+	"H+n0J607iJrl707zO7xF273fuvf4tHy7tu2urdLFRi3FtVWrGxIMp96maaqlegqQNYiS3LSTKA0Jtn5V",
+	"g2VHRXq4V/A9dwvFt/3NyU9ud94clafQGpErjFywlu4W++8TYlAEBag8v4R3NI7n7s4OH5ddxQVtUoMa",
+	"vMoBWmEwCCWchUwPWphqJWoEd3x1WhWkAbHDLqiBXe8FR3IvnjPlECqGGdSopuU0w2OO6RYN6adu6qZ/",
+	"MucZWric/XQaP/g4mUu26ZzEj2yz1eBGaNozdv2wt0ClOcVBGz+cJAygDC75Tb5Ase8umx6syyCVkFy3",
+	"gryse+CqtkM/6Jn4nhsS+egBjoUCRE6YcOuVg3njHF3pXTh56JjapVDavOCerYXUA4I7dgDITza684b7",
+	"jWzzFT65AnmhtSRnUALkUSQQ/MAHbUDvqAgxj0f0qj9SIfGtkB4WMIaWfLEAfk0v7eBosIXvFeCNIPoa",
+	"m/P3KERmHOQrprtn5CEYU4ELhfmgHgUj2FJr68HKGDVxTm/X519aRs7spPVmbS7KJkRvuIJwsCjBGybn",
+	"O2FzJlmOcbvHh9+tPvyGJtOuPbPqmYoMHDszau8m2ZWMqtiT5wCTZE7JihqXEFbO024/nLKqngr78iaT",
+	"eOgC0z9nAn+ICaon0+oXmye9UvDGB0mofmlUdDGNa1/CPptRtFo+11ocHr9pBLc8PH5TD4d5ePzmlbnA",
+	"ykovIVpooy1+rjfHr7UejNdBo735WG9tvtXaBuF3qs77QUHD5z8oqwcDDYpiEKkW1+dXLW2faQvIGjU6",
+	"+m8DpOUmwtwykdAFtUgC9c8+iHgZlDFPmTxxysiwQW20QwjSqRvuYfZ70zHMN4i6hHkkbXm6dpXRrIbi",
+	"LaHwu4PIT8KAiz/TjFe/HOVX9tuRjXtwRtWlHzj8eMzkiuYQCy042GA4LeTmAMJF8ouMVT4f5bRaYK+w",
+	"tKwSUg9XesoSyXRY4mofF2p5whLGcW3g+uaWBT/KFeFPEw87jHvwkqtVGGf9BB0NSnIXfj3FjPe1r375",
+	"lQ6sxXn9+7dmsOdcrSkY6NRK7U6wzO1lo2nYr48ntMmTQ0MJdYAFYWFtN8qCxn6URcdUKpZGPppI+3VK",
+	"bsrM/6Ifa3hbybB+bB1x2srD5UaKfdcYAuGEKS1kS+xwnNQgzuwUq3qhS5dTV8CqvkYbIyRjU2LpXXib",
+	"egpny/rD+ffJkKuMo+cNSibGDuDXP7UseusDIQj+Hnkn7FmTs8QGCVJTospwDj7Ksn05bNbwvqvEgMfY",
+	"j+u1jaHZjRSdIuGWRm4BPYSys+vujCc9NHaLnuvJPdoi8vfEJGuJ399JP1p6bG/R0WtA0IZ2WzaJ97vV",
+	"RHvmWCOrAzqstoj3aonPgN6wZrwXd6cM6MZWLfuJXNIt3TRrxntp3uoDOmw0KvvuuuFbnW5bm4T9RjmA",
+	"1i5jtcPeKhdpN95FKzf76l1lpVogC3ABE1+BP16YFsJEKsrZFn7Ljc4HBThsISbDWncTzl36qJPIvj7a",
+	"UX2blq043ddJJ3r0N+7F/f4uunC9r3UHudmm6XYg66Tk2zRuuVi27uJGk4hfHcMwv4ej+fC2yhz2pIwB",
+	"hq3FSMYV1QxjrkDUd2/WMH64YSYwpvpo9vLnNXsJ3l7RN5efhfdAwMiN8H5tyjBraiXXuF87seU4Pdoa",
+	"P250ze9ZcizFRWTF8BkcjMKw4RcbIos8x4g/BhmMUprnzsHFup9qynMm1Yy8eA+xOFE9b2XTj+2pwJwe",
+	"UUiZXlv3AIY0/0Nf90WxahzjXmcWP8dIHpLKTthqRAuzbqKDKfB8Rl7SjTlKwvr68Hk9VQbY23qNlu9v",
+	"0L4BFGK79h3PnKivDUpQiDYvcx5LL5x0tQePe6LZe00evjn7bu9r0GWh/32pziwHMYt2w8QsVkw954Df",
+	"b4gQxBP48KFl+S8DMlGdvyklPklQPMJKfNVmBQ8UBlOZBh5yVssHG+kyBObFikmekKPnM/Ic4xWB1cb5",
+	"RAqhzyeztvDh5uOeuuTrPWfstQeEm0mMgWlIoEhZ5wzXTFq9AzF1Z+T/igJuBpwzOreshGRkTlc841QS",
+	"kWiaOSuZjFEDYfI7k8IlHXr81V//CrtM0YAv4SvbQBS6pc1fnz5+ZK4mXfB0XzG9MP9onlxuyAUeTkZ8",
+	"dvUZOZpDSEIPWHDyqy8G6JtZpyJpAFczvVk88JRishNakCXvTvdz8mzypowpMmyb2xDb819hkvXEy4Nt",
+	"LsEgGPiwcBiVrgPxcvj5xPdd+eyekm/tDLcLYhXSql4+NjzYfZUPLiC5KDumYID172aoJ096WoI+Adsc",
+	"ISA2zF1okMDCrF+jn9Jn5qcEGLGdbxI2uV1/JOjzu+78fM06QQCa0K0ycNH2LpJl3jtkHDLrQa4hQA2G",
+	"qcHl20x+ZEmvWOC3DIiqIBoNV+Tg1fMwqG8z9SEwR7kgbD43WOsuG65b0jXBum5mAL2ia7O4f8P8pzDf",
+	"D2RNuVRhMCW7OirdesNwzCGeTiPltUDasSphZO1YeRBaJ1accqWl6C7t6P2SyZxlsZJkXbwUabzsggv1",
+	"M7hbt5fCkLCzkb59rPXZefH48RfJJdvAHwOcwMLdbz0ZcVGDL6qKGuDz/YkayuEGiRqg+ihq+NOKGvpF",
+	"fg0H8Avnj97kc6EIqGc1UG8ZtPB+Uui3ryqqEZ9bDU9s/DI6I9aqR3mFJQ+MTGsFDcdMJizXrbngbTWy",
+	"9vXcy3aHweZF1rewsuZNFqfZap1RzTodkULh0lm1gfM+4MqiEVfEORaAA42I4o/mK5a+LnTfIqEedHST",
+	"Ne4cwHj4KO3Z0JswntrDGEOtqY8hHGCCx/UAcIPIQlOZ8KegC+WyooTho+D0LgjQt4f9VP3O4d1Ngm8R",
+	"0hXcMhB3QU8hxOcNAd4H6LjS6/6hXZ1H/NYz1V+1BrsNgW3fX849zHpiGqxmBpUVc/mcovC9vd3tGFoL",
+	"6+W65QaXUNh+s6va3fvfZBz/fs+T5YLu/iTVtO73D107gSh4pasiqWaLSDAK2wdRtoY3ASwtICGX3bd3",
+	"fvtUr5wb3zf1lQ/YxqgTdbPOdv7TDQ6iplRCB+Rv+3gSy7CV6ayRrJh+kV1sSe4fX3M8PIEvaglJAEvp",
+	"DUMwr4vbep/tVQFdibXDUlOfVCqD99+uEfcsErWcU1taZgxoSf1RE8PdmYy19AJonI0WgWitll9v69no",
+	"PBQ7n4bBKbyh9pQwsxwOwZ75PIxyZ2ugTDUXGrXFeM1CvPmcLljFhZPnhJogPy363e3iBPgdv3km6bSR",
+	"aKh/533t8sQMOm5VgrdlYILvuQ24dyzFFU+ZT9BS0xlz4+7YFuTD2syhw/H3XJcZPE01gh6x22Q8cXlO",
+	"rAXCnC/cqSyt66IMn/TF/bdW2ZWXCEb7RPJ4wq54V6ATLDWTLhQrRYWd861tVTD5xqjTttwt00k+iJW2",
+	"YFzbbe6fjdXl2p1vwZ0fioujXEthTrQZOH4RtVQsE8hAHg0elpPCGIwQbGlylJOHx69Pz8h+mD16/98o",
+	"fP2Npx/2oZNHM/JGWc/K18Yh/WmI11ZWe4TGM/gDXZvgEviWKp4Q0wrKTYwKA/Qm4rY7pVTXUOe9Flwv",
+	"i4soz1VIK9+xiZ8mThxM13yG7WaJWE1iCUIDIF1QBcE1qir8eF+wZmxrfk7JRaFdnk5UVfDfWRrUIi9y",
+	"zeRacsWsiLwfi3SbbeT3Bq/WYocgsIbAlEfFWTXYLChOhQVaNeObTx6ui4uMJ9jk0ZT8cHZ2vG/+cwrl",
+	"UyIkOT39AX6Y9eQCyG64CAO/Q5eHXKml/fvthzpiBBV7KPcPZc0PYZ89zU59xU7fqAA8plL1AVLDyIHm",
+	"E8F+GR79e9MwxNsIUobTMIdJC5JkIkfq2I86putpOwL9wLJV4ME63B4jaOSIg0mJ0m9uUbar5SVTkaRk",
+	"fBWVs5+ElyXQ5SWV2vKgXJEly1ah9Vz0RoJNWdM2K03Lz/taZT6esl+SsnUmNiuW1xKirjZ7dL3eK4eI",
+	"jI9K7u0yGR9WWALsITax4ARTecG1pJJnG5Jj2GLv6qYqsw7AHXIAk3zB8/dwmS4mzyZPZk+fYLAHMOue",
+	"gHkRpBB2U14KpRUgkPlr8syNYEmvuQ2wGFmXyb79iNKAyTEExjCmNW+RFzGLOhRFrifPvqjEITILnDz7",
+	"+rEH7mFWKM3k0XH8lYfwMtZBHSpWB1RTq4w2ahN4BftNoB8bez6jkGcJlhbmhwXW2rCzRMiUSXLB5kJi",
+	"3JA9y0SkdsTKVvxq57pnNfhmSzd0ZY6yLRBXTEqeMjXbrLLJ24Dd7k8/E9IH3PJorMwmsRDi8iBp0ona",
+	"mY1wuIdlcgBMMlNmCIjkubpghL1nSWHD9A96SJi5dT4mNF8xUehPMAkXeaAeVHNwPVg9qObgMij3YPng",
+	"5nm4PsRyMw5z1yqx46TI3fGtfoyEI7/6mcqbmOK8yK+4FDm8Z6+o5IYSmWBUe3BO0CRnSnj+TxQ023Ms",
+	"i9zAOBqxXRZ5t814FUPD3NE035SW5Jb5VprmKZUpUUuWZURtck3fG+ThyuVud6R6ZT3G3EiKrPkapOML",
+	"ppdMTg1GoW34hlwzWU6CFHnKJKGGdV2SvQSNqt/HlXPXQl4+5y3GrqYQKJ1Pl4nLhSRrmIPS2u0HNuwD",
+	"XmVFXGZcPbbPtsE138xYbr5e93IelTYv3q8lU2h10zuvoHIzWk1OmC8OiBsz+Ec1ciiyYGbryswnUZpn",
+	"s3CyNLprsSU3zpNoMUn38XsemnBSub3dqAZzfJaZOIheYGCWoKjmar4pv/qpD7c9qhghRwhyu+CCWpNc",
+	"L8FA5wMiZIiWHtQg6ErQzfOGYI5lep0aqEZxpPJO2eLtVeXizCTNSwrTnxtKEJHC0VkiI3cXZiEkzpVC",
+	"CqHJ4UEUfwYm5LThxVDfH5nXoEScxmAd37Y/Q7aVpCX/5eklXxPJVkIzK98iV0GDeEh5nalBwDj76RRD",
+	"IjoHjkFTN71fss3w3i/ZZnjnRrrSZoHisqDeGPpbpEHtGqufMwhOQLfg07zoB0o+c5zJMNmnoQrHUTJi",
+	"vjppJ4qRHyBPb0P+mbHKsPbOBcnnpLfWzDAVxQxelvzdteRas/zGklPZlJw6wSdVNjphnpAOmaoq5ual",
+	"FFm89O5UIDIwpDIRK6YInWubyKEUch2hwArZGEb+VTBIki3pimkmFVFFsiRUPSPnk31DEfe12HfGm3+H",
+	"2t9A7fNJHG1apbN+++5fIOswso2uf8/0Vv6M6BFlkff7F2c+bDg5yDdO2ZOI1Aq1nz5+bPb6i7/9rceJ",
+	"EV/Q9Tn8IDBBmA0sBlayoagyEwnNTNOWm6D1xHjUtJOvujDtR3d4at/hjQ6F1A2BCWSkzRUROTCzIFVU",
+	"S4y2Uw1AbJ9kk2dfffnlF1/2JewGriOWNxi+N9Z1tvQXThhNlStQmLkUYfic0npdsTIwHywGqYFivxCj",
+	"cEY/YCfxAjV52+BEDIjbkHVHETDgqjvIVQkwrHzOdLI0nH6FGEdwdEd57S1IXmEvhu9BKHv9AZp2CV8B",
+	"Pk7kSrNs1iLF4ykA5rSFGpsekFLjI0rk2Qbg65qahyMcfyfNLJcPihipyAqi8JqrwdF0fDqChAG4PjtP",
+	"91K72DjSiPeHMoF9zUg4E6bsCxSi0S5Zti79a8oVuWNjoOwR5cYiZwxe1xQfN/0Bd5MFm4T2UBe8UM3h",
+	"pomOSm/XNLmkC9a/om2EZLC8l6LI9c8iK1asvrzq7LEOXgrlxFemOYO8maU0Ka5F81DpDAljKuFQZVC4",
+	"FYpUu1tiI1hOC1RcR62wOC6ySjZrp5s7mr8S+hitJBoaudfW27V6BT0I2zyYkV+WLCcKncseHGTXdKMe",
+	"oDcwwpGbGwZsgTDbOch8qq1emZJKI3hT0kwymm4Iew9i4frl5OgPjmmiT1UXA70OJEwGPr4f86PWl/lk",
+	"+3MgjWNWROdmt+bDbWHNwHMxnTTbNlD/eSWCr2WAxZzQ3JyEPZCzcprr5mFunoJ1Bcd6FxWgJKzIUpAe",
+	"4tI/MbSkkWzBlZYbS2JXGNuBBlEgyoa5wMxq1tzRkADXGTBImTC3gyLWHETIlWrSuarydgAP7tYb3bk8",
+	"4/lO9BkaxuI5O2e5kPbaJ9dgcVIYxte7gfeoNnBCA8k2VB7ymO1fp9cdob99k3wMFqA5Z+G67OxuH0et",
+	"gIvF6rtfE9/m+FFDECalkC/bAqCb0aEGseFFXTRxJ9Y2ZtKFjD+6heQLntPMpyEYFOtJMi03h+7GrUWK",
+	"qbg5ITnUVF2WORZNa14RWA5yOKpAoT7zvt1tDR13/xvdmMpd7PnaDfJH2X2TY9FuvNMbo3nzispLlHSv",
+	"S8BY0/4bokgw0SH48o9rPcBwLVZrgNXaP345C98i8D75xy8/nsZSL6U8fn+/eL9GvZ+rQpKM8pVT8lsB",
+	"4T9+OYtFlSkG2MBtFy4KcqnLjmlihXCSN5gjdhZF439eX6o3be9eA2Ty8B+nr1+RX9gF+ZFtyCnTj0pR",
+	"Abw/QwGBNQ67ZBu49uyuwaQhHxn1xiYtINreCvCf17o/7LRGJHerjaHwj1+r7hdarUKQeIKSH4sLJnOm",
+	"mdp/vWb56ZLPtb9u+8QmdM1bt4Bb6heMAJaJRl4b9bfkap3RTdwf7Idatg+sS7wSAKhfO48wLe17gudb",
+	"zDrpF58nmCvy49eqBAVXxHYS1+kIuaA5/x0gdaAMyqwG0FeD8q/jLfHFA4P3X0y1nF8hLBy6XX6t4q5E",
+	"FzR5peLdn3x7cFizHyuDVMVPgxQZ2279J9UWto82WZR7VjuBlBbEDL5GAYQ1nzJd4rxR4Z9DuHf+u3Wt",
+	"sWUgmkIRKdgt7EmWMapYYCMF7SUL+7WhXjxUykDsOKCNCDaHtFOJzvZouuL5Hkb68K3gJxuQY6qCA1N3",
+	"5FrxrbEBUYrhjyRaPXe/Fm6LU59OFIw21IGgnCXBhp9oCLsi1ztq+CqJqxEGgRbPithaLUP796wE67am",
+	"pb54QFefbli6yMMytIctt7bXJcu2Lg9A7FiC41o8dk/5Mk+50jxPtM1cO7UEitFkSbhBGg7mtCsKYTip",
+	"IueTS7b5Bjix88nsPK8aabLS+Oyb0lIT+OgFF/k3hdpjVOm9Jwa8nMlvLmhyyTAa53CuseqSF1tdNSIW",
+	"BiiCb6jLFUbP5aPPOWWzQjWYZKrIoACiI8FgaMMKv0vbJ7RFhGBcM/Jitdab/bzIstroNiIYyYVe2rQh",
+	"kQhcQa99l9zLen1DFsqZ3koUr0u2qcbwikaSaqKcC+gTNSY2JQG36FwerYHVJtdLpnlSbkdpzBSaFBrM",
+	"xe0w1o2iUN5zEKahZuTAdwGiRtMB6phsLN1/l06UU+Im9iEeyZXnRYRm2ei0imkXmdZQJfhNScZX3EvI",
+	"ywgqgN7eoAItVHmeYn7KasZoJkHSAYFGAUL0ivLMcIth3kTIQkf/VTCLmxuv69ICnzpemury7ltBaRBo",
+	"iqLTI0uRRwWyoIV9ZtsQdLkJV2vPip9JCe5DBJOLXJwr0Ghr7MtMy8aqWgtMSORAZldaNWwx63aWa0Ii",
+	"CPSS5oSSObt29r24p2uqFEsRJG7Hna84agMdtJFtw1c0rNNtbS0FJU+R680cpCovzjmXSruQ0WxKijxj",
+	"SpGNKHA+0gblxyGs/RLkhM2rkpYWS5kV5TnPF0earVpEI/VARxfKbGyuLXLZeQLg8aanEj1e8fi4NJ9u",
+	"o91S4B3tWzpkcdL51BI0IS1UPWUDJVEdz/063KQUKXLI7Q54ioA03TigZ2yuSZHD4clTH/LZGiYrJrnh",
+	"ta0XRzjRIBYKeWgv+QuW0EIxwrWzXUiWRQ4GvKIsBRDY/K4ZVbbSo3I9klnQIQbW14QL4eomK3HR4ESW",
+	"wguR5uTqyezJlyQVMG/FdDAGYjnPNcvNNhbKs0pNvDEr+wtTmq9Al/4XqKb479ZdOhFZhjKEGcHUxsqx",
+	"gWZcyYBStvWNKnWgBtIbflsV1JBgUI07o3adNR8MUePDMx/30uRZDqinC4IJ7iaqLcwWmv+2ZbT1xsGl",
+	"uwsQELhla6m/jgx380po+PeFUY5CtifB1Cuh4Xf0mVz6OkXWVXW80QIH3kayVuMXDQiDRb9tgl11MYkw",
+	"fGDVPTzeYn1zP4DZ0hE2fdLk7DBhZM0Prk/PtsJq/WKN0KrQNup/MYe9v405gwzJGhOuBNxABttDGAlW",
+	"Sq6gJr7RmmK0iJ7bKqIbeu4b2zi02zagwLUi2I7IW5qVSsm3t/qtSjob6+3KO2edoVtW1uZbPgXxaUuj",
+	"qFB/OpHz5H9/9dXT1q3H4mbLZi4ovV0WqPaOuxu2Lb6vXXT9H9pRoBuhm3VCCXJu5fbDhcaYKB1v1Vbx",
+	"se20Urkivo8nWLA6jc4+sZIRJLR3gXKxId20CT6mE2NlzV7n2cbLgv6AMu765vWJuXmdWnTGvokQmA4d",
+	"UgBcrGLZ+zlnkjwsnKy2VmZF3jxHUtSSR/4PL54Xps7TtmBfNxapq0Ssu3yGLdyxGj4o0dB4K+0g7EDf",
+	"mYZK/We5UEzyfC76unP1hvVojtOh0U1WjokRs7M5k5Klv7laZitqWmCjTwxD0riqVtvJc/8VJuReayDI",
+	"9F7Uc+xCsQUqGKy+4NfzyBzOJ2+hxHD1mfuhiovzydtHN+Au6zqFOkUONrK6DwGFrVHKmykkXh89P+y5",
+	"hGo1alfQ0fPDwRdQzyVhurrxFRF08qlfEBXQ9l4PXaTd9IQVQP9uEd8HpUkSw6mq2UKIBYZa+FRJOU+T",
+	"j0fIDZRvSMbviVAa2wq8DP7gBNJi9Z1RvzJEYJPu+TLC6xJ4mmVkzSSIb9O4FB6FilaYqKAFjqtgT2xd",
+	"NPKMsOp5LjT1ofN2VFKUlUEKdbHxwmSexOMXwHy4yM/4iilNVy0qXogxYfrClmBuhktJK8KtlGq2ZyrH",
+	"43xnbJexrAQRmm8z3oLlQVqrugAHxcOJF89WEldQbyZNyl6cVDFlymCvjd5JjsW6yAwkPLxBpTwjJ4ym",
+	"e0a5MjDkfHZTHdVL1FBhMRpYoS4IZWVL6oONOVWIPUuoJkmoZgvDnTDyEMgafEWx4SOv05js7H6J9eMX",
+	"zXU0LeJBmDiEaqO+VnhXuu9TwnOjd+V5uo9UyqpkW/QIFU1IZMDc6Y0sEGFY/zZSgXLmgSoNr66wP+uQ",
+	"0LrOD60U6aTdq+CgbqwRRk6sSYPHRC23l6hlGE77vUk7t70icMacLe4+b2JEwg0/EsGEKj9kGFHj1mE9",
+	"SDhTffK/VCSXTLYxQc+hFIZuiuEML7ZdQvawu45lbs0GxpftGEK7xBhL+DrhQzw2bs8GSyR8cByD0JeH",
+	"LEWWNlxp0VEkwjnYVv1z9v0H2Tmc+5Fj/c4nq42Qi30ceu+iyNOMnU/iz4MemzD14OPbhGV0w6RqYzR0",
+	"ZqwIDRzODVs5E2uWB5mEQVEwg2rnE1KyaI8cRLF3M1f2Xkuj6YtYXdMscxWpZK4m29Ia/CbGbRjvqbRv",
+	"c4hgq+G8uiJV2Ch8rRsdBtvhTIUI5pHOxNCQXHvPW5zptIyHp0WlwQMFvsptEI1OfDg4O9z4ADXoAte0",
+	"YErXz8+MnNEFji2ZEtkV8lLUVcfAV8wAnecLtGZxIbexkSliKaELynOsru2gJhmqunG0EKSPzYghS6H8",
+	"dR/6R25pSKgefBqGhJX4IZ5Ohpu/i2WhJestd9qO4RXMjpUun54qN5w1a7QfIgG89Kmbnbe0eXk0vKQP",
+	"oHKZ7zgk/yZci2mE7DOR7uViQvlm2aOpLf5Fcs3COniioRKg+bpQy0fhfWxn4htHb+ZbCFglSqapU01i",
+	"q32YTtzSWyRoJYexgWOTQ+LL7/77+SsIX3x0bGIkSKaQ2BGHkGQtpHaX6b8KuplxMfU9zSRLl1TDt9XG",
+	"f03E6tmXjx8/npInf3s6e/LV17Mnsyf2y6/Pnj15C3/H7+AwlkklkHVj/yG0BNSG/bPhYIAciAoyDI9f",
+	"cufRu24e9EMkfKBrfXB4DVP62jRsUhSLNB0hK7xzT4+YPVatJmt3VVABM+p9+8X6CXqPGLtLKbLjjOas",
+	"HQAevLYVUGApMrI27T4l/6mIQ9mN9Ad3pBpeS2FOCRhjf8czHRv/aB6yenAJ2WbKhZ3hytq3OdEgWNYC",
+	"T4W2gDUb99KRw1mrgoiIPLhkmwdESPLA2+0/AH4TRjUVjQEd965pYJnsp+NmQ62DAHko2YLKFAxfnYna",
+	"Iz9HZ2ZqAz3g3ihLC/fM9A1vpYFnBAhfMK2ZdBEoad4S1+129SlrliuDR61Klc/WWezTU+x3aVqiF1eg",
+	"WGnKRXZNUj0KJT9C9ujtU2GFmx9NiNWZd7oPneKuVvUa1WzpYen9JU1vjDrIljdsNaZQ/9OmUG8ckk6U",
+	"bjL0oeq6idH9fCXxfCXwk2ppPEcwDKyMw4q9RxVVjGF/YcvI0XOvoqtNcIAC69iYsZ8g/pgx/HnplH5s",
+	"GYncLNIyQiG7QtN0glk/0E1UMiM/M/NmLb4F8XCmBwTsKI5RmOSD58U9E+JThSIzTZqCd5ad1KyBfGLd",
+	"lVmsTji6EsiXZc5fx5IRy95W6EiQYR5rRRd47EMOxIBUBiRAu3TTr8t94bdKEZF3ainLmu1UONKrV1As",
+	"mD6fmD/MRYF/oSkC/o00C/+GhN/4J1oP4N9/sSIssNHwIzzaVoKsWmLVhT535bStBBhnAHkPVXM2rpl6",
+	"NCQym53ANARpDKnKXY3fwx7q3oGx3GnMGESBxDT3MqjX3m3YWTlEYK80+JoN0LPXriiYWQwm/13QNGP6",
+	"Y6WzemFzmWzRxEjDt6kf8aDZovUPjGZ6ifGrb5yna2Db52zN8pTlCd9uTBPhGqXbW2Sg6Yws2zd4d9xD",
+	"k84mgnLeyCMtU1S+QQ7rfsOldUwk/u7fLVA9iEaMpZhjI7dLSB2MGocm6g3jOtGTMqUuLVWMoBSNh8Zt",
+	"YwyabZ0DqDPzeiW0NU+iuY0BC5ewqe+EP+KKyUBPWeZ+UzLZ53nK3s/+qYbxW6GMOrpuX+q4AocjtWjR",
+	"tZyEUyfrHy4xr2cnnE4aMbOnk6ZMHb+1IdRJqLcMNrGW3VBIH0E/DDY9yiw+I5lFiSrOeVD5bNsD28Uz",
+	"OPc8EFtyg4d4HWe0quVVcYcv4+z+pB2yNuggLiw4vaOo488q6ig3+bhQyxMbvqOVTzGQ4Lo9FR7XZEnV",
+	"smozSWCTMHGmTzVjTAjiare7YYViy2zhglps+RZcB2vyXI9ZiFEZ2Yw4poraXzKaqv0V5TkKCeZqX9OF",
+	"2r96Mnu8NX8079m5uIiqWl4JUVk1OKzyCj2O5R2O1d4kxrIYHfk+gqoi4R1WHL7iTT3Gw/n1JgUMZ9hX",
+	"uTLJnn3yt1brTkGNkCHiOUp5zEbRC1FoKwCCehDLpLp99ePqUqw2Rz0spASCqalu4RsHXRMdCVZraB3M",
+	"Jg4ovA4OMib1SYHZh+vPpGAFTSZ+WVPKl8VufdT0HSc7RZsPyXNb4vlsvkJOPzS1vGKSLhgplBXTiQsb",
+	"U8pGaYaBjTiOfAf7+aw7B2x/dteuzK7n5+l/tSVznU7WHbLGMwx6bcsN1HBFQO205IsFkyoKSXSvMf1D",
+	"bjSuN/38RbDfp7YRGp/XEMf3GGxTZR1Vo4le5KoM1jRhsqUNnHGXyS9U5vhYOpQcYmWZZB/5XAx+T7XM",
+	"pey4tUowYmsdnEqw6B+jvNqJZ78Md2Ji2whl4qxwCss+OD4KF31YpsQ65QszTacMmE5e5FJk2Yrluvz2",
+	"HOSgk+nku4wx92b0Vppu7NNNbi6BM7ZaZ1Szkocx+m8nbJlMJ2hFdKqFZJXxDtZG3U1d4o7pxJgI2H++",
+	"5XmK0DxFz7gD9If1k/u2yC7LbKQxXqAm5bLam9b78fD4TSuVXBexQDjTyXOuLlt9K7i6jLfCIEGtIYda",
+	"Qwg1r9Ewts/g27RlNX13Zde8erxMWiDx4W2VUlQiFTU3MM4pnTaynNlu0EWwXcVB3U0VCx3lXG+hEpGm",
+	"1oy8djEY8euaSeKIGzyb8AbY4olWvzIjLzVlhFAmgFmumbyiWccNd8H0NWO5Wz+Bpkzdy6Xlc5F3pCFv",
+	"2+ppuBWRFXfdCECCWomjKa0KqCouPWYrXYxGdFWw2X5K6ajAlJ1alO9d1KrdssHD+CD/RIRZJWJtK84K",
+	"Wt62QKvs+tDGk+wQAkBw0t7UJVhNYSSztEic5zRXpHK6EANmUV9plCn8QFVEaG++lh5aEK3TVL5PoUIE",
+	"au1paHoBBrUU+EEUuWZye4B1yRECUE4rW1iZXh92OIHnPYktcWBDQLe+E4Guj4LLP6/gstxmY//ffYWb",
+	"GjZsNoDckiY3k/KWDmw6rHylcvR4TlK52ZNFDg5WEYmLZFS3RTUte0bpoTNXD0JsbI3iZmU5Sw9hRTF8",
+	"R4uYLWeEjVLwgTLEEB9MEBtfKEZWNKcLFxUZo08EkU3KbtBQ644Whidiy4UFOurbnlFd2mUxoZxouRdD",
+	"ELocqSteBp3PMUvUxYZQcGjJWWrxe2jkCAMvU1IKATvyyw+Nl2C7MC8Hckg1zQREUMaA2VgXPC6YyTyc",
+	"lpmGw14SbFcaV9kP+2br4t7qWwZhaHBjnVSkJkjX1k/2oXrkCQgGO+8UyqZyc1LkUY8YcADahkJRCXqX",
+	"dWFQwBxDM7DULsq5kxRPyUWhwb0ag0K3+AoBnW/HD1W5kyOMCZIFNfNfoQXG38cOfJTqWruNbUvmosj9",
+	"5MEMwyzReB+uXcaACn3GbjlEvDHrc90YLg6tN/IEkbBGycUcmT4w0UOYY18uNWsOr/fvsLgUXk1JIIua",
+	"klBONSVtgimY93PrCU/BTxxcvsyuwgidU7RY3z5Je76g5+CsNYa6EHqJI3l67l2gWki5mJemLqFzu70B",
+	"trOljBvfnLmdhuvWHKgwzsCG+BAW/kgZ3y1a1kDAlA2sH5j1s8Ylxt7eSHRyYVivIKzBjLygyRInUutK",
+	"L8MOALkDAUCQRMXG6C/nZHtQhJLLQmmxctbXG7rCKAfTyNH2IQQ853hBFcNdAqNXBriPjA3PlWY0vXFU",
+	"gVhEATQ+J1T5Q0U67ghN5YLpE3bF4ybGZ0F0LWlrRba5KzXg0Ds7qk+oBAyoTbbDaDvyAO++LnbQ5oXt",
+	"b6jPo7u9nzr0edOJU2sddtgBBG9xZwxgVeVmHi3ptVzH33cEc/OdB7HaIn0PCMG2tg+GbTg/PEZ4Hl1Z",
+	"L/PZPMCV049Exl6JjgbWr8cpRttAH8lUJIW5X8j/PXj50xQFFeyiWCxAt3jKciufhYwZqrRKMJ0mkoEb",
+	"L81U3RKpcqshfQlvNjW1RF9dstRKsYPMPzDzNgoHa5yRM1nkCYTP43PIYO4yfnz11x/5t/2c3EDlsT/z",
+	"lUgJc6uD6s+L0MnWhMFNoEuzlkrQmlA85Aa11/eWykG3kFJ9Vv1+6HoNFv+RrIwrg0flXzm7fh0P/WeG",
+	"zdk1BpohD7lPCH+RoXuuSSdmfjjv+IhjNLviolAdA7gqNxjFvhu/g2PVLrHCY2epEpP+vVnebuW16amx",
+	"gyTMbuIDRFp5Lf4zc17u7re2KtOJEx/P0FA5rofue6xWJIXVtUZPW1v+heZ92FJzQK7nk+8OiWlrbrQ8",
+	"pTIFh/He7MsY4zKIPYGeLRWn+ObNumvKYZcBIwbxos27268stvjtvL213bKWTMagDm9uisgYCjsToNTo",
+	"lG+jWgaugYjHC0lzrWqeXyGvCxauUAvfqRgH92JDAi28mpZMfbYxhTS3USX0poxmQSGur9W72kkBi2T4",
+	"U0MjR33Z56YvExnb3lG9S9dVImX8ZNhCh/eNQ2I2p3pKHMobygOhEA2220AZ+PbUkif2dJRB8ZTPpuVz",
+	"czoqP6L5Z4jmFvHuCttbfBWqFWrOCmXhvfkq1MYcJqUv24wqvz+vyq92RrYLvVhrXZPjA3F3PAzoUi6w",
+	"7hQimi6FlaaK3Fr9WhLePBbZlpmZ3e3g0M2OW78qUPFmpbRwbxCuS82I4pp9kwupl0YvQg58tyz1PYIg",
+	"1N5qGAy35mMPK/Tu9DWOD1t493Z/Iv4/UIJC/rDFvISrS8Fq4RuXUIqMtYZRDsWTJ7WdCmAW7VgVgEAt",
+	"opr6xV1OusLT7kKJTouWcA91aaRdeTDVPuy3Pe9+ALCDMOI9uv4TIREQhLZAYReFol2XQUvj3e5+Ixrb",
+	"A+gTmnjdX5YxaZp8D/Opt7HzK+cLL4eSxcL27t1d4dcuNsDDNV4f4bvOzHMyncDYQyU3DfiaG8Z2FC+0",
+	"3Q9Wq1a26Ba0nyJj7VxBnB24Vz5gq2M33vx/6pvfeQRtSfFMsz4RRgOl5VD5PtwWZoQy2XIpc28JBmMO",
+	"sC8ye6WECTwf5rXHaDGe1GVZdbLDNa5XTF6onqBLJYUXVSGlmgYp1HcJyGP5CReTp7lC98SuBOi5QZap",
+	"ct/c0tuwaUcGMsI5hmFvKgykjFJLGXcXOLOIVGGcgI8TJf6a70UeuLNF9tBebtDXVuQz7j5QBzDMvgWo",
+	"mSg0GhYg+xv3pvPAW4prsGqBup7PhZjj2FefO+q3BstObX6ntqVVKzX9X5SWVLPFZrjzS63HDmBYr6TY",
+	"7VoWO7dCu2iyxq92h4FoRGyIUBOFOheTaEsUvdnvnJcHGgU2tqkHP2Kb+wH2Rxawrm+LdMH6J1GvDxw6",
+	"BPU5W0qmTE6UAeGhnONfPHQKzvbU7Wz0sLl9R0t1wRNmlalmiRYprVlCdWdCLrGKCjEdAKog7jchjSpV",
+	"uQMT09jY2+i8EmiCm4jXkwvmgfr4uWBMQrSWNwnbePpqVxxPV9KWjQQ62DkZyconcIi43No7BPM2uMMP",
+	"HkV2rP4ZpgEgH3/1+HHcpeImGWaothxBAMEy6jb0bCaCqRJyF0yJSQO41owz3RZhwUhBuhmlhTSTu2Sb",
+	"fWQosI4iLF/w3PDKdFOabVldKVlTSVdM22Q+9uahZoZ7/uDHg9BXj1X/OQ0OUbc9bXcKlgfq00jBoir+",
+	"ubCru+RcqVGu2NV62rULVagHTnvG7YUfCrkmPxvYGJCZWPrfUgHms1Tzi4xFsEnFqeCojPlTK2MCNNrO",
+	"RS9seLseekHPg7MGVpC4N2sgXa/RWqANkaG4Pg8byL6t1ZkprLeJOKEzvRTpcBa8pdu+oxhdwYcB4H6J",
+	"84uSaZy7T7UaGJpUeKuAljjuESE39ZAfJmaMTu3MdhUtPHD9VxcWl/zVKlQFgEHh/QUva6LxoDdteFGM",
+	"ksE/q2SwTqq3E+nUWpO0Lpyw2bPQLaJxsofzC5hDLE5AbGGV2bVduRxatcz+V4aJmdnwopBX7OunbZnD",
+	"6IB8aREKfXlVyZ5sLWmfxmxog7TINplOC08OdsOu9lOiivVaSK1IyrRNUYYtnPdTQCyfTJ++bbxm+ujj",
+	"j24NTybT6PenQBNrLyK7VMuMRq2G0TEpfAy1LRpyzOO7qNWdDjLStD8poBjfK3ka0oWpveWRyICtngVp",
+	"2S5+XnW2Dfk8yxQKMJrH1uK1xbK+A9riM9Gosp3LRM/Z2zoGWqO/+w2DFgX8dmTt7KfTelLhRibAfrjd",
+	"PFvjHSYN/BCFXCXMU3OYSjle87nI9yBsXKkObrODDem/9R07OD6yidDwLBaKgey/0GLVlrRvfDr+uZ+O",
+	"IY7dqilftes27r1ep87Ah+X3xsI3hx3IwofNRi7+T8zFN07Ntox8vYOIfV/FSYHCTcMTRmjbYbhRtNFr",
+	"5+leG8bgpcuu2WoI16Ygri8i2n/esFAPTRLKxIioPBYSLRLC2Ave5L1hg7OlOl60aYur+4XSix5iBpXg",
+	"VOcmViWHg+0v4OZ+Ru7r8Tb+rG9jlBpuKdBtdoANP3jJZ1S7jO9Yj57OS8rGItCFzFmKyVHN7mmH2zYS",
+	"EPqw+W2TTEvOrsz3uWbymkqEpKE9ZVrkPmvA2+E7AAZDmA9fsYsDgUofiQ3xY+/Ai0DbkSH5TBiSknDc",
+	"hCvxvdRYk4zPGcQUN4cesgrbJ2hJPRoHBC5AuNuN8Y/SdNVilAEdlyQG2jFFqK7qif/2mKR0o4L8DECJ",
+	"AqketJ/WCBOEPQEvfpEzsmFU2h4wDKOPxGCQa89MZQuG58Q6MKhg+si3FReK6dBOTjm3vDpLV1mjMeCo",
+	"tIhwT1syO4PwplXq1VLVxnByZvF0MQAfMqq0yabdgw2mWh0lQjYpJdRRCGvFQclasoSrINwNRvMdur9R",
+	"KKnlTnKtw4ZM6/T0B6IlzZWBWBMsa8mvqGY/ss0xVWq9lFS1WeD4cuhXqeWxb1tZqql4LWR6z/Ku6aQy",
+	"pV6xnF05AOhy8BKim9WGv/AdbzXkaOytBrJGmmVWJJ2K/IF2NaybEHR+ixrEJGpZdVosFkwZrIb8YXYK",
+	"pi7MES5NFx/tsQ/lwXQ9iMwXT6OGVONVf6tXvVJ0wbZ/Z1e1AQhHZxUdHUkyquIP+hVNljxnHU/6TW0A",
+	"s9HcucZ9R3lWSHY+sfOxUcC4sijAFWGrtTZ9MAk/c1FVb7ikqcbu+wSmSZKMSuugZdPg2cUCGpuofqlg",
+	"CjBXXDEpecpISwxk1X2Q6yblRl1nbp5n5Hxyiha6znfBr/TO0UatWbJH83TPgnSyyyPHLtySCY8BJdJF",
+	"OUCweEwPEs2vQC3F2uPmLPliuZeZRRGzWkJNI9xTM3bF6APKcBaZoDZBBc/9ZxO5kJlZu06gQsoqP1eU",
+	"55rlNLfpsOeSqSUWFfllLq7zocYkjVUeuIk0i06CGTdLj8o1NAu/c6tqGdAtrFn8nNHuCi8rsIjNOoBO",
+	"s/iNg1ew53+AZMHVe5GvorTxJDTihrA4h0cE6ppzSqXmc5poZwYbOBhTyW2ULzTsTRFv0d7Ypb0pJ9Z/",
+	"7HCCzXP0ts7CHeRhx2ZqykaXFHOSYwpWP0FZ5JWQMXays0ljHL93L3JDQHvOK4NKVa2vB0B4WLFiOpm6",
+	"v0zkZ8vnZjy/ZKn/IyihGacKTqnCGvhHUMOMzBM0yHMj8ByXOplObHw7+AzcLWcQp/2CpsEJn062O+QB",
+	"aF74dbWWnfjJNqv85JbeVtTV+MBCp1ny0sGrrair21MH0mbR8xLIzcKjEuzNwu+DjYggWLA1zdJvabzV",
+	"G799Edgb/iAkRT8JmvYgs6HJA1BZ6eLCIKugKSwnF3oPgu0iXu0ppi2JZVJCmLEVk4sAfXe9W/wSTnEG",
+	"9c8/uRnVC14J/Z2dYL3oW5qe+vnWC1/Y+de/v3TraRTU8M4XRO6GNznX5Yuo9nopb5VeaWKcu/gw7VY6",
+	"AbPRzg7PhfQIUM0/bYzdzVPQvjZTylbIAdH3P7F8oZeTZ08f//XrCNfISuwcuKg6Cf6AWLdNF1W0xyAO",
+	"vn3sCFwj+zWFpVvvBZvVKWDBPDhkkeeOk/IA+Oqv1XxEdO/3x3t/23v7X9Esemag+GxMCarSyugcapnO",
+	"rKjJxi0vJxMW9l60MGwVS6p7FAJ7WkHJAIoxhvcsWZ+K5JLpYykuIoCGz/AMCS/wiw0Ra2Yjtp0dHnsr",
+	"I/OAOCwtjvBFjM+I5rt/KWL6BJPvPzTC1KIq0MtEQjPTNO5aJGLWS8dC6jp7A7oJBk7hEGt1XVxkXC1L",
+	"h2jrcoXowleGoH715ZdffDmdrHiOv5/0JmOB+UQBzzK2YlpufhKLY8mFy50YxXOmNFnbSj75jVgQlmuJ",
+	"/ueGClxDtMQQVu/MG+1dhbcx9N1lzjKPIwmYxaShndc+E2EuNOKl6QAw76IYGss0trIXdthY2YGdSqzs",
+	"UPK2ohdStpSUCRVjpa/c0mKFR7jcWNFzBEFt69QpiHJiggUr5BFzs1VqRt79UxQyp9k7t1fKCnNwD+22",
+	"Wp87W3dK3gUoq2pNTb+Bi6imPGcSvoSNwu233aL7ga+xw8badf/D9xcpPKgM0QBcNHJoo4o3aWTBms0f",
+	"dMFyHcAD30LatScLqtk13UTFw2JIptLoCTW3UleQiCBJQTnb8nQO1TvGUCyWDCXnbSGOwieeiyNld9+j",
+	"HJXMzcyIBg+yrLsK4XNS5IoZFYuTPxk82jjoO4Sso1+LhvaAKJ4vsupk4RJ13qQS/hWFJqqYz/l7iCdK",
+	"iVqyLNtTepMxssjEBbE3+KzG3Hz5VfVyf7z3N7r3+8He/zw7P9/7bXYO//fr+fnb/zg/3zs//8v5+d/f",
+	"/tfD/zOs3qO/Pzw/n/2KFWPF/znZNiCvQ63OC+Ml05IngwjPCqvOyDtzYdaox+HxmylZQXLOKQoBrLtv",
+	"npKc6WshL0tFVHkhdpMkL9vGljZzh6ZSx0QMqtp1SKnMhG9IpiqA+gH7ixe2UypXrZtYBbXq9Mpuwc1I",
+	"Fm9N4mljFUOpT+SprwVJRGaDGagAE2xU+c6EnhR1hXAW98g73OEyvee71bswvac5kO+W74IEn+RloUDn",
+	"QDXJGFWaPHlcC6P+1WNV5Ya/eKyqeUEf/v2ZTw366O/n52l7Zutt6LHfjRuQ5Or5u9mRriZAbq6gWqHq",
+	"3Rw44FDv7D4au31mxm41FNnO0K3e+Ha9l2u9x83HIpWqpmO1CvfngRobeCClqDQcDcb+tAZjscPXh+E1",
+	"W7AaHbcBSdrJOeYXiYcOMUWW1Xcd4J5LNsfoJ/06Hex/yGI9hRmmKrNhpFze9xu665UM483TG1msPtAd",
+	"xnQVt08PXJOCCKyrggSTA82jBvsGNpRp0X3YznnSL8Di3gz2l6/Y/4i8lkTnJyNua7rDGpj8LnJWBpOV",
+	"ynKRMNrRwasDgtMgBycvDvZ/en14cHb0+pWJLsgkg49VfsZQB262jQhJRMKoNTx0LX0wHFN5TaXmSZFR",
+	"SRTXrIwSRDWhklH0zLMMJjmAODl0/xW7/u3/Cnk5JS8Kg3/7x1Ryl6C/yOnqgi8KUSjyxV6ypJImmkmi",
+	"3VrxNWydbVlKHp5Pvn95hrEP35wdxlNTTidg5HeCBLWJYZjTxFrlWbLb9MoE6vwbT1vbY41gN+KxfwSS",
+	"15QtWL7H3mtJ9zRdIGERcjV5Fgz1odXEygwppHOT9KZVNPz8G3wGr5X+VDsDpyZSNhUrc+CNwszN7ze0",
+	"oosFVDr+8fAFzs/Vuc25+IFrk4JF/xbPLWO3C6o008qg0cJvPkRIA6CTt7tNN5gSEh9Uf/5WSN46R1eJ",
+	"vDk5Ig8dvercacLnhOdJVqSY/KhSz2H3o9vag3AVtS2oQjJmQmGK7akzK6o0uF20rXRdmycYfrfuAJTe",
+	"1jSgs8rwtVsowJFpQAairACSNLUWuWK9NM1Wa7DtoBZq2yLbB1YqDaDjSfZbm0MpUID2xr91Kl8rHQVF",
+	"8f7Q0P43HnvLl6b4eBzgXuG5k6zE4/bxtBVAR88PydFzC+WH//jl7NGMHON1ij5GmFAL6qE0dc1ynpZY",
+	"pZtGkp2nxtOF4PBE+4GSFgKIYKhTvm8ZlUxGYml+aMO+SGisLWzKa3GzmpERLPAoQaOdNgv8lQ8RtUWg",
+	"lZc+7lWHLxkUReNLDbfqDk+3nakbM3aqMa7sabJkaRFLIPbcJW9bMqJsLXcdQOSDhKTiOrfmgsC72STo",
+	"U3srmM+ar1ypizJINMayjTzte0PLHkqRv3i/lky5tzZIm7+XNGE+9u0WMXJ1wAV3PvJdvcZjUk+ic4hC",
+	"XDFpVI4dpNScXletnZa2UMEX3eQvHnz2uyLLfNa1RpvQwzjyWHuDuQqCOoPfaK+DVtE80vCKlSz9zWWN",
+	"iJkr2Do+s0RbdpCY40AtPYacDfOVCIRP1V25apPrfs91PX6SNQXpf6C7To2a4meRFSv2Mh74BD5HIsJQ",
+	"cgXNIprRaFxW7GcdRIn1uma8V1z4IdO5WPtNL6X7+0wn+/mC5++NCGg+S59J0bvOdWsIUcWSQnK9MZRq",
+	"hTO/gPvDXQT46ztHI//xy5k5klB78syWluMbkZXF7KOWaH9v3hw9dxvVDBEjrvOq8mtGyEu6RvezakwZ",
+	"RZxcaeaQk5tB/lUwSEuNWG2mYliv8gys+Y/MsmxgkYEiE00xHQxbUZ5Nnk00o6v/4139Z1yUPZpVfAcl",
+	"xj5HS5GRM0ZXNonks4mT21Va1w3TJr9Wu3j7MNbskRVhIkLb+NrGowHtfDGvLmQzFnOUWWHC+XRR5j0y",
+	"t4NeMi6JUUOaG0XNznMwu02YJZR2ZQdrmiwZeTp73FjM9fX1jELxTMjFvm2r9n86Onzx6vTF3tPZ49lS",
+	"rzKk+xpwtQakg+OjydSfuWeTqycXTNMnpoVYs5yuudFezR7PntgIuICO+7RIuWZGiAm/FzGR3Yn17w6F",
+	"3dCOYENcsPfMOEqtbPrA1HmBfU8nZXBjkMA1dMEu4ZQWPjmU9T/FEb1bOh4wZZMrcRkkk4bjDdbaNniE",
+	"Ihm/ZOTBNw+m5ME35r9mwx78xzcPSuu3S7Z58g0IsJ9ML9nm6X/gj6dWTBJDexjxNEh5CzdDxCnzw7S+",
+	"0lNDgIRMQZcp3WksMrsg5yBZVUM+MH08IA9zBhZWcy6Vbp0cdF6ZVCkkM/2E3h7wCz4OU1kHW/raDHMA",
+	"HdS/PocOI4uHgKRoo2ZiQl8wWccl3H6z00FOYmAl2pab8RXXleX2Osc1J3aQlzyeR1QzFxgMHYndNnm9",
+	"BFqrhmlDhEs4LunKdLAyCmbMhqbrlR6gGLFgDxCB3Xp9NmhA/L7Vu046kfDtdOL6gVP+9PFjR5gZXsiB",
+	"9cL+P63/Wdlfp6bK77058kj5a5zhj4YI/fUWx/Tqv8ZY39KUOAkmDPrkHgZ9kzvxEUtx1C/uYdTvhLzg",
+	"aYpxHv769G/3MOSZEOQlzTcOxBBm5Mt7Wa11Rydvcu+GiDwuyI5+nZT3GPCb7/ccizN5FpTBhPfNbu0n",
+	"3sM7eud9z3QtKXeVQZ01bjzD01m38Ts9bn6U9qP25Ot72BEzEzCsc3Bh6UfFxAoyhBsHN5Hd9SAbUufW",
+	"h4yHR7hKLiV3eVfjE5jHXBw1vmf6OBj8DlGkHKaNIv/UtbKPSDc/S7w1FPQ+rsajXDOwmEVzC4J+SMOO",
+	"jUFr95yLnpmq9McZbBgxr3jPXYw7XLLPgOnBXye0Pt0+8oLw9oCTFSqM46fMTeEuz1dDFhZD7dpsx0M1",
+	"Hqr6obqiGU+t01j0UP1sK4BTU10PcMlajoBr1ffkPluyaK/m1Lmp+VfHklF8VDpZRqgv/Whvjy6UMCuB",
+	"ZfzJHx+4Up6Xax0P/B/0wP/bXWzmEH3Y9zq1tei1t2HvMedA7GoNDXLUFrfrw+ODl4QrVTD5qGksYa1l",
+	"jJkUyM7BQsVK2OKExyV56aQ6r4IkZB3XfhFIPGy6Lkt5QhhOQkE8Ghz0ECIA0rci3dwaqlSMpsxeh129",
+	"37u+vt4zXMBeITMb/Grnvj/Ul/vhDmlr1XKilfBIX+N2qWzv8BViO+T4OcRpf/jBs8hgsouQUk1rHBNw",
+	"l3X7Rdx/ahHj9FYl+hm9YFnNFYddMbnRJlBOqyTYtNpNKv/56B/iIniHeH6RPC8X7xGEnHmUJNc8y4hi",
+	"uhPRKs2NzV0Fy9l7rjR26tpb/DWeAOYYG1P+MrMkVcHB4bmLtWnS22o8RbepI3h7x1I8RzhGsfkoNv+I",
+	"YvPyYgTBeZwXPZTMvkOj12PzdsQGYeXJ3bBflSEGsUhP7nDsGNTS8Rjf+TF+fB/H2KhdMp7okXDECEdN",
+	"41aWljo3/2X/3/ACRjqTMR014czYVhQHG9QoTq8ALMyJHR3IsIM4x5b36G7v0HsXiL3+8TOjCH+9hyFf",
+	"CU0wBtxIEiIkoV2xPvhUf8/0nRzpBdOfwnnu4zDGUz2e6nt/IRhZU8Sg3Xze4mRD/Ts52zDBWz3dQ58t",
+	"ezD0f21prmHafCQh71D6Mj5e/lxEbXwvfXwyWkSYI3Rs24KKnrB1RpO7efagS9xHIaR3Kf+5b+o5SpxG",
+	"oj0S7c9CyJUwqTFNFpPsSiSl72m7vhmMNcp2ipiWl6UGLnTKi2uhD8vWJ8GoPdfA6zLVZ3MO4C14jWmA",
+	"VFEm88XQSGgQAvGaunwD0UXuFV4UH+f9HAXNqHAbFW4fjaRESUSH5u0EqEHzhJbnktpT6SIOY6DioLIx",
+	"VLDZJznNrNI/xkqakYITo+5IZRc9lB/pATwSiJGxGmlSK02q8Dst3E2N8VF8kfN84exRu5mf4PidYjsL",
+	"nT7Lu9aGoxneaIY3muGNZnjb3v5VKjJyAOMT4Y9wHVcv0wEGegNu1DZjvdaWd/8MqI13z2Z8PRMZJayj",
+	"Td9IeNrfAnWGv/s9MMD0D79XaRmxJ5OUNClm/tdFw7ZSivWT0dEwcJQvjPKFW6ArUemAZDTFl7d/diQd",
+	"Z7thNHjPhODWzAkhU96/CnaEcUhN5Y/0BBppxUgr/niPn07bw50eP9D2nsnFaKF4t/RpfJeNli/jU/AO",
+	"yXARZdnAFLHGtR0O5tqsKeM9k+JPwsjxhqKyj0qNR0ndeCOMN8IoHNxCOLhP18auErNIR++aA6jACETs",
+	"zzddrH+T40cj+9YGB27wW7tvtCC0OuHxvhm5/5HWj7T+z0zrSypuiD4ag1PM878vmSowK1Kb1asp90lW",
+	"LqhiKRE5GiSVNkI0T/eFNfzxX2OWraY3tJK9K6NW7B1H+kjEsjqF9sh5I50cjVjunIRUzrtJLvN+T15Q",
+	"SDqOHyfPbDprOJCenmA7TyE+1OlNvdyTlosiu/THvsfsFE/Kt0V2+dq16DM4jTQZTU1HU9PR1HQ0Nd3i",
+	"cq7Qj9HIdLyfP/L9XLk0h5iXdt2cU3K95EYPa5YDqRGJ7xtkIFkGJMJx9XCFGdKJzmlI2hShapMnSyly",
+	"UahsMyNHOUnlZk8WOVmJlE2hj7JjrjAMPUp7gdgK49pqapXUzo/lBudVygdTAGC2mcdGVt53/x/NCQhw",
+	"cEIup0Jt/mbCCSQuDWHDNbkWRZYCMDdEiyk43YrCrhTB1up5KzcnRTQtw4UQGaP53YmOYmD6KO+gyBTq",
+	"ZHZaQYEQyW5bcTFwSqPKYjQu/twunMjrrvaUa3vjbRFFtPPeMo+OjGnM9R5eWUuq4Z4ocjA9VlqsgTLT",
+	"ObzjloxcwCtMzEtJFTM111IkTCmWtoUp3eEyqasbulY02iaPwvXR3vBGZKk9dmkPD8zzJCtSQwVQ7iIW",
+	"kinlTq0fJBrq9B7IwicS+HQwDzcSiJFA/OH5lkHi6GES6FHoPAqdR6Hzn0joHMERK6Qh84wuDJ4gS8FQ",
+	"jGRms1pRuXGnz5KYGfnFrARAJazcSS9ZCRaApAEA5XkpInOdhZl1yWtX+kBc50w+QGyq4P2DEkaKUMkc",
+	"VrKUXJt5PLAdm64emFeUmVEb3IK6A4RWd8pyjEL5USj/kXmN4XL43pgOWO1OTV7uO1pDOOooPR2lp58d",
+	"ZYiZw4Rvje3For1ZlTwZ2UEUMQolR5nDKHPY+bT3yiG7kyfd2sn9pOSG47Edj+1HZt+7AxX0Hl2oeGuH",
+	"d4w3cIsEZHxZjO5F42PmtuhkVxakfjJpYwbcGqH8JKIBbCN3uT/COMp4Rko8UuI/vVhpP2WJWK24Uhyn",
+	"GKXgZmZpkbFAQYXin6BtU9RUFt6iwKns9JMg6yEURt53pLjji/0j0r8qsYsQw4wqrRjLW+17vreWC6Yi",
+	"MTWJ5iumNF2tW6hWhxjvJ6r0qRntVsR5rfOaC3mrpPJu9fUOJh2M6V+b+/JKkEM7iZHGjDTmY9IYT0Mi",
+	"9EWyPGVw0nroi6toma0oETmxdW5TJxAb3JlSIZxvk5xErcyAhF3m4jr3E/mZyQrDVzM3gson1bqTP6rG",
+	"YiRf46N0JJjV0B+WKEYIJvrw9pJLrGZI2zZqVLukUZk6KlNHtumPokzd+jgHqtVbO9CjgnUUMo2UbKRk",
+	"N1F3bk3IKsrPWyNlowp0JF0j6Roff3/Qx5994JmnH8ulyLIVy7WLNLsWGU94n7vtC9/Ohfo+Nu02fQ64",
+	"Le346JM7+uSOPrljIMhhpLGN+oyOp6Pj6Ue7dVuu0s0QV9Te67TNObWt4R25q7YOd88OrN3zGM0dR5fW",
+	"keZUef8ORr/7HbCNK+wOZAzbdpCxrWQxvRMYHWhHucUocr05belwqd2BCHzP9L1SgE9Ed7wNlzMShJEg",
+	"fNQHTrez7g5EAZreK1kYNdB3SprGt9eo2Bmfe3dHgTvdgHcgwFY3fq8k+JPQnN9MCvYxifAogxvvgfEe",
+	"GMV+TbFfIvI5X3QafZeVK5Fuu1/zh9jvFndFRx7yylWBev85pLAlXKmCpSTIpDsjR3NilsxTlk69NYAB",
+	"K0bxXbLk0uhUu/OO22C/Kj4IqKNBWcsVSahiPs4wd249ViFchwjk5DLZvIReMgltcZIBlMOBUC8MM79g",
+	"hK3WulVbmyg5+fgiC7vx43tglI98dlS5zPRdJbLSzXmgbVWd7PUaVXmgjMZUozHVaEw1GlNtdWVb6jFa",
+	"UY1WVH+oS7TPfCrvuDL7Dadsizu3mNpKUv/kricwymdGG6nPmaK0SElkMP0I476FMdR2RKluBlUSpR1l",
+	"7DGJyWj4NL7jR3nuJ0Oi2m2stqMtFXnsnRCWT86eqoMVGgnMKCj8OG+cTguq7Y58zXbqTg79aC11N4Rn",
+	"fH6N7NTITt0Bfe2yj9qOvDYso+6EwH5itlB/fNo6itVGuj7S9VGSF0jy9p1ZVGsaBjRuZERIkrJ8E70q",
+	"mjeEbXUHN4QWhFan9KndEM5c9KPfFG4i/dLGkXaPEojPnpKWtLKbpG4fP/jm8szdQveNUs2Rpow05eNJ",
+	"NW9EBuIyzrsgBKOkc5R0jhRwfBH/GSSdNyK5bXLPuyC6o/RzZP5G5u/P/aAMAxFfmZm0PhpPmJacXTFF",
+	"qHeCwCaz8zzuFIMd9jnCfDa+FqdCaiJkyiT4TOpl6ftwsSkzF1b9XB6YPh6Qhzm7NvR5zqXSrZODziuT",
+	"SrGryTOYy2Q6YXmxMuhC4Rd8fDvd1U8E9x/3zWyRc/To8yHaxQFj+nl5UN2pvMJs2+hjMvqYfLzLymBg",
+	"5ILCG8PcRvOMsT43ze9MnT7XzO+wo9Edc3THHN0x/zTumA3IHdmoD2bY1YrKjTtmNuWGWzTQlbaZ0NSm",
+	"lVWn2Els9y6EyBjN7/iOBrI13tHjHf3R7mg4KUNC51ev4TZ3T6h1Ry6e2Pc9u3UGg442Z6Mr5+dGFCqM",
+	"O3wOGff9f8O/H/Y1W60zqtkVJihv5+iBG3G1ia8eY+nPbK2fy0q9Ym9xnSMzZZiAxjAtQu55QLN2zO0+",
+	"PizGh8X4sBjjvBiyW6NbI3c/cvd/zIu8eWsPuNkHRGZIXZqa+gXcEo2hdmBufM/f3TVf16wPHHkM+TCq",
+	"r0f1dZUeRV8HktEUWWPPF/TSkO+ZHgnIfRKQOrRHSjJSkk+KsxmeZ69P5okVncxzK6O8atdj1Kjx4I8H",
+	"/zZYCMyN13dwv2f6lk7tLTovfR7azpFsjGTj4+o5uzPo9ZEOqHdLxGN0eLo92jHKUUcnp1Hre0sksjPF",
+	"XR+FtN5Lt0QjPwn/pC1MU+6NJI5WMCMJHknwn9XwZlAIEJCnl16oVcm6o8/xl/FurqZ3+j4en6bj0/Qz",
+	"fprWPMq3eKje1lken6vjc3UkYiMR2+HxKPFNuCUzEr4kb4uIje/JkQcaycenpc4P4leg9fig+BUpV5rn",
+	"ifZW3tjWh2UoqU9JHzZr1hbo4icceQABMr1Yw2tPdqSdmJ+EFKs2ld0lz9NOKuTCO6Bib1BohwMy55l1",
+	"SqjPReTZBibkZ6yIXtLQ9WDBr1iO9b01/Z2Y6t/CLNFKvW+Wt25mX6Ibzvde4mXs9iZm7+lqnWELnO0L",
+	"/GI+WF3z5NnEfvQTh5OTuWMA1vwYk+aKS5GvWK6/WUuRFolGKzzJFlzk3xRqj1Gl956YBXAmv7mgySXL",
+	"U0zbPIyywOEbTelHU/qPdkMB3jdvKHsczNUk5ILm/HeY1nYRliotZ4S8NqQOiYeqFiLFM9SkUEySJVWE",
+	"JglThtzEI2O8rszqcw3TdJeywxDCI4kaSdS9k6jyxv4JDmntxDsKFn5vErJqK0PPJFsLxbWQnPWE6Dlx",
+	"NTd9cXpOwj7HaD2jU+3oVDs61Q4giiWFGW/Y8Yb9aI8AfyVuhoTMiVyLbXFzyqp3FDwnGOCeI+jURx4N",
+	"iMYwOp8ltaiw2xXmus5tb+OjNojIYO0KkdlKjRYZZHRZG5Vbo3JrFzrQ4bc26DB/z/Stn+RPxEyvm5cY",
+	"j/J4lO/5AdDtSzboOFsztVs+0KOt3i0TlfFtMjo3jM+h26SdnU5mg0intQ+8deL5SdgIbivRuV+COUqQ",
+	"Rio9Uuk/v9AKy9QmT3p1xFj1dJMn/Vrisu6oJh7VxKOaeFQTD+QUSsIxKopHRfFHvEXLi3GYqjhyO7Yr",
+	"i8vKd6YuDoa4d4VxfeyR4R9Vxp8p3ajx32VphAHfTm08iOA4xXGF4GwpYokMNCqPRwnAqHHajSJ0qo8H",
+	"HWpQIN/Bif5klMjd/MV4qMdDfe/Pgz5F8qCDbbWod3C0R3XyrZOX8eUyqirGx9LtUtEelfIgIuqVyndA",
+	"Rj8RxfK2sp/7Jp6jtGmk2SPN/jwEXCJjFzxPeb7oUzCLjH2LNXv1y2XVUb08qpdH9fKoXh7GK5R0Y9Qu",
+	"j9rlj3eJlpfiIOVy5GZs1S2Xde9KtRyMcN+a5frQI6s/KpY/T5JRZbvLwibXvZVWeRClsUrlCqXZTr4S",
+	"GWZUKY+v/lH7tBMt6NIoDzrQRqF8+6f5U1EndzMV43kez/N9Pwd6lMmDzjSqUG//VI+a5NumLONLZVRK",
+	"jI+jWyWg3XrkQfTTqZFvn4J+GkrkbaU890w2R7HSSKxHYv15SLIGKI6HaIxHVfGoKh5VxaOqeDBPMOqI",
+	"Rx3xR70mhyqHB2mF71Ad/DH0wCOnPiqAP0t60OCXA0Z5W13vICXvLnKPUa07PsVHNdCOJ7xHn9uvyL3x",
+	"if2EVLfjYR0P60dlz/uVtUO0tDc+sqNe9tbIxvhyGGX842PldqhjryZ2mAr2xuTxk1G6/rGI4Si1GWnv",
+	"SHv/VIIixRLJtNJC9ilWT6HmqbYaoS79alB1VLOOatZRzTqqWYeRuZJujNrWUdv60S7N4FIconSN3Yxt",
+	"uteg7h2pYMMR7lkT2xh6ZO1HheznSTIq7HZQ2OS6t9HSDqM0WL1KabaSl8SGGVW34yt/1AbtRAs6NLjD",
+	"DvT3TN/Baf5E1Lo9TMV4nsfzfN/PgW4l77AzDbXv4FSPmt/bpizjS2VUQoyPo1sloJ164GH006qD74CC",
+	"fhLK4a2lPPdMNkex0kisR2L9OUiyoDeaJKLIda8KGSofYOV+LXJYe1Qkj4rkUZE8KpIHcgwh6Rh1yaMu",
+	"+SNep+EFOUydHL0l2zXKYfU7UypXBrl3vXJz9PENMKqWP1sKUuPJqyx4hC3fTsc8kPw4NXON/Gwpg4kO",
+	"NiqbRwHBqJzalTp06psHHm5QOd/Ryf5kFM99XMd4vMfj/RGeD33q54FH3Gqg7+iQj3roOyA048tm1G6M",
+	"j6nbpqc92uiB5NQrpO+IoH4iaunt5UT3T0hH2dRIwUcK/jmLw6ofPuxrccnyHu21oc8Hx0cE6xqKXb8d",
+	"nAIvkUy7alQykgvtNX9DdN1nOJvbujuWzE3mgmUiXxAtWq6RGmz/mC9xgE6fTm8keeOT/I+i0ctLskHm",
+	"Qg4gG4QrIvJs07AX8Lp+LYheckUsEzdMOQgn5xMkK3fNpyJcPqpSM5jCyD2O3OPIPf4xuEfHGG7BRA7Q",
+	"tZ6wK3FZuxhi7OQglesnSNSnfdNDkICloIHUqAQe6ejIkt4NVbtiUnGRtz59jd7YNie2blRb/LPt5w6P",
+	"mBtiPGOfPcI7rH0LbdFkGu+9QmaTZ5N9uub7V08mH976NnXEfu0wWMGjTDItObsyxuW0SLk29vC5BtcE",
+	"e93AZ/g6+TDt6c1gCMu1BUulk7CguyORk4NCL4+luOIpk1V/iaC/ta3QPy24Ts0SEyY1n5tZMEW4UgVL",
+	"rb29O+zBGEFl08HAqR+WrU75Iuf5wqJRdB3hhLC29G+Q7nGeM8CUWKcpFPWDBesRmsCnRgf2+8CZfFtk",
+	"l/57x7QuiuzSU9Hevl/kUmTZiuX6YG32m2bHIuPJJjoA85WprbyGyluM0rVXZfeD9qh2uhrnasCREjn5",
+	"LmMsPp25KdlqCuj+QmgihVIk5fM5kyyP9w51t+r9tVzQnP/evv8iqNC77hO2FoprIeNbLX3xgJ6w+ekm",
+	"T1r6st82edLfWyPPousFAvsOaF3PhlvvxKWF7OurNTCpf6iUDm39fcU91MCtCL2RShXArPUxNABdEsYB",
+	"WyK8le3zyrE7bz/8vwMAi1hMRtOuBAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
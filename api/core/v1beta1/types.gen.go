@@ -23,6 +23,20 @@ const (
 	AppTypeContainer AppType = "container"
 	AppTypeHelm      AppType = "helm"
 	AppTypeQuadlet   AppType = "quadlet"
+	AppTypeSystemd   AppType = "systemd"
+)
+
+// Defines values for ApplicationProbeFailureAction.
+const (
+	ApplicationProbeFailureActionNone    ApplicationProbeFailureAction = "None"
+	ApplicationProbeFailureActionRestart ApplicationProbeFailureAction = "Restart"
+)
+
+// Defines values for ApplicationProbeResult.
+const (
+	ApplicationProbeResultFailure ApplicationProbeResult = "Failure"
+	ApplicationProbeResultSuccess ApplicationProbeResult = "Success"
+	ApplicationProbeResultUnknown ApplicationProbeResult = "Unknown"
 )
 
 // Defines values for ApplicationStatusType.
@@ -49,6 +63,12 @@ const (
 	ApplicationsSummaryStatusUnknown        ApplicationsSummaryStatusType = "Unknown"
 )
 
+// Defines values for AuditEventOutcome.
+const (
+	AuditEventOutcomeFailure AuditEventOutcome = "Failure"
+	AuditEventOutcomeSuccess AuditEventOutcome = "Success"
+)
+
 // Defines values for AuthDynamicOrganizationAssignmentType.
 const (
 	AuthDynamicOrganizationAssignmentTypeDynamic AuthDynamicOrganizationAssignmentType = "dynamic"
@@ -74,6 +94,22 @@ const (
 	AuthStaticRoleAssignmentTypeStatic AuthStaticRoleAssignmentType = "static"
 )
 
+// Defines values for CertificateIssuerType.
+const (
+	CertificateIssuerTypeAcme      CertificateIssuerType = "acme"
+	CertificateIssuerTypeFlightctl CertificateIssuerType = "flightctl"
+)
+
+// Defines values for CertificateRevocationReason.
+const (
+	CertificateRevocationReasonAffiliationChanged   CertificateRevocationReason = "AffiliationChanged"
+	CertificateRevocationReasonCessationOfOperation CertificateRevocationReason = "CessationOfOperation"
+	CertificateRevocationReasonKeyCompromise        CertificateRevocationReason = "KeyCompromise"
+	CertificateRevocationReasonPrivilegeWithdrawn   CertificateRevocationReason = "PrivilegeWithdrawn"
+	CertificateRevocationReasonSuperseded           CertificateRevocationReason = "Superseded"
+	CertificateRevocationReasonUnspecified          CertificateRevocationReason = "Unspecified"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -99,6 +135,28 @@ const (
 	ConditionTypeResourceSyncAccessible               ConditionType = "Accessible"
 	ConditionTypeResourceSyncResourceParsed           ConditionType = "ResourceParsed"
 	ConditionTypeResourceSyncSynced                   ConditionType = "Synced"
+	ConditionTypeSecretStoreAccessible                ConditionType = "Accessible"
+)
+
+// Defines values for DeviceBulkOperationPhase.
+const (
+	DeviceBulkOperationPhaseCompleted DeviceBulkOperationPhase = "Completed"
+	DeviceBulkOperationPhaseFailed    DeviceBulkOperationPhase = "Failed"
+	DeviceBulkOperationPhasePending   DeviceBulkOperationPhase = "Pending"
+	DeviceBulkOperationPhaseRunning   DeviceBulkOperationPhase = "Running"
+)
+
+// Defines values for DeviceBulkOperationProgressedDetailsDetailType.
+const (
+	DeviceBulkOperationProgressed DeviceBulkOperationProgressedDetailsDetailType = "DeviceBulkOperationProgressed"
+)
+
+// Defines values for DeviceBulkOperationType.
+const (
+	DeviceBulkOperationTypeDecommission   DeviceBulkOperationType = "Decommission"
+	DeviceBulkOperationTypeRerender       DeviceBulkOperationType = "Rerender"
+	DeviceBulkOperationTypeResume         DeviceBulkOperationType = "Resume"
+	DeviceBulkOperationTypeUpdateMetadata DeviceBulkOperationType = "UpdateMetadata"
 )
 
 // Defines values for DeviceDecommissionTargetType.
@@ -198,9 +256,18 @@ const (
 
 // Defines values for EventReason.
 const (
+	EventReasonDeviceApplicationCPUCritical    EventReason = "DeviceApplicationCPUCritical"
+	EventReasonDeviceApplicationCPUNormal      EventReason = "DeviceApplicationCPUNormal"
+	EventReasonDeviceApplicationCPUWarning     EventReason = "DeviceApplicationCPUWarning"
 	EventReasonDeviceApplicationDegraded       EventReason = "DeviceApplicationDegraded"
 	EventReasonDeviceApplicationError          EventReason = "DeviceApplicationError"
 	EventReasonDeviceApplicationHealthy        EventReason = "DeviceApplicationHealthy"
+	EventReasonDeviceApplicationMemoryCritical EventReason = "DeviceApplicationMemoryCritical"
+	EventReasonDeviceApplicationMemoryNormal   EventReason = "DeviceApplicationMemoryNormal"
+	EventReasonDeviceApplicationMemoryWarning  EventReason = "DeviceApplicationMemoryWarning"
+	EventReasonDeviceBulkOperationCompleted    EventReason = "DeviceBulkOperationCompleted"
+	EventReasonDeviceBulkOperationFailed       EventReason = "DeviceBulkOperationFailed"
+	EventReasonDeviceBulkOperationProgressed   EventReason = "DeviceBulkOperationProgressed"
 	EventReasonDeviceCPUCritical               EventReason = "DeviceCPUCritical"
 	EventReasonDeviceCPUNormal                 EventReason = "DeviceCPUNormal"
 	EventReasonDeviceCPUWarning                EventReason = "DeviceCPUWarning"
@@ -222,11 +289,13 @@ const (
 	EventReasonDeviceMemoryWarning             EventReason = "DeviceMemoryWarning"
 	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
 	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
+	EventReasonDeviceRenderRequested           EventReason = "DeviceRenderRequested"
 	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
 	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
 	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
 	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
 	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
+	EventReasonFleetImageArchitectureMismatch  EventReason = "FleetImageArchitectureMismatch"
 	EventReasonFleetInvalid                    EventReason = "FleetInvalid"
 	EventReasonFleetRolloutBatchCompleted      EventReason = "FleetRolloutBatchCompleted"
 	EventReasonFleetRolloutBatchDispatched     EventReason = "FleetRolloutBatchDispatched"
@@ -239,8 +308,10 @@ const (
 	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
 	EventReasonInternalTaskPermanentlyFailed   EventReason = "InternalTaskPermanentlyFailed"
 	EventReasonReferencedRepositoryUpdated     EventReason = "ReferencedRepositoryUpdated"
+	EventReasonReferencedSecretUpdated         EventReason = "ReferencedSecretUpdated"
 	EventReasonRepositoryAccessible            EventReason = "RepositoryAccessible"
 	EventReasonRepositoryInaccessible          EventReason = "RepositoryInaccessible"
+	EventReasonRepositoryPushReceived          EventReason = "RepositoryPushReceived"
 	EventReasonResourceCreated                 EventReason = "ResourceCreated"
 	EventReasonResourceCreationFailed          EventReason = "ResourceCreationFailed"
 	EventReasonResourceDeleted                 EventReason = "ResourceDeleted"
@@ -316,6 +387,12 @@ const (
 	GitRepoSpecTypeGit GitRepoSpecType = "git"
 )
 
+// Defines values for HttpGetProbeScheme.
+const (
+	HttpGetProbeSchemeHttp  HttpGetProbeScheme = "http"
+	HttpGetProbeSchemeHttps HttpGetProbeScheme = "https"
+)
+
 // Defines values for HttpRepoSpecType.
 const (
 	HttpRepoSpecTypeHttp HttpRepoSpecType = "http"
@@ -413,6 +490,11 @@ const (
 	RepoSpecTypeOci  RepoSpecType = "oci"
 )
 
+// Defines values for RepositoryPushReceivedDetailsDetailType.
+const (
+	RepositoryPushReceived RepositoryPushReceivedDetailsDetailType = "RepositoryPushReceived"
+)
+
 // Defines values for ResourceAlertSeverityType.
 const (
 	ResourceAlertSeverityTypeCritical ResourceAlertSeverityType = "Critical"
@@ -425,10 +507,16 @@ const (
 	ResourceKindAuthProvider              ResourceKind = "AuthProvider"
 	ResourceKindCertificateSigningRequest ResourceKind = "CertificateSigningRequest"
 	ResourceKindDevice                    ResourceKind = "Device"
+	ResourceKindDeviceBulkOperation       ResourceKind = "DeviceBulkOperation"
+	ResourceKindEnrollmentApprovalPolicy  ResourceKind = "EnrollmentApprovalPolicy"
 	ResourceKindEnrollmentRequest         ResourceKind = "EnrollmentRequest"
 	ResourceKindFleet                     ResourceKind = "Fleet"
 	ResourceKindRepository                ResourceKind = "Repository"
 	ResourceKindResourceSync              ResourceKind = "ResourceSync"
+	ResourceKindRole                      ResourceKind = "Role"
+	ResourceKindRoleBinding               ResourceKind = "RoleBinding"
+	ResourceKindSecretStore               ResourceKind = "SecretStore"
+	ResourceKindServiceAccount            ResourceKind = "ServiceAccount"
 	ResourceKindTemplateVersion           ResourceKind = "TemplateVersion"
 )
 
@@ -450,11 +538,12 @@ const (
 
 // Defines values for ResourceUpdatedDetailsUpdatedFields.
 const (
-	Labels       ResourceUpdatedDetailsUpdatedFields = "labels"
-	Owner        ResourceUpdatedDetailsUpdatedFields = "owner"
-	Spec         ResourceUpdatedDetailsUpdatedFields = "spec"
-	SpecSelector ResourceUpdatedDetailsUpdatedFields = "spec.selector"
-	SpecTemplate ResourceUpdatedDetailsUpdatedFields = "spec.template"
+	Labels           ResourceUpdatedDetailsUpdatedFields = "labels"
+	Owner            ResourceUpdatedDetailsUpdatedFields = "owner"
+	Spec             ResourceUpdatedDetailsUpdatedFields = "spec"
+	SpecSelector     ResourceUpdatedDetailsUpdatedFields = "spec.selector"
+	SpecTemplate     ResourceUpdatedDetailsUpdatedFields = "spec.template"
+	StatusSystemInfo ResourceUpdatedDetailsUpdatedFields = "status.systemInfo"
 )
 
 // Defines values for Rfc7662IntrospectionSpecType.
@@ -462,11 +551,29 @@ const (
 	Rfc7662 Rfc7662IntrospectionSpecType = "rfc7662"
 )
 
+// Defines values for RoleBindingSubjectKind.
+const (
+	RoleBindingSubjectKindGroup RoleBindingSubjectKind = "Group"
+	RoleBindingSubjectKindUser  RoleBindingSubjectKind = "User"
+)
+
 // Defines values for RolloutStrategy.
 const (
 	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
 )
 
+// Defines values for SecretStoreAuthMethod.
+const (
+	SecretStoreAuthMethodAppRole SecretStoreAuthMethod = "appRole"
+	SecretStoreAuthMethodToken   SecretStoreAuthMethod = "token"
+)
+
+// Defines values for SecretStoreSpecKvVersion.
+const (
+	SecretStoreKvVersion1 SecretStoreSpecKvVersion = 1
+	SecretStoreKvVersion2 SecretStoreSpecKvVersion = 2
+)
+
 // Defines values for SystemdActiveStateType.
 const (
 	SystemdActiveStateActivating   SystemdActiveStateType = "activating"
@@ -511,6 +618,30 @@ const (
 	SystemdLoadStateUnknown    SystemdLoadStateType = "unknown"
 )
 
+// Defines values for TelemetryLogPriority.
+const (
+	TelemetryLogPriorityAlert   TelemetryLogPriority = "alert"
+	TelemetryLogPriorityCrit    TelemetryLogPriority = "crit"
+	TelemetryLogPriorityDebug   TelemetryLogPriority = "debug"
+	TelemetryLogPriorityEmerg   TelemetryLogPriority = "emerg"
+	TelemetryLogPriorityErr     TelemetryLogPriority = "err"
+	TelemetryLogPriorityInfo    TelemetryLogPriority = "info"
+	TelemetryLogPriorityNotice  TelemetryLogPriority = "notice"
+	TelemetryLogPriorityWarning TelemetryLogPriority = "warning"
+)
+
+// Defines values for TelemetryLogsSource.
+const (
+	TelemetryLogsSourceApplications TelemetryLogsSource = "applications"
+	TelemetryLogsSourceJournal      TelemetryLogsSource = "journal"
+)
+
+// Defines values for TelemetryMetricsSource.
+const (
+	TelemetryMetricsSourceApplications TelemetryMetricsSource = "applications"
+	TelemetryMetricsSourceHost         TelemetryMetricsSource = "host"
+)
+
 // Defines values for TokenRequestGrantType.
 const (
 	AuthorizationCode TokenRequestGrantType = "authorization_code"
@@ -522,6 +653,12 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for ListAuditEventsParamsOrder.
+const (
+	AuditEventsOrderAsc  ListAuditEventsParamsOrder = "asc"
+	AuditEventsOrderDesc ListAuditEventsParamsOrder = "desc"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -572,9 +709,36 @@ type AbsolutePath struct {
 	Path *string `json:"path,omitempty"`
 }
 
+// AcmeIssuerSpec AcmeIssuerSpec describes an ACME server that issues certificates after validating HTTP-01 challenges served by the agent.
+type AcmeIssuerSpec struct {
+	// DirectoryUrl The URL of the directory of the ACME server.
+	DirectoryUrl string `json:"directoryUrl"`
+
+	// Email The contact email address of the ACME account of the device.
+	Email *string `json:"email,omitempty"`
+
+	// HttpPort The port on which the agent serves HTTP-01 challenges while ordering a certificate. Defaults to 80.
+	HttpPort *int `json:"httpPort,omitempty"`
+}
+
 // ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
 type ApiVersion = string
 
+// AppRoleSecretStoreAuth Authentication to a secrets store using the AppRole auth method.
+type AppRoleSecretStoreAuth struct {
+	// Method The method used to authenticate to a secrets store.
+	Method SecretStoreAuthMethod `json:"method"`
+
+	// MountPath The path at which the AppRole auth method is mounted.
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// RoleId The role ID used to log in.
+	RoleId string `json:"roleId"`
+
+	// SecretId The secret ID used to log in.
+	SecretId string `json:"secretId"`
+}
+
 // AppType The type of the application.
 type AppType string
 
@@ -590,15 +754,105 @@ type ApplicationContent struct {
 	Path string `json:"path"`
 }
 
+// ApplicationDependencies defines model for ApplicationDependencies.
+type ApplicationDependencies struct {
+	// DependsOn Names of the applications of the device that must be ready before this application is started. An application is ready once it is running and its readiness probe, if any, succeeds, or once it has completed. Applications are stopped before the applications they depend on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+}
+
 // ApplicationEnvVars defines model for ApplicationEnvVars.
 type ApplicationEnvVars struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 }
 
+// ApplicationHealthProbes defines model for ApplicationHealthProbes.
+type ApplicationHealthProbes struct {
+	// LivenessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
+	// ReadinessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+}
+
+// ApplicationHostAccess defines model for ApplicationHostAccess.
+type ApplicationHostAccess struct {
+	// Capabilities Linux capabilities to add to the containers of the application. Capabilities must be allowed by the agent configuration of the device.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+
+	// Devices Host devices to pass through to the containers of the application, each as HOST-PATH[:CONTAINER-PATH][:PERMISSIONS] where PERMISSIONS is a combination of r, w and m. Host devices must be allowed by the agent configuration of the device.
+	Devices *[]string `json:"devices,omitempty"`
+
+	// Ipc The IPC namespace of the containers of the application, either 'private', 'shareable' to allow other applications to join it, 'host' for the IPC namespace of the host, which must be allowed by the agent configuration of the device, or 'app:NAME' to join the shareable IPC namespace of the container application NAME. Defaults to the podman default.
+	Ipc *string `json:"ipc,omitempty"`
+
+	// Network The network the containers of the application join, either 'host' for the network of the host, 'none' for no network, or the name of a podman network. Networks other than 'none' must be allowed by the agent configuration of the device. Defaults to the default podman network.
+	Network *string `json:"network,omitempty"`
+}
+
 // ApplicationPort Port mapping in format "hostPort:containerPort" (e.g., "8080:80").
 type ApplicationPort = string
 
+// ApplicationProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+type ApplicationProbe struct {
+	// Exec Probes an application by running a command in one of its containers. Exiting with status 0 is a success.
+	Exec *ExecProbe `json:"exec,omitempty"`
+
+	// FailureAction Action taken by the agent when the probe fails. "Restart" restarts the containers of the application.
+	FailureAction *ApplicationProbeFailureAction `json:"failureAction,omitempty"`
+
+	// FailureThreshold Number of consecutive failed runs for the probe to be considered failed. Defaults to 3.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+
+	// HttpGet Probes an application with an HTTP GET request. Any status code from 200 to 399 is a success.
+	HttpGet *HttpGetProbe `json:"httpGet,omitempty"`
+
+	// InitialDelaySeconds Number of seconds after the application is started before the probe is first run. Defaults to 0.
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+
+	// PeriodSeconds Number of seconds between two runs of the probe. Defaults to 10.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+
+	// SuccessThreshold Number of consecutive successful runs for the probe to be considered successful after having failed. Defaults to 1.
+	SuccessThreshold *int `json:"successThreshold,omitempty"`
+
+	// TcpSocket Probes an application by opening a TCP connection. Connecting is a success.
+	TcpSocket *TcpSocketProbe `json:"tcpSocket,omitempty"`
+
+	// TimeoutSeconds Number of seconds after which a run of the probe times out. Defaults to 1.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+}
+
+// ApplicationProbeFailureAction Action taken by the agent when the probe fails. "Restart" restarts the containers of the application.
+type ApplicationProbeFailureAction string
+
+// ApplicationProbeResult Result of a health probe. "Unknown" until the probe has passed or failed its threshold.
+type ApplicationProbeResult string
+
+// ApplicationProbeStatus Result of a health probe of an application.
+type ApplicationProbeStatus struct {
+	// ConsecutiveFailures Number of consecutive failed runs of the probe.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+
+	// LastProbeTime Time of the last run of the probe.
+	LastProbeTime *time.Time `json:"lastProbeTime,omitempty"`
+
+	// Message Human readable details of the last failed run of the probe.
+	Message *string `json:"message,omitempty"`
+
+	// Result Result of a health probe. "Unknown" until the probe has passed or failed its threshold.
+	Result ApplicationProbeResult `json:"result"`
+}
+
+// ApplicationProbesStatus Results of the health probes of an application.
+type ApplicationProbesStatus struct {
+	// Liveness Result of a health probe of an application.
+	Liveness *ApplicationProbeStatus `json:"liveness,omitempty"`
+
+	// Readiness Result of a health probe of an application.
+	Readiness *ApplicationProbeStatus `json:"readiness,omitempty"`
+}
+
 // ApplicationProviderBase Common properties for all application types.
 type ApplicationProviderBase struct {
 	// AppType The type of the application.
@@ -622,6 +876,39 @@ type ApplicationResourceLimits struct {
 	Memory *string `json:"memory,omitempty"`
 }
 
+// ApplicationResourceMonitors defines model for ApplicationResourceMonitors.
+type ApplicationResourceMonitors struct {
+	// ResourceMonitors Monitors of the resource usage of the application. Only CPU and Memory monitors are supported. Usage percentages are relative to the capacity of the device, except for the memory of applications with memory limits, which is relative to their limits.
+	ResourceMonitors *[]ResourceMonitor `json:"resourceMonitors,omitempty"`
+}
+
+// ApplicationResourceStatus Status of the resources of an application, evaluated against its resource monitors.
+type ApplicationResourceStatus struct {
+	// Cpu The types of resource statuses.
+	Cpu DeviceResourceStatusType `json:"cpu"`
+
+	// Memory The types of resource statuses.
+	Memory DeviceResourceStatusType `json:"memory"`
+}
+
+// ApplicationResourceUsage Resource usage of an application, as last sampled by the agent.
+type ApplicationResourceUsage struct {
+	// CpuPercentage CPU usage of the application as a percentage of the CPU capacity of the device.
+	CpuPercentage float32 `json:"cpuPercentage"`
+
+	// LastSampleTime The time the usage was sampled.
+	LastSampleTime time.Time `json:"lastSampleTime"`
+
+	// MemoryBytes Memory used by the application in bytes.
+	MemoryBytes int64 `json:"memoryBytes"`
+
+	// MemoryPercentage Memory used by the application as a percentage of its memory limits, or of the memory of the device if it has none.
+	MemoryPercentage float32 `json:"memoryPercentage"`
+
+	// VolumeBytes Disk space used by the volumes of the application in bytes.
+	VolumeBytes *int64 `json:"volumeBytes,omitempty"`
+}
+
 // ApplicationResources Resource constraints for the application.
 type ApplicationResources struct {
 	// Limits Resource limits for the application.
@@ -668,6 +955,63 @@ type ApplicationVolumeStatus struct {
 // ApplicationsSummaryStatusType Status of all applications on the device.
 type ApplicationsSummaryStatusType string
 
+// AuditEvent AuditEvent records a user action on the API, such as a mutating API call or a console session.
+type AuditEvent struct {
+	// Actor The username of the identity that performed the action.
+	Actor string `json:"actor"`
+
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Outcome The outcome of the action recorded by an audit event.
+	Outcome AuditEventOutcome `json:"outcome"`
+
+	// RequestId The ID of the request that performed the action.
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Resource The API resource the action was performed on (e.g., "devices", "devices/console").
+	Resource string `json:"resource"`
+
+	// ResourceName The name of the resource the action was performed on, if any.
+	ResourceName *string `json:"resourceName,omitempty"`
+
+	// SourceIP The IP address the request originated from.
+	SourceIP *string `json:"sourceIP,omitempty"`
+
+	// StatusCode The HTTP status code of the response to the action.
+	StatusCode int32 `json:"statusCode"`
+
+	// Summary A summary of the changes requested by the action.
+	Summary *string `json:"summary,omitempty"`
+
+	// Verb The verb of the action (e.g., "create", "update", "patch", "delete", "connect").
+	Verb string `json:"verb"`
+}
+
+// AuditEventList AuditEventList is a list of AuditEvents.
+type AuditEventList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of AuditEvents.
+	Items []AuditEvent `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// AuditEventOutcome The outcome of the action recorded by an audit event.
+type AuditEventOutcome string
+
 // AuthConfig defines model for AuthConfig.
 type AuthConfig struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -827,6 +1171,127 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// CertificateApplicationSecretDestination CertificateApplicationSecretDestination delivers a certificate and its private key as the Podman secrets `<name>.crt` and `<name>.key` of the user the application runs as.
+type CertificateApplicationSecretDestination struct {
+	// Application The name of the application.
+	Application string `json:"application"`
+
+	// Name The prefix of the names of the secrets.
+	Name string `json:"name"`
+}
+
+// CertificateApplicationVolumeDestination CertificateApplicationVolumeDestination delivers a certificate and its private key as files in a volume of an application.
+type CertificateApplicationVolumeDestination struct {
+	// Application The name of the application.
+	Application string `json:"application"`
+
+	// CertFile The name of the file of the PEM encoded certificate chain in the volume. Defaults to `tls.crt`.
+	CertFile *string `json:"certFile,omitempty"`
+
+	// KeyFile The name of the file of the PEM encoded private key in the volume. Defaults to `tls.key`.
+	KeyFile *string `json:"keyFile,omitempty"`
+
+	// Volume The name of the volume of the application.
+	Volume string `json:"volume"`
+}
+
+// CertificateDestination CertificateDestination describes where the agent delivers a certificate and its private key. Exactly one destination must be specified.
+type CertificateDestination struct {
+	// ApplicationSecret CertificateApplicationSecretDestination delivers a certificate and its private key as the Podman secrets `<name>.crt` and `<name>.key` of the user the application runs as.
+	ApplicationSecret *CertificateApplicationSecretDestination `json:"applicationSecret,omitempty"`
+
+	// ApplicationVolume CertificateApplicationVolumeDestination delivers a certificate and its private key as files in a volume of an application.
+	ApplicationVolume *CertificateApplicationVolumeDestination `json:"applicationVolume,omitempty"`
+
+	// File CertificateFileDestination delivers a certificate and its private key as files on the device.
+	File *CertificateFileDestination `json:"file,omitempty"`
+}
+
+// CertificateFileDestination CertificateFileDestination delivers a certificate and its private key as files on the device.
+type CertificateFileDestination struct {
+	// CertPath The absolute path of the PEM encoded certificate chain.
+	CertPath string `json:"certPath"`
+
+	// KeyPath The absolute path of the PEM encoded private key.
+	KeyPath string `json:"keyPath"`
+}
+
+// CertificateIssuerSpec CertificateIssuerSpec describes the issuer of a certificate. Certificates are issued by the Flight Control CA if unset.
+type CertificateIssuerSpec struct {
+	// Acme AcmeIssuerSpec describes an ACME server that issues certificates after validating HTTP-01 challenges served by the agent.
+	Acme *AcmeIssuerSpec `json:"acme,omitempty"`
+
+	// Type The type of the issuer of a certificate. `flightctl` requests the certificate from the Flight Control CA, `acme` orders it from an ACME server.
+	Type CertificateIssuerType `json:"type"`
+}
+
+// CertificateIssuerType The type of the issuer of a certificate. `flightctl` requests the certificate from the Flight Control CA, `acme` orders it from an ACME server.
+type CertificateIssuerType string
+
+// CertificateReloadSpec CertificateReloadSpec describes the services that are reloaded after a certificate has been delivered.
+type CertificateReloadSpec struct {
+	// SystemdUnits The systemd units that are reloaded, or restarted if they do not support reloading.
+	SystemdUnits *[]string `json:"systemdUnits,omitempty"`
+}
+
+// CertificateRevocation CertificateRevocation records a certificate issued by the service's certificate authority that must no longer be accepted.
+type CertificateRevocation struct {
+	// DeviceName The name of the Device the revoked certificate was issued to, if any.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Issuer The key identifier of the certificate authority that issued the revoked certificate, in lowercase hexadecimal.
+	Issuer string `json:"issuer"`
+
+	// NotAfter The expiration time of the revoked certificate, if known. The revocation is no longer published once the certificate has expired.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+
+	// Reason The reason a certificate was revoked, as defined by RFC 5280.
+	Reason CertificateRevocationReason `json:"reason"`
+
+	// RevokedAt The time the certificate was revoked.
+	RevokedAt time.Time `json:"revokedAt"`
+
+	// SerialNumber The serial number of the revoked certificate, in lowercase hexadecimal.
+	SerialNumber string `json:"serialNumber"`
+}
+
+// CertificateRevocationList CertificateRevocationList is a list of CertificateRevocation.
+type CertificateRevocationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of CertificateRevocation.
+	Items []CertificateRevocation `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// CertificateRevocationReason The reason a certificate was revoked, as defined by RFC 5280.
+type CertificateRevocationReason string
+
+// CertificateRevocationRequest Request to revoke certificates issued by the service's certificate authority. Either deviceName or serialNumber must be provided.
+type CertificateRevocationRequest struct {
+	// DeviceName The name of the Device whose certificates are revoked. All the certificates issued to the Device are revoked.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Reason The reason a certificate was revoked, as defined by RFC 5280.
+	Reason *CertificateRevocationReason `json:"reason,omitempty"`
+
+	// SerialNumber The serial number of a single certificate to revoke, in hexadecimal, optionally separated by colons.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+	union        json.RawMessage
+}
+
+// CertificateRevocationRequest0 defines model for .
+type CertificateRevocationRequest0 = interface{}
+
+// CertificateRevocationRequest1 defines model for .
+type CertificateRevocationRequest1 = interface{}
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -898,12 +1363,24 @@ type ComposeApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// DependsOn Names of the applications of the device that must be ready before this application is started. An application is ready once it is running and its readiness probe, if any, succeeds, or once it has completed. Applications are stopped before the applications they depend on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// LivenessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// ReadinessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+
+	// ResourceMonitors Monitors of the resource usage of the application. Only CPU and Memory monitors are supported. Usage percentages are relative to the capacity of the device, except for the memory of applications with memory limits, which is relative to their limits.
+	ResourceMonitors *[]ResourceMonitor `json:"resourceMonitors,omitempty"`
+
 	// Volumes List of application volumes.
 	Volumes *[]ApplicationVolume `json:"volumes,omitempty"`
 	union   json.RawMessage
@@ -964,18 +1441,42 @@ type ContainerApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// Capabilities Linux capabilities to add to the containers of the application. Capabilities must be allowed by the agent configuration of the device.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+
+	// DependsOn Names of the applications of the device that must be ready before this application is started. An application is ready once it is running and its readiness probe, if any, succeeds, or once it has completed. Applications are stopped before the applications they depend on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Devices Host devices to pass through to the containers of the application, each as HOST-PATH[:CONTAINER-PATH][:PERMISSIONS] where PERMISSIONS is a combination of r, w and m. Host devices must be allowed by the agent configuration of the device.
+	Devices *[]string `json:"devices,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
 	// Image Reference to the image for this container.
 	Image string `json:"image"`
 
+	// Ipc The IPC namespace of the containers of the application, either 'private', 'shareable' to allow other applications to join it, 'host' for the IPC namespace of the host, which must be allowed by the agent configuration of the device, or 'app:NAME' to join the shareable IPC namespace of the container application NAME. Defaults to the podman default.
+	Ipc *string `json:"ipc,omitempty"`
+
+	// LivenessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// Network The network the containers of the application join, either 'host' for the network of the host, 'none' for no network, or the name of a podman network. Networks other than 'none' must be allowed by the agent configuration of the device. Defaults to the default podman network.
+	Network *string `json:"network,omitempty"`
+
 	// Ports Port mappings.
 	Ports *[]ApplicationPort `json:"ports,omitempty"`
 
+	// ReadinessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+
+	// ResourceMonitors Monitors of the resource usage of the application. Only CPU and Memory monitors are supported. Usage percentages are relative to the capacity of the device, except for the memory of applications with memory limits, which is relative to their limits.
+	ResourceMonitors *[]ResourceMonitor `json:"resourceMonitors,omitempty"`
+
 	// Resources Resource constraints for the application.
 	Resources *ApplicationResources `json:"resources,omitempty"`
 
@@ -1045,9 +1546,15 @@ type DeviceApplicationStatus struct {
 	// Name Human readable name of the application.
 	Name string `json:"name"`
 
+	// Probes Results of the health probes of an application.
+	Probes *ApplicationProbesStatus `json:"probes,omitempty"`
+
 	// Ready The number of containers which are ready in the application.
 	Ready string `json:"ready"`
 
+	// Resources Status of the resources of an application, evaluated against its resource monitors.
+	Resources *ApplicationResourceStatus `json:"resources,omitempty"`
+
 	// Restarts Number of restarts observed for the application.
 	Restarts int `json:"restarts"`
 
@@ -1057,8 +1564,14 @@ type DeviceApplicationStatus struct {
 	// Status Status of a single application on the device.
 	Status ApplicationStatusType `json:"status"`
 
+	// Usage Resource usage of an application, as last sampled by the agent.
+	Usage *ApplicationResourceUsage `json:"usage,omitempty"`
+
 	// Volumes Status of volumes used by this application.
 	Volumes *[]ApplicationVolumeStatus `json:"volumes,omitempty"`
+
+	// WaitingFor Names of the applications this application is waiting for to be ready before it is started.
+	WaitingFor *[]string `json:"waitingFor,omitempty"`
 }
 
 // DeviceApplicationsSummaryStatus A summary of the health of applications on the device.
@@ -1070,58 +1583,239 @@ type DeviceApplicationsSummaryStatus struct {
 	Status ApplicationsSummaryStatusType `json:"status"`
 }
 
-// DeviceConfigStatus Current status of the device config.
-type DeviceConfigStatus struct {
-	// RenderedVersion Rendered version of the device config.
-	RenderedVersion string `json:"renderedVersion"`
-}
+// DeviceBulkMetadataUpdate The changes to the labels and annotations of the devices of an UpdateMetadata operation. Annotations managed by the service cannot be changed.
+type DeviceBulkMetadataUpdate struct {
+	// RemoveAnnotations The keys of the annotations to remove from the devices.
+	RemoveAnnotations *[]string `json:"removeAnnotations,omitempty"`
 
-// DeviceConsole DeviceConsole represents the console connection information.
-type DeviceConsole struct {
-	// SessionID The session ID for the console connection.
-	SessionID string `json:"sessionID"`
+	// RemoveLabels The keys of the labels to remove from the devices.
+	RemoveLabels *[]string `json:"removeLabels,omitempty"`
 
-	// SessionMetadata Additional session metadata in the form of key=value pairs, can be used to initialize the type of terminal, console to be used, etc.
-	SessionMetadata string `json:"sessionMetadata"`
-}
+	// SetAnnotations The annotations to add to the devices, or to update if the devices already have them.
+	SetAnnotations *map[string]string `json:"setAnnotations,omitempty"`
 
-// DeviceDecommission Metadata about a device decommissioning request.
-type DeviceDecommission struct {
-	// Target Specifies the desired decommissioning method of the device.
-	Target DeviceDecommissionTargetType `json:"target"`
+	// SetLabels The labels to add to the devices, or to update if the devices already have them.
+	SetLabels *map[string]string `json:"setLabels,omitempty"`
 }
 
-// DeviceDecommissionTargetType Specifies the desired decommissioning method of the device.
-type DeviceDecommissionTargetType string
+// DeviceBulkOperation DeviceBulkOperation applies an operation to all the devices matching a label selector and/or field selector. The operation is executed asynchronously, in batches of devices, and reports its progress in its status.
+type DeviceBulkOperation struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
 
-// DeviceIntegrityCheckStatus DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
-type DeviceIntegrityCheckStatus struct {
-	// Info Human-readable information about the integrity check status.
-	Info *string `json:"info,omitempty"`
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
 
-	// Status Status of the integrity check performed on the device.
-	Status DeviceIntegrityCheckStatusType `json:"status"`
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec DeviceBulkOperationSpec describes the devices an operation applies to and the operation. At least one selector must be provided.
+	Spec DeviceBulkOperationSpec `json:"spec"`
+
+	// Status DeviceBulkOperationStatus reports the progress of an operation.
+	Status *DeviceBulkOperationStatus `json:"status,omitempty"`
 }
 
-// DeviceIntegrityCheckStatusType Status of the integrity check performed on the device.
-type DeviceIntegrityCheckStatusType string
+// DeviceBulkOperationFailure A device an operation failed for.
+type DeviceBulkOperationFailure struct {
+	// Device The name of the device.
+	Device string `json:"device"`
 
-// DeviceIntegrityStatus Summary status of the integrity of the device.
-type DeviceIntegrityStatus struct {
-	// DeviceIdentity DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
-	DeviceIdentity *DeviceIntegrityCheckStatus `json:"deviceIdentity,omitempty"`
+	// Message Why the operation failed for the device.
+	Message string `json:"message"`
+}
 
-	// Info Human readable information about the last integrity transition.
-	Info *string `json:"info,omitempty"`
+// DeviceBulkOperationList DeviceBulkOperationList is a list of DeviceBulkOperation.
+type DeviceBulkOperationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
 
-	// LastVerified Timestamp of the last integrity verification.
-	LastVerified *time.Time `json:"lastVerified,omitempty"`
+	// Items List of DeviceBulkOperation.
+	Items []DeviceBulkOperation `json:"items"`
 
-	// Status Status of the integrity of the device.
-	Status DeviceIntegrityStatusSummaryType `json:"status"`
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
 
-	// Tpm DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
-	Tpm *DeviceIntegrityCheckStatus `json:"tpm,omitempty"`
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// DeviceBulkOperationPhase The phase of an operation. An operation that was applied to all the matching devices is Completed if it succeeded for all of them, and Failed otherwise.
+type DeviceBulkOperationPhase string
+
+// DeviceBulkOperationProgressedDetails defines model for DeviceBulkOperationProgressedDetails.
+type DeviceBulkOperationProgressedDetails struct {
+	// Continue The position in the list of matching devices the next batch of the operation starts at.
+	Continue *string `json:"continue,omitempty"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType DeviceBulkOperationProgressedDetailsDetailType `json:"detailType"`
+
+	// FailedDevices The number of devices the operation failed for so far.
+	FailedDevices int64 `json:"failedDevices"`
+
+	// ProcessedDevices The number of devices the operation was applied to so far.
+	ProcessedDevices int64 `json:"processedDevices"`
+}
+
+// DeviceBulkOperationProgressedDetailsDetailType The type of detail for discriminator purposes.
+type DeviceBulkOperationProgressedDetailsDetailType string
+
+// DeviceBulkOperationSpec DeviceBulkOperationSpec describes the devices an operation applies to and the operation. At least one selector must be provided.
+type DeviceBulkOperationSpec struct {
+	// Decommission Metadata about a device decommissioning request.
+	Decommission *DeviceDecommission `json:"decommission,omitempty"`
+
+	// FieldSelector A selector to restrict the devices the operation applies to by their fields. Uses the same format as Kubernetes field selectors (e.g., "metadata.name=device1,status.updated.status=OutOfDate").
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+
+	// LabelSelector A selector to restrict the devices the operation applies to by their labels. Uses the same format as Kubernetes label selectors (e.g., "key1=value1,key2!=value2").
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Metadata The changes to the labels and annotations of the devices of an UpdateMetadata operation. Annotations managed by the service cannot be changed.
+	Metadata *DeviceBulkMetadataUpdate `json:"metadata,omitempty"`
+
+	// Operation The operation applied to the devices. UpdateMetadata sets and removes the labels and annotations described by the metadata field, Decommission decommissions the devices as described by the decommission field, Resume resumes the devices paused due to conflicts, and Rerender renders the devices again.
+	Operation DeviceBulkOperationType `json:"operation"`
+	union     json.RawMessage
+}
+
+// DeviceBulkOperationSpec0 defines model for .
+type DeviceBulkOperationSpec0 = interface{}
+
+// DeviceBulkOperationSpec1 defines model for .
+type DeviceBulkOperationSpec1 = interface{}
+
+// DeviceBulkOperationStatus DeviceBulkOperationStatus reports the progress of an operation.
+type DeviceBulkOperationStatus struct {
+	// CompletionTime The time the operation was applied to all the matching devices.
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+
+	// FailedDevices The number of devices the operation failed for.
+	FailedDevices int64 `json:"failedDevices"`
+
+	// Failures The devices the operation failed for and why. Only the first failures are recorded.
+	Failures *[]DeviceBulkOperationFailure `json:"failures,omitempty"`
+
+	// MatchedDevices The number of devices matching the selectors when the operation was created.
+	MatchedDevices int64 `json:"matchedDevices"`
+
+	// Message A human-readable summary of the result of the operation.
+	Message *string `json:"message,omitempty"`
+
+	// Phase The phase of an operation. An operation that was applied to all the matching devices is Completed if it succeeded for all of them, and Failed otherwise.
+	Phase DeviceBulkOperationPhase `json:"phase"`
+
+	// ProcessedDevices The number of devices the operation was applied to so far, including those it failed for.
+	ProcessedDevices int64 `json:"processedDevices"`
+
+	// ScopeLabelSelectors The label selectors of the role bindings that granted the operation to the user who created it. When set, the operation only applies to the devices matching any of them.
+	ScopeLabelSelectors *[]string `json:"scopeLabelSelectors,omitempty"`
+
+	// StartTime The time the operation started being applied to the devices.
+	StartTime *time.Time `json:"startTime,omitempty"`
+}
+
+// DeviceBulkOperationType The operation applied to the devices. UpdateMetadata sets and removes the labels and annotations described by the metadata field, Decommission decommissions the devices as described by the decommission field, Resume resumes the devices paused due to conflicts, and Rerender renders the devices again.
+type DeviceBulkOperationType string
+
+// DeviceCertificateSpec DeviceCertificateSpec describes a certificate the agent provisions, renews and delivers to the applications and services of the device. The common name, DNS names and IP addresses of certificates declared in fleet templates can contain parameters such as `{{ .metadata.name }}` or `{{ .metadata.labels.site }}`.
+type DeviceCertificateSpec struct {
+	// CommonName The common name of the certificate. Defaults to the first DNS name, or to the name of the certificate if no DNS names are specified.
+	CommonName *string `json:"commonName,omitempty"`
+
+	// Destination CertificateDestination describes where the agent delivers a certificate and its private key. Exactly one destination must be specified.
+	Destination CertificateDestination `json:"destination"`
+
+	// DnsNames The DNS names of the certificate.
+	DnsNames *[]string `json:"dnsNames,omitempty"`
+
+	// ExpirationSeconds The requested validity of certificates issued by the Flight Control CA, in seconds. The CA may issue certificates with a shorter validity.
+	ExpirationSeconds *int32 `json:"expirationSeconds,omitempty"`
+
+	// IpAddresses The IP addresses of the certificate.
+	IpAddresses *[]string `json:"ipAddresses,omitempty"`
+
+	// Issuer CertificateIssuerSpec describes the issuer of a certificate. Certificates are issued by the Flight Control CA if unset.
+	Issuer *CertificateIssuerSpec `json:"issuer,omitempty"`
+
+	// Name The unique name of the certificate.
+	Name string `json:"name"`
+
+	// Reload CertificateReloadSpec describes the services that are reloaded after a certificate has been delivered.
+	Reload *CertificateReloadSpec `json:"reload,omitempty"`
+
+	// RenewBefore How long before expiry the certificate is renewed, as a duration such as `720h`. Defaults to a third of the lifetime of the certificate.
+	RenewBefore *string `json:"renewBefore,omitempty"`
+}
+
+// DeviceCertificateStatus DeviceCertificateStatus describes the status of a certificate declared in the device spec.
+type DeviceCertificateStatus struct {
+	// Message The error of the last failed attempt to provision or deliver the certificate.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the certificate.
+	Name string `json:"name"`
+
+	// NotAfter The time at which the current certificate expires.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+
+	// NotBefore The time from which the current certificate is valid.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+}
+
+// DeviceConfigStatus Current status of the device config.
+type DeviceConfigStatus struct {
+	// RenderedVersion Rendered version of the device config.
+	RenderedVersion string `json:"renderedVersion"`
+}
+
+// DeviceConsole DeviceConsole represents the console connection information.
+type DeviceConsole struct {
+	// SessionID The session ID for the console connection.
+	SessionID string `json:"sessionID"`
+
+	// SessionMetadata Additional session metadata in the form of key=value pairs, can be used to initialize the type of terminal, console to be used, etc.
+	SessionMetadata string `json:"sessionMetadata"`
+}
+
+// DeviceDecommission Metadata about a device decommissioning request.
+type DeviceDecommission struct {
+	// Target Specifies the desired decommissioning method of the device.
+	Target DeviceDecommissionTargetType `json:"target"`
+}
+
+// DeviceDecommissionTargetType Specifies the desired decommissioning method of the device.
+type DeviceDecommissionTargetType string
+
+// DeviceIntegrityCheckStatus DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
+type DeviceIntegrityCheckStatus struct {
+	// Info Human-readable information about the integrity check status.
+	Info *string `json:"info,omitempty"`
+
+	// Status Status of the integrity check performed on the device.
+	Status DeviceIntegrityCheckStatusType `json:"status"`
+}
+
+// DeviceIntegrityCheckStatusType Status of the integrity check performed on the device.
+type DeviceIntegrityCheckStatusType string
+
+// DeviceIntegrityStatus Summary status of the integrity of the device.
+type DeviceIntegrityStatus struct {
+	// DeviceIdentity DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
+	DeviceIdentity *DeviceIntegrityCheckStatus `json:"deviceIdentity,omitempty"`
+
+	// Info Human readable information about the last integrity transition.
+	Info *string `json:"info,omitempty"`
+
+	// LastVerified Timestamp of the last integrity verification.
+	LastVerified *time.Time `json:"lastVerified,omitempty"`
+
+	// Status Status of the integrity of the device.
+	Status DeviceIntegrityStatusSummaryType `json:"status"`
+
+	// Tpm DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
+	Tpm *DeviceIntegrityCheckStatus `json:"tpm,omitempty"`
 }
 
 // DeviceIntegrityStatusSummaryType Status of the integrity of the device.
@@ -1271,6 +1965,9 @@ type DeviceSpec struct {
 	// Applications List of application providers.
 	Applications *[]ApplicationProviderSpec `json:"applications,omitempty"`
 
+	// Certificates List of certificates the agent provisions for the applications and services of the device.
+	Certificates *[]DeviceCertificateSpec `json:"certificates,omitempty"`
+
 	// Config List of config providers.
 	Config *[]ConfigProviderSpec `json:"config,omitempty"`
 
@@ -1292,6 +1989,9 @@ type DeviceSpec struct {
 		MatchPatterns *[]string `json:"matchPatterns,omitempty"`
 	} `json:"systemd,omitempty"`
 
+	// Telemetry DeviceTelemetrySpec configures the metrics and logs the agent forwards to the telemetry gateway. Telemetry is only forwarded by agents configured with the endpoint of the telemetry gateway.
+	Telemetry *DeviceTelemetrySpec `json:"telemetry,omitempty"`
+
 	// UpdatePolicy Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 	UpdatePolicy *DeviceUpdatePolicySpec `json:"updatePolicy,omitempty"`
 }
@@ -1304,6 +2004,9 @@ type DeviceStatus struct {
 	// ApplicationsSummary A summary of the health of applications on the device.
 	ApplicationsSummary DeviceApplicationsSummaryStatus `json:"applicationsSummary"`
 
+	// Certificates List of the statuses of the certificates declared in the device spec.
+	Certificates *[]DeviceCertificateStatus `json:"certificates,omitempty"`
+
 	// Conditions Conditions represent the observations of a the current state of a device.
 	Conditions []Condition `json:"conditions"`
 
@@ -1364,11 +2067,29 @@ type DeviceSystemInfo struct {
 	// CustomInfo User-defined information about the device.
 	CustomInfo *CustomDeviceInfo `json:"customInfo,omitempty"`
 
+	// Extensions Structured system information reported by the system info collectors of the agent, keyed by collector name. Fields can be queried with field selectors such as status.systemInfo.extensions.secureBoot.enabled.
+	Extensions *DeviceSystemInfoExtensions `json:"extensions,omitempty"`
+
 	// OperatingSystem The Operating System reported by the device.
 	OperatingSystem      string            `json:"operatingSystem"`
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// DeviceSystemInfoExtensions Structured system information reported by the system info collectors of the agent, keyed by collector name. Fields can be queried with field selectors such as status.systemInfo.extensions.secureBoot.enabled.
+type DeviceSystemInfoExtensions map[string]map[string]interface{}
+
+// DeviceTelemetrySpec DeviceTelemetrySpec configures the metrics and logs the agent forwards to the telemetry gateway. Telemetry is only forwarded by agents configured with the endpoint of the telemetry gateway.
+type DeviceTelemetrySpec struct {
+	// Logs TelemetryLogsSpec configures the logs the agent forwards to the telemetry gateway.
+	Logs *TelemetryLogsSpec `json:"logs,omitempty"`
+
+	// MaxUploadRate The maximum average rate at which the agent uploads telemetry, in bytes per second, followed by an optional unit - `k` (kibibytes), `m` (mebibytes) or `g` (gibibytes). The upload rate is not limited if unset.
+	MaxUploadRate *string `json:"maxUploadRate,omitempty"`
+
+	// Metrics TelemetryMetricsSpec configures the metrics the agent forwards to the telemetry gateway.
+	Metrics *TelemetryMetricsSpec `json:"metrics,omitempty"`
+}
+
 // DeviceUpdatePolicySpec Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 type DeviceUpdatePolicySpec struct {
 	// DownloadSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
@@ -1450,6 +2171,69 @@ type Duration = string
 // EncodingType Specifies the encoding type used for data representation.
 type EncodingType string
 
+// EnrollmentApprovalAllowList EnrollmentApprovalAllowList restricts approval to the devices whose hardware is listed. A device must match every non-empty list. It requires tpmAttested, so that the product serial number and name signed by the TPM are matched.
+type EnrollmentApprovalAllowList struct {
+	// ProductNames The product names of the allowed devices.
+	ProductNames *[]string `json:"productNames,omitempty"`
+
+	// SerialNumbers The product serial numbers of the allowed devices.
+	SerialNumbers *[]string `json:"serialNumbers,omitempty"`
+}
+
+// EnrollmentApprovalPolicy EnrollmentApprovalPolicy automatically approves the enrollment requests of its organization that match its conditions.
+type EnrollmentApprovalPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentApprovalPolicySpec describes the enrollment requests approved by a policy and the labels applied to their devices.
+	Spec EnrollmentApprovalPolicySpec `json:"spec"`
+}
+
+// EnrollmentApprovalPolicyList EnrollmentApprovalPolicyList is a list of EnrollmentApprovalPolicy.
+type EnrollmentApprovalPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of EnrollmentApprovalPolicy.
+	Items []EnrollmentApprovalPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EnrollmentApprovalPolicyMatch EnrollmentApprovalPolicyMatch holds the conditions an enrollment request must meet to be approved. All the specified conditions must be met, and at least one condition must be specified. As the values matched by allowList and labelSelector are reported by the device, they require tpmAttested.
+type EnrollmentApprovalPolicyMatch struct {
+	// AllowList EnrollmentApprovalAllowList restricts approval to the devices whose hardware is listed. A device must match every non-empty list. It requires tpmAttested, so that the product serial number and name signed by the TPM are matched.
+	AllowList *EnrollmentApprovalAllowList `json:"allowList,omitempty"`
+
+	// EnrollmentCredentials Requires the enrollment request to be submitted with one of the enrollment credentials of the given names, which are the names of the certificate signing requests that issued the enrollment certificates.
+	EnrollmentCredentials *[]string `json:"enrollmentCredentials,omitempty"`
+
+	// LabelSelector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+
+	// TpmAttested Requires the TPM of the device to be verified, that is its endorsement key certificate chains to a trusted manufacturer CA and the device passed the credential activation challenge.
+	TpmAttested *bool `json:"tpmAttested,omitempty"`
+}
+
+// EnrollmentApprovalPolicySpec EnrollmentApprovalPolicySpec describes the enrollment requests approved by a policy and the labels applied to their devices.
+type EnrollmentApprovalPolicySpec struct {
+	// Labels A set of labels to apply to the devices of the approved enrollment requests. They take precedence over the labels requested by the devices.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Match EnrollmentApprovalPolicyMatch holds the conditions an enrollment request must meet to be approved. All the specified conditions must be met, and at least one condition must be specified. As the values matched by allowList and labelSelector are reported by the device, they require tpmAttested.
+	Match EnrollmentApprovalPolicyMatch `json:"match"`
+}
+
 // EnrollmentConfig defines model for EnrollmentConfig.
 type EnrollmentConfig struct {
 	// EnrollmentService EnrollmentService contains information about how to communicate with a Flight Control enrollment service.
@@ -1635,6 +2419,15 @@ type EventSource struct {
 	Component string `json:"component"`
 }
 
+// ExecProbe Probes an application by running a command in one of its containers. Exiting with status 0 is a success.
+type ExecProbe struct {
+	// Command The command and its arguments.
+	Command []string `json:"command"`
+
+	// Container Name of the container to run the command in. May be omitted if the application has a single container.
+	Container *string `json:"container,omitempty"`
+}
+
 // FileContent The content of a file.
 type FileContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
@@ -1698,6 +2491,12 @@ type Fleet struct {
 	Status *FleetStatus `json:"status,omitempty"`
 }
 
+// FleetFieldSelector FleetFieldSelector restricts the devices matched by the label selector of a fleet to those whose status fields have the given values. It is ANDed with the label selector and has no effect without it.
+type FleetFieldSelector struct {
+	// MatchFields A map of {field,value} pairs. Supported fields are status.systemInfo.architecture, status.systemInfo.operatingSystem, status.systemInfo.agentVersion, status.systemInfo.productName, status.systemInfo.distroName, status.systemInfo.distroVersion, status.systemInfo.kernel, status.systemInfo.cpuModel, status.systemInfo.biosVendor, status.systemInfo.biosVersion and status.systemInfo.customInfo.<key>.
+	MatchFields map[string]string `json:"matchFields"`
+}
+
 // FleetList FleetList is a list of Fleets.
 type FleetList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -1823,6 +2622,9 @@ type FleetRolloutStatus struct {
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
 type FleetSpec struct {
+	// FieldSelector FleetFieldSelector restricts the devices matched by the label selector of a fleet to those whose status fields have the given values. It is ANDed with the label selector and has no effect without it.
+	FieldSelector *FleetFieldSelector `json:"fieldSelector,omitempty"`
+
 	// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 	RolloutPolicy *RolloutPolicy `json:"rolloutPolicy,omitempty"`
 
@@ -1913,6 +2715,9 @@ type HelmApplication struct {
 	// Namespace The target namespace for the application deployment.
 	Namespace *string `json:"namespace,omitempty"`
 
+	// ResourceMonitors Monitors of the resource usage of the application. Only CPU and Memory monitors are supported. Usage percentages are relative to the capacity of the device, except for the memory of applications with memory limits, which is relative to their limits.
+	ResourceMonitors *[]ResourceMonitor `json:"resourceMonitors,omitempty"`
+
 	// Values Configuration values for the application. Supports arbitrarily nested structures.
 	Values *map[string]interface{} `json:"values,omitempty"`
 
@@ -2001,6 +2806,24 @@ type HttpConfigProviderSpec struct {
 	Name string `json:"name"`
 }
 
+// HttpGetProbe Probes an application with an HTTP GET request. Any status code from 200 to 399 is a success.
+type HttpGetProbe struct {
+	// Host Host to connect to. Defaults to localhost.
+	Host *string `json:"host,omitempty"`
+
+	// Path Path of the request. Defaults to "/".
+	Path *string `json:"path,omitempty"`
+
+	// Port Port the application listens on, as published on the device.
+	Port int `json:"port"`
+
+	// Scheme Scheme of the request. The server certificate is not verified for https.
+	Scheme *HttpGetProbeScheme `json:"scheme,omitempty"`
+}
+
+// HttpGetProbeScheme Scheme of the request. The server certificate is not verified for https.
+type HttpGetProbeScheme string
+
 // HttpRepoSpec HTTP endpoint specification for fetching configuration.
 type HttpRepoSpec struct {
 	// HttpConfig Configuration for HTTP transport.
@@ -2365,6 +3188,39 @@ type OciAuth struct {
 // OciAuthType The type of authentication for OCI registries.
 type OciAuthType string
 
+// OciConfigProviderSpec defines model for OciConfigProviderSpec.
+type OciConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// OciRef The reference to an OCI artifact holding configuration files.
+	OciRef struct {
+		// Artifact The name of the artifact within the registry (e.g., "myorg/config-bundle").
+		Artifact string `json:"artifact"`
+
+		// Group The files' group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// Layers The titles (the "org.opencontainers.image.title" annotation) of the layers to extract. If not specified, all layers are extracted.
+		Layers *[]string `json:"layers,omitempty"`
+
+		// MountPath Path in the device's file system under which the artifact's files are written.
+		MountPath string `json:"mountPath"`
+
+		// Paths The files or directories within the artifact to write to the device, relative to the artifact's root. If not specified, all files are written.
+		Paths *[]string `json:"paths,omitempty"`
+
+		// Reference The tag or digest of the artifact. Tags are resolved to a digest when rendering, and devices are rendered again when the tag moves.
+		Reference string `json:"reference"`
+
+		// Repository The name of the OCI repository resource hosting the artifact.
+		Repository string `json:"repository"`
+
+		// User The files' owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"ociRef"`
+}
+
 // OciRepoSpec OCI container registry specification.
 type OciRepoSpec struct {
 	// AccessMode Access mode for the registry: "Read" for read-only (pull), "ReadWrite" for read-write (pull and push).
@@ -2517,12 +3373,36 @@ type QuadletApplication struct {
 	// AppType The type of the application.
 	AppType AppType `json:"appType"`
 
+	// Capabilities Linux capabilities to add to the containers of the application. Capabilities must be allowed by the agent configuration of the device.
+	Capabilities *[]string `json:"capabilities,omitempty"`
+
+	// DependsOn Names of the applications of the device that must be ready before this application is started. An application is ready once it is running and its readiness probe, if any, succeeds, or once it has completed. Applications are stopped before the applications they depend on.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Devices Host devices to pass through to the containers of the application, each as HOST-PATH[:CONTAINER-PATH][:PERMISSIONS] where PERMISSIONS is a combination of r, w and m. Host devices must be allowed by the agent configuration of the device.
+	Devices *[]string `json:"devices,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// Ipc The IPC namespace of the containers of the application, either 'private', 'shareable' to allow other applications to join it, 'host' for the IPC namespace of the host, which must be allowed by the agent configuration of the device, or 'app:NAME' to join the shareable IPC namespace of the container application NAME. Defaults to the podman default.
+	Ipc *string `json:"ipc,omitempty"`
+
+	// LivenessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	LivenessProbe *ApplicationProbe `json:"livenessProbe,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// Network The network the containers of the application join, either 'host' for the network of the host, 'none' for no network, or the name of a podman network. Networks other than 'none' must be allowed by the agent configuration of the device. Defaults to the default podman network.
+	Network *string `json:"network,omitempty"`
+
+	// ReadinessProbe A health check the agent periodically runs against an application. Exactly one of httpGet, tcpSocket and exec must be set.
+	ReadinessProbe *ApplicationProbe `json:"readinessProbe,omitempty"`
+
+	// ResourceMonitors Monitors of the resource usage of the application. Only CPU and Memory monitors are supported. Usage percentages are relative to the capacity of the device, except for the memory of applications with memory limits, which is relative to their limits.
+	ResourceMonitors *[]ResourceMonitor `json:"resourceMonitors,omitempty"`
+
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
 	RunAs Username `json:"runAs,omitempty"`
 
@@ -2585,6 +3465,21 @@ type RepositoryList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// RepositoryPushReceivedDetails defines model for RepositoryPushReceivedDetails.
+type RepositoryPushReceivedDetails struct {
+	// Commit The commit hash the reference points to after the push.
+	Commit *string `json:"commit,omitempty"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType RepositoryPushReceivedDetailsDetailType `json:"detailType"`
+
+	// Ref The git reference that was pushed, such as refs/heads/main or refs/tags/v1.0.
+	Ref string `json:"ref"`
+}
+
+// RepositoryPushReceivedDetailsDetailType The type of detail for discriminator purposes.
+type RepositoryPushReceivedDetailsDetailType string
+
 // RepositorySpec RepositorySpec describes a configuration repository.
 type RepositorySpec struct {
 	union json.RawMessage
@@ -2682,9 +3577,36 @@ type ResourceSyncList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// ResourceSyncPlan ResourceSyncPlan lists the changes required to sync the resources of a ResourceSync in dry-run mode.
+type ResourceSyncPlan struct {
+	// Creates The resources that would be created.
+	Creates []ResourceSyncPlannedChange `json:"creates"`
+
+	// Deletes The resources that would be deleted, or for Devices, whose managed labels and annotations would be removed.
+	Deletes []ResourceSyncPlannedChange `json:"deletes"`
+
+	// Updates The resources that would be updated.
+	Updates []ResourceSyncPlannedChange `json:"updates"`
+}
+
+// ResourceSyncPlannedChange A resource affected by a planned change.
+type ResourceSyncPlannedChange struct {
+	// Kind The kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name The name of the resource. For CatalogItems, the name is prefixed with the name of the catalog (e.g., "catalog/item").
+	Name string `json:"name"`
+}
+
 // ResourceSyncSpec ResourceSyncSpec describes the file(s) to sync from a repository.
 type ResourceSyncSpec struct {
-	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
+	// DryRun If true, the changes required to sync the resources are computed and reported in the status, but not applied.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Kinds The kinds of resources this ResourceSync manages. Resources of other kinds that the ResourceSync may manage found in the path are skipped, and resources of kinds it cannot manage fail the sync. For a ResourceSync of type fleet, the kinds may be any of Fleet, Repository, SecretStore, AuthProvider, EnrollmentApprovalPolicy and Device, and default to Fleet. For a ResourceSync of type catalog, the kinds may be any of Catalog and CatalogItem, and default to both. For Devices, only the labels and annotations of existing devices are managed.
+	Kinds *[]string `json:"kinds,omitempty"`
+
+	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources. If the directory contains a kustomization.yaml file, the resources are rendered from the bases and patches it lists instead.
 	Path string `json:"path"`
 
 	// Repository The name of the repository resource to use as the sync source.
@@ -2707,6 +3629,12 @@ type ResourceSyncStatus struct {
 
 	// ObservedGeneration The last generation that was synced.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Plan ResourceSyncPlan lists the changes required to sync the resources of a ResourceSync in dry-run mode.
+	Plan *ResourceSyncPlan `json:"plan,omitempty"`
+
+	// RenderedResources The resources rendered from the kustomization file at the path of the ResourceSync, as a multi-document YAML, for debugging. Sensitive fields, such as the credentials of Repositories, SecretStores and AuthProviders, are masked. Only set if the path contains a kustomization file. Truncated if longer than 64KiB.
+	RenderedResources *string `json:"renderedResources,omitempty"`
 }
 
 // ResourceSyncType The type of resources this ResourceSync manages. Defaults to fleet if not specified.
@@ -2745,64 +3673,378 @@ type Rfc7662IntrospectionSpec struct {
 // Rfc7662IntrospectionSpecType The introspection type.
 type Rfc7662IntrospectionSpecType string
 
-// RolloutDeviceSelection Describes how to select devices for rollout.
-type RolloutDeviceSelection struct {
-	union json.RawMessage
-}
-
-// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
-type RolloutPolicy struct {
-	// DefaultUpdateTimeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
-	DefaultUpdateTimeout *Duration `json:"defaultUpdateTimeout,omitempty"`
+// Role Role is a custom role of an organization that grants operations on resources. It is granted to users by RoleBindings, or directly by an identity provider assigning a role of the same name.
+type Role struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
 
-	// DeviceSelection Describes how to select devices for rollout.
-	DeviceSelection *RolloutDeviceSelection `json:"deviceSelection,omitempty"`
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
 
-	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
-	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
 
-	// SuccessThreshold Percentage is the string format representing percentage string.
-	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+	// Spec RoleSpec describes the permissions granted by a role.
+	Spec RoleSpec `json:"spec"`
 }
 
-// RolloutStrategy The strategy of choice for device selection in rollout policy.
-type RolloutStrategy string
+// RoleBinding RoleBinding grants a custom role of its organization to users and groups, optionally restricted to the devices matching a label selector.
+type RoleBinding struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
 
-// SshConfig Configuration for SSH transport.
-type SshConfig struct {
-	// PrivateKeyPassphrase The passphrase for sshPrivateKey.
-	PrivateKeyPassphrase *string `json:"privateKeyPassphrase,omitempty"`
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
 
-	// SkipServerVerification Skip remote server verification.
-	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
 
-	// SshPrivateKey Base64 encoded private SSH key.
-	SshPrivateKey *string `json:"sshPrivateKey,omitempty"`
+	// Spec RoleBindingSpec describes the role granted by a binding, to whom, and on which devices.
+	Spec RoleBindingSpec `json:"spec"`
 }
 
-// Status Status is a return value for calls that don't return other objects.
-type Status struct {
+// RoleBindingList RoleBindingList is a list of RoleBinding.
+type RoleBindingList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
 	ApiVersion ApiVersion `json:"apiVersion"`
 
-	// Code Suggested HTTP return code for this status, 0 if not set.
-	Code int32 `json:"code"`
+	// Items List of RoleBinding.
+	Items []RoleBinding `json:"items"`
 
 	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
 	Kind string `json:"kind"`
 
-	// Message A human-readable description of the status of this operation.
-	Message string `json:"message"`
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
 
-	// Reason A machine-readable description of why this operation is in the "Failure" status. If this value is empty there is no information available. A Reason clarifies an HTTP status code but does not override it.
-	Reason string `json:"reason"`
+// RoleBindingSpec RoleBindingSpec describes the role granted by a binding, to whom, and on which devices.
+type RoleBindingSpec struct {
+	// LabelSelector A label selector restricting the binding to the devices whose labels match it (e.g., "site=north"). A restricted binding only grants the "get", "list" and "patch" operations on the "devices" resource; the other operations of the role are not granted.
+	LabelSelector *string `json:"labelSelector,omitempty"`
 
-	// Status Status of the operation. One of: "Success" or "Failure". More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status.
-	Status string `json:"status"`
+	// RoleRef The name of the Role granted by the binding.
+	RoleRef string `json:"roleRef"`
+
+	// Subjects The users and groups the role is granted to.
+	Subjects []RoleBindingSubject `json:"subjects"`
 }
 
-// SystemdActiveStateType The high-level unit activation state.
-type SystemdActiveStateType string
+// RoleBindingSubject RoleBindingSubject identifies a user or group a role is granted to.
+type RoleBindingSubject struct {
+	// Kind The kind of the subject. A User subject matches the username of the caller. A Group subject matches a role or group assigned to the caller in the organization by its identity provider.
+	Kind RoleBindingSubjectKind `json:"kind"`
+
+	// Name The name of the user or group.
+	Name string `json:"name"`
+}
+
+// RoleBindingSubjectKind The kind of the subject. A User subject matches the username of the caller. A Group subject matches a role or group assigned to the caller in the organization by its identity provider.
+type RoleBindingSubjectKind string
+
+// RoleList RoleList is a list of Role.
+type RoleList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of Role.
+	Items []Role `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// RoleRule RoleRule grants operations on resources.
+type RoleRule struct {
+	// Resources The resources the rule applies to, such as "devices", "fleets" or "devices/console". The value "*" matches all resources.
+	Resources []string `json:"resources"`
+
+	// Verbs The operations granted on the resources, which are "get", "list", "create", "update", "patch" and "delete". The value "*" grants all operations.
+	Verbs []string `json:"verbs"`
+}
+
+// RoleSpec RoleSpec describes the permissions granted by a role.
+type RoleSpec struct {
+	// Rules The rules of the role. A role grants the union of the operations granted by its rules.
+	Rules []RoleRule `json:"rules"`
+}
+
+// RolloutDeviceSelection Describes how to select devices for rollout.
+type RolloutDeviceSelection struct {
+	union json.RawMessage
+}
+
+// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
+type RolloutPolicy struct {
+	// DefaultUpdateTimeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	DefaultUpdateTimeout *Duration `json:"defaultUpdateTimeout,omitempty"`
+
+	// DeviceSelection Describes how to select devices for rollout.
+	DeviceSelection *RolloutDeviceSelection `json:"deviceSelection,omitempty"`
+
+	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
+	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
+
+	// SuccessThreshold Percentage is the string format representing percentage string.
+	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+}
+
+// RolloutStrategy The strategy of choice for device selection in rollout policy.
+type RolloutStrategy string
+
+// SecretConfigProviderSpec defines model for SecretConfigProviderSpec.
+type SecretConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// SecretStoreRef The reference to a secret in a SecretStore.
+	SecretStoreRef struct {
+		// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// Keys The keys of the secret to write to the device. If not specified, all keys are written.
+		Keys *[]string `json:"keys,omitempty"`
+
+		// Mode The permission mode of the files written to the device. If not specified, defaults to 0600.
+		Mode *int `json:"mode,omitempty"`
+
+		// MountPath Path in the device's file system at which the secret should be mounted, with one file per key.
+		MountPath string `json:"mountPath"`
+
+		// Path The path of the secret within the store's key/value secrets engine. May contain template parameters to select a per-device secret.
+		Path string `json:"path"`
+
+		// SecretStore The name of the SecretStore resource.
+		SecretStore string `json:"secretStore"`
+
+		// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"secretStoreRef"`
+}
+
+// SecretStore SecretStore represents a HashiCorp Vault or OpenBao compatible key/value secrets store.
+type SecretStore struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec SecretStoreSpec describes how to connect and authenticate to a key/value secrets store.
+	Spec SecretStoreSpec `json:"spec"`
+
+	// Status SecretStoreStatus represents information about the status of a secrets store.
+	Status *SecretStoreStatus `json:"status,omitempty"`
+}
+
+// SecretStoreAuth Authentication for secrets stores.
+type SecretStoreAuth struct {
+	union json.RawMessage
+}
+
+// SecretStoreAuthMethod The method used to authenticate to a secrets store.
+type SecretStoreAuthMethod string
+
+// SecretStoreList SecretStoreList is a list of SecretStores.
+type SecretStoreList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of secrets stores.
+	Items []SecretStore `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// SecretStoreSpec SecretStoreSpec describes how to connect and authenticate to a key/value secrets store.
+type SecretStoreSpec struct {
+	// Address The address of the secrets store server (e.g., https://vault.example.com:8200).
+	Address string `json:"address"`
+
+	// Auth Authentication for secrets stores.
+	Auth SecretStoreAuth `json:"auth"`
+
+	// KvVersion The version of the key/value secrets engine. Only version 2 supports detecting secret changes.
+	KvVersion *SecretStoreSpecKvVersion `json:"kvVersion,omitempty"`
+
+	// MountPath The path at which the key/value secrets engine is mounted.
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// Namespace The namespace to send requests to, for servers that support namespaces.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Tls TLS configuration for connecting to a secrets store.
+	Tls *SecretStoreTlsConfig `json:"tls,omitempty"`
+}
+
+// SecretStoreSpecKvVersion The version of the key/value secrets engine. Only version 2 supports detecting secret changes.
+type SecretStoreSpecKvVersion int
+
+// SecretStoreStatus SecretStoreStatus represents information about the status of a secrets store.
+type SecretStoreStatus struct {
+	// Conditions Current state of the secrets store.
+	Conditions []Condition `json:"conditions"`
+}
+
+// SecretStoreTlsConfig TLS configuration for connecting to a secrets store.
+type SecretStoreTlsConfig struct {
+	// CaCrt Base64 encoded root CA.
+	CaCrt *string `json:"ca.crt,omitempty"`
+
+	// SkipServerVerification Skip remote server verification.
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+}
+
+// ServiceAccount ServiceAccount is a non-human identity of an organization that authenticates with API tokens, for use by automation.
+type ServiceAccount struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec ServiceAccountSpec describes the roles granted to a service account.
+	Spec ServiceAccountSpec `json:"spec"`
+}
+
+// ServiceAccountList ServiceAccountList is a list of ServiceAccount.
+type ServiceAccountList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of ServiceAccount.
+	Items []ServiceAccount `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// ServiceAccountSpec ServiceAccountSpec describes the roles granted to a service account.
+type ServiceAccountSpec struct {
+	// Description A human-readable description of what the service account is used for.
+	Description *string `json:"description,omitempty"`
+
+	// Roles The roles granted to the service account in its organization, such as "flightctl-operator" or the name of a custom Role.
+	Roles []string `json:"roles"`
+}
+
+// ServiceAccountToken ServiceAccountToken is an expiring API token a service account authenticates with.
+type ServiceAccountToken struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec ServiceAccountTokenSpec describes the lifetime and scope of an API token.
+	Spec ServiceAccountTokenSpec `json:"spec"`
+
+	// Status ServiceAccountTokenStatus reports the usage of an API token.
+	Status *ServiceAccountTokenStatus `json:"status,omitempty"`
+
+	// Token The secret API token. It is only returned when the token is created and cannot be retrieved afterwards.
+	Token *string `json:"token,omitempty"`
+}
+
+// ServiceAccountTokenList ServiceAccountTokenList is a list of ServiceAccountToken.
+type ServiceAccountTokenList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of ServiceAccountToken.
+	Items []ServiceAccountToken `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// ServiceAccountTokenSpec ServiceAccountTokenSpec describes the lifetime and scope of an API token.
+type ServiceAccountTokenSpec struct {
+	// ExpirationTimestamp The time the token expires at. Defaults to 90 days after the creation of the token, and cannot be more than one year after it.
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+
+	// Roles Restricts the token to a subset of the roles of its service account. Defaults to all the roles of the service account.
+	Roles *[]string `json:"roles,omitempty"`
+}
+
+// ServiceAccountTokenStatus ServiceAccountTokenStatus reports the usage of an API token.
+type ServiceAccountTokenStatus struct {
+	// LastUsedTimestamp The last time the token authenticated a request, with a precision of one minute.
+	LastUsedTimestamp *time.Time `json:"lastUsedTimestamp,omitempty"`
+}
+
+// SshConfig Configuration for SSH transport.
+type SshConfig struct {
+	// PrivateKeyPassphrase The passphrase for sshPrivateKey.
+	PrivateKeyPassphrase *string `json:"privateKeyPassphrase,omitempty"`
+
+	// SkipServerVerification Skip remote server verification.
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+
+	// SshPrivateKey Base64 encoded private SSH key.
+	SshPrivateKey *string `json:"sshPrivateKey,omitempty"`
+}
+
+// Status Status is a return value for calls that don't return other objects.
+type Status struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Code Suggested HTTP return code for this status, 0 if not set.
+	Code int32 `json:"code"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Message A human-readable description of the status of this operation.
+	Message string `json:"message"`
+
+	// Reason A machine-readable description of why this operation is in the "Failure" status. If this value is empty there is no information available. A Reason clarifies an HTTP status code but does not override it.
+	Reason string `json:"reason"`
+
+	// Status Status of the operation. One of: "Success" or "Failure". More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status.
+	Status string `json:"status"`
+}
+
+// SystemdActiveStateType The high-level unit activation state.
+type SystemdActiveStateType string
+
+// SystemdApplication defines model for SystemdApplication.
+type SystemdApplication struct {
+	// AppType The type of the application.
+	AppType AppType `json:"appType"`
+
+	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
+	EnvVars *map[string]string `json:"envVars,omitempty"`
+
+	// Image Reference to the OCI image or artifact containing the binaries and systemd unit files of the application.
+	Image string `json:"image"`
+
+	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
+	Name *string `json:"name,omitempty"`
+}
 
 // SystemdEnableStateType The enable state of the unit file.
 type SystemdEnableStateType string
@@ -2831,6 +4073,45 @@ type SystemdUnitStatus struct {
 	Unit string `json:"unit"`
 }
 
+// TcpSocketProbe Probes an application by opening a TCP connection. Connecting is a success.
+type TcpSocketProbe struct {
+	// Host Host to connect to. Defaults to localhost.
+	Host *string `json:"host,omitempty"`
+
+	// Port Port the application listens on, as published on the device.
+	Port int `json:"port"`
+}
+
+// TelemetryLogPriority The lowest priority of the log entries to forward. Defaults to `info`.
+type TelemetryLogPriority string
+
+// TelemetryLogsSource A source of logs. `journal` forwards the entries of the system journal, `applications` forwards the logs of the containers of applications.
+type TelemetryLogsSource string
+
+// TelemetryLogsSpec TelemetryLogsSpec configures the logs the agent forwards to the telemetry gateway.
+type TelemetryLogsSpec struct {
+	// Priority The lowest priority of the log entries to forward. Defaults to `info`.
+	Priority *TelemetryLogPriority `json:"priority,omitempty"`
+
+	// Sources The sources of the logs to forward.
+	Sources []TelemetryLogsSource `json:"sources"`
+
+	// Units The systemd units whose journal entries are forwarded. All journal entries are forwarded if unset. Does not apply to the logs of applications.
+	Units *[]string `json:"units,omitempty"`
+}
+
+// TelemetryMetricsSource A source of metrics. `host` forwards the CPU, memory, filesystem and network usage of the device, `applications` forwards the resource usage and restarts of the applications of the device.
+type TelemetryMetricsSource string
+
+// TelemetryMetricsSpec TelemetryMetricsSpec configures the metrics the agent forwards to the telemetry gateway.
+type TelemetryMetricsSpec struct {
+	// Interval The interval between two collections of metrics, as a positive integer followed by a time unit - `s` for seconds, `m` for minutes or `h` for hours. Must be at least 10s. Defaults to 60s.
+	Interval *string `json:"interval,omitempty"`
+
+	// Sources The sources of the metrics to forward.
+	Sources []TelemetryMetricsSource `json:"sources"`
+}
+
 // TemplateVersion TemplateVersion represents a version of a template.
 type TemplateVersion struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2875,6 +4156,9 @@ type TemplateVersionStatus struct {
 	// Applications List of application providers.
 	Applications *[]ApplicationProviderSpec `json:"applications,omitempty"`
 
+	// Certificates List of certificates the agent provisions for the applications and services of the device.
+	Certificates *[]DeviceCertificateSpec `json:"certificates,omitempty"`
+
 	// Conditions Current state of the device.
 	Conditions []Condition `json:"conditions"`
 
@@ -2899,6 +4183,9 @@ type TemplateVersionStatus struct {
 		MatchPatterns *[]string `json:"matchPatterns,omitempty"`
 	} `json:"systemd,omitempty"`
 
+	// Telemetry DeviceTelemetrySpec configures the metrics and logs the agent forwards to the telemetry gateway. Telemetry is only forwarded by agents configured with the endpoint of the telemetry gateway.
+	Telemetry *DeviceTelemetrySpec `json:"telemetry,omitempty"`
+
 	// UpdatePolicy Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 	UpdatePolicy *DeviceUpdatePolicySpec `json:"updatePolicy,omitempty"`
 
@@ -2963,6 +4250,15 @@ type TokenResponse struct {
 // TokenResponseTokenType Token type.
 type TokenResponseTokenType string
 
+// TokenSecretStoreAuth Authentication to a secrets store using a static token.
+type TokenSecretStoreAuth struct {
+	// Method The method used to authenticate to a secrets store.
+	Method SecretStoreAuthMethod `json:"method"`
+
+	// Token The token used to authenticate.
+	Token string `json:"token"`
+}
+
 // UpdateSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 type UpdateSchedule struct {
 	// At Cron expression format for scheduling times.
@@ -3008,6 +4304,24 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Order Sort order for the results by timestamp. Defaults to 'desc' (newest first).
+	Order *ListAuditEventsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit The maximum number of audit events to return in the response.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
+// ListAuditEventsParamsOrder defines parameters for ListAuditEvents.
+type ListAuditEventsParamsOrder string
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	// Authorization The authentication token to validate.
@@ -3029,6 +4343,12 @@ type ListAuthProvidersParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListCertificateRevocationsParams defines parameters for ListCertificateRevocations.
+type ListCertificateRevocationsParams struct {
+	// DeviceName Only return the certificates that were issued to the Device with this name.
+	DeviceName *string `form:"deviceName,omitempty" json:"deviceName,omitempty"`
+}
+
 // ListCertificateSigningRequestsParams defines parameters for ListCertificateSigningRequests.
 type ListCertificateSigningRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3044,6 +4364,27 @@ type ListCertificateSigningRequestsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListDeviceBulkOperationsParams defines parameters for ListDeviceBulkOperations.
+type ListDeviceBulkOperationsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateDeviceBulkOperationParams defines parameters for CreateDeviceBulkOperation.
+type CreateDeviceBulkOperationParams struct {
+	// DryRun If true, only validate the operation and count the devices it would apply to, without creating it.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListDevicesParams defines parameters for ListDevices.
 type ListDevicesParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3068,6 +4409,21 @@ type GetRenderedDeviceParams struct {
 	KnownRenderedVersion *string `form:"knownRenderedVersion,omitempty" json:"knownRenderedVersion,omitempty"`
 }
 
+// ListEnrollmentApprovalPoliciesParams defines parameters for ListEnrollmentApprovalPolicies.
+type ListEnrollmentApprovalPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetEnrollmentConfigParams defines parameters for GetEnrollmentConfig.
 type GetEnrollmentConfigParams struct {
 	// Csr The name of a CertificateSigningRequest resource to query for an issued certificate. If provided, the service will check if the CertificateSigningRequest contains an issued certificate and in this case include it the returned EnrollmentConfig. In all other case, the enrollment certificate field will be empty.
@@ -3200,6 +4556,66 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListRoleBindingsParams defines parameters for ListRoleBindings.
+type ListRoleBindingsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListRolesParams defines parameters for ListRoles.
+type ListRolesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSecretStoresParams defines parameters for ListSecretStores.
+type ListSecretStoresParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListServiceAccountsParams defines parameters for ListServiceAccounts.
+type ListServiceAccountsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
 type AuthTokenJSONRequestBody = TokenRequest
 
@@ -3215,6 +4631,9 @@ type PatchAuthProviderApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceAuthProviderJSONRequestBody defines body for ReplaceAuthProvider for application/json ContentType.
 type ReplaceAuthProviderJSONRequestBody = AuthProvider
 
+// RevokeCertificatesJSONRequestBody defines body for RevokeCertificates for application/json ContentType.
+type RevokeCertificatesJSONRequestBody = CertificateRevocationRequest
+
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = CertificateSigningRequest
 
@@ -3230,6 +4649,9 @@ type UpdateCertificateSigningRequestApprovalJSONRequestBody = CertificateSigning
 // ResumeDevicesJSONRequestBody defines body for ResumeDevices for application/json ContentType.
 type ResumeDevicesJSONRequestBody = DeviceResumeRequest
 
+// CreateDeviceBulkOperationJSONRequestBody defines body for CreateDeviceBulkOperation for application/json ContentType.
+type CreateDeviceBulkOperationJSONRequestBody = DeviceBulkOperation
+
 // CreateDeviceJSONRequestBody defines body for CreateDevice for application/json ContentType.
 type CreateDeviceJSONRequestBody = Device
 
@@ -3248,6 +4670,15 @@ type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceDeviceStatusJSONRequestBody defines body for ReplaceDeviceStatus for application/json ContentType.
 type ReplaceDeviceStatusJSONRequestBody = Device
 
+// CreateEnrollmentApprovalPolicyJSONRequestBody defines body for CreateEnrollmentApprovalPolicy for application/json ContentType.
+type CreateEnrollmentApprovalPolicyJSONRequestBody = EnrollmentApprovalPolicy
+
+// PatchEnrollmentApprovalPolicyApplicationJSONPatchPlusJSONRequestBody defines body for PatchEnrollmentApprovalPolicy for application/json-patch+json ContentType.
+type PatchEnrollmentApprovalPolicyApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceEnrollmentApprovalPolicyJSONRequestBody defines body for ReplaceEnrollmentApprovalPolicy for application/json ContentType.
+type ReplaceEnrollmentApprovalPolicyJSONRequestBody = EnrollmentApprovalPolicy
+
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = EnrollmentRequest
 
@@ -3290,14 +4721,53 @@ type PatchRepositoryApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceRepositoryJSONRequestBody defines body for ReplaceRepository for application/json ContentType.
 type ReplaceRepositoryJSONRequestBody = Repository
 
-// CreateResourceSyncJSONRequestBody defines body for CreateResourceSync for application/json ContentType.
-type CreateResourceSyncJSONRequestBody = ResourceSync
+// CreateResourceSyncJSONRequestBody defines body for CreateResourceSync for application/json ContentType.
+type CreateResourceSyncJSONRequestBody = ResourceSync
+
+// PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody defines body for PatchResourceSync for application/json-patch+json ContentType.
+type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
+type ReplaceResourceSyncJSONRequestBody = ResourceSync
+
+// CreateRoleBindingJSONRequestBody defines body for CreateRoleBinding for application/json ContentType.
+type CreateRoleBindingJSONRequestBody = RoleBinding
+
+// PatchRoleBindingApplicationJSONPatchPlusJSONRequestBody defines body for PatchRoleBinding for application/json-patch+json ContentType.
+type PatchRoleBindingApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceRoleBindingJSONRequestBody defines body for ReplaceRoleBinding for application/json ContentType.
+type ReplaceRoleBindingJSONRequestBody = RoleBinding
+
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = Role
+
+// PatchRoleApplicationJSONPatchPlusJSONRequestBody defines body for PatchRole for application/json-patch+json ContentType.
+type PatchRoleApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceRoleJSONRequestBody defines body for ReplaceRole for application/json ContentType.
+type ReplaceRoleJSONRequestBody = Role
+
+// CreateSecretStoreJSONRequestBody defines body for CreateSecretStore for application/json ContentType.
+type CreateSecretStoreJSONRequestBody = SecretStore
+
+// PatchSecretStoreApplicationJSONPatchPlusJSONRequestBody defines body for PatchSecretStore for application/json-patch+json ContentType.
+type PatchSecretStoreApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceSecretStoreJSONRequestBody defines body for ReplaceSecretStore for application/json ContentType.
+type ReplaceSecretStoreJSONRequestBody = SecretStore
+
+// CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
+type CreateServiceAccountJSONRequestBody = ServiceAccount
 
-// PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody defines body for PatchResourceSync for application/json-patch+json ContentType.
-type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+// PatchServiceAccountApplicationJSONPatchPlusJSONRequestBody defines body for PatchServiceAccount for application/json-patch+json ContentType.
+type PatchServiceAccountApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
-// ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
-type ReplaceResourceSyncJSONRequestBody = ResourceSync
+// ReplaceServiceAccountJSONRequestBody defines body for ReplaceServiceAccount for application/json ContentType.
+type ReplaceServiceAccountJSONRequestBody = ServiceAccount
+
+// CreateServiceAccountTokenJSONRequestBody defines body for CreateServiceAccountToken for application/json ContentType.
+type CreateServiceAccountTokenJSONRequestBody = ServiceAccountToken
 
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
@@ -3356,6 +4826,14 @@ func (a *DeviceSystemInfo) UnmarshalJSON(b []byte) error {
 		delete(object, "customInfo")
 	}
 
+	if raw, found := object["extensions"]; found {
+		err = json.Unmarshal(raw, &a.Extensions)
+		if err != nil {
+			return fmt.Errorf("error reading 'extensions': %w", err)
+		}
+		delete(object, "extensions")
+	}
+
 	if raw, found := object["operatingSystem"]; found {
 		err = json.Unmarshal(raw, &a.OperatingSystem)
 		if err != nil {
@@ -3405,6 +4883,13 @@ func (a DeviceSystemInfo) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if a.Extensions != nil {
+		object["extensions"], err = json.Marshal(a.Extensions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'extensions': %w", err)
+		}
+	}
+
 	object["operatingSystem"], err = json.Marshal(a.OperatingSystem)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'operatingSystem': %w", err)
@@ -3531,6 +5016,34 @@ func (t *ApplicationProviderSpec) MergeHelmApplication(v HelmApplication) error
 	return err
 }
 
+// AsSystemdApplication returns the union data inside the ApplicationProviderSpec as a SystemdApplication
+func (t ApplicationProviderSpec) AsSystemdApplication() (SystemdApplication, error) {
+	var body SystemdApplication
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSystemdApplication overwrites any union data inside the ApplicationProviderSpec as the provided SystemdApplication
+func (t *ApplicationProviderSpec) FromSystemdApplication(v SystemdApplication) error {
+	v.AppType = "systemd"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSystemdApplication performs a merge with any union data inside the ApplicationProviderSpec, using the provided SystemdApplication
+func (t *ApplicationProviderSpec) MergeSystemdApplication(v SystemdApplication) error {
+	v.AppType = "systemd"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ApplicationProviderSpec) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"appType"`
@@ -3553,6 +5066,8 @@ func (t ApplicationProviderSpec) ValueByDiscriminator() (interface{}, error) {
 		return t.AsHelmApplication()
 	case "quadlet":
 		return t.AsQuadletApplication()
+	case "systemd":
+		return t.AsSystemdApplication()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -4151,6 +5666,130 @@ func (t *Batch_Limit) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsCertificateRevocationRequest0 returns the union data inside the CertificateRevocationRequest as a CertificateRevocationRequest0
+func (t CertificateRevocationRequest) AsCertificateRevocationRequest0() (CertificateRevocationRequest0, error) {
+	var body CertificateRevocationRequest0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCertificateRevocationRequest0 overwrites any union data inside the CertificateRevocationRequest as the provided CertificateRevocationRequest0
+func (t *CertificateRevocationRequest) FromCertificateRevocationRequest0(v CertificateRevocationRequest0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCertificateRevocationRequest0 performs a merge with any union data inside the CertificateRevocationRequest, using the provided CertificateRevocationRequest0
+func (t *CertificateRevocationRequest) MergeCertificateRevocationRequest0(v CertificateRevocationRequest0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCertificateRevocationRequest1 returns the union data inside the CertificateRevocationRequest as a CertificateRevocationRequest1
+func (t CertificateRevocationRequest) AsCertificateRevocationRequest1() (CertificateRevocationRequest1, error) {
+	var body CertificateRevocationRequest1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCertificateRevocationRequest1 overwrites any union data inside the CertificateRevocationRequest as the provided CertificateRevocationRequest1
+func (t *CertificateRevocationRequest) FromCertificateRevocationRequest1(v CertificateRevocationRequest1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCertificateRevocationRequest1 performs a merge with any union data inside the CertificateRevocationRequest, using the provided CertificateRevocationRequest1
+func (t *CertificateRevocationRequest) MergeCertificateRevocationRequest1(v CertificateRevocationRequest1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t CertificateRevocationRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.DeviceName != nil {
+		object["deviceName"], err = json.Marshal(t.DeviceName)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'deviceName': %w", err)
+		}
+	}
+
+	if t.Reason != nil {
+		object["reason"], err = json.Marshal(t.Reason)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'reason': %w", err)
+		}
+	}
+
+	if t.SerialNumber != nil {
+		object["serialNumber"], err = json.Marshal(t.SerialNumber)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'serialNumber': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *CertificateRevocationRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["deviceName"]; found {
+		err = json.Unmarshal(raw, &t.DeviceName)
+		if err != nil {
+			return fmt.Errorf("error reading 'deviceName': %w", err)
+		}
+	}
+
+	if raw, found := object["reason"]; found {
+		err = json.Unmarshal(raw, &t.Reason)
+		if err != nil {
+			return fmt.Errorf("error reading 'reason': %w", err)
+		}
+	}
+
+	if raw, found := object["serialNumber"]; found {
+		err = json.Unmarshal(raw, &t.SerialNumber)
+		if err != nil {
+			return fmt.Errorf("error reading 'serialNumber': %w", err)
+		}
+	}
+
+	return err
+}
+
 // AsImageApplicationProviderSpec returns the union data inside the ComposeApplication as a ImageApplicationProviderSpec
 func (t ComposeApplication) AsImageApplicationProviderSpec() (ImageApplicationProviderSpec, error) {
 	var body ImageApplicationProviderSpec
@@ -4221,6 +5860,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -4228,6 +5874,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.LivenessProbe != nil {
+		object["livenessProbe"], err = json.Marshal(t.LivenessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'livenessProbe': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -4235,6 +5888,20 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.ReadinessProbe != nil {
+		object["readinessProbe"], err = json.Marshal(t.ReadinessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'readinessProbe': %w", err)
+		}
+	}
+
+	if t.ResourceMonitors != nil {
+		object["resourceMonitors"], err = json.Marshal(t.ResourceMonitors)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resourceMonitors': %w", err)
+		}
+	}
+
 	if t.Volumes != nil {
 		object["volumes"], err = json.Marshal(t.Volumes)
 		if err != nil {
@@ -4263,6 +5930,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -4270,6 +5944,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["livenessProbe"]; found {
+		err = json.Unmarshal(raw, &t.LivenessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'livenessProbe': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -4277,6 +5958,20 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["readinessProbe"]; found {
+		err = json.Unmarshal(raw, &t.ReadinessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'readinessProbe': %w", err)
+		}
+	}
+
+	if raw, found := object["resourceMonitors"]; found {
+		err = json.Unmarshal(raw, &t.ResourceMonitors)
+		if err != nil {
+			return fmt.Errorf("error reading 'resourceMonitors': %w", err)
+		}
+	}
+
 	if raw, found := object["volumes"]; found {
 		err = json.Unmarshal(raw, &t.Volumes)
 		if err != nil {
@@ -4334,27 +6029,141 @@ func (t *ConfigProviderSpec) MergeKubernetesSecretProviderSpec(v KubernetesSecre
 		return err
 	}
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsInlineConfigProviderSpec returns the union data inside the ConfigProviderSpec as a InlineConfigProviderSpec
+func (t ConfigProviderSpec) AsInlineConfigProviderSpec() (InlineConfigProviderSpec, error) {
+	var body InlineConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInlineConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided InlineConfigProviderSpec
+func (t *ConfigProviderSpec) FromInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInlineConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided InlineConfigProviderSpec
+func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
+func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
+	var body HttpConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
+func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHttpConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided HttpConfigProviderSpec
+func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretConfigProviderSpec returns the union data inside the ConfigProviderSpec as a SecretConfigProviderSpec
+func (t ConfigProviderSpec) AsSecretConfigProviderSpec() (SecretConfigProviderSpec, error) {
+	var body SecretConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided SecretConfigProviderSpec
+func (t *ConfigProviderSpec) FromSecretConfigProviderSpec(v SecretConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided SecretConfigProviderSpec
+func (t *ConfigProviderSpec) MergeSecretConfigProviderSpec(v SecretConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOciConfigProviderSpec returns the union data inside the ConfigProviderSpec as a OciConfigProviderSpec
+func (t ConfigProviderSpec) AsOciConfigProviderSpec() (OciConfigProviderSpec, error) {
+	var body OciConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOciConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) FromOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOciConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) MergeOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ConfigProviderSpec) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ConfigProviderSpec) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsInlineConfigProviderSpec returns the union data inside the ConfigProviderSpec as a InlineConfigProviderSpec
-func (t ConfigProviderSpec) AsInlineConfigProviderSpec() (InlineConfigProviderSpec, error) {
-	var body InlineConfigProviderSpec
+// AsDeviceBulkOperationSpec0 returns the union data inside the DeviceBulkOperationSpec as a DeviceBulkOperationSpec0
+func (t DeviceBulkOperationSpec) AsDeviceBulkOperationSpec0() (DeviceBulkOperationSpec0, error) {
+	var body DeviceBulkOperationSpec0
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromInlineConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided InlineConfigProviderSpec
-func (t *ConfigProviderSpec) FromInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+// FromDeviceBulkOperationSpec0 overwrites any union data inside the DeviceBulkOperationSpec as the provided DeviceBulkOperationSpec0
+func (t *DeviceBulkOperationSpec) FromDeviceBulkOperationSpec0(v DeviceBulkOperationSpec0) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeInlineConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided InlineConfigProviderSpec
-func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProviderSpec) error {
+// MergeDeviceBulkOperationSpec0 performs a merge with any union data inside the DeviceBulkOperationSpec, using the provided DeviceBulkOperationSpec0
+func (t *DeviceBulkOperationSpec) MergeDeviceBulkOperationSpec0(v DeviceBulkOperationSpec0) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4365,22 +6174,22 @@ func (t *ConfigProviderSpec) MergeInlineConfigProviderSpec(v InlineConfigProvide
 	return err
 }
 
-// AsHttpConfigProviderSpec returns the union data inside the ConfigProviderSpec as a HttpConfigProviderSpec
-func (t ConfigProviderSpec) AsHttpConfigProviderSpec() (HttpConfigProviderSpec, error) {
-	var body HttpConfigProviderSpec
+// AsDeviceBulkOperationSpec1 returns the union data inside the DeviceBulkOperationSpec as a DeviceBulkOperationSpec1
+func (t DeviceBulkOperationSpec) AsDeviceBulkOperationSpec1() (DeviceBulkOperationSpec1, error) {
+	var body DeviceBulkOperationSpec1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromHttpConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided HttpConfigProviderSpec
-func (t *ConfigProviderSpec) FromHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+// FromDeviceBulkOperationSpec1 overwrites any union data inside the DeviceBulkOperationSpec as the provided DeviceBulkOperationSpec1
+func (t *DeviceBulkOperationSpec) FromDeviceBulkOperationSpec1(v DeviceBulkOperationSpec1) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeHttpConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided HttpConfigProviderSpec
-func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpec) error {
+// MergeDeviceBulkOperationSpec1 performs a merge with any union data inside the DeviceBulkOperationSpec, using the provided DeviceBulkOperationSpec1
+func (t *DeviceBulkOperationSpec) MergeDeviceBulkOperationSpec1(v DeviceBulkOperationSpec1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4391,13 +6200,102 @@ func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpe
 	return err
 }
 
-func (t ConfigProviderSpec) MarshalJSON() ([]byte, error) {
+func (t DeviceBulkOperationSpec) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Decommission != nil {
+		object["decommission"], err = json.Marshal(t.Decommission)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'decommission': %w", err)
+		}
+	}
+
+	if t.FieldSelector != nil {
+		object["fieldSelector"], err = json.Marshal(t.FieldSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'fieldSelector': %w", err)
+		}
+	}
+
+	if t.LabelSelector != nil {
+		object["labelSelector"], err = json.Marshal(t.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'labelSelector': %w", err)
+		}
+	}
+
+	if t.Metadata != nil {
+		object["metadata"], err = json.Marshal(t.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'metadata': %w", err)
+		}
+	}
+
+	object["operation"], err = json.Marshal(t.Operation)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'operation': %w", err)
+	}
+
+	b, err = json.Marshal(object)
 	return b, err
 }
 
-func (t *ConfigProviderSpec) UnmarshalJSON(b []byte) error {
+func (t *DeviceBulkOperationSpec) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["decommission"]; found {
+		err = json.Unmarshal(raw, &t.Decommission)
+		if err != nil {
+			return fmt.Errorf("error reading 'decommission': %w", err)
+		}
+	}
+
+	if raw, found := object["fieldSelector"]; found {
+		err = json.Unmarshal(raw, &t.FieldSelector)
+		if err != nil {
+			return fmt.Errorf("error reading 'fieldSelector': %w", err)
+		}
+	}
+
+	if raw, found := object["labelSelector"]; found {
+		err = json.Unmarshal(raw, &t.LabelSelector)
+		if err != nil {
+			return fmt.Errorf("error reading 'labelSelector': %w", err)
+		}
+	}
+
+	if raw, found := object["metadata"]; found {
+		err = json.Unmarshal(raw, &t.Metadata)
+		if err != nil {
+			return fmt.Errorf("error reading 'metadata': %w", err)
+		}
+	}
+
+	if raw, found := object["operation"]; found {
+		err = json.Unmarshal(raw, &t.Operation)
+		if err != nil {
+			return fmt.Errorf("error reading 'operation': %w", err)
+		}
+	}
+
 	return err
 }
 
@@ -4735,6 +6633,34 @@ func (t *EventDetails) MergeReferencedRepositoryUpdatedDetails(v ReferencedRepos
 	return err
 }
 
+// AsRepositoryPushReceivedDetails returns the union data inside the EventDetails as a RepositoryPushReceivedDetails
+func (t EventDetails) AsRepositoryPushReceivedDetails() (RepositoryPushReceivedDetails, error) {
+	var body RepositoryPushReceivedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRepositoryPushReceivedDetails overwrites any union data inside the EventDetails as the provided RepositoryPushReceivedDetails
+func (t *EventDetails) FromRepositoryPushReceivedDetails(v RepositoryPushReceivedDetails) error {
+	v.DetailType = "RepositoryPushReceived"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRepositoryPushReceivedDetails performs a merge with any union data inside the EventDetails, using the provided RepositoryPushReceivedDetails
+func (t *EventDetails) MergeRepositoryPushReceivedDetails(v RepositoryPushReceivedDetails) error {
+	v.DetailType = "RepositoryPushReceived"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsFleetRolloutStartedDetails returns the union data inside the EventDetails as a FleetRolloutStartedDetails
 func (t EventDetails) AsFleetRolloutStartedDetails() (FleetRolloutStartedDetails, error) {
 	var body FleetRolloutStartedDetails
@@ -4903,6 +6829,34 @@ func (t *EventDetails) MergeFleetRolloutDeviceSelectedDetails(v FleetRolloutDevi
 	return err
 }
 
+// AsDeviceBulkOperationProgressedDetails returns the union data inside the EventDetails as a DeviceBulkOperationProgressedDetails
+func (t EventDetails) AsDeviceBulkOperationProgressedDetails() (DeviceBulkOperationProgressedDetails, error) {
+	var body DeviceBulkOperationProgressedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDeviceBulkOperationProgressedDetails overwrites any union data inside the EventDetails as the provided DeviceBulkOperationProgressedDetails
+func (t *EventDetails) FromDeviceBulkOperationProgressedDetails(v DeviceBulkOperationProgressedDetails) error {
+	v.DetailType = "DeviceBulkOperationProgressed"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDeviceBulkOperationProgressedDetails performs a merge with any union data inside the EventDetails, using the provided DeviceBulkOperationProgressedDetails
+func (t *EventDetails) MergeDeviceBulkOperationProgressedDetails(v DeviceBulkOperationProgressedDetails) error {
+	v.DetailType = "DeviceBulkOperationProgressed"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t EventDetails) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"detailType"`
@@ -4917,6 +6871,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "DeviceBulkOperationProgressed":
+		return t.AsDeviceBulkOperationProgressedDetails()
 	case "DeviceMultipleOwnersDetected":
 		return t.AsDeviceMultipleOwnersDetectedDetails()
 	case "DeviceMultipleOwnersResolved":
@@ -4941,6 +6897,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsInternalTaskPermanentlyFailedDetails()
 	case "ReferencedRepositoryUpdated":
 		return t.AsReferencedRepositoryUpdatedDetails()
+	case "RepositoryPushReceived":
+		return t.AsRepositoryPushReceivedDetails()
 	case "ResourceSyncCompleted":
 		return t.AsResourceSyncCompletedDetails()
 	case "ResourceUpdated":
@@ -5354,6 +7312,27 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'appType': %w", err)
 	}
 
+	if t.Capabilities != nil {
+		object["capabilities"], err = json.Marshal(t.Capabilities)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'capabilities': %w", err)
+		}
+	}
+
+	if t.DependsOn != nil {
+		object["dependsOn"], err = json.Marshal(t.DependsOn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dependsOn': %w", err)
+		}
+	}
+
+	if t.Devices != nil {
+		object["devices"], err = json.Marshal(t.Devices)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'devices': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -5361,6 +7340,20 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Ipc != nil {
+		object["ipc"], err = json.Marshal(t.Ipc)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'ipc': %w", err)
+		}
+	}
+
+	if t.LivenessProbe != nil {
+		object["livenessProbe"], err = json.Marshal(t.LivenessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'livenessProbe': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -5368,6 +7361,27 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Network != nil {
+		object["network"], err = json.Marshal(t.Network)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'network': %w", err)
+		}
+	}
+
+	if t.ReadinessProbe != nil {
+		object["readinessProbe"], err = json.Marshal(t.ReadinessProbe)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'readinessProbe': %w", err)
+		}
+	}
+
+	if t.ResourceMonitors != nil {
+		object["resourceMonitors"], err = json.Marshal(t.ResourceMonitors)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resourceMonitors': %w", err)
+		}
+	}
+
 	object["runAs"], err = json.Marshal(t.RunAs)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'runAs': %w", err)
@@ -5401,6 +7415,27 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["capabilities"]; found {
+		err = json.Unmarshal(raw, &t.Capabilities)
+		if err != nil {
+			return fmt.Errorf("error reading 'capabilities': %w", err)
+		}
+	}
+
+	if raw, found := object["dependsOn"]; found {
+		err = json.Unmarshal(raw, &t.DependsOn)
+		if err != nil {
+			return fmt.Errorf("error reading 'dependsOn': %w", err)
+		}
+	}
+
+	if raw, found := object["devices"]; found {
+		err = json.Unmarshal(raw, &t.Devices)
+		if err != nil {
+			return fmt.Errorf("error reading 'devices': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -5408,6 +7443,20 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["ipc"]; found {
+		err = json.Unmarshal(raw, &t.Ipc)
+		if err != nil {
+			return fmt.Errorf("error reading 'ipc': %w", err)
+		}
+	}
+
+	if raw, found := object["livenessProbe"]; found {
+		err = json.Unmarshal(raw, &t.LivenessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'livenessProbe': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -5415,6 +7464,27 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["network"]; found {
+		err = json.Unmarshal(raw, &t.Network)
+		if err != nil {
+			return fmt.Errorf("error reading 'network': %w", err)
+		}
+	}
+
+	if raw, found := object["readinessProbe"]; found {
+		err = json.Unmarshal(raw, &t.ReadinessProbe)
+		if err != nil {
+			return fmt.Errorf("error reading 'readinessProbe': %w", err)
+		}
+	}
+
+	if raw, found := object["resourceMonitors"]; found {
+		err = json.Unmarshal(raw, &t.ResourceMonitors)
+		if err != nil {
+			return fmt.Errorf("error reading 'resourceMonitors': %w", err)
+		}
+	}
+
 	if raw, found := object["runAs"]; found {
 		err = json.Unmarshal(raw, &t.RunAs)
 		if err != nil {
@@ -5728,3 +7798,92 @@ func (t *RolloutDeviceSelection) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
+
+// AsTokenSecretStoreAuth returns the union data inside the SecretStoreAuth as a TokenSecretStoreAuth
+func (t SecretStoreAuth) AsTokenSecretStoreAuth() (TokenSecretStoreAuth, error) {
+	var body TokenSecretStoreAuth
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTokenSecretStoreAuth overwrites any union data inside the SecretStoreAuth as the provided TokenSecretStoreAuth
+func (t *SecretStoreAuth) FromTokenSecretStoreAuth(v TokenSecretStoreAuth) error {
+	v.Method = "token"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTokenSecretStoreAuth performs a merge with any union data inside the SecretStoreAuth, using the provided TokenSecretStoreAuth
+func (t *SecretStoreAuth) MergeTokenSecretStoreAuth(v TokenSecretStoreAuth) error {
+	v.Method = "token"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsAppRoleSecretStoreAuth returns the union data inside the SecretStoreAuth as a AppRoleSecretStoreAuth
+func (t SecretStoreAuth) AsAppRoleSecretStoreAuth() (AppRoleSecretStoreAuth, error) {
+	var body AppRoleSecretStoreAuth
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAppRoleSecretStoreAuth overwrites any union data inside the SecretStoreAuth as the provided AppRoleSecretStoreAuth
+func (t *SecretStoreAuth) FromAppRoleSecretStoreAuth(v AppRoleSecretStoreAuth) error {
+	v.Method = "appRole"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAppRoleSecretStoreAuth performs a merge with any union data inside the SecretStoreAuth, using the provided AppRoleSecretStoreAuth
+func (t *SecretStoreAuth) MergeAppRoleSecretStoreAuth(v AppRoleSecretStoreAuth) error {
+	v.Method = "appRole"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t SecretStoreAuth) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"method"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t SecretStoreAuth) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "appRole":
+		return t.AsAppRoleSecretStoreAuth()
+	case "token":
+		return t.AsTokenSecretStoreAuth()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t SecretStoreAuth) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *SecretStoreAuth) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
@@ -74,6 +74,34 @@ func (a ComposeApplication) RunAsWithDefault() Username {
 	return CurrentProcessUsername
 }
 
+// HostAccess returns the host resources the containers of the application are given access to.
+func (a ContainerApplication) HostAccess() ApplicationHostAccess {
+	return ApplicationHostAccess{Devices: a.Devices, Capabilities: a.Capabilities, Network: a.Network, Ipc: a.Ipc}
+}
+
+// HostAccess returns the host resources the containers of the application are given access to.
+func (a QuadletApplication) HostAccess() ApplicationHostAccess {
+	return ApplicationHostAccess{Devices: a.Devices, Capabilities: a.Capabilities, Network: a.Network, Ipc: a.Ipc}
+}
+
+const (
+	// IpcPrivate gives the containers of an application their own IPC namespace.
+	IpcPrivate = "private"
+	// IpcShareable gives the containers of an application their own IPC namespace, which other
+	// applications may join.
+	IpcShareable = "shareable"
+	// IpcHost makes the containers of an application use the IPC namespace of the host.
+	IpcHost = "host"
+	// IpcAppPrefix prefixes the name of the application whose IPC namespace the containers of an
+	// application join.
+	IpcAppPrefix = "app:"
+
+	// NetworkHost makes the containers of an application use the network of the host.
+	NetworkHost = "host"
+	// NetworkNone gives the containers of an application no network.
+	NetworkNone = "none"
+)
+
 // decodeContents decodes the content based on the encoding type and returns the
 // decoded content as a byte slice.
 func decodeContents(content string, encoding *EncodingType) ([]byte,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"text/template"
 )
@@ -14,6 +15,9 @@ type DeviceCompletionCount struct {
 	SameTemplateVersion bool
 	UpdatingReason      UpdateState
 	UpdateTimedOut      bool
+	// ApplicationsUnhealthy is true if an application of the device hasn't passed its readiness
+	// probe or has failed its liveness probe.
+	ApplicationsUnhealthy bool
 }
 
 type HookActionType string
@@ -32,10 +36,12 @@ const (
 type ConfigProviderType string
 
 const (
-	GitConfigProviderType        ConfigProviderType = "gitRef"
-	HttpConfigProviderType       ConfigProviderType = "httpRef"
-	InlineConfigProviderType     ConfigProviderType = "inline"
-	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	GitConfigProviderType         ConfigProviderType = "gitRef"
+	HttpConfigProviderType        ConfigProviderType = "httpRef"
+	InlineConfigProviderType      ConfigProviderType = "inline"
+	KubernetesSecretProviderType  ConfigProviderType = "secretRef"
+	OciConfigProviderType         ConfigProviderType = "ociRef"
+	SecretStoreConfigProviderType ConfigProviderType = "secretStoreRef"
 )
 
 type ApplicationProviderType string
@@ -107,6 +113,8 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		OciConfigProviderType,
+		SecretStoreConfigProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -184,9 +192,100 @@ func (a ApplicationProviderSpec) GetName() (*string, error) {
 			return nil, err
 		}
 		return app.Name, nil
+	case AppTypeSystemd:
+		app, err := a.AsSystemdApplication()
+		if err != nil {
+			return nil, err
+		}
+		return app.Name, nil
+	default:
+		return nil, fmt.Errorf("unknown app type: %s", appType)
+	}
+}
+
+// GetDependsOn returns the names of the applications the application depends on. Helm and systemd
+// applications have no dependencies.
+func (a ApplicationProviderSpec) GetDependsOn() ([]string, error) {
+	appType, err := a.GetAppType()
+	if err != nil {
+		return nil, err
+	}
+	var dependsOn *[]string
+	switch appType {
+	case AppTypeContainer:
+		app, err := a.AsContainerApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeCompose:
+		app, err := a.AsComposeApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeQuadlet:
+		app, err := a.AsQuadletApplication()
+		if err != nil {
+			return nil, err
+		}
+		dependsOn = app.DependsOn
+	case AppTypeHelm, AppTypeSystemd:
 	default:
 		return nil, fmt.Errorf("unknown app type: %s", appType)
 	}
+	if dependsOn == nil {
+		return nil, nil
+	}
+	return *dependsOn, nil
+}
+
+// GetCommonName returns the common name of the certificate, which defaults to the first DNS name, or
+// to the name of the certificate if no DNS names are specified.
+func (c DeviceCertificateSpec) GetCommonName() string {
+	if c.CommonName != nil && *c.CommonName != "" {
+		return *c.CommonName
+	}
+	if c.DnsNames != nil && len(*c.DnsNames) > 0 {
+		return (*c.DnsNames)[0]
+	}
+	return c.Name
+}
+
+// GetVolumes returns the volumes of the application. Helm and systemd applications have no volumes.
+func (a ApplicationProviderSpec) GetVolumes() ([]ApplicationVolume, error) {
+	appType, err := a.GetAppType()
+	if err != nil {
+		return nil, err
+	}
+	var volumes *[]ApplicationVolume
+	switch appType {
+	case AppTypeContainer:
+		app, err := a.AsContainerApplication()
+		if err != nil {
+			return nil, err
+		}
+		volumes = app.Volumes
+	case AppTypeCompose:
+		app, err := a.AsComposeApplication()
+		if err != nil {
+			return nil, err
+		}
+		volumes = app.Volumes
+	case AppTypeQuadlet:
+		app, err := a.AsQuadletApplication()
+		if err != nil {
+			return nil, err
+		}
+		volumes = app.Volumes
+	case AppTypeHelm, AppTypeSystemd:
+	default:
+		return nil, fmt.Errorf("unknown app type: %s", appType)
+	}
+	if volumes == nil {
+		return nil, nil
+	}
+	return *volumes, nil
 }
 
 func (c ApplicationVolume) Type() (ApplicationVolumeProviderType, error) {
@@ -325,6 +424,51 @@ func (e MatchExpression) String() string {
 	return sb.String()
 }
 
+// Matches reports whether a set of labels satisfies the expression.
+func (e MatchExpression) Matches(labels map[string]string) bool {
+	var values []string
+	if e.Values != nil {
+		values = *e.Values
+	}
+	value, exists := labels[e.Key]
+	switch e.Operator {
+	case Exists:
+		return exists
+	case DoesNotExist:
+		return !exists
+	case In:
+		return exists && slices.Contains(values, value)
+	case NotIn:
+		return !exists || !slices.Contains(values, value)
+	default:
+		return false
+	}
+}
+
+// Matches reports whether a set of labels satisfies the label selector. Empty/null label
+// selectors match nothing.
+func (l *LabelSelector) Matches(labels map[string]string) bool {
+	if l == nil || (l.MatchLabels == nil && l.MatchExpressions == nil) {
+		return false
+	}
+	if l.MatchLabels != nil {
+		for k, v := range *l.MatchLabels {
+			if value, exists := labels[k]; !exists || value != v {
+				return false
+			}
+		}
+	}
+	if l.MatchExpressions == nil {
+		return true
+	}
+	for _, e := range *l.MatchExpressions {
+		if !e.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
 // GetConsoles returns the list of DeviceConsole objects, or an empty list if the field is nil.
 func (rd DeviceSpec) GetConsoles() []DeviceConsole {
 	if rd.Consoles == nil {
@@ -334,6 +478,47 @@ func (rd DeviceSpec) GetConsoles() []DeviceConsole {
 	}
 }
 
+// resourceSyncManageableKinds lists the kinds of resources a ResourceSync of each type may manage.
+// The catalog kinds are defined in v1alpha1, which can't be imported here.
+var resourceSyncManageableKinds = map[ResourceSyncType][]string{
+	ResourceSyncTypeFleet:   {FleetKind, RepositoryKind, SecretStoreKind, AuthProviderKind, EnrollmentApprovalPolicyKind, DeviceKind},
+	ResourceSyncTypeCatalog: {"Catalog", "CatalogItem"},
+}
+
+// resourceSyncDefaultKinds lists the kinds of resources a ResourceSync of each type manages if
+// none are specified.
+var resourceSyncDefaultKinds = map[ResourceSyncType][]string{
+	ResourceSyncTypeFleet:   {FleetKind},
+	ResourceSyncTypeCatalog: {"Catalog", "CatalogItem"},
+}
+
+// GetType returns the type of the ResourceSync, defaulting to fleet.
+func (r ResourceSyncSpec) GetType() ResourceSyncType {
+	if r.Type == nil {
+		return ResourceSyncTypeFleet
+	}
+	return *r.Type
+}
+
+// ManageableKinds returns the kinds of resources a ResourceSync of this type may manage.
+func (t ResourceSyncType) ManageableKinds() []string {
+	return resourceSyncManageableKinds[t]
+}
+
+// DefaultKinds returns the kinds of resources a ResourceSync of this type manages if none are specified.
+func (t ResourceSyncType) DefaultKinds() []string {
+	return resourceSyncDefaultKinds[t]
+}
+
+// ManagedKinds returns the kinds of resources the ResourceSync manages, either as listed in
+// the spec or the default kinds of its type.
+func (r ResourceSyncSpec) ManagedKinds() []string {
+	if r.Kinds != nil && len(*r.Kinds) > 0 {
+		return *r.Kinds
+	}
+	return r.GetType().DefaultKinds()
+}
+
 type SensitiveDataHider interface {
 	HideSensitiveData() error
 }
@@ -464,6 +649,104 @@ func (r *RepositoryList) HideSensitiveData() error {
 	return nil
 }
 
+func hideSecretStoreAuth(auth *SecretStoreAuth) error {
+	method, err := auth.Discriminator()
+	if err != nil {
+		return err
+	}
+	switch SecretStoreAuthMethod(method) {
+	case SecretStoreAuthMethodToken:
+		tokenAuth, err := auth.AsTokenSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		hideValue(&tokenAuth.Token)
+		return auth.FromTokenSecretStoreAuth(tokenAuth)
+	case SecretStoreAuthMethodAppRole:
+		appRoleAuth, err := auth.AsAppRoleSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		hideValue(&appRoleAuth.SecretId)
+		return auth.FromAppRoleSecretStoreAuth(appRoleAuth)
+	default:
+		return fmt.Errorf("unknown secret store auth method: %s", method)
+	}
+}
+
+func (s *SecretStore) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	return hideSecretStoreAuth(&s.Spec.Auth)
+}
+
+func (s *SecretStoreList) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Items {
+		if err := s.Items[i].HideSensitiveData(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreserveSensitiveData preserves the credentials of the existing secret store when the new secret store
+// contains the masked placeholder value ("*****") and uses the same auth method.
+func (s *SecretStore) PreserveSensitiveData(existing SensitiveDataPreserver) error {
+	if s == nil || existing == nil {
+		return nil
+	}
+
+	existingStore, ok := existing.(*SecretStore)
+	if !ok {
+		return fmt.Errorf("existing object is not a SecretStore")
+	}
+
+	method, err := s.Spec.Auth.Discriminator()
+	if err != nil {
+		return err
+	}
+	existingMethod, err := existingStore.Spec.Auth.Discriminator()
+	if err != nil {
+		return err
+	}
+
+	// If the auth methods don't match, nothing to preserve
+	if method != existingMethod {
+		return nil
+	}
+
+	switch SecretStoreAuthMethod(method) {
+	case SecretStoreAuthMethodToken:
+		tokenAuth, err := s.Spec.Auth.AsTokenSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		existingTokenAuth, err := existingStore.Spec.Auth.AsTokenSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		preserveValue(&tokenAuth.Token, &existingTokenAuth.Token)
+		return s.Spec.Auth.FromTokenSecretStoreAuth(tokenAuth)
+	case SecretStoreAuthMethodAppRole:
+		appRoleAuth, err := s.Spec.Auth.AsAppRoleSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		existingAppRoleAuth, err := existingStore.Spec.Auth.AsAppRoleSecretStoreAuth()
+		if err != nil {
+			return err
+		}
+		preserveValue(&appRoleAuth.SecretId, &existingAppRoleAuth.SecretId)
+		return s.Spec.Auth.FromAppRoleSecretStoreAuth(appRoleAuth)
+	default:
+		return nil // Unknown method, nothing to preserve
+	}
+}
+
 // PreserveSensitiveData preserves sensitive data from the existing repository when the new repository
 // contains the masked placeholder value ("*****"). This allows users to update a repository without
 // needing to re-provide credentials if they haven't changed.
@@ -1039,3 +1039,104 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func newTokenSecretStore(token string) *SecretStore {
+	auth := SecretStoreAuth{}
+	_ = auth.FromTokenSecretStoreAuth(TokenSecretStoreAuth{Method: SecretStoreAuthMethodToken, Token: token})
+	return &SecretStore{
+		Metadata: ObjectMeta{Name: lo.ToPtr("vault")},
+		Spec:     SecretStoreSpec{Address: "https://vault.example.com:8200", Auth: auth},
+	}
+}
+
+func newAppRoleSecretStore(roleId, secretId string) *SecretStore {
+	auth := SecretStoreAuth{}
+	_ = auth.FromAppRoleSecretStoreAuth(AppRoleSecretStoreAuth{Method: SecretStoreAuthMethodAppRole, RoleId: roleId, SecretId: secretId})
+	return &SecretStore{
+		Metadata: ObjectMeta{Name: lo.ToPtr("vault")},
+		Spec:     SecretStoreSpec{Address: "https://vault.example.com:8200", Auth: auth},
+	}
+}
+
+func TestSecretStoreHideSensitiveData(t *testing.T) {
+	tokenStore := newTokenSecretStore("s.originaltoken")
+	appRoleStore := newAppRoleSecretStore("my-role", "originalsecretid")
+	list := &SecretStoreList{Items: []SecretStore{*tokenStore, *appRoleStore}}
+
+	require.NoError(t, list.HideSensitiveData())
+
+	tokenAuth, err := list.Items[0].Spec.Auth.AsTokenSecretStoreAuth()
+	require.NoError(t, err)
+	assert.Equal(t, "*****", tokenAuth.Token)
+
+	appRoleAuth, err := list.Items[1].Spec.Auth.AsAppRoleSecretStoreAuth()
+	require.NoError(t, err)
+	assert.Equal(t, "my-role", appRoleAuth.RoleId, "RoleId is not sensitive")
+	assert.Equal(t, "*****", appRoleAuth.SecretId)
+}
+
+func TestSecretStorePreserveSensitiveData(t *testing.T) {
+	t.Run("token preserved when masked", func(t *testing.T) {
+		newStore := newTokenSecretStore("*****")
+		require.NoError(t, newStore.PreserveSensitiveData(newTokenSecretStore("s.originaltoken")))
+		tokenAuth, err := newStore.Spec.Auth.AsTokenSecretStoreAuth()
+		require.NoError(t, err)
+		assert.Equal(t, "s.originaltoken", tokenAuth.Token)
+	})
+
+	t.Run("token replaced when not masked", func(t *testing.T) {
+		newStore := newTokenSecretStore("s.newtoken")
+		require.NoError(t, newStore.PreserveSensitiveData(newTokenSecretStore("s.originaltoken")))
+		tokenAuth, err := newStore.Spec.Auth.AsTokenSecretStoreAuth()
+		require.NoError(t, err)
+		assert.Equal(t, "s.newtoken", tokenAuth.Token)
+	})
+
+	t.Run("secret ID preserved when masked", func(t *testing.T) {
+		newStore := newAppRoleSecretStore("my-role", "*****")
+		require.NoError(t, newStore.PreserveSensitiveData(newAppRoleSecretStore("my-role", "originalsecretid")))
+		appRoleAuth, err := newStore.Spec.Auth.AsAppRoleSecretStoreAuth()
+		require.NoError(t, err)
+		assert.Equal(t, "originalsecretid", appRoleAuth.SecretId)
+	})
+
+	t.Run("nothing preserved when auth method changes", func(t *testing.T) {
+		newStore := newAppRoleSecretStore("my-role", "*****")
+		require.NoError(t, newStore.PreserveSensitiveData(newTokenSecretStore("s.originaltoken")))
+		appRoleAuth, err := newStore.Spec.Auth.AsAppRoleSecretStoreAuth()
+		require.NoError(t, err)
+		assert.Equal(t, "*****", appRoleAuth.SecretId)
+	})
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"site": "factory-a", "model": "gateway"}
+	tests := []struct {
+		name     string
+		selector *LabelSelector
+		want     bool
+	}{
+		{"nil selector", nil, false},
+		{"empty selector", &LabelSelector{}, false},
+		{"matching labels", &LabelSelector{MatchLabels: &map[string]string{"site": "factory-a"}}, true},
+		{"mismatched labels", &LabelSelector{MatchLabels: &map[string]string{"site": "factory-b"}}, false},
+		{"in expression", &LabelSelector{MatchExpressions: &MatchExpressions{{Key: "model", Operator: In, Values: &[]string{"gateway", "sensor"}}}}, true},
+		{"not in expression", &LabelSelector{MatchExpressions: &MatchExpressions{{Key: "model", Operator: NotIn, Values: &[]string{"gateway"}}}}, false},
+		{"exists expression", &LabelSelector{MatchExpressions: &MatchExpressions{{Key: "site", Operator: Exists}}}, true},
+		{"does not exist expression", &LabelSelector{MatchExpressions: &MatchExpressions{{Key: "site", Operator: DoesNotExist}}}, false},
+		{
+			"labels and expressions",
+			&LabelSelector{
+				MatchLabels:      &map[string]string{"site": "factory-a"},
+				MatchExpressions: &MatchExpressions{{Key: "rack", Operator: DoesNotExist}},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.selector.Matches(labels))
+		})
+	}
+}
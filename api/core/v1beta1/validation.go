@@ -6,6 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"slices"
@@ -19,6 +23,7 @@ import (
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
 	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
 )
@@ -113,6 +118,249 @@ func (r DeviceSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateSystemdName(&matchPattern, fmt.Sprintf("spec.systemd.matchPatterns[%d]", i))...)
 		}
 	}
+	if r.Telemetry != nil {
+		allErrs = append(allErrs, r.Telemetry.Validate()...)
+	}
+	if r.Certificates != nil {
+		allErrs = append(allErrs, validateCertificates(*r.Certificates, lo.FromPtr(r.Applications), fleetTemplate)...)
+	}
+	return allErrs
+}
+
+// minTelemetryMetricsInterval is the shortest interval at which metrics can be collected.
+const minTelemetryMetricsInterval = 10 * time.Second
+
+var telemetryUploadRatePattern = regexp.MustCompile(`^[0-9]+[kmg]?$`)
+
+func (t DeviceTelemetrySpec) Validate() []error {
+	allErrs := []error{}
+	if t.Metrics != nil {
+		for i, source := range t.Metrics.Sources {
+			if source != TelemetryMetricsSourceHost && source != TelemetryMetricsSourceApplications {
+				allErrs = append(allErrs, fmt.Errorf("spec.telemetry.metrics.sources[%d]: unsupported source %q", i, source))
+			}
+		}
+		if t.Metrics.Interval != nil {
+			interval, err := time.ParseDuration(*t.Metrics.Interval)
+			if err != nil {
+				allErrs = append(allErrs, fmt.Errorf("spec.telemetry.metrics.interval: invalid duration %q: %w", *t.Metrics.Interval, err))
+			} else if interval < minTelemetryMetricsInterval {
+				allErrs = append(allErrs, fmt.Errorf("spec.telemetry.metrics.interval: must be at least %s, got %q", minTelemetryMetricsInterval, *t.Metrics.Interval))
+			}
+		}
+	}
+	if t.Logs != nil {
+		for i, source := range t.Logs.Sources {
+			if source != TelemetryLogsSourceJournal && source != TelemetryLogsSourceApplications {
+				allErrs = append(allErrs, fmt.Errorf("spec.telemetry.logs.sources[%d]: unsupported source %q", i, source))
+			}
+		}
+		for i, unit := range lo.FromPtr(t.Logs.Units) {
+			allErrs = append(allErrs, validation.ValidateSystemdName(&unit, fmt.Sprintf("spec.telemetry.logs.units[%d]", i))...)
+		}
+		if t.Logs.Priority != nil && !slices.Contains(telemetryLogPriorities, *t.Logs.Priority) {
+			allErrs = append(allErrs, fmt.Errorf("spec.telemetry.logs.priority: unsupported priority %q", *t.Logs.Priority))
+		}
+	}
+	if t.MaxUploadRate != nil {
+		if !telemetryUploadRatePattern.MatchString(*t.MaxUploadRate) {
+			allErrs = append(allErrs, fmt.Errorf("spec.telemetry.maxUploadRate: must be in format 'number[unit]' where unit is k, m, or g, got %q", *t.MaxUploadRate))
+		} else if value, err := strconv.ParseUint(strings.TrimRight(*t.MaxUploadRate, "kmg"), 10, 64); err != nil || value == 0 {
+			allErrs = append(allErrs, fmt.Errorf("spec.telemetry.maxUploadRate: must be greater than zero"))
+		}
+	}
+	return allErrs
+}
+
+// telemetryLogPriorities lists the journal priorities from the most to the least severe.
+var telemetryLogPriorities = []TelemetryLogPriority{
+	TelemetryLogPriorityEmerg,
+	TelemetryLogPriorityAlert,
+	TelemetryLogPriorityCrit,
+	TelemetryLogPriorityErr,
+	TelemetryLogPriorityWarning,
+	TelemetryLogPriorityNotice,
+	TelemetryLogPriorityInfo,
+	TelemetryLogPriorityDebug,
+}
+
+// minCertificateExpirationSeconds is the shortest validity that can be requested for a certificate.
+const minCertificateExpirationSeconds = 600
+
+// maxCertificateCommonNameLength is the upper bound of the common name of a certificate (RFC 5280).
+const maxCertificateCommonNameLength = 64
+
+var certificateSecretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+func validateCertificates(certs []DeviceCertificateSpec, apps []ApplicationProviderSpec, fleetTemplate bool) []error {
+	allErrs := []error{}
+	seenNames := make(map[string]struct{}, len(certs))
+	appsByName := make(map[string]ApplicationProviderSpec, len(apps))
+	for _, app := range apps {
+		if name, err := app.GetName(); err == nil && name != nil {
+			appsByName[*name] = app
+		}
+	}
+
+	for i, cert := range certs {
+		pathPrefix := fmt.Sprintf("spec.certificates[%d]", i)
+		allErrs = append(allErrs, validation.ValidateGenericName(&cert.Name, pathPrefix+".name")...)
+		if _, exists := seenNames[cert.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: duplicate certificate name: %s", pathPrefix, cert.Name))
+		}
+		seenNames[cert.Name] = struct{}{}
+
+		if cert.CommonName != nil {
+			containsParams, paramErrs := validateParametersInString(cert.CommonName, pathPrefix+".commonName", fleetTemplate)
+			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validation.ValidateString(cert.CommonName, pathPrefix+".commonName", 1, maxCertificateCommonNameLength, nil, "")...)
+			}
+		}
+		for j, dnsName := range lo.FromPtr(cert.DnsNames) {
+			path := fmt.Sprintf("%s.dnsNames[%d]", pathPrefix, j)
+			containsParams, paramErrs := validateParametersInString(&dnsName, path, fleetTemplate)
+			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validation.ValidateHostnameOrFQDN(&dnsName, path)...)
+			}
+		}
+		for j, ipAddress := range lo.FromPtr(cert.IpAddresses) {
+			path := fmt.Sprintf("%s.ipAddresses[%d]", pathPrefix, j)
+			containsParams, paramErrs := validateParametersInString(&ipAddress, path, fleetTemplate)
+			allErrs = append(allErrs, paramErrs...)
+			if !containsParams && net.ParseIP(ipAddress) == nil {
+				allErrs = append(allErrs, fmt.Errorf("%s: invalid IP address %q", path, ipAddress))
+			}
+		}
+
+		if cert.Issuer != nil {
+			allErrs = append(allErrs, cert.Issuer.Validate(pathPrefix+".issuer")...)
+		}
+		if cert.ExpirationSeconds != nil {
+			if cert.Issuer != nil && cert.Issuer.Type == CertificateIssuerTypeAcme {
+				allErrs = append(allErrs, fmt.Errorf("%s.expirationSeconds: is only supported by the %s issuer", pathPrefix, CertificateIssuerTypeFlightctl))
+			} else if *cert.ExpirationSeconds < minCertificateExpirationSeconds {
+				allErrs = append(allErrs, fmt.Errorf("%s.expirationSeconds: must be at least %d", pathPrefix, minCertificateExpirationSeconds))
+			}
+		}
+		if cert.Issuer != nil && cert.Issuer.Type == CertificateIssuerTypeAcme && len(lo.FromPtr(cert.DnsNames))+len(lo.FromPtr(cert.IpAddresses)) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s: certificates issued by ACME must specify at least one DNS name or IP address", pathPrefix))
+		}
+		if cert.RenewBefore != nil {
+			renewBefore, err := time.ParseDuration(*cert.RenewBefore)
+			if err != nil {
+				allErrs = append(allErrs, fmt.Errorf("%s.renewBefore: invalid duration %q: %w", pathPrefix, *cert.RenewBefore, err))
+			} else if renewBefore <= 0 {
+				allErrs = append(allErrs, fmt.Errorf("%s.renewBefore: must be positive, got %q", pathPrefix, *cert.RenewBefore))
+			}
+		}
+
+		allErrs = append(allErrs, cert.Destination.Validate(pathPrefix+".destination", appsByName)...)
+
+		if cert.Reload != nil {
+			for j, unit := range lo.FromPtr(cert.Reload.SystemdUnits) {
+				allErrs = append(allErrs, validation.ValidateSystemdName(&unit, fmt.Sprintf("%s.reload.systemdUnits[%d]", pathPrefix, j))...)
+			}
+		}
+	}
+	return allErrs
+}
+
+func (i CertificateIssuerSpec) Validate(path string) []error {
+	allErrs := []error{}
+	switch i.Type {
+	case CertificateIssuerTypeFlightctl:
+		if i.Acme != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.acme: must not be set for the %s issuer", path, i.Type))
+		}
+	case CertificateIssuerTypeAcme:
+		if i.Acme == nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.acme: is required for the %s issuer", path, i.Type))
+			break
+		}
+		directoryURL, err := url.Parse(i.Acme.DirectoryUrl)
+		if err != nil || directoryURL.Scheme != "https" || directoryURL.Host == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.acme.directoryUrl: must be an https URL, got %q", path, i.Acme.DirectoryUrl))
+		}
+		if i.Acme.HttpPort != nil && (*i.Acme.HttpPort < 1 || *i.Acme.HttpPort > 65535) {
+			allErrs = append(allErrs, fmt.Errorf("%s.acme.httpPort: must be between 1 and 65535", path))
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s.type: unsupported issuer type %q", path, i.Type))
+	}
+	return allErrs
+}
+
+func (d CertificateDestination) Validate(path string, appsByName map[string]ApplicationProviderSpec) []error {
+	allErrs := []error{}
+	count := 0
+	if d.File != nil {
+		count++
+		allErrs = append(allErrs, validation.ValidateFilePath(&d.File.CertPath, path+".file.certPath")...)
+		allErrs = append(allErrs, validation.ValidateFilePath(&d.File.KeyPath, path+".file.keyPath")...)
+		if d.File.CertPath == d.File.KeyPath {
+			allErrs = append(allErrs, fmt.Errorf("%s.file: certPath and keyPath must differ", path))
+		}
+	}
+	if d.ApplicationVolume != nil {
+		count++
+		allErrs = append(allErrs, d.ApplicationVolume.Validate(path+".applicationVolume", appsByName)...)
+	}
+	if d.ApplicationSecret != nil {
+		count++
+		allErrs = append(allErrs, d.ApplicationSecret.Validate(path+".applicationSecret", appsByName)...)
+	}
+	if count != 1 {
+		allErrs = append(allErrs, fmt.Errorf("%s: exactly one of file, applicationVolume or applicationSecret must be specified", path))
+	}
+	return allErrs
+}
+
+func (d CertificateApplicationVolumeDestination) Validate(path string, appsByName map[string]ApplicationProviderSpec) []error {
+	allErrs := []error{}
+	app, ok := appsByName[d.Application]
+	if !ok {
+		return append(allErrs, fmt.Errorf("%s.application: unknown application %q", path, d.Application))
+	}
+	volumes, err := app.GetVolumes()
+	if err != nil {
+		return append(allErrs, fmt.Errorf("%s.application: %w", path, err))
+	}
+	if !slices.ContainsFunc(volumes, func(v ApplicationVolume) bool { return v.Name == d.Volume }) {
+		allErrs = append(allErrs, fmt.Errorf("%s.volume: application %q has no volume %q", path, d.Application, d.Volume))
+	}
+	allErrs = append(allErrs, validateCertificateFileName(d.CertFile, path+".certFile")...)
+	allErrs = append(allErrs, validateCertificateFileName(d.KeyFile, path+".keyFile")...)
+	if d.CertFile != nil && d.KeyFile != nil && *d.CertFile == *d.KeyFile {
+		allErrs = append(allErrs, fmt.Errorf("%s: certFile and keyFile must differ", path))
+	}
+	return allErrs
+}
+
+func validateCertificateFileName(fileName *string, path string) []error {
+	if fileName == nil {
+		return nil
+	}
+	if *fileName == "" || *fileName == "." || *fileName == ".." || strings.ContainsRune(*fileName, '/') {
+		return []error{fmt.Errorf("%s: must be a file name, got %q", path, *fileName)}
+	}
+	return nil
+}
+
+func (d CertificateApplicationSecretDestination) Validate(path string, appsByName map[string]ApplicationProviderSpec) []error {
+	allErrs := validation.ValidateString(&d.Name, path+".name", 1, 200, certificateSecretNameRegexp, certificateSecretNameRegexp.String())
+	app, ok := appsByName[d.Application]
+	if !ok {
+		return append(allErrs, fmt.Errorf("%s.application: unknown application %q", path, d.Application))
+	}
+	appType, err := app.GetAppType()
+	if err != nil {
+		return append(allErrs, fmt.Errorf("%s.application: %w", path, err))
+	}
+	if appType != AppTypeContainer && appType != AppTypeCompose && appType != AppTypeQuadlet {
+		allErrs = append(allErrs, fmt.Errorf("%s.application: secrets are not supported for %s applications", path, appType))
+	}
 	return allErrs
 }
 
@@ -170,6 +418,20 @@ func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 				break
 			}
 			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case SecretStoreConfigProviderType:
+			provider, err := config.AsSecretConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case OciConfigProviderType:
+			provider, err := config.AsOciConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
 		default:
 			// if we hit this case, it means that the type should be added to the switch statement above
 			allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
@@ -387,6 +649,86 @@ func (c KubernetesSecretProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+func (c SecretConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&c.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.SecretStoreRef.SecretStore, "spec.config[].secretStoreRef.secretStore")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.SecretStoreRef.Path, "spec.config[].secretStoreRef.path", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateRelativePath(&c.SecretStoreRef.Path, "spec.config[].secretStoreRef.path", 1024)...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.SecretStoreRef.MountPath, "spec.config[].secretStoreRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.SecretStoreRef.MountPath, "spec.config[].secretStoreRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.SecretStoreRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].secretStoreRef.mountPath: %w", err))
+		}
+	}
+
+	if c.SecretStoreRef.Keys != nil {
+		for i := range *c.SecretStoreRef.Keys {
+			// Each key is written to a file of the same name under mountPath
+			key := (*c.SecretStoreRef.Keys)[i]
+			keyPath := fmt.Sprintf("spec.config[].secretStoreRef.keys[%d]", i)
+			allErrs = append(allErrs, validation.ValidateString(&key, keyPath, 1, 253, nil, "")...)
+			if strings.Contains(key, "/") || key == "." || key == ".." {
+				allErrs = append(allErrs, fmt.Errorf("%s: must be a valid file name: %q", keyPath, key))
+			}
+		}
+	}
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.SecretStoreRef.User.String(), "spec.config[].secretStoreRef.user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.SecretStoreRef.Group, "spec.config[].secretStoreRef.group")...)
+	allErrs = append(allErrs, validation.ValidateLinuxFileMode(c.SecretStoreRef.Mode, "spec.config[].secretStoreRef.mode")...)
+
+	return allErrs
+}
+
+func (c OciConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&c.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.OciRef.Repository, "spec.config[].ociRef.repository")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.OciRef.Artifact, "spec.config[].ociRef.artifact", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateOciRepositoryPath(&c.OciRef.Artifact, "spec.config[].ociRef.artifact")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.OciRef.Reference, "spec.config[].ociRef.reference", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateOciTagOrDigest(&c.OciRef.Reference, "spec.config[].ociRef.reference")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.OciRef.MountPath, "spec.config[].ociRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.OciRef.MountPath, "spec.config[].ociRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.OciRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].ociRef.mountPath: %w", err))
+		}
+	}
+
+	if c.OciRef.Layers != nil {
+		for i := range *c.OciRef.Layers {
+			allErrs = append(allErrs, validation.ValidateString(&(*c.OciRef.Layers)[i], fmt.Sprintf("spec.config[].ociRef.layers[%d]", i), 1, 253, nil, "")...)
+		}
+	}
+	if c.OciRef.Paths != nil {
+		for i := range *c.OciRef.Paths {
+			allErrs = append(allErrs, validation.ValidateRelativePath(&(*c.OciRef.Paths)[i], fmt.Sprintf("spec.config[].ociRef.paths[%d]", i), 1024)...)
+		}
+	}
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.OciRef.User.String(), "spec.config[].ociRef.user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.OciRef.Group, "spec.config[].ociRef.group")...)
+
+	return allErrs
+}
+
 func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateGenericName(&c.Name, "spec.config[].name")...)
@@ -470,6 +812,241 @@ func (r EnrollmentRequestApproval) Validate() []error {
 	return allErrs
 }
 
+func (r EnrollmentApprovalPolicy) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(r.Spec.Labels, "spec.labels")...)
+
+	match := r.Spec.Match
+	if !lo.FromPtr(match.TpmAttested) && match.EnrollmentCredentials == nil && match.AllowList == nil && match.LabelSelector == nil {
+		allErrs = append(allErrs, errors.New("spec.match: at least one of [tpmAttested,enrollmentCredentials,allowList,labelSelector] must be specified"))
+	}
+	if match.EnrollmentCredentials != nil {
+		if len(*match.EnrollmentCredentials) == 0 {
+			allErrs = append(allErrs, errors.New("spec.match.enrollmentCredentials must not be empty"))
+		}
+		for i := range *match.EnrollmentCredentials {
+			allErrs = append(allErrs, validation.ValidateString(&(*match.EnrollmentCredentials)[i], fmt.Sprintf("spec.match.enrollmentCredentials[%d]", i), 1, 256, nil, "")...)
+		}
+	}
+	if match.AllowList != nil && len(lo.FromPtr(match.AllowList.SerialNumbers)) == 0 && len(lo.FromPtr(match.AllowList.ProductNames)) == 0 {
+		allErrs = append(allErrs, errors.New("spec.match.allowList: at least one of [serialNumbers,productNames] must not be empty"))
+	}
+	if (match.AllowList != nil || match.LabelSelector != nil) && !lo.FromPtr(match.TpmAttested) {
+		allErrs = append(allErrs, errors.New("spec.match: allowList and labelSelector require tpmAttested, as the values they match are reported by the device"))
+	}
+	allErrs = append(allErrs, match.LabelSelector.Validate()...)
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for EnrollmentApprovalPolicy.
+func (r *EnrollmentApprovalPolicy) ValidateUpdate(newObj *EnrollmentApprovalPolicy) []error {
+	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
+		r.ApiVersion, newObj.ApiVersion,
+		r.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func (r Role) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+
+	if name := lo.FromPtr(r.Metadata.Name); slices.Contains(KnownInternalRoles, name) || slices.Contains(KnownExternalRoles, name) {
+		allErrs = append(allErrs, fmt.Errorf("metadata.name: %q is the name of a built-in role", name))
+	}
+	if len(r.Spec.Rules) == 0 {
+		allErrs = append(allErrs, errors.New("spec.rules must not be empty"))
+	}
+	for i, rule := range r.Spec.Rules {
+		if len(rule.Resources) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("spec.rules[%d].resources must not be empty", i))
+		}
+		for j := range rule.Resources {
+			allErrs = append(allErrs, validation.ValidateString(&rule.Resources[j], fmt.Sprintf("spec.rules[%d].resources[%d]", i, j), 1, 256, nil, "")...)
+		}
+		if len(rule.Verbs) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("spec.rules[%d].verbs must not be empty", i))
+		}
+		for j, verb := range rule.Verbs {
+			if verb != "*" && !slices.Contains(RoleVerbs, verb) {
+				allErrs = append(allErrs, fmt.Errorf("spec.rules[%d].verbs[%d]: unsupported verb %q, must be one of %v or \"*\"", i, j, verb, RoleVerbs))
+			}
+		}
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for Role.
+func (r *Role) ValidateUpdate(newObj *Role) []error {
+	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
+		r.ApiVersion, newObj.ApiVersion,
+		r.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func (r RoleBinding) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.Spec.RoleRef, "spec.roleRef")...)
+
+	if len(r.Spec.Subjects) == 0 {
+		allErrs = append(allErrs, errors.New("spec.subjects must not be empty"))
+	}
+	for i, subject := range r.Spec.Subjects {
+		if subject.Kind != RoleBindingSubjectKindUser && subject.Kind != RoleBindingSubjectKindGroup {
+			allErrs = append(allErrs, fmt.Errorf("spec.subjects[%d].kind: unsupported kind %q, must be one of [%s,%s]", i, subject.Kind, RoleBindingSubjectKindUser, RoleBindingSubjectKindGroup))
+		}
+		allErrs = append(allErrs, validation.ValidateString(&r.Spec.Subjects[i].Name, fmt.Sprintf("spec.subjects[%d].name", i), 1, 256, nil, "")...)
+	}
+	if r.Spec.LabelSelector != nil {
+		if strings.TrimSpace(*r.Spec.LabelSelector) == "" {
+			allErrs = append(allErrs, errors.New("spec.labelSelector must not be empty"))
+		} else if _, err := labels.Parse(*r.Spec.LabelSelector); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.labelSelector: %w", err))
+		}
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for RoleBinding.
+func (r *RoleBinding) ValidateUpdate(newObj *RoleBinding) []error {
+	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
+		r.ApiVersion, newObj.ApiVersion,
+		r.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func (r ServiceAccount) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validateServiceAccountRoles(r.Spec.Roles, "spec.roles")...)
+	allErrs = append(allErrs, validation.ValidateString(r.Spec.Description, "spec.description", 0, 1024, nil, "")...)
+	if len(r.Spec.Roles) == 0 {
+		allErrs = append(allErrs, errors.New("spec.roles must not be empty"))
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for ServiceAccount.
+func (r *ServiceAccount) ValidateUpdate(newObj *ServiceAccount) []error {
+	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
+		r.ApiVersion, newObj.ApiVersion,
+		r.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func (r ServiceAccountToken) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	if r.Spec.Roles != nil {
+		if len(*r.Spec.Roles) == 0 {
+			allErrs = append(allErrs, errors.New("spec.roles must not be empty"))
+		}
+		allErrs = append(allErrs, validateServiceAccountRoles(*r.Spec.Roles, "spec.roles")...)
+	}
+	return allErrs
+}
+
+// validateServiceAccountRoles validates the roles granted to a service account or its tokens. Service
+// accounts are scoped to their organization, so they cannot be granted the global admin role.
+func validateServiceAccountRoles(roles []string, path string) []error {
+	allErrs := []error{}
+	for i := range roles {
+		allErrs = append(allErrs, validation.ValidateString(&roles[i], fmt.Sprintf("%s[%d]", path, i), 1, 256, nil, "")...)
+		if roles[i] == ExternalRoleAdmin || roles[i] == RoleAdmin {
+			allErrs = append(allErrs, fmt.Errorf("%s[%d]: service accounts cannot be granted the %q role, use %q instead", path, i, roles[i], ExternalRoleOrgAdmin))
+		}
+	}
+	return allErrs
+}
+
+func (r DeviceBulkOperation) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+
+	spec := r.Spec
+	if strings.TrimSpace(lo.FromPtr(spec.LabelSelector)) == "" && strings.TrimSpace(lo.FromPtr(spec.FieldSelector)) == "" {
+		allErrs = append(allErrs, errors.New("at least one of spec.labelSelector or spec.fieldSelector must be specified"))
+	}
+	if spec.LabelSelector != nil {
+		if _, err := labels.Parse(*spec.LabelSelector); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.labelSelector: %w", err))
+		}
+	}
+
+	switch spec.Operation {
+	case DeviceBulkOperationTypeUpdateMetadata:
+		if spec.Metadata == nil {
+			allErrs = append(allErrs, errors.New("spec.metadata must be specified for the UpdateMetadata operation"))
+		} else {
+			allErrs = append(allErrs, spec.Metadata.Validate()...)
+		}
+	case DeviceBulkOperationTypeDecommission:
+		if spec.Decommission == nil {
+			allErrs = append(allErrs, errors.New("spec.decommission must be specified for the Decommission operation"))
+		} else if spec.Decommission.Target != DeviceDecommissionTargetTypeUnenroll && spec.Decommission.Target != DeviceDecommissionTargetTypeFactoryReset {
+			allErrs = append(allErrs, fmt.Errorf("spec.decommission.target: unsupported target %q", spec.Decommission.Target))
+		}
+	case DeviceBulkOperationTypeResume, DeviceBulkOperationTypeRerender:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.operation: unsupported operation %q, must be one of [%s,%s,%s,%s]", spec.Operation,
+			DeviceBulkOperationTypeUpdateMetadata, DeviceBulkOperationTypeDecommission, DeviceBulkOperationTypeResume, DeviceBulkOperationTypeRerender))
+	}
+	if spec.Operation != DeviceBulkOperationTypeUpdateMetadata && spec.Metadata != nil {
+		allErrs = append(allErrs, fmt.Errorf("spec.metadata can only be specified for the %s operation", DeviceBulkOperationTypeUpdateMetadata))
+	}
+	if spec.Operation != DeviceBulkOperationTypeDecommission && spec.Decommission != nil {
+		allErrs = append(allErrs, fmt.Errorf("spec.decommission can only be specified for the %s operation", DeviceBulkOperationTypeDecommission))
+	}
+	return allErrs
+}
+
+// serviceManagedAnnotationPrefixes are the prefixes of the device annotations managed by the service,
+// which cannot be changed by bulk operations.
+var serviceManagedAnnotationPrefixes = []string{"device-controller/", "fleet-controller/", "resourcesync-controller/"}
+
+func (m DeviceBulkMetadataUpdate) Validate() []error {
+	allErrs := []error{}
+	if len(lo.FromPtr(m.SetLabels)) == 0 && len(lo.FromPtr(m.RemoveLabels)) == 0 &&
+		len(lo.FromPtr(m.SetAnnotations)) == 0 && len(lo.FromPtr(m.RemoveAnnotations)) == 0 {
+		allErrs = append(allErrs, errors.New("spec.metadata must set or remove at least one label or annotation"))
+	}
+
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(m.SetLabels, "spec.metadata.setLabels")...)
+	removedLabels := lo.SliceToMap(lo.FromPtr(m.RemoveLabels), func(key string) (string, string) { return key, "" })
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(&removedLabels, "spec.metadata.removeLabels")...)
+	for key := range lo.FromPtr(m.SetLabels) {
+		if _, ok := removedLabels[key]; ok {
+			allErrs = append(allErrs, fmt.Errorf("spec.metadata: label %q cannot be both set and removed", key))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateAnnotations(m.SetAnnotations)...)
+	removedAnnotations := lo.SliceToMap(lo.FromPtr(m.RemoveAnnotations), func(key string) (string, string) { return key, "" })
+	allErrs = append(allErrs, validation.ValidateAnnotations(&removedAnnotations)...)
+	for key := range lo.Assign(lo.FromPtr(m.SetAnnotations), removedAnnotations) {
+		if lo.SomeBy(serviceManagedAnnotationPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }) {
+			allErrs = append(allErrs, fmt.Errorf("spec.metadata: annotation %q is managed by the service and cannot be changed", key))
+		}
+	}
+	for key := range lo.FromPtr(m.SetAnnotations) {
+		if _, ok := removedAnnotations[key]; ok {
+			allErrs = append(allErrs, fmt.Errorf("spec.metadata: annotation %q cannot be both set and removed", key))
+		}
+	}
+	return allErrs
+}
+
 func (r CertificateSigningRequest) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -490,6 +1067,32 @@ func (csr *CertificateSigningRequest) ValidateUpdate(newObj *CertificateSigningR
 		csr.Status, newObj.Status)
 }
 
+var certificateSerialNumberRegexp = regexp.MustCompile(`^([0-9a-fA-F]+|[0-9a-fA-F]{1,2}(:[0-9a-fA-F]{2})*)$`)
+
+func (r CertificateRevocationRequest) Validate() []error {
+	allErrs := []error{}
+	hasDeviceName := r.DeviceName != nil && *r.DeviceName != ""
+	hasSerialNumber := r.SerialNumber != nil && *r.SerialNumber != ""
+	switch {
+	case hasDeviceName == hasSerialNumber:
+		allErrs = append(allErrs, errors.New("exactly one of deviceName or serialNumber must be specified"))
+	case hasDeviceName:
+		allErrs = append(allErrs, validation.ValidateResourceName(r.DeviceName)...)
+	case !certificateSerialNumberRegexp.MatchString(*r.SerialNumber):
+		allErrs = append(allErrs, fmt.Errorf("serialNumber %q must be a hexadecimal number", *r.SerialNumber))
+	}
+	if r.Reason != nil {
+		switch *r.Reason {
+		case CertificateRevocationReasonUnspecified, CertificateRevocationReasonKeyCompromise,
+			CertificateRevocationReasonAffiliationChanged, CertificateRevocationReasonSuperseded,
+			CertificateRevocationReasonCessationOfOperation, CertificateRevocationReasonPrivilegeWithdrawn:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("unsupported revocation reason %q", *r.Reason))
+		}
+	}
+	return allErrs
+}
+
 func (b *Batch_Limit) Validate() []error {
 	if b == nil {
 		return nil
@@ -590,6 +1193,10 @@ func (r Fleet) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
 	allErrs = append(allErrs, r.Spec.Selector.Validate()...)
+	allErrs = append(allErrs, r.Spec.FieldSelector.Validate()...)
+	if r.Spec.FieldSelector != nil && r.Spec.Selector == nil {
+		allErrs = append(allErrs, errors.New("spec.fieldSelector requires spec.selector"))
+	}
 	allErrs = append(allErrs, r.Spec.RolloutPolicy.Validate()...)
 
 	// Validate the Device spec settings
@@ -721,6 +1328,70 @@ func (r *Repository) ValidateUpdate(newObj *Repository) []error {
 		r.Status, newObj.Status)
 }
 
+func (s *SecretStore) Validate() []error {
+	if s == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(s.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(s.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(s.Metadata.Annotations)...)
+
+	if u, err := url.Parse(s.Spec.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, fmt.Errorf("spec.address: must be an http or https URL: %q", s.Spec.Address))
+	}
+	if s.Spec.MountPath != nil {
+		allErrs = append(allErrs, validation.ValidateRelativePath(s.Spec.MountPath, "spec.mountPath", 256)...)
+	}
+	if s.Spec.Namespace != nil {
+		allErrs = append(allErrs, validation.ValidateString(s.Spec.Namespace, "spec.namespace", 1, 256, nil, "")...)
+	}
+	if s.Spec.KvVersion != nil && *s.Spec.KvVersion != SecretStoreKvVersion1 && *s.Spec.KvVersion != SecretStoreKvVersion2 {
+		allErrs = append(allErrs, fmt.Errorf("spec.kvVersion: unsupported key/value engine version: %d", *s.Spec.KvVersion))
+	}
+	if s.Spec.Tls != nil && s.Spec.Tls.CaCrt != nil {
+		allErrs = append(allErrs, validation.ValidateBase64Field(*s.Spec.Tls.CaCrt, "spec.tls.ca.crt", maxBase64CertificateLength)...)
+	}
+
+	method, err := s.Spec.Auth.Discriminator()
+	if err != nil {
+		allErrs = append(allErrs, fmt.Errorf("invalid secret store auth: %w", err))
+		return allErrs
+	}
+	switch SecretStoreAuthMethod(method) {
+	case SecretStoreAuthMethodToken:
+		tokenAuth, err := s.Spec.Auth.AsTokenSecretStoreAuth()
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("invalid token auth: %w", err))
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&tokenAuth.Token, "spec.auth.token", 1, 8192, nil, "")...)
+	case SecretStoreAuthMethodAppRole:
+		appRoleAuth, err := s.Spec.Auth.AsAppRoleSecretStoreAuth()
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("invalid AppRole auth: %w", err))
+			return allErrs
+		}
+		if appRoleAuth.MountPath != nil {
+			allErrs = append(allErrs, validation.ValidateRelativePath(appRoleAuth.MountPath, "spec.auth.mountPath", 256)...)
+		}
+		allErrs = append(allErrs, validation.ValidateString(&appRoleAuth.RoleId, "spec.auth.roleId", 1, 256, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateString(&appRoleAuth.SecretId, "spec.auth.secretId", 1, 8192, nil, "")...)
+	default:
+		allErrs = append(allErrs, fmt.Errorf("unknown secret store auth method: %s", method))
+	}
+
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for SecretStore.
+func (s *SecretStore) ValidateUpdate(newObj *SecretStore) []error {
+	return validateImmutableCoreFields(s.Metadata.Name, newObj.Metadata.Name,
+		s.ApiVersion, newObj.ApiVersion,
+		s.Kind, newObj.Kind,
+		s.Status, newObj.Status)
+}
+
 func (r ResourceSync) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -729,6 +1400,14 @@ func (r ResourceSync) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.Spec.Repository, "spec.repository")...)
 	allErrs = append(allErrs, validation.ValidateGitRevision(&r.Spec.TargetRevision, "spec.targetRevision")...)
 	allErrs = append(allErrs, validation.ValidateString(&r.Spec.Path, "spec.path", 0, 2048, nil, "")...)
+	if r.Spec.Kinds != nil {
+		manageableKinds := r.Spec.GetType().ManageableKinds()
+		for i, kind := range *r.Spec.Kinds {
+			if !lo.Contains(manageableKinds, kind) {
+				allErrs = append(allErrs, fmt.Errorf("spec.kinds[%d]: kind %q can't be managed by a ResourceSync of type %q, must be one of %v", i, kind, r.Spec.GetType(), manageableKinds))
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -739,14 +1418,8 @@ func (rs *ResourceSync) ValidateUpdate(newObj *ResourceSync) []error {
 		rs.Kind, newObj.Kind,
 		rs.Status, newObj.Status)
 
-	normalizeType := func(t *ResourceSyncType) ResourceSyncType {
-		if t == nil {
-			return ResourceSyncTypeFleet
-		}
-		return *t
-	}
-	oldType := normalizeType(rs.Spec.Type)
-	newType := normalizeType(newObj.Spec.Type)
+	oldType := rs.Spec.GetType()
+	newType := newObj.Spec.GetType()
 	if oldType != newType {
 		allErrs = append(allErrs, fmt.Errorf("spec.type is immutable once set (was %q, got %q)", oldType, newType))
 	}
@@ -772,6 +1445,22 @@ func (l *LabelSelector) Validate() []error {
 	return nil
 }
 
+func (s *FleetFieldSelector) Validate() []error {
+	if s == nil {
+		return nil
+	}
+	allErrs := []error{}
+	if len(s.MatchFields) == 0 {
+		allErrs = append(allErrs, errors.New("spec.fieldSelector.matchFields must not be empty"))
+	}
+	for _, field := range slices.Sorted(maps.Keys(s.MatchFields)) {
+		if !IsFleetSelectorField(field) {
+			allErrs = append(allErrs, fmt.Errorf("spec.fieldSelector.matchFields: unsupported field %q", field))
+		}
+	}
+	return allErrs
+}
+
 func (d *DeviceSystemInfo) IsEmpty() bool {
 	empty := DeviceSystemInfo{}
 	return reflect.DeepEqual(*d, empty)
@@ -949,11 +1638,15 @@ func validateApplications(apps []ApplicationProviderSpec, fleetTemplate bool) []
 			allErrs = append(allErrs, validateComposeApplication(app, appName, fleetTemplate)...)
 		case AppTypeQuadlet:
 			allErrs = append(allErrs, validateQuadletApplication(app, appName, fleetTemplate)...)
+		case AppTypeSystemd:
+			allErrs = append(allErrs, validateSystemdApplication(app, appName, fleetTemplate)...)
 		default:
 			allErrs = append(allErrs, fmt.Errorf("unknown application type: %s", appType))
 		}
 	}
 
+	allErrs = append(allErrs, validateApplicationDependencies(apps)...)
+	allErrs = append(allErrs, validateApplicationIPC(apps)...)
 	return allErrs
 }
 
@@ -976,6 +1669,9 @@ func validateContainerApplication(app ApplicationProviderSpec, appName string, f
 
 	allErrs = append(allErrs, validateEnvVars(container.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(container.Volumes, appName, AppTypeContainer, fleetTemplate)...)
+	allErrs = append(allErrs, validateHealthProbes(container.ReadinessProbe, container.LivenessProbe, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationResourceMonitors(container.ResourceMonitors, pathPrefix)...)
+	allErrs = append(allErrs, validateHostAccess(container.HostAccess(), pathPrefix)...)
 
 	return allErrs
 }
@@ -1000,6 +1696,22 @@ func ValidateHelmApplication(app ApplicationProviderSpec, appName string, fleetT
 			allErrs = append(allErrs, validation.ValidateHelmValuesFile(&vf, fmt.Sprintf("%s.valuesFiles[%d]", pathPrefix, i), validation.DNS1123MaxLength)...)
 		}
 	}
+	allErrs = append(allErrs, validateApplicationResourceMonitors(helm.ResourceMonitors, pathPrefix)...)
+
+	return allErrs
+}
+
+func validateSystemdApplication(app ApplicationProviderSpec, appName string, fleetTemplate bool) []error {
+	allErrs := []error{}
+	pathPrefix := fmt.Sprintf("spec.applications[%s]", appName)
+
+	systemd, err := app.AsSystemdApplication()
+	if err != nil {
+		return []error{fmt.Errorf("invalid systemd application: %w", err)}
+	}
+
+	allErrs = append(allErrs, validateOciImageReference(&systemd.Image, pathPrefix+".image", fleetTemplate)...)
+	allErrs = append(allErrs, validateEnvVars(systemd.EnvVars, pathPrefix)...)
 
 	return allErrs
 }
@@ -1039,6 +1751,8 @@ func validateComposeApplication(app ApplicationProviderSpec, appName string, fle
 
 	allErrs = append(allErrs, validateEnvVars(compose.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(compose.Volumes, appName, AppTypeCompose, fleetTemplate)...)
+	allErrs = append(allErrs, validateHealthProbes(compose.ReadinessProbe, compose.LivenessProbe, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationResourceMonitors(compose.ResourceMonitors, pathPrefix)...)
 
 	return allErrs
 }
@@ -1078,6 +1792,9 @@ func validateQuadletApplication(app ApplicationProviderSpec, appName string, fle
 
 	allErrs = append(allErrs, validateEnvVars(quadlet.EnvVars, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(quadlet.Volumes, appName, AppTypeQuadlet, fleetTemplate)...)
+	allErrs = append(allErrs, validateHealthProbes(quadlet.ReadinessProbe, quadlet.LivenessProbe, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationResourceMonitors(quadlet.ResourceMonitors, pathPrefix)...)
+	allErrs = append(allErrs, validateHostAccess(quadlet.HostAccess(), pathPrefix)...)
 
 	return allErrs
 }
@@ -1163,6 +1880,100 @@ func validateVolume(vol ApplicationVolume, path string, fleetTemplate bool, appT
 	return errs
 }
 
+// validateApplicationResourceMonitors validates the resource monitors of an application, which
+// only support the CPU and Memory monitor types.
+func validateApplicationResourceMonitors(monitors *[]ResourceMonitor, pathPrefix string) []error {
+	if monitors == nil {
+		return nil
+	}
+
+	allErrs := []error{}
+	for i, monitor := range *monitors {
+		monitorType, err := monitor.Discriminator()
+		if err == nil && monitorType != "CPU" && monitorType != "Memory" {
+			allErrs = append(allErrs, fmt.Errorf("%s.resourceMonitors[%d]: unsupported monitor type %q, valid types are CPU and Memory", pathPrefix, i, monitorType))
+			continue
+		}
+		allErrs = append(allErrs, monitor.Validate()...)
+	}
+	allErrs = append(allErrs, validateResourceMonitor(*monitors)...)
+	return allErrs
+}
+
+func validateHealthProbes(readiness, liveness *ApplicationProbe, pathPrefix string) []error {
+	allErrs := []error{}
+	if readiness != nil {
+		allErrs = append(allErrs, validateProbe(readiness, pathPrefix+".readinessProbe")...)
+		if readiness.FailureAction != nil && *readiness.FailureAction == ApplicationProbeFailureActionRestart {
+			allErrs = append(allErrs, fmt.Errorf("%s.readinessProbe.failureAction: %q is only supported for livenessProbe", pathPrefix, ApplicationProbeFailureActionRestart))
+		}
+	}
+	if liveness != nil {
+		allErrs = append(allErrs, validateProbe(liveness, pathPrefix+".livenessProbe")...)
+	}
+	return allErrs
+}
+
+func validateProbe(probe *ApplicationProbe, path string) []error {
+	allErrs := []error{}
+
+	handlers := 0
+	if probe.HttpGet != nil {
+		handlers++
+		allErrs = append(allErrs, validateProbePort(probe.HttpGet.Port, path+".httpGet.port")...)
+		if probe.HttpGet.Path != nil && !strings.HasPrefix(*probe.HttpGet.Path, "/") {
+			allErrs = append(allErrs, fmt.Errorf("%s.httpGet.path: must start with '/', got %q", path, *probe.HttpGet.Path))
+		}
+		if probe.HttpGet.Scheme != nil && *probe.HttpGet.Scheme != HttpGetProbeSchemeHttp && *probe.HttpGet.Scheme != HttpGetProbeSchemeHttps {
+			allErrs = append(allErrs, fmt.Errorf("%s.httpGet.scheme: must be %q or %q, got %q", path, HttpGetProbeSchemeHttp, HttpGetProbeSchemeHttps, *probe.HttpGet.Scheme))
+		}
+	}
+	if probe.TcpSocket != nil {
+		handlers++
+		allErrs = append(allErrs, validateProbePort(probe.TcpSocket.Port, path+".tcpSocket.port")...)
+	}
+	if probe.Exec != nil {
+		handlers++
+		if len(probe.Exec.Command) == 0 || probe.Exec.Command[0] == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.exec.command: must not be empty", path))
+		}
+		if probe.Exec.Container != nil {
+			allErrs = append(allErrs, validation.ValidateGenericName(probe.Exec.Container, path+".exec.container")...)
+		}
+	}
+	if handlers != 1 {
+		allErrs = append(allErrs, fmt.Errorf("%s: exactly one of httpGet, tcpSocket or exec must be set", path))
+	}
+
+	if probe.InitialDelaySeconds != nil && *probe.InitialDelaySeconds < 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.initialDelaySeconds: must not be negative", path))
+	}
+	for _, field := range []struct {
+		name  string
+		value *int
+	}{
+		{"periodSeconds", probe.PeriodSeconds},
+		{"timeoutSeconds", probe.TimeoutSeconds},
+		{"successThreshold", probe.SuccessThreshold},
+		{"failureThreshold", probe.FailureThreshold},
+	} {
+		if field.value != nil && *field.value < 1 {
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: must be at least 1", path, field.name))
+		}
+	}
+	if probe.FailureAction != nil && *probe.FailureAction != ApplicationProbeFailureActionNone && *probe.FailureAction != ApplicationProbeFailureActionRestart {
+		allErrs = append(allErrs, fmt.Errorf("%s.failureAction: unknown action %q", path, *probe.FailureAction))
+	}
+	return allErrs
+}
+
+func validateProbePort(port int, path string) []error {
+	if port < 1 || port > portRangeEnd {
+		return []error{fmt.Errorf("%s: must be in the valid port range of [1, %d], got %d", path, portRangeEnd, port)}
+	}
+	return nil
+}
+
 func validateContainerPorts(ports *[]ApplicationPort, path string) []error {
 	if ports == nil || len(*ports) == 0 {
 		return nil
@@ -1226,6 +2037,84 @@ func validatePodmanMemoryLimit(memory *string, path string) []error {
 	return nil
 }
 
+var (
+	capabilityPattern    = regexp.MustCompile(`^(CAP_)?[A-Z][A-Z0-9_]*$`)
+	podmanNetworkPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// validateHostAccess returns any errors for the host resources the containers of an application
+// are given access to. Whether the device allows them is checked by the agent.
+func validateHostAccess(access ApplicationHostAccess, pathPrefix string) []error {
+	var allErrs []error
+	for i, device := range lo.FromPtr(access.Devices) {
+		if err := validateHostDevice(device); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.devices[%d]: %w", pathPrefix, i, err))
+		}
+	}
+
+	for i, capability := range lo.FromPtr(access.Capabilities) {
+		path := fmt.Sprintf("%s.capabilities[%d]", pathPrefix, i)
+		switch {
+		case !capabilityPattern.MatchString(capability):
+			allErrs = append(allErrs, fmt.Errorf("%s: must be a Linux capability name like NET_ADMIN, got %q", path, capability))
+		case strings.TrimPrefix(capability, "CAP_") == "ALL":
+			allErrs = append(allErrs, fmt.Errorf("%s: adding all capabilities is not supported", path))
+		}
+	}
+
+	if access.Network != nil {
+		network := *access.Network
+		if len(network) > validation.DNS1123MaxLength || !podmanNetworkPattern.MatchString(network) {
+			allErrs = append(allErrs, fmt.Errorf("%s.network: must be 'host', 'none' or the name of a podman network, got %q", pathPrefix, network))
+		}
+	}
+
+	if access.Ipc != nil {
+		ipc := *access.Ipc
+		switch {
+		case ipc == IpcPrivate, ipc == IpcShareable, ipc == IpcHost:
+		case strings.HasPrefix(ipc, IpcAppPrefix) && len(ipc) > len(IpcAppPrefix):
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.ipc: must be 'private', 'shareable', 'host' or 'app:NAME', got %q", pathPrefix, ipc))
+		}
+	}
+	return allErrs
+}
+
+// validateHostDevice returns an error if a device isn't in the format HOST-PATH[:CONTAINER-PATH][:PERMISSIONS].
+func validateHostDevice(device string) error {
+	parts := strings.Split(device, ":")
+	if len(parts) > 3 {
+		return fmt.Errorf("must be in format 'HOST-PATH[:CONTAINER-PATH][:PERMISSIONS]', got %q", device)
+	}
+
+	hostPath := parts[0]
+	if !strings.HasPrefix(hostPath, "/dev/") || path.Clean(hostPath) != hostPath {
+		return fmt.Errorf("host path must be a clean absolute path under /dev/, got %q", hostPath)
+	}
+
+	var containerPath, permissions string
+	switch {
+	case len(parts) == 3:
+		containerPath, permissions = parts[1], parts[2]
+	case len(parts) == 2 && strings.HasPrefix(parts[1], "/"):
+		containerPath = parts[1]
+	case len(parts) == 2:
+		permissions = parts[1]
+	}
+	if len(parts) == 3 || containerPath != "" {
+		if !strings.HasPrefix(containerPath, "/") || path.Clean(containerPath) != containerPath {
+			return fmt.Errorf("container path must be a clean absolute path, got %q", containerPath)
+		}
+	}
+	if len(parts) == 3 || permissions != "" {
+		if permissions == "" || strings.Trim(permissions, "rwm") != "" || len(lo.Uniq([]rune(permissions))) != len(permissions) {
+			return fmt.Errorf("permissions must be a combination of r, w and m, got %q", permissions)
+		}
+	}
+	return nil
+}
+
 func ensureAppName(app ApplicationProviderSpec) (string, error) {
 	name, err := app.GetName()
 	if err != nil {
@@ -1259,6 +2148,15 @@ func ensureAppName(app ApplicationProviderSpec) (string, error) {
 			return "", fmt.Errorf("helm image cannot be empty when application name is not provided")
 		}
 		return helm.Image, nil
+	case AppTypeSystemd:
+		systemd, err := app.AsSystemdApplication()
+		if err != nil {
+			return "", fmt.Errorf("invalid systemd application: %w", err)
+		}
+		if systemd.Image == "" {
+			return "", fmt.Errorf("systemd image cannot be empty when application name is not provided")
+		}
+		return systemd.Image, nil
 	case AppTypeCompose:
 		compose, err := app.AsComposeApplication()
 		if err != nil {
@@ -1813,6 +2711,15 @@ func (a AuthRoleAssignment) Validate(ctx context.Context) []error {
 	return allErrs
 }
 
+// IsAssignableRole reports whether a role can be assigned to users by an auth provider, which are the
+// known external roles and the names of custom roles. The names of the built-in roles are reserved.
+func IsAssignableRole(role string) bool {
+	if slices.Contains(KnownExternalRoles, role) {
+		return true
+	}
+	return !slices.Contains(KnownInternalRoles, role) && len(validation.ValidateResourceName(&role)) == 0
+}
+
 func (a AuthStaticRoleAssignment) Validate(ctx context.Context) []error {
 	allErrs := []error{}
 
@@ -1825,8 +2732,8 @@ func (a AuthStaticRoleAssignment) Validate(ctx context.Context) []error {
 		if role == "" {
 			allErrs = append(allErrs, fmt.Errorf("role at index %d cannot be empty", i))
 		}
-		if !slices.Contains(KnownExternalRoles, role) {
-			allErrs = append(allErrs, fmt.Errorf("role at index %d is not a valid role: %s", i, role))
+		if !IsAssignableRole(role) {
+			allErrs = append(allErrs, fmt.Errorf("role at index %d is not a valid role: %s (must be one of %v or the name of a custom role)", i, role, KnownExternalRoles))
 		}
 	}
 
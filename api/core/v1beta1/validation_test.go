@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"strings"
 	"testing"
 
@@ -1192,6 +1193,802 @@ func newTestApplicationWithPortsAndResources(require *require.Assertions, name s
 	return app
 }
 
+func TestValidateApplicationHealthProbes(t *testing.T) {
+	tests := []struct {
+		name        string
+		readiness   *ApplicationProbe
+		liveness    *ApplicationProbe
+		errorSubstr string
+	}{
+		{
+			name:      "http readiness and exec liveness probes",
+			readiness: &ApplicationProbe{HttpGet: &HttpGetProbe{Port: 8080, Path: lo.ToPtr("/healthz")}, PeriodSeconds: lo.ToPtr(5)},
+			liveness: &ApplicationProbe{
+				Exec:          &ExecProbe{Command: []string{"pg_isready"}},
+				FailureAction: lo.ToPtr(ApplicationProbeFailureActionRestart),
+			},
+		},
+		{
+			name:        "no probe handler",
+			readiness:   &ApplicationProbe{PeriodSeconds: lo.ToPtr(5)},
+			errorSubstr: "exactly one of httpGet, tcpSocket or exec must be set",
+		},
+		{
+			name:        "several probe handlers",
+			liveness:    &ApplicationProbe{HttpGet: &HttpGetProbe{Port: 8080}, TcpSocket: &TcpSocketProbe{Port: 8080}},
+			errorSubstr: "exactly one of httpGet, tcpSocket or exec must be set",
+		},
+		{
+			name:        "invalid port",
+			readiness:   &ApplicationProbe{TcpSocket: &TcpSocketProbe{Port: 70000}},
+			errorSubstr: "readinessProbe.tcpSocket.port: must be in the valid port range",
+		},
+		{
+			name:        "relative http path",
+			readiness:   &ApplicationProbe{HttpGet: &HttpGetProbe{Port: 8080, Path: lo.ToPtr("healthz")}},
+			errorSubstr: "readinessProbe.httpGet.path: must start with '/'",
+		},
+		{
+			name:        "empty exec command",
+			liveness:    &ApplicationProbe{Exec: &ExecProbe{}},
+			errorSubstr: "livenessProbe.exec.command: must not be empty",
+		},
+		{
+			name:        "zero failure threshold",
+			liveness:    &ApplicationProbe{TcpSocket: &TcpSocketProbe{Port: 8080}, FailureThreshold: lo.ToPtr(0)},
+			errorSubstr: "livenessProbe.failureThreshold: must be at least 1",
+		},
+		{
+			name:        "restart on readiness failure",
+			readiness:   &ApplicationProbe{TcpSocket: &TcpSocketProbe{Port: 8080}, FailureAction: lo.ToPtr(ApplicationProbeFailureActionRestart)},
+			errorSubstr: "is only supported for livenessProbe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			var app ApplicationProviderSpec
+			require.NoError(app.FromContainerApplication(ContainerApplication{
+				Name:           lo.ToPtr("app1"),
+				AppType:        AppTypeContainer,
+				Image:          "quay.io/app/image:1",
+				ReadinessProbe: tt.readiness,
+				LivenessProbe:  tt.liveness,
+			}))
+
+			errs := validateContainerApplication(app, "app1", false)
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateApplicationResourceMonitors(t *testing.T) {
+	tests := []struct {
+		name        string
+		monitors    func(t *testing.T) []ResourceMonitor
+		errorSubstr string
+	}{
+		{
+			name: "cpu and memory monitors",
+			monitors: func(t *testing.T) []ResourceMonitor {
+				return []ResourceMonitor{createCPUMonitor(t), createMemoryMonitor(t)}
+			},
+		},
+		{
+			name:        "disk monitor",
+			monitors:    func(t *testing.T) []ResourceMonitor { return []ResourceMonitor{createDiskMonitor(t)} },
+			errorSubstr: `resourceMonitors[0]: unsupported monitor type "Disk"`,
+		},
+		{
+			name: "duplicate monitor type",
+			monitors: func(t *testing.T) []ResourceMonitor {
+				return []ResourceMonitor{createCPUMonitor(t), createCPUMonitor(t)}
+			},
+			errorSubstr: ErrDuplicateMonitorType.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			var app ApplicationProviderSpec
+			require.NoError(app.FromContainerApplication(ContainerApplication{
+				Name:             lo.ToPtr("app1"),
+				AppType:          AppTypeContainer,
+				Image:            "quay.io/app/image:1",
+				ResourceMonitors: lo.ToPtr(tt.monitors(t)),
+			}))
+
+			errs := validateContainerApplication(app, "app1", false)
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateApplicationDependencies(t *testing.T) {
+	containerApp := func(t *testing.T, name string, dependsOn ...string) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		require.NoError(t, app.FromContainerApplication(ContainerApplication{
+			Name:      lo.ToPtr(name),
+			AppType:   AppTypeContainer,
+			Image:     "quay.io/app/image:1",
+			DependsOn: lo.ToPtr(dependsOn),
+		}))
+		return app
+	}
+	helmApp := func(t *testing.T, name string) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		require.NoError(t, app.FromHelmApplication(HelmApplication{
+			Name:    lo.ToPtr(name),
+			AppType: AppTypeHelm,
+			Image:   "quay.io/app/chart:1",
+		}))
+		return app
+	}
+
+	tests := []struct {
+		name        string
+		apps        func(t *testing.T) []ApplicationProviderSpec
+		errorSubstr string
+	}{
+		{
+			name: "dependency chain",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "ingest", "broker", "volumes"),
+					containerApp(t, "broker", "volumes"),
+					containerApp(t, "volumes"),
+				}
+			},
+		},
+		{
+			name: "unknown application",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{containerApp(t, "ingest", "broker")}
+			},
+			errorSubstr: `spec.applications[ingest].dependsOn[0]: unknown application "broker"`,
+		},
+		{
+			name: "self dependency",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{containerApp(t, "ingest", "ingest")}
+			},
+			errorSubstr: "spec.applications[ingest].dependsOn[0]: application cannot depend on itself",
+		},
+		{
+			name: "duplicate dependency",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "ingest", "broker", "broker"),
+					containerApp(t, "broker"),
+				}
+			},
+			errorSubstr: `spec.applications[ingest].dependsOn[1]: duplicate dependency "broker"`,
+		},
+		{
+			name: "helm dependency",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "ingest", "broker"),
+					helmApp(t, "broker"),
+				}
+			},
+			errorSubstr: `application cannot depend on helm application "broker"`,
+		},
+		{
+			name: "systemd dependency",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				var systemdApp ApplicationProviderSpec
+				require.NoError(t, systemdApp.FromSystemdApplication(SystemdApplication{
+					Name:    lo.ToPtr("broker"),
+					AppType: AppTypeSystemd,
+					Image:   "quay.io/app/binary:1",
+				}))
+				return []ApplicationProviderSpec{containerApp(t, "ingest", "broker"), systemdApp}
+			},
+			errorSubstr: `application cannot depend on systemd application "broker"`,
+		},
+		{
+			name: "dependency cycle",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "volumes"),
+					containerApp(t, "ingest", "broker"),
+					containerApp(t, "broker", "volumes", "auth"),
+					containerApp(t, "auth", "ingest"),
+				}
+			},
+			errorSubstr: "spec.applications[ingest].dependsOn: dependency cycle: ingest -> broker -> auth -> ingest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := validateApplicationDependencies(tt.apps(t))
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.Len(errs, 1)
+			require.Contains(errs[0].Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateHostAccess(t *testing.T) {
+	tests := []struct {
+		name        string
+		access      ApplicationHostAccess
+		errorSubstr string
+	}{
+		{
+			name: "valid host access",
+			access: ApplicationHostAccess{
+				Devices:      lo.ToPtr([]string{"/dev/ttyUSB0", "/dev/ttyUSB1:/dev/ttyS0", "/dev/video0:rw", "/dev/bus/usb/001/002:/dev/usb:rwm"}),
+				Capabilities: lo.ToPtr([]string{"NET_ADMIN", "CAP_SYS_TIME"}),
+				Network:      lo.ToPtr("sensors"),
+				Ipc:          lo.ToPtr("app:broker"),
+			},
+		},
+		{
+			name:        "device outside of /dev",
+			access:      ApplicationHostAccess{Devices: lo.ToPtr([]string{"/etc/shadow"})},
+			errorSubstr: "spec.applications[app].devices[0]: host path must be a clean absolute path under /dev/",
+		},
+		{
+			name:        "device path escaping /dev",
+			access:      ApplicationHostAccess{Devices: lo.ToPtr([]string{"/dev/../etc/shadow"})},
+			errorSubstr: "host path must be a clean absolute path under /dev/",
+		},
+		{
+			name:        "relative container path",
+			access:      ApplicationHostAccess{Devices: lo.ToPtr([]string{"/dev/ttyUSB0:ttyS0:rw"})},
+			errorSubstr: "container path must be a clean absolute path",
+		},
+		{
+			name:        "invalid device permissions",
+			access:      ApplicationHostAccess{Devices: lo.ToPtr([]string{"/dev/ttyUSB0:rx"})},
+			errorSubstr: "permissions must be a combination of r, w and m",
+		},
+		{
+			name:        "invalid capability",
+			access:      ApplicationHostAccess{Capabilities: lo.ToPtr([]string{"net_admin"})},
+			errorSubstr: "spec.applications[app].capabilities[0]: must be a Linux capability name",
+		},
+		{
+			name:        "all capabilities",
+			access:      ApplicationHostAccess{Capabilities: lo.ToPtr([]string{"CAP_ALL"})},
+			errorSubstr: "adding all capabilities is not supported",
+		},
+		{
+			name:        "invalid network",
+			access:      ApplicationHostAccess{Network: lo.ToPtr("container:db")},
+			errorSubstr: "spec.applications[app].network: must be 'host', 'none' or the name of a podman network",
+		},
+		{
+			name:        "invalid ipc",
+			access:      ApplicationHostAccess{Ipc: lo.ToPtr("app:")},
+			errorSubstr: "spec.applications[app].ipc: must be 'private', 'shareable', 'host' or 'app:NAME'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := validateHostAccess(tt.access, "spec.applications[app]")
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.Len(errs, 1)
+			require.Contains(errs[0].Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateApplicationIPC(t *testing.T) {
+	containerApp := func(t *testing.T, name, ipc string, runAs Username) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		require.NoError(t, app.FromContainerApplication(ContainerApplication{
+			Name:    lo.ToPtr(name),
+			AppType: AppTypeContainer,
+			Image:   "quay.io/app/image:1",
+			Ipc:     lo.ToPtr(ipc),
+			RunAs:   runAs,
+		}))
+		return app
+	}
+	quadletApp := func(t *testing.T, name, ipc string) ApplicationProviderSpec {
+		var app ApplicationProviderSpec
+		require.NoError(t, app.FromQuadletApplication(QuadletApplication{
+			Name:    lo.ToPtr(name),
+			AppType: AppTypeQuadlet,
+			Ipc:     lo.ToPtr(ipc),
+		}))
+		return app
+	}
+
+	tests := []struct {
+		name        string
+		apps        func(t *testing.T) []ApplicationProviderSpec
+		errorSubstr string
+	}{
+		{
+			name: "join shareable container application",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "broker", IpcShareable, ""),
+					quadletApp(t, "ingest", "app:broker"),
+				}
+			},
+		},
+		{
+			name: "own namespace",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{containerApp(t, "broker", "app:broker", "")}
+			},
+			errorSubstr: "spec.applications[broker].ipc: application cannot join its own IPC namespace",
+		},
+		{
+			name: "unknown application",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{containerApp(t, "ingest", "app:broker", "")}
+			},
+			errorSubstr: `spec.applications[ingest].ipc: unknown application "broker"`,
+		},
+		{
+			name: "quadlet application",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					quadletApp(t, "broker", IpcShareable),
+					containerApp(t, "ingest", "app:broker", ""),
+				}
+			},
+			errorSubstr: `can only join the IPC namespace of a container application, "broker" is a quadlet application`,
+		},
+		{
+			name: "not shareable",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "broker", IpcPrivate, ""),
+					containerApp(t, "ingest", "app:broker", ""),
+				}
+			},
+			errorSubstr: `application "broker" must set ipc to "shareable"`,
+		},
+		{
+			name: "different user",
+			apps: func(t *testing.T) []ApplicationProviderSpec {
+				return []ApplicationProviderSpec{
+					containerApp(t, "broker", IpcShareable, "flightctl"),
+					containerApp(t, "ingest", "app:broker", ""),
+				}
+			},
+			errorSubstr: `application "broker" must run as the same user`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := validateApplicationIPC(tt.apps(t))
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.Len(errs, 1)
+			require.Contains(errs[0].Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateSystemdApplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		app         SystemdApplication
+		errorSubstr string
+	}{
+		{
+			name: "valid",
+			app: SystemdApplication{
+				Name:    lo.ToPtr("sensors"),
+				Image:   "quay.io/app/binary:1",
+				EnvVars: lo.ToPtr(map[string]string{"LOG_LEVEL": "debug"}),
+			},
+		},
+		{
+			name: "name defaults to image",
+			app:  SystemdApplication{Image: "quay.io/app/binary:1"},
+		},
+		{
+			name:        "invalid image",
+			app:         SystemdApplication{Name: lo.ToPtr("sensors"), Image: "_invalid"},
+			errorSubstr: "spec.applications[sensors].image",
+		},
+		{
+			name: "invalid env var",
+			app: SystemdApplication{
+				Name:    lo.ToPtr("sensors"),
+				Image:   "quay.io/app/binary:1",
+				EnvVars: lo.ToPtr(map[string]string{"1INVALID": "value"}),
+			},
+			errorSubstr: "spec.applications[sensors].envVars",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			tt.app.AppType = AppTypeSystemd
+			var app ApplicationProviderSpec
+			require.NoError(app.FromSystemdApplication(tt.app))
+
+			errs := validateApplications([]ApplicationProviderSpec{app}, false)
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateDeviceTelemetrySpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        DeviceTelemetrySpec
+		errorSubstr string
+	}{
+		{
+			name: "valid",
+			spec: DeviceTelemetrySpec{
+				Metrics: &TelemetryMetricsSpec{
+					Sources:  []TelemetryMetricsSource{TelemetryMetricsSourceHost, TelemetryMetricsSourceApplications},
+					Interval: lo.ToPtr("30s"),
+				},
+				Logs: &TelemetryLogsSpec{
+					Sources:  []TelemetryLogsSource{TelemetryLogsSourceJournal},
+					Units:    &[]string{"sshd", "getty@*.service"},
+					Priority: lo.ToPtr(TelemetryLogPriorityWarning),
+				},
+				MaxUploadRate: lo.ToPtr("64k"),
+			},
+		},
+		{
+			name:        "interval too short",
+			spec:        DeviceTelemetrySpec{Metrics: &TelemetryMetricsSpec{Sources: []TelemetryMetricsSource{TelemetryMetricsSourceHost}, Interval: lo.ToPtr("5s")}},
+			errorSubstr: "spec.telemetry.metrics.interval: must be at least 10s",
+		},
+		{
+			name:        "unsupported metrics source",
+			spec:        DeviceTelemetrySpec{Metrics: &TelemetryMetricsSpec{Sources: []TelemetryMetricsSource{"gpu"}}},
+			errorSubstr: "spec.telemetry.metrics.sources[0]",
+		},
+		{
+			name:        "unsupported logs source",
+			spec:        DeviceTelemetrySpec{Logs: &TelemetryLogsSpec{Sources: []TelemetryLogsSource{"kernel"}}},
+			errorSubstr: "spec.telemetry.logs.sources[0]",
+		},
+		{
+			name:        "invalid unit",
+			spec:        DeviceTelemetrySpec{Logs: &TelemetryLogsSpec{Sources: []TelemetryLogsSource{TelemetryLogsSourceJournal}, Units: &[]string{"ssh d"}}},
+			errorSubstr: "spec.telemetry.logs.units[0]",
+		},
+		{
+			name:        "unsupported priority",
+			spec:        DeviceTelemetrySpec{Logs: &TelemetryLogsSpec{Sources: []TelemetryLogsSource{TelemetryLogsSourceJournal}, Priority: lo.ToPtr(TelemetryLogPriority("verbose"))}},
+			errorSubstr: "spec.telemetry.logs.priority",
+		},
+		{
+			name:        "invalid upload rate",
+			spec:        DeviceTelemetrySpec{MaxUploadRate: lo.ToPtr("64kb")},
+			errorSubstr: "spec.telemetry.maxUploadRate",
+		},
+		{
+			name:        "zero upload rate",
+			spec:        DeviceTelemetrySpec{MaxUploadRate: lo.ToPtr("0k")},
+			errorSubstr: "spec.telemetry.maxUploadRate: must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := tt.spec.Validate()
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateCertificates(t *testing.T) {
+	var containerApp, helmApp ApplicationProviderSpec
+	require.NoError(t, containerApp.FromContainerApplication(ContainerApplication{
+		Name:    lo.ToPtr("web"),
+		AppType: AppTypeContainer,
+		Image:   "quay.io/app/image:1",
+		Volumes: &[]ApplicationVolume{{Name: "tls"}},
+	}))
+	require.NoError(t, helmApp.FromHelmApplication(HelmApplication{
+		Name:    lo.ToPtr("chart"),
+		AppType: AppTypeHelm,
+		Image:   "quay.io/app/chart:1",
+	}))
+	apps := []ApplicationProviderSpec{containerApp, helmApp}
+
+	fileDestination := CertificateDestination{File: &CertificateFileDestination{CertPath: "/etc/web/tls.crt", KeyPath: "/etc/web/tls.key"}}
+	acmeIssuer := &CertificateIssuerSpec{Type: CertificateIssuerTypeAcme, Acme: &AcmeIssuerSpec{DirectoryUrl: "https://acme.example.com/directory"}}
+
+	tests := []struct {
+		name          string
+		cert          DeviceCertificateSpec
+		fleetTemplate bool
+		errorSubstr   string
+	}{
+		{
+			name: "valid file destination",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				DnsNames:    &[]string{"web.example.com"},
+				IpAddresses: &[]string{"192.168.1.10"},
+				RenewBefore: lo.ToPtr("720h"),
+				Destination: fileDestination,
+				Reload:      &CertificateReloadSpec{SystemdUnits: &[]string{"nginx.service"}},
+			},
+		},
+		{
+			name: "valid templated names in fleet template",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				CommonName:  lo.ToPtr("{{ .metadata.name }}"),
+				DnsNames:    &[]string{"{{ .metadata.name }}.{{ .metadata.labels.site }}.example.com"},
+				Destination: fileDestination,
+			},
+			fleetTemplate: true,
+		},
+		{
+			name: "valid application volume destination",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				Issuer:      acmeIssuer,
+				DnsNames:    &[]string{"web.example.com"},
+				Destination: CertificateDestination{ApplicationVolume: &CertificateApplicationVolumeDestination{Application: "web", Volume: "tls"}},
+			},
+		},
+		{
+			name: "valid application secret destination",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				Destination: CertificateDestination{ApplicationSecret: &CertificateApplicationSecretDestination{Application: "web", Name: "web-tls"}},
+			},
+		},
+		{
+			name:        "invalid dns name",
+			cert:        DeviceCertificateSpec{Name: "web", DnsNames: &[]string{"web_example"}, Destination: fileDestination},
+			errorSubstr: "spec.certificates[0].dnsNames[0]",
+		},
+		{
+			name:        "invalid ip address",
+			cert:        DeviceCertificateSpec{Name: "web", IpAddresses: &[]string{"192.168.1"}, Destination: fileDestination},
+			errorSubstr: "spec.certificates[0].ipAddresses[0]: invalid IP address",
+		},
+		{
+			name:        "expiration too short",
+			cert:        DeviceCertificateSpec{Name: "web", ExpirationSeconds: lo.ToPtr(int32(60)), Destination: fileDestination},
+			errorSubstr: "spec.certificates[0].expirationSeconds: must be at least 600",
+		},
+		{
+			name:        "invalid renew before",
+			cert:        DeviceCertificateSpec{Name: "web", RenewBefore: lo.ToPtr("30 days"), Destination: fileDestination},
+			errorSubstr: "spec.certificates[0].renewBefore",
+		},
+		{
+			name:        "acme without names",
+			cert:        DeviceCertificateSpec{Name: "web", Issuer: acmeIssuer, Destination: fileDestination},
+			errorSubstr: "must specify at least one DNS name or IP address",
+		},
+		{
+			name: "acme without https directory",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				Issuer:      &CertificateIssuerSpec{Type: CertificateIssuerTypeAcme, Acme: &AcmeIssuerSpec{DirectoryUrl: "http://acme.example.com/directory"}},
+				DnsNames:    &[]string{"web.example.com"},
+				Destination: fileDestination,
+			},
+			errorSubstr: "spec.certificates[0].issuer.acme.directoryUrl",
+		},
+		{
+			name:        "no destination",
+			cert:        DeviceCertificateSpec{Name: "web"},
+			errorSubstr: "exactly one of file, applicationVolume or applicationSecret",
+		},
+		{
+			name: "multiple destinations",
+			cert: DeviceCertificateSpec{
+				Name: "web",
+				Destination: CertificateDestination{
+					File:              fileDestination.File,
+					ApplicationSecret: &CertificateApplicationSecretDestination{Application: "web", Name: "web-tls"},
+				},
+			},
+			errorSubstr: "exactly one of file, applicationVolume or applicationSecret",
+		},
+		{
+			name:        "unknown application volume",
+			cert:        DeviceCertificateSpec{Name: "web", Destination: CertificateDestination{ApplicationVolume: &CertificateApplicationVolumeDestination{Application: "web", Volume: "data"}}},
+			errorSubstr: `application "web" has no volume "data"`,
+		},
+		{
+			name:        "unknown application",
+			cert:        DeviceCertificateSpec{Name: "web", Destination: CertificateDestination{ApplicationSecret: &CertificateApplicationSecretDestination{Application: "db", Name: "db-tls"}}},
+			errorSubstr: `unknown application "db"`,
+		},
+		{
+			name:        "secret for helm application",
+			cert:        DeviceCertificateSpec{Name: "web", Destination: CertificateDestination{ApplicationSecret: &CertificateApplicationSecretDestination{Application: "chart", Name: "chart-tls"}}},
+			errorSubstr: "secrets are not supported for helm applications",
+		},
+		{
+			name: "volume file outside of volume",
+			cert: DeviceCertificateSpec{
+				Name:        "web",
+				Destination: CertificateDestination{ApplicationVolume: &CertificateApplicationVolumeDestination{Application: "web", Volume: "tls", CertFile: lo.ToPtr("../tls.crt")}},
+			},
+			errorSubstr: "applicationVolume.certFile: must be a file name",
+		},
+		{
+			name:        "invalid reload unit",
+			cert:        DeviceCertificateSpec{Name: "web", Destination: fileDestination, Reload: &CertificateReloadSpec{SystemdUnits: &[]string{"nginx service"}}},
+			errorSubstr: "spec.certificates[0].reload.systemdUnits[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := validateCertificates([]DeviceCertificateSpec{tt.cert}, apps, tt.fleetTemplate)
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestValidateCertificatesDuplicateName(t *testing.T) {
+	cert := DeviceCertificateSpec{
+		Name:        "web",
+		Destination: CertificateDestination{File: &CertificateFileDestination{CertPath: "/etc/web/tls.crt", KeyPath: "/etc/web/tls.key"}},
+	}
+	errs := validateCertificates([]DeviceCertificateSpec{cert, cert}, nil, false)
+	require.Contains(t, errors.Join(errs...).Error(), "duplicate certificate name: web")
+}
+
+func TestValidateFleetFieldSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		selector    *FleetFieldSelector
+		errorSubstr string
+	}{
+		{
+			name: "nil selector",
+		},
+		{
+			name: "valid",
+			selector: &FleetFieldSelector{MatchFields: map[string]string{
+				"status.systemInfo.architecture":        "arm64",
+				"status.systemInfo.productName":         "Jetson AGX Orin",
+				"status.systemInfo.customInfo.siteCode": "nyc-01",
+			}},
+		},
+		{
+			name:        "empty",
+			selector:    &FleetFieldSelector{MatchFields: map[string]string{}},
+			errorSubstr: "spec.fieldSelector.matchFields must not be empty",
+		},
+		{
+			name:        "unsupported field",
+			selector:    &FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.bootID": "abc"}},
+			errorSubstr: `unsupported field "status.systemInfo.bootID"`,
+		},
+		{
+			name:        "nested custom info key",
+			selector:    &FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.customInfo.a.b": "c"}},
+			errorSubstr: `unsupported field "status.systemInfo.customInfo.a.b"`,
+		},
+		{
+			name:        "non status field",
+			selector:    &FleetFieldSelector{MatchFields: map[string]string{"metadata.name": "device"}},
+			errorSubstr: `unsupported field "metadata.name"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := tt.selector.Validate()
+			if tt.errorSubstr == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errors.Join(errs...).Error(), tt.errorSubstr)
+		})
+	}
+}
+
+func TestDeviceMatchesFleetFieldSelector(t *testing.T) {
+	device := &Device{Status: &DeviceStatus{SystemInfo: DeviceSystemInfo{
+		Architecture:         "arm64",
+		OperatingSystem:      "linux",
+		CustomInfo:           &CustomDeviceInfo{"siteCode": "nyc-01"},
+		AdditionalProperties: map[string]string{"productName": "Jetson AGX Orin"},
+	}}}
+
+	tests := []struct {
+		name     string
+		device   *Device
+		selector *FleetFieldSelector
+		want     bool
+	}{
+		{
+			name:   "nil selector",
+			device: device,
+			want:   true,
+		},
+		{
+			name:   "all fields match",
+			device: device,
+			selector: &FleetFieldSelector{MatchFields: map[string]string{
+				"status.systemInfo.architecture":        "arm64",
+				"status.systemInfo.productName":         "Jetson AGX Orin",
+				"status.systemInfo.customInfo.siteCode": "nyc-01",
+			}},
+			want: true,
+		},
+		{
+			name:     "value differs",
+			device:   device,
+			selector: &FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.architecture": "amd64"}},
+		},
+		{
+			name:     "field not reported",
+			device:   device,
+			selector: &FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.customInfo.rack": ""}},
+		},
+		{
+			name:     "device without status",
+			device:   &Device{},
+			selector: &FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.architecture": "arm64"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.device.MatchesFleetFieldSelector(tt.selector))
+		})
+	}
+}
+
 func TestValidateVolumeAppTypeCompatibility(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -1905,12 +2702,21 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 			errSubstrs: []string{"at least one role is required"},
 		},
 		{
-			name: "invalid custom role",
+			name: "valid custom role",
 			ctx:  superAdminCtx,
 			assignment: AuthStaticRoleAssignment{
 				Type:  AuthStaticRoleAssignmentTypeStatic,
 				Roles: []string{ExternalRoleAdmin, ExternalRoleViewer, "custom-role"},
 			},
+			wantErrs: 0,
+		},
+		{
+			name: "built-in role name",
+			ctx:  superAdminCtx,
+			assignment: AuthStaticRoleAssignment{
+				Type:  AuthStaticRoleAssignmentTypeStatic,
+				Roles: []string{ExternalRoleViewer, RoleAdmin},
+			},
 			wantErrs:   1,
 			errSubstrs: []string{"is not a valid role"},
 		},
@@ -1928,7 +2734,7 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 			ctx:  superAdminCtx,
 			assignment: AuthStaticRoleAssignment{
 				Type:  AuthStaticRoleAssignmentTypeStatic,
-				Roles: []string{ExternalRoleAdmin, "invalid-role"},
+				Roles: []string{ExternalRoleAdmin, "Invalid Role"},
 			},
 			wantErrs:   1,
 			errSubstrs: []string{"is not a valid role"},
@@ -1957,7 +2763,7 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 			ctx:  baseCtx,
 			assignment: AuthStaticRoleAssignment{
 				Type:  AuthStaticRoleAssignmentTypeStatic,
-				Roles: []string{"invalid-role-1", "invalid-role-2"},
+				Roles: []string{"invalid_role_1", "invalid_role_2"},
 			},
 			wantErrs:   2,
 			errSubstrs: []string{"is not a valid role", "is not a valid role"},
@@ -1967,7 +2773,7 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 			ctx:  superAdminCtx,
 			assignment: AuthStaticRoleAssignment{
 				Type:  AuthStaticRoleAssignmentTypeStatic,
-				Roles: []string{ExternalRoleAdmin, "invalid-role", ExternalRoleViewer},
+				Roles: []string{ExternalRoleAdmin, "invalid/role", ExternalRoleViewer},
 			},
 			wantErrs:   1,
 			errSubstrs: []string{"is not a valid role"},
@@ -2320,3 +3126,395 @@ func TestRepository_Validate_BackwardCompatibility(t *testing.T) {
 		require.Empty(t, errs, "HttpRepoSpec should validate successfully")
 	})
 }
+
+func TestSecretConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		mountPath     string
+		keys          *[]string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{"valid", "apps/myapp", "/etc/myapp/secrets", nil, false, false},
+		{"valid with keys", "apps/myapp", "/etc/myapp/secrets", &[]string{"username", "password"}, false, false},
+		{"reject absolute path", "/apps/myapp", "/etc/myapp/secrets", nil, false, true},
+		{"reject relative mount path", "apps/myapp", "etc/myapp/secrets", nil, false, true},
+		{"reject forbidden mount path", "apps/myapp", "/etc/flightctl/certs", nil, false, true},
+		{"reject key with slash", "apps/myapp", "/etc/myapp/secrets", &[]string{"../passwd"}, false, true},
+		{"reject dot key", "apps/myapp", "/etc/myapp/secrets", &[]string{".."}, false, true},
+		{"allow parameters in fleet template", "devices/{{ .metadata.name }}", "/etc/myapp/secrets", nil, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := SecretConfigProviderSpec{Name: "test-secret-config"}
+			spec.SecretStoreRef.SecretStore = "vault"
+			spec.SecretStoreRef.Path = tt.path
+			spec.SecretStoreRef.MountPath = tt.mountPath
+			spec.SecretStoreRef.Keys = tt.keys
+
+			errs := spec.Validate(tt.fleetTemplate)
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestOciConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		artifact      string
+		reference     string
+		mountPath     string
+		paths         *[]string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{"valid tag", "myorg/config-bundle", "v1.0", "/etc/myapp", nil, false, false},
+		{"valid digest", "myorg/config-bundle", "sha256:" + strings.Repeat("a", 64), "/etc/myapp", nil, false, false},
+		{"valid with paths", "myorg/config-bundle", "v1.0", "/etc/myapp", &[]string{"conf.d", "app.conf"}, false, false},
+		{"reject invalid artifact", "MyOrg/Config", "v1.0", "/etc/myapp", nil, false, true},
+		{"reject invalid reference", "myorg/config-bundle", "v1/0", "/etc/myapp", nil, false, true},
+		{"reject relative mount path", "myorg/config-bundle", "v1.0", "etc/myapp", nil, false, true},
+		{"reject forbidden mount path", "myorg/config-bundle", "v1.0", "/etc/flightctl/certs", nil, false, true},
+		{"reject absolute path", "myorg/config-bundle", "v1.0", "/etc/myapp", &[]string{"/conf.d"}, false, true},
+		{"allow parameters in fleet template", "myorg/config-bundle", "{{ .metadata.labels.version }}", "/etc/{{ .metadata.name }}", nil, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := OciConfigProviderSpec{Name: "test-oci-config"}
+			spec.OciRef.Repository = "registry"
+			spec.OciRef.Artifact = tt.artifact
+			spec.OciRef.Reference = tt.reference
+			spec.OciRef.MountPath = tt.mountPath
+			spec.OciRef.Paths = tt.paths
+
+			errs := spec.Validate(tt.fleetTemplate)
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestResourceSync_ValidateKinds(t *testing.T) {
+	tests := []struct {
+		name     string
+		syncType *ResourceSyncType
+		kinds    []string
+		wantErr  bool
+	}{
+		{"fleet type with default kinds", nil, nil, false},
+		{"fleet type with additional kinds", nil, []string{FleetKind, RepositoryKind, SecretStoreKind, AuthProviderKind, DeviceKind}, false},
+		{"catalog type with catalog kinds", lo.ToPtr(ResourceSyncTypeCatalog), []string{"Catalog", "CatalogItem"}, false},
+		{"reject catalog kind in fleet type", lo.ToPtr(ResourceSyncTypeFleet), []string{"Catalog"}, true},
+		{"reject fleet kind in catalog type", lo.ToPtr(ResourceSyncTypeCatalog), []string{FleetKind}, true},
+		{"reject kind that can't be managed", nil, []string{ResourceSyncKind}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &ResourceSync{
+				Metadata: ObjectMeta{Name: lo.ToPtr("rs")},
+				Spec:     ResourceSyncSpec{Repository: "repo", TargetRevision: "main", Path: "/", Type: tt.syncType},
+			}
+			if tt.kinds != nil {
+				rs.Spec.Kinds = &tt.kinds
+			}
+
+			errs := rs.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestSecretStore_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(s *SecretStore)
+		wantErr bool
+	}{
+		{"valid token auth", func(s *SecretStore) {}, false},
+		{"valid AppRole auth", func(s *SecretStore) {
+			_ = s.Spec.Auth.FromAppRoleSecretStoreAuth(AppRoleSecretStoreAuth{Method: SecretStoreAuthMethodAppRole, RoleId: "my-role", SecretId: "my-secret-id"})
+		}, false},
+		{"reject non-HTTP address", func(s *SecretStore) { s.Spec.Address = "ftp://vault.example.com" }, true},
+		{"reject address without host", func(s *SecretStore) { s.Spec.Address = "https://" }, true},
+		{"reject invalid KV version", func(s *SecretStore) { s.Spec.KvVersion = lo.ToPtr(SecretStoreSpecKvVersion(3)) }, true},
+		{"reject absolute mount path", func(s *SecretStore) { s.Spec.MountPath = lo.ToPtr("/secret") }, true},
+		{"reject CA that is not base64", func(s *SecretStore) { s.Spec.Tls = &SecretStoreTlsConfig{CaCrt: lo.ToPtr("not base64!")} }, true},
+		{"reject empty token", func(s *SecretStore) {
+			_ = s.Spec.Auth.FromTokenSecretStoreAuth(TokenSecretStoreAuth{Method: SecretStoreAuthMethodToken})
+		}, true},
+		{"reject AppRole without role ID", func(s *SecretStore) {
+			_ = s.Spec.Auth.FromAppRoleSecretStoreAuth(AppRoleSecretStoreAuth{Method: SecretStoreAuthMethodAppRole, SecretId: "my-secret-id"})
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := SecretStoreAuth{}
+			require.NoError(t, auth.FromTokenSecretStoreAuth(TokenSecretStoreAuth{Method: SecretStoreAuthMethodToken, Token: "s.token"}))
+			secretStore := &SecretStore{
+				Metadata: ObjectMeta{Name: lo.ToPtr("vault")},
+				Spec:     SecretStoreSpec{Address: "https://vault.example.com:8200", Auth: auth},
+			}
+			tt.mutate(secretStore)
+
+			errs := secretStore.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestCertificateRevocationRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CertificateRevocationRequest
+		wantErr bool
+	}{
+		{"valid device", CertificateRevocationRequest{DeviceName: lo.ToPtr("mydevice")}, false},
+		{"valid serial number", CertificateRevocationRequest{SerialNumber: lo.ToPtr("1A2b3c")}, false},
+		{"valid colon separated serial number", CertificateRevocationRequest{SerialNumber: lo.ToPtr("1a:2b:3c")}, false},
+		{"valid reason", CertificateRevocationRequest{DeviceName: lo.ToPtr("mydevice"), Reason: lo.ToPtr(CertificateRevocationReasonKeyCompromise)}, false},
+		{"reject empty request", CertificateRevocationRequest{}, true},
+		{"reject device and serial number", CertificateRevocationRequest{DeviceName: lo.ToPtr("mydevice"), SerialNumber: lo.ToPtr("1a2b")}, true},
+		{"reject invalid device name", CertificateRevocationRequest{DeviceName: lo.ToPtr("My_Device")}, true},
+		{"reject serial number that is not hexadecimal", CertificateRevocationRequest{SerialNumber: lo.ToPtr("xyz")}, true},
+		{"reject unsupported reason", CertificateRevocationRequest{DeviceName: lo.ToPtr("mydevice"), Reason: lo.ToPtr(CertificateRevocationReason("lost"))}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.request.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestEnrollmentApprovalPolicy_Validate(t *testing.T) {
+	selector := &LabelSelector{MatchLabels: &map[string]string{"site": "factory-a"}}
+	tests := []struct {
+		name    string
+		match   EnrollmentApprovalPolicyMatch
+		labels  *map[string]string
+		wantErr bool
+	}{
+		{"valid tpm attested", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true)}, nil, false},
+		{"valid enrollment credentials", EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-a"}}, nil, false},
+		{"valid tpm attested allow list", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), AllowList: &EnrollmentApprovalAllowList{SerialNumbers: &[]string{"SN-0001"}}}, nil, false},
+		{"valid tpm attested label selector with labels", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), LabelSelector: selector}, &map[string]string{"fleet": "production"}, false},
+		{"reject allow list without tpm attested", EnrollmentApprovalPolicyMatch{AllowList: &EnrollmentApprovalAllowList{SerialNumbers: &[]string{"SN-0001"}}}, nil, true},
+		{"reject label selector without tpm attested", EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-a"}, LabelSelector: selector}, nil, true},
+		{"reject no match conditions", EnrollmentApprovalPolicyMatch{}, nil, true},
+		{"reject tpm attested false only", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(false)}, nil, true},
+		{"reject empty enrollment credentials", EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{}}, nil, true},
+		{"reject empty enrollment credential name", EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{""}}, nil, true},
+		{"reject empty allow list", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), AllowList: &EnrollmentApprovalAllowList{}}, nil, true},
+		{"reject empty label selector", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), LabelSelector: &LabelSelector{}}, nil, true},
+		{"reject invalid labels", EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), LabelSelector: selector}, &map[string]string{"in valid": "production"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := EnrollmentApprovalPolicy{
+				ApiVersion: "v1beta1",
+				Kind:       EnrollmentApprovalPolicyKind,
+				Metadata:   ObjectMeta{Name: lo.ToPtr("factory-a")},
+				Spec:       EnrollmentApprovalPolicySpec{Match: tt.match, Labels: tt.labels},
+			}
+			errs := policy.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestRole_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    string
+		rules   []RoleRule
+		wantErr bool
+	}{
+		{"valid", "site-technician", []RoleRule{{Resources: []string{"devices", "devices/console"}, Verbs: []string{"get", "list", "patch"}}}, false},
+		{"valid wildcards", "auditor", []RoleRule{{Resources: []string{"*"}, Verbs: []string{"*"}}}, false},
+		{"reject built-in role name", RoleOperator, []RoleRule{{Resources: []string{"devices"}, Verbs: []string{"get"}}}, true},
+		{"reject external role name", ExternalRoleViewer, []RoleRule{{Resources: []string{"devices"}, Verbs: []string{"get"}}}, true},
+		{"reject no rules", "site-technician", nil, true},
+		{"reject no resources", "site-technician", []RoleRule{{Verbs: []string{"get"}}}, true},
+		{"reject empty resource", "site-technician", []RoleRule{{Resources: []string{""}, Verbs: []string{"get"}}}, true},
+		{"reject no verbs", "site-technician", []RoleRule{{Resources: []string{"devices"}}}, true},
+		{"reject unknown verb", "site-technician", []RoleRule{{Resources: []string{"devices"}, Verbs: []string{"reboot"}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := Role{
+				ApiVersion: "v1beta1",
+				Kind:       RoleKind,
+				Metadata:   ObjectMeta{Name: lo.ToPtr(tt.role)},
+				Spec:       RoleSpec{Rules: tt.rules},
+			}
+			errs := role.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestServiceAccount_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		roles   []string
+		wantErr bool
+	}{
+		{"valid", []string{ExternalRoleOperator, "site-technician"}, false},
+		{"reject no roles", nil, true},
+		{"reject empty role", []string{""}, true},
+		{"reject global admin role", []string{ExternalRoleAdmin}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceAccount := ServiceAccount{
+				ApiVersion: "v1beta1",
+				Kind:       ServiceAccountKind,
+				Metadata:   ObjectMeta{Name: lo.ToPtr("ci-pipeline")},
+				Spec:       ServiceAccountSpec{Roles: tt.roles},
+			}
+			errs := serviceAccount.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestDeviceBulkOperation_Validate(t *testing.T) {
+	unenroll := &DeviceDecommission{Target: DeviceDecommissionTargetTypeUnenroll}
+	tests := []struct {
+		name          string
+		labelSelector *string
+		fieldSelector *string
+		operation     DeviceBulkOperationType
+		metadata      *DeviceBulkMetadataUpdate
+		decommission  *DeviceDecommission
+		wantErr       bool
+	}{
+		{"valid label update", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata,
+			&DeviceBulkMetadataUpdate{SetLabels: &map[string]string{"tier": "edge"}, RemoveLabels: &[]string{"legacy"}}, nil, false},
+		{"valid decommission by field selector", nil, lo.ToPtr("metadata.name=device-1"), DeviceBulkOperationTypeDecommission, nil, unenroll, false},
+		{"valid resume", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeResume, nil, nil, false},
+		{"reject no selector", nil, nil, DeviceBulkOperationTypeRerender, nil, nil, true},
+		{"reject invalid label selector", lo.ToPtr("site in (north"), nil, DeviceBulkOperationTypeResume, nil, nil, true},
+		{"reject unsupported operation", lo.ToPtr("site=north"), nil, "Reboot", nil, nil, true},
+		{"reject update without metadata", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata, nil, nil, true},
+		{"reject empty metadata update", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata, &DeviceBulkMetadataUpdate{}, nil, true},
+		{"reject invalid label", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata,
+			&DeviceBulkMetadataUpdate{SetLabels: &map[string]string{"tier": "not a valid value"}}, nil, true},
+		{"reject label both set and removed", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata,
+			&DeviceBulkMetadataUpdate{SetLabels: &map[string]string{"tier": "edge"}, RemoveLabels: &[]string{"tier"}}, nil, true},
+		{"reject service-managed annotation", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeUpdateMetadata,
+			&DeviceBulkMetadataUpdate{RemoveAnnotations: &[]string{DeviceAnnotationConflictPaused}}, nil, true},
+		{"reject decommission without target", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeDecommission, nil, nil, true},
+		{"reject decommission details for other operation", lo.ToPtr("site=north"), nil, DeviceBulkOperationTypeResume, nil, unenroll, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := DeviceBulkOperation{
+				ApiVersion: "v1beta1",
+				Kind:       DeviceBulkOperationKind,
+				Metadata:   ObjectMeta{Name: lo.ToPtr("relabel-north")},
+				Spec: DeviceBulkOperationSpec{
+					LabelSelector: tt.labelSelector,
+					FieldSelector: tt.fieldSelector,
+					Operation:     tt.operation,
+					Metadata:      tt.metadata,
+					Decommission:  tt.decommission,
+				},
+			}
+			errs := operation.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestRoleBinding_Validate(t *testing.T) {
+	group := RoleBindingSubject{Kind: RoleBindingSubjectKindGroup, Name: "north-technicians"}
+	tests := []struct {
+		name          string
+		roleRef       string
+		subjects      []RoleBindingSubject
+		labelSelector *string
+		wantErr       bool
+	}{
+		{"valid", "site-technician", []RoleBindingSubject{group}, nil, false},
+		{"valid label selector", "site-technician", []RoleBindingSubject{group, {Kind: RoleBindingSubjectKindUser, Name: "alice"}}, lo.ToPtr("site=north,tier!=core"), false},
+		{"reject invalid role reference", "Site_Technician", []RoleBindingSubject{group}, nil, true},
+		{"reject no subjects", "site-technician", nil, nil, true},
+		{"reject unknown subject kind", "site-technician", []RoleBindingSubject{{Kind: "ServiceAccount", Name: "robot"}}, nil, true},
+		{"reject empty subject name", "site-technician", []RoleBindingSubject{{Kind: RoleBindingSubjectKindUser}}, nil, true},
+		{"reject empty label selector", "site-technician", []RoleBindingSubject{group}, lo.ToPtr(" "), true},
+		{"reject invalid label selector", "site-technician", []RoleBindingSubject{group}, lo.ToPtr("site in (north"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := RoleBinding{
+				ApiVersion: "v1beta1",
+				Kind:       RoleBindingKind,
+				Metadata:   ObjectMeta{Name: lo.ToPtr("north")},
+				Spec:       RoleBindingSpec{RoleRef: tt.roleRef, Subjects: tt.subjects, LabelSelector: tt.labelSelector},
+			}
+			errs := binding.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
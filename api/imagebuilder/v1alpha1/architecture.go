@@ -0,0 +1,99 @@
+package imagebuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultImageBuildArchitecture is the architecture built when an ImageBuild does not list any.
+const DefaultImageBuildArchitecture = ImageBuildArchitectureAmd64
+
+// ImageBuildArchitectures lists all architectures supported by the image builder.
+var ImageBuildArchitectures = []ImageBuildArchitecture{
+	ImageBuildArchitectureAmd64,
+	ImageBuildArchitectureArm64,
+	ImageBuildArchitecturePpc64le,
+	ImageBuildArchitectureS390x,
+}
+
+// GetArchitectures returns the architectures requested by the spec, falling back to
+// DefaultImageBuildArchitecture when none are set.
+func (s ImageBuildSpec) GetArchitectures() []ImageBuildArchitecture {
+	if s.Architectures == nil || len(*s.Architectures) == 0 {
+		return []ImageBuildArchitecture{DefaultImageBuildArchitecture}
+	}
+	return *s.Architectures
+}
+
+// IsMultiArch returns true if the spec requests more than one architecture.
+func (s ImageBuildSpec) IsMultiArch() bool {
+	return len(s.GetArchitectures()) > 1
+}
+
+// Platform returns the podman/OCI platform string for the architecture (e.g. "linux/arm64").
+func (a ImageBuildArchitecture) Platform() string {
+	return "linux/" + string(a)
+}
+
+// IsValid returns true if the architecture is one supported by the image builder.
+func (a ImageBuildArchitecture) IsValid() bool {
+	for _, supported := range ImageBuildArchitectures {
+		if a == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchitectureLogPrefix returns the prefix prepended to every log line produced while
+// building the given architecture of a multi-architecture ImageBuild.
+func ArchitectureLogPrefix(arch ImageBuildArchitecture) string {
+	return fmt.Sprintf("[%s] ", arch)
+}
+
+// FilterArchitectureLogs returns only the log lines of logs that were produced while
+// building the given architecture, with the architecture prefix removed.
+func FilterArchitectureLogs(logs string, arch ImageBuildArchitecture) string {
+	prefix := ArchitectureLogPrefix(arch)
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(logs, "\n") {
+		if rest, ok := strings.CutPrefix(line, prefix); ok {
+			b.WriteString(rest)
+		}
+	}
+	return b.String()
+}
+
+// FindImageBuildArchitectureStatus finds the status entry for arch in statuses.
+func FindImageBuildArchitectureStatus(statuses []ImageBuildArchitectureStatus, arch ImageBuildArchitecture) *ImageBuildArchitectureStatus {
+	for i := range statuses {
+		if statuses[i].Architecture == arch {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// SetImageBuildArchitectureCondition sets newCondition on the status entry for arch,
+// creating the entry if it does not exist yet. If manifestDigest is non-nil it is also
+// recorded on the entry. statuses must be non-nil.
+func SetImageBuildArchitectureCondition(statuses *[]ImageBuildArchitectureStatus, arch ImageBuildArchitecture, newCondition ImageBuildCondition, manifestDigest *string) {
+	if statuses == nil {
+		return
+	}
+	entry := FindImageBuildArchitectureStatus(*statuses, arch)
+	if entry == nil {
+		*statuses = append(*statuses, ImageBuildArchitectureStatus{
+			Architecture: arch,
+			Conditions:   []ImageBuildCondition{},
+		})
+		entry = &(*statuses)[len(*statuses)-1]
+	}
+	if entry.Conditions == nil {
+		entry.Conditions = []ImageBuildCondition{}
+	}
+	SetImageBuildStatusCondition(&entry.Conditions, newCondition)
+	if manifestDigest != nil {
+		entry.ManifestDigest = manifestDigest
+	}
+}
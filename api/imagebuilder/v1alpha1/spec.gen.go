@@ -19,91 +19,97 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9a3PbNrZ/BcO9M0129EjS9j48c2eu6yRd3yRN1na6HzaZDkQeSViTAAOAdtSO/vvO",
-	"OQBJUAIlOrHdpNWnWAR4cF44T4D5LUlVUSoJ0prk6LfEpEsoOP15XIqfQRuhJP7KwKRalJZ+JsdvTv0Y",
-	"y2AuJBhml8Cu3DPImIPD1JzZpTBMQ6nBgLQcAeBjLpma/QtSO2HnoPFFZpaqyjOWKnkF2jINqVpI8WsD",
-	"zTCraJmcWzCWCWlBS56zK55XMGJcZqzgK6YB4bJKBhBoipmwV0oDE3KujtjS2tIcTacLYSeX/20mQk1T",
-	"VRSVFHY1TZW0Wswqq7SZZnAF+dSIxZjrdCkspLbSMOWlGBOyEokykyL7iwajKp2CmSSjBGRVJEf/TK4e",
-	"87xc8sfJKJnnYrG0qc1xteb5+1FiVyUkR4mxWshFMko+jvHt8RXXkhdgEEwrj59bgO3D5zXoU/VzAPjj",
-	"eKHGXejrUfKDkJmQiwt6vinciyUwfAPFNHMT2VxpYr0o+ALYrBJ5FpIIXOerZJSgZAaSE6DwzL8dPHpJ",
-	"gNajhMb8wDaqNNogmSo5F4tKOyUbMyhmkBmWgrZiLlJuUfQtGUhAqVWJw0Aqb4fyY5v2LaLX61Gi4UMl",
-	"NGQ4jUbbWU75URbPPpZK2+dKF9zuF8ic5uFOAHovkIpVHa0rsstklHxI1fWTZJQIo+pf40yYS9Rcy4UE",
-	"PVBem2j+/Orpi2Qb+7+fvP7Hk8jz0/PXfbOfCnN50mKzHiWnSM8PqGTbzGjHWqtiGHc6yZDjaBtQXzlr",
-	"aOwTOO/YuP/QME+Okr9MW5s49aZnGljD9SghcE4AJmIdteYrFBbh6mhmjWlgdsnx5xw0yBScgWypmrDX",
-	"Ml+xUpUV7qaMXS9Bsmthlw6QYR8q0CtWcs0LsEibYVZXRJ2wUJh9pARYIS1e9hyRxt+XQkbY/kLIDFfi",
-	"zCmJs6itCPARquLZs/OLhlZHm1P1QFqtyUdzLeQctJs516ogKCCzUgnptDvNBUjLTDUrhDW1iNEbTNgJ",
-	"l1JZNgNWlRmya8JOJTvhBeQn3MCdG3xknhkjy8jkbxnaAizPuOX7ZJIqDb9cPZ6B5Y9/USVIXopfXhPj",
-	"XoHlCMqUkA4SLanROc7Gtyy3lbnBe27+pvUKNorXkIA2j1vMuLWAe2341hSG0MRcgOmx7LUzatxQJhBg",
-	"ISS3SuMKBS9Lv5gzzz10d7yLd189U9Ej1TPXjR1Z/cQLfIUIX48SJeH1PDn65252d5Zdj3ZP7izctY4n",
-	"SmbCeuvF83zA0lFFa+D8wA0QQnG/OEyFGmjkzQb6wfejDbVooJC4O1a/ibHizDgDbmJBq3veaE8A8gx4",
-	"tkIlcwBCN/oGauWgqe7PN5VZur9OVFHmYAH3w3MucvrjhMsUcj+B/oZsoJftJafFo3dKgGA/mAbz3ikh",
-	"Sb2TGlr7wQRM2DMJuRMXZU885GOhQIJR2ZFYP53xuIwH0cHuKRiLpiaaGUWnBRYNNS8LRkJbZvvCFHrs",
-	"LE0sNkQiXKoFTFW2rAJAWx6JRi74oifM5IvBkDSUygir9Go/WmfN3DY4wCGU5OuTU4xny8osN1nhY9rd",
-	"kXWAxyhgVUDqbs/0Uhi7S5A47kKfHP/qKl4n47ul4LIO4roIvdyz+PDYz0XWh9DvCw79UNgu8LtZIOaU",
-	"YLe+n8H8nGS0S+mbSYHt4szL1u1NEhmXMYXsMWE15P32IgITbURlgHHD2kW2uL4/hW9frv1Ei9vANH60",
-	"Qc5ufu9n9hankQVdXm+GvJ/sIEKwn+cg9kK6TQfhU/na1uxZ+5Y9xLlPvXrlV0LK3OCslp6TZdp6eS43",
-	"q2ddGc7aBGmYBQ+ykawbkgx7P4xjMFNs1HRgpujmr0dJZUCfhBnacCBvt17dlJ1Hq0vjqOHWHsE12W+v",
-	"6GhGWEoiv1NpTY7Hjbp6dVCj2fb1gbOIK3s4o1b6jaBvawM1UW2EhBOPYjtn23Z+QljQppSR+IAwPasr",
-	"V3E651Wee0Vva1zb9LIHMFlMRuxDxVfojpVeTGkAjcKR5YuHUY7k3NhzABlfG0eZFQW0tpJdo78AkOzB",
-	"Eri2M+CWQLtCanKUYOwwxpeiTpxLMQdjn4oFxKJEXDWjsRiN9dtxC7VDb9/GdlSfCm9NDhwJbs191ZOu",
-	"JpfVLBfpJfTYbDfMLmHVANpeIyo6nCZ7XVQ9ejOoG7aiWWIUkNFrIXwJNM7ZpmrblphlXXKvi8xWNc0q",
-	"LjOXwJDgqVOViTmpv/U1+1vMEA5x+ldeonXa9Qk1Wv/iHRRpHeQvrqK4gdZtlxQ3mzOTPn7cpKjogQ6q",
-	"Kp44A3IvdcUoSRuFxeicDpI7QHWLiz2gNqqL0Vnd8mIc0GZ9ccessMAYU6jdFUYvztspMUaW36gxhj3S",
-	"N0vcPnFHjEMUmTIj5CKHujXsXJLZQPRDBRVxNA1lWTYSS71cMDJyzL8xUQHKf69Xiw/36VMwJapLHRAN",
-	"vvEJXoU22Lqj1tdOiBT7tu3EfVb7YqsfWr2Het/eep8PF3YVoMIpQypQLgbe13UNSmp7U862Hvm5fdUo",
-	"zPcxZlwMLBNuHHxqiR9SO9xnMFtUgrL8Jrr9pad2wvDaU0tA13rV2fCevvXmUaWblY062thb5/Go7NPp",
-	"XZWdcMrw0s6zHt58RhWmBXlTc72zDnOTKojPWe+7DOKWhWyjEuL3EzoAWnhgZSQ8A7HtKrmF3jOAGVyJ",
-	"1A0bxi2bC20smyllb+n4X/zI4+DTf2fef76IuuKzxrui8yGmGsjYleAblT7Q7PjNaYhXYFK69Y73Efn2",
-	"7Sa/hSgY0GArLX0wgGJMeZ7702yZkt/YeoayS9A+FLjFQClVWUQ659UC1Q4y9reLizc1Cji3VTW31Ufs",
-	"ERNzhjGEAdvRfSHtt09aTRTSwgL0IUK69QjJGL6ICPGYLauCy7EGnvFZDiwYbrpNjb12fCxhR61R92Tr",
-	"x6zg6VJI6F3qernaWAAF7Y8Nv6NcvNLwLvH4TNipR8ipgDAMitIiDND0UyriOGoaAuNXXOS48IQdM188",
-	"SHOufYdVOjX2xJIazyrcX2BIc9UVaC0yYMJGCTe7N3J9tKNhHnst0bAdsXfJeZWmYMy7hCkdUnrnaoPh",
-	"ypjLbOxZurfMG4uJPeHeTDQa0CpdzPgOKF1tcRKftu4eDUDlejpzlefqGrf+i2oGWoIFg0aZheSytwbo",
-	"MC+j4hqzivEsQ0PiYzbnatCIb1tOdOwXmkvjSgeir5beDQBaXG3zLmTOvCjZbCzERJLpvkFY0Leh/4bb",
-	"mTV7zM9j6D0xKpULloHlIjeMz1RlPcYNelHVVjODhjL7EST0NUWQ+kmdHk0WzUznpbrccBGRZTOOLrUq",
-	"3bKhT/jP76I+od+4PJhpAfOHTHeLgs2a35hBlA6rB+9W3p4ScbNNIrp0K5vmfJABajgyIhVUc3ahKxix",
-	"5zw3MGJv5aVU1536FY5TLTQ3+K+fMTDn2sDOw9p4WoPeeNys1Ed6k7pHazg4EqRntW46hTQraZdgRRrc",
-	"DigqY9mSX8GICZnmFUW1uTDUgcrYFddCVaZxhz7KYsdtnIFxBAJgSuarmr+/tZ2KEasRW0eTHStkFdnT",
-	"r/gKQwvcMmLetujwN2e5KIRlyrlJWRUzjADnjLIeH5RB5q5oeSNQX4Qg304REFtywwr0NcShwFPitm78",
-	"qyr5hwqa214zwiND+yWMoQEXgAZduo0oCjlPpsR5bir0WYVoagFXzmpK+EhxIiWINSYtu08cm1A2dM3E",
-	"CGMxLiNYiJYPxEpljMA3Pcs8pd0sBelOl1wuIEPfSyywSy4ZZ3O4ZoWQFbKLZFpyYzCwQ5bUEq+v4s0F",
-	"0Hkpx213caQyLhgVhtWi9ay8FnmOKIoMnVPK85pTntNOli5f0mBKJXFrVjIHY9hKVQ4fDSmIhpVWXYJs",
-	"DoaB1kiOsyU9cVrhDvWcWihOVCV7cstWo0w1MyhYab1yeTyJ8ddLkS4Z10Dsd9sHU1AZCLomxUduUD91",
-	"ylLfGMpYzmeQozgcVw3kkGJgM8KXNvW8oaNGyrDK2Q3SU8dIBFMzPYe5ZZWkzSMzpgphMYfJKkonDGjB",
-	"c/Grj9FCREmOrvTNHoAgTZ9ByiuDMaELOC1Ll5W8REiqHSUWCONvUBo/6WFLjwbPOqeBmzQ5QjAx+XRK",
-	"6lRHUcZKOn71ePL4e5apOjEL1nBajp5Xohgr0xwk2dYbpOyvYKwoKL74q9tt4lffIklVjvIjJE4ohWru",
-	"m+K6GshS9sG2qrZ86NHpB3zkqR0UMKyH+tCgl7y1CdoxJLTrRXiesxJNAKXBUU/iNobfEIbe8KaMjLif",
-	"m2rgNnZLDtNK3lTAeOb8I8/fRMonwe7uktBOdmHXqjGIonOWM+AS4uOjE2N5UfYUZ+pI1+fWGNg5UrLh",
-	"oWwGOXzKWn4X0Os3WW+xI4o9Zs7EpY2J6VQWgmQhiHD9zsjAYKxH8QFM2JvmGmHN75WxUEyoOT3GAGFg",
-	"0Evm8HPE/4qXZL9dweQSVnU8k1d1KJByGbpzpRdc4g7GeRgxLJTGnw9Mqkr31Fnlh2GxaUuL5LB7E+4y",
-	"ejTxuJagY1IKKjvcMnUtTX3b3T3HCI69owx1imu9S/qrnugLO948sqCsYx/PRFrWue+2Z0MW9hsT3I73",
-	"0UHn0v2QsitmIpBWWtjVOeYc/pgqcA36uLLL3gSl+1I8UQnA9NnD7kru1/NaU///HxeY9dMSyZEfbala",
-	"Wlv2AlZ6cZrFVeLt29OnjUo4/QsSSC/iNvKaMPaKlz557rzQGukJip5aALgIXd5Naq1MlF78IrIWb16K",
-	"F0A1/gbJT2axg7BGOQo5V3V0z1MKstDL5clRYoEX/xd+l6BFDhniPitA8a5WObsAXiSjpNK5Z7I5mk47",
-	"b29tfKo9s7pK7e095cZd2JN38oKqtX5GwSUdpQsOTQfODd+f+Qtvzek73wAI73ybyTtMaHORgnTlHE/c",
-	"ccnTJbAnk0db9FxfX084DU+UXkz9u2b68vTk2U/nz8ZPJo8mS1vk1JoRNkdwG3zqEn385jQZJVf1xm6/",
-	"CIFydtJKjpJvJ48mj9H5crukrTblpZhePXbnYolYerwA23NeoO9qUFPvQ42iqe1MQyv6u+SGWqzb3sjF",
-	"vi5JQoOR2jYipfDXpxx1UOGcjdAuijZ92k+j5x56vZ15xJmsR7eKFUWXvVjR6KdhhTum4B9FURWd7MBU",
-	"uW3z4E7O0uQjfTzCzLqDxd62RYRb6Dqc0w4+HGCV/5aAj/cdlk3fwbmSPryaQsGNGISpDFV6mlhUg4tP",
-	"4t9KEJIBT5ehZos6TN/Nt+CLCR0UM5jzKrfJ0ZxqTTXKM6Vy4DJZr9+TKybYtN2ePHpUm05w+Skvy9x3",
-	"16f/8qXAdoFhBxToIBLZ5o1A/wUahe9ucc2mEri11g88Y2cu8XWLPr6HRd9KXtklRXKZW/Xbe1j1udIz",
-	"kWVAncTvnvzPPSx5oRR7xeWqZrHBpb+/F2rPvQt9K5vSlQvw+MI0h1ZmzXGTUsV6+ieUQu244Nf1Km56",
-	"p+vsiyo/qGx1BzvIEd4Wt9GurLf27uM7WznGreywee988z66j817ouQ8Fy6BPJiLrrn4OK6tAAYdQWy6",
-	"HsVC1ulv6JHXzsDQkd0tU/OUng82NW56x9TsjGAHXC5uoggMvtsgwl8m6hqZXQHP/QQPuwKHP40Z+O4e",
-	"lvxJWfZcVTI72IFtOxDNQ3+kPsCwffwj2C9yE99G1vIHSFEOVuZgZb7WaGOa0sUvutAcz25onHGmK0lN",
-	"zq0vMDp4uJf9FbkRq7+wNWJKM39DyXVa6t5F6u+bRfIjGrlVe0cXkAnsHyd8OaROfyxrdq/ZGhu7LeqP",
-	"f/jNQSd3aJce7Otw+1pb0N1mNleL3pYEhoK5Wpj6BlIsYmPPcSy14sq3eMyIGauBF8a9m4srP6s+9pI1",
-	"E1093Z+p/P7RI5YLGWt4dOLMl2rxVYSajguOCb7WriqTr9iDXFwCu6xmkNrcjY/nD6OcvAQo6W3pzr4w",
-	"VYLcx02ee6jUds+Vgf5OCR15vu3Y1cJHO4UrkHbsmNDdFRt9L7Wov1TIjT9fND4HadmzK3fKx/HxAZ2G",
-	"cwj/L3LYaWXIr4fxpjhiU+ZcyOFo0HSGb3bXJZ7QmbKIBGLLH+LvQ/z9pfoHNP2bziH4JPqeLnXsSntP",
-	"m7pNkQ996kOf+k761Hee9wRfnzgkP4em7+9m18HfAR7a9d0w0zvbvv5+8R32fesvmfwOjd9w6UPn99D5",
-	"/XPajO1gsI74eiLBmzd/91qcoPvbWJybp/ORZb6KAmq/HTqkhofU8J7Nwd4O8N7NXJfmDjv5sJMPO/nr",
-	"c+yf2Gf138VyjVYPsdNpbb8Q+Zm91tszLF9ht3W/iTnkK4d26+e0W/0eOfRbb8HU9nVcNyxupq5lrnjW",
-	"W2R/6idsRmFcWzHnqW0rpxoWwli9iiRZHsYfIjhTqYV4L7EpUc+E5FQ1HtCBY2N2XLNylqsZq5ddj5Jv",
-	"Hz3ZFghtTzZmZ5AJDan/2IhjvYPw9uxlMkqWwDPi7W/JS5U2l8P72bCmFf8rcpUVilJprlftmne0/MGF",
-	"HELj27PX96FLp/XFeHdYgD3TWumv0V00jmCPw7jxGZ1Nox0eLfGwB5zSaWbe9JiO75V94jmdO/I4d3NQ",
-	"p+HRoJM6Wxz9Ex7V8Tz43c7q7Fj/UMc51HG+XG9Bx3Xoky70CS4yp+6zF1P6r3I8xK0PT9Um2jAlez8w",
-	"4Y1McHRo22BGIMUOAYWgPGHr9+t/BwAA//8XWl7uqIYAAA==",
+	"H4sIAAAAAAAC/+w9f3PbNrJfBcN7M5fcUHKS5vre+c2bea6T9PySNL7Yaf+4dDoQuZJwJgEGAO2oHX33",
+	"N7sASZAiJSqx3aSnv2IR4GKx2N+7RH6LEpUXSoK0Jjr+LTLJEnJOf54U4kfQRiiJv1IwiRaFpZ/RyfmZ",
+	"H2MpzIUEw+wS2LV7BilzcJiaM7sUhmkoNBiQliMAfMwlU7N/QWKn7AI0vsjMUpVZyhIlr0FbpiFRCyl+",
+	"raEZZhUtk3ELxjIhLWjJM3bNsxJixmXKcr5iGhAuK2UAgaaYKXutNDAh5+qYLa0tzPHR0ULY6dV/malQ",
+	"R4nK81IKuzpKlLRazEqrtDlK4RqyIyMWE66TpbCQ2FLDES/EhJCVuCkzzdM/aTCq1AmYaRRHIMs8Ov5n",
+	"dP2YZ8WSP47iaJ6JxdImNsPV6uc/x5FdFRAdR8ZqIRdRHH2c4NuTa64lz8EgmOY8fmwANg9fVKDP1I8B",
+	"4I+ThZq0oa/j6DshUyEXl/S8e7iXS2D4Bh7TzE1kc6WJ9CLnC2CzUmRpuEXgOltFcYQnM3I7AQrP/dvB",
+	"o1cEaB1HNOYHNlGl0RrJRMm5WJTaMdmEQT6D1LAEtBVzkXCLR99sAzdQaFXgMBDL27H02Nz7xqbX6zjS",
+	"8KEUGlKcRqPNLMf8eBbPPxZK2xdK59zuPpA5zUNJAHovOBWrWlyXp1dRHH1I1M2TKI6EUdWvSSrMFXKu",
+	"5UKCHnleXTR/fP3sZbSJ/T9O3/z0pOf52cWbodnPhLk6bbBZx9EZ7uc7ZLJNYjRjjVYxjDueZEhx1A3I",
+	"r5zVexw6cN7Scf+hYR4dR386anTikVc9R4E2XMcRgXMHYHq0o9Z8hYdFuLo9s1o1MLvk+HMOGmQCTkE2",
+	"u5qyNzJbsUIVJUpTym6WINmNsEsHyLAPJegVK7jmOVjcm2FWl7Q7YSE3u7YSYBWt67PniDT+vhKyh+wv",
+	"hUxxJc4ckziN2hwBPkJWfPv84rLeq9ubY/VmqmlUPqprIeeg3cy5VjlBAZkWSkjH3UkmQFpmylkurKmO",
+	"GK3BlJ1yKZVlM2BlkSK5puxMslOeQ3bKDdy5wkfimQmSjFT+hqLNwfKUW77rTBKl4ZfrxzOw/PEvqgDJ",
+	"C/HLGyLca7AcQZkCklFHS2x0gbPxLcttafZ4z83vaq9AUDyHBHvzuPUptwbwSUDJHpFhlusFWHZ6/o6F",
+	"RHeSLEPDE7PSVOxmeA5M8hx/c+eEtN7WgGwOKSr+Z3AtErhYGQv5mZy3tCXP02+fRnHEdU7/FkXy7dMM",
+	"cGvf/O3Rx5E6sn+3Jx72wKjOt4ye13j0j1847AYpfVEf/5AW3Zwd6lW79ERnjpHIdWNI/qxDaefTBXps",
+	"U9l2OGAcQ7b4Zh1HiZKpIPnb3NVpqTWqimaO80CrPTgvBrVYAHQ/vUk4nVbw+/RnzqWYg7HPxAKM7bfm",
+	"KY1VyDnert4jXO0wrtvdjHB21KLWdvEcdLE2pjAUdjEXYAYcr8pXrL3EVCDAXEhulcYVcl4UfjHnPQ1Q",
+	"vOX8ee9yYCo6jNXMdc15qx94jq/QxtdxpCS8mUfH/9x+0K1l1/H2ya2F24LYsAlyf5aNWLrXDtRwvuMG",
+	"CKF+t3VP5iVnc6Sb+nPcFbUKCh13yymrQ6B+YrwFbvpiSve85p4A5Fvg6aoR6lBvn0PFHDTV/XlemqX7",
+	"61TlRQYW0Fy94CKjP065TCDzE+hvSPdW8J3tNHgMTgkQHAZTYz44JdzS4KR6r8NgAiLsmITU6T/KgXDF",
+	"hyrBCfaeHR3rpxMel/EgWtg9A2OF5LY3cdE7LdBoyHlpMBLqMjsURdBjp2n6lD1uolL1qrRFGQDacBhp",
+	"5JIv+kFZvhgNCV0fI6zSq91ova3nNr47DuFJvjk9w3CzKM2ySwofcm63SAEecUCqYKvbLdMr0WdF2+Mu",
+	"MsmEs6n92ujWYr/KV2gj9GrH4nu6GIfI7EuOzPCwXVy2X5zkmGA7v7+F+QWd0TamrycFuoszf7ZONunI",
+	"Wi55ffoDKqyCvFtf9MBEHVEawCisWWSD6rszbM3LlZ1ocBuZZYs729lO793E3qA0RZ4tWndd3k82ECHY",
+	"zzMQOyHdpoHwmbY6LN++9i1biAufGRk8vwIS5gZn1em5s0waK9/OMWyPXk0/wSr705qKckEgg1BvrvSU",
+	"/YR5vRyVoF1yyZTsBNTCEEBI4w2vRBhWlLNMmCWkKHC8CR4Jh+A0lKwWLUC3Y0n2DOa8zEhfM0qAMDFn",
+	"pTRgP8FgdeP0rvGaNQHmOIBBNJe2Xbpx74d+ICbCajEfmQhz89dxVBrQp2GEOx7Iu41Xu7zv0WrvsaHW",
+	"Dsbfmd3pz+gkPk8S5HT2S91s8n43F9R1mtkzRCHhPhc359dKb8jKfzOFuW8DLv3h0kwtS+9Eqd+ij5DQ",
+	"AnQbXEOBWn3HJDMgrV4RFsCTZUcyZa1DPlNQqmzrprh8WpqrfYa3mdWiU3xbFS36qTsvs8wrm6a8sckL",
+	"7AFMF9OYfSj5Cl09pRdHNIAG59jyxcPe4824sRcAckD3cmOZFTk0B8luuGEGQLIHS+DazoBbAu1qaNFx",
+	"hH7pBF/qW2//PF64x+rtKXuhNMvLzIo+RnbetfCBZwtaS6X3G9EtquFdn9Ia0hIbkwNfB7XfrgRfW1mQ",
+	"aUquYMCtcMPsClY1oM01ejkAp8lBL6oa3Q9qRx3XS8TBNgaVsC+i9VO2rvs1RUpZFW2rMqVVdbsDl6mL",
+	"sYl/yCanYk5SZH3V9xaD2EMo+ZUX+Rx3fUKVz794B2U+B/mLS3p30LrtrHe3vD8dosc+eW8PdFTi+9Qp",
+	"kHtJffduqZP77p3TQnILqHb+ewBUJwHeO6udAe8H1E2Bb5kV5sD7GGp7Etwf5+1kwXuW76TBwy6b8yU3",
+	"AwazwKFWQdc3FzmTZDqIfiihJIom4VkW9Ykl/lzQwXLE33tTAcr/qFbrHx7ip2BKLy+1QNT49k/wLNQh",
+	"65Z0dDOhJx+9qSfuMyHdt/qhWeiQkt6ZkvbuwrYcaThlTJLU+cC7GgOCrO/OyLVJmX9u6b8X5s99xLgc",
+	"mcnutM42mx+T3t6lMBtUzgIoHXSHs6PNhPHp0WYDbe1VBdU7Wiu6za77ZeZa3DiYSvOo7OLpbcmzcMr4",
+	"7NnzAdp8RjKnAbmvut6aztknmeJj1vvOprhlMV3Ybo+qG6PcwiMzI2Gbzqap5BYGu8hTahukYcO4ZXOh",
+	"sUlLKXtLDeT9TfOj+8ffevv5stcUv62tKxofIqrB7yEE7yQMQbOT87MQr2as7SL14BpHQ9LknjtnQIMt",
+	"tfTOAB5jwrPM90OnSv7ZVjOUXYL2rsAtOkqJSntO56JcINtByv5+eXleoYBzG1Zzoh6zR1ghkcoyXyOp",
+	"eV9I+82ThhOFtLAAffCQbt1DMoYvevuHl2XO5UQDT/ksAxYM1wXRINePdCxgS65RD0TrJyznyVJIGFzq",
+	"ZrnqLIAH7WsG7ykWLzW8jzw+U3bmEXIsIAyDvLAIA1wVUCqiuM4dMH7NRYYLT9kJ88mDJOPaNwFIx8Z+",
+	"s8TGsxLlCwxxrroGrUUKTNjejZvtguxp2RCPvZGo2I7Z++iiTBIw5n3ElA53eudsg+7KhMt04km6u0u2",
+	"xyf2G/dqouaAhun6lO+I1NUGJfFpY+5RAZRVq3uWqRsU/ZflDLQECwaVMgu3y94ZoM9BGCXXqHabpozX",
+	"PpszNajENzUnGvZLzaVxqQMxlEtvOwANrrZ+F1KnXpSsBQsxkaS693ALhgT67yjOrJYxP4+h9Uw4qccU",
+	"LBeZYXymSusxrtHrZW01M6go0+9BwlBRBHc/rcKj6aKe6axUmxrOI7JsxtGkloWSrY0Lab992uAR2IRh",
+	"5fJgpgXMHzLdTgrWa/7ZjNrpuHzwduYdSBHXYtLDS7ciNBejFFBNEVeuVXN2qfEj0Bc8MxCzd/JKqptW",
+	"/grHKReaGfzXzxgZc3Ww87A6TyvQncf1SkNbr0P33hwOjgThWcWbjiHNStolWJEE35flpbFsya8hZkIm",
+	"WUlebSYMVaDwU1gtVGlqc+i9LHZSgyA/AgG4cryn729NpSJmFWLr3mDHCln2yPRrvkLXAkVGzJsSHf7G",
+	"ZFkuLFPOTMoynwH1B1DU450ybIXBHXglUH1Khy+QYGu25Ma11BCFAkuJYl3bV1XwDyXU3wvPCI8U9Zcw",
+	"poRKi4VVuo4Xxa1bMXWWmxJ9ViGaWsC105oSPlram5o3mDTkPnVkwrOhDxWNMBakb+BBtLwjVihjBL7p",
+	"SeZ32o5ScN/JkssFpGh7m64izuZww3IhSyQXnWnBjYHUkaQ68epj7rmALK2p7T49rD62EuhNuKP1pLwR",
+	"WYYoihSkFQnPKkq5Ye/yuHhJgymURNEsZQbGsJUqHT4aEhA1Ka26Aln3LoLWuB2nSwb8tNx1Op1ZyE9V",
+	"KQdiy4ajTDkzeLDSeubyeBLhb5aCuj2AyO/Ex3WsNAddbcV7blA9dcxSfXOasozPIMPjcFQ1kEFilTbU",
+	"z9Xl83ofFVKGlU5vEJ86QiKYiugZzK1r1qIJKhcWY5i0pHDCgBY8E796Hy1ElM7Rpb7ZAxDE6TNIeGmA",
+	"CescTsuSZSmvEJJqRsNeBbJCNOlhsx8NnnSOA7t7chsR5nN2UoU6iiJW4vHrx9PHf2WpqgKzYA3H5UJa",
+	"kHiMpan7UTb5Bnf2FzBW5ORf/IWmGfGrL5EkKsPzIyROKYSqbyzAdTWQphyCjU2ATvMp7X/AR57YUQ7D",
+	"eqwNbTT0phA0Y0x0rQjPMlagCqAwuNeSOMHwAmHoDa/KSIn7uYkGbvu+s5ZSubsf3M/U2UeenfekTwLp",
+	"bm+hmezcrlWtEEWr3TigEuLjvRNjeV4MJGcqT9e9SY6d20o63pVNIYNPWctLAb2+z3qLLV7sCXMqLqlV",
+	"TCuzEAQLDZRKMlIw6OuRfwBTdl5/iF7Rmz6gnVJxeoIOwkinl9Th5xz/a16Q/qZh7B+q/JmsrFyBhMvQ",
+	"nCu94Hj/B81LuIWF0vjzgUlU4Z46rfwwTDZtcJEc92kPze8PPG4k6L5TCjI73DJ1I011X4p7jh4ce08R",
+	"6hGu9T4aznrGUfXW8LUtsvJ9PBFpWWe+m5oNadg/m+B+FQevfW3LmLQrRiKQlFrY1QXGHO6YZ8A16JPS",
+	"LgcDlPZL/YFKAGZIH7ZXcr9eVJz6fz9dRrG78AZxdqPNrjBPMQhY6cVZ2s8S796dPatZwvFfEED6I248",
+	"ryljr3nhg+fWC42SnuLRI8EFLkLXP0QVV0ZKL34RaYM3L8RLoBx/jeQnk9hBWOM5YvKm8u55Qk4WWrks",
+	"Oo4s8Px/w5ttGuSQIO5iGvJ3tcrYJfA8iqNSZ57ImAxqvb0h+JR7ZlWW2ut7io3bsKfv5SVla/2MnEtq",
+	"pQv6+gPjhu/P/DeZdfedLwCEt4aY6XuJ4a5IQLp0jt/cScGTJbAn00cb+7m5uZlyGp5id6t/1xy9Ojt9",
+	"/sPF88mT6aPp0uYZ7tUKmyG4Dp3amz45P4vi6LoS7OZOITxnd1rRcfTN9NEUbwUquF2SqGGy7Oj6sWuv",
+	"pc3S4wXYgX6Boa/X6nzfWeqnNjMNrehvIzFUYt20Rs73dUESKozENh6pmjchR+VUOGMjtPOizRD30+iF",
+	"h16JM+8xJuv4VrEi73IQKxr9NKwuqfP3o8jLvBUdGPpQo0YojFnqeGSIRiIXtoXFzrLFOu41Hc5oB1fP",
+	"WOVvo/H+vsOyrjs4UzKEV50o2ItAGMpQpqf2RTU4/6T/th0hXQd/wNmictO30y24c6eFYuo+m4mO55Rr",
+	"qlCeKZUBl9Ea2wYr2CRuTx49qlQnuPiUF0Xmq+tH//KpwGaBcQ0KKIRON3cc/ZeoFJ7e4pp1JnBjre84",
+	"fp1Pga9b9PE9LPpO8tIuyZNL3arf3MOqL5SeiTQFqiQ+ffK3e1jyUin2mstVRWL6UOSv97LbC29C38k6",
+	"deUcPL4wddPKrG43KVRfTf+UQqgt36C2rYqb3qo6+6TKdypd3YEEuY03yW3UK+sN2X18Zyv3USs9CO+d",
+	"C++j+xBe/J4mE4k9qItNdfFxUmmB6DgYI4R7XNaj39Air52CoZbdDVXzjJ6PVjVuekvVbPVgR3z/XnsR",
+	"6Hw3TgT901Uy2xye+3EetjkO/zZq4Ok9LPmDsuyFKmV60AObeqA3Dv2e6gDj5Ph7sF+kEN9G1PIHCFEO",
+	"WuagZb5Wb+MooQ+/EMuB6IbGGWe6lFTk3LjD18FDWfafyMWsugQuxjqg/0LJVVqq2kXivzfriY9o5Fb1",
+	"HX2ATGD/OO7LIXT6Y2mze43W2MSJqG//8MJBnTskpQf9Ol6/Vhp0u5rN1GKwJIGuYKYWpvoCqc9jo/s1",
+	"eGLFdXWnRsyM1cBz497NxLWfVbW9pPVEl0/3PZV/ffSIZUKC2eFnvlKLr8LVdFRwRPC5dlWabMUeZOIK",
+	"2FU5g8Rmbnwyf9hLySuAgt6WrveFqQLkLmryzEOlsnumDAxXSqjleT/ftW/HBmzsuhUdEri+O0xWaJWW",
+	"CXWziQyakh+ey0Jcg9y8x3rwvpahbXRuXd7XnLXvzhphQy18tEeAPeETd8ptse8U9tSiui2UG99ANbkA",
+	"adnza9fG5BjlAbX7uRP5H2QhNu8yxMP+qj9iU2RcyPFo0HSGb7bXpUOnprkeFutb/hBgHAKML9UAom3r",
+	"Wr/gfw3ZUYbv+2Z/oA7f5AAOhfhDIf5OCvF3HtgF12scortDVft30+vgP3IeW9buqOmtdW039y4L236F",
+	"36OyHS59KG0fStv/njpj0xl0g2bIE9y/ur1T4wTl7Vrj7J+v6Fnmq8gQD+uhQ2h4CA3vWR3sLHHvFOYq",
+	"93iQ5IMkHyT56zPsn1hI9hd/uUqyh9gqJTdXYH5mMfn2FMtXWE7erWIO8cqhnvw59WQvI4eC8i2o2qGS",
+	"ckfjpupGZoqng0n2Z35C1wvj2oo5T2yTOdWwEMbqVU+Q5WH8IZwzlVjoryXWKeqZkJyyxiMqcGzCTipS",
+	"zjI1Y9Wy6zj65tGTzQMh8WQT9hZSoSHxt6k40jsI796+iuJoCTwl2v4WvVJJ/fX7MBnWtOJ/bq54CXmh",
+	"NNerZs07Wv5gQg6u8e3p6/vgpbPqy3/XLMCea63012guakOww2Ds3YTUVdph74yHPaINqZ65bx+Sr5V9",
+	"YiPSHVmcu+lEqmk0qhVpg6J33Iv0JbbqeBr8br06W9Y/5HEOeZwv11pQuw7dWYNy59Spu9fjiP4vIA9x",
+	"42atSkUbpuTgDRpeyQStQ+t4BKS+JqAQlN/Y+uf1/w8AUvKB8suNAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -31,6 +31,14 @@ const (
 	ExportFormatTypeVMDK               ExportFormatType = "vmdk"
 )
 
+// Defines values for ImageBuildArchitecture.
+const (
+	ImageBuildArchitectureAmd64   ImageBuildArchitecture = "amd64"
+	ImageBuildArchitectureArm64   ImageBuildArchitecture = "arm64"
+	ImageBuildArchitecturePpc64le ImageBuildArchitecture = "ppc64le"
+	ImageBuildArchitectureS390x   ImageBuildArchitecture = "s390x"
+)
+
 // Defines values for ImageBuildConditionReason.
 const (
 	ImageBuildConditionReasonBuilding  ImageBuildConditionReason = "Building"
@@ -132,6 +140,21 @@ type ImageBuild struct {
 	Status *ImageBuildStatus `json:"status,omitempty"`
 }
 
+// ImageBuildArchitecture A target CPU architecture for an image build, using the same naming as the architecture reported in DeviceSystemInfo.
+type ImageBuildArchitecture string
+
+// ImageBuildArchitectureStatus ImageBuildArchitectureStatus represents the build status of a single architecture of an ImageBuild.
+type ImageBuildArchitectureStatus struct {
+	// Architecture A target CPU architecture for an image build, using the same naming as the architecture reported in DeviceSystemInfo.
+	Architecture ImageBuildArchitecture `json:"architecture"`
+
+	// Conditions Current conditions of the build for this architecture.
+	Conditions []ImageBuildCondition `json:"conditions"`
+
+	// ManifestDigest The digest of the image manifest built for this architecture.
+	ManifestDigest *string `json:"manifestDigest,omitempty"`
+}
+
 // ImageBuildBinding ImageBuildBinding specifies binding configuration for the build.
 type ImageBuildBinding struct {
 	union json.RawMessage
@@ -217,6 +240,9 @@ type ImageBuildSource struct {
 
 // ImageBuildSpec ImageBuildSpec describes the specification for an image build.
 type ImageBuildSpec struct {
+	// Architectures The list of architectures to build the image for. When more than one architecture is listed, the destination is published as a manifest list containing one image per architecture. Defaults to amd64 if unset.
+	Architectures *[]ImageBuildArchitecture `json:"architectures,omitempty"`
+
 	// Binding ImageBuildBinding specifies binding configuration for the build.
 	Binding ImageBuildBinding `json:"binding"`
 
@@ -232,9 +258,12 @@ type ImageBuildSpec struct {
 
 // ImageBuildStatus ImageBuildStatus represents the current status of an ImageBuild.
 type ImageBuildStatus struct {
-	// Architecture The architecture of the built image.
+	// Architecture The architecture of the built image. Deprecated in favor of architectures; only set for single-architecture builds.
 	Architecture *string `json:"architecture,omitempty"`
 
+	// Architectures The per-architecture status of the build, one entry for each architecture in the spec.
+	Architectures *[]ImageBuildArchitectureStatus `json:"architectures,omitempty"`
+
 	// Conditions Current conditions of the ImageBuild.
 	Conditions *[]ImageBuildCondition `json:"conditions,omitempty"`
 
@@ -244,7 +273,7 @@ type ImageBuildStatus struct {
 	// LastSeen The last time the build was seen (heartbeat).
 	LastSeen *time.Time `json:"lastSeen,omitempty"`
 
-	// ManifestDigest The digest of the built image manifest.
+	// ManifestDigest The digest of the built image manifest. For multi-architecture builds this is the digest of the manifest list.
 	ManifestDigest *string `json:"manifestDigest,omitempty"`
 }
 
@@ -410,6 +439,9 @@ type GetImageBuildParams struct {
 type GetImageBuildLogParams struct {
 	// Follow If true, stream logs continuously (like kubectl logs -f). For active builds, keeps connection open. For completed builds, returns all logs and closes.
 	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// Architecture If set, only return log lines produced while building the given architecture of a multi-architecture build.
+	Architecture *ImageBuildArchitecture `form:"architecture,omitempty" json:"architecture,omitempty"`
 }
 
 // ListImageExportsParams defines parameters for ListImageExports.
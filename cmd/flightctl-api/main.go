@@ -20,6 +20,7 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/rendered"
+	"github.com/flightctl/flightctl/internal/revocation"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -42,7 +43,7 @@ func main() {
 	defer log.Println("API service stopped")
 	log.Printf("Using config: %s", cfg)
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
@@ -73,6 +74,11 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed creating TLS config: %v", err)
 	}
+	previousCABundle, err := crypto.LoadPreviousCABundle(cfg.CA)
+	if err != nil {
+		log.Fatalf("loading previous CA bundle: %v", err)
+	}
+	crypto.AddClientCAs(agentTlsConfig, previousCABundle)
 
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 
@@ -93,6 +99,17 @@ func main() {
 		log.Fatalf("starting rendered version manager: %v", err)
 	}
 
+	var revocationRefreshInterval time.Duration
+	if cfg.CA.Revocation != nil {
+		revocationRefreshInterval = time.Duration(cfg.CA.Revocation.RefreshIntervalSeconds) * time.Second
+	}
+	if err = revocation.Revocations.Initialize(ctx, store, provider, revocationRefreshInterval, log); err != nil {
+		log.Fatalf("creating certificate revocation registry: %v", err)
+	}
+	if err = revocation.Revocations.Instance().Start(ctx); err != nil {
+		log.Fatalf("starting certificate revocation registry: %v", err)
+	}
+
 	// create the agent service listener as tcp (combined HTTP+gRPC)
 	agentListener, err := net.Listen("tcp", cfg.Service.AgentEndpointAddress)
 	if err != nil {
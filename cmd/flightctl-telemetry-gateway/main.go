@@ -5,10 +5,13 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/store"
 	tg "github.com/flightctl/flightctl/internal/telemetry_gateway"
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceattrs"
 	"github.com/flightctl/flightctl/pkg/log"
 )
 
@@ -43,7 +46,22 @@ func main() {
 		cancel()
 	}()
 
-	if err := tg.Run(ctx, cfg); err != nil {
+	var opts []tg.Option
+	if cfg.TelemetryGateway.Logs != nil {
+		// Logs are labeled and routed by the attributes of the device, read from the data store.
+		db, err := store.InitDB(cfg, log)
+		if err != nil {
+			log.Fatalf("initializing data store: %v", err)
+		}
+
+		store := store.NewStore(db, log.WithField("pkg", "store"))
+		defer store.Close()
+
+		ttl := time.Duration(cfg.TelemetryGateway.Logs.DeviceCacheTTL)
+		opts = append(opts, tg.WithDeviceLookup(deviceattrs.NewDeviceCache(store.Device(), ttl)))
+	}
+
+	if err := tg.Run(ctx, cfg, opts...); err != nil {
 		log.Fatalf("failed to create telemetry gateway: %v", err)
 	}
 }
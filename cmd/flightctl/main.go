@@ -42,6 +42,8 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdCertificate())
 	cmd.AddCommand(cli.NewCmdDownload())
 	cmd.AddCommand(cli.NewCmdLogs())
+	cmd.AddCommand(cli.NewCmdToken())
+	cmd.AddCommand(cli.NewCmdLabel())
 
 	return cmd
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
 	systeminfocommon "github.com/flightctl/flightctl/internal/agent/device/systeminfo/common"
+	"github.com/flightctl/flightctl/internal/agent/device/telemetry"
 	"github.com/flightctl/flightctl/internal/agent/identity"
 	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/agent/reload"
@@ -201,6 +202,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.DataDir,
 		a.config.SystemInfo,
 		a.config.SystemInfoCustom,
+		a.config.SystemInfoCollectors,
 		a.config.SystemInfoTimeout,
 	)
 	if err := systemInfoManager.Initialize(ctx); err != nil {
@@ -284,6 +286,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		systemInfoManager,
 		systemdManagerFactory,
 		pullConfigResolver,
+		a.config.ApplicationHostAccess,
 	)
 
 	// register the application manager with the shutdown manager
@@ -374,10 +377,15 @@ func (a *Agent) Run(ctx context.Context) error {
 		identityProvider,
 		statusManager,
 		systemInfoManager,
+		podmanClientFactory,
+		rwFactory,
+		rootSystemdClient,
+		tpmClient,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to initialize certificate manager: %w", err)
 	}
+	statusManager.RegisterStatusExporter(certManager)
 
 	// create the gRPC client this must be done after bootstrap
 	grpcClient, err := identityProvider.CreateGRPCClient(&a.config.ManagementService.Config)
@@ -417,6 +425,16 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.DataDir,
 	)
 
+	// create telemetry manager
+	telemetryManager := telemetry.NewManager(
+		a.config,
+		identityProvider,
+		statusManager,
+		client.NewJournalctl(rootExecuter, v1beta1.RootUsername),
+		rootReadWriter,
+		a.log,
+	)
+
 	// create agent
 	agent := device.NewAgent(
 		deviceName,
@@ -440,6 +458,8 @@ func (a *Agent) Run(ctx context.Context) error {
 		prefetchManager,
 		pullConfigResolver,
 		pruningManager,
+		telemetryManager,
+		certManager,
 		backoff,
 		a.log,
 	)
@@ -464,6 +484,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	startAsync(consoleManager.Run)
 	startAsync(specManager.Publisher().Run)
 	startAsync(certManager.Run)
+	startAsync(telemetryManager.Run)
 
 	// main agent loop: all critical work happens here serially
 	err = agent.Run(ctx)
@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -51,6 +53,20 @@ func WithLogSince(t time.Time) LogOptions {
 	}
 }
 
+// WithLogAfterCursor returns the entries following the entry with the given cursor.
+func WithLogAfterCursor(cursor string) LogOptions {
+	return func(o *logOptions) {
+		o.args = append(o.args, "--after-cursor", cursor)
+	}
+}
+
+// WithLogPriority returns the entries of the given priority or more severe, e.g. "warning".
+func WithLogPriority(priority string) LogOptions {
+	return func(o *logOptions) {
+		o.args = append(o.args, "-p", priority)
+	}
+}
+
 func (j *Journalctl) Logs(ctx context.Context, options ...LogOptions) ([]string, error) {
 	args := []string{
 		"-o", "cat",
@@ -81,3 +97,85 @@ func (j *Journalctl) Logs(ctx context.Context, options ...LogOptions) ([]string,
 
 	return result, nil
 }
+
+// JournalEntry is an entry of the journal with its string fields, e.g. MESSAGE or _SYSTEMD_UNIT.
+type JournalEntry map[string]string
+
+// Cursor returns the cursor of the entry, which identifies it in the journal.
+func (e JournalEntry) Cursor() string {
+	return e["__CURSOR"]
+}
+
+// Timestamp returns the time at which the entry was written.
+func (e JournalEntry) Timestamp() time.Time {
+	usec, err := strconv.ParseInt(e["__REALTIME_TIMESTAMP"], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMicro(usec)
+}
+
+// Entries returns the entries of the journal with all their fields.
+func (j *Journalctl) Entries(ctx context.Context, options ...LogOptions) ([]JournalEntry, error) {
+	opts := logOptions{args: []string{"-o", "json", "--no-pager"}}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	stdout, stderr, exitCode := j.exec.ExecuteWithContext(ctx, journalctlCommand, opts.args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("journalctl entries: %w", errors.FromStderr(stderr, exitCode))
+	}
+
+	var entries []JournalEntry
+	for _, line := range strings.Split(stdout, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		entry, err := parseJournalEntry([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("parsing journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// parseJournalEntry parses an entry output by journalctl in JSON format. Fields holding binary
+// data are output as arrays of bytes, and fields set several times as arrays of values, of which
+// only the first one is kept.
+func parseJournalEntry(data []byte) (JournalEntry, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	entry := make(JournalEntry, len(fields))
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			entry[key] = v
+		case []any:
+			if s, ok := journalBytesToString(v); ok {
+				entry[key] = s
+			} else if len(v) > 0 {
+				if s, ok := v[0].(string); ok {
+					entry[key] = s
+				}
+			}
+		}
+	}
+	return entry, nil
+}
+
+func journalBytesToString(values []any) (string, bool) {
+	b := make([]byte, 0, len(values))
+	for _, value := range values {
+		n, ok := value.(float64)
+		if !ok || n < 0 || n > 255 {
+			return "", false
+		}
+		b = append(b, byte(n))
+	}
+	return string(b), true
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestJournalctlEntries(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	output := `{"__CURSOR":"s=1;i=1","__REALTIME_TIMESTAMP":"1700000000000000","PRIORITY":"6","_SYSTEMD_UNIT":"sshd.service","MESSAGE":"Accepted publickey"}
+{"__CURSOR":"s=1;i=2","__REALTIME_TIMESTAMP":"1700000001000000","PRIORITY":"3","CONTAINER_NAME":"web","MESSAGE":[104,105,27]}
+`
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockExec.EXPECT().
+		ExecuteWithContext(gomock.Any(), "/usr/bin/journalctl", "-o", "json", "--no-pager", "-p", "info", "--after-cursor", "s=1;i=0").
+		Return(output, "", 0)
+
+	journalctl := NewJournalctl(mockExec, v1beta1.RootUsername)
+	entries, err := journalctl.Entries(t.Context(), WithLogPriority("info"), WithLogAfterCursor("s=1;i=0"))
+	require.NoError(err)
+	require.Len(entries, 2)
+
+	require.Equal("s=1;i=1", entries[0].Cursor())
+	require.Equal(time.UnixMicro(1700000000000000), entries[0].Timestamp())
+	require.Equal("sshd.service", entries[0]["_SYSTEMD_UNIT"])
+	require.Equal("Accepted publickey", entries[0]["MESSAGE"])
+
+	// binary messages are output as arrays of bytes
+	require.Equal("hi\x1b", entries[1]["MESSAGE"])
+	require.Equal("web", entries[1]["CONTAINER_NAME"])
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -324,3 +325,103 @@ func (k *Kube) Kustomize(ctx context.Context, dir string) (stdout, stderr string
 	}
 	return k.exec.ExecuteWithContext(ctx, binary, "kustomize", dir)
 }
+
+// KubePodStats is the resource usage of a pod as reported by the kubelet.
+type KubePodStats struct {
+	Name      string
+	Namespace string
+	// CPUUsageNanoCores is the CPU usage of the pod in nanocores.
+	CPUUsageNanoCores uint64
+	// MemoryWorkingSetBytes is the working set memory of the pod in bytes.
+	MemoryWorkingSetBytes uint64
+	// VolumeUsedBytes is the space used by the persistent volumes of the pod in bytes.
+	VolumeUsedBytes uint64
+}
+
+// kubeletStatsSummary is the subset of the kubelet stats summary used to report pod usage.
+type kubeletStatsSummary struct {
+	Pods []struct {
+		PodRef struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"podRef"`
+		CPU *struct {
+			UsageNanoCores *uint64 `json:"usageNanoCores"`
+		} `json:"cpu"`
+		Memory *struct {
+			WorkingSetBytes *uint64 `json:"workingSetBytes"`
+		} `json:"memory"`
+		Volume []struct {
+			UsedBytes *uint64         `json:"usedBytes"`
+			PVCRef    json.RawMessage `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// PodStats returns the resource usage of the pods of all nodes of the cluster from the stats
+// summary of their kubelet.
+func (k *Kube) PodStats(ctx context.Context, opts ...KubeOption) ([]KubePodStats, error) {
+	binary := k.Binary()
+	if binary == "" {
+		return nil, fmt.Errorf("kubernetes CLI binary not available")
+	}
+
+	options := &kubeOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	withKubeconfig := func(args ...string) []string {
+		if options.kubeconfigPath != "" {
+			args = append(args, "--kubeconfig", options.kubeconfigPath)
+		}
+		return args
+	}
+
+	stdout, stderr, exitCode := k.exec.ExecuteWithContext(ctx, binary, withKubeconfig("get", "nodes", "-o", "jsonpath={.items[*].metadata.name}")...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("get nodes: %s", stderr)
+	}
+
+	var stats []KubePodStats
+	for _, node := range strings.Fields(stdout) {
+		stdout, stderr, exitCode := k.exec.ExecuteWithContext(ctx, binary, withKubeconfig("get", "--raw", fmt.Sprintf("/api/v1/nodes/%s/proxy/stats/summary", node))...)
+		if exitCode != 0 {
+			return nil, fmt.Errorf("get stats summary of node %s: %s", node, stderr)
+		}
+		nodeStats, err := parseKubeletStatsSummary([]byte(stdout))
+		if err != nil {
+			return nil, fmt.Errorf("parse stats summary of node %s: %w", node, err)
+		}
+		stats = append(stats, nodeStats...)
+	}
+	return stats, nil
+}
+
+func parseKubeletStatsSummary(data []byte) ([]KubePodStats, error) {
+	var summary kubeletStatsSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, err
+	}
+
+	stats := make([]KubePodStats, 0, len(summary.Pods))
+	for _, pod := range summary.Pods {
+		podStats := KubePodStats{
+			Name:      pod.PodRef.Name,
+			Namespace: pod.PodRef.Namespace,
+		}
+		if pod.CPU != nil && pod.CPU.UsageNanoCores != nil {
+			podStats.CPUUsageNanoCores = *pod.CPU.UsageNanoCores
+		}
+		if pod.Memory != nil && pod.Memory.WorkingSetBytes != nil {
+			podStats.MemoryWorkingSetBytes = *pod.Memory.WorkingSetBytes
+		}
+		for _, volume := range pod.Volume {
+			// only account for persistent volumes, not for configmaps, secrets and the like
+			if len(volume.PVCRef) > 0 && volume.UsedBytes != nil {
+				podStats.VolumeUsedBytes += *volume.UsedBytes
+			}
+		}
+		stats = append(stats, podStats)
+	}
+	return stats, nil
+}
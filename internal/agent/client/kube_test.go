@@ -336,3 +336,29 @@ func TestKube_ResolveKubeconfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKubeletStatsSummary(t *testing.T) {
+	require := require.New(t)
+
+	summary := `{
+  "node": {"nodeName": "node1"},
+  "pods": [
+    {
+      "podRef": {"name": "web-0", "namespace": "shop"},
+      "cpu": {"usageNanoCores": 250000000},
+      "memory": {"workingSetBytes": 1048576},
+      "volume": [
+        {"name": "data", "usedBytes": 4096, "pvcRef": {"name": "data-web-0", "namespace": "shop"}},
+        {"name": "kube-api-access", "usedBytes": 12}
+      ]
+    },
+    {"podRef": {"name": "starting", "namespace": "shop"}}
+  ]
+}`
+	stats, err := parseKubeletStatsSummary([]byte(summary))
+	require.NoError(err)
+	require.Equal([]KubePodStats{
+		{Name: "web-0", Namespace: "shop", CPUUsageNanoCores: 250000000, MemoryWorkingSetBytes: 1048576, VolumeUsedBytes: 4096},
+		{Name: "starting", Namespace: "shop"},
+	}, stats)
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	stderrs "errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -59,6 +61,21 @@ type PodmanContainerConfig struct {
 	Labels map[string]string `json:"Labels"`
 }
 
+// PodmanContainerStats represents the resource usage of a container as reported by podman stats.
+type PodmanContainerStats struct {
+	ContainerID string `json:"ContainerID"`
+	Name        string `json:"Name"`
+	// CPU is the CPU usage of the container as a percentage of one core.
+	CPU float64 `json:"CPU"`
+	// CPUNano is the cumulative CPU time of the container in nanoseconds.
+	CPUNano uint64 `json:"CPUNano"`
+	// SystemNano is the time of the sample in nanoseconds.
+	SystemNano uint64 `json:"SystemNano"`
+	MemUsage   uint64 `json:"MemUsage"`
+	// MemLimit is the memory limit of the container, or the memory of the host if it has none.
+	MemLimit uint64 `json:"MemLimit"`
+}
+
 // ArtifactInspect represents the structure of artifact inspect output
 type ArtifactInspect struct {
 	Manifest ArtifactManifest `json:"Manifest"`
@@ -576,6 +593,58 @@ func (p *Podman) RemoveContainer(ctx context.Context, labels []string) error {
 	return nil
 }
 
+// ExecContainer runs a command in a running container and returns its output.
+func (p *Podman) ExecContainer(ctx context.Context, container string, command ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := append([]string{"exec", container}, command...)
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return "", fmt.Errorf("exec in container %s: %w", container, errors.FromStderr(stderr, exitCode))
+	}
+	return strings.TrimSpace(stdout), nil
+}
+
+// RestartContainers restarts the given containers.
+func (p *Podman) RestartContainers(ctx context.Context, containers ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := append([]string{"restart"}, containers...)
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("restart containers: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
+// ContainerStats returns the current resource usage of the given containers.
+func (p *Podman) ContainerStats(ctx context.Context, containers ...string) ([]PodmanContainerStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := append([]string{"stats", "--no-stream", "--format", "{{json .ContainerStats}}"}, containers...)
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("container stats: %w", errors.FromStderr(stderr, exitCode))
+	}
+
+	var stats []PodmanContainerStats
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var containerStats PodmanContainerStats
+		if err := json.Unmarshal([]byte(line), &containerStats); err != nil {
+			return nil, fmt.Errorf("unmarshal container stats: %w", err)
+		}
+		stats = append(stats, containerStats)
+	}
+	return stats, nil
+}
+
 func (p *Podman) CreateVolume(ctx context.Context, name string, labels []string) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
@@ -680,6 +749,59 @@ func (p *Podman) RemoveVolumes(ctx context.Context, volumes ...string) error {
 	return nil
 }
 
+// CreateSecret creates the named secret with the given data, replacing any existing secret of the
+// same name. The data is passed on stdin so it never appears in the process arguments.
+func (p *Podman) CreateSecret(ctx context.Context, name string, data []byte, labels []string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"secret", "create", "--replace"}
+	for _, label := range labels {
+		args = append(args, "--label", label)
+	}
+	args = append(args, name, "-")
+
+	cmd := p.exec.CommandContext(ctx, podmanCmd, args...)
+	cmd.Stdin = bytes.NewReader(data)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		exitCode := 1
+		var exitErr *exec.ExitError
+		if stderrs.As(err, &exitErr) {
+			exitCode = exitErr.ExitCode()
+		}
+		return fmt.Errorf("create secret %s: %w", name, errors.FromStderr(stderr.String(), exitCode))
+	}
+	return nil
+}
+
+// InspectSecretData returns the data of the named secret.
+func (p *Podman) InspectSecretData(ctx context.Context, name string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"secret", "inspect", "--showsecret", "--format", "{{.SecretData}}", name}
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("inspect secret %s: %w", name, errors.FromStderr(stderr, exitCode))
+	}
+	return []byte(strings.TrimSpace(stdout)), nil
+}
+
+// RemoveSecret removes the named secret if it exists.
+func (p *Podman) RemoveSecret(ctx context.Context, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"secret", "rm", "--ignore", name}
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("remove secret %s: %w", name, errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
 func applyFilters(args, labels, filters []string) []string {
 	for _, label := range labels {
 		args = append(args, "--filter", fmt.Sprintf("label=%s", label))
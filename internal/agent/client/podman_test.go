@@ -2,6 +2,9 @@ package client
 
 import (
 	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
@@ -274,6 +277,35 @@ func TestPodman_RemoveImage(t *testing.T) {
 	}
 }
 
+func TestPodman_CreateSecret(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := log.NewPrefixLogger("test")
+	mockExec := executer.NewMockExecuter(ctrl)
+	readWriter := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+	podman := NewPodman(log, mockExec, readWriter, poll.Config{})
+
+	received := filepath.Join(t.TempDir(), "secret")
+	mockExec.EXPECT().CommandContext(gomock.Any(), "podman", []string{"secret", "create", "--replace", "--label", "app=web", "web-tls.key", "-"}).
+		DoAndReturn(func(ctx context.Context, command string, args ...string) *exec.Cmd {
+			return exec.CommandContext(ctx, "sh", "-c", "cat > "+received)
+		})
+	require.NoError(podman.CreateSecret(context.Background(), "web-tls.key", []byte("key-data"), []string{"app=web"}))
+
+	data, err := os.ReadFile(received)
+	require.NoError(err)
+	require.Equal("key-data", string(data))
+
+	mockExec.EXPECT().CommandContext(gomock.Any(), "podman", []string{"secret", "create", "--replace", "web-tls.key", "-"}).
+		DoAndReturn(func(ctx context.Context, command string, args ...string) *exec.Cmd {
+			return exec.CommandContext(ctx, "sh", "-c", "echo 'Error: invalid secret' >&2; exit 125")
+		})
+	err = podman.CreateSecret(context.Background(), "web-tls.key", []byte("key-data"), nil)
+	require.ErrorContains(err, "invalid secret")
+}
+
 func TestPodman_RemoveArtifact(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -367,3 +399,27 @@ func TestPodman_RemoveArtifact(t *testing.T) {
 		})
 	}
 }
+
+func TestPodman_ContainerStats(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	readWriter := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+	podman := NewPodman(log.NewPrefixLogger("test"), mockExec, readWriter, poll.Config{})
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"stats", "--no-stream", "--format", "{{json .ContainerStats}}", "web", "db"}).
+		Return(`{"ContainerID":"abc","Name":"web","CPU":12.5,"CPUNano":2000,"SystemNano":1000,"MemUsage":1048576,"MemLimit":4194304}
+{"ContainerID":"def","Name":"db","CPU":0,"CPUNano":0,"SystemNano":1000,"MemUsage":0,"MemLimit":4194304}
+`, "", 0)
+	stats, err := podman.ContainerStats(context.Background(), "web", "db")
+	require.NoError(err)
+	require.Len(stats, 2)
+	require.Equal(PodmanContainerStats{ContainerID: "abc", Name: "web", CPU: 12.5, CPUNano: 2000, SystemNano: 1000, MemUsage: 1048576, MemLimit: 4194304}, stats[0])
+	require.Equal("db", stats[1].Name)
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", gomock.Any()).Return("", "Error: no such container web", 125)
+	_, err = podman.ContainerStats(context.Background(), "web")
+	require.Error(err)
+}
@@ -103,6 +103,16 @@ func (s *Systemd) Restart(ctx context.Context, name string) error {
 	return nil
 }
 
+// ReloadOrRestart reloads the unit if it supports reloading, and restarts it otherwise.
+func (s *Systemd) ReloadOrRestart(ctx context.Context, name string) error {
+	command, args := s.createArgs("reload-or-restart", name)
+	_, stderr, exitCode := s.exec.ExecuteWithContext(ctx, command, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("reload-or-restart systemd unit: %s: %w", name, errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
 func (s *Systemd) Disable(ctx context.Context, name string) error {
 	command, args := s.createArgs("disable", name)
 	_, stderr, exitCode := s.exec.ExecuteWithContext(ctx, command, args...)
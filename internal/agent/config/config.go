@@ -3,12 +3,14 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -33,6 +35,8 @@ const (
 	DefaultSystemInfoTimeout = util.Duration(2 * time.Minute)
 	// MaxSystemInfoTimeout is the maximum timeout for collecting system info
 	MaxSystemInfoTimeout = util.Duration(2 * time.Minute)
+	// MinSystemInfoCollectorInterval is the minimum interval between two runs of a structured system info collector
+	MinSystemInfoCollectorInterval = util.Duration(time.Minute)
 	// DefaultPullRetrySteps is the default retry attempts are allowed for pulling an OCI target.
 	DefaultPullRetrySteps = 6
 	// DefaultPullTimeout is the default timeout for pulling a single OCI
@@ -74,6 +78,9 @@ const (
 	DefaultMetricsEnabled = false
 	// DefaultProfilingEnabled controls whether runtime profiling (pprof) is enabled by default.
 	DefaultProfilingEnabled = false
+	// DefaultTelemetryMaxBufferSize is the default maximum disk space used to buffer telemetry while
+	// the telemetry gateway is unreachable.
+	DefaultTelemetryMaxBufferSize = 64 * 1024 * 1024
 )
 
 type Config struct {
@@ -131,6 +138,10 @@ type Config struct {
 	// SystemInfoTimeout is the timeout for collecting system info.
 	SystemInfoTimeout util.Duration `json:"system-info-timeout,omitempty"`
 
+	// SystemInfoCollectors lists the collectors of structured system information, each reported as
+	// a JSON object in device.status.systemInfo.extensions under the name of the collector.
+	SystemInfoCollectors []SystemInfoCollector `json:"system-info-collectors,omitempty"`
+
 	// PullTimeout is the max duration a single OCI target will try to pull.
 	PullTimeout util.Duration `json:"pull-timeout,omitempty"`
 
@@ -146,6 +157,13 @@ type Config struct {
 	// ImagePruning holds all image/artifact pruning-related configuration
 	ImagePruning ImagePruning `json:"image-pruning,omitempty"`
 
+	// ApplicationHostAccess lists the host resources the containers of applications may be given
+	// access to. Applications requesting anything else are rejected.
+	ApplicationHostAccess ApplicationHostAccess `json:"application-host-access,omitempty"`
+
+	// Telemetry holds the configuration of the forwarding of metrics and logs to the telemetry gateway.
+	Telemetry Telemetry `json:"telemetry,omitempty"`
+
 	readWriter fileio.ReadWriter
 }
 
@@ -160,12 +178,46 @@ type TPM struct {
 	StorageFilePath string `json:"storage-file-path,omitempty"`
 }
 
+type SystemInfoCollector struct {
+	// Name is the key of the collected information in device.status.systemInfo.extensions. Without a
+	// command, it selects a built-in collector: tpm, secureBoot, serialNumbers, firmware, modems or usb.
+	Name string `json:"name"`
+	// Command is the absolute path of an executable printing a JSON object to collect.
+	Command string `json:"command,omitempty"`
+	// Interval is the interval between two collections. If unset, the information is collected
+	// when the agent starts and whenever the rest of the system info is collected.
+	Interval util.Duration `json:"interval,omitempty"`
+	// Timeout is the timeout of a collection. Defaults to system-info-timeout.
+	Timeout util.Duration `json:"timeout,omitempty"`
+}
+
 type ImagePruning struct {
 	// Enabled controls whether automatic pruning is enabled.
 	// Default: false
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type ApplicationHostAccess struct {
+	// Devices lists glob patterns of the host devices that may be passed to containers,
+	// e.g. /dev/ttyUSB*.
+	Devices []string `json:"devices,omitempty"`
+	// Capabilities lists the Linux capabilities that may be added to containers, e.g. NET_ADMIN.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// Networks lists the podman networks containers may attach to. "host" allows the network of the host.
+	Networks []string `json:"networks,omitempty"`
+	// HostIPC allows containers to use the IPC namespace of the host.
+	HostIPC bool `json:"host-ipc,omitempty"`
+}
+
+type Telemetry struct {
+	// Endpoint is the address of the telemetry gateway, e.g. telemetry.example.com:4317. Metrics and
+	// logs are only forwarded if it is set and the device spec enables them.
+	Endpoint string `json:"endpoint,omitempty"`
+	// MaxBufferSize is the maximum disk space in bytes used to buffer telemetry while the telemetry
+	// gateway is unreachable. The oldest telemetry is dropped once it is exceeded.
+	MaxBufferSize int64 `json:"max-buffer-size,omitempty"`
+}
+
 // DefaultSystemInfo defines the list of system information keys that are included
 // in the default system info status report generated by the agent.
 var DefaultSystemInfo = append([]string{
@@ -181,6 +233,17 @@ var DefaultSystemInfo = append([]string{
 	systeminfocommon.NetMACDefaultKey,
 }, systeminfocommon.RuntimeKeys()...)
 
+// DefaultSystemInfoCollectors defines the structured system information collectors run by default.
+// Hot-pluggable hardware is collected periodically.
+var DefaultSystemInfoCollectors = []SystemInfoCollector{
+	{Name: systeminfocommon.TPMCollector},
+	{Name: systeminfocommon.SecureBootCollector},
+	{Name: systeminfocommon.SerialNumbersCollector},
+	{Name: systeminfocommon.FirmwareCollector},
+	{Name: systeminfocommon.ModemsCollector, Interval: util.Duration(10 * time.Minute)},
+	{Name: systeminfocommon.USBCollector, Interval: util.Duration(10 * time.Minute)},
+}
+
 func NewDefault() *Config {
 	c := &Config{
 		ConfigDir:            DefaultConfigDir,
@@ -193,6 +256,7 @@ func NewDefault() *Config {
 		ServiceConfig:        config.NewServiceConfig(),
 		SystemInfo:           DefaultSystemInfo,
 		SystemInfoTimeout:    DefaultSystemInfoTimeout,
+		SystemInfoCollectors: DefaultSystemInfoCollectors,
 		PullTimeout:          DefaultPullTimeout,
 		PullRetrySteps:       DefaultPullRetrySteps,
 		MetricsEnabled:       DefaultMetricsEnabled,
@@ -207,6 +271,9 @@ func NewDefault() *Config {
 		ImagePruning: ImagePruning{
 			Enabled: lo.ToPtr(false),
 		},
+		Telemetry: Telemetry{
+			MaxBufferSize: DefaultTelemetryMaxBufferSize,
+		},
 	}
 
 	if value := os.Getenv(TestRootDirEnvKey); value != "" {
@@ -303,6 +370,25 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("system-info-timeout cannot exceed %s, got %s", MaxSystemInfoTimeout, cfg.SystemInfoTimeout)
 	}
 
+	if err := validateSystemInfoCollectors(cfg.SystemInfoCollectors); err != nil {
+		return err
+	}
+
+	for _, pattern := range cfg.ApplicationHostAccess.Devices {
+		if _, err := filepath.Match(pattern, ""); err != nil || !strings.HasPrefix(pattern, "/dev/") {
+			return fmt.Errorf("application-host-access.devices: invalid device pattern %q", pattern)
+		}
+	}
+
+	if cfg.Telemetry.Endpoint != "" {
+		if _, _, err := net.SplitHostPort(cfg.Telemetry.Endpoint); err != nil {
+			return fmt.Errorf("telemetry.endpoint: must be in format 'host:port', got %q", cfg.Telemetry.Endpoint)
+		}
+	}
+	if cfg.Telemetry.MaxBufferSize < 0 {
+		return fmt.Errorf("telemetry.max-buffer-size cannot be negative, got %d", cfg.Telemetry.MaxBufferSize)
+	}
+
 	if cfg.TPM.AuthEnabled && !cfg.TPM.Enabled {
 		return fmt.Errorf("cannot enable TPM password authentication when TPM device identity is disabled")
 	}
@@ -450,6 +536,7 @@ func mergeConfigs(base, override *Config) {
 	overrideSliceIfNotNil(&base.SystemInfo, override.SystemInfo)
 	overrideSliceIfNotNil(&base.SystemInfoCustom, override.SystemInfoCustom)
 	overrideIfNotEmpty(&base.SystemInfoTimeout, override.SystemInfoTimeout)
+	overrideSliceIfNotNil(&base.SystemInfoCollectors, override.SystemInfoCollectors)
 
 	// tpm
 	overrideIfNotEmpty(&base.TPM.Enabled, override.TPM.Enabled)
@@ -471,6 +558,16 @@ func mergeConfigs(base, override *Config) {
 	// but a dropin with image-pruning.enabled: false will override to false.
 	overrideIfNotEmpty(&base.ImagePruning.Enabled, override.ImagePruning.Enabled)
 
+	// application host access
+	overrideSliceIfNotNil(&base.ApplicationHostAccess.Devices, override.ApplicationHostAccess.Devices)
+	overrideSliceIfNotNil(&base.ApplicationHostAccess.Capabilities, override.ApplicationHostAccess.Capabilities)
+	overrideSliceIfNotNil(&base.ApplicationHostAccess.Networks, override.ApplicationHostAccess.Networks)
+	overrideIfNotEmpty(&base.ApplicationHostAccess.HostIPC, override.ApplicationHostAccess.HostIPC)
+
+	// telemetry
+	overrideIfNotEmpty(&base.Telemetry.Endpoint, override.Telemetry.Endpoint)
+	overrideIfNotEmpty(&base.Telemetry.MaxBufferSize, override.Telemetry.MaxBufferSize)
+
 	for k, v := range override.DefaultLabels {
 		base.DefaultLabels[k] = v
 	}
@@ -500,3 +597,32 @@ func overrideSliceIfNotNil[T any](dst *[]T, src []T) {
 		*dst = src
 	}
 }
+
+var systemInfoCollectorNamePattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+func validateSystemInfoCollectors(collectors []SystemInfoCollector) error {
+	names := make(map[string]struct{}, len(collectors))
+	for _, c := range collectors {
+		if !systemInfoCollectorNamePattern.MatchString(c.Name) {
+			return fmt.Errorf("system-info-collectors: name %q must be camelCase with no spaces or special characters", c.Name)
+		}
+		if _, ok := names[c.Name]; ok {
+			return fmt.Errorf("system-info-collectors: duplicate name %q", c.Name)
+		}
+		names[c.Name] = struct{}{}
+
+		if c.Command == "" && !systeminfocommon.IsBuiltInCollector(c.Name) {
+			return fmt.Errorf("system-info-collectors: %q is not a built-in collector and has no command", c.Name)
+		}
+		if c.Command != "" && !filepath.IsAbs(c.Command) {
+			return fmt.Errorf("system-info-collectors: command of %q must be an absolute path, got %q", c.Name, c.Command)
+		}
+		if c.Interval != 0 && c.Interval < MinSystemInfoCollectorInterval {
+			return fmt.Errorf("system-info-collectors: interval of %q must be at least %s, got %s", c.Name, MinSystemInfoCollectorInterval, c.Interval)
+		}
+		if c.Timeout < 0 || c.Timeout > MaxSystemInfoTimeout {
+			return fmt.Errorf("system-info-collectors: timeout of %q must be between 0 and %s, got %s", c.Name, MaxSystemInfoTimeout, c.Timeout)
+		}
+	}
+	return nil
+}
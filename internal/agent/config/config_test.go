@@ -4,8 +4,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
@@ -271,3 +273,159 @@ image-pruning:
 	require.NotNil(cfg.ImagePruning.Enabled, "Enabled should not be nil when dropin enables pruning")
 	require.True(*cfg.ImagePruning.Enabled, "pruning dropin should override config setting")
 }
+
+func TestLoadApplicationHostAccessFromConfD(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "etc", "flightctl")
+	dataDir := filepath.Join(tmpDir, "var", "lib", "flightctl")
+	require.NoError(os.MkdirAll(configDir, 0o755))
+	require.NoError(os.MkdirAll(dataDir, 0o755))
+
+	cfg := NewDefault()
+	cfg.ConfigDir = configDir
+	cfg.DataDir = dataDir
+	cfg.readWriter = fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+
+	configFile := filepath.Join(configDir, "config.yaml")
+	content := `enrollment-service:
+  service:
+    server: https://enrollment.endpoint
+    certificate-authority-data: abcd
+  authentication:
+    client-certificate-data: efgh
+    client-key-data: ijkl
+status-update-interval: 0m10s
+application-host-access:
+  devices:
+  - /dev/ttyUSB*
+  capabilities:
+  - NET_ADMIN
+`
+	require.NoError(os.WriteFile(configFile, []byte(content), 0o600))
+
+	// the dropin replaces the networks and keeps the devices and capabilities of the config
+	dropinDir := filepath.Join(configDir, "conf.d")
+	require.NoError(os.MkdirAll(dropinDir, 0o755))
+	dropin := "application-host-access:\n  networks:\n  - host\n  - sensors\n  host-ipc: true\n"
+	require.NoError(os.WriteFile(filepath.Join(dropinDir, "host-access.yaml"), []byte(dropin), 0o600))
+
+	require.NoError(cfg.LoadWithOverrides(configFile))
+	require.Equal(ApplicationHostAccess{
+		Devices:      []string{"/dev/ttyUSB*"},
+		Capabilities: []string{"NET_ADMIN"},
+		Networks:     []string{"host", "sensors"},
+		HostIPC:      true,
+	}, cfg.ApplicationHostAccess)
+
+	// device patterns must be valid globs under /dev/
+	invalid := "application-host-access:\n  devices:\n  - /etc/*\n"
+	require.NoError(os.WriteFile(filepath.Join(dropinDir, "invalid.yaml"), []byte(invalid), 0o600))
+	err := cfg.LoadWithOverrides(configFile)
+	require.ErrorContains(err, `invalid device pattern "/etc/*"`)
+}
+
+func TestLoadTelemetryFromConfD(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "etc", "flightctl")
+	dataDir := filepath.Join(tmpDir, "var", "lib", "flightctl")
+	require.NoError(os.MkdirAll(configDir, 0o755))
+	require.NoError(os.MkdirAll(dataDir, 0o755))
+
+	cfg := NewDefault()
+	cfg.ConfigDir = configDir
+	cfg.DataDir = dataDir
+	cfg.readWriter = fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+
+	configFile := filepath.Join(configDir, "config.yaml")
+	content := `enrollment-service:
+  service:
+    server: https://enrollment.endpoint
+    certificate-authority-data: abcd
+  authentication:
+    client-certificate-data: efgh
+    client-key-data: ijkl
+status-update-interval: 0m10s
+`
+	require.NoError(os.WriteFile(configFile, []byte(content), 0o600))
+
+	require.NoError(cfg.LoadWithOverrides(configFile))
+	require.Equal(Telemetry{MaxBufferSize: DefaultTelemetryMaxBufferSize}, cfg.Telemetry)
+
+	dropinDir := filepath.Join(configDir, "conf.d")
+	require.NoError(os.MkdirAll(dropinDir, 0o755))
+	dropin := "telemetry:\n  endpoint: telemetry.example.com:4317\n  max-buffer-size: 1048576\n"
+	require.NoError(os.WriteFile(filepath.Join(dropinDir, "telemetry.yaml"), []byte(dropin), 0o600))
+
+	require.NoError(cfg.LoadWithOverrides(configFile))
+	require.Equal(Telemetry{
+		Endpoint:      "telemetry.example.com:4317",
+		MaxBufferSize: 1048576,
+	}, cfg.Telemetry)
+
+	// the endpoint must include a port
+	invalid := "telemetry:\n  endpoint: telemetry.example.com\n"
+	require.NoError(os.WriteFile(filepath.Join(dropinDir, "zz-invalid.yaml"), []byte(invalid), 0o600))
+	err := cfg.LoadWithOverrides(configFile)
+	require.ErrorContains(err, "telemetry.endpoint")
+}
+
+func TestValidateSystemInfoCollectors(t *testing.T) {
+	tests := []struct {
+		name       string
+		collectors []SystemInfoCollector
+		wantErr    string
+	}{
+		{
+			name:       "defaults",
+			collectors: DefaultSystemInfoCollectors,
+		},
+		{
+			name: "custom collector",
+			collectors: []SystemInfoCollector{
+				{Name: "inventory", Command: "/usr/libexec/inventory", Interval: util.Duration(time.Hour), Timeout: util.Duration(time.Minute)},
+			},
+		},
+		{
+			name:       "invalid name",
+			collectors: []SystemInfoCollector{{Name: "asset-tag", Command: "/usr/libexec/asset"}},
+			wantErr:    "camelCase",
+		},
+		{
+			name:       "duplicate name",
+			collectors: []SystemInfoCollector{{Name: "usb"}, {Name: "usb", Command: "/usr/libexec/usb"}},
+			wantErr:    "duplicate name",
+		},
+		{
+			name:       "unknown built-in",
+			collectors: []SystemInfoCollector{{Name: "inventory"}},
+			wantErr:    "not a built-in collector",
+		},
+		{
+			name:       "relative command",
+			collectors: []SystemInfoCollector{{Name: "inventory", Command: "inventory.sh"}},
+			wantErr:    "absolute path",
+		},
+		{
+			name:       "interval too short",
+			collectors: []SystemInfoCollector{{Name: "usb", Interval: util.Duration(time.Second)}},
+			wantErr:    "interval",
+		},
+		{
+			name:       "timeout too long",
+			collectors: []SystemInfoCollector{{Name: "usb", Timeout: util.Duration(time.Hour)}},
+			wantErr:    "timeout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSystemInfoCollectors(tt.collectors)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/shutdown"
+	"github.com/samber/lo"
 )
 
 const (
@@ -121,6 +122,12 @@ type Application interface {
 	Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error)
 	// ActionSpec returns the type-specific action configuration for this application.
 	ActionSpec() lifecycle.ActionSpec
+	// HealthProbes returns the readiness and liveness probes of the application.
+	HealthProbes() v1beta1.ApplicationHealthProbes
+	// ResourceMonitors returns the monitors of the resource usage of the application.
+	ResourceMonitors() []v1beta1.ResourceMonitor
+	// DependsOn returns the names of the applications that must be ready before the application is started.
+	DependsOn() []string
 }
 
 // Workload represents an application workload tracked by a Monitor.
@@ -130,6 +137,8 @@ type Workload struct {
 	Name     string
 	Status   StatusType
 	Restarts int
+	// Unit is the systemd unit running the workload, if any.
+	Unit string
 }
 
 type application struct {
@@ -139,6 +148,9 @@ type application struct {
 	volume     provider.VolumeManager
 	status     *v1beta1.DeviceApplicationStatus
 	actionSpec lifecycle.ActionSpec
+	probes     v1beta1.ApplicationHealthProbes
+	monitors   []v1beta1.ResourceMonitor
+	dependsOn  []string
 }
 
 // NewApplication creates a new application from an application provider.
@@ -154,10 +166,57 @@ func NewApplication(p provider.Provider) *application {
 			AppType:  spec.AppType,
 			RunAs:    spec.User,
 		},
-		volume: spec.Volume,
+		volume:    spec.Volume,
+		probes:    healthProbesFromSpec(spec),
+		monitors:  resourceMonitorsFromSpec(spec),
+		dependsOn: dependsOnFromSpec(spec),
 	}
 }
 
+// healthProbesFromSpec returns the health probes of the podman application types.
+func healthProbesFromSpec(spec *provider.ApplicationSpec) v1beta1.ApplicationHealthProbes {
+	switch {
+	case spec.ContainerApp != nil:
+		return v1beta1.ApplicationHealthProbes{ReadinessProbe: spec.ContainerApp.ReadinessProbe, LivenessProbe: spec.ContainerApp.LivenessProbe}
+	case spec.ComposeApp != nil:
+		return v1beta1.ApplicationHealthProbes{ReadinessProbe: spec.ComposeApp.ReadinessProbe, LivenessProbe: spec.ComposeApp.LivenessProbe}
+	case spec.QuadletApp != nil:
+		return v1beta1.ApplicationHealthProbes{ReadinessProbe: spec.QuadletApp.ReadinessProbe, LivenessProbe: spec.QuadletApp.LivenessProbe}
+	default:
+		return v1beta1.ApplicationHealthProbes{}
+	}
+}
+
+// resourceMonitorsFromSpec returns the resource monitors of the application.
+func resourceMonitorsFromSpec(spec *provider.ApplicationSpec) []v1beta1.ResourceMonitor {
+	var monitors *[]v1beta1.ResourceMonitor
+	switch {
+	case spec.ContainerApp != nil:
+		monitors = spec.ContainerApp.ResourceMonitors
+	case spec.ComposeApp != nil:
+		monitors = spec.ComposeApp.ResourceMonitors
+	case spec.QuadletApp != nil:
+		monitors = spec.QuadletApp.ResourceMonitors
+	case spec.HelmApp != nil:
+		monitors = spec.HelmApp.ResourceMonitors
+	}
+	return lo.FromPtr(monitors)
+}
+
+// dependsOnFromSpec returns the names of the applications the podman application types depend on.
+func dependsOnFromSpec(spec *provider.ApplicationSpec) []string {
+	var dependsOn *[]string
+	switch {
+	case spec.ContainerApp != nil:
+		dependsOn = spec.ContainerApp.DependsOn
+	case spec.ComposeApp != nil:
+		dependsOn = spec.ComposeApp.DependsOn
+	case spec.QuadletApp != nil:
+		dependsOn = spec.QuadletApp.DependsOn
+	}
+	return lo.FromPtr(dependsOn)
+}
+
 // NewHelmApplication creates a new application with Helm-specific configuration.
 func NewHelmApplication(p provider.Provider) *application {
 	spec := p.Spec()
@@ -244,6 +303,18 @@ func (a *application) ActionSpec() lifecycle.ActionSpec {
 	return a.actionSpec
 }
 
+func (a *application) HealthProbes() v1beta1.ApplicationHealthProbes {
+	return a.probes
+}
+
+func (a *application) ResourceMonitors() []v1beta1.ResourceMonitor {
+	return a.monitors
+}
+
+func (a *application) DependsOn() []string {
+	return a.dependsOn
+}
+
 func (a *application) Path() string {
 	return a.path
 }
@@ -0,0 +1,38 @@
+package applications
+
+import (
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+)
+
+// dependencyCheckInterval is how often the agent checks whether the dependencies of the applications
+// waiting to be started are ready.
+const dependencyCheckInterval = 2 * time.Second
+
+// isApplicationReady returns true if an application is running and its readiness probe, if any,
+// succeeds, or if it has completed.
+func isApplicationReady(status *v1beta1.DeviceApplicationStatus) bool {
+	switch status.Status {
+	case v1beta1.ApplicationStatusCompleted:
+		return true
+	case v1beta1.ApplicationStatusRunning:
+		return status.Probes == nil || status.Probes.Readiness == nil ||
+			status.Probes.Readiness.Result == v1beta1.ApplicationProbeResultSuccess
+	default:
+		return false
+	}
+}
+
+// applyDependencyResults reports an application that waits for its dependencies to be ready before
+// it is started. Applications that were never started are reported as preparing.
+func applyDependencyResults(status *v1beta1.DeviceApplicationStatus, waitingFor []string) {
+	if len(waitingFor) > 0 {
+		status.WaitingFor = lo.ToPtr(slices.Clone(waitingFor))
+	}
+	if status.Status == v1beta1.ApplicationStatusUnknown {
+		status.Status = v1beta1.ApplicationStatusPreparing
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"runtime"
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -12,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/applications/helm"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/pkg/log"
 )
 
@@ -114,6 +116,9 @@ func (m *KubernetesMonitor) stopMonitor() error {
 
 // Stop stops the Kubernetes monitor.
 func (m *KubernetesMonitor) Stop() error {
+	m.mu.Lock()
+	m.usage.stopAll()
+	m.mu.Unlock()
 	return m.stopMonitor()
 }
 
@@ -130,10 +135,22 @@ func (m *KubernetesMonitor) Drain(ctx context.Context) error {
 
 // ExecuteActions executes all queued actions.
 func (m *KubernetesMonitor) ExecuteActions(ctx context.Context) error {
-	if _, err := m.executeActions(ctx, nil); err != nil {
+	actions, err := m.executeActions(ctx, nil)
+	if err != nil {
 		return fmt.Errorf("execute kubernetes actions: %w", err)
 	}
 
+	m.mu.Lock()
+	for _, action := range actions {
+		switch action.Type {
+		case lifecycle.ActionAdd, lifecycle.ActionUpdate:
+			m.startUsageMonitor(ctx, action.ID)
+		case lifecycle.ActionRemove:
+			m.usage.stop(action.ID)
+		}
+	}
+	m.mu.Unlock()
+
 	if m.hasApps() {
 		if err := m.startMonitor(ctx); err != nil {
 			return fmt.Errorf("failed to start kubernetes monitor: %w", err)
@@ -147,6 +164,44 @@ func (m *KubernetesMonitor) ExecuteActions(ctx context.Context) error {
 	return nil
 }
 
+// startUsageMonitor starts sampling the resource usage of the pods of an application from the
+// kubelet, replacing any monitor already running for it. The caller must hold the lock.
+func (m *KubernetesMonitor) startUsageMonitor(ctx context.Context, appID string) {
+	app, ok := m.apps[appID]
+	if !ok {
+		return
+	}
+
+	var namespace string
+	if helmSpec, ok := app.ActionSpec().(lifecycle.HelmSpec); ok {
+		namespace = helmSpec.Namespace
+	}
+	sampler := &kubernetesUsageSampler{
+		podStats: func(ctx context.Context) ([]client.KubePodStats, error) {
+			kubeconfigPath, err := m.clients.Kube().ResolveKubeconfig()
+			if err != nil {
+				return nil, fmt.Errorf("resolving kubeconfig: %w", err)
+			}
+			return m.clients.Kube().PodStats(ctx, client.WithKubeKubeconfig(kubeconfigPath))
+		},
+		workloads: func() []Workload {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			return app.Workloads()
+		},
+		namespace:    namespace,
+		deviceMemory: resource.TotalMemoryBytes,
+		numCPU:       runtime.NumCPU(),
+	}
+	monitor, err := newUsageMonitor(m.log, app.Name(), app.ResourceMonitors(), sampler.sample)
+	if err != nil {
+		m.log.Errorf("Failed to monitor resource usage of application %s: %v", app.Name(), err)
+		m.usage.stop(appID)
+		return
+	}
+	m.usage.start(ctx, appID, monitor)
+}
+
 type kubernetesWatchEvent struct {
 	Type   string        `json:"type"`
 	Object kubernetesPod `json:"object"`
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"hash/crc32"
 	"iter"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/samber/lo"
 )
 
 type ActionType string
@@ -58,6 +60,8 @@ type Action struct {
 	Volumes []Volume
 	// Spec holds type-specific configuration, discriminated by AppType.
 	Spec ActionSpec
+	// DependsOn is a list of names of the applications this application depends on
+	DependsOn []string
 }
 
 // HelmSpec contains Helm-specific action configuration.
@@ -107,6 +111,44 @@ func (as Actions) ByUser() iter.Seq2[v1beta1.Username, ActionsByType] {
 	}
 }
 
+// SortByDependencies orders actions according to the dependencies between their applications.
+// Removals come first, each before the removals of the applications its application depends on,
+// followed by additions and updates, each after those of the applications its application depends
+// on. Actions are otherwise kept in order.
+func SortByDependencies(actions Actions) Actions {
+	removes, others := lo.FilterReject(actions, func(a Action, _ int) bool { return a.Type == ActionRemove })
+	removes = orderByDependencies(removes)
+	slices.Reverse(removes)
+	return slices.Concat(removes, orderByDependencies(others))
+}
+
+// orderByDependencies orders actions so that each comes after the actions of the applications its
+// application depends on. Actions involved in a dependency cycle keep their order.
+func orderByDependencies(actions []Action) []Action {
+	pending := make(map[string]struct{}, len(actions))
+	for _, a := range actions {
+		pending[a.Name] = struct{}{}
+	}
+
+	ordered := make([]Action, 0, len(actions))
+	remaining := slices.Clone(actions)
+	for len(remaining) > 0 {
+		next := slices.IndexFunc(remaining, func(a Action) bool {
+			return !lo.SomeBy(a.DependsOn, func(name string) bool {
+				_, ok := pending[name]
+				return ok
+			})
+		})
+		if next < 0 {
+			next = 0
+		}
+		ordered = append(ordered, remaining[next])
+		delete(pending, remaining[next].Name)
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+	return ordered
+}
+
 type Volume struct {
 	ID            string
 	Reference     string
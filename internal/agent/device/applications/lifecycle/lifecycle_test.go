@@ -0,0 +1,71 @@
+package lifecycle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		actions  Actions
+		expected []string
+	}{
+		{
+			name: "no dependencies keep their order",
+			actions: Actions{
+				{Name: "b", Type: ActionAdd},
+				{Name: "a", Type: ActionUpdate},
+				{Name: "c", Type: ActionAdd},
+			},
+			expected: []string{"b", "a", "c"},
+		},
+		{
+			name: "additions after their dependencies",
+			actions: Actions{
+				{Name: "ingest", Type: ActionAdd, DependsOn: []string{"broker", "volumes"}},
+				{Name: "broker", Type: ActionAdd, DependsOn: []string{"volumes"}},
+				{Name: "volumes", Type: ActionUpdate},
+			},
+			expected: []string{"volumes", "broker", "ingest"},
+		},
+		{
+			name: "removals first and before their dependencies",
+			actions: Actions{
+				{Name: "broker", Type: ActionRemove},
+				{Name: "ingest", Type: ActionRemove, DependsOn: []string{"broker"}},
+				{Name: "web", Type: ActionAdd, DependsOn: []string{"api"}},
+				{Name: "api", Type: ActionAdd},
+			},
+			expected: []string{"ingest", "broker", "api", "web"},
+		},
+		{
+			name: "dependencies outside of the actions are ignored",
+			actions: Actions{
+				{Name: "ingest", Type: ActionAdd, DependsOn: []string{"broker"}},
+				{Name: "web", Type: ActionAdd},
+			},
+			expected: []string{"ingest", "web"},
+		},
+		{
+			name: "cycles keep their order",
+			actions: Actions{
+				{Name: "a", Type: ActionAdd, DependsOn: []string{"b"}},
+				{Name: "b", Type: ActionAdd, DependsOn: []string{"a"}},
+			},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := SortByDependencies(tt.actions)
+			names := make([]string, 0, len(sorted))
+			for _, a := range sorted {
+				names = append(names, a.Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
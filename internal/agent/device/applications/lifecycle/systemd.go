@@ -0,0 +1,199 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/internal/quadlet"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	// SystemdAppPath is the managed prefix the contents of systemd applications are installed to.
+	SystemdAppPath = "/var/lib/flightctl/apps/systemd"
+	// SystemdUnitPath is the directory the unit files of systemd applications are installed to.
+	SystemdUnitPath   = "/etc/systemd/system"
+	SystemdTargetName = "flightctl-systemd-app.target"
+)
+
+var _ ActionHandler = (*Systemd)(nil)
+
+// Systemd handles the lifecycle of applications made of native binaries run directly by systemd.
+// Each application is started and stopped through a target wanting all of its units.
+type Systemd struct {
+	systemdFactory systemd.ManagerFactory
+	log            *log.PrefixLogger
+}
+
+func NewSystemd(log *log.PrefixLogger, systemdFactory systemd.ManagerFactory) *Systemd {
+	return &Systemd{
+		systemdFactory: systemdFactory,
+		log:            log,
+	}
+}
+
+// SystemdTarget returns the name of the target of the systemd application with the given ID.
+func SystemdTarget(appID string) string {
+	return quadlet.NamespaceResource(appID, SystemdTargetName)
+}
+
+func (s *Systemd) loadedUnits(ctx context.Context, systemctl systemd.Manager, units []string) ([]string, error) {
+	entries, err := systemctl.ListUnitsByMatchPattern(ctx, units)
+	if err != nil {
+		return nil, fmt.Errorf("listing loaded units: %w", err)
+	}
+
+	unitSet := make(map[string]struct{}, len(entries))
+	for _, u := range entries {
+		if u.LoadState == string(v1beta1.SystemdLoadStateLoaded) {
+			unitSet[u.Unit] = struct{}{}
+		}
+	}
+	loaded := make([]string, 0, len(unitSet))
+	for _, unit := range units {
+		if _, ok := unitSet[unit]; ok {
+			loaded = append(loaded, unit)
+		}
+	}
+	return loaded, nil
+}
+
+func (s *Systemd) add(ctx context.Context, action Action, systemctl systemd.Manager) error {
+	appName := action.Name
+	s.log.Debugf("Starting systemd application: %s path: %s", appName, action.Path)
+	if action.ID == "" {
+		return fmt.Errorf("target name: empty appID")
+	}
+	target := SystemdTarget(action.ID)
+	startTime := time.Now()
+
+	units, err := systemctl.ListDependencies(ctx, target)
+	if err != nil {
+		return fmt.Errorf("listing dependencies: %w", err)
+	}
+
+	loaded, err := s.loadedUnits(ctx, systemctl, units)
+	if err != nil {
+		return err
+	}
+	for _, unit := range units {
+		if !slices.Contains(loaded, unit) {
+			return fmt.Errorf("%w: %s not loaded", errors.ErrNoRetry, unit)
+		}
+	}
+
+	s.log.Debugf("Starting systemd application: %s target: %s", appName, target)
+	if err := systemctl.Start(ctx, target); err != nil {
+		err = fmt.Errorf("starting target %s: %w", target, err)
+		for _, unit := range units {
+			unitLogs, logsErr := systemctl.Logs(ctx, client.WithLogUnit(unit), client.WithLogSince(startTime))
+			if logsErr != nil {
+				err = fmt.Errorf("gathering unit %q logs: %w: %w", unit, logsErr, err)
+				continue
+			}
+			if len(unitLogs) > 0 {
+				s.log.Infof("Unit: %q logs: %s", unit, strings.Join(unitLogs, "\n"))
+				err = fmt.Errorf("unit %w logs: %s: %w", errors.WithElement(unit), strings.Join(unitLogs, ","), err)
+			}
+		}
+		if stopErr := s.stop(ctx, action, systemctl, units); stopErr != nil {
+			s.log.Errorf("Failed to stop systemd application %s after failing to start it: %v", appName, stopErr)
+		}
+		return err
+	}
+
+	systemctl.AddExclusions(append(units, target)...)
+	s.log.Infof("Started systemd application: %s", appName)
+	return nil
+}
+
+func (s *Systemd) remove(ctx context.Context, action Action, systemctl systemd.Manager) error {
+	if action.ID == "" {
+		return fmt.Errorf("target name: empty appID")
+	}
+	target := SystemdTarget(action.ID)
+
+	units, err := systemctl.ListDependencies(ctx, target)
+	if err != nil {
+		return fmt.Errorf("listing dependencies: %w", err)
+	}
+
+	// the target was never created or has already been removed
+	if len(units) == 0 {
+		s.log.Debugf("Skipping stop for %s: target has no dependencies", action.Name)
+		return nil
+	}
+
+	if err := s.stop(ctx, action, systemctl, units); err != nil {
+		return err
+	}
+	systemctl.RemoveExclusions(append(units, target)...)
+	s.log.Infof("Removed systemd application: %s", action.Name)
+	return nil
+}
+
+// stop stops the target of the application and waits for all of its loaded units to stop.
+func (s *Systemd) stop(ctx context.Context, action Action, systemctl systemd.Manager, units []string) error {
+	target := SystemdTarget(action.ID)
+	s.log.Debugf("Stopping systemd application: %s target: %s", action.Name, target)
+	if err := systemctl.Stop(ctx, target); err != nil {
+		return fmt.Errorf("stopping target %s: %w", target, err)
+	}
+
+	loaded, err := s.loadedUnits(ctx, systemctl, units)
+	if err != nil {
+		return err
+	}
+	if len(loaded) == 0 {
+		return nil
+	}
+
+	s.log.Debugf("Stopping systemd application: %s units: %s", action.Name, strings.Join(loaded, ", "))
+	if err := systemctl.Stop(ctx, loaded...); err != nil {
+		return fmt.Errorf("stopping units: %w", err)
+	}
+	// reset the units so that properties such as restart counts are reset
+	if err := systemctl.ResetFailed(ctx, loaded...); err != nil {
+		return fmt.Errorf("resetting failed: %w", err)
+	}
+	return nil
+}
+
+func (s *Systemd) Execute(ctx context.Context, actions Actions) error {
+	for user, byType := range actions.ByUser() {
+		systemctl, err := s.systemdFactory(user)
+		if err != nil {
+			return fmt.Errorf("creating systemd client: %w", err)
+		}
+
+		if len(byType.Unknown) > 0 {
+			return fmt.Errorf("unknown action type %s", byType.Unknown[0].Type)
+		}
+
+		for _, a := range slices.Concat(byType.Removes, byType.Updates) {
+			if err := s.remove(ctx, a, systemctl); err != nil {
+				return fmt.Errorf("removing: %w", err)
+			}
+		}
+
+		// the unit files of the applications have been installed or removed by their providers
+		if err := systemctl.DaemonReload(ctx); err != nil {
+			return fmt.Errorf("systemd daemon reload: %w", err)
+		}
+
+		for _, a := range slices.Concat(byType.Adds, byType.Updates) {
+			if err := s.add(ctx, a, systemctl); err != nil {
+				return fmt.Errorf("adding: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSystemd_Execute(t *testing.T) {
+	target := "test-id-flightctl-systemd-app.target"
+	loaded := []client.SystemDUnitListEntry{{Unit: "reader.service", LoadState: string(api.SystemdLoadStateLoaded)}}
+
+	testCases := []struct {
+		name       string
+		action     Action
+		setupMocks func(*systemd.MockManager)
+		wantErr    bool
+	}{
+		{
+			name:   "ActionAdd success",
+			action: Action{Type: ActionAdd, Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {
+				m.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+				m.EXPECT().ListDependencies(gomock.Any(), target).Return([]string{"reader.service"}, nil)
+				m.EXPECT().ListUnitsByMatchPattern(gomock.Any(), []string{"reader.service"}).Return(loaded, nil)
+				m.EXPECT().Start(gomock.Any(), target).Return(nil)
+			},
+		},
+		{
+			name:   "ActionAdd unit not loaded",
+			action: Action{Type: ActionAdd, Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {
+				m.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+				m.EXPECT().ListDependencies(gomock.Any(), target).Return([]string{"reader.service"}, nil)
+				m.EXPECT().ListUnitsByMatchPattern(gomock.Any(), []string{"reader.service"}).Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "ActionAdd start failure stops the application",
+			action: Action{Type: ActionAdd, Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {
+				m.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+				m.EXPECT().ListDependencies(gomock.Any(), target).Return([]string{"reader.service"}, nil)
+				m.EXPECT().ListUnitsByMatchPattern(gomock.Any(), []string{"reader.service"}).Return(loaded, nil).Times(2)
+				m.EXPECT().Start(gomock.Any(), target).Return(errors.New("failed"))
+				m.EXPECT().Logs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"exec format error"}, nil)
+				m.EXPECT().Stop(gomock.Any(), target).Return(nil)
+				m.EXPECT().Stop(gomock.Any(), "reader.service").Return(nil)
+				m.EXPECT().ResetFailed(gomock.Any(), "reader.service").Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "ActionRemove success",
+			action: Action{Type: ActionRemove, Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {
+				m.EXPECT().ListDependencies(gomock.Any(), target).Return([]string{"reader.service"}, nil)
+				m.EXPECT().Stop(gomock.Any(), target).Return(nil)
+				m.EXPECT().ListUnitsByMatchPattern(gomock.Any(), []string{"reader.service"}).Return(loaded, nil)
+				m.EXPECT().Stop(gomock.Any(), "reader.service").Return(nil)
+				m.EXPECT().ResetFailed(gomock.Any(), "reader.service").Return(nil)
+				m.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "ActionRemove without target",
+			action: Action{Type: ActionRemove, Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {
+				m.EXPECT().ListDependencies(gomock.Any(), target).Return(nil, nil)
+				m.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "unsupported action type",
+			action:     Action{Type: "invalid", Name: "test-app", ID: "test-id"},
+			setupMocks: func(m *systemd.MockManager) {},
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSystemdMgr := systemd.NewMockManager(ctrl)
+			tc.setupMocks(mockSystemdMgr)
+			mockSystemdMgr.EXPECT().AddExclusions(gomock.Any()).AnyTimes()
+			mockSystemdMgr.EXPECT().RemoveExclusions(gomock.Any()).AnyTimes()
+
+			var systemdFactory systemd.ManagerFactory = func(user api.Username) (systemd.Manager, error) {
+				return mockSystemdMgr, nil
+			}
+
+			s := NewSystemd(log.NewPrefixLogger("test"), systemdFactory)
+			err := s.Execute(context.Background(), Actions{tc.action})
+			if tc.wantErr {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
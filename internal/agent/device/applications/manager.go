@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
 	"github.com/flightctl/flightctl/internal/agent/shutdown"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 var _ Manager = (*manager)(nil)
@@ -23,12 +25,14 @@ var _ Manager = (*manager)(nil)
 type manager struct {
 	podmanMonitor      *PodmanMonitor
 	kubernetesMonitor  *KubernetesMonitor
+	systemdMonitor     *SystemdMonitor
 	clients            client.CLIClients
 	podmanFactory      client.PodmanFactory
 	rwFactory          fileio.ReadWriterFactory
 	pullConfigResolver dependency.PullConfigResolver
 	log                *log.PrefixLogger
 	bootTime           string
+	hostAccessPolicy   provider.HostAccessPolicy
 
 	// osUpdatePending is cached from BeforeUpdate for use during syncDevice
 	osUpdatePending bool
@@ -48,17 +52,20 @@ func NewManager(
 	systemInfo systeminfo.Manager,
 	systemdFactory systemd.ManagerFactory,
 	pullConfigResolver dependency.PullConfigResolver,
+	hostAccessPolicy provider.HostAccessPolicy,
 ) Manager {
 	bootTime := systemInfo.BootTime()
 	return &manager{
 		rwFactory:          rwFactory,
 		podmanMonitor:      NewPodmanMonitor(log, podmanFactory, systemdFactory, bootTime, rwFactory),
 		kubernetesMonitor:  NewKubernetesMonitor(log, clients, rwFactory),
+		systemdMonitor:     NewSystemdMonitor(log, systemdFactory),
 		podmanFactory:      podmanFactory,
 		clients:            clients,
 		pullConfigResolver: pullConfigResolver,
 		log:                log,
 		bootTime:           bootTime,
+		hostAccessPolicy:   hostAccessPolicy,
 		ociTargetCache:     provider.NewOCITargetCache(),
 		appDataCache:       provider.NewAppDataCache(),
 	}
@@ -100,6 +107,14 @@ func (m *manager) Ensure(ctx context.Context, provider provider.Provider) error
 			return fmt.Errorf("%w: %w", errors.ErrInstallingApplication, err)
 		}
 		return m.kubernetesMonitor.Ensure(NewHelmApplication(provider))
+	case v1beta1.AppTypeSystemd:
+		if m.systemdMonitor.Has(provider.Spec().ID) {
+			return nil
+		}
+		if err := provider.Install(ctx); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrInstallingApplication, err)
+		}
+		return m.systemdMonitor.Ensure(NewApplication(provider))
 	default:
 		return fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
@@ -124,6 +139,8 @@ func (m *manager) Remove(ctx context.Context, provider provider.Provider) error
 		return m.podmanMonitor.QueueRemove(NewApplication(provider))
 	case v1beta1.AppTypeHelm:
 		return m.kubernetesMonitor.Remove(NewHelmApplication(provider))
+	case v1beta1.AppTypeSystemd:
+		return m.systemdMonitor.Remove(NewApplication(provider))
 	default:
 		return fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
@@ -151,6 +168,14 @@ func (m *manager) Update(ctx context.Context, provider provider.Provider) error
 			return fmt.Errorf("%w: %w", errors.ErrInstallingApplication, err)
 		}
 		return m.kubernetesMonitor.Update(NewHelmApplication(provider))
+	case v1beta1.AppTypeSystemd:
+		if err := provider.Remove(ctx); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrRemovingApplication, err)
+		}
+		if err := provider.Install(ctx); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrInstallingApplication, err)
+		}
+		return m.systemdMonitor.Update(NewApplication(provider))
 	default:
 		return fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
@@ -192,6 +217,9 @@ func (m *manager) verifyProviders(ctx context.Context, providers []provider.Prov
 			}
 			m.log.Infof("%s is missing app dependencies. Deferring application validation until after OS update: %v", p.Name(), err)
 		}
+		if err := provider.VerifyHostAccess(p.Spec(), m.hostAccessPolicy); err != nil {
+			return fmt.Errorf("verify app provider: %w: %w: %w", errors.WithElement(p.Name()), errors.ErrNoRetry, err)
+		}
 	}
 	return nil
 }
@@ -207,6 +235,10 @@ func (m *manager) AfterUpdate(ctx context.Context) error {
 		return fmt.Errorf("error executing kubernetes actions: %w", err)
 	}
 
+	if err := m.systemdMonitor.ExecuteActions(ctx); err != nil {
+		return fmt.Errorf("error executing systemd actions: %w", err)
+	}
+
 	return nil
 }
 
@@ -234,6 +266,12 @@ func (m *manager) Status(ctx context.Context, status *v1beta1.DeviceStatus, opts
 	}
 	allResults = append(allResults, k8sResults...)
 
+	systemdResults, err := m.systemdMonitor.Status(ctx)
+	if err != nil {
+		return err
+	}
+	allResults = append(allResults, systemdResults...)
+
 	statuses, summary := aggregateAppStatuses(allResults)
 	status.ApplicationsSummary = summary
 	status.Applications = statuses
@@ -268,7 +306,11 @@ func aggregateAppStatuses(results []AppStatusResult) ([]v1beta1.DeviceApplicatio
 				overallStatus = v1beta1.ApplicationsSummaryStatusDegraded
 			}
 		case v1beta1.ApplicationsSummaryStatusUnknown:
-			degradedApps = append(degradedApps, fmt.Sprintf("Not started: %s", result.Status.Name))
+			if waitingFor := lo.FromPtr(result.Status.WaitingFor); len(waitingFor) > 0 {
+				degradedApps = append(degradedApps, fmt.Sprintf("%s is waiting for %s", result.Status.Name, strings.Join(waitingFor, ", ")))
+			} else {
+				degradedApps = append(degradedApps, fmt.Sprintf("Not started: %s", result.Status.Name))
+			}
 			if overallStatus != v1beta1.ApplicationsSummaryStatusError {
 				overallStatus = v1beta1.ApplicationsSummaryStatusDegraded
 			}
@@ -301,6 +343,9 @@ func (m *manager) Shutdown(ctx context.Context, state shutdown.State) error {
 		if err := m.kubernetesMonitor.Stop(); err != nil {
 			errs = append(errs, err)
 		}
+		if err := m.systemdMonitor.Drain(ctx); err != nil {
+			errs = append(errs, err)
+		}
 	} else {
 		m.log.Debug("Agent restart detected - stopping monitors")
 		if err := m.podmanMonitor.Stop(); err != nil {
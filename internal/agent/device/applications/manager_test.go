@@ -264,6 +264,7 @@ func TestManager(t *testing.T) {
 				rwFactory:         rwFactory,
 				podmanMonitor:     NewPodmanMonitor(log, podmanFactory, systemdFactory, bootTime.Format(time.RFC3339), rwMockFactory),
 				kubernetesMonitor: NewKubernetesMonitor(log, cliClients, rwFactory),
+				systemdMonitor:    NewSystemdMonitor(log, systemdFactory),
 				clients:           cliClients,
 				log:               log,
 			}
@@ -366,6 +367,7 @@ func TestManagerRemoveApplication(t *testing.T) {
 		rwFactory:         rwFactory,
 		podmanMonitor:     NewPodmanMonitor(log, podmanFactory, systemdFactory, bootTime.Format(time.RFC3339), rwMockFactory),
 		kubernetesMonitor: NewKubernetesMonitor(log, cliClients, rwFactory),
+		systemdMonitor:    NewSystemdMonitor(log, systemdFactory),
 		log:               log,
 	}
 
@@ -930,6 +932,7 @@ func TestVerifyProvidersDeferredDependencies(t *testing.T) {
 			setupProviders: func(ctrl *gomock.Controller) []provider.Provider {
 				mockProvider := provider.NewMockProvider(ctrl)
 				mockProvider.EXPECT().Name().Return("helm-app").AnyTimes()
+				mockProvider.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				mockProvider.EXPECT().Verify(gomock.Any()).Return(
 					fmt.Errorf("%w: helm binary not found", errors.ErrAppDependency))
 				return []provider.Provider{mockProvider}
@@ -942,6 +945,7 @@ func TestVerifyProvidersDeferredDependencies(t *testing.T) {
 			setupProviders: func(ctrl *gomock.Controller) []provider.Provider {
 				mockProvider := provider.NewMockProvider(ctrl)
 				mockProvider.EXPECT().Name().Return("helm-app").AnyTimes()
+				mockProvider.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				mockProvider.EXPECT().Verify(gomock.Any()).Return(
 					fmt.Errorf("%w: helm binary not found", errors.ErrAppDependency))
 				return []provider.Provider{mockProvider}
@@ -955,11 +959,13 @@ func TestVerifyProvidersDeferredDependencies(t *testing.T) {
 			setupProviders: func(ctrl *gomock.Controller) []provider.Provider {
 				helmProvider := provider.NewMockProvider(ctrl)
 				helmProvider.EXPECT().Name().Return("helm-app").AnyTimes()
+				helmProvider.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				helmProvider.EXPECT().Verify(gomock.Any()).Return(
 					fmt.Errorf("%w: helm binary not found", errors.ErrAppDependency))
 
 				containerProvider := provider.NewMockProvider(ctrl)
 				containerProvider.EXPECT().Name().Return("container-app").AnyTimes()
+				containerProvider.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				containerProvider.EXPECT().Verify(gomock.Any()).Return(nil)
 
 				return []provider.Provider{helmProvider, containerProvider}
@@ -972,6 +978,7 @@ func TestVerifyProvidersDeferredDependencies(t *testing.T) {
 			setupProviders: func(ctrl *gomock.Controller) []provider.Provider {
 				mockProvider := provider.NewMockProvider(ctrl)
 				mockProvider.EXPECT().Name().Return("helm-app").AnyTimes()
+				mockProvider.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				mockProvider.EXPECT().Verify(gomock.Any()).Return(
 					fmt.Errorf("critical error: invalid spec"))
 				return []provider.Provider{mockProvider}
@@ -985,10 +992,12 @@ func TestVerifyProvidersDeferredDependencies(t *testing.T) {
 			setupProviders: func(ctrl *gomock.Controller) []provider.Provider {
 				p1 := provider.NewMockProvider(ctrl)
 				p1.EXPECT().Name().Return("app1").AnyTimes()
+				p1.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				p1.EXPECT().Verify(gomock.Any()).Return(nil)
 
 				p2 := provider.NewMockProvider(ctrl)
 				p2.EXPECT().Name().Return("app2").AnyTimes()
+				p2.EXPECT().Spec().Return(&provider.ApplicationSpec{}).AnyTimes()
 				p2.EXPECT().Verify(gomock.Any()).Return(nil)
 
 				return []provider.Provider{p1, p2}
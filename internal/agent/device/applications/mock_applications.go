@@ -309,6 +309,34 @@ func (mr *MockApplicationMockRecorder) CopyWorkloadsFrom(other any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyWorkloadsFrom", reflect.TypeOf((*MockApplication)(nil).CopyWorkloadsFrom), other)
 }
 
+// DependsOn mocks base method.
+func (m *MockApplication) DependsOn() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependsOn")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// DependsOn indicates an expected call of DependsOn.
+func (mr *MockApplicationMockRecorder) DependsOn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependsOn", reflect.TypeOf((*MockApplication)(nil).DependsOn))
+}
+
+// HealthProbes mocks base method.
+func (m *MockApplication) HealthProbes() v1beta1.ApplicationHealthProbes {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthProbes")
+	ret0, _ := ret[0].(v1beta1.ApplicationHealthProbes)
+	return ret0
+}
+
+// HealthProbes indicates an expected call of HealthProbes.
+func (mr *MockApplicationMockRecorder) HealthProbes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthProbes", reflect.TypeOf((*MockApplication)(nil).HealthProbes))
+}
+
 // ID mocks base method.
 func (m *MockApplication) ID() string {
 	m.ctrl.T.Helper()
@@ -379,6 +407,20 @@ func (mr *MockApplicationMockRecorder) RemoveWorkload(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkload", reflect.TypeOf((*MockApplication)(nil).RemoveWorkload), name)
 }
 
+// ResourceMonitors mocks base method.
+func (m *MockApplication) ResourceMonitors() []v1beta1.ResourceMonitor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceMonitors")
+	ret0, _ := ret[0].([]v1beta1.ResourceMonitor)
+	return ret0
+}
+
+// ResourceMonitors indicates an expected call of ResourceMonitors.
+func (mr *MockApplicationMockRecorder) ResourceMonitors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceMonitors", reflect.TypeOf((*MockApplication)(nil).ResourceMonitors))
+}
+
 // Status mocks base method.
 func (m *MockApplication) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error) {
 	m.ctrl.T.Helper()
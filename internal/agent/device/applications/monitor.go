@@ -88,6 +88,8 @@ type monitor struct {
 	apps     map[string]Application
 	actions  []lifecycle.Action
 	handlers map[v1beta1.AppType]lifecycle.ActionHandler
+	// usage holds the usage monitors running for applications by application ID.
+	usage usageMonitors
 
 	log  *log.PrefixLogger
 	name string
@@ -101,6 +103,7 @@ func newMonitor(log *log.PrefixLogger, name string, registrations ...handlerRegi
 	return &monitor{
 		apps:     make(map[string]Application),
 		handlers: handlers,
+		usage:    make(usageMonitors),
 		log:      log,
 		name:     name,
 	}
@@ -472,10 +475,13 @@ func (m *monitor) Status() ([]AppStatusResult, error) {
 			errs = append(errs, err)
 			continue
 		}
-		results = append(results, AppStatusResult{
+		result := AppStatusResult{
 			Status:  *appStatus,
 			Summary: appSummary,
-		})
+		}
+		usage, resources := m.usage.status(app.ID())
+		applyUsageResults(&result.Status, &result.Summary, usage, resources)
+		results = append(results, result)
 	}
 
 	if len(errs) > 0 {
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io"
 	"os/exec"
+	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -19,6 +22,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
@@ -42,6 +46,17 @@ type PodmanMonitor struct {
 	systemdFactory systemd.ManagerFactory
 	watchers       map[v1beta1.Username]*podmanEventWatcher
 	events         chan client.PodmanEvent
+	// probers is a map of application ID to the health probes running for the application.
+	probers map[string]*appProbers
+	// usage holds the usage monitors running for applications by application ID.
+	usage usageMonitors
+	// execMu serializes the execution of actions by the lifecycle handlers.
+	execMu sync.Mutex
+	// waiting is a map of application ID to the action starting the application, deferred until
+	// the applications it depends on are ready.
+	waiting map[string]lifecycle.Action
+	// stopWaiting stops starting the waiting applications. It is nil when no application is waiting.
+	stopWaiting context.CancelFunc
 
 	log *log.PrefixLogger
 }
@@ -68,6 +83,9 @@ func NewPodmanMonitor(
 		},
 		watchers:               make(map[v1beta1.Username]*podmanEventWatcher),
 		apps:                   make(map[string]Application),
+		probers:                make(map[string]*appProbers),
+		usage:                  make(usageMonitors),
+		waiting:                make(map[string]lifecycle.Action),
 		startTime:              startTime,
 		lastActionsSuccessTime: startTime,
 		log:                    log,
@@ -123,6 +141,17 @@ func (m *PodmanMonitor) stopMonitorForUser(username v1beta1.Username) error {
 
 // Stop stops the podman monitor without draining applications
 func (m *PodmanMonitor) Stop() error {
+	m.mu.Lock()
+	for appID := range m.probers {
+		m.stopProbers(appID)
+	}
+	m.usage.stopAll()
+	if m.stopWaiting != nil {
+		m.stopWaiting()
+		m.stopWaiting = nil
+	}
+	m.mu.Unlock()
+
 	var errs []error
 	for _, watcher := range m.watchers {
 		if err := watcher.Stop(); err != nil {
@@ -199,14 +228,15 @@ func (m *PodmanMonitor) Ensure(ctx context.Context, app Application) error {
 
 	appName := app.Name()
 	action := lifecycle.Action{
-		AppType:  app.AppType(),
-		Type:     lifecycle.ActionAdd,
-		User:     app.User(),
-		Name:     appName,
-		ID:       appID,
-		Path:     app.Path(),
-		Embedded: app.IsEmbedded(),
-		Volumes:  provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionAdd,
+		User:      app.User(),
+		Name:      appName,
+		ID:        appID,
+		Path:      app.Path(),
+		Embedded:  app.IsEmbedded(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -223,15 +253,17 @@ func (m *PodmanMonitor) QueueRemove(app Application) error {
 	// handle the case where the workload doesn't exist.
 	appID := app.ID()
 	delete(m.apps, appID)
+	delete(m.waiting, appID)
 	appName := app.Name()
 
 	action := lifecycle.Action{
-		AppType: app.AppType(),
-		Type:    lifecycle.ActionRemove,
-		Name:    appName,
-		User:    app.User(),
-		ID:      appID,
-		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionRemove,
+		Name:      appName,
+		User:      app.User(),
+		ID:        appID,
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -250,16 +282,19 @@ func (m *PodmanMonitor) QueueUpdate(app Application) error {
 	}
 
 	m.apps[appID] = app
+	// the update replaces any start still waiting for the dependencies of the application
+	delete(m.waiting, appID)
 
 	// currently we don't support updating embedded applications
 	action := lifecycle.Action{
-		AppType: app.AppType(),
-		Type:    lifecycle.ActionUpdate,
-		Name:    app.Name(),
-		User:    app.User(),
-		ID:      appID,
-		Path:    app.Path(),
-		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
+		AppType:   app.AppType(),
+		Type:      lifecycle.ActionUpdate,
+		Name:      app.Name(),
+		User:      app.User(),
+		ID:        appID,
+		Path:      app.Path(),
+		Volumes:   provider.ToLifecycleVolumes(app.Volume().List()),
+		DependsOn: app.DependsOn(),
 	}
 
 	m.actions = append(m.actions, action)
@@ -292,10 +327,25 @@ func (m *PodmanMonitor) ExecuteActions(ctx context.Context) error {
 }
 
 func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool) error {
-	ctx = m.addBatchTimeToCtx(ctx)
+	m.execMu.Lock()
+	defer m.execMu.Unlock()
+
 	actions := m.drainActions()
+	if !systemShutdown {
+		actions = m.deferWaitingActions(ctx, actions)
+	}
+	return m.runActions(ctx, actions, systemShutdown)
+}
+
+// runActions executes actions in the order of the dependencies between their applications. The
+// caller must hold the execution lock.
+func (m *PodmanMonitor) runActions(ctx context.Context, actions []lifecycle.Action, systemShutdown bool) error {
+	ctx = m.addBatchTimeToCtx(ctx)
+	actions = lifecycle.SortByDependencies(actions)
 
-	groupedActions := make(map[v1beta1.AppType][]lifecycle.Action)
+	// consecutive actions of the same app type are executed together by their handler
+	var batches [][]lifecycle.Action
+	var batchTypes []v1beta1.AppType
 	for i := range actions {
 		action := actions[i]
 		appType := normalizeActionAppType(action.AppType)
@@ -309,11 +359,16 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 		if !ok {
 			return fmt.Errorf("%w: no action handler registered: %s", errors.ErrUnsupportedAppType, action.AppType)
 		}
-		groupedActions[appType] = append(groupedActions[appType], action)
+		if n := len(batches); n > 0 && batchTypes[n-1] == appType {
+			batches[n-1] = append(batches[n-1], action)
+			continue
+		}
+		batches = append(batches, []lifecycle.Action{action})
+		batchTypes = append(batchTypes, appType)
 	}
 
-	for appType, actions := range groupedActions {
-		if err := m.handlers[appType].Execute(ctx, actions); err != nil {
+	for i, batch := range batches {
+		if err := m.handlers[batchTypes[i]].Execute(ctx, batch); err != nil {
 			return err
 		}
 	}
@@ -329,7 +384,11 @@ func (m *PodmanMonitor) executeActions(ctx context.Context, systemShutdown bool)
 			if err := m.ensureMonitorForUser(ctx, a.User); err != nil {
 				return fmt.Errorf("failed to start podman monitor: %w", err)
 			}
+			m.startProbers(ctx, a.ID)
+			m.startUsageMonitor(ctx, a.ID)
 		case lifecycle.ActionRemove:
+			m.stopProbers(a.ID)
+			m.usage.stop(a.ID)
 			// Stop the monitor for the app user if no other apps for that user are running.
 			user := a.User
 			exists := false
@@ -373,10 +432,17 @@ func (m *PodmanMonitor) Status() ([]AppStatusResult, error) {
 			errs = append(errs, err)
 			continue
 		}
-		results = append(results, AppStatusResult{
+		result := AppStatusResult{
 			Status:  *appStatus,
 			Summary: appSummary,
-		})
+		}
+		applyProbeResults(&result.Status, &result.Summary, m.probeResults(app.ID()))
+		usage, resources := m.usage.status(app.ID())
+		applyUsageResults(&result.Status, &result.Summary, usage, resources)
+		if _, ok := m.waiting[app.ID()]; ok {
+			applyDependencyResults(&result.Status, m.waitingFor(app, nil))
+		}
+		results = append(results, result)
 	}
 
 	if len(errs) > 0 {
@@ -480,9 +546,349 @@ func (m *PodmanMonitor) updateApplicationStatus(app Application, event *client.P
 		Name:     event.Name,
 		Status:   status,
 		Restarts: restarts,
+		Unit:     event.Attributes[quadletSystemdLabel],
 	})
 }
 
+// appProbers holds the health probes running for an application.
+type appProbers struct {
+	readiness *prober
+	liveness  *prober
+	cancel    context.CancelFunc
+}
+
+// startProbers starts the health probes of an application, replacing any probes already
+// running for it. The caller must hold the lock.
+func (m *PodmanMonitor) startProbers(ctx context.Context, appID string) {
+	m.stopProbers(appID)
+
+	app, ok := m.apps[appID]
+	if !ok {
+		return
+	}
+	probes := app.HealthProbes()
+	if probes.ReadinessProbe == nil && probes.LivenessProbe == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	running := &appProbers{cancel: cancel}
+	if probes.ReadinessProbe != nil {
+		running.readiness = m.newProber(app, probeTypeReadiness, *probes.ReadinessProbe)
+		go running.readiness.Run(ctx)
+	}
+	if probes.LivenessProbe != nil {
+		running.liveness = m.newProber(app, probeTypeLiveness, *probes.LivenessProbe)
+		go running.liveness.Run(ctx)
+	}
+	m.probers[appID] = running
+}
+
+// stopProbers stops the health probes of an application. The caller must hold the lock.
+func (m *PodmanMonitor) stopProbers(appID string) {
+	running, ok := m.probers[appID]
+	if !ok {
+		return
+	}
+	running.cancel()
+	delete(m.probers, appID)
+}
+
+// probeResults returns the results of the health probes of an application, or nil if it has
+// none. The caller must hold the lock.
+func (m *PodmanMonitor) probeResults(appID string) *v1beta1.ApplicationProbesStatus {
+	running, ok := m.probers[appID]
+	if !ok {
+		return nil
+	}
+	var results v1beta1.ApplicationProbesStatus
+	if running.readiness != nil {
+		results.Readiness = lo.ToPtr(running.readiness.Status())
+	}
+	if running.liveness != nil {
+		results.Liveness = lo.ToPtr(running.liveness.Status())
+	}
+	return &results
+}
+
+func (m *PodmanMonitor) newProber(app Application, probeType probeType, spec v1beta1.ApplicationProbe) *prober {
+	var check probeCheck
+	switch {
+	case spec.HttpGet != nil:
+		check = httpGetCheck(*spec.HttpGet)
+	case spec.TcpSocket != nil:
+		check = tcpSocketCheck(*spec.TcpSocket)
+	case spec.Exec != nil:
+		check = m.execCheck(app, *spec.Exec)
+	default:
+		check = func(context.Context) error { return fmt.Errorf("no probe handler defined") }
+	}
+
+	var onFailure func(ctx context.Context) error
+	if lo.FromPtr(spec.FailureAction) == v1beta1.ApplicationProbeFailureActionRestart {
+		onFailure = func(ctx context.Context) error { return m.restartApplication(ctx, app) }
+	}
+
+	active := func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return lo.ContainsBy(app.Workloads(), func(w Workload) bool { return w.Status == StatusRunning })
+	}
+
+	return newProber(m.log, app.Name(), probeType, spec, check, active, onFailure)
+}
+
+// deferWaitingActions returns the actions to execute now, and defers the additions and updates of
+// applications whose dependencies aren't ready until they are. The caller must hold the execution lock.
+func (m *PodmanMonitor) deferWaitingActions(ctx context.Context, actions []lifecycle.Action) []lifecycle.Action {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// applications added or updated by these actions aren't ready before the actions are executed
+	starting := make(map[string]struct{})
+	for _, a := range actions {
+		if a.Type != lifecycle.ActionRemove {
+			starting[a.Name] = struct{}{}
+		}
+	}
+
+	ready := make([]lifecycle.Action, 0, len(actions))
+	for _, a := range actions {
+		app, ok := m.apps[a.ID]
+		if a.Type == lifecycle.ActionRemove || len(a.DependsOn) == 0 || !ok {
+			ready = append(ready, a)
+			continue
+		}
+		waitingFor := m.waitingFor(app, starting)
+		if len(waitingFor) == 0 {
+			ready = append(ready, a)
+			continue
+		}
+		m.log.Infof("Deferring start of application %s until its dependencies are ready: %v", a.Name, waitingFor)
+		m.waiting[a.ID] = a
+	}
+
+	if len(m.waiting) > 0 && m.stopWaiting == nil {
+		// applications are started with the context of the monitor so that their probes and usage
+		// monitors outlive the waiting
+		waitingCtx, cancel := context.WithCancel(ctx)
+		m.stopWaiting = cancel
+		go m.startWhenReady(ctx, waitingCtx.Done())
+	}
+	return ready
+}
+
+// waitingFor returns the names of the dependencies of an application that aren't ready. The
+// applications being started aren't ready. The caller must hold the lock.
+func (m *PodmanMonitor) waitingFor(app Application, starting map[string]struct{}) []string {
+	var names []string
+	for _, name := range app.DependsOn() {
+		if _, ok := starting[name]; ok {
+			names = append(names, name)
+			continue
+		}
+		dependency, ok := lo.Find(lo.Values(m.apps), func(a Application) bool { return a.Name() == name })
+		if !ok || !m.isReady(dependency) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isReady returns true if an application is started and ready. The caller must hold the lock.
+func (m *PodmanMonitor) isReady(app Application) bool {
+	if _, waiting := m.waiting[app.ID()]; waiting {
+		return false
+	}
+	appStatus, summary, err := app.Status()
+	if err != nil {
+		return false
+	}
+	status := *appStatus
+	applyProbeResults(&status, &summary, m.probeResults(app.ID()))
+	return isApplicationReady(&status)
+}
+
+// startWhenReady periodically starts the waiting applications whose dependencies are ready, until
+// no application is waiting anymore or it is stopped.
+func (m *PodmanMonitor) startWhenReady(ctx context.Context, stopped <-chan struct{}) {
+	ticker := time.NewTicker(dependencyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stopped:
+			return
+		case <-ticker.C:
+			if !m.startReadyApplications(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// startReadyApplications starts the waiting applications whose dependencies are ready, and returns
+// whether applications are still waiting.
+func (m *PodmanMonitor) startReadyApplications(ctx context.Context) bool {
+	m.execMu.Lock()
+	defer m.execMu.Unlock()
+
+	m.mu.Lock()
+	var ready []lifecycle.Action
+	for appID, action := range m.waiting {
+		app, ok := m.apps[appID]
+		if !ok {
+			delete(m.waiting, appID)
+			continue
+		}
+		if len(m.waitingFor(app, nil)) == 0 {
+			ready = append(ready, action)
+		}
+	}
+	for _, action := range ready {
+		delete(m.waiting, action.ID)
+	}
+	m.mu.Unlock()
+
+	if len(ready) > 0 {
+		slices.SortFunc(ready, func(a, b lifecycle.Action) int { return strings.Compare(a.Name, b.Name) })
+		m.log.Infof("Starting %d applications whose dependencies are ready", len(ready))
+		if err := m.runActions(ctx, ready, false); err != nil {
+			m.log.Errorf("Failed to start applications whose dependencies are ready: %v", err)
+			// retry the applications that are still desired on the next check
+			m.mu.Lock()
+			for _, action := range ready {
+				_, tracked := m.apps[action.ID]
+				_, waiting := m.waiting[action.ID]
+				if tracked && !waiting {
+					m.waiting[action.ID] = action
+				}
+			}
+			m.mu.Unlock()
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.waiting) > 0 {
+		return true
+	}
+	if m.stopWaiting != nil {
+		m.stopWaiting()
+		m.stopWaiting = nil
+	}
+	return false
+}
+
+// startUsageMonitor starts sampling the resource usage of the containers and volumes of an
+// application, replacing any monitor already running for it. The caller must hold the lock.
+func (m *PodmanMonitor) startUsageMonitor(ctx context.Context, appID string) {
+	app, ok := m.apps[appID]
+	if !ok {
+		return
+	}
+
+	sampler := &podmanUsageSampler{
+		podman: func() (*client.Podman, error) { return m.clientFactory(app.User()) },
+		workloads: func() []Workload {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			return app.Workloads()
+		},
+		volumes: func() []string {
+			var volumes []string
+			for _, volume := range app.Volume().List() {
+				if volume.Available {
+					volumes = append(volumes, volume.ID)
+				}
+			}
+			return volumes
+		},
+		deviceMemory: resource.TotalMemoryBytes,
+		numCPU:       runtime.NumCPU(),
+	}
+	monitor, err := newUsageMonitor(m.log, app.Name(), app.ResourceMonitors(), sampler.sample)
+	if err != nil {
+		m.log.Errorf("Failed to monitor resource usage of application %s: %v", app.Name(), err)
+		m.usage.stop(appID)
+		return
+	}
+	m.usage.start(ctx, appID, monitor)
+}
+
+// execCheck returns a check running a command in a container of the application.
+func (m *PodmanMonitor) execCheck(app Application, spec v1beta1.ExecProbe) probeCheck {
+	return func(ctx context.Context) error {
+		m.mu.Lock()
+		workloads := app.Workloads()
+		m.mu.Unlock()
+
+		var container string
+		switch {
+		case spec.Container != nil:
+			workload, ok := lo.Find(workloads, func(w Workload) bool { return w.Name == *spec.Container })
+			if !ok {
+				return fmt.Errorf("container %s not found", *spec.Container)
+			}
+			container = workload.Name
+		case len(workloads) == 1:
+			container = workloads[0].Name
+		default:
+			return fmt.Errorf("the container to run the probe in must be specified for applications with %d containers", len(workloads))
+		}
+
+		podman, err := m.clientFactory(app.User())
+		if err != nil {
+			return err
+		}
+		_, err = podman.ExecContainer(ctx, container, spec.Command...)
+		return err
+	}
+}
+
+// restartApplication restarts the workloads of an application. Workloads run by systemd units
+// are restarted through systemd, the others through podman.
+func (m *PodmanMonitor) restartApplication(ctx context.Context, app Application) error {
+	m.mu.Lock()
+	workloads := app.Workloads()
+	m.mu.Unlock()
+
+	var units, containers []string
+	for _, workload := range workloads {
+		if workload.Unit != "" {
+			units = append(units, workload.Unit)
+		} else {
+			containers = append(containers, workload.Name)
+		}
+	}
+	units = lo.Uniq(units)
+
+	if len(units) > 0 {
+		systemctl, err := m.systemdFactory(app.User())
+		if err != nil {
+			return err
+		}
+		if err := systemctl.Stop(ctx, units...); err != nil {
+			return err
+		}
+		if err := systemctl.Start(ctx, units...); err != nil {
+			return err
+		}
+	}
+	if len(containers) > 0 {
+		podman, err := m.clientFactory(app.User())
+		if err != nil {
+			return err
+		}
+		if err := podman.RestartContainers(ctx, containers...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *PodmanMonitor) updateQuadletContainerStatus(ctx context.Context, app Application, event *client.PodmanEvent) {
 	systemdUnit, ok := event.Attributes[quadletSystemdLabel]
 	if !ok {
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -793,6 +794,97 @@ func TestPodmanMonitorHandlerSelection(t *testing.T) {
 	require.Equal(0, len(quadletActions), "Quadlet handler should not be called")
 }
 
+func TestPodmanMonitorDependencies(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	log := log.NewPrefixLogger("test")
+	log.SetLevel(logrus.DebugLevel)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReadWriter := fileio.NewMockReadWriter(ctrl)
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockPodmanClient := client.NewPodman(log, mockExec, mockReadWriter, util.NewPollConfig())
+
+	tempDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tempDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tempDir)),
+	)
+
+	mockExec.EXPECT().CommandContext(gomock.Any(), "podman", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string, args ...string) *exec.Cmd {
+			now := time.Now().UnixNano()
+			return exec.CommandContext(ctx, "echo", fmt.Sprintf(`{"timeNano": %d}`, now)) //nolint:gosec
+		}).AnyTimes()
+
+	var podmanFactory client.PodmanFactory = func(user v1beta1.Username) (*client.Podman, error) {
+		return mockPodmanClient, nil
+	}
+	var systemdFactory systemd.ManagerFactory = func(user v1beta1.Username) (systemd.Manager, error) {
+		return systemd.NewMockManager(ctrl), nil
+	}
+	var rwFactory fileio.ReadWriterFactory = func(username v1beta1.Username) (fileio.ReadWriter, error) {
+		return readWriter, nil
+	}
+	podmanMonitor := NewPodmanMonitor(log, podmanFactory, systemdFactory, "", rwFactory)
+
+	var mu sync.Mutex
+	var executed []string
+	mockComposeHandler := lifecycle.NewMockActionHandler(ctrl)
+	mockComposeHandler.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, actions lifecycle.Actions) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, a := range actions {
+				executed = append(executed, fmt.Sprintf("%s %s", a.Type, a.Name))
+			}
+			return nil
+		}).AnyTimes()
+	podmanMonitor.handlers[v1beta1.AppTypeCompose] = mockComposeHandler
+	drainExecuted := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		result := executed
+		executed = nil
+		return result
+	}
+
+	ingest := createTestApplication(require, "ingest", v1beta1.ApplicationStatusPreparing, v1beta1.CurrentProcessUsername)
+	ingest.(*application).dependsOn = []string{"broker"}
+	broker := createTestApplication(require, "broker", v1beta1.ApplicationStatusPreparing, v1beta1.CurrentProcessUsername)
+
+	require.NoError(podmanMonitor.Ensure(ctx, ingest))
+	require.NoError(podmanMonitor.Ensure(ctx, broker))
+	require.NoError(podmanMonitor.ExecuteActions(ctx))
+
+	// ingest waits for broker to be ready
+	require.Equal([]string{"add broker"}, drainExecuted())
+	results, err := podmanMonitor.Status()
+	require.NoError(err)
+	result, ok := lo.Find(results, func(r AppStatusResult) bool { return r.Status.Name == "ingest" })
+	require.True(ok)
+	require.Equal(v1beta1.ApplicationStatusPreparing, result.Status.Status)
+	require.Equal([]string{"broker"}, lo.FromPtr(result.Status.WaitingFor))
+
+	require.True(podmanMonitor.startReadyApplications(ctx))
+	require.Empty(drainExecuted())
+
+	// ingest is started once broker is running
+	podmanMonitor.mu.Lock()
+	broker.AddWorkload(&Workload{Name: "broker-container", Status: StatusRunning})
+	podmanMonitor.mu.Unlock()
+	require.False(podmanMonitor.startReadyApplications(ctx))
+	require.Equal([]string{"add ingest"}, drainExecuted())
+
+	// ingest is stopped before broker
+	require.NoError(podmanMonitor.QueueRemove(broker))
+	require.NoError(podmanMonitor.QueueRemove(ingest))
+	require.NoError(podmanMonitor.ExecuteActions(ctx))
+	require.Equal([]string{"remove ingest", "remove broker"}, drainExecuted())
+}
+
 func TestPodmanMonitorDrainSkipsQuadletActions(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,257 @@
+package applications
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultProbePeriod           = 10 * time.Second
+	defaultProbeTimeout          = 1 * time.Second
+	defaultProbeSuccessThreshold = 1
+	defaultProbeFailureThreshold = 3
+	defaultProbeHost             = "localhost"
+	maxProbeMessageLength        = 256
+)
+
+type probeType string
+
+const (
+	probeTypeReadiness probeType = "readiness"
+	probeTypeLiveness  probeType = "liveness"
+)
+
+// probeCheck runs a probe once and returns an error if the application failed it.
+type probeCheck func(ctx context.Context) error
+
+// prober periodically runs a health probe of an application and tracks its result.
+type prober struct {
+	log       *log.PrefixLogger
+	appName   string
+	probeType probeType
+	spec      v1beta1.ApplicationProbe
+	check     probeCheck
+	// active reports whether the application has running workloads to probe.
+	active func() bool
+	// onFailure is the action run when the probe reaches its failure threshold, if any.
+	onFailure func(ctx context.Context) error
+
+	mu                   sync.Mutex
+	status               v1beta1.ApplicationProbeStatus
+	consecutiveSuccesses int
+}
+
+func newProber(
+	log *log.PrefixLogger,
+	appName string,
+	probeType probeType,
+	spec v1beta1.ApplicationProbe,
+	check probeCheck,
+	active func() bool,
+	onFailure func(ctx context.Context) error,
+) *prober {
+	return &prober{
+		log:       log,
+		appName:   appName,
+		probeType: probeType,
+		spec:      spec,
+		check:     check,
+		active:    active,
+		onFailure: onFailure,
+		status:    v1beta1.ApplicationProbeStatus{Result: v1beta1.ApplicationProbeResultUnknown},
+	}
+}
+
+// Run runs the probe every period until the context is canceled.
+func (p *prober) Run(ctx context.Context) {
+	delay := time.Duration(lo.FromPtr(p.spec.InitialDelaySeconds)) * time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = p.period()
+		if p.probe(ctx) {
+			// give the restarted application its initial delay again
+			delay = time.Duration(lo.FromPtr(p.spec.InitialDelaySeconds)) * time.Second
+		}
+	}
+}
+
+// probe runs the probe once and returns true if the failure action was run.
+func (p *prober) probe(ctx context.Context) bool {
+	if p.active != nil && !p.active() {
+		return false
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, p.timeout())
+	err := p.check(checkCtx)
+	cancel()
+
+	if !p.record(err, time.Now()) || p.onFailure == nil {
+		return false
+	}
+
+	p.log.Warnf("Application %s failed its %s probe %d times, restarting it: %v", p.appName, p.probeType, p.failureThreshold(), err)
+	if err := p.onFailure(ctx); err != nil {
+		p.log.Errorf("Failed to restart application %s: %v", p.appName, err)
+	}
+	p.mu.Lock()
+	p.status.ConsecutiveFailures = 0
+	p.mu.Unlock()
+	return true
+}
+
+// record updates the result of the probe with the outcome of a run, and returns true if the
+// probe reached its failure threshold.
+func (p *prober) record(err error, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status.LastProbeTime = &now
+	if err == nil {
+		p.status.ConsecutiveFailures = 0
+		p.status.Message = nil
+		p.consecutiveSuccesses++
+		if p.status.Result != v1beta1.ApplicationProbeResultSuccess && p.consecutiveSuccesses >= p.successThreshold() {
+			p.log.Infof("Application %s passed its %s probe", p.appName, p.probeType)
+			p.status.Result = v1beta1.ApplicationProbeResultSuccess
+		}
+		return false
+	}
+
+	p.consecutiveSuccesses = 0
+	p.status.ConsecutiveFailures++
+	message := err.Error()
+	if len(message) > maxProbeMessageLength {
+		message = message[:maxProbeMessageLength-3] + "..."
+	}
+	p.status.Message = &message
+	if p.status.ConsecutiveFailures < p.failureThreshold() {
+		return false
+	}
+	if p.status.Result != v1beta1.ApplicationProbeResultFailure {
+		p.log.Warnf("Application %s failed its %s probe: %s", p.appName, p.probeType, message)
+		p.status.Result = v1beta1.ApplicationProbeResultFailure
+	}
+	return true
+}
+
+// Status returns the current result of the probe.
+func (p *prober) Status() v1beta1.ApplicationProbeStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.status
+}
+
+func (p *prober) period() time.Duration {
+	if p.spec.PeriodSeconds == nil {
+		return defaultProbePeriod
+	}
+	return time.Duration(*p.spec.PeriodSeconds) * time.Second
+}
+
+func (p *prober) timeout() time.Duration {
+	if p.spec.TimeoutSeconds == nil {
+		return defaultProbeTimeout
+	}
+	return time.Duration(*p.spec.TimeoutSeconds) * time.Second
+}
+
+func (p *prober) successThreshold() int {
+	return lo.FromPtrOr(p.spec.SuccessThreshold, defaultProbeSuccessThreshold)
+}
+
+func (p *prober) failureThreshold() int {
+	return lo.FromPtrOr(p.spec.FailureThreshold, defaultProbeFailureThreshold)
+}
+
+// httpGetCheck returns a check sending an HTTP GET request, which succeeds with any status code
+// from 200 to 399.
+func httpGetCheck(spec v1beta1.HttpGetProbe) probeCheck {
+	scheme := string(lo.FromPtrOr(spec.Scheme, v1beta1.HttpGetProbeSchemeHttp))
+	target := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(lo.FromPtrOr(spec.Host, defaultProbeHost), strconv.Itoa(spec.Port)),
+		Path:   lo.FromPtrOr(spec.Path, "/"),
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			// like kubelet, probes don't verify the certificate of the application
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("GET %s returned status %d", target.String(), resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// tcpSocketCheck returns a check opening a TCP connection.
+func tcpSocketCheck(spec v1beta1.TcpSocketProbe) probeCheck {
+	address := net.JoinHostPort(lo.FromPtrOr(spec.Host, defaultProbeHost), strconv.Itoa(spec.Port))
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
+
+// applyProbeResults folds the results of the health probes of an application into its status.
+// Only running applications are affected: a failed liveness probe puts the application in error,
+// and an application isn't healthy until its readiness probe succeeds.
+func applyProbeResults(status *v1beta1.DeviceApplicationStatus, summary *v1beta1.DeviceApplicationsSummaryStatus, probes *v1beta1.ApplicationProbesStatus) {
+	if probes == nil {
+		return
+	}
+	status.Probes = probes
+	if status.Status != v1beta1.ApplicationStatusRunning {
+		return
+	}
+
+	if probes.Liveness != nil && probes.Liveness.Result == v1beta1.ApplicationProbeResultFailure {
+		status.Status = v1beta1.ApplicationStatusError
+		summary.Status = v1beta1.ApplicationsSummaryStatusError
+		return
+	}
+
+	if probes.Readiness != nil && probes.Readiness.Result != v1beta1.ApplicationProbeResultSuccess {
+		if probes.Readiness.Result == v1beta1.ApplicationProbeResultUnknown {
+			status.Status = v1beta1.ApplicationStatusStarting
+		}
+		if summary.Status == v1beta1.ApplicationsSummaryStatusHealthy {
+			summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+		}
+	}
+}
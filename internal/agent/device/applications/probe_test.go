@@ -0,0 +1,197 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProberThresholds(t *testing.T) {
+	require := require.New(t)
+
+	var checkErr error
+	restarts := 0
+	p := newProber(log.NewPrefixLogger("test"), "app", probeTypeLiveness,
+		v1beta1.ApplicationProbe{SuccessThreshold: lo.ToPtr(2), FailureThreshold: lo.ToPtr(2)},
+		func(context.Context) error { return checkErr },
+		nil,
+		func(context.Context) error { restarts++; return nil },
+	)
+	ctx := context.Background()
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, p.Status().Result)
+
+	// the success threshold must be reached to succeed
+	require.False(p.probe(ctx))
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, p.Status().Result)
+	require.False(p.probe(ctx))
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, p.Status().Result)
+	require.NotNil(p.Status().LastProbeTime)
+
+	// a single failure below the threshold keeps the result
+	checkErr = fmt.Errorf("connection refused")
+	require.False(p.probe(ctx))
+	status := p.Status()
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, status.Result)
+	require.Equal(1, status.ConsecutiveFailures)
+	require.Equal("connection refused", lo.FromPtr(status.Message))
+
+	// reaching the failure threshold fails the probe and runs the failure action
+	require.True(p.probe(ctx))
+	require.Equal(v1beta1.ApplicationProbeResultFailure, p.Status().Result)
+	require.Equal(0, p.Status().ConsecutiveFailures)
+	require.Equal(1, restarts)
+
+	// the probe recovers once the success threshold is reached again
+	checkErr = nil
+	require.False(p.probe(ctx))
+	require.Equal(v1beta1.ApplicationProbeResultFailure, p.Status().Result)
+	require.False(p.probe(ctx))
+	require.Equal(v1beta1.ApplicationProbeResultSuccess, p.Status().Result)
+	require.Nil(p.Status().Message)
+	require.Equal(1, restarts)
+}
+
+func TestProberSkipsInactiveApplication(t *testing.T) {
+	require := require.New(t)
+
+	checks := 0
+	p := newProber(log.NewPrefixLogger("test"), "app", probeTypeReadiness, v1beta1.ApplicationProbe{},
+		func(context.Context) error { checks++; return nil },
+		func() bool { return false },
+		nil,
+	)
+	require.False(p.probe(context.Background()))
+	require.Equal(0, checks)
+	require.Equal(v1beta1.ApplicationProbeResultUnknown, p.Status().Result)
+	require.Nil(p.Status().LastProbeTime)
+}
+
+func TestHttpGetCheck(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(err)
+	host, portStr, err := net.SplitHostPort(serverURL.Host)
+	require.NoError(err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(httpGetCheck(v1beta1.HttpGetProbe{Host: &host, Port: port, Path: lo.ToPtr("/healthz")})(ctx))
+	err = httpGetCheck(v1beta1.HttpGetProbe{Host: &host, Port: port, Path: lo.ToPtr("/other")})(ctx)
+	require.ErrorContains(err, "returned status 503")
+}
+
+func TestTcpSocketCheck(t *testing.T) {
+	require := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	spec := v1beta1.TcpSocketProbe{Host: lo.ToPtr("127.0.0.1"), Port: port}
+	require.NoError(tcpSocketCheck(spec)(ctx))
+
+	require.NoError(listener.Close())
+	require.Error(tcpSocketCheck(spec)(ctx))
+}
+
+func TestApplyProbeResults(t *testing.T) {
+	probeStatus := func(result v1beta1.ApplicationProbeResult) *v1beta1.ApplicationProbeStatus {
+		return &v1beta1.ApplicationProbeStatus{Result: result}
+	}
+
+	tests := []struct {
+		name                  string
+		status                v1beta1.ApplicationStatusType
+		summary               v1beta1.ApplicationsSummaryStatusType
+		probes                *v1beta1.ApplicationProbesStatus
+		expectedStatus        v1beta1.ApplicationStatusType
+		expectedSummaryStatus v1beta1.ApplicationsSummaryStatusType
+	}{
+		{
+			name:                  "no probes",
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
+		},
+		{
+			name:                  "ready and live",
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			probes:                &v1beta1.ApplicationProbesStatus{Readiness: probeStatus(v1beta1.ApplicationProbeResultSuccess), Liveness: probeStatus(v1beta1.ApplicationProbeResultSuccess)},
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
+		},
+		{
+			name:                  "readiness not yet passed",
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			probes:                &v1beta1.ApplicationProbesStatus{Readiness: probeStatus(v1beta1.ApplicationProbeResultUnknown)},
+			expectedStatus:        v1beta1.ApplicationStatusStarting,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+		},
+		{
+			name:                  "readiness failed",
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			probes:                &v1beta1.ApplicationProbesStatus{Readiness: probeStatus(v1beta1.ApplicationProbeResultFailure)},
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+		},
+		{
+			name:                  "liveness failed",
+			status:                v1beta1.ApplicationStatusRunning,
+			summary:               v1beta1.ApplicationsSummaryStatusHealthy,
+			probes:                &v1beta1.ApplicationProbesStatus{Readiness: probeStatus(v1beta1.ApplicationProbeResultSuccess), Liveness: probeStatus(v1beta1.ApplicationProbeResultFailure)},
+			expectedStatus:        v1beta1.ApplicationStatusError,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusError,
+		},
+		{
+			name:                  "application not running",
+			status:                v1beta1.ApplicationStatusPreparing,
+			summary:               v1beta1.ApplicationsSummaryStatusUnknown,
+			probes:                &v1beta1.ApplicationProbesStatus{Liveness: probeStatus(v1beta1.ApplicationProbeResultFailure)},
+			expectedStatus:        v1beta1.ApplicationStatusPreparing,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			status := v1beta1.DeviceApplicationStatus{Name: "app", Status: tt.status}
+			summary := v1beta1.DeviceApplicationsSummaryStatus{Status: tt.summary}
+
+			applyProbeResults(&status, &summary, tt.probes)
+
+			require.Equal(tt.expectedStatus, status.Status)
+			require.Equal(tt.expectedSummaryStatus, summary.Status)
+			require.Equal(tt.probes, status.Probes)
+		})
+	}
+}
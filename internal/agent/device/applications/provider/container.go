@@ -16,6 +16,9 @@ import (
 	"github.com/samber/lo"
 )
 
+// containerAppQuadletFile is the quadlet generated for the container of a container application.
+const containerAppQuadletFile = "app.container"
+
 var _ Provider = (*containerProvider)(nil)
 var _ appProvider = (*containerProvider)(nil)
 
@@ -154,6 +157,10 @@ func (p *containerProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("installing container: %w", err)
 	}
 
+	if err := writeHostAccessDropIn(p.readWriter, p.spec); err != nil {
+		return fmt.Errorf("installing container: %w", err)
+	}
+
 	return nil
 }
 
@@ -281,7 +288,7 @@ func generateQuadlet(ctx context.Context, podman *client.Podman, rw fileio.ReadW
 		return fmt.Errorf("serializing quadlet: %w", err)
 	}
 
-	if err := rw.WriteFile(filepath.Join(dir, "app.container"), contents, fileio.DefaultFilePermissions); err != nil {
+	if err := rw.WriteFile(filepath.Join(dir, containerAppQuadletFile), contents, fileio.DefaultFilePermissions); err != nil {
 		return fmt.Errorf("writing container quadlet: %w", err)
 	}
 	return nil
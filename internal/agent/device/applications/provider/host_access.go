@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/quadlet"
+	"github.com/samber/lo"
+)
+
+const hostAccessDropInFile = "98-flightctl-host-access.conf"
+
+// HostAccessPolicy lists the host resources the device allows the containers of applications to access.
+type HostAccessPolicy = config.ApplicationHostAccess
+
+// hostAccess returns the host resources the containers of the application are given access to.
+func (s *ApplicationSpec) hostAccess() v1beta1.ApplicationHostAccess {
+	switch {
+	case s.ContainerApp != nil:
+		return s.ContainerApp.HostAccess()
+	case s.QuadletApp != nil:
+		return s.QuadletApp.HostAccess()
+	default:
+		return v1beta1.ApplicationHostAccess{}
+	}
+}
+
+// VerifyHostAccess returns an error if the application requests access to host resources that
+// the policy of the device doesn't allow.
+func VerifyHostAccess(spec *ApplicationSpec, policy HostAccessPolicy) error {
+	access := spec.hostAccess()
+
+	for _, device := range lo.FromPtr(access.Devices) {
+		hostPath, _, _ := strings.Cut(device, ":")
+		allowed := slices.ContainsFunc(policy.Devices, func(pattern string) bool {
+			matched, err := filepath.Match(pattern, hostPath)
+			return err == nil && matched
+		})
+		if !allowed {
+			return fmt.Errorf("%w: device %s", errors.ErrHostAccessNotAllowed, hostPath)
+		}
+	}
+
+	allowedCapabilities := lo.Map(policy.Capabilities, func(c string, _ int) string { return normalizeCapability(c) })
+	for _, capability := range lo.FromPtr(access.Capabilities) {
+		if !slices.Contains(allowedCapabilities, normalizeCapability(capability)) {
+			return fmt.Errorf("%w: capability %s", errors.ErrHostAccessNotAllowed, capability)
+		}
+	}
+
+	if network := lo.FromPtr(access.Network); network != "" && network != v1beta1.NetworkNone {
+		if !slices.Contains(policy.Networks, network) {
+			return fmt.Errorf("%w: network %s", errors.ErrHostAccessNotAllowed, network)
+		}
+	}
+
+	if lo.FromPtr(access.Ipc) == v1beta1.IpcHost && !policy.HostIPC {
+		return fmt.Errorf("%w: host IPC namespace", errors.ErrHostAccessNotAllowed)
+	}
+	return nil
+}
+
+func normalizeCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+}
+
+// writeHostAccessDropIn writes a drop-in applying the host access of the application to all of its
+// containers. The drop-in is written after the quadlets are installed so that network and
+// container names refer to resources outside of the application and are not namespaced.
+func writeHostAccessDropIn(rw fileio.ReadWriter, spec *ApplicationSpec) error {
+	access := spec.hostAccess()
+	dropInDir := filepath.Join(spec.Path, fmt.Sprintf("%s-%s.d", spec.ID, quadlet.ContainerExtension))
+	dropInPath := filepath.Join(dropInDir, hostAccessDropInFile)
+	if access == (v1beta1.ApplicationHostAccess{}) {
+		// remove the drop-in of a previous version of the application
+		return rw.RemoveFile(dropInPath)
+	}
+
+	unit := quadlet.NewEmptyUnit()
+	for _, device := range lo.FromPtr(access.Devices) {
+		unit.Add(quadlet.ContainerGroup, quadlet.AddDeviceKey, device)
+	}
+	for _, capability := range lo.FromPtr(access.Capabilities) {
+		unit.Add(quadlet.ContainerGroup, quadlet.AddCapabilityKey, normalizeCapability(capability))
+	}
+	if access.Network != nil {
+		unit.Add(quadlet.ContainerGroup, quadlet.NetworkKey, *access.Network)
+	}
+	if access.Ipc != nil {
+		ipc := *access.Ipc
+		if target, ok := strings.CutPrefix(ipc, v1beta1.IpcAppPrefix); ok {
+			// applications may only join the IPC namespace of a container application running as the same user
+			ipc = "container:" + containerAppContainerName(lifecycle.GenerateAppID(target, spec.User))
+		}
+		unit.Add(quadlet.ContainerGroup, quadlet.PodmanArgsKey, fmt.Sprintf("--ipc=%s", ipc))
+	}
+
+	contents, err := unit.Write()
+	if err != nil {
+		return fmt.Errorf("serializing host access drop-in: %w", err)
+	}
+	if err := rw.MkdirAll(dropInDir, fileio.DefaultDirectoryPermissions); err != nil {
+		return fmt.Errorf("creating drop-in directory: %w", err)
+	}
+	if err := rw.WriteFile(dropInPath, contents, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing host access drop-in: %w", err)
+	}
+	return nil
+}
+
+// containerAppContainerName returns the name podman gives to the container of a container application.
+func containerAppContainerName(appID string) string {
+	return "systemd-" + strings.TrimSuffix(namespacedQuadlet(appID, containerAppQuadletFile), quadlet.ContainerExtension)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyHostAccess(t *testing.T) {
+	policy := HostAccessPolicy{
+		Devices:      []string{"/dev/ttyUSB*", "/dev/video0"},
+		Capabilities: []string{"CAP_NET_ADMIN"},
+		Networks:     []string{"host", "sensors"},
+	}
+
+	tests := []struct {
+		name    string
+		access  v1beta1.ApplicationHostAccess
+		wantErr bool
+	}{
+		{
+			name: "allowed",
+			access: v1beta1.ApplicationHostAccess{
+				Devices:      lo.ToPtr([]string{"/dev/ttyUSB0:/dev/ttyS0:rw", "/dev/video0"}),
+				Capabilities: lo.ToPtr([]string{"NET_ADMIN"}),
+				Network:      lo.ToPtr("sensors"),
+				Ipc:          lo.ToPtr(v1beta1.IpcShareable),
+			},
+		},
+		{
+			name:   "no network is always allowed",
+			access: v1beta1.ApplicationHostAccess{Network: lo.ToPtr(v1beta1.NetworkNone)},
+		},
+		{
+			name:    "device not allowed",
+			access:  v1beta1.ApplicationHostAccess{Devices: lo.ToPtr([]string{"/dev/video1"})},
+			wantErr: true,
+		},
+		{
+			name:    "capability not allowed",
+			access:  v1beta1.ApplicationHostAccess{Capabilities: lo.ToPtr([]string{"SYS_ADMIN"})},
+			wantErr: true,
+		},
+		{
+			name:    "network not allowed",
+			access:  v1beta1.ApplicationHostAccess{Network: lo.ToPtr("backend")},
+			wantErr: true,
+		},
+		{
+			name:    "host ipc not allowed",
+			access:  v1beta1.ApplicationHostAccess{Ipc: lo.ToPtr(v1beta1.IpcHost)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &ApplicationSpec{ContainerApp: &v1beta1.ContainerApplication{
+				Devices:      tt.access.Devices,
+				Capabilities: tt.access.Capabilities,
+				Network:      tt.access.Network,
+				Ipc:          tt.access.Ipc,
+			}}
+			err := VerifyHostAccess(spec, policy)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errors.ErrHostAccessNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWriteHostAccessDropIn(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+
+	spec := &ApplicationSpec{
+		ID:   lifecycle.GenerateAppID("ingest", ""),
+		Path: "/etc/containers/systemd/ingest",
+		QuadletApp: &v1beta1.QuadletApplication{
+			Devices:      lo.ToPtr([]string{"/dev/ttyUSB0"}),
+			Capabilities: lo.ToPtr([]string{"CAP_NET_ADMIN"}),
+			Network:      lo.ToPtr("host"),
+			Ipc:          lo.ToPtr("app:broker"),
+		},
+	}
+	require.NoError(writeHostAccessDropIn(rw, spec))
+
+	dropInPath := filepath.Join(spec.Path, spec.ID+"-.container.d", hostAccessDropInFile)
+	contents, err := rw.ReadFile(dropInPath)
+	require.NoError(err)
+	brokerContainer := "systemd-" + lifecycle.GenerateAppID("broker", "") + "-app"
+	require.Contains(string(contents), "AddDevice=/dev/ttyUSB0")
+	require.Contains(string(contents), "AddCapability=NET_ADMIN")
+	require.Contains(string(contents), "Network=host")
+	require.Contains(string(contents), "PodmanArgs=--ipc=container:"+brokerContainer)
+
+	// the drop-in is removed once the application no longer requests host access
+	spec.QuadletApp = &v1beta1.QuadletApplication{}
+	require.NoError(writeHostAccessDropIn(rw, spec))
+	exists, err := rw.PathExists(dropInPath)
+	require.NoError(err)
+	require.False(exists)
+}
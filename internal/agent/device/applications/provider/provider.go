@@ -85,6 +85,7 @@ type ApplicationSpec struct {
 	HelmApp      *v1beta1.HelmApplication
 	ComposeApp   *v1beta1.ComposeApplication
 	QuadletApp   *v1beta1.QuadletApplication
+	SystemdApp   *v1beta1.SystemdApplication
 }
 
 func pullAuthPathForUser(username v1beta1.Username) string {
@@ -386,13 +387,19 @@ func ResolveImageAppName(appSpec *v1beta1.ApplicationProviderSpec) (string, erro
 			return "", err
 		}
 		return helm.SanitizeReleaseName(app.Image)
+	case v1beta1.AppTypeSystemd:
+		app, err := (*appSpec).AsSystemdApplication()
+		if err != nil {
+			return "", err
+		}
+		return app.Image, nil
 	default:
 		return "", fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
 }
 
 // AppNeedsNestedExtraction determines if an app needs nested OCI target extraction.
-// Container apps don't need extraction (simple image pull).
+// Container and systemd apps don't need extraction (simple image pull).
 // Helm apps need extraction for chart images.
 // Compose/Quadlet apps need extraction only if image-based (not inline).
 func AppNeedsNestedExtraction(appSpec *v1beta1.ApplicationProviderSpec) (bool, error) {
@@ -401,7 +408,7 @@ func AppNeedsNestedExtraction(appSpec *v1beta1.ApplicationProviderSpec) (bool, e
 		return false, err
 	}
 	switch appType {
-	case v1beta1.AppTypeContainer:
+	case v1beta1.AppTypeContainer, v1beta1.AppTypeSystemd:
 		return false, nil
 	case v1beta1.AppTypeHelm:
 		return true, nil
@@ -431,7 +438,7 @@ func AppNeedsNestedExtraction(appSpec *v1beta1.ApplicationProviderSpec) (bool, e
 }
 
 // ResolveImageRef extracts the OCI image reference from an app spec based on its type.
-// For Container and Helm apps, returns the image directly.
+// For Container, Helm and systemd apps, returns the image directly.
 // For Compose and Quadlet apps, returns the image from the nested ImageApplicationProviderSpec.
 func ResolveImageRef(appSpec *v1beta1.ApplicationProviderSpec) (string, error) {
 	appType, err := (*appSpec).GetAppType()
@@ -451,6 +458,12 @@ func ResolveImageRef(appSpec *v1beta1.ApplicationProviderSpec) (string, error) {
 			return "", err
 		}
 		return app.Image, nil
+	case v1beta1.AppTypeSystemd:
+		app, err := (*appSpec).AsSystemdApplication()
+		if err != nil {
+			return "", err
+		}
+		return app.Image, nil
 	case v1beta1.AppTypeCompose:
 		app, err := (*appSpec).AsComposeApplication()
 		if err != nil {
@@ -497,7 +510,7 @@ func ResolveUser(appSpec *v1beta1.ApplicationProviderSpec) (v1beta1.Username, er
 			return "", err
 		}
 		return app.RunAsWithDefault(), nil
-	case v1beta1.AppTypeHelm:
+	case v1beta1.AppTypeHelm, v1beta1.AppTypeSystemd:
 		return v1beta1.CurrentProcessUsername, nil
 	default:
 		return "", fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
@@ -626,6 +639,9 @@ func createProviderForAppType(
 	case v1beta1.AppTypeHelm:
 		return newHelmProvider(ctx, log, clients, providerSpec, rwFactory)
 
+	case v1beta1.AppTypeSystemd:
+		return newSystemdProvider(ctx, log, podman, providerSpec, rwFactory)
+
 	default:
 		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
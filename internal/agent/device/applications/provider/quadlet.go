@@ -313,6 +313,10 @@ func (p *quadletProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("installing quadlet: %w", err)
 	}
 
+	if err := writeHostAccessDropIn(p.readWriter, p.spec); err != nil {
+		return fmt.Errorf("installing quadlet: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,446 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/quadlet"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	// systemdAppDirVariable is replaced in the unit files of systemd applications with the
+	// directory the application is installed to.
+	systemdAppDirVariable = "${FLIGHTCTL_APP_DIR}"
+	// systemdAppKey marks the units installed by a systemd application with the ID of the application.
+	systemdAppKey = "X-Flightctl-App"
+	// systemdAppBinDir is the directory of a systemd application whose files are made executable.
+	systemdAppBinDir = "bin"
+	// systemdVendorUnitPath is the directory of the units shipped by the operating system.
+	systemdVendorUnitPath = "/usr/lib/systemd/system"
+)
+
+var systemdBinaryDeps = []dependencyBins{
+	{variants: []string{"podman"}},
+	{variants: []string{"systemctl"}},
+}
+
+// systemdUnitExtensions are the types of units a systemd application may contain.
+var systemdUnitExtensions = []string{".service", ".timer", ".socket", ".path", ".target"}
+
+// systemdActivatorExtensions are the types of units that activate a service of the same name.
+var systemdActivatorExtensions = []string{".timer", ".socket", ".path"}
+
+var systemdUnitNameRegex = regexp.MustCompile(`^[a-zA-Z0-9:_.\\-]+(@[a-zA-Z0-9:_.\\-]*)?\.[a-z]+$`)
+
+var _ Provider = (*systemdProvider)(nil)
+var _ appProvider = (*systemdProvider)(nil)
+
+type systemdProvider struct {
+	log            *log.PrefixLogger
+	podman         *client.Podman
+	readWriter     fileio.ReadWriter
+	commandChecker commandChecker
+	spec           *ApplicationSpec
+}
+
+func newSystemdProvider(
+	ctx context.Context,
+	log *log.PrefixLogger,
+	podmanFactory client.PodmanFactory,
+	apiSpec *v1beta1.ApplicationProviderSpec,
+	rwFactory fileio.ReadWriterFactory,
+) (*systemdProvider, error) {
+	systemdApp, err := (*apiSpec).AsSystemdApplication()
+	if err != nil {
+		return nil, fmt.Errorf("getting systemd application: %w", err)
+	}
+
+	appName := lo.FromPtr(systemdApp.Name)
+	if appName == "" {
+		appName = systemdApp.Image
+	}
+
+	// systemd applications always run as system units
+	user := v1beta1.CurrentProcessUsername
+
+	volumeManager, err := NewVolumeManager(log, appName, v1beta1.AppTypeSystemd, user, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	podman, err := podmanFactory(user)
+	if err != nil {
+		return nil, fmt.Errorf("creating podman client for user %s: %w", user, err)
+	}
+
+	readWriter, err := rwFactory(user)
+	if err != nil {
+		return nil, fmt.Errorf("creating read/writer for user %s: %w", user, err)
+	}
+
+	return &systemdProvider{
+		log:            log,
+		podman:         podman,
+		readWriter:     readWriter,
+		commandChecker: client.IsCommandAvailable,
+		spec: &ApplicationSpec{
+			Name:       appName,
+			ID:         lifecycle.GenerateAppID(appName, user),
+			User:       user,
+			AppType:    v1beta1.AppTypeSystemd,
+			Path:       filepath.Join(lifecycle.SystemdAppPath, appName),
+			EnvVars:    lo.FromPtr(systemdApp.EnvVars),
+			SystemdApp: &systemdApp,
+			Volume:     volumeManager,
+		},
+	}, nil
+}
+
+func (p *systemdProvider) EnsureDependencies(_ context.Context) error {
+	return ensureDependenciesFromAppType(systemdBinaryDeps, p.commandChecker)
+}
+
+func (p *systemdProvider) Verify(ctx context.Context) error {
+	if err := p.EnsureDependencies(ctx); err != nil {
+		return err
+	}
+
+	if err := ensureAppTypeFromImage(ctx, p.podman, v1beta1.AppTypeSystemd, p.spec.SystemdApp.Image); err != nil {
+		return fmt.Errorf("ensuring app type: %w", err)
+	}
+
+	if err := validateEnvVars(p.spec.EnvVars); err != nil {
+		return fmt.Errorf("%w: validating env vars: %w", errors.ErrInvalidSpec, err)
+	}
+
+	tmpAppPath, err := p.readWriter.MkdirTemp("app_temp")
+	if err != nil {
+		return fmt.Errorf("creating tmp dir: %w", err)
+	}
+	defer func() {
+		if err := p.readWriter.RemoveAll(tmpAppPath); err != nil {
+			p.log.Warnf("Failed to cleanup temporary directory %q: %v", tmpAppPath, err)
+		}
+	}()
+
+	if err := extractOCIContentsToPath(ctx, p.podman, p.log, p.readWriter, p.spec.SystemdApp.Image, tmpAppPath); err != nil {
+		return fmt.Errorf("extracting OCI contents: %w", err)
+	}
+
+	if err := ensureSystemdUnits(p.readWriter, tmpAppPath, p.spec.ID); err != nil {
+		return fmt.Errorf("%w: verifying systemd units: %w", errors.ErrNoRetry, err)
+	}
+	return nil
+}
+
+func (p *systemdProvider) Install(ctx context.Context) error {
+	if err := extractOCIContentsToPath(ctx, p.podman, p.log, p.readWriter, p.spec.SystemdApp.Image, p.spec.Path); err != nil {
+		return fmt.Errorf("extracting OCI contents: %w", err)
+	}
+
+	if err := writeENVFile(p.spec.Path, p.readWriter, p.spec.EnvVars); err != nil {
+		return fmt.Errorf("writing env file: %w", err)
+	}
+
+	if err := installSystemdUnits(p.readWriter, p.spec); err != nil {
+		return fmt.Errorf("installing systemd units: %w", err)
+	}
+	return nil
+}
+
+func (p *systemdProvider) Remove(ctx context.Context) error {
+	if err := removeSystemdUnits(p.readWriter, p.spec); err != nil {
+		return fmt.Errorf("removing systemd units: %w", err)
+	}
+	if err := p.readWriter.RemoveAll(p.spec.Path); err != nil {
+		return fmt.Errorf("removing systemd app path: %w", err)
+	}
+	return nil
+}
+
+func (p *systemdProvider) Name() string {
+	return p.spec.Name
+}
+
+func (p *systemdProvider) ID() string {
+	return p.spec.ID
+}
+
+func (p *systemdProvider) Spec() *ApplicationSpec {
+	return p.spec
+}
+
+func (p *systemdProvider) collectOCITargets(_ context.Context, configProvider dependency.PullConfigResolver) (dependency.OCIPullTargetsByUser, error) {
+	var targets dependency.OCIPullTargetsByUser
+	return targets.Add(p.spec.User, dependency.OCIPullTarget{
+		Type:         dependency.OCITypeAuto,
+		Reference:    p.spec.SystemdApp.Image,
+		PullPolicy:   v1beta1.PullIfNotPresent,
+		ClientOptsFn: containerPullOptions(configProvider, p.spec.User),
+	}), nil
+}
+
+func (p *systemdProvider) extractNestedTargets(_ context.Context, _ dependency.PullConfigResolver) (*AppData, error) {
+	// systemd apps don't have nested targets to extract
+	return &AppData{}, nil
+}
+
+func (p *systemdProvider) parentIsAvailable(_ context.Context) (string, string, bool, error) {
+	// systemd apps don't have nested targets, so parent availability doesn't matter
+	return p.spec.SystemdApp.Image, "", true, nil
+}
+
+// systemdUnitFiles returns the names of the unit files at the top level of the application directory.
+func systemdUnitFiles(rw fileio.ReadWriter, appPath string) ([]string, error) {
+	entries, err := rw.ReadDir(appPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory: %w", err)
+	}
+
+	var units []string
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(systemdUnitExtensions, filepath.Ext(entry.Name())) {
+			continue
+		}
+		units = append(units, entry.Name())
+	}
+	slices.Sort(units)
+	return units, nil
+}
+
+// ensureSystemdUnits verifies that the application contains valid units and that they don't
+// replace units that are not installed by the application.
+func ensureSystemdUnits(rw fileio.ReadWriter, appPath string, appID string) error {
+	units, err := systemdUnitFiles(rw, appPath)
+	if err != nil {
+		return err
+	}
+	if len(units) == 0 {
+		return fmt.Errorf("no unit files found, expected files with extensions: %s", strings.Join(systemdUnitExtensions, ", "))
+	}
+
+	for _, unit := range units {
+		if !systemdUnitNameRegex.MatchString(unit) {
+			return fmt.Errorf("invalid unit name %q", unit)
+		}
+		if unit == lifecycle.SystemdTarget(appID) {
+			return fmt.Errorf("unit name %q is reserved", unit)
+		}
+		contents, err := rw.ReadFile(filepath.Join(appPath, unit))
+		if err != nil {
+			return fmt.Errorf("reading unit %s: %w", unit, err)
+		}
+		if _, err := quadlet.NewUnit(contents); err != nil {
+			return fmt.Errorf("parsing unit %s: %w", unit, err)
+		}
+
+		owner, err := systemdUnitOwner(rw, unit)
+		if err != nil {
+			return err
+		}
+		if owner == appID {
+			continue
+		}
+		for _, dir := range []string{lifecycle.SystemdUnitPath, systemdVendorUnitPath} {
+			exists, err := rw.PathExists(filepath.Join(dir, unit))
+			if err != nil {
+				return fmt.Errorf("checking unit %s: %w", unit, err)
+			}
+			if exists {
+				return fmt.Errorf("unit %s already exists in %s", unit, dir)
+			}
+		}
+	}
+	return nil
+}
+
+// systemdUnitOwner returns the ID of the application that installed the unit, or an empty string if
+// the unit was not installed by an application.
+func systemdUnitOwner(rw fileio.ReadWriter, unit string) (string, error) {
+	contents, err := rw.ReadFile(systemdUnitDropInPath(unit))
+	if err != nil {
+		if errors.Is(err, errors.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading drop-in of unit %s: %w", unit, err)
+	}
+	dropIn, err := quadlet.NewUnit(contents)
+	if err != nil {
+		return "", fmt.Errorf("parsing drop-in of unit %s: %w", unit, err)
+	}
+	owner, err := dropIn.Lookup("Unit", systemdAppKey)
+	if err != nil {
+		if errors.Is(err, quadlet.ErrKeyNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return owner, nil
+}
+
+func systemdUnitDropInPath(unit string) string {
+	return filepath.Join(lifecycle.SystemdUnitPath, unit+".d", quadletDropInFile)
+}
+
+// installSystemdUnits installs the units of a systemd application to the systemd unit directory.
+//
+// Occurrences of ${FLIGHTCTL_APP_DIR} in the unit files are replaced with the directory of the
+// application. Each unit is given a drop-in making it part of the target of the application and,
+// for services, loading the environment variables of the application. The target wants all of the
+// units that are not activated by another unit of the application.
+func installSystemdUnits(rw fileio.ReadWriter, spec *ApplicationSpec) error {
+	units, err := systemdUnitFiles(rw, spec.Path)
+	if err != nil {
+		return err
+	}
+
+	target := lifecycle.SystemdTarget(spec.ID)
+	targetUnit := quadlet.NewEmptyUnit()
+	targetUnit.Add("Unit", "Description", fmt.Sprintf("flightctl application %s", spec.Name))
+	for _, unit := range units {
+		contents, err := rw.ReadFile(filepath.Join(spec.Path, unit))
+		if err != nil {
+			return fmt.Errorf("reading unit %s: %w", unit, err)
+		}
+		contents = []byte(strings.ReplaceAll(string(contents), systemdAppDirVariable, spec.Path))
+		if err := rw.WriteFile(filepath.Join(lifecycle.SystemdUnitPath, unit), contents, fileio.DefaultFilePermissions); err != nil {
+			return fmt.Errorf("writing unit %s: %w", unit, err)
+		}
+
+		dropIn := quadlet.NewEmptyUnit()
+		dropIn.Add("Unit", "PartOf", target)
+		dropIn.Add("Unit", systemdAppKey, spec.ID)
+		if filepath.Ext(unit) == ".service" {
+			// the leading dash ignores the file if the application doesn't define environment variables
+			dropIn.Add("Service", "EnvironmentFile", "-"+filepath.Join(spec.Path, ".env"))
+		}
+		dropInContents, err := dropIn.Write()
+		if err != nil {
+			return fmt.Errorf("serializing drop-in: %w", err)
+		}
+		dropInPath := systemdUnitDropInPath(unit)
+		if err := rw.MkdirAll(filepath.Dir(dropInPath), fileio.DefaultDirectoryPermissions); err != nil {
+			return fmt.Errorf("creating drop-in directory: %w", err)
+		}
+		if err := rw.WriteFile(dropInPath, dropInContents, fileio.DefaultFilePermissions); err != nil {
+			return fmt.Errorf("writing drop-in of unit %s: %w", unit, err)
+		}
+
+		if isSystemdEntrypoint(unit, units) {
+			targetUnit.Add("Unit", "Wants", unit)
+			targetUnit.Add("Unit", "After", unit)
+		}
+	}
+
+	if err := makeSystemdBinariesExecutable(rw, spec.Path); err != nil {
+		return err
+	}
+
+	contents, err := targetUnit.Write()
+	if err != nil {
+		return fmt.Errorf("serializing target: %w", err)
+	}
+	if err := rw.WriteFile(filepath.Join(lifecycle.SystemdUnitPath, target), contents, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing target: %w", err)
+	}
+	return nil
+}
+
+// isSystemdEntrypoint returns true if the unit is started by the target of the application. Template
+// units can't be started directly and services activated by a timer, socket or path unit are started
+// by that unit.
+func isSystemdEntrypoint(unit string, units []string) bool {
+	ext := filepath.Ext(unit)
+	base := strings.TrimSuffix(unit, ext)
+	if strings.HasSuffix(base, "@") {
+		return false
+	}
+	if ext != ".service" {
+		return true
+	}
+	return !slices.ContainsFunc(systemdActivatorExtensions, func(activator string) bool {
+		return slices.Contains(units, base+activator)
+	})
+}
+
+// makeSystemdBinariesExecutable makes the files in the bin directory of the application executable,
+// as file modes are not preserved by all OCI artifacts.
+func makeSystemdBinariesExecutable(rw fileio.ReadWriter, appPath string) error {
+	binPath := filepath.Join(appPath, systemdAppBinDir)
+	exists, err := rw.PathExists(binPath)
+	if err != nil {
+		return fmt.Errorf("checking bin directory: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	entries, err := rw.ReadDir(binPath)
+	if err != nil {
+		return fmt.Errorf("reading bin directory: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		path := filepath.Join(binPath, entry.Name())
+		contents, err := rw.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading binary %s: %w", entry.Name(), err)
+		}
+		if err := rw.WriteFile(path, contents, fileio.DefaultExecutablePermissions); err != nil {
+			return fmt.Errorf("writing binary %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// removeSystemdUnits removes the units installed by a systemd application along with its target.
+// Units not installed by the application are left untouched.
+func removeSystemdUnits(rw fileio.ReadWriter, spec *ApplicationSpec) error {
+	exists, err := rw.PathExists(spec.Path)
+	if err != nil {
+		return fmt.Errorf("checking app path: %w", err)
+	}
+
+	var units []string
+	if exists {
+		units, err = systemdUnitFiles(rw, spec.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, unit := range units {
+		owner, err := systemdUnitOwner(rw, unit)
+		if err != nil {
+			return err
+		}
+		if owner != spec.ID {
+			continue
+		}
+		if err := rw.RemoveFile(filepath.Join(lifecycle.SystemdUnitPath, unit)); err != nil {
+			return fmt.Errorf("removing unit %s: %w", unit, err)
+		}
+		if err := rw.RemoveFile(systemdUnitDropInPath(unit)); err != nil {
+			return fmt.Errorf("removing drop-in of unit %s: %w", unit, err)
+		}
+	}
+
+	if err := rw.RemoveFile(filepath.Join(lifecycle.SystemdUnitPath, lifecycle.SystemdTarget(spec.ID))); err != nil {
+		return fmt.Errorf("removing target: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/stretchr/testify/require"
+)
+
+const testSystemdService = `[Unit]
+Description=Sensor reader
+
+[Service]
+ExecStart=${FLIGHTCTL_APP_DIR}/bin/reader
+`
+
+const testSystemdTimer = `[Timer]
+OnCalendar=hourly
+`
+
+func newTestSystemdSpec(t *testing.T, rw fileio.ReadWriter, files map[string]string) *ApplicationSpec {
+	spec := &ApplicationSpec{
+		Name:    "sensors",
+		ID:      lifecycle.GenerateAppID("sensors", v1beta1.CurrentProcessUsername),
+		User:    v1beta1.CurrentProcessUsername,
+		AppType: v1beta1.AppTypeSystemd,
+		Path:    filepath.Join(lifecycle.SystemdAppPath, "sensors"),
+	}
+	for name, contents := range files {
+		path := filepath.Join(spec.Path, name)
+		require.NoError(t, rw.MkdirAll(filepath.Dir(path), fileio.DefaultDirectoryPermissions))
+		require.NoError(t, rw.WriteFile(path, []byte(contents), fileio.DefaultFilePermissions))
+	}
+	return spec
+}
+
+func TestInstallSystemdUnits(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	spec := newTestSystemdSpec(t, rw, map[string]string{
+		"reader.service":  testSystemdService,
+		"rotate.service":  testSystemdService,
+		"rotate.timer":    testSystemdTimer,
+		"worker@.service": testSystemdService,
+		"bin/reader":      "binary",
+		"README.md":       "docs",
+	})
+
+	require.NoError(ensureSystemdUnits(rw, spec.Path, spec.ID))
+	require.NoError(installSystemdUnits(rw, spec))
+
+	// the app directory is substituted in the installed units
+	contents, err := rw.ReadFile(filepath.Join(lifecycle.SystemdUnitPath, "reader.service"))
+	require.NoError(err)
+	require.Contains(string(contents), "ExecStart="+spec.Path+"/bin/reader")
+
+	dropIn, err := rw.ReadFile(systemdUnitDropInPath("reader.service"))
+	require.NoError(err)
+	require.Contains(string(dropIn), "PartOf="+lifecycle.SystemdTarget(spec.ID))
+	require.Contains(string(dropIn), "EnvironmentFile=-"+spec.Path+"/.env")
+	dropIn, err = rw.ReadFile(systemdUnitDropInPath("rotate.timer"))
+	require.NoError(err)
+	require.NotContains(string(dropIn), "EnvironmentFile")
+
+	// the target starts the units not activated by another unit
+	target, err := rw.ReadFile(filepath.Join(lifecycle.SystemdUnitPath, lifecycle.SystemdTarget(spec.ID)))
+	require.NoError(err)
+	require.Contains(string(target), "Wants=reader.service")
+	require.Contains(string(target), "Wants=rotate.timer")
+	require.NotContains(string(target), "Wants=rotate.service")
+	require.NotContains(string(target), "Wants=worker@.service")
+
+	info, err := os.Stat(rw.PathFor(filepath.Join(spec.Path, "bin/reader")))
+	require.NoError(err)
+	require.Equal(fileio.DefaultExecutablePermissions, info.Mode().Perm())
+
+	exists, err := rw.PathExists(filepath.Join(lifecycle.SystemdUnitPath, "README.md"))
+	require.NoError(err)
+	require.False(exists)
+
+	// reinstalling the application doesn't conflict with its own units
+	require.NoError(ensureSystemdUnits(rw, spec.Path, spec.ID))
+
+	require.NoError(removeSystemdUnits(rw, spec))
+	for _, unit := range []string{"reader.service", "rotate.timer", lifecycle.SystemdTarget(spec.ID)} {
+		exists, err := rw.PathExists(filepath.Join(lifecycle.SystemdUnitPath, unit))
+		require.NoError(err)
+		require.False(exists, unit)
+	}
+}
+
+func TestEnsureSystemdUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		existing map[string]string
+		wantErr  bool
+	}{
+		{
+			name:  "valid",
+			files: map[string]string{"reader.service": testSystemdService},
+		},
+		{
+			name:    "no units",
+			files:   map[string]string{"bin/reader": "binary"},
+			wantErr: true,
+		},
+		{
+			name:     "conflicts with unmanaged unit",
+			files:    map[string]string{"sshd.service": testSystemdService},
+			existing: map[string]string{"/usr/lib/systemd/system/sshd.service": testSystemdService},
+			wantErr:  true,
+		},
+		{
+			name:  "conflicts with unit of another application",
+			files: map[string]string{"reader.service": testSystemdService},
+			existing: map[string]string{
+				"/etc/systemd/system/reader.service":                     testSystemdService,
+				"/etc/systemd/system/reader.service.d/99-flightctl.conf": "[Unit]\nX-Flightctl-App=other\n",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			tmpDir := t.TempDir()
+			rw := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+			)
+			spec := newTestSystemdSpec(t, rw, tt.files)
+			for path, contents := range tt.existing {
+				require.NoError(rw.MkdirAll(filepath.Dir(path), fileio.DefaultDirectoryPermissions))
+				require.NoError(rw.WriteFile(path, []byte(contents), fileio.DefaultFilePermissions))
+			}
+
+			err := ensureSystemdUnits(rw, spec.Path, spec.ID)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
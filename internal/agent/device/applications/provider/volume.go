@@ -120,6 +120,25 @@ func (m *volumeManager) volumeID(volName string, user v1beta1.Username) string {
 	}
 }
 
+// ResolveVolume returns the name of the podman volume backing the named volume of an application,
+// together with the user whose podman storage holds it.
+func ResolveVolume(appSpec *v1beta1.ApplicationProviderSpec, volName string) (string, v1beta1.Username, error) {
+	appName, err := ResolveImageAppName(appSpec)
+	if err != nil {
+		return "", "", err
+	}
+	appType, err := appSpec.GetAppType()
+	if err != nil {
+		return "", "", fmt.Errorf("getting app type: %w", err)
+	}
+	user, err := ResolveUser(appSpec)
+	if err != nil {
+		return "", "", err
+	}
+	m := &volumeManager{appName: appName, appType: appType, user: user}
+	return m.volumeID(volName, user), user, nil
+}
+
 func (m *volumeManager) Get(id string) (*Volume, bool) {
 	vol, ok := m.volumes[id]
 	return vol, ok
@@ -0,0 +1,168 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// SystemdMonitor monitors applications made of native binaries run directly by systemd. Unlike
+// container applications there is no event stream to follow, so the state of the units of the
+// applications is refreshed each time the status is collected.
+type SystemdMonitor struct {
+	*monitor
+
+	systemdFactory systemd.ManagerFactory
+}
+
+// NewSystemdMonitor creates a new SystemdMonitor.
+func NewSystemdMonitor(log *log.PrefixLogger, systemdFactory systemd.ManagerFactory) *SystemdMonitor {
+	m := newMonitor(log, "systemd",
+		handlerRegistration{
+			appType: v1beta1.AppTypeSystemd,
+			handler: lifecycle.NewSystemd(log, systemdFactory),
+		},
+	)
+
+	return &SystemdMonitor{
+		monitor:        m,
+		systemdFactory: systemdFactory,
+	}
+}
+
+// Ensure adds an application to be monitored.
+func (m *SystemdMonitor) Ensure(app Application) error {
+	return m.monitor.Ensure(app)
+}
+
+// Remove removes an application from monitoring.
+func (m *SystemdMonitor) Remove(app Application) error {
+	return m.monitor.Remove(app)
+}
+
+// Update updates an existing application, preserving workloads from the old app.
+func (m *SystemdMonitor) Update(app Application) error {
+	return m.monitor.updateWithWorkloads(app)
+}
+
+// Drain stops and removes all applications.
+func (m *SystemdMonitor) Drain(ctx context.Context) error {
+	m.drainActions()
+
+	if err := m.drain(ctx); err != nil {
+		return err
+	}
+
+	return m.ExecuteActions(ctx)
+}
+
+// ExecuteActions executes all queued actions.
+func (m *SystemdMonitor) ExecuteActions(ctx context.Context) error {
+	if _, err := m.executeActions(ctx, nil); err != nil {
+		return fmt.Errorf("execute systemd actions: %w", err)
+	}
+	return nil
+}
+
+// Status refreshes the workloads of the monitored applications from systemd and returns their status.
+func (m *SystemdMonitor) Status(ctx context.Context) ([]AppStatusResult, error) {
+	for _, app := range m.getApps() {
+		if err := m.refreshWorkloads(ctx, app); err != nil {
+			m.log.Errorf("Failed to refresh units of systemd application %s: %v", app.Name(), err)
+		}
+	}
+	return m.monitor.Status()
+}
+
+// refreshWorkloads replaces the workloads of the application with the units wanted by its target.
+func (m *SystemdMonitor) refreshWorkloads(ctx context.Context, app Application) error {
+	systemctl, err := m.systemdFactory(app.User())
+	if err != nil {
+		return fmt.Errorf("creating systemd client: %w", err)
+	}
+
+	deps, err := systemctl.ListDependencies(ctx, lifecycle.SystemdTarget(app.ID()))
+	if err != nil {
+		return fmt.Errorf("listing dependencies: %w", err)
+	}
+	var units []string
+	for _, unit := range deps {
+		if filepath.Ext(unit) != ".target" {
+			units = append(units, unit)
+		}
+	}
+
+	var entries []client.SystemDUnitListEntry
+	if len(units) > 0 {
+		entries, err = systemctl.ListUnitsByMatchPattern(ctx, units)
+		if err != nil {
+			return fmt.Errorf("listing units: %w", err)
+		}
+	}
+
+	workloads := make([]Workload, 0, len(entries))
+	for _, entry := range entries {
+		workloads = append(workloads, Workload{
+			ID:       entry.Unit,
+			Name:     entry.Unit,
+			Unit:     entry.Unit,
+			Status:   systemdUnitStatus(entry),
+			Restarts: m.unitRestarts(ctx, systemctl, entry.Unit),
+		})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.apps[app.ID()]; !ok {
+		return nil
+	}
+	app.ClearWorkloads()
+	for i := range workloads {
+		app.AddWorkload(&workloads[i])
+	}
+	return nil
+}
+
+func (m *SystemdMonitor) unitRestarts(ctx context.Context, systemctl systemd.Manager, unit string) int {
+	if filepath.Ext(unit) != ".service" {
+		return 0
+	}
+	restarts, err := systemctl.Show(ctx, unit, client.WithShowRestarts())
+	if err != nil || len(restarts) == 0 {
+		m.log.Debugf("Could not show systemd unit: %s restarts: %v", unit, err)
+		return 0
+	}
+	count, err := strconv.Atoi(restarts[0])
+	if err != nil {
+		m.log.Debugf("Could not parse systemd unit restarts: %v", err)
+		return 0
+	}
+	return count
+}
+
+// systemdUnitStatus maps the state of a unit to the status of a workload. Services that ran to
+// completion are reported as exited, while timer, socket and path units are running as long as
+// they are waiting to be triggered.
+func systemdUnitStatus(entry client.SystemDUnitListEntry) StatusType {
+	switch v1beta1.SystemdActiveStateType(entry.ActiveState) {
+	case v1beta1.SystemdActiveStateActive:
+		if entry.SubState == "exited" {
+			return StatusExited
+		}
+		return StatusRunning
+	case v1beta1.SystemdActiveStateActivating, v1beta1.SystemdActiveStateReloading,
+		v1beta1.SystemdActiveStateRefreshing, v1beta1.SystemdActiveStateDeactivating:
+		return StatusInit
+	case v1beta1.SystemdActiveStateFailed:
+		return StatusDied
+	default:
+		return StatusExited
+	}
+}
@@ -0,0 +1,105 @@
+package applications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSystemdMonitorStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		units       []client.SystemDUnitListEntry
+		wantStatus  v1beta1.ApplicationStatusType
+		wantSummary v1beta1.ApplicationsSummaryStatusType
+		wantReady   string
+	}{
+		{
+			name: "running",
+			units: []client.SystemDUnitListEntry{
+				{Unit: "reader.service", ActiveState: "active", SubState: "running"},
+				{Unit: "rotate.timer", ActiveState: "active", SubState: "waiting"},
+			},
+			wantStatus:  v1beta1.ApplicationStatusRunning,
+			wantSummary: v1beta1.ApplicationsSummaryStatusHealthy,
+			wantReady:   "2/2",
+		},
+		{
+			name: "starting",
+			units: []client.SystemDUnitListEntry{
+				{Unit: "reader.service", ActiveState: "active", SubState: "running"},
+				{Unit: "writer.service", ActiveState: "activating", SubState: "start"},
+			},
+			wantStatus:  v1beta1.ApplicationStatusStarting,
+			wantSummary: v1beta1.ApplicationsSummaryStatusDegraded,
+			wantReady:   "1/2",
+		},
+		{
+			name: "completed",
+			units: []client.SystemDUnitListEntry{
+				{Unit: "setup.service", ActiveState: "active", SubState: "exited"},
+			},
+			wantStatus:  v1beta1.ApplicationStatusCompleted,
+			wantSummary: v1beta1.ApplicationsSummaryStatusHealthy,
+			wantReady:   "0/1",
+		},
+		{
+			name: "failed",
+			units: []client.SystemDUnitListEntry{
+				{Unit: "reader.service", ActiveState: "failed", SubState: "failed"},
+			},
+			wantStatus:  v1beta1.ApplicationStatusError,
+			wantSummary: v1beta1.ApplicationsSummaryStatusError,
+			wantReady:   "0/1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			logger := log.NewPrefixLogger("test")
+
+			volumeManager, err := provider.NewVolumeManager(logger, "sensors", v1beta1.AppTypeSystemd, v1beta1.CurrentProcessUsername, nil)
+			require.NoError(err)
+			spec := &provider.ApplicationSpec{
+				Name:    "sensors",
+				ID:      lifecycle.GenerateAppID("sensors", v1beta1.CurrentProcessUsername),
+				AppType: v1beta1.AppTypeSystemd,
+				Volume:  volumeManager,
+			}
+			mockProvider := provider.NewMockProvider(ctrl)
+			mockProvider.EXPECT().Spec().Return(spec).AnyTimes()
+
+			units := make([]string, 0, len(tt.units))
+			for _, u := range tt.units {
+				units = append(units, u.Unit)
+			}
+			mockSystemdMgr := systemd.NewMockManager(ctrl)
+			mockSystemdMgr.EXPECT().ListDependencies(gomock.Any(), lifecycle.SystemdTarget(spec.ID)).
+				Return(append(units, "app.target"), nil)
+			mockSystemdMgr.EXPECT().ListUnitsByMatchPattern(gomock.Any(), units).Return(tt.units, nil)
+			mockSystemdMgr.EXPECT().Show(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"0"}, nil).AnyTimes()
+
+			m := NewSystemdMonitor(logger, func(v1beta1.Username) (systemd.Manager, error) {
+				return mockSystemdMgr, nil
+			})
+			require.NoError(m.Ensure(NewApplication(mockProvider)))
+
+			results, err := m.Status(context.Background())
+			require.NoError(err)
+			require.Len(results, 1)
+			require.Equal(tt.wantStatus, results[0].Status.Status)
+			require.Equal(tt.wantSummary, results[0].Summary.Status)
+			require.Equal(tt.wantReady, results[0].Status.Ready)
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultUsageSamplingInterval = resource.DefaultSamplingInterval
+	usageSampleTimeout           = 30 * time.Second
+)
+
+// usageSample is a sample of the resource usage of an application.
+type usageSample struct {
+	// cpuPercentage is the CPU usage as a percentage of the CPU capacity of the device.
+	cpuPercentage float64
+	memoryBytes   uint64
+	// memoryPercentage is the memory usage as a percentage of the memory limits of the
+	// application, or of the memory of the device.
+	memoryPercentage float64
+	// volumeBytes is the disk space used by the volumes of the application, if it has any.
+	volumeBytes *uint64
+}
+
+// usageSampler samples the resource usage of an application.
+type usageSampler func(ctx context.Context) (*usageSample, error)
+
+// usageMonitor periodically samples the resource usage of an application and evaluates the alert
+// rules of its resource monitors.
+type usageMonitor struct {
+	log      *log.PrefixLogger
+	appName  string
+	sample   usageSampler
+	interval time.Duration
+	// cpuAlerts and memoryAlerts are nil if the application has no monitor of that type.
+	cpuAlerts    []*resource.Alert
+	memoryAlerts []*resource.Alert
+	cancel       context.CancelFunc
+
+	mu    sync.Mutex
+	usage *v1beta1.ApplicationResourceUsage
+}
+
+func newUsageMonitor(log *log.PrefixLogger, appName string, monitors []v1beta1.ResourceMonitor, sample usageSampler) (*usageMonitor, error) {
+	m := &usageMonitor{
+		log:     log,
+		appName: appName,
+		sample:  sample,
+	}
+
+	for i := range monitors {
+		monitorType, err := monitors[i].Discriminator()
+		if err != nil {
+			return nil, err
+		}
+
+		var spec v1beta1.ResourceMonitorSpec
+		var alerts *[]*resource.Alert
+		switch monitorType {
+		case resource.CPUMonitorType:
+			cpuSpec, err := monitors[i].AsCpuResourceMonitorSpec()
+			if err != nil {
+				return nil, err
+			}
+			spec = v1beta1.ResourceMonitorSpec{AlertRules: cpuSpec.AlertRules, SamplingInterval: cpuSpec.SamplingInterval}
+			alerts = &m.cpuAlerts
+		case resource.MemoryMonitorType:
+			memorySpec, err := monitors[i].AsMemoryResourceMonitorSpec()
+			if err != nil {
+				return nil, err
+			}
+			spec = v1beta1.ResourceMonitorSpec{AlertRules: memorySpec.AlertRules, SamplingInterval: memorySpec.SamplingInterval}
+			alerts = &m.memoryAlerts
+		default:
+			return nil, fmt.Errorf("unsupported application resource monitor type: %s", monitorType)
+		}
+
+		interval, err := time.ParseDuration(spec.SamplingInterval)
+		if err != nil {
+			return nil, err
+		}
+		// sample as often as the most frequent monitor requires
+		if m.interval == 0 || interval < m.interval {
+			m.interval = interval
+		}
+
+		*alerts = make([]*resource.Alert, 0, len(spec.AlertRules))
+		for _, rule := range spec.AlertRules {
+			alert, err := resource.NewAlert(rule)
+			if err != nil {
+				return nil, err
+			}
+			*alerts = append(*alerts, alert)
+		}
+	}
+
+	if m.interval == 0 {
+		m.interval = defaultUsageSamplingInterval
+	}
+	return m, nil
+}
+
+// Run samples the usage of the application every interval until the context is canceled. The
+// first sample is taken after an interval, once the application had time to start.
+func (m *usageMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.sync(ctx)
+		}
+	}
+}
+
+func (m *usageMonitor) sync(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, usageSampleTimeout)
+	defer cancel()
+
+	sample, err := m.sample(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			m.log.Warnf("Failed to sample resource usage of application %s: %v", m.appName, err)
+		}
+		return
+	}
+	m.record(sample, time.Now())
+}
+
+// record stores a sample and evaluates the alert rules against it.
+func (m *usageMonitor) record(sample *usageSample, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.usage = &v1beta1.ApplicationResourceUsage{
+		CpuPercentage:    float32(sample.cpuPercentage),
+		MemoryBytes:      int64(sample.memoryBytes), //nolint:gosec
+		MemoryPercentage: float32(sample.memoryPercentage),
+		LastSampleTime:   now,
+	}
+	if sample.volumeBytes != nil {
+		m.usage.VolumeBytes = lo.ToPtr(int64(*sample.volumeBytes)) //nolint:gosec
+	}
+
+	m.log.Tracef("Application %s usage: cpu %.1f%%, memory %.1f%%", m.appName, sample.cpuPercentage, sample.memoryPercentage)
+	for _, alert := range m.cpuAlerts {
+		alert.Sync(int64(sample.cpuPercentage))
+	}
+	for _, alert := range m.memoryAlerts {
+		alert.Sync(int64(sample.memoryPercentage))
+	}
+}
+
+// Status returns the last sampled usage of the application, and the status of its resources if it
+// has resource monitors.
+func (m *usageMonitor) Status() (*v1beta1.ApplicationResourceUsage, *v1beta1.ApplicationResourceStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var usage *v1beta1.ApplicationResourceUsage
+	if m.usage != nil {
+		usage = lo.ToPtr(*m.usage)
+	}
+	if m.cpuAlerts == nil && m.memoryAlerts == nil {
+		return usage, nil
+	}
+	return usage, &v1beta1.ApplicationResourceStatus{
+		Cpu:    firingAlertsStatus(m.cpuAlerts),
+		Memory: firingAlertsStatus(m.memoryAlerts),
+	}
+}
+
+// firingAlertsStatus returns the resource status matching the most severe firing alert.
+func firingAlertsStatus(alerts []*resource.Alert) v1beta1.DeviceResourceStatusType {
+	highest := resource.AlertLevelMap[v1beta1.ResourceAlertSeverityTypeInfo]
+	for _, alert := range alerts {
+		if !alert.IsFiring() {
+			continue
+		}
+		if severity := resource.AlertLevelMap[alert.Severity]; severity.Level > highest.Level {
+			highest = severity
+		}
+	}
+	return highest.Status
+}
+
+// usageMonitors holds the usage monitors running for applications by application ID. Callers
+// synchronize access.
+type usageMonitors map[string]*usageMonitor
+
+// start runs the usage monitor of an application, replacing any monitor already running for it.
+func (u usageMonitors) start(ctx context.Context, appID string, monitor *usageMonitor) {
+	u.stop(appID)
+	ctx, monitor.cancel = context.WithCancel(ctx)
+	u[appID] = monitor
+	go monitor.Run(ctx)
+}
+
+func (u usageMonitors) stop(appID string) {
+	monitor, ok := u[appID]
+	if !ok {
+		return
+	}
+	monitor.cancel()
+	delete(u, appID)
+}
+
+func (u usageMonitors) stopAll() {
+	for appID := range u {
+		u.stop(appID)
+	}
+}
+
+// status returns the usage and resource status of an application, if it is monitored.
+func (u usageMonitors) status(appID string) (*v1beta1.ApplicationResourceUsage, *v1beta1.ApplicationResourceStatus) {
+	monitor, ok := u[appID]
+	if !ok {
+		return nil, nil
+	}
+	return monitor.Status()
+}
+
+// applyUsageResults adds the resource usage of an application to its status. An application
+// with a resource alert firing isn't healthy.
+func applyUsageResults(status *v1beta1.DeviceApplicationStatus, summary *v1beta1.DeviceApplicationsSummaryStatus, usage *v1beta1.ApplicationResourceUsage, resources *v1beta1.ApplicationResourceStatus) {
+	status.Usage = usage
+	status.Resources = resources
+	if resources == nil || summary.Status != v1beta1.ApplicationsSummaryStatusHealthy {
+		return
+	}
+	if resources.Cpu != v1beta1.DeviceResourceStatusHealthy || resources.Memory != v1beta1.DeviceResourceStatusHealthy {
+		summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+	}
+}
+
+// podmanUsageSampler samples the usage of the containers and volumes of a podman application.
+type podmanUsageSampler struct {
+	podman       func() (*client.Podman, error)
+	workloads    func() []Workload
+	volumes      func() []string
+	deviceMemory func() (uint64, error)
+	numCPU       int
+	// previous holds the last stats of each container to compute their CPU usage over the interval.
+	previous map[string]client.PodmanContainerStats
+}
+
+func (s *podmanUsageSampler) sample(ctx context.Context) (*usageSample, error) {
+	podman, err := s.podman()
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []string
+	for _, workload := range s.workloads() {
+		if workload.Status == StatusRunning {
+			containers = append(containers, workload.ID)
+		}
+	}
+
+	sample := &usageSample{}
+	if len(containers) > 0 {
+		stats, err := podman.ContainerStats(ctx, containers...)
+		if err != nil {
+			return nil, err
+		}
+		deviceMemory, err := s.deviceMemory()
+		if err != nil {
+			return nil, err
+		}
+		s.aggregate(sample, stats, deviceMemory)
+	}
+
+	volumes := s.volumes()
+	if len(volumes) > 0 {
+		var volumeBytes uint64
+		for _, volume := range volumes {
+			mountpoint, err := podman.InspectVolumeMount(ctx, volume)
+			if err != nil {
+				return nil, err
+			}
+			size, err := dirSize(mountpoint)
+			if err != nil {
+				return nil, fmt.Errorf("volume %s: %w", volume, err)
+			}
+			volumeBytes += size
+		}
+		sample.volumeBytes = &volumeBytes
+	}
+	return sample, nil
+}
+
+// aggregate adds the stats of the containers of an application to its sample. The memory limit of
+// the application is the sum of the limits of its containers, capped at the memory of the device
+// as containers without limits report the memory of the device.
+func (s *podmanUsageSampler) aggregate(sample *usageSample, stats []client.PodmanContainerStats, deviceMemory uint64) {
+	current := make(map[string]client.PodmanContainerStats, len(stats))
+	var cpuCores float64
+	var memoryLimit uint64
+	for _, containerStats := range stats {
+		current[containerStats.ContainerID] = containerStats
+		cpuCores += containerCPUCores(s.previous[containerStats.ContainerID], containerStats)
+		sample.memoryBytes += containerStats.MemUsage
+		memoryLimit += containerStats.MemLimit
+	}
+	s.previous = current
+
+	if s.numCPU > 0 {
+		sample.cpuPercentage = cpuCores / float64(s.numCPU) * 100
+	}
+	if deviceMemory > 0 && (memoryLimit == 0 || memoryLimit > deviceMemory) {
+		memoryLimit = deviceMemory
+	}
+	if memoryLimit > 0 {
+		sample.memoryPercentage = float64(sample.memoryBytes) / float64(memoryLimit) * 100
+	}
+}
+
+// containerCPUCores returns the number of cores used by a container since its previous stats,
+// falling back on the usage reported by podman for the first sample.
+func containerCPUCores(previous, current client.PodmanContainerStats) float64 {
+	if previous.SystemNano == 0 || current.SystemNano <= previous.SystemNano || current.CPUNano < previous.CPUNano {
+		return current.CPU / 100
+	}
+	return float64(current.CPUNano-previous.CPUNano) / float64(current.SystemNano-previous.SystemNano)
+}
+
+// kubernetesUsageSampler samples the usage of the pods of a Kubernetes application.
+type kubernetesUsageSampler struct {
+	podStats     func(ctx context.Context) ([]client.KubePodStats, error)
+	workloads    func() []Workload
+	namespace    string
+	deviceMemory func() (uint64, error)
+	numCPU       int
+}
+
+func (s *kubernetesUsageSampler) sample(ctx context.Context) (*usageSample, error) {
+	stats, err := s.podStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deviceMemory, err := s.deviceMemory()
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string]struct{})
+	for _, workload := range s.workloads() {
+		pods[workload.Name] = struct{}{}
+	}
+
+	sample := &usageSample{volumeBytes: lo.ToPtr(uint64(0))}
+	var nanoCores uint64
+	for _, podStats := range stats {
+		if _, ok := pods[podStats.Name]; !ok || (s.namespace != "" && podStats.Namespace != s.namespace) {
+			continue
+		}
+		nanoCores += podStats.CPUUsageNanoCores
+		sample.memoryBytes += podStats.MemoryWorkingSetBytes
+		*sample.volumeBytes += podStats.VolumeUsedBytes
+	}
+
+	if s.numCPU > 0 {
+		sample.cpuPercentage = float64(nanoCores) / 1e9 / float64(s.numCPU) * 100
+	}
+	if deviceMemory > 0 {
+		sample.memoryPercentage = float64(sample.memoryBytes) / float64(deviceMemory) * 100
+	}
+	return sample, nil
+}
+
+// dirSize returns the total size of the regular files under a directory.
+func dirSize(root string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files may be removed by the application while walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += uint64(info.Size()) //nolint:gosec
+		return nil
+	})
+	return size, err
+}
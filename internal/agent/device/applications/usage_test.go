@@ -0,0 +1,166 @@
+package applications
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newCPUResourceMonitor(t *testing.T, samplingInterval string, rules ...v1beta1.ResourceAlertRule) v1beta1.ResourceMonitor {
+	var monitor v1beta1.ResourceMonitor
+	require.NoError(t, monitor.FromCpuResourceMonitorSpec(v1beta1.CpuResourceMonitorSpec{
+		MonitorType:      "CPU",
+		SamplingInterval: samplingInterval,
+		AlertRules:       rules,
+	}))
+	return monitor
+}
+
+func TestUsageMonitor(t *testing.T) {
+	require := require.New(t)
+
+	monitors := []v1beta1.ResourceMonitor{
+		newCPUResourceMonitor(t, "30s", v1beta1.ResourceAlertRule{
+			Severity:   v1beta1.ResourceAlertSeverityTypeCritical,
+			Percentage: 90,
+			Duration:   "0s",
+		}),
+	}
+	m, err := newUsageMonitor(log.NewPrefixLogger("test"), "app", monitors, nil)
+	require.NoError(err)
+	require.Equal(30*time.Second, m.interval)
+
+	// nothing is reported until the first sample
+	usage, resources := m.Status()
+	require.Nil(usage)
+	require.Equal(&v1beta1.ApplicationResourceStatus{Cpu: v1beta1.DeviceResourceStatusHealthy, Memory: v1beta1.DeviceResourceStatusHealthy}, resources)
+
+	now := time.Now()
+	sample := &usageSample{cpuPercentage: 95, memoryBytes: 1024, memoryPercentage: 10, volumeBytes: lo.ToPtr(uint64(2048))}
+	m.record(sample, now)
+	time.Sleep(time.Millisecond)
+	m.record(sample, now)
+	usage, resources = m.Status()
+	require.Equal(&v1beta1.ApplicationResourceUsage{
+		CpuPercentage:    95,
+		MemoryBytes:      1024,
+		MemoryPercentage: 10,
+		VolumeBytes:      lo.ToPtr(int64(2048)),
+		LastSampleTime:   now,
+	}, usage)
+	require.Equal(v1beta1.DeviceResourceStatusCritical, resources.Cpu)
+	require.Equal(v1beta1.DeviceResourceStatusHealthy, resources.Memory)
+
+	// the alert resolves once the usage is back under the threshold
+	m.record(&usageSample{cpuPercentage: 10}, now)
+	_, resources = m.Status()
+	require.Equal(v1beta1.DeviceResourceStatusHealthy, resources.Cpu)
+}
+
+func TestUsageMonitorWithoutResourceMonitors(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newUsageMonitor(log.NewPrefixLogger("test"), "app", nil, nil)
+	require.NoError(err)
+	require.Equal(defaultUsageSamplingInterval, m.interval)
+
+	m.record(&usageSample{cpuPercentage: 95}, time.Now())
+	usage, resources := m.Status()
+	require.NotNil(usage)
+	require.Nil(resources)
+}
+
+func TestPodmanUsageSamplerAggregate(t *testing.T) {
+	require := require.New(t)
+	const gib = 1 << 30
+
+	s := &podmanUsageSampler{numCPU: 4}
+
+	// the first sample uses the CPU usage reported by podman, and containers without limits
+	// are capped at the memory of the device
+	sample := &usageSample{}
+	s.aggregate(sample, []client.PodmanContainerStats{
+		{ContainerID: "web", CPU: 100, CPUNano: 1e9, SystemNano: 10e9, MemUsage: gib, MemLimit: 8 * gib},
+		{ContainerID: "db", CPU: 60, CPUNano: 1e9, SystemNano: 10e9, MemUsage: gib, MemLimit: 8 * gib},
+	}, 8*gib)
+	require.InDelta(40, sample.cpuPercentage, 0.001)
+	require.Equal(uint64(2*gib), sample.memoryBytes)
+	require.InDelta(25, sample.memoryPercentage, 0.001)
+
+	// the next samples use the CPU time spent since the previous sample, and the memory limits
+	// of the containers
+	sample = &usageSample{}
+	s.aggregate(sample, []client.PodmanContainerStats{
+		{ContainerID: "web", CPU: 100, CPUNano: 3e9, SystemNano: 12e9, MemUsage: gib, MemLimit: 2 * gib},
+		{ContainerID: "db", CPU: 60, CPUNano: 1e9, SystemNano: 12e9, MemUsage: gib, MemLimit: 2 * gib},
+	}, 8*gib)
+	require.InDelta(25, sample.cpuPercentage, 0.001)
+	require.InDelta(50, sample.memoryPercentage, 0.001)
+}
+
+func TestKubernetesUsageSampler(t *testing.T) {
+	require := require.New(t)
+	const gib = 1 << 30
+
+	s := &kubernetesUsageSampler{
+		podStats: func(context.Context) ([]client.KubePodStats, error) {
+			return []client.KubePodStats{
+				{Name: "web-0", Namespace: "shop", CPUUsageNanoCores: 5e8, MemoryWorkingSetBytes: gib, VolumeUsedBytes: 4096},
+				{Name: "web-1", Namespace: "shop", CPUUsageNanoCores: 5e8, MemoryWorkingSetBytes: gib},
+				{Name: "web-0", Namespace: "other", CPUUsageNanoCores: 1e9, MemoryWorkingSetBytes: gib},
+				{Name: "unrelated", Namespace: "shop", CPUUsageNanoCores: 1e9, MemoryWorkingSetBytes: gib},
+			}, nil
+		},
+		workloads: func() []Workload {
+			return []Workload{{Name: "web-0"}, {Name: "web-1"}}
+		},
+		namespace:    "shop",
+		deviceMemory: func() (uint64, error) { return 8 * gib, nil },
+		numCPU:       2,
+	}
+
+	sample, err := s.sample(context.Background())
+	require.NoError(err)
+	require.InDelta(50, sample.cpuPercentage, 0.001)
+	require.Equal(uint64(2*gib), sample.memoryBytes)
+	require.InDelta(25, sample.memoryPercentage, 0.001)
+	require.Equal(uint64(4096), lo.FromPtr(sample.volumeBytes))
+}
+
+func TestApplyUsageResults(t *testing.T) {
+	require := require.New(t)
+
+	usage := &v1beta1.ApplicationResourceUsage{CpuPercentage: 95}
+	status := v1beta1.DeviceApplicationStatus{Name: "app", Status: v1beta1.ApplicationStatusRunning}
+	summary := v1beta1.DeviceApplicationsSummaryStatus{Status: v1beta1.ApplicationsSummaryStatusHealthy}
+	applyUsageResults(&status, &summary, usage, nil)
+	require.Equal(usage, status.Usage)
+	require.Equal(v1beta1.ApplicationsSummaryStatusHealthy, summary.Status)
+
+	resources := &v1beta1.ApplicationResourceStatus{Cpu: v1beta1.DeviceResourceStatusWarning, Memory: v1beta1.DeviceResourceStatusHealthy}
+	applyUsageResults(&status, &summary, usage, resources)
+	require.Equal(resources, status.Resources)
+	require.Equal(v1beta1.ApplicationsSummaryStatusDegraded, summary.Status)
+}
+
+func TestDirSize(t *testing.T) {
+	require := require.New(t)
+
+	root := t.TempDir()
+	require.NoError(os.WriteFile(filepath.Join(root, "a"), make([]byte, 100), 0600))
+	require.NoError(os.MkdirAll(filepath.Join(root, "sub"), 0700))
+	require.NoError(os.WriteFile(filepath.Join(root, "sub", "b"), make([]byte, 50), 0600))
+	require.NoError(os.Symlink(filepath.Join(root, "a"), filepath.Join(root, "link")))
+
+	size, err := dirSize(root)
+	require.NoError(err)
+	require.Equal(uint64(150), size)
+}
@@ -0,0 +1,3 @@
+package certmanager
+
+//go:generate go run -modfile=../../../../tools/go.mod go.uber.org/mock/mockgen -source=manager.go -destination=mock_manager.go -package=certmanager
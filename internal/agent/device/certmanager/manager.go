@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/certmanager/provider"
@@ -15,8 +16,10 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
 	"github.com/flightctl/flightctl/internal/agent/identity"
+	"github.com/flightctl/flightctl/internal/tpm"
 	pkgcertmanager "github.com/flightctl/flightctl/pkg/certmanager"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 const (
@@ -25,13 +28,22 @@ const (
 	certsBundleName       = "certs-config-yaml"
 	certsBundleConfigFile = "certs.yaml"
 
+	workloadBundleName = "device-workload"
+
 	defaultSyncInterval         = time.Hour
 	renewBeforeExpiryPercentage = 75
 )
 
+// WorkloadManager reconciles the workload certificates declared in the device spec.
+type WorkloadManager interface {
+	// SyncWorkloadCertificates applies the certificates of the desired device spec.
+	SyncWorkloadCertificates(ctx context.Context, desired *v1beta1.DeviceSpec) error
+}
+
 type AgentCertManager struct {
-	cm  *pkgcertmanager.CertManager
-	log *log.PrefixLogger
+	cm       *pkgcertmanager.CertManager
+	workload *provider.WorkloadConfigProvider
+	log      *log.PrefixLogger
 }
 
 // NewAgentCertManager wires the pkg certmanager with agent-specific providers/factories.
@@ -46,6 +58,10 @@ func NewAgentCertManager(
 	identityProvider identity.Provider,
 	statusManager status.Manager,
 	systemInfoManager systeminfo.Manager,
+	podmanFactory client.PodmanFactory,
+	rwFactory fileio.ReadWriterFactory,
+	systemdClient *client.Systemd,
+	tpmClient tpm.Client,
 ) (*AgentCertManager, error) {
 	if log == nil {
 		return nil, fmt.Errorf("logger is nil")
@@ -110,8 +126,7 @@ func NewAgentCertManager(
 		return nil, fmt.Errorf("new %q bundle: %w", managementBundleName, err)
 	}
 
-	certsBundle, err := pkgcertmanager.NewBundle(
-		certsBundleName,
+	certsBundleOpts := []pkgcertmanager.BundleOption{
 		pkgcertmanager.WithConfigProvider(
 			provider.NewDropInConfigProvider(readWriter, filepath.Join(cfg.ConfigDir, certsBundleConfigFile)),
 		),
@@ -121,22 +136,61 @@ func NewAgentCertManager(
 		pkgcertmanager.WithStorageFactory(
 			provider.NewFileSystemStorageFactory(readWriter),
 		),
-	)
+	}
+	// Keys of certificates using the TPM provisioner and storage are generated and held by the TPM.
+	if tpmClient != nil {
+		certsBundleOpts = append(certsBundleOpts,
+			pkgcertmanager.WithProvisionerFactory(
+				provider.NewTPMProvisionerFactory(deviceName, managementClient, tpmClient),
+			),
+			pkgcertmanager.WithStorageFactory(
+				provider.NewTPMStorageFactory(tpmClient, readWriter),
+			),
+		)
+	}
+	certsBundle, err := pkgcertmanager.NewBundle(certsBundleName, certsBundleOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("new %q bundle: %w", certsBundleName, err)
 	}
 
+	// Workload certificates declared in the device spec and delivered to files or applications.
+	workloadConfigProvider := provider.NewWorkloadConfigProvider()
+	workloadBundle, err := pkgcertmanager.NewBundle(
+		workloadBundleName,
+		pkgcertmanager.WithConfigProvider(workloadConfigProvider),
+		pkgcertmanager.WithProvisionerFactory(
+			provider.NewCSRProvisionerFactory(deviceName, managementClient, idFactory),
+		),
+		pkgcertmanager.WithProvisionerFactory(
+			provider.NewACMEProvisionerFactory(readWriter, cfg.DataDir),
+		),
+		pkgcertmanager.WithStorageFactory(
+			provider.WithSystemdReloadOnStore(systemdClient, provider.NewFileSystemStorageFactory(readWriter)),
+		),
+		pkgcertmanager.WithStorageFactory(
+			provider.WithSystemdReloadOnStore(systemdClient, provider.NewApplicationVolumeStorageFactory(podmanFactory, rwFactory)),
+		),
+		pkgcertmanager.WithStorageFactory(
+			provider.WithSystemdReloadOnStore(systemdClient, provider.NewApplicationSecretStorageFactory(podmanFactory)),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new %q bundle: %w", workloadBundleName, err)
+	}
+
 	cm, err := pkgcertmanager.NewManager(ctx, log,
 		pkgcertmanager.WithBundleProvider(managementBundle),
 		pkgcertmanager.WithBundleProvider(certsBundle),
+		pkgcertmanager.WithBundleProvider(workloadBundle),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("new cert manager: %w", err)
 	}
 
 	return &AgentCertManager{
-		cm:  cm,
-		log: log,
+		cm:       cm,
+		workload: workloadConfigProvider,
+		log:      log,
 	}, nil
 }
 
@@ -146,6 +200,49 @@ func (a *AgentCertManager) Sync(ctx context.Context, _ *config.Config) error {
 	return a.cm.Sync(ctx)
 }
 
+// SyncWorkloadCertificates reconciles the workload certificates with those declared in the desired spec.
+// Certificates are provisioned asynchronously; their progress is reported through Status.
+func (a *AgentCertManager) SyncWorkloadCertificates(ctx context.Context, desired *v1beta1.DeviceSpec) error {
+	a.workload.Update(desired)
+	return a.cm.SyncBundle(ctx, workloadBundleName)
+}
+
+// Status reports the validity of the workload certificates and the last error encountered for each.
+func (a *AgentCertManager) Status(_ context.Context, deviceStatus *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+	certificates := a.workload.Certificates()
+	if len(certificates) == 0 {
+		deviceStatus.Certificates = nil
+		return nil
+	}
+
+	managed, err := a.cm.BundleCertificates(workloadBundleName)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]pkgcertmanager.CertificateStatus, len(managed))
+	for _, c := range managed {
+		byName[c.Name] = c
+	}
+
+	statuses := make([]v1beta1.DeviceCertificateStatus, 0, len(certificates))
+	for _, cert := range certificates {
+		certStatus := v1beta1.DeviceCertificateStatus{Name: cert.Name}
+		if err := a.workload.Error(cert.Name); err != nil {
+			certStatus.Message = lo.ToPtr(err.Error())
+		}
+		if c, ok := byName[cert.Name]; ok {
+			certStatus.NotBefore = c.Info.NotBefore
+			certStatus.NotAfter = c.Info.NotAfter
+			if c.Error != "" {
+				certStatus.Message = lo.ToPtr(c.Error)
+			}
+		}
+		statuses = append(statuses, certStatus)
+	}
+	deviceStatus.Certificates = &statuses
+	return nil
+}
+
 // Run periodically calls Sync until ctx is canceled.
 func (a *AgentCertManager) Run(ctx context.Context) {
 	// First sync immediately.
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager.go
+//
+// Generated by this command:
+//
+//	mockgen -source=manager.go -destination=mock_manager.go -package=certmanager
+//
+
+// Package certmanager is a generated GoMock package.
+package certmanager
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkloadManager is a mock of WorkloadManager interface.
+type MockWorkloadManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkloadManagerMockRecorder
+}
+
+// MockWorkloadManagerMockRecorder is the mock recorder for MockWorkloadManager.
+type MockWorkloadManagerMockRecorder struct {
+	mock *MockWorkloadManager
+}
+
+// NewMockWorkloadManager creates a new mock instance.
+func NewMockWorkloadManager(ctrl *gomock.Controller) *MockWorkloadManager {
+	mock := &MockWorkloadManager{ctrl: ctrl}
+	mock.recorder = &MockWorkloadManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkloadManager) EXPECT() *MockWorkloadManagerMockRecorder {
+	return m.recorder
+}
+
+// SyncWorkloadCertificates mocks base method.
+func (m *MockWorkloadManager) SyncWorkloadCertificates(ctx context.Context, desired *v1beta1.DeviceSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncWorkloadCertificates", ctx, desired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncWorkloadCertificates indicates an expected call of SyncWorkloadCertificates.
+func (mr *MockWorkloadManagerMockRecorder) SyncWorkloadCertificates(ctx, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkloadCertificates", reflect.TypeOf((*MockWorkloadManager)(nil).SyncWorkloadCertificates), ctx, desired)
+}
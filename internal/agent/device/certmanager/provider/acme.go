@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	ProvisionerTypeACME certmanager.ProvisionerType = "acme"
+
+	// DefaultACMEHTTPPort is the port the HTTP-01 challenge responder listens on by default.
+	DefaultACMEHTTPPort = 80
+
+	acmeAccountKeyDir = "acme"
+	// acmeProvisionTimeout bounds a complete ACME order, including challenge validation.
+	acmeProvisionTimeout = 5 * time.Minute
+)
+
+// ACMEProvisionerConfig defines configuration for certificates obtained from an ACME (RFC 8555) server.
+// Domain ownership is proven with the HTTP-01 challenge served by the agent.
+type ACMEProvisionerConfig struct {
+	// DirectoryURL is the URL of the ACME server directory
+	DirectoryURL string `json:"directory-url"`
+	// Email is the contact address registered with the ACME account
+	Email string `json:"email,omitempty"`
+	// HTTPPort is the port the HTTP-01 challenge responder listens on
+	HTTPPort int `json:"http-port,omitempty"`
+	// CommonName is the common name for the certificate
+	CommonName string `json:"common-name,omitempty"`
+	// DNSNames are the DNS identifiers requested for the certificate
+	DNSNames []string `json:"dns-names,omitempty"`
+	// IPAddresses are the IP identifiers requested for the certificate
+	IPAddresses []string `json:"ip-addresses,omitempty"`
+}
+
+// ACMEProvisioner obtains certificates from an ACME server.
+// Each call to Provision runs a complete order: it authorizes the requested identifiers by answering
+// HTTP-01 challenges, finalizes the order with a freshly generated key and returns the issued chain.
+type ACMEProvisioner struct {
+	cfg *ACMEProvisionerConfig
+	// accountKeyPath is where the ACME account key is persisted
+	accountKeyPath string
+	readWriter     fileio.ReadWriter
+	log            certmanager.Logger
+}
+
+// NewACMEProvisioner creates a new ACME provisioner with the specified configuration.
+func NewACMEProvisioner(cfg *ACMEProvisionerConfig, accountKeyPath string, rw fileio.ReadWriter, log certmanager.Logger) *ACMEProvisioner {
+	return &ACMEProvisioner{
+		cfg:            cfg,
+		accountKeyPath: accountKeyPath,
+		readWriter:     rw,
+		log:            log,
+	}
+}
+
+// Provision runs an ACME order to completion and returns the issued certificate chain and key.
+func (p *ACMEProvisioner) Provision(ctx context.Context, _ certmanager.ProvisionRequest) (*certmanager.ProvisionResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, acmeProvisionTimeout)
+	defer cancel()
+
+	accountKey, err := p.accountKey()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &acme.Client{Key: accountKey, DirectoryURL: p.cfg.DirectoryURL}
+	account := &acme.Account{}
+	if p.cfg.Email != "" {
+		account.Contact = []string{"mailto:" + p.cfg.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("register ACME account: %w", err)
+	}
+
+	ids := acme.DomainIDs(p.cfg.DNSNames...)
+	ids = append(ids, acme.IPIDs(p.cfg.IPAddresses...)...)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one DNS name or IP address must be set")
+	}
+
+	order, err := client.AuthorizeOrder(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("authorize ACME order: %w", err)
+	}
+
+	responder, err := newHTTP01Responder(p.httpPort())
+	if err != nil {
+		return nil, err
+	}
+	defer responder.Close()
+
+	for _, authzURL := range order.AuthzURLs {
+		if err := p.authorize(ctx, client, responder, authzURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, fmt.Errorf("wait for ACME order: %w", err)
+	}
+
+	_, key, err := fccrypto.NewKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	csrDER, err := p.csr(key)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csrDER, true)
+	if err != nil {
+		return nil, fmt.Errorf("finalize ACME order: %w", err)
+	}
+
+	var certPEM []byte
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM, err := fccrypto.PEMEncodeKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encode key: %w", err)
+	}
+
+	return &certmanager.ProvisionResult{Ready: true, Cert: certPEM, Key: keyPEM}, nil
+}
+
+// authorize proves control of the identifier of a single authorization using the HTTP-01 challenge.
+func (p *ACMEProvisioner) authorize(ctx context.Context, client *acme.Client, responder *http01Responder, authzURL string) error {
+	authz, err := client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return fmt.Errorf("get ACME authorization: %w", err)
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "http-01" {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("ACME server offers no http-01 challenge for %q", authz.Identifier.Value)
+	}
+
+	response, err := client.HTTP01ChallengeResponse(challenge.Token)
+	if err != nil {
+		return fmt.Errorf("compute http-01 challenge response: %w", err)
+	}
+	path := client.HTTP01ChallengePath(challenge.Token)
+	responder.Set(path, response)
+	defer responder.Delete(path)
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("accept http-01 challenge for %q: %w", authz.Identifier.Value, err)
+	}
+	if _, err := client.WaitAuthorization(ctx, authzURL); err != nil {
+		return fmt.Errorf("wait for authorization of %q: %w", authz.Identifier.Value, err)
+	}
+
+	p.log.Debugf("ACME authorization of %q completed", authz.Identifier.Value)
+	return nil
+}
+
+func (p *ACMEProvisioner) csr(key crypto.PrivateKey) ([]byte, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("generated key is not a signer")
+	}
+
+	opts := []fccrypto.CSROption{fccrypto.WithDNSNames(p.cfg.DNSNames...)}
+	if len(p.cfg.IPAddresses) > 0 {
+		ips := make([]net.IP, 0, len(p.cfg.IPAddresses))
+		for _, s := range p.cfg.IPAddresses {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", s)
+			}
+			ips = append(ips, ip)
+		}
+		opts = append(opts, fccrypto.WithIPAddresses(ips...))
+	}
+
+	csrPEM, err := fccrypto.MakeCSR(signer, p.cfg.CommonName, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create CSR: %w", err)
+	}
+	block, _ := pem.Decode(csrPEM)
+	if block == nil {
+		return nil, fmt.Errorf("decode CSR")
+	}
+	return block.Bytes, nil
+}
+
+// accountKey loads the ACME account key, generating and persisting a new one on first use.
+func (p *ACMEProvisioner) accountKey() (crypto.Signer, error) {
+	exists, err := p.readWriter.PathExists(p.accountKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("check ACME account key: %w", err)
+	}
+
+	if exists {
+		keyPEM, err := p.readWriter.ReadFile(p.accountKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("read ACME account key: %w", err)
+		}
+		key, err := fccrypto.ParseKeyPEM(keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("parse ACME account key: %w", err)
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("ACME account key is not a signer")
+		}
+		return signer, nil
+	}
+
+	_, key, err := fccrypto.NewKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("generate ACME account key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("ACME account key is not a signer")
+	}
+	keyPEM, err := fccrypto.PEMEncodeKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encode ACME account key: %w", err)
+	}
+	if err := p.readWriter.MkdirAll(filepath.Dir(p.accountKeyPath), 0o700); err != nil {
+		return nil, fmt.Errorf("mkdir for ACME account key: %w", err)
+	}
+	if err := p.readWriter.WriteFile(p.accountKeyPath, keyPEM, 0o600); err != nil {
+		return nil, fmt.Errorf("write ACME account key: %w", err)
+	}
+	return signer, nil
+}
+
+func (p *ACMEProvisioner) httpPort() int {
+	if p.cfg.HTTPPort > 0 {
+		return p.cfg.HTTPPort
+	}
+	return DefaultACMEHTTPPort
+}
+
+// http01Responder serves the key authorizations of pending HTTP-01 challenges.
+type http01Responder struct {
+	mu        sync.RWMutex
+	responses map[string]string
+	server    *http.Server
+}
+
+func newHTTP01Responder(port int) (*http01Responder, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+	if err != nil {
+		return nil, fmt.Errorf("listen for http-01 challenges on port %d: %w", port, err)
+	}
+
+	r := &http01Responder{responses: make(map[string]string)}
+	r.server = &http.Server{
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() { _ = r.server.Serve(listener) }()
+	return r, nil
+}
+
+func (r *http01Responder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.RLock()
+	response, ok := r.responses[req.URL.Path]
+	r.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(response))
+}
+
+func (r *http01Responder) Set(path, response string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses[path] = response
+}
+
+func (r *http01Responder) Delete(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.responses, path)
+}
+
+func (r *http01Responder) Close() {
+	_ = r.server.Close()
+}
+
+// ACMEProvisionerFactory implements ProvisionerFactory for ACME provisioners.
+type ACMEProvisionerFactory struct {
+	// File I/O interface used to persist ACME account keys
+	rw fileio.ReadWriter
+	// Directory holding the ACME account keys, one per ACME directory
+	accountKeyDir string
+}
+
+// NewACMEProvisionerFactory creates a new ACMEProvisionerFactory persisting account keys under dataDir.
+func NewACMEProvisionerFactory(rw fileio.ReadWriter, dataDir string) *ACMEProvisionerFactory {
+	return &ACMEProvisionerFactory{
+		rw:            rw,
+		accountKeyDir: filepath.Join(dataDir, "certs", acmeAccountKeyDir),
+	}
+}
+
+// Type returns the provisioner type string used as map key in the certificate manager.
+func (f *ACMEProvisionerFactory) Type() string {
+	return string(ProvisionerTypeACME)
+}
+
+// New creates a new ACMEProvisioner based on the provided certificate config.
+func (f *ACMEProvisionerFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.ProvisionerProvider, error) {
+	var acmeConfig ACMEProvisionerConfig
+	if err := json.Unmarshal(cc.Provisioner.Config, &acmeConfig); err != nil {
+		return nil, fmt.Errorf("failed to decode ACME provisioner config for certificate %q: %w", cc.Name, err)
+	}
+	if acmeConfig.CommonName == "" {
+		acmeConfig.CommonName = cc.Name
+	}
+	return NewACMEProvisioner(&acmeConfig, f.accountKeyPath(acmeConfig.DirectoryURL), f.rw, log), nil
+}
+
+// Validate checks whether the provided config is valid for an ACME provisioner.
+func (f *ACMEProvisionerFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if cc.Provisioner.Type != ProvisionerTypeACME {
+		return fmt.Errorf("not an ACME provisioner")
+	}
+
+	var acmeConfig ACMEProvisionerConfig
+	if err := json.Unmarshal(cc.Provisioner.Config, &acmeConfig); err != nil {
+		return fmt.Errorf("failed to decode ACME provisioner config for certificate %q: %w", cc.Name, err)
+	}
+
+	u, err := url.Parse(acmeConfig.DirectoryURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("directory-url must be an https URL for ACME provisioner in certificate %q", cc.Name)
+	}
+	if acmeConfig.HTTPPort < 0 || acmeConfig.HTTPPort > 65535 {
+		return fmt.Errorf("invalid http-port %d for ACME provisioner in certificate %q", acmeConfig.HTTPPort, cc.Name)
+	}
+	if len(acmeConfig.DNSNames) == 0 && len(acmeConfig.IPAddresses) == 0 {
+		return fmt.Errorf("at least one DNS name or IP address is required for ACME provisioner in certificate %q", cc.Name)
+	}
+	for _, ip := range acmeConfig.IPAddresses {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid IP address %q for ACME provisioner in certificate %q", ip, cc.Name)
+		}
+	}
+	return nil
+}
+
+// accountKeyPath returns the path of the account key used with the given ACME directory.
+func (f *ACMEProvisionerFactory) accountKeyPath(directoryURL string) string {
+	sum := sha256.Sum256([]byte(directoryURL))
+	return filepath.Join(f.accountKeyDir, hex.EncodeToString(sum[:8])+".key")
+}
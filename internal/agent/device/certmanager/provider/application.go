@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+)
+
+const (
+	StorageTypeApplicationVolume certmanager.StorageType = "application-volume"
+	StorageTypeApplicationSecret certmanager.StorageType = "application-secret"
+
+	DefaultApplicationVolumeCertFile = "tls.crt"
+	DefaultApplicationVolumeKeyFile  = "tls.key"
+
+	applicationSecretCertSuffix = ".crt"
+	applicationSecretKeySuffix  = ".key"
+	applicationSecretLabel      = "io.flightctl.certificate=true"
+)
+
+// ApplicationVolumeStorageConfig defines configuration for storing certificates in an application volume.
+type ApplicationVolumeStorageConfig struct {
+	// VolumeName is the name of the podman volume backing the application volume
+	VolumeName string `json:"volume-name"`
+	// User is the user whose podman storage holds the volume
+	User v1beta1.Username `json:"user,omitempty"`
+	// CertFile is the path of the certificate relative to the volume root
+	CertFile string `json:"cert-file,omitempty"`
+	// KeyFile is the path of the private key relative to the volume root
+	KeyFile string `json:"key-file,omitempty"`
+}
+
+// ApplicationVolumeStorage writes certificates and private keys into the mount point of a podman volume
+// owned by an application, so that the application can consume them from its containers.
+type ApplicationVolumeStorage struct {
+	cfg           ApplicationVolumeStorageConfig
+	podmanFactory client.PodmanFactory
+	rwFactory     fileio.ReadWriterFactory
+	log           certmanager.Logger
+}
+
+// NewApplicationVolumeStorage creates a new application volume storage provider.
+func NewApplicationVolumeStorage(cfg ApplicationVolumeStorageConfig, podmanFactory client.PodmanFactory, rwFactory fileio.ReadWriterFactory, log certmanager.Logger) *ApplicationVolumeStorage {
+	if cfg.CertFile == "" {
+		cfg.CertFile = DefaultApplicationVolumeCertFile
+	}
+	if cfg.KeyFile == "" {
+		cfg.KeyFile = DefaultApplicationVolumeKeyFile
+	}
+	return &ApplicationVolumeStorage{
+		cfg:           cfg,
+		podmanFactory: podmanFactory,
+		rwFactory:     rwFactory,
+		log:           log,
+	}
+}
+
+// LoadCertificate loads the certificate stored in the application volume.
+func (s *ApplicationVolumeStorage) LoadCertificate(ctx context.Context) (*x509.Certificate, error) {
+	mountPoint, rw, err := s.volume(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	certPEM, err := rw.ReadFile(filepath.Join(mountPoint, s.cfg.CertFile))
+	if err != nil {
+		return nil, fmt.Errorf("reading cert file: %w", err)
+	}
+
+	cert, err := fccrypto.ParsePEMCertificate(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// Store writes the certificate and private key into the application volume.
+func (s *ApplicationVolumeStorage) Store(ctx context.Context, req certmanager.StoreRequest) error {
+	if req.Result.Cert == nil {
+		return fmt.Errorf("application volume storage: nil certificate")
+	}
+
+	mountPoint, rw, err := s.volume(ctx)
+	if err != nil {
+		return err
+	}
+
+	certPath := filepath.Join(mountPoint, s.cfg.CertFile)
+	if err := rw.MkdirAll(filepath.Dir(certPath), fileio.DefaultDirectoryPermissions); err != nil {
+		return fmt.Errorf("mkdir for cert file: %w", err)
+	}
+	if err := rw.WriteFile(certPath, req.Result.Cert, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("write cert: %w", err)
+	}
+
+	if req.Result.Key != nil {
+		keyPath := filepath.Join(mountPoint, s.cfg.KeyFile)
+		if err := rw.MkdirAll(filepath.Dir(keyPath), fileio.DefaultDirectoryPermissions); err != nil {
+			return fmt.Errorf("mkdir for key file: %w", err)
+		}
+		if err := rw.WriteFile(keyPath, req.Result.Key, 0o600); err != nil {
+			return fmt.Errorf("write key: %w", err)
+		}
+	}
+
+	s.log.Debugf("Successfully wrote cert and key to volume %s", s.cfg.VolumeName)
+	return nil
+}
+
+// volume returns the mount point of the volume and a read-writer acting as the volume's owner.
+func (s *ApplicationVolumeStorage) volume(ctx context.Context) (string, fileio.ReadWriter, error) {
+	podman, err := s.podmanFactory(s.cfg.User)
+	if err != nil {
+		return "", nil, fmt.Errorf("podman client for user %q: %w", s.cfg.User, err)
+	}
+	mountPoint, err := podman.InspectVolumeMount(ctx, s.cfg.VolumeName)
+	if err != nil {
+		return "", nil, fmt.Errorf("inspect volume %q: %w", s.cfg.VolumeName, err)
+	}
+	if mountPoint == "" {
+		return "", nil, fmt.Errorf("volume %q has no mount point", s.cfg.VolumeName)
+	}
+	rw, err := s.rwFactory(s.cfg.User)
+	if err != nil {
+		return "", nil, fmt.Errorf("read-writer for user %q: %w", s.cfg.User, err)
+	}
+	return mountPoint, rw, nil
+}
+
+// ApplicationVolumeStorageFactory implements StorageFactory for application volume storage.
+type ApplicationVolumeStorageFactory struct {
+	podmanFactory client.PodmanFactory
+	rwFactory     fileio.ReadWriterFactory
+}
+
+// NewApplicationVolumeStorageFactory creates a new application volume storage factory.
+func NewApplicationVolumeStorageFactory(podmanFactory client.PodmanFactory, rwFactory fileio.ReadWriterFactory) *ApplicationVolumeStorageFactory {
+	return &ApplicationVolumeStorageFactory{
+		podmanFactory: podmanFactory,
+		rwFactory:     rwFactory,
+	}
+}
+
+// Type returns the storage type string used as map key in the certificate manager.
+func (f *ApplicationVolumeStorageFactory) Type() string {
+	return string(StorageTypeApplicationVolume)
+}
+
+// New creates a new ApplicationVolumeStorage instance from the certificate configuration.
+func (f *ApplicationVolumeStorageFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.StorageProvider, error) {
+	var cfg ApplicationVolumeStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode application volume Storage config for certificate %q: %w", cc.Name, err)
+	}
+	return NewApplicationVolumeStorage(cfg, f.podmanFactory, f.rwFactory, log), nil
+}
+
+// Validate checks whether the provided configuration is valid for application volume storage.
+func (f *ApplicationVolumeStorageFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if cc.Storage.Type != StorageTypeApplicationVolume {
+		return fmt.Errorf("not an application volume Storage")
+	}
+
+	var cfg ApplicationVolumeStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return fmt.Errorf("failed to decode application volume Storage config for certificate %q: %w", cc.Name, err)
+	}
+	if cfg.VolumeName == "" {
+		return fmt.Errorf("volume-name is required for application volume storage, certificate %s", cc.Name)
+	}
+	for _, file := range []string{cfg.CertFile, cfg.KeyFile} {
+		if file != "" && !filepath.IsLocal(file) {
+			return fmt.Errorf("file %q must be relative to the volume root, certificate %s", file, cc.Name)
+		}
+	}
+	return nil
+}
+
+// ApplicationSecretStorageConfig defines configuration for storing certificates as podman secrets.
+type ApplicationSecretStorageConfig struct {
+	// Name is the prefix of the secrets; the certificate is stored as "<name>.crt" and the key as "<name>.key"
+	Name string `json:"name"`
+	// User is the user whose podman storage holds the secrets
+	User v1beta1.Username `json:"user,omitempty"`
+}
+
+// ApplicationSecretStorage stores certificates and private keys as podman secrets that applications
+// can mount into their containers.
+type ApplicationSecretStorage struct {
+	cfg           ApplicationSecretStorageConfig
+	podmanFactory client.PodmanFactory
+	log           certmanager.Logger
+}
+
+// NewApplicationSecretStorage creates a new application secret storage provider.
+func NewApplicationSecretStorage(cfg ApplicationSecretStorageConfig, podmanFactory client.PodmanFactory, log certmanager.Logger) *ApplicationSecretStorage {
+	return &ApplicationSecretStorage{
+		cfg:           cfg,
+		podmanFactory: podmanFactory,
+		log:           log,
+	}
+}
+
+// LoadCertificate loads the certificate stored in the certificate secret.
+func (s *ApplicationSecretStorage) LoadCertificate(ctx context.Context) (*x509.Certificate, error) {
+	podman, err := s.podmanFactory(s.cfg.User)
+	if err != nil {
+		return nil, fmt.Errorf("podman client for user %q: %w", s.cfg.User, err)
+	}
+
+	certPEM, err := podman.InspectSecretData(ctx, s.cfg.Name+applicationSecretCertSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := fccrypto.ParsePEMCertificate(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// Store creates or replaces the certificate and key secrets.
+func (s *ApplicationSecretStorage) Store(ctx context.Context, req certmanager.StoreRequest) error {
+	if req.Result.Cert == nil {
+		return fmt.Errorf("application secret storage: nil certificate")
+	}
+
+	podman, err := s.podmanFactory(s.cfg.User)
+	if err != nil {
+		return fmt.Errorf("podman client for user %q: %w", s.cfg.User, err)
+	}
+
+	labels := []string{applicationSecretLabel}
+	if err := podman.CreateSecret(ctx, s.cfg.Name+applicationSecretCertSuffix, req.Result.Cert, labels); err != nil {
+		return err
+	}
+	if req.Result.Key != nil {
+		if err := podman.CreateSecret(ctx, s.cfg.Name+applicationSecretKeySuffix, req.Result.Key, labels); err != nil {
+			return err
+		}
+	}
+
+	s.log.Debugf("Successfully stored cert and key in secrets %s%s and %s%s",
+		s.cfg.Name, applicationSecretCertSuffix, s.cfg.Name, applicationSecretKeySuffix)
+
+	// Best-effort cleanup. Never fail Store if cleanup fails.
+	s.deleteOldBestEffort(ctx, req)
+
+	return nil
+}
+
+func (s *ApplicationSecretStorage) deleteOldBestEffort(ctx context.Context, req certmanager.StoreRequest) {
+	if req.LastApplied.IsEmpty() || req.LastApplied.Type != StorageTypeApplicationSecret {
+		return
+	}
+
+	var lastCfg ApplicationSecretStorageConfig
+	if err := json.Unmarshal(req.LastApplied.Config, &lastCfg); err != nil {
+		s.log.Debugf("application secret storage: cannot decode last-applied config for cleanup: %v", err)
+		return
+	}
+	if lastCfg.Name == "" || (lastCfg.Name == s.cfg.Name && lastCfg.User == s.cfg.User) {
+		return
+	}
+
+	podman, err := s.podmanFactory(lastCfg.User)
+	if err != nil {
+		s.log.Warnf("application secret storage: podman client for user %q: %v", lastCfg.User, err)
+		return
+	}
+	for _, suffix := range []string{applicationSecretCertSuffix, applicationSecretKeySuffix} {
+		if err := podman.RemoveSecret(ctx, lastCfg.Name+suffix); err != nil {
+			s.log.Warnf("application secret storage: failed to delete old secret %s%s: %v", lastCfg.Name, suffix, err)
+		}
+	}
+}
+
+// ApplicationSecretStorageFactory implements StorageFactory for application secret storage.
+type ApplicationSecretStorageFactory struct {
+	podmanFactory client.PodmanFactory
+}
+
+// NewApplicationSecretStorageFactory creates a new application secret storage factory.
+func NewApplicationSecretStorageFactory(podmanFactory client.PodmanFactory) *ApplicationSecretStorageFactory {
+	return &ApplicationSecretStorageFactory{podmanFactory: podmanFactory}
+}
+
+// Type returns the storage type string used as map key in the certificate manager.
+func (f *ApplicationSecretStorageFactory) Type() string {
+	return string(StorageTypeApplicationSecret)
+}
+
+// New creates a new ApplicationSecretStorage instance from the certificate configuration.
+func (f *ApplicationSecretStorageFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.StorageProvider, error) {
+	var cfg ApplicationSecretStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode application secret Storage config for certificate %q: %w", cc.Name, err)
+	}
+	return NewApplicationSecretStorage(cfg, f.podmanFactory, log), nil
+}
+
+// Validate checks whether the provided configuration is valid for application secret storage.
+func (f *ApplicationSecretStorageFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if cc.Storage.Type != StorageTypeApplicationSecret {
+		return fmt.Errorf("not an application secret Storage")
+	}
+
+	var cfg ApplicationSecretStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return fmt.Errorf("failed to decode application secret Storage config for certificate %q: %w", cc.Name, err)
+	}
+	if cfg.Name == "" {
+		return fmt.Errorf("name is required for application secret storage, certificate %s", cc.Name)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"text/template"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/identity"
 	agentapi "github.com/flightctl/flightctl/internal/api/client/agent"
 	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +36,12 @@ type CSRProvisionerConfig struct {
 	Signer string `json:"signer"`
 	// CommonName is the common name for the certificate
 	CommonName string `json:"common-name,omitempty"`
+	// DNSNames are the DNS subject alternative names requested for the certificate
+	DNSNames []string `json:"dns-names,omitempty"`
+	// IPAddresses are the IP subject alternative names requested for the certificate
+	IPAddresses []string `json:"ip-addresses,omitempty"`
+	// RequestName is the prefix of the name of the CSR resource; defaults to the common name
+	RequestName string `json:"request-name,omitempty"`
 	// Usages specifies a set of key usages requested in the issued certificate (e.g., "clientAuth", "serverAuth")
 	Usages []string `json:"usages,omitempty"`
 	// ExpirationSeconds requests a specific certificate validity duration (in seconds); signer may ignore
@@ -86,11 +94,20 @@ func (p *CSRProvisioner) Provision(ctx context.Context, _ certmanager.ProvisionR
 		return nil, fmt.Errorf("commonName must be set")
 	}
 
+	csrOpts, err := p.csrOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate unique CSR object name for Kubernetes resource
-	p.csrName = fmt.Sprintf("%s-%s", p.cfg.CommonName, uuid.NewString()[:8])
+	requestName := p.cfg.RequestName
+	if requestName == "" {
+		requestName = p.cfg.CommonName
+	}
+	p.csrName = fmt.Sprintf("%s-%s", requestName, uuid.NewString()[:8])
 
 	// Generate private key and CSR using the configured CommonName (without suffix)
-	id, err := p.identityProvider.NewExportable(p.cfg.CommonName)
+	id, err := p.identityProvider.NewExportable(p.cfg.CommonName, csrOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("new identity: %w", err)
 	}
@@ -101,39 +118,71 @@ func (p *CSRProvisioner) Provision(ctx context.Context, _ certmanager.ProvisionR
 
 	p.identity = id
 
+	if err := submitCSR(ctx, p.csrClient, p.cfg, p.csrName, csr); err != nil {
+		return nil, err
+	}
+	return &certmanager.ProvisionResult{Ready: false}, nil
+}
+
+// submitCSR creates the named CertificateSigningRequest resource for the PEM encoded request on the management server.
+func submitCSR(ctx context.Context, c csrClient, cfg *CSRProvisionerConfig, name string, csr []byte) error {
 	usages := []string{
 		"clientAuth",
 		"CA:false",
 	}
 
-	if len(p.cfg.Usages) > 0 {
-		usages = append(usages, p.cfg.Usages...)
+	if len(cfg.Usages) > 0 {
+		usages = append(usages, cfg.Usages...)
 	}
 
 	req := api.CertificateSigningRequest{
 		ApiVersion: api.CertificateSigningRequestAPIVersion,
 		Kind:       api.CertificateSigningRequestKind,
 		Metadata: api.ObjectMeta{
-			Name: &p.csrName,
+			Name: &name,
 		},
 		Spec: api.CertificateSigningRequestSpec{
-			ExpirationSeconds: p.cfg.ExpirationSeconds,
+			ExpirationSeconds: cfg.ExpirationSeconds,
 			Request:           csr,
-			SignerName:        p.cfg.Signer,
+			SignerName:        cfg.Signer,
 			Usages:            &usages,
 		},
 	}
-	_, statusCode, err := p.csrClient.CreateCertificateSigningRequest(ctx, req)
+	_, statusCode, err := c.CreateCertificateSigningRequest(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("create csr: %w", err)
+		return fmt.Errorf("create csr: %w", err)
 	}
 
 	switch statusCode {
 	case http.StatusOK, http.StatusCreated:
-		return &certmanager.ProvisionResult{Ready: false}, nil
+		return nil
 	default:
-		return nil, fmt.Errorf("%w: unexpected status code %d", errors.ErrCreateCertificateSigningRequest, statusCode)
+		return fmt.Errorf("%w: unexpected status code %d", errors.ErrCreateCertificateSigningRequest, statusCode)
+	}
+}
+
+// csrOptions returns the CSR options requesting the configured subject alternative names.
+func (p *CSRProvisioner) csrOptions() ([]fccrypto.CSROption, error) {
+	return csrOptions(p.cfg)
+}
+
+func csrOptions(cfg *CSRProvisionerConfig) ([]fccrypto.CSROption, error) {
+	var opts []fccrypto.CSROption
+	if len(cfg.DNSNames) > 0 {
+		opts = append(opts, fccrypto.WithDNSNames(cfg.DNSNames...))
+	}
+	if len(cfg.IPAddresses) > 0 {
+		ips := make([]net.IP, 0, len(cfg.IPAddresses))
+		for _, s := range cfg.IPAddresses {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", s)
+			}
+			ips = append(ips, ip)
+		}
+		opts = append(opts, fccrypto.WithIPAddresses(ips...))
 	}
+	return opts, nil
 }
 
 // check polls the management server for CSR status and returns the certificate when ready.
@@ -146,37 +195,49 @@ func (p *CSRProvisioner) check(ctx context.Context) (*certmanager.ProvisionResul
 		return nil, fmt.Errorf("no identity generated")
 	}
 
-	csr, statusCode, err := p.csrClient.GetCertificateSigningRequest(ctx, p.csrName)
+	certPEM, err := fetchCertificate(ctx, p.csrClient, p.csrName)
+	if err != nil {
+		return nil, err
+	}
+	if certPEM == nil {
+		return &certmanager.ProvisionResult{Ready: false}, nil
+	}
+
+	keyPEM, err := p.identity.KeyPEM()
+	if err != nil {
+		return nil, fmt.Errorf("key pem: %w", err)
+	}
+
+	return &certmanager.ProvisionResult{Ready: true, Cert: certPEM, Key: keyPEM}, nil
+}
+
+// fetchCertificate returns the certificate issued for the named CSR, or nil if the CSR is still pending.
+// It returns an error if the CSR was denied or failed.
+func fetchCertificate(ctx context.Context, c csrClient, name string) ([]byte, error) {
+	csr, statusCode, err := c.GetCertificateSigningRequest(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("get csr: %w", err)
 	}
 	if statusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d while fetching CSR %q", statusCode, p.csrName)
+		return nil, fmt.Errorf("unexpected status code %d while fetching CSR %q", statusCode, name)
 	}
 	if csr == nil {
-		return nil, fmt.Errorf("received nil CSR object for %q", p.csrName)
+		return nil, fmt.Errorf("received nil CSR object for %q", name)
 	}
 	if csr.Status == nil {
-		return &certmanager.ProvisionResult{Ready: false}, nil // Not ready yet, wait for status to be populated
+		return nil, nil // Not ready yet, wait for status to be populated
 	}
 
 	if api.IsStatusConditionTrue(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestApproved) && csr.Status.Certificate != nil {
-		certPEM := *csr.Status.Certificate
-
-		keyPEM, err := p.identity.KeyPEM()
-		if err != nil {
-			return nil, fmt.Errorf("key pem: %w", err)
-		}
-
-		return &certmanager.ProvisionResult{Ready: true, Cert: certPEM, Key: keyPEM}, nil
+		return *csr.Status.Certificate, nil
 	}
 
 	if api.IsStatusConditionTrue(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestDenied) ||
 		api.IsStatusConditionTrue(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestFailed) {
-		return nil, fmt.Errorf("csr %q was denied or failed", p.csrName)
+		return nil, fmt.Errorf("csr %q was denied or failed", name)
 	}
 
-	return &certmanager.ProvisionResult{Ready: false}, nil // still pending
+	return nil, nil // still pending
 }
 
 // CSRProvisionerFactory implements ProvisionerFactory for CSR-based provisioners.
@@ -220,28 +281,35 @@ func (f *CSRProvisionerFactory) New(log certmanager.Logger, cc certmanager.Certi
 		return nil, fmt.Errorf("failed to create identity provider for type %q in certificate %q: %w", csrConfig.IdentityType, cc.Name, err)
 	}
 
-	commonName := csrConfig.CommonName
+	commonName, err := renderCommonName(f.deviceName, cc.Name, csrConfig.CommonName)
+	if err != nil {
+		return nil, err
+	}
+	csrConfig.CommonName = commonName
+
+	return NewCSRProvisioner(f.deviceName, f.managementClient, identityProvider, &csrConfig)
+}
+
+// renderCommonName renders the commonName template of a certificate, defaulting to the certificate name.
+func renderCommonName(deviceName, certName, commonName string) (string, error) {
 	if commonName == "" {
-		commonName = cc.Name
+		commonName = certName
 	}
 
 	tmpl, err := template.New("commonName").Parse(commonName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse commonName template for certificate %q: %w", cc.Name, err)
+		return "", fmt.Errorf("failed to parse commonName template for certificate %q: %w", certName, err)
 	}
 
 	templateData := map[string]string{
-		"DEVICE_ID": f.deviceName,
+		"DEVICE_ID": deviceName,
 	}
 
 	var rendered bytes.Buffer
 	if err := tmpl.Execute(&rendered, templateData); err != nil {
-		return nil, fmt.Errorf("failed to render commonName template for certificate %q: %w", cc.Name, err)
+		return "", fmt.Errorf("failed to render commonName template for certificate %q: %w", certName, err)
 	}
-
-	csrConfig.CommonName = rendered.String()
-
-	return NewCSRProvisioner(f.deviceName, f.managementClient, identityProvider, &csrConfig)
+	return rendered.String(), nil
 }
 
 // Validate checks whether the provided config is valid for a CSR provisioner.
@@ -262,6 +330,12 @@ func (f *CSRProvisionerFactory) Validate(log certmanager.Logger, cc certmanager.
 		return fmt.Errorf("signer must be specified for CSR provisioner in certificate %q", cc.Name)
 	}
 
+	for _, ip := range csrConfig.IPAddresses {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid IP address %q for CSR provisioner in certificate %q", ip, cc.Name)
+		}
+	}
+
 	if !f.identityFactory.CanProvide(csrConfig.IdentityType) {
 		return fmt.Errorf("invalid identity type %q for certificate", cc.Name)
 	}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+
+	"github.com/flightctl/flightctl/pkg/certmanager"
+)
+
+// ReloadConfig lists the systemd units to reload once a certificate has been stored.
+// It is read from the storage configuration, next to the storage-specific settings.
+type ReloadConfig struct {
+	// SystemdUnits are reloaded (or restarted, if they do not support reloading) after each store
+	SystemdUnits []string `json:"reload-systemd-units,omitempty"`
+}
+
+// systemdReloader is the minimal systemd client surface required to reload units.
+type systemdReloader interface {
+	ReloadOrRestart(ctx context.Context, name string) error
+}
+
+// WithSystemdReloadOnStore wraps a storage factory so that the systemd units listed in the storage
+// configuration are reloaded after the certificate has been stored.
+func WithSystemdReloadOnStore(systemd systemdReloader, f certmanager.StorageFactory) certmanager.StorageFactory {
+	if systemd == nil || f == nil {
+		return f
+	}
+	return &reloadStorageFactory{next: f, systemd: systemd}
+}
+
+type reloadStorageFactory struct {
+	next    certmanager.StorageFactory
+	systemd systemdReloader
+}
+
+func (f *reloadStorageFactory) Type() string {
+	return f.next.Type()
+}
+
+func (f *reloadStorageFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if _, err := decodeReloadConfig(cc); err != nil {
+		return err
+	}
+	return f.next.Validate(log, cc)
+}
+
+func (f *reloadStorageFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.StorageProvider, error) {
+	reloadCfg, err := decodeReloadConfig(cc)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := f.next.New(log, cc)
+	if err != nil {
+		return nil, err
+	}
+	if len(reloadCfg.SystemdUnits) == 0 {
+		return s, nil
+	}
+
+	return &reloadStorage{
+		next:    s,
+		systemd: f.systemd,
+		units:   reloadCfg.SystemdUnits,
+		log:     log,
+	}, nil
+}
+
+type reloadStorage struct {
+	next    certmanager.StorageProvider
+	systemd systemdReloader
+	units   []string
+	log     certmanager.Logger
+}
+
+func (s *reloadStorage) LoadCertificate(ctx context.Context) (*x509.Certificate, error) {
+	return s.next.LoadCertificate(ctx)
+}
+
+func (s *reloadStorage) Store(ctx context.Context, req certmanager.StoreRequest) error {
+	if err := s.next.Store(ctx, req); err != nil {
+		return err
+	}
+
+	// The certificate is in place at this point; a unit that fails to reload must not cause it to be
+	// provisioned again, so failures are only logged.
+	for _, unit := range s.units {
+		if err := s.systemd.ReloadOrRestart(ctx, unit); err != nil {
+			s.log.Errorf("Certificate stored, but reloading unit %s failed: %v", unit, err)
+			continue
+		}
+		s.log.Infof("Reloaded unit %s after certificate update", unit)
+	}
+	return nil
+}
+
+func decodeReloadConfig(cc certmanager.CertificateConfig) (ReloadConfig, error) {
+	var cfg ReloadConfig
+	if len(cc.Storage.Config) == 0 {
+		return cfg, nil
+	}
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to decode reload config for certificate %q: %w", cc.Name, err)
+	}
+	return cfg, nil
+}
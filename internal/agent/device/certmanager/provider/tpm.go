@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	ProvisionerTypeTPM certmanager.ProvisionerType = "tpm"
+	StorageTypeTPM     certmanager.StorageType     = "tpm"
+
+	// tpmKeyNameMeta is the provision result metadata entry holding the name of the TPM key
+	tpmKeyNameMeta = "tpm/key-name"
+)
+
+// tpmCertificateKeys is the minimal TPM client surface required to manage certificate keys.
+type tpmCertificateKeys interface {
+	CreateCertificateKey(name string, commonName string, opts ...fccrypto.CSROption) ([]byte, error)
+	CommitCertificateKey(name string) error
+	CertificateSigner(name string) (crypto.Signer, error)
+}
+
+// TPMProvisioner handles certificate provisioning through Certificate Signing Requests for keys held by the TPM.
+// It generates a non-exportable key under the TPM storage hierarchy, submits a CSR signed with it to the
+// management server, and polls for approval and certificate issuance. The private key never leaves the TPM,
+// so the provision result carries no key; the TPM storage commits the new key once the certificate is stored.
+type TPMProvisioner struct {
+	// Name of the TPM key, which is the name of the certificate
+	keyName string
+	// Client for communicating with management server
+	csrClient csrClient
+	// Client managing the certificate keys in the TPM
+	tpmClient tpmCertificateKeys
+	// Configuration for CSR provisioning
+	cfg *CSRProvisionerConfig
+
+	// Name of the CSR resource on the server
+	csrName string
+}
+
+// NewTPMProvisioner creates a new TPM provisioner with the specified configuration.
+func NewTPMProvisioner(keyName string, csrClient csrClient, tpmClient tpmCertificateKeys, cfg *CSRProvisionerConfig) *TPMProvisioner {
+	return &TPMProvisioner{
+		keyName:   keyName,
+		csrClient: csrClient,
+		tpmClient: tpmClient,
+		cfg:       cfg,
+	}
+}
+
+// Provision attempts to provision a certificate through the CSR workflow.
+// On first call, it generates a pending TPM key and submits a CSR to the server.
+// On subsequent calls, it checks the CSR status and returns the certificate when approved.
+func (p *TPMProvisioner) Provision(ctx context.Context, _ certmanager.ProvisionRequest) (*certmanager.ProvisionResult, error) {
+	if p.csrName != "" {
+		certPEM, err := fetchCertificate(ctx, p.csrClient, p.csrName)
+		if err != nil {
+			return nil, err
+		}
+		if certPEM == nil {
+			return &certmanager.ProvisionResult{Ready: false}, nil
+		}
+		return &certmanager.ProvisionResult{
+			Ready: true,
+			Cert:  certPEM,
+			Meta:  map[string][]byte{tpmKeyNameMeta: []byte(p.keyName)},
+		}, nil
+	}
+
+	if p.cfg.CommonName == "" {
+		return nil, fmt.Errorf("commonName must be set")
+	}
+
+	csrOpts, err := csrOptions(p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	requestName := p.cfg.RequestName
+	if requestName == "" {
+		requestName = p.cfg.CommonName
+	}
+	csrName := fmt.Sprintf("%s-%s", requestName, uuid.NewString()[:8])
+
+	csr, err := p.tpmClient.CreateCertificateKey(p.keyName, p.cfg.CommonName, csrOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("create TPM key: %w", err)
+	}
+
+	if err := submitCSR(ctx, p.csrClient, p.cfg, csrName, csr); err != nil {
+		return nil, err
+	}
+	p.csrName = csrName
+	return &certmanager.ProvisionResult{Ready: false}, nil
+}
+
+// TPMProvisionerFactory implements ProvisionerFactory for TPM-backed CSR provisioners.
+type TPMProvisionerFactory struct {
+	// Name of the device for CSR common name substitution
+	deviceName string
+	// Client for communicating with management server
+	managementClient csrClient
+	// Client managing the certificate keys in the TPM
+	tpmClient tpmCertificateKeys
+}
+
+// NewTPMProvisionerFactory creates a new TPMProvisionerFactory with the specified dependencies.
+func NewTPMProvisionerFactory(deviceName string, managementClient csrClient, tpmClient tpmCertificateKeys) *TPMProvisionerFactory {
+	return &TPMProvisionerFactory{
+		deviceName:       deviceName,
+		managementClient: managementClient,
+		tpmClient:        tpmClient,
+	}
+}
+
+// Type returns the provisioner type string used as map key in the certificate manager.
+func (f *TPMProvisionerFactory) Type() string {
+	return string(ProvisionerTypeTPM)
+}
+
+// New creates a new TPMProvisioner based on the provided certificate config.
+func (f *TPMProvisionerFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.ProvisionerProvider, error) {
+	var cfg CSRProvisionerConfig
+	if err := json.Unmarshal(cc.Provisioner.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode TPM provisioner config for certificate %q: %w", cc.Name, err)
+	}
+
+	commonName, err := renderCommonName(f.deviceName, cc.Name, cfg.CommonName)
+	if err != nil {
+		return nil, err
+	}
+	cfg.CommonName = commonName
+
+	return NewTPMProvisioner(cc.Name, f.managementClient, f.tpmClient, &cfg), nil
+}
+
+// Validate checks whether the provided config is valid for a TPM provisioner.
+// The private key stays in the TPM, so the certificate must also be stored by the TPM storage.
+func (f *TPMProvisionerFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if cc.Provisioner.Type != ProvisionerTypeTPM {
+		return fmt.Errorf("not a TPM provisioner")
+	}
+
+	var cfg CSRProvisionerConfig
+	if err := json.Unmarshal(cc.Provisioner.Config, &cfg); err != nil {
+		return fmt.Errorf("failed to decode TPM provisioner config for certificate %q: %w", cc.Name, err)
+	}
+
+	if cfg.Signer == "" {
+		return fmt.Errorf("signer must be specified for TPM provisioner in certificate %q", cc.Name)
+	}
+	if cfg.IdentityType != "" {
+		return fmt.Errorf("identity-type is not supported by the TPM provisioner in certificate %q", cc.Name)
+	}
+
+	for _, ip := range cfg.IPAddresses {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid IP address %q for TPM provisioner in certificate %q", ip, cc.Name)
+		}
+	}
+
+	if cc.Storage.Type != StorageTypeTPM {
+		return fmt.Errorf("TPM provisioner requires %q storage in certificate %q", StorageTypeTPM, cc.Name)
+	}
+
+	return nil
+}
+
+// TPMStorageConfig defines configuration for certificates whose private key is held by the TPM.
+type TPMStorageConfig struct {
+	// CertPath is the path where the certificate will be stored
+	CertPath string `json:"cert-path"`
+}
+
+// TPMStorage stores certificates whose private key is held by the TPM.
+// Storing a certificate makes the TPM key it was issued for the active key of the certificate;
+// the previous key remains in use until then so that renewals never leave the certificate without a key.
+type TPMStorage struct {
+	// Name of the TPM key, which is the name of the certificate
+	keyName string
+	// Path where the certificate file will be stored
+	certPath string
+	// Client managing the certificate keys in the TPM
+	tpmClient tpmCertificateKeys
+	// File I/O interface for reading and writing files
+	rw fileio.ReadWriter
+	// Logger for storage operations
+	log certmanager.Logger
+}
+
+// NewTPMStorage creates a new TPM storage provider for the named key.
+func NewTPMStorage(keyName, certPath string, tpmClient tpmCertificateKeys, rw fileio.ReadWriter, log certmanager.Logger) *TPMStorage {
+	return &TPMStorage{
+		keyName:   keyName,
+		certPath:  certPath,
+		tpmClient: tpmClient,
+		rw:        rw,
+		log:       log,
+	}
+}
+
+// LoadCertificate loads the certificate from the filesystem and verifies that it was issued for the active
+// TPM key. A certificate without a matching key is reported as an error so that it is provisioned again.
+func (s *TPMStorage) LoadCertificate(_ context.Context) (*x509.Certificate, error) {
+	certPEM, err := s.rw.ReadFile(s.certPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading cert file: %w", err)
+	}
+
+	cert, err := fccrypto.ParsePEMCertificate(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PEM certificate: %w", err)
+	}
+
+	if err := s.verifyActiveKey(cert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// Store commits the TPM key the certificate was issued for and writes the certificate to the filesystem.
+func (s *TPMStorage) Store(_ context.Context, req certmanager.StoreRequest) error {
+	if req.Result.Cert == nil {
+		return fmt.Errorf("tpm storage: nil certificate")
+	}
+	if keyName := string(req.Result.Meta[tpmKeyNameMeta]); keyName != s.keyName {
+		return fmt.Errorf("tpm storage: certificate was not issued for TPM key %q", s.keyName)
+	}
+
+	cert, err := fccrypto.ParsePEMCertificate(req.Result.Cert)
+	if err != nil {
+		return fmt.Errorf("failed to parse PEM certificate: %w", err)
+	}
+
+	if err := s.tpmClient.CommitCertificateKey(s.keyName); err != nil {
+		// The key may already have been committed by a store that failed afterwards.
+		if !errors.Is(err, tpm.ErrNotFound) {
+			return fmt.Errorf("commit TPM key: %w", err)
+		}
+		if err := s.verifyActiveKey(cert); err != nil {
+			return err
+		}
+	}
+
+	if err := s.rw.MkdirAll(filepath.Dir(s.certPath), 0o700); err != nil {
+		return fmt.Errorf("mkdir for cert path: %w", err)
+	}
+	if err := s.rw.WriteFile(s.certPath, req.Result.Cert, fileio.DefaultFilePermissions); err != nil {
+		s.log.Errorf("Failed to write cert to %s: %v", s.certPath, err)
+		return fmt.Errorf("write cert: %w", err)
+	}
+	s.log.Debugf("Successfully wrote cert to %s for TPM key %s", s.certPath, s.keyName)
+
+	s.deleteOldBestEffort(req)
+	return nil
+}
+
+func (s *TPMStorage) verifyActiveKey(cert *x509.Certificate) error {
+	signer, err := s.tpmClient.CertificateSigner(s.keyName)
+	if err != nil {
+		return fmt.Errorf("load TPM key: %w", err)
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return fmt.Errorf("certificate does not match the active TPM key %q", s.keyName)
+	}
+	return nil
+}
+
+func (s *TPMStorage) deleteOldBestEffort(req certmanager.StoreRequest) {
+	if req.LastApplied.IsEmpty() || req.LastApplied.Type != StorageTypeTPM {
+		return
+	}
+
+	var lastCfg TPMStorageConfig
+	if err := json.Unmarshal(req.LastApplied.Config, &lastCfg); err != nil {
+		s.log.Debugf("tpm storage: cannot decode last-applied config for cleanup: %v", err)
+		return
+	}
+
+	if lastCfg.CertPath != "" && lastCfg.CertPath != s.certPath {
+		if err := s.rw.RemoveFile(lastCfg.CertPath); err != nil {
+			s.log.Warnf("tpm storage: failed to delete old cert %s: %v", lastCfg.CertPath, err)
+		}
+	}
+}
+
+// TPMStorageFactory implements StorageFactory for certificates whose private key is held by the TPM.
+type TPMStorageFactory struct {
+	// Client managing the certificate keys in the TPM
+	tpmClient tpmCertificateKeys
+	// File I/O interface for reading and writing files
+	rw fileio.ReadWriter
+}
+
+// NewTPMStorageFactory creates a new TPM storage factory.
+func NewTPMStorageFactory(tpmClient tpmCertificateKeys, rw fileio.ReadWriter) *TPMStorageFactory {
+	return &TPMStorageFactory{
+		tpmClient: tpmClient,
+		rw:        rw,
+	}
+}
+
+// Type returns the storage type string used as map key in the certificate manager.
+func (f *TPMStorageFactory) Type() string {
+	return string(StorageTypeTPM)
+}
+
+// New creates a new TPMStorage instance from the certificate configuration.
+func (f *TPMStorageFactory) New(log certmanager.Logger, cc certmanager.CertificateConfig) (certmanager.StorageProvider, error) {
+	var cfg TPMStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode TPM storage config for certificate %q: %w", cc.Name, err)
+	}
+
+	return NewTPMStorage(cc.Name, cfg.CertPath, f.tpmClient, f.rw, log), nil
+}
+
+// Validate checks whether the provided configuration is valid for TPM storage.
+// Only certificates issued for TPM keys can be stored, so the provisioner must be the TPM provisioner.
+func (f *TPMStorageFactory) Validate(log certmanager.Logger, cc certmanager.CertificateConfig) error {
+	if cc.Storage.Type != StorageTypeTPM {
+		return fmt.Errorf("not a TPM storage")
+	}
+
+	var cfg TPMStorageConfig
+	if err := json.Unmarshal(cc.Storage.Config, &cfg); err != nil {
+		return fmt.Errorf("failed to decode TPM storage config for certificate %q: %w", cc.Name, err)
+	}
+
+	if cfg.CertPath == "" {
+		return fmt.Errorf("cert-path is required for TPM storage, certificate %s", cc.Name)
+	}
+	if cc.Provisioner.Type != ProvisionerTypeTPM {
+		return fmt.Errorf("TPM storage requires %q provisioner in certificate %s", ProvisionerTypeTPM, cc.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCertificateKeys keeps pending and active software keys in place of the TPM.
+type fakeCertificateKeys struct {
+	pending map[string]*ecdsa.PrivateKey
+	active  map[string]*ecdsa.PrivateKey
+}
+
+func newFakeCertificateKeys() *fakeCertificateKeys {
+	return &fakeCertificateKeys{
+		pending: make(map[string]*ecdsa.PrivateKey),
+		active:  make(map[string]*ecdsa.PrivateKey),
+	}
+}
+
+func (f *fakeCertificateKeys) CreateCertificateKey(name string, commonName string, opts ...fccrypto.CSROption) ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	f.pending[name] = key
+	return fccrypto.MakeCSR(key, commonName, opts...)
+}
+
+func (f *fakeCertificateKeys) CommitCertificateKey(name string) error {
+	key, ok := f.pending[name]
+	if !ok {
+		return fmt.Errorf("pending certificate key %s %w", name, tpm.ErrNotFound)
+	}
+	f.active[name] = key
+	delete(f.pending, name)
+	return nil
+}
+
+func (f *fakeCertificateKeys) CertificateSigner(name string) (crypto.Signer, error) {
+	key, ok := f.active[name]
+	if !ok {
+		return nil, fmt.Errorf("certificate key %s %w", name, tpm.ErrNotFound)
+	}
+	return key, nil
+}
+
+func issueTestCertificate(t *testing.T, pub crypto.PublicKey) []byte {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "web"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, caKey)
+	require.NoError(t, err)
+	certPEM, err := fccrypto.EncodeCertificatePEM(&x509.Certificate{Raw: der})
+	require.NoError(t, err)
+	return certPEM
+}
+
+func TestTPMStorage(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	keys := newFakeCertificateKeys()
+	storage := NewTPMStorage("web", "/etc/web/tls.crt", keys, rw, log.NewPrefixLogger("test"))
+
+	_, err := storage.LoadCertificate(ctx)
+	require.Error(err)
+
+	// the pending key becomes active once its certificate is stored
+	_, err = keys.CreateCertificateKey("web", "web")
+	require.NoError(err)
+	certPEM := issueTestCertificate(t, keys.pending["web"].Public())
+	result := &certmanager.ProvisionResult{Ready: true, Cert: certPEM, Meta: map[string][]byte{tpmKeyNameMeta: []byte("web")}}
+	require.NoError(storage.Store(ctx, certmanager.StoreRequest{Result: result}))
+
+	cert, err := storage.LoadCertificate(ctx)
+	require.NoError(err)
+	require.Equal("web", cert.Subject.CommonName)
+
+	// storing the same certificate again is accepted once its key is already active
+	require.NoError(storage.Store(ctx, certmanager.StoreRequest{Result: result}))
+
+	// a certificate issued for another key is rejected
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	mismatch := &certmanager.ProvisionResult{Ready: true, Cert: issueTestCertificate(t, other.Public()), Meta: result.Meta}
+	require.ErrorContains(storage.Store(ctx, certmanager.StoreRequest{Result: mismatch}), "does not match")
+
+	// a certificate that no longer matches the active key is reported so that it is provisioned again
+	keys.active["web"] = other
+	_, err = storage.LoadCertificate(ctx)
+	require.ErrorContains(err, "does not match")
+}
+
+func TestTPMFactoriesValidate(t *testing.T) {
+	logger := log.NewPrefixLogger("test")
+	provisionerFactory := NewTPMProvisionerFactory("device", nil, newFakeCertificateKeys())
+	storageFactory := NewTPMStorageFactory(newFakeCertificateKeys(), nil)
+
+	mustJSON := func(v any) json.RawMessage {
+		raw, err := json.Marshal(v)
+		require.NoError(t, err)
+		return raw
+	}
+
+	valid := certmanager.CertificateConfig{
+		Name: "web",
+		Provisioner: certmanager.ProvisionerConfig{
+			Type:   ProvisionerTypeTPM,
+			Config: mustJSON(CSRProvisionerConfig{Signer: "flightctl.io/device-svc-client"}),
+		},
+		Storage: certmanager.StorageConfig{
+			Type:   StorageTypeTPM,
+			Config: mustJSON(TPMStorageConfig{CertPath: "/etc/web/tls.crt"}),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(cc *certmanager.CertificateConfig)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			mutate: func(cc *certmanager.CertificateConfig) {},
+		},
+		{
+			name: "missing signer",
+			mutate: func(cc *certmanager.CertificateConfig) {
+				cc.Provisioner.Config = mustJSON(CSRProvisionerConfig{})
+			},
+			wantErr: "signer must be specified",
+		},
+		{
+			name: "identity type",
+			mutate: func(cc *certmanager.CertificateConfig) {
+				cc.Provisioner.Config = mustJSON(CSRProvisionerConfig{Signer: "s", IdentityType: "software"})
+			},
+			wantErr: "identity-type is not supported",
+		},
+		{
+			name: "filesystem storage",
+			mutate: func(cc *certmanager.CertificateConfig) {
+				cc.Storage = certmanager.StorageConfig{
+					Type:   StorageTypeFilesystem,
+					Config: mustJSON(FileSystemStorageConfig{CertPath: "/a", KeyPath: "/b"}),
+				}
+			},
+			wantErr: "requires \"tpm\" storage",
+		},
+		{
+			name: "missing cert path",
+			mutate: func(cc *certmanager.CertificateConfig) {
+				cc.Storage.Config = mustJSON(TPMStorageConfig{})
+			},
+			wantErr: "cert-path is required",
+		},
+		{
+			name: "csr provisioner",
+			mutate: func(cc *certmanager.CertificateConfig) {
+				cc.Provisioner.Type = ProvisionerTypeCSR
+			},
+			wantErr: "requires \"tpm\" provisioner",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := valid
+			tt.mutate(&cc)
+
+			var err error
+			if cc.Provisioner.Type == ProvisionerTypeTPM {
+				err = provisionerFactory.Validate(logger, cc)
+			}
+			if err == nil && cc.Storage.Type == StorageTypeTPM {
+				err = storageFactory.Validate(logger, cc)
+			}
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	appprovider "github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	"github.com/samber/lo"
+)
+
+const (
+	workloadSignerName = "flightctl.io/device-workload"
+	workloadUsage      = "serverAuth"
+)
+
+// WorkloadConfigProvider supplies the certificate configurations of the workload certificates declared
+// in the device spec. The spec is pushed by the agent on every reconciliation through Update.
+type WorkloadConfigProvider struct {
+	mu sync.Mutex
+	// Certificates declared in the desired device spec
+	certificates []v1beta1.DeviceCertificateSpec
+	// Applications declared in the desired device spec, used to resolve application destinations
+	applications []v1beta1.ApplicationProviderSpec
+	// Errors preventing certificates from being configured, keyed by certificate name
+	errs map[string]error
+}
+
+// NewWorkloadConfigProvider creates a workload configuration provider with no certificates.
+func NewWorkloadConfigProvider() *WorkloadConfigProvider {
+	return &WorkloadConfigProvider{errs: make(map[string]error)}
+}
+
+// Name returns the unique identifier for this provider.
+func (p *WorkloadConfigProvider) Name() string { return "device-spec" }
+
+// Update replaces the certificates and applications with those of the desired device spec.
+func (p *WorkloadConfigProvider) Update(spec *v1beta1.DeviceSpec) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.certificates = nil
+	p.applications = nil
+	if spec != nil {
+		p.certificates = lo.FromPtr(spec.Certificates)
+		p.applications = lo.FromPtr(spec.Applications)
+	}
+}
+
+// Certificates returns the certificates of the desired device spec.
+func (p *WorkloadConfigProvider) Certificates() []v1beta1.DeviceCertificateSpec {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.certificates
+}
+
+// Error returns the error preventing the named certificate from being configured, if any.
+func (p *WorkloadConfigProvider) Error(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.errs[name]
+}
+
+// GetCertificateConfigs maps the certificates of the desired device spec to certificate configurations.
+// Certificates that cannot be mapped are left out and their error is recorded.
+func (p *WorkloadConfigProvider) GetCertificateConfigs() ([]certmanager.CertificateConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.errs = make(map[string]error)
+	configs := make([]certmanager.CertificateConfig, 0, len(p.certificates))
+	for _, cert := range p.certificates {
+		cfg, err := p.certificateConfig(cert)
+		if err != nil {
+			p.errs[cert.Name] = err
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
+
+func (p *WorkloadConfigProvider) certificateConfig(cert v1beta1.DeviceCertificateSpec) (certmanager.CertificateConfig, error) {
+	provisioner, err := workloadProvisionerConfig(cert)
+	if err != nil {
+		return certmanager.CertificateConfig{}, err
+	}
+
+	storage, err := p.storageConfig(cert)
+	if err != nil {
+		return certmanager.CertificateConfig{}, err
+	}
+
+	cfg := certmanager.CertificateConfig{
+		Name:        cert.Name,
+		Provisioner: provisioner,
+		Storage:     storage,
+	}
+	if cert.RenewBefore != nil {
+		renewBefore, err := time.ParseDuration(*cert.RenewBefore)
+		if err != nil {
+			return certmanager.CertificateConfig{}, fmt.Errorf("invalid renewBefore: %w", err)
+		}
+		cfg.RenewBefore = &renewBefore
+	}
+	return cfg, nil
+}
+
+func workloadProvisionerConfig(cert v1beta1.DeviceCertificateSpec) (certmanager.ProvisionerConfig, error) {
+	var (
+		typ certmanager.ProvisionerType
+		cfg any
+	)
+
+	issuerType := v1beta1.CertificateIssuerTypeFlightctl
+	if cert.Issuer != nil {
+		issuerType = cert.Issuer.Type
+	}
+
+	switch issuerType {
+	case v1beta1.CertificateIssuerTypeFlightctl:
+		typ = ProvisionerTypeCSR
+		cfg = CSRProvisionerConfig{
+			Signer:            workloadSignerName,
+			CommonName:        cert.GetCommonName(),
+			DNSNames:          lo.FromPtr(cert.DnsNames),
+			IPAddresses:       lo.FromPtr(cert.IpAddresses),
+			RequestName:       cert.Name,
+			Usages:            []string{workloadUsage},
+			ExpirationSeconds: cert.ExpirationSeconds,
+		}
+	case v1beta1.CertificateIssuerTypeAcme:
+		if cert.Issuer.Acme == nil {
+			return certmanager.ProvisionerConfig{}, fmt.Errorf("acme issuer requires acme settings")
+		}
+		typ = ProvisionerTypeACME
+		cfg = ACMEProvisionerConfig{
+			DirectoryURL: cert.Issuer.Acme.DirectoryUrl,
+			Email:        lo.FromPtr(cert.Issuer.Acme.Email),
+			HTTPPort:     lo.FromPtr(cert.Issuer.Acme.HttpPort),
+			CommonName:   cert.GetCommonName(),
+			DNSNames:     lo.FromPtr(cert.DnsNames),
+			IPAddresses:  lo.FromPtr(cert.IpAddresses),
+		}
+	default:
+		return certmanager.ProvisionerConfig{}, fmt.Errorf("unsupported issuer type %q", issuerType)
+	}
+
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return certmanager.ProvisionerConfig{}, fmt.Errorf("encode provisioner config: %w", err)
+	}
+	return certmanager.ProvisionerConfig{Type: typ, Config: raw}, nil
+}
+
+func (p *WorkloadConfigProvider) storageConfig(cert v1beta1.DeviceCertificateSpec) (certmanager.StorageConfig, error) {
+	var reload ReloadConfig
+	if cert.Reload != nil {
+		reload.SystemdUnits = lo.FromPtr(cert.Reload.SystemdUnits)
+	}
+
+	var (
+		typ certmanager.StorageType
+		cfg any
+	)
+
+	dest := cert.Destination
+	switch {
+	case dest.File != nil:
+		typ = StorageTypeFilesystem
+		cfg = struct {
+			FileSystemStorageConfig
+			ReloadConfig
+		}{
+			FileSystemStorageConfig{CertPath: dest.File.CertPath, KeyPath: dest.File.KeyPath},
+			reload,
+		}
+	case dest.ApplicationVolume != nil:
+		appSpec, err := p.application(dest.ApplicationVolume.Application)
+		if err != nil {
+			return certmanager.StorageConfig{}, err
+		}
+		volumeName, user, err := appprovider.ResolveVolume(appSpec, dest.ApplicationVolume.Volume)
+		if err != nil {
+			return certmanager.StorageConfig{}, fmt.Errorf("resolve volume %q of application %q: %w",
+				dest.ApplicationVolume.Volume, dest.ApplicationVolume.Application, err)
+		}
+		typ = StorageTypeApplicationVolume
+		cfg = struct {
+			ApplicationVolumeStorageConfig
+			ReloadConfig
+		}{
+			ApplicationVolumeStorageConfig{
+				VolumeName: volumeName,
+				User:       user,
+				CertFile:   lo.FromPtr(dest.ApplicationVolume.CertFile),
+				KeyFile:    lo.FromPtr(dest.ApplicationVolume.KeyFile),
+			},
+			reload,
+		}
+	case dest.ApplicationSecret != nil:
+		appSpec, err := p.application(dest.ApplicationSecret.Application)
+		if err != nil {
+			return certmanager.StorageConfig{}, err
+		}
+		user, err := appprovider.ResolveUser(appSpec)
+		if err != nil {
+			return certmanager.StorageConfig{}, fmt.Errorf("resolve user of application %q: %w", dest.ApplicationSecret.Application, err)
+		}
+		typ = StorageTypeApplicationSecret
+		cfg = struct {
+			ApplicationSecretStorageConfig
+			ReloadConfig
+		}{
+			ApplicationSecretStorageConfig{Name: dest.ApplicationSecret.Name, User: user},
+			reload,
+		}
+	default:
+		return certmanager.StorageConfig{}, fmt.Errorf("no destination set")
+	}
+
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return certmanager.StorageConfig{}, fmt.Errorf("encode storage config: %w", err)
+	}
+	return certmanager.StorageConfig{Type: typ, Config: raw}, nil
+}
+
+func (p *WorkloadConfigProvider) application(name string) (*v1beta1.ApplicationProviderSpec, error) {
+	for i := range p.applications {
+		appName, err := appprovider.ResolveImageAppName(&p.applications[i])
+		if err != nil {
+			continue
+		}
+		if appName == name {
+			return &p.applications[i], nil
+		}
+	}
+	return nil, fmt.Errorf("application %q not found", name)
+}
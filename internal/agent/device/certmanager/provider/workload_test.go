@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	appprovider "github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadConfigProvider(t *testing.T) {
+	require := require.New(t)
+
+	containerApp := v1beta1.ContainerApplication{
+		Name:    lo.ToPtr("web"),
+		AppType: v1beta1.AppTypeContainer,
+		Image:   "quay.io/example/web:latest",
+	}
+	var appSpec v1beta1.ApplicationProviderSpec
+	require.NoError(appSpec.FromContainerApplication(containerApp))
+
+	spec := &v1beta1.DeviceSpec{
+		Applications: &[]v1beta1.ApplicationProviderSpec{appSpec},
+		Certificates: &[]v1beta1.DeviceCertificateSpec{
+			{
+				Name:              "file",
+				DnsNames:          &[]string{"device.example.com"},
+				IpAddresses:       &[]string{"192.168.1.10"},
+				ExpirationSeconds: lo.ToPtr(int32(86400)),
+				RenewBefore:       lo.ToPtr("1h"),
+				Destination: v1beta1.CertificateDestination{
+					File: &v1beta1.CertificateFileDestination{CertPath: "/etc/web/tls.crt", KeyPath: "/etc/web/tls.key"},
+				},
+				Reload: &v1beta1.CertificateReloadSpec{SystemdUnits: &[]string{"web.service"}},
+			},
+			{
+				Name:     "acme",
+				DnsNames: &[]string{"web.example.com"},
+				Issuer: &v1beta1.CertificateIssuerSpec{
+					Type: v1beta1.CertificateIssuerTypeAcme,
+					Acme: &v1beta1.AcmeIssuerSpec{DirectoryUrl: "https://acme.example.com/directory", HttpPort: lo.ToPtr(8080)},
+				},
+				Destination: v1beta1.CertificateDestination{
+					ApplicationVolume: &v1beta1.CertificateApplicationVolumeDestination{Application: "web", Volume: "certs"},
+				},
+			},
+			{
+				Name:     "secret",
+				DnsNames: &[]string{"web.example.com"},
+				Destination: v1beta1.CertificateDestination{
+					ApplicationSecret: &v1beta1.CertificateApplicationSecretDestination{Application: "web", Name: "web-tls"},
+				},
+			},
+			{
+				Name:     "missing-app",
+				DnsNames: &[]string{"web.example.com"},
+				Destination: v1beta1.CertificateDestination{
+					ApplicationSecret: &v1beta1.CertificateApplicationSecretDestination{Application: "unknown", Name: "tls"},
+				},
+			},
+		},
+	}
+
+	p := NewWorkloadConfigProvider()
+	p.Update(spec)
+	configs, err := p.GetCertificateConfigs()
+	require.NoError(err)
+	require.Len(configs, 3)
+	require.Len(p.Certificates(), 4)
+	require.ErrorContains(p.Error("missing-app"), `application "unknown" not found`)
+	require.NoError(p.Error("file"))
+
+	// flightctl issuer delivered to a file
+	file := configs[0]
+	require.Equal("file", file.Name)
+	require.Equal(ProvisionerTypeCSR, file.Provisioner.Type)
+	var csrCfg CSRProvisionerConfig
+	require.NoError(json.Unmarshal(file.Provisioner.Config, &csrCfg))
+	require.Equal(workloadSignerName, csrCfg.Signer)
+	require.Equal("device.example.com", csrCfg.CommonName)
+	require.Equal([]string{"device.example.com"}, csrCfg.DNSNames)
+	require.Equal([]string{"192.168.1.10"}, csrCfg.IPAddresses)
+	require.Equal("file", csrCfg.RequestName)
+	require.Equal([]string{workloadUsage}, csrCfg.Usages)
+	require.Equal(int32(86400), lo.FromPtr(csrCfg.ExpirationSeconds))
+	require.Equal(time.Hour, lo.FromPtr(file.RenewBefore))
+
+	require.Equal(StorageTypeFilesystem, file.Storage.Type)
+	var fsCfg FileSystemStorageConfig
+	require.NoError(json.Unmarshal(file.Storage.Config, &fsCfg))
+	require.Equal("/etc/web/tls.crt", fsCfg.CertPath)
+	require.Equal("/etc/web/tls.key", fsCfg.KeyPath)
+	reloadCfg, err := decodeReloadConfig(file)
+	require.NoError(err)
+	require.Equal([]string{"web.service"}, reloadCfg.SystemdUnits)
+
+	// ACME issuer delivered to an application volume
+	acmeCert := configs[1]
+	require.Equal(ProvisionerTypeACME, acmeCert.Provisioner.Type)
+	var acmeCfg ACMEProvisionerConfig
+	require.NoError(json.Unmarshal(acmeCert.Provisioner.Config, &acmeCfg))
+	require.Equal("https://acme.example.com/directory", acmeCfg.DirectoryURL)
+	require.Equal(8080, acmeCfg.HTTPPort)
+	require.Equal([]string{"web.example.com"}, acmeCfg.DNSNames)
+
+	require.Equal(StorageTypeApplicationVolume, acmeCert.Storage.Type)
+	var volumeCfg ApplicationVolumeStorageConfig
+	require.NoError(json.Unmarshal(acmeCert.Storage.Config, &volumeCfg))
+	volumeName, user, err := appprovider.ResolveVolume(&appSpec, "certs")
+	require.NoError(err)
+	require.Equal(volumeName, volumeCfg.VolumeName)
+	require.Equal(user, volumeCfg.User)
+
+	// flightctl issuer delivered to application secrets
+	secret := configs[2]
+	require.Equal(StorageTypeApplicationSecret, secret.Storage.Type)
+	var secretCfg ApplicationSecretStorageConfig
+	require.NoError(json.Unmarshal(secret.Storage.Config, &secretCfg))
+	require.Equal("web-tls", secretCfg.Name)
+
+	// removing the certificates from the spec removes their configurations
+	p.Update(&v1beta1.DeviceSpec{})
+	configs, err = p.GetCertificateConfigs()
+	require.NoError(err)
+	require.Empty(configs)
+	require.NoError(p.Error("missing-app"))
+}
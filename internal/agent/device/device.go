@@ -9,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/client"
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
@@ -23,6 +24,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
+	"github.com/flightctl/flightctl/internal/agent/device/telemetry"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
@@ -51,6 +53,8 @@ type Agent struct {
 	prefetchManager        dependency.PrefetchManager
 	pullConfigResolver     dependency.PullConfigResolver
 	pruningManager         imagepruning.Manager
+	telemetryManager       telemetry.Manager
+	certificateManager     certmanager.WorkloadManager
 
 	statusUpdateInterval util.Duration
 
@@ -81,6 +85,8 @@ func NewAgent(
 	prefetchManager dependency.PrefetchManager,
 	pullConfigResolver dependency.PullConfigResolver,
 	pruningManager imagepruning.Manager,
+	telemetryManager telemetry.Manager,
+	certificateManager certmanager.WorkloadManager,
 	backoff wait.Backoff,
 	log *log.PrefixLogger,
 ) *Agent {
@@ -106,6 +112,8 @@ func NewAgent(
 		prefetchManager:        prefetchManager,
 		pullConfigResolver:     pullConfigResolver,
 		pruningManager:         pruningManager,
+		telemetryManager:       telemetryManager,
+		certificateManager:     certificateManager,
 		backoff:                backoff,
 		log:                    log,
 	}
@@ -452,6 +460,14 @@ func (a *Agent) syncDevice(ctx context.Context, current, desired *v1beta1.Device
 		return fmt.Errorf("%w: %w", errors.ErrComponentLifecycle, err)
 	}
 
+	if err := a.telemetryManager.Sync(ctx, desired.Spec); err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrComponentTelemetry, err)
+	}
+
+	if err := a.certificateManager.SyncWorkloadCertificates(ctx, desired.Spec); err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrComponentCertificates, err)
+	}
+
 	// NOTE: policy manager is reconciled early in sync() so that the agent
 	// can correct for an invalid policy.
 
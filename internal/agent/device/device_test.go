@@ -10,6 +10,7 @@ import (
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
@@ -26,6 +27,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
+	"github.com/flightctl/flightctl/internal/agent/device/telemetry"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/poll"
@@ -243,6 +245,10 @@ func TestSync(t *testing.T) {
 			mockPrefetchManager := dependency.NewMockPrefetchManager(ctrl)
 			mockOSManager := os.NewMockManager(ctrl)
 			mockPruningManager := imagepruning.NewMockManager(ctrl)
+			mockTelemetryManager := telemetry.NewMockManager(ctrl)
+			mockTelemetryManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockCertificateManager := certmanager.NewMockWorkloadManager(ctrl)
+			mockCertificateManager.EXPECT().SyncWorkloadCertificates(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
 			tc.setupMocks(
 				tc.current,
@@ -307,6 +313,8 @@ func TestSync(t *testing.T) {
 				prefetchManager:        mockPrefetchManager,
 				osManager:              mockOSManager,
 				pruningManager:         mockPruningManager,
+				telemetryManager:       mockTelemetryManager,
+				certificateManager:     mockCertificateManager,
 				pullConfigResolver:     mockPullConfigResolver,
 			}
 
@@ -34,6 +34,8 @@ var (
 	ErrComponentConfig         = errors.New("config")
 	ErrComponentSystemd        = errors.New("systemd")
 	ErrComponentLifecycle      = errors.New("lifecycle")
+	ErrComponentTelemetry      = errors.New("telemetry")
+	ErrComponentCertificates   = errors.New("certificates")
 	ErrComponentOS             = errors.New("os")
 	ErrComponentOSReconciled   = errors.New("os reconciliation")
 
@@ -153,6 +155,7 @@ var (
 	ErrGettingImageDigest          = errors.New("getting image digest")
 	ErrGettingArtifactDigest       = errors.New("getting artifact digest")
 	ErrPrefetchCollector           = errors.New("prefetch collector")
+	ErrHostAccessNotAllowed        = errors.New("host access not allowed")
 
 	// config errors
 	ErrFailedToRetrieveUserID      = errors.New("failed to retrieve userid")
@@ -336,6 +339,7 @@ var (
 		ErrGettingImageDigest:          codes.Unavailable,
 		ErrGettingArtifactDigest:       codes.Unavailable,
 		ErrPrefetchCollector:           codes.Internal,
+		ErrHostAccessNotAllowed:        codes.PermissionDenied,
 		ErrKubeconfigNotFound:          codes.NotFound,
 
 		// config errors
@@ -782,6 +782,13 @@ func (m *manager) extractReferencesFromApplication(_ context.Context, appSpec *v
 		}
 		refs = append(refs, ImageRef{Image: helmApp.Image, Owner: owner, Type: RefTypeHelm})
 
+	case v1beta1.AppTypeSystemd:
+		systemdApp, err := (*appSpec).AsSystemdApplication()
+		if err != nil {
+			return nil, fmt.Errorf("getting systemd application: %w", err)
+		}
+		refs = append(refs, ImageRef{Image: systemdApp.Image, Owner: owner, Type: RefTypePodman})
+
 	default:
 		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedAppType, appType)
 	}
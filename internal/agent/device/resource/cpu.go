@@ -236,6 +236,11 @@ func (u *CPUUsage) Error() error {
 	return u.err
 }
 
+// CollectCPUUsage collects the cumulative CPU times of the device.
+func CollectCPUUsage(ctx context.Context, usage *CPUUsage) error {
+	return newCPUCollector(DefaultProcStatPath).CollectUsage(ctx, usage)
+}
+
 type cpuCollector struct {
 	statPath string
 }
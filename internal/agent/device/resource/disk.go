@@ -169,6 +169,11 @@ type DiskUsage struct {
 	lastCollectedAt time.Time
 }
 
+// DirUsage returns the usage of the filesystem containing dir.
+func DirUsage(dir string) (*DiskUsage, error) {
+	return getDirUsage(dir)
+}
+
 func getDirUsage(dir string) (*DiskUsage, error) {
 	var stat syscall.Statfs_t
 	err := syscall.Statfs(dir, &stat)
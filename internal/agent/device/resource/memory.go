@@ -133,6 +133,27 @@ func (m *MemoryMonitor) getSamplingInterval() time.Duration {
 	return m.samplingInterval
 }
 
+// CollectMemoryUsage collects the memory usage of the device.
+func CollectMemoryUsage(ctx context.Context, usage *MemoryUsage) error {
+	m := &MemoryMonitor{memInfoPath: DefaultProcMemInfoPath}
+	return m.CollectUsage(ctx, usage)
+}
+
+// TotalMemoryBytes returns the total memory of the device in bytes.
+func TotalMemoryBytes() (uint64, error) {
+	file, err := os.ReadFile(DefaultProcMemInfoPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var usage MemoryUsage
+	if err := parseMemStats(strings.Split(string(file), "\n"), &usage); err != nil {
+		return 0, err
+	}
+	// meminfo reports kB
+	return usage.MemTotal * 1024, nil
+}
+
 func parseMemStats(lines []string, usage *MemoryUsage) error {
 	for _, line := range lines {
 		fields := strings.Fields(line)
@@ -133,3 +133,32 @@ func RuntimeKeys() []string {
 func BuiltInKeys() []string {
 	return builtInKeys.Strings()
 }
+
+const (
+	// Built-in structured system info collectors, reported in device.status.systemInfo.extensions.
+	TPMCollector           = "tpm"
+	SecureBootCollector    = "secureBoot"
+	SerialNumbersCollector = "serialNumbers"
+	FirmwareCollector      = "firmware"
+	ModemsCollector        = "modems"
+	USBCollector           = "usb"
+)
+
+var builtInCollectors = newKeySet(
+	TPMCollector,
+	SecureBootCollector,
+	SerialNumbersCollector,
+	FirmwareCollector,
+	ModemsCollector,
+	USBCollector,
+)
+
+// IsBuiltInCollector reports whether the name is the one of a built-in structured system info collector.
+func IsBuiltInCollector(name string) bool {
+	return builtInCollectors.Has(name)
+}
+
+// BuiltInCollectors returns the names of the built-in structured system info collectors.
+func BuiltInCollectors() []string {
+	return builtInCollectors.Strings()
+}
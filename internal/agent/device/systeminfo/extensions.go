@@ -0,0 +1,189 @@
+package systeminfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/systeminfo/common"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// maxExtensionSize is the maximum size of the JSON object printed by a custom collector.
+const maxExtensionSize = 64 * 1024
+
+// errCollectorUnavailable signals that a collector cannot run on the device, e.g. because a tool it
+// relies on is not installed. Nothing is reported for the collector.
+var errCollectorUnavailable = errors.New("collector unavailable")
+
+type extensionContext struct {
+	exec     executer.Executer
+	reader   fileio.Reader
+	lookPath func(file string) (string, error)
+}
+
+// extensionFn collects structured system information as a JSON object.
+type extensionFn func(ctx context.Context, extCtx *extensionContext) (map[string]any, error)
+
+var builtInExtensions = map[string]extensionFn{
+	common.TPMCollector:           collectTPMExtension,
+	common.SecureBootCollector:    collectSecureBootExtension,
+	common.SerialNumbersCollector: collectSerialNumbersExtension,
+	common.FirmwareCollector:      collectFirmwareExtension,
+	common.ModemsCollector:        collectModemsExtension,
+	common.USBCollector:           collectUSBExtension,
+}
+
+type extension struct {
+	value map[string]any
+	// lastRun is the time of the last collection, successful or not.
+	lastRun time.Time
+}
+
+// isDue reports whether the collector must run. Collectors without interval run whenever the rest
+// of the system info is collected.
+func isDue(collector config.SystemInfoCollector, ext *extension, infoCollected bool, now time.Time) bool {
+	if ext == nil {
+		return true
+	}
+	if collector.Interval == 0 {
+		return infoCollected
+	}
+	return now.Sub(ext.lastRun) >= time.Duration(collector.Interval)
+}
+
+// collectExtensions runs the due collectors and returns the extensions to report.
+func (m *manager) collectExtensions(ctx context.Context, infoCollected bool) *v1beta1.DeviceSystemInfoExtensions {
+	m.mu.Lock()
+	collectors := make([]config.SystemInfoCollector, 0, len(m.extensionCollectors))
+	now := m.now()
+	for _, c := range m.extensionCollectors {
+		if isDue(c, m.extensions[c.Name], infoCollected, now) {
+			collectors = append(collectors, c)
+		}
+	}
+	defaultTimeout := m.collectionTimeout
+	m.mu.Unlock()
+
+	extCtx := &extensionContext{
+		exec:     m.exec,
+		reader:   m.readWriter,
+		lookPath: m.lookPath,
+	}
+	// a nil result keeps the last collected value
+	results := make(map[string]*extension, len(collectors))
+	for _, c := range collectors {
+		if ctx.Err() != nil {
+			break
+		}
+		value, err := runExtensionCollector(ctx, m.log, extCtx, c, defaultTimeout)
+		switch {
+		case errors.Is(err, errCollectorUnavailable):
+			m.log.Debugf("System info collector %s is not available", c.Name)
+			results[c.Name] = &extension{lastRun: now}
+		case err != nil:
+			m.log.Warnf("System info collector %s failed: %v", c.Name, err)
+			results[c.Name] = nil
+		default:
+			results[c.Name] = &extension{value: value, lastRun: now}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	configured := make(map[string]struct{}, len(m.extensionCollectors))
+	for _, c := range m.extensionCollectors {
+		configured[c.Name] = struct{}{}
+	}
+	for name, result := range results {
+		if _, ok := configured[name]; !ok {
+			// the collector was removed while collecting
+			continue
+		}
+		if result == nil {
+			// retry at the next interval
+			if ext, ok := m.extensions[name]; ok {
+				ext.lastRun = now
+			} else {
+				m.extensions[name] = &extension{lastRun: now}
+			}
+			continue
+		}
+		m.extensions[name] = result
+	}
+
+	extensions := make(v1beta1.DeviceSystemInfoExtensions, len(m.extensions))
+	for name, ext := range m.extensions {
+		if _, ok := configured[name]; !ok {
+			delete(m.extensions, name)
+			continue
+		}
+		if ext.value != nil {
+			extensions[name] = ext.value
+		}
+	}
+	if len(extensions) == 0 {
+		return nil
+	}
+	return &extensions
+}
+
+func runExtensionCollector(ctx context.Context, log *log.PrefixLogger, extCtx *extensionContext, collector config.SystemInfoCollector, defaultTimeout time.Duration) (map[string]any, error) {
+	timeout := time.Duration(collector.Timeout)
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	log.Debugf("Running system info collector %s", collector.Name)
+	if collector.Command == "" {
+		fn, ok := builtInExtensions[collector.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown built-in collector")
+		}
+		return fn(ctx, extCtx)
+	}
+	return runCustomCollector(ctx, extCtx.exec, collector.Command)
+}
+
+// runCustomCollector runs the command of a custom collector, which must print a JSON object.
+func runCustomCollector(ctx context.Context, exec executer.Executer, command string) (map[string]any, error) {
+	stdout, stderr, exitCode := exec.ExecuteWithContext(ctx, command)
+	if exitCode != 0 {
+		return nil, errors.FromStderr(stderr, exitCode)
+	}
+	if len(stdout) > maxExtensionSize {
+		return nil, fmt.Errorf("output exceeds %d bytes", maxExtensionSize)
+	}
+	var value map[string]any
+	if err := json.Unmarshal([]byte(stdout), &value); err != nil {
+		return nil, fmt.Errorf("output is not a JSON object: %w", err)
+	}
+	if value == nil {
+		return nil, fmt.Errorf("output is not a JSON object")
+	}
+	return value, nil
+}
+
+// runTool runs a command line tool returning JSON and decodes its output into out. errCollectorUnavailable
+// is returned if the tool is not installed.
+func runTool(ctx context.Context, extCtx *extensionContext, out any, command string, args ...string) error {
+	if _, err := extCtx.lookPath(command); err != nil {
+		return errCollectorUnavailable
+	}
+	stdout, stderr, exitCode := extCtx.exec.ExecuteWithContext(ctx, command, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("%s: %w", command, errors.FromStderr(stderr, exitCode))
+	}
+	if err := json.Unmarshal([]byte(stdout), out); err != nil {
+		return fmt.Errorf("parsing %s output: %w", command, err)
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package systeminfo
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/systeminfo/common"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestReadWriter(t *testing.T, files map[string]string) fileio.ReadWriter {
+	t.Helper()
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	for path, content := range files {
+		require.NoError(t, readWriter.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, readWriter.WriteFile(path, []byte(content), 0644))
+	}
+	return readWriter
+}
+
+func lookPathFound(file string) (string, error) { return "/usr/bin/" + file, nil }
+
+func lookPathNotFound(string) (string, error) { return "", errors.New("executable file not found") }
+
+func TestRunCustomCollector(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdout   string
+		stderr   string
+		exitCode int
+		want     map[string]any
+		wantErr  bool
+	}{
+		{
+			name:   "json object",
+			stdout: `{"asset": "A-123", "slots": {"used": 2}}`,
+			want:   map[string]any{"asset": "A-123", "slots": map[string]any{"used": float64(2)}},
+		},
+		{
+			name:    "json array",
+			stdout:  `["A-123"]`,
+			wantErr: true,
+		},
+		{
+			name:    "json null",
+			stdout:  `null`,
+			wantErr: true,
+		},
+		{
+			name:    "plain string",
+			stdout:  "A-123",
+			wantErr: true,
+		},
+		{
+			name:     "command failure",
+			stderr:   "permission denied",
+			exitCode: 1,
+			wantErr:  true,
+		},
+		{
+			name:    "output too large",
+			stdout:  `{"data": "` + strings.Repeat("a", maxExtensionSize) + `"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			mockExec := executer.NewMockExecuter(ctrl)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/libexec/inventory").Return(tt.stdout, tt.stderr, tt.exitCode)
+
+			value, err := runCustomCollector(context.Background(), mockExec, "/usr/libexec/inventory")
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.want, value)
+		})
+	}
+}
+
+func TestCollectExtensions(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockExec := executer.NewMockExecuter(ctrl)
+	readWriter := newTestReadWriter(t, map[string]string{
+		"/sys/class/dmi/id/product_serial": "SN-1\n",
+	})
+
+	collectors := []config.SystemInfoCollector{
+		{Name: common.SerialNumbersCollector},
+		{Name: "inventory", Command: "/usr/libexec/inventory", Interval: util.Duration(time.Hour)},
+	}
+	m := NewManager(log.NewPrefixLogger("test"), mockExec, readWriter, "/var/lib/flightctl", nil, nil, collectors, util.Duration(5*time.Second))
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	// all collectors run the first time
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/libexec/inventory").Return(`{"asset": "A-1"}`, "", 0)
+	extensions := m.collectExtensions(ctx, false)
+	require.NotNil(extensions)
+	require.Equal(map[string]any{"system": "SN-1"}, (*extensions)[common.SerialNumbersCollector])
+	require.Equal(map[string]any{"asset": "A-1"}, (*extensions)["inventory"])
+
+	// collectors without interval run with the rest of the system info
+	require.NoError(readWriter.WriteFile("/sys/class/dmi/id/product_serial", []byte("SN-2"), 0644))
+	extensions = m.collectExtensions(ctx, false)
+	require.Equal(map[string]any{"system": "SN-1"}, (*extensions)[common.SerialNumbersCollector])
+	extensions = m.collectExtensions(ctx, true)
+	require.Equal(map[string]any{"system": "SN-2"}, (*extensions)[common.SerialNumbersCollector])
+
+	// collectors with an interval run once it elapsed, keeping the last value on failure
+	now = now.Add(time.Hour)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/libexec/inventory").Return("", "boom", 1)
+	extensions = m.collectExtensions(ctx, false)
+	require.Equal(map[string]any{"asset": "A-1"}, (*extensions)["inventory"])
+	extensions = m.collectExtensions(ctx, false)
+	require.Equal(map[string]any{"asset": "A-1"}, (*extensions)["inventory"])
+
+	now = now.Add(time.Hour)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/libexec/inventory").Return(`{"asset": "A-2"}`, "", 0)
+	extensions = m.collectExtensions(ctx, false)
+	require.Equal(map[string]any{"asset": "A-2"}, (*extensions)["inventory"])
+
+	// removed collectors are no longer reported
+	cfg := config.NewDefault()
+	cfg.SystemInfo = nil
+	cfg.SystemInfoCollectors = collectors[:1]
+	require.NoError(m.ReloadConfig(ctx, cfg))
+	extensions = m.collectExtensions(ctx, false)
+	require.Len(*extensions, 1)
+	require.Contains(*extensions, common.SerialNumbersCollector)
+
+	cfg.SystemInfoCollectors = []config.SystemInfoCollector{}
+	require.NoError(m.ReloadConfig(ctx, cfg))
+	require.Nil(m.collectExtensions(ctx, false))
+}
+
+func TestBuiltInExtensions(t *testing.T) {
+	secureBootVar := string([]byte{0x06, 0x00, 0x00, 0x00, 0x01})
+	setupModeVar := string([]byte{0x06, 0x00, 0x00, 0x00, 0x00})
+
+	tests := []struct {
+		name      string
+		collector string
+		files     map[string]string
+		lookPath  func(string) (string, error)
+		setupExec func(*executer.MockExecuter)
+		want      map[string]any
+		wantErr   error
+	}{
+		{
+			name:      "tpm present",
+			collector: common.TPMCollector,
+			files: map[string]string{
+				"/sys/class/tpm/tpm0/tpm_version_major":  "2\n",
+				"/sys/class/tpm/tpm0/device/description": "TPM 2.0 Device\n",
+				"/sys/class/tpm/tpmrm0/dev":              "253:65536\n",
+			},
+			want: map[string]any{"present": true, "device": "/dev/tpm0", "version": "2.0", "description": "TPM 2.0 Device"},
+		},
+		{
+			name:      "tpm absent",
+			collector: common.TPMCollector,
+			want:      map[string]any{"present": false},
+		},
+		{
+			name:      "secure boot enabled",
+			collector: common.SecureBootCollector,
+			files: map[string]string{
+				"/sys/firmware/efi/efivars/SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c": secureBootVar,
+				"/sys/firmware/efi/efivars/SetupMode-8be4df61-93ca-11d2-aa0d-00e098032b8c":  setupModeVar,
+			},
+			want: map[string]any{"efi": true, "enabled": true, "setupMode": false},
+		},
+		{
+			name:      "legacy boot",
+			collector: common.SecureBootCollector,
+			want:      map[string]any{"efi": false, "enabled": false},
+		},
+		{
+			name:      "serial numbers",
+			collector: common.SerialNumbersCollector,
+			files: map[string]string{
+				"/sys/class/dmi/id/board_serial":              "BOARD-1\n",
+				"/sys/firmware/devicetree/base/serial-number": "10000000abcdef\x00",
+			},
+			want: map[string]any{"board": "BOARD-1", "deviceTree": "10000000abcdef"},
+		},
+		{
+			name:      "firmware without fwupd",
+			collector: common.FirmwareCollector,
+			files: map[string]string{
+				"/sys/class/dmi/id/bios_vendor":  "SeaBIOS\n",
+				"/sys/class/dmi/id/bios_version": "1.16.3\n",
+			},
+			lookPath: lookPathNotFound,
+			want:     map[string]any{"bios": map[string]any{"vendor": "SeaBIOS", "version": "1.16.3"}},
+		},
+		{
+			name:      "firmware with fwupd",
+			collector: common.FirmwareCollector,
+			lookPath:  lookPathFound,
+			setupExec: func(e *executer.MockExecuter) {
+				e.EXPECT().ExecuteWithContext(gomock.Any(), "fwupdmgr", "get-devices", "--json").Return(
+					`{"Devices": [{"Name": "System Firmware", "Vendor": "ACME", "Version": "0.1.9", "DeviceId": "abc"}, {"Name": "Hub"}]}`, "", 0)
+			},
+			want: map[string]any{"devices": []any{
+				map[string]any{"id": "abc", "name": "System Firmware", "vendor": "ACME", "version": "0.1.9"},
+			}},
+		},
+		{
+			name:      "usb devices",
+			collector: common.USBCollector,
+			files: map[string]string{
+				"/sys/bus/usb/devices/usb1/idVendor":      "1d6b\n",
+				"/sys/bus/usb/devices/1-1/idVendor":       "2c7c\n",
+				"/sys/bus/usb/devices/1-1/idProduct":      "0125\n",
+				"/sys/bus/usb/devices/1-1/manufacturer":   "Quectel\n",
+				"/sys/bus/usb/devices/1-1/product":        "EG25-G\n",
+				"/sys/bus/usb/devices/1-1/speed":          "480\n",
+				"/sys/bus/usb/devices/1-1:1.0/bInterface": "00\n",
+			},
+			want: map[string]any{
+				"count": 1,
+				"devices": []any{
+					map[string]any{"port": "1-1", "vendorId": "2c7c", "productId": "0125", "manufacturer": "Quectel", "product": "EG25-G", "speedMbps": "480"},
+				},
+			},
+		},
+		{
+			name:      "modems",
+			collector: common.ModemsCollector,
+			lookPath:  lookPathFound,
+			setupExec: func(e *executer.MockExecuter) {
+				e.EXPECT().ExecuteWithContext(gomock.Any(), "mmcli", "--list-modems", "--output-json").Return(
+					`{"modem-list": ["/org/freedesktop/ModemManager1/Modem/0"]}`, "", 0)
+				e.EXPECT().ExecuteWithContext(gomock.Any(), "mmcli", "--modem", "/org/freedesktop/ModemManager1/Modem/0", "--output-json").Return(
+					`{"modem": {"generic": {"manufacturer": "QUALCOMM INCORPORATED", "model": "EG25", "revision": "EG25GGBR07A08M2G", "equipment-identifier": "867698041234567", "state": "connected", "access-technologies": ["lte"], "signal-quality": {"value": "67", "recent": "yes"}}, "3gpp": {"operator-name": "Example", "registration-state": "home"}}}`, "", 0)
+			},
+			want: map[string]any{
+				"count": 1,
+				"modems": []any{
+					map[string]any{
+						"manufacturer":       "QUALCOMM INCORPORATED",
+						"model":              "EG25",
+						"firmwareRevision":   "EG25GGBR07A08M2G",
+						"imei":               "867698041234567",
+						"state":              "connected",
+						"accessTechnologies": []string{"lte"},
+						"signalQuality":      "67",
+						"operatorName":       "Example",
+						"registrationState":  "home",
+					},
+				},
+			},
+		},
+		{
+			name:      "modems without ModemManager",
+			collector: common.ModemsCollector,
+			lookPath:  lookPathNotFound,
+			wantErr:   errCollectorUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			mockExec := executer.NewMockExecuter(ctrl)
+			if tt.setupExec != nil {
+				tt.setupExec(mockExec)
+			}
+			lookPath := tt.lookPath
+			if lookPath == nil {
+				lookPath = lookPathNotFound
+			}
+			extCtx := &extensionContext{
+				exec:     mockExec,
+				reader:   newTestReadWriter(t, tt.files),
+				lookPath: lookPath,
+			}
+
+			value, err := builtInExtensions[tt.collector](context.Background(), extCtx)
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.want, value)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package systeminfo
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+const deviceTreeSerialPath = "/sys/firmware/devicetree/base/serial-number"
+
+// collectSerialNumbersExtension reports the serial numbers of the system, board and chassis from
+// the DMI table, and of the device tree on devices without DMI.
+func collectSerialNumbersExtension(_ context.Context, extCtx *extensionContext) (map[string]any, error) {
+	serials := map[string]any{}
+	for key, path := range map[string]string{
+		"system":     filepath.Join(dmiClassPath, "product_serial"),
+		"board":      filepath.Join(dmiClassPath, "board_serial"),
+		"chassis":    filepath.Join(dmiClassPath, "chassis_serial"),
+		"deviceTree": deviceTreeSerialPath,
+	} {
+		if v := readTrimmed(extCtx, path); v != "" {
+			serials[key] = v
+		}
+	}
+	return serials, nil
+}
+
+// fwupdDevices is the subset of the output of fwupdmgr get-devices --json that is reported.
+type fwupdDevices struct {
+	Devices []struct {
+		Name     string `json:"Name"`
+		Vendor   string `json:"Vendor"`
+		Version  string `json:"Version"`
+		DeviceID string `json:"DeviceId"`
+	} `json:"Devices"`
+}
+
+// collectFirmwareExtension reports the BIOS version and, if fwupd is installed, the firmware
+// versions of the devices it manages.
+func collectFirmwareExtension(ctx context.Context, extCtx *extensionContext) (map[string]any, error) {
+	firmware := map[string]any{}
+	bios := map[string]any{}
+	for key, file := range map[string]string{
+		"vendor":  "bios_vendor",
+		"version": "bios_version",
+		"date":    "bios_date",
+	} {
+		if v := readTrimmed(extCtx, filepath.Join(dmiClassPath, file)); v != "" {
+			bios[key] = v
+		}
+	}
+	if len(bios) > 0 {
+		firmware["bios"] = bios
+	}
+
+	var out fwupdDevices
+	err := runTool(ctx, extCtx, &out, "fwupdmgr", "get-devices", "--json")
+	switch {
+	case err == nil:
+		devices := make([]any, 0, len(out.Devices))
+		for _, d := range out.Devices {
+			if d.Version == "" {
+				continue
+			}
+			devices = append(devices, map[string]any{
+				"id":      d.DeviceID,
+				"name":    d.Name,
+				"vendor":  d.Vendor,
+				"version": d.Version,
+			})
+		}
+		firmware["devices"] = devices
+	case !errors.Is(err, errCollectorUnavailable):
+		return nil, err
+	}
+	return firmware, nil
+}
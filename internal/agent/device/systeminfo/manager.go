@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	osexec "os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -35,14 +36,18 @@ type manager struct {
 	readWriter fileio.ReadWriter
 	dataDir    string
 
-	mu                sync.Mutex
-	infoKeys          []string
-	customKeys        []string
-	collectionTimeout time.Duration
-	collectors        map[string]CollectorFn
-	collected         bool
-
-	log *log.PrefixLogger
+	mu                  sync.Mutex
+	infoKeys            []string
+	customKeys          []string
+	collectionTimeout   time.Duration
+	collectors          map[string]CollectorFn
+	collected           bool
+	extensionCollectors []config.SystemInfoCollector
+	extensions          map[string]*extension
+
+	now      func() time.Time
+	lookPath func(file string) (string, error)
+	log      *log.PrefixLogger
 }
 
 func NewManager(
@@ -52,17 +57,22 @@ func NewManager(
 	dataDir string,
 	infoKeys []string,
 	customKeys []string,
+	extensionCollectors []config.SystemInfoCollector,
 	collectionTimeout util.Duration,
 ) *manager {
 	return &manager{
-		exec:              exec,
-		readWriter:        readWriter,
-		dataDir:           dataDir,
-		infoKeys:          infoKeys,
-		customKeys:        customKeys,
-		collectionTimeout: time.Duration(collectionTimeout),
-		collectors:        make(map[string]CollectorFn),
-		log:               log,
+		exec:                exec,
+		readWriter:          readWriter,
+		dataDir:             dataDir,
+		infoKeys:            infoKeys,
+		customKeys:          customKeys,
+		extensionCollectors: extensionCollectors,
+		extensions:          make(map[string]*extension),
+		collectionTimeout:   time.Duration(collectionTimeout),
+		collectors:          make(map[string]CollectorFn),
+		now:                 time.Now,
+		lookPath:            osexec.LookPath,
+		log:                 log,
 	}
 }
 
@@ -179,6 +189,13 @@ func (m *manager) ReloadConfig(ctx context.Context, cfg *config.Config) error {
 		m.customKeys = cfg.SystemInfoCustom
 	}
 
+	if !reflect.DeepEqual(m.extensionCollectors, cfg.SystemInfoCollectors) {
+		m.log.Infof("Updating system info collectors: %v -> %v", collectorNames(m.extensionCollectors), collectorNames(cfg.SystemInfoCollectors))
+		m.extensionCollectors = cfg.SystemInfoCollectors
+		// recollect with the new configuration
+		clear(m.extensions)
+	}
+
 	timeout := time.Duration(cfg.SystemInfoTimeout)
 	if m.collectionTimeout != timeout {
 		m.log.Infof("Updating system info collection timeout: %v -> %v", m.collectionTimeout, timeout)
@@ -209,6 +226,7 @@ func (m *manager) Status(ctx context.Context, deviceStatus *v1beta1.DeviceStatus
 
 	if m.collected && !collectorOpts.Force {
 		m.mu.Unlock()
+		deviceStatus.SystemInfo.Extensions = m.collectExtensions(ctx, false)
 		return nil
 	}
 
@@ -245,13 +263,23 @@ func (m *manager) Status(ctx context.Context, deviceStatus *v1beta1.DeviceStatus
 
 	if err != nil {
 		deviceStatus.SystemInfo = m.defaultSystemInfo()
+		deviceStatus.SystemInfo.Extensions = m.collectExtensions(ctx, true)
 		return err
 	}
+	systemInfo.Extensions = m.collectExtensions(ctx, true)
 	deviceStatus.SystemInfo = systemInfo
 
 	return nil
 }
 
+func collectorNames(collectors []config.SystemInfoCollector) []string {
+	names := make([]string, 0, len(collectors))
+	for _, c := range collectors {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
 // defaultSystemInfo returns the default system info.
 func (m *manager) defaultSystemInfo() v1beta1.DeviceSystemInfo {
 	return v1beta1.DeviceSystemInfo{
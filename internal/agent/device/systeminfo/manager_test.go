@@ -45,7 +45,7 @@ func TestManager(t *testing.T) {
 	mockExecuter.EXPECT().ExecuteWithContext(context.Background(), "uptime", "-s").Return(bootTime, "", 0).Times(2)
 
 	// initialize client new device
-	manager := NewManager(log, mockExecuter, readWriter, dataDir, nil, nil, collectTimeout)
+	manager := NewManager(log, mockExecuter, readWriter, dataDir, nil, nil, nil, collectTimeout)
 	err = manager.Initialize(context.Background())
 	require.NoError(err)
 	require.NotNil(manager)
@@ -66,7 +66,7 @@ func TestManager(t *testing.T) {
 	require.NoError(err)
 
 	// reinitialize client
-	manager = NewManager(log, mockExecuter, readWriter, dataDir, nil, nil, collectTimeout)
+	manager = NewManager(log, mockExecuter, readWriter, dataDir, nil, nil, nil, collectTimeout)
 	err = manager.Initialize(context.Background())
 	require.NoError(err)
 	require.NotEmpty(manager.BootTime())
@@ -161,7 +161,7 @@ func TestReloadConfig(t *testing.T) {
 			log := log.NewPrefixLogger("test")
 			collectTimeout := util.Duration(5 * time.Second)
 
-			manager := NewManager(log, mockExecuter, readWriter, dataDir, tt.initialKeys, nil, collectTimeout)
+			manager := NewManager(log, mockExecuter, readWriter, dataDir, tt.initialKeys, nil, nil, collectTimeout)
 
 			// Simulate that data has been collected
 			manager.collected = true
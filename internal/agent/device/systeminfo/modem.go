@@ -0,0 +1,70 @@
+package systeminfo
+
+import (
+	"context"
+	"fmt"
+)
+
+// mmcliModemList is the output of mmcli --list-modems --output-json.
+type mmcliModemList struct {
+	ModemList []string `json:"modem-list"`
+}
+
+// mmcliModem is the subset of the output of mmcli --modem <path> --output-json that is reported.
+type mmcliModem struct {
+	Modem struct {
+		Generic struct {
+			Manufacturer        string   `json:"manufacturer"`
+			Model               string   `json:"model"`
+			Revision            string   `json:"revision"`
+			EquipmentIdentifier string   `json:"equipment-identifier"`
+			State               string   `json:"state"`
+			AccessTechnologies  []string `json:"access-technologies"`
+			SignalQuality       struct {
+				Value string `json:"value"`
+			} `json:"signal-quality"`
+		} `json:"generic"`
+		ThreeGPP struct {
+			OperatorName      string `json:"operator-name"`
+			RegistrationState string `json:"registration-state"`
+		} `json:"3gpp"`
+	} `json:"modem"`
+}
+
+// collectModemsExtension reports the cellular modems managed by ModemManager.
+func collectModemsExtension(ctx context.Context, extCtx *extensionContext) (map[string]any, error) {
+	var list mmcliModemList
+	if err := runTool(ctx, extCtx, &list, "mmcli", "--list-modems", "--output-json"); err != nil {
+		return nil, err
+	}
+
+	modems := make([]any, 0, len(list.ModemList))
+	for _, path := range list.ModemList {
+		var m mmcliModem
+		if err := runTool(ctx, extCtx, &m, "mmcli", "--modem", path, "--output-json"); err != nil {
+			return nil, fmt.Errorf("modem %s: %w", path, err)
+		}
+		generic := m.Modem.Generic
+		modem := map[string]any{
+			"manufacturer":       generic.Manufacturer,
+			"model":              generic.Model,
+			"firmwareRevision":   generic.Revision,
+			"imei":               generic.EquipmentIdentifier,
+			"state":              generic.State,
+			"accessTechnologies": generic.AccessTechnologies,
+			"signalQuality":      generic.SignalQuality.Value,
+		}
+		if v := m.Modem.ThreeGPP.OperatorName; v != "" {
+			modem["operatorName"] = v
+		}
+		if v := m.Modem.ThreeGPP.RegistrationState; v != "" {
+			modem["registrationState"] = v
+		}
+		modems = append(modems, modem)
+	}
+
+	return map[string]any{
+		"count":  len(modems),
+		"modems": modems,
+	}, nil
+}
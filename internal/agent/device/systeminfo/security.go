@@ -0,0 +1,93 @@
+package systeminfo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+const (
+	sysClassTPMDir = "/sys/class/tpm"
+	sysFirmwareEFI = "/sys/firmware/efi"
+	efiVarsDir     = "/sys/firmware/efi/efivars"
+	// efiGlobalVariableGUID is the vendor GUID of the UEFI global variables.
+	efiGlobalVariableGUID = "8be4df61-93ca-11d2-aa0d-00e098032b8c"
+)
+
+// collectTPMExtension reports whether the device has a TPM, and its version and manufacturer.
+func collectTPMExtension(_ context.Context, extCtx *extensionContext) (map[string]any, error) {
+	entries, err := extCtx.reader.ReadDir(sysClassTPMDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, "tpm") || strings.HasPrefix(name, "tpmrm") {
+			continue
+		}
+		tpm := map[string]any{
+			"present": true,
+			"device":  "/dev/" + name,
+		}
+		dir := filepath.Join(sysClassTPMDir, name)
+		if v := readTrimmed(extCtx, filepath.Join(dir, "tpm_version_major")); v != "" {
+			tpm["version"] = v + ".0"
+		}
+		if v := readTrimmed(extCtx, filepath.Join(dir, "device", "description")); v != "" {
+			tpm["description"] = v
+		}
+		return tpm, nil
+	}
+	return map[string]any{"present": false}, nil
+}
+
+// collectSecureBootExtension reports whether the device booted with UEFI and secure boot enabled.
+func collectSecureBootExtension(_ context.Context, extCtx *extensionContext) (map[string]any, error) {
+	efi, err := extCtx.reader.PathExists(sysFirmwareEFI)
+	if err != nil {
+		return nil, err
+	}
+	if !efi {
+		return map[string]any{"efi": false, "enabled": false}, nil
+	}
+
+	enabled, err := readEFIBoolVariable(extCtx, "SecureBoot")
+	if err != nil {
+		return nil, err
+	}
+	setupMode, err := readEFIBoolVariable(extCtx, "SetupMode")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"efi":       true,
+		"enabled":   enabled,
+		"setupMode": setupMode,
+	}, nil
+}
+
+// readEFIBoolVariable reads a UEFI global variable holding a single byte, following the 4 bytes
+// of attributes exposed by efivarfs.
+func readEFIBoolVariable(extCtx *extensionContext, name string) (bool, error) {
+	data, err := extCtx.reader.ReadFile(filepath.Join(efiVarsDir, name+"-"+efiGlobalVariableGUID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(data) >= 5 && data[4] == 1, nil
+}
+
+func readTrimmed(extCtx *extensionContext, path string) string {
+	data, err := extCtx.reader.ReadFile(path)
+	if err != nil {
+		// best effort: ignore errors for missing files and permissions
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(data), "\x00"))
+}
@@ -0,0 +1,63 @@
+package systeminfo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+const sysBusUSBDevicesDir = "/sys/bus/usb/devices"
+
+// collectUSBExtension reports the USB devices attached to the device, excluding root hubs.
+func collectUSBExtension(_ context.Context, extCtx *extensionContext) (map[string]any, error) {
+	entries, err := extCtx.reader.ReadDir(sysBusUSBDevicesDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		// root hubs are named usbN and interfaces <port>:<config>.<interface>
+		if strings.HasPrefix(name, "usb") || strings.Contains(name, ":") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	devices := make([]any, 0, len(names))
+	for _, name := range names {
+		dir := filepath.Join(sysBusUSBDevicesDir, name)
+		vendorID := readTrimmed(extCtx, filepath.Join(dir, "idVendor"))
+		if vendorID == "" {
+			continue
+		}
+		device := map[string]any{
+			"port":      name,
+			"vendorId":  vendorID,
+			"productId": readTrimmed(extCtx, filepath.Join(dir, "idProduct")),
+		}
+		for key, file := range map[string]string{
+			"manufacturer": "manufacturer",
+			"product":      "product",
+			"serial":       "serial",
+			"speedMbps":    "speed",
+			"class":        "bDeviceClass",
+		} {
+			if v := readTrimmed(extCtx, filepath.Join(dir, file)); v != "" {
+				device[key] = v
+			}
+		}
+		devices = append(devices, device)
+	}
+
+	return map[string]any{
+		"count":   len(devices),
+		"devices": devices,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package telemetry
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+type signalType string
+
+const (
+	signalMetrics signalType = "metrics"
+	signalLogs    signalType = "logs"
+)
+
+// batch is a batch of metrics or logs serialized as OTLP protobuf.
+type batch struct {
+	seq    uint64
+	signal signalType
+	data   []byte
+}
+
+type bufferedBatch struct {
+	seq    uint64
+	signal signalType
+	size   int64
+}
+
+func (b bufferedBatch) fileName() string {
+	return fmt.Sprintf("%020d.%s", b.seq, b.signal)
+}
+
+// buffer persists batches to disk until they are exported, so that telemetry collected while the
+// telemetry gateway is unreachable survives restarts of the agent. Once the buffer exceeds its
+// maximum size the oldest batches are dropped.
+type buffer struct {
+	rw      fileio.ReadWriter
+	dir     string
+	maxSize int64
+	log     *log.PrefixLogger
+
+	batches []bufferedBatch
+	size    int64
+	nextSeq uint64
+}
+
+// newBuffer creates a buffer in dir, loading the batches left by a previous run of the agent.
+func newBuffer(rw fileio.ReadWriter, dir string, maxSize int64, log *log.PrefixLogger) (*buffer, error) {
+	if err := rw.MkdirAll(dir, fileio.DefaultDirectoryPermissions); err != nil {
+		return nil, fmt.Errorf("creating telemetry buffer directory: %w", err)
+	}
+	entries, err := rw.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading telemetry buffer directory: %w", err)
+	}
+
+	b := &buffer{
+		rw:      rw,
+		dir:     dir,
+		maxSize: maxSize,
+		log:     log,
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name, signal, _ := strings.Cut(entry.Name(), ".")
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || (signalType(signal) != signalMetrics && signalType(signal) != signalLogs) {
+			log.Warnf("Removing unexpected file %s from the telemetry buffer", entry.Name())
+			if err := rw.RemoveFile(filepath.Join(dir, entry.Name())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		b.batches = append(b.batches, bufferedBatch{seq: seq, signal: signalType(signal), size: info.Size()})
+		b.size += info.Size()
+		if seq >= b.nextSeq {
+			b.nextSeq = seq + 1
+		}
+	}
+	sort.Slice(b.batches, func(i, j int) bool { return b.batches[i].seq < b.batches[j].seq })
+
+	if err := b.evict(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Add persists a batch, dropping the oldest batches if the buffer exceeds its maximum size.
+func (b *buffer) Add(signal signalType, data []byte) error {
+	entry := bufferedBatch{seq: b.nextSeq, signal: signal, size: int64(len(data))}
+	if entry.size > b.maxSize {
+		b.log.Warnf("Dropping %s batch of %d bytes larger than the telemetry buffer", signal, entry.size)
+		return nil
+	}
+
+	if err := b.rw.WriteFile(filepath.Join(b.dir, entry.fileName()), data, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing telemetry batch: %w", err)
+	}
+	b.nextSeq++
+	b.batches = append(b.batches, entry)
+	b.size += entry.size
+	return b.evict()
+}
+
+// Oldest returns the oldest batch of the buffer, or nil if it is empty.
+func (b *buffer) Oldest() (*batch, error) {
+	for len(b.batches) > 0 {
+		entry := b.batches[0]
+		data, err := b.rw.ReadFile(filepath.Join(b.dir, entry.fileName()))
+		if err != nil {
+			b.log.Warnf("Dropping unreadable telemetry batch %s: %v", entry.fileName(), err)
+			if err := b.Remove(entry.seq); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return &batch{seq: entry.seq, signal: entry.signal, data: data}, nil
+	}
+	return nil, nil
+}
+
+// Remove removes the batch with the given sequence number from the buffer.
+func (b *buffer) Remove(seq uint64) error {
+	for i, entry := range b.batches {
+		if entry.seq != seq {
+			continue
+		}
+		if err := b.rw.RemoveFile(filepath.Join(b.dir, entry.fileName())); err != nil {
+			return fmt.Errorf("removing telemetry batch: %w", err)
+		}
+		b.batches = append(b.batches[:i], b.batches[i+1:]...)
+		b.size -= entry.size
+		return nil
+	}
+	return nil
+}
+
+// Len returns the number of batches in the buffer.
+func (b *buffer) Len() int {
+	return len(b.batches)
+}
+
+func (b *buffer) evict() error {
+	dropped := 0
+	for b.size > b.maxSize && len(b.batches) > 0 {
+		if err := b.Remove(b.batches[0].seq); err != nil {
+			return err
+		}
+		dropped++
+	}
+	if dropped > 0 {
+		b.log.Warnf("Telemetry buffer is full: dropped the %d oldest batches", dropped)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReadWriter(t *testing.T) fileio.ReadWriter {
+	tmpDir := t.TempDir()
+	return fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+}
+
+func TestBuffer(t *testing.T) {
+	require := require.New(t)
+	rw := newTestReadWriter(t)
+	log := log.NewPrefixLogger("test")
+
+	b, err := newBuffer(rw, "/buffer", 10, log)
+	require.NoError(err)
+
+	oldest, err := b.Oldest()
+	require.NoError(err)
+	require.Nil(oldest)
+
+	require.NoError(b.Add(signalMetrics, []byte("1234")))
+	require.NoError(b.Add(signalLogs, []byte("5678")))
+	require.Equal(2, b.Len())
+
+	// exceeding the maximum size drops the oldest batch
+	require.NoError(b.Add(signalMetrics, []byte("abcd")))
+	require.Equal(2, b.Len())
+	oldest, err = b.Oldest()
+	require.NoError(err)
+	require.Equal(signalLogs, oldest.signal)
+	require.Equal([]byte("5678"), oldest.data)
+
+	// batches larger than the buffer are dropped
+	require.NoError(b.Add(signalLogs, []byte("0123456789a")))
+	require.Equal(2, b.Len())
+
+	// batches survive restarts in order
+	b, err = newBuffer(rw, "/buffer", 10, log)
+	require.NoError(err)
+	require.Equal(2, b.Len())
+	oldest, err = b.Oldest()
+	require.NoError(err)
+	require.Equal([]byte("5678"), oldest.data)
+	require.NoError(b.Remove(oldest.seq))
+
+	oldest, err = b.Oldest()
+	require.NoError(err)
+	require.Equal(signalMetrics, oldest.signal)
+	require.Equal([]byte("abcd"), oldest.data)
+	require.NoError(b.Remove(oldest.seq))
+	require.Equal(0, b.Len())
+
+	// sequence numbers keep increasing after a restart
+	require.NoError(b.Add(signalMetrics, []byte("efgh")))
+	oldest, err = b.Oldest()
+	require.NoError(err)
+	require.Equal(uint64(3), oldest.seq)
+}
+
+func TestBufferRemovesUnexpectedFiles(t *testing.T) {
+	require := require.New(t)
+	rw := newTestReadWriter(t)
+
+	require.NoError(rw.MkdirAll("/buffer", fileio.DefaultDirectoryPermissions))
+	require.NoError(rw.WriteFile("/buffer/garbage", []byte("x"), fileio.DefaultFilePermissions))
+	require.NoError(rw.WriteFile("/buffer/00000000000000000007.traces", []byte("x"), fileio.DefaultFilePermissions))
+	require.NoError(rw.WriteFile("/buffer/00000000000000000004.logs", []byte("logs"), fileio.DefaultFilePermissions))
+
+	b, err := newBuffer(rw, "/buffer", 1024, log.NewPrefixLogger("test"))
+	require.NoError(err)
+	require.Equal(1, b.Len())
+
+	exists, err := rw.PathExists("/buffer/garbage")
+	require.NoError(err)
+	require.False(exists)
+
+	require.NoError(b.Add(signalMetrics, []byte("metrics")))
+	require.NoError(b.Remove(4))
+	oldest, err := b.Oldest()
+	require.NoError(err)
+	require.Equal(uint64(5), oldest.seq)
+}
@@ -0,0 +1,3 @@
+package telemetry
+
+//go:generate go run -modfile=../../../../tools/go.mod go.uber.org/mock/mockgen -source=telemetry.go -destination=mock_telemetry.go -package=telemetry
@@ -0,0 +1,96 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+)
+
+// errPermanent marks batches rejected by the telemetry gateway, which are dropped instead of retried.
+var errPermanent = errors.New("telemetry batch rejected")
+
+// exporter sends batches to the telemetry gateway.
+type exporter interface {
+	Export(ctx context.Context, b *batch) error
+	Close() error
+}
+
+// otlpExporter sends batches to the telemetry gateway over OTLP/gRPC.
+type otlpExporter struct {
+	conn    *grpc.ClientConn
+	metrics pmetricotlp.GRPCClient
+	logs    plogotlp.GRPCClient
+}
+
+// newOTLPExporter creates an exporter for the telemetry gateway at endpoint, authenticating with the
+// client certificate of the TLS configuration.
+func newOTLPExporter(endpoint string, tlsConfig *tls.Config) (*otlpExporter, error) {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid telemetry endpoint %q: %w", endpoint, err)
+	}
+	tlsConfig = tlsConfig.Clone()
+	tlsConfig.ServerName = host
+
+	conn, err := grpc.NewClient(endpoint,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating telemetry gateway client: %w", err)
+	}
+	return &otlpExporter{
+		conn:    conn,
+		metrics: pmetricotlp.NewGRPCClient(conn),
+		logs:    plogotlp.NewGRPCClient(conn),
+	}, nil
+}
+
+func (e *otlpExporter) Export(ctx context.Context, b *batch) error {
+	var err error
+	switch b.signal {
+	case signalMetrics:
+		var md pmetric.Metrics
+		md, err = (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(b.data)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errPermanent, err)
+		}
+		_, err = e.metrics.Export(ctx, pmetricotlp.NewExportRequestFromMetrics(md))
+	case signalLogs:
+		var ld plog.Logs
+		ld, err = (&plog.ProtoUnmarshaler{}).UnmarshalLogs(b.data)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errPermanent, err)
+		}
+		_, err = e.logs.Export(ctx, plogotlp.NewExportRequestFromLogs(ld))
+	default:
+		return fmt.Errorf("%w: unknown signal %q", errPermanent, b.signal)
+	}
+	if err == nil {
+		return nil
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.Unimplemented, codes.PermissionDenied:
+		// retrying would fail again, e.g. if the gateway does not accept this signal
+		return fmt.Errorf("%w: %w", errPermanent, err)
+	default:
+		return err
+	}
+}
+
+func (e *otlpExporter) Close() error {
+	return e.conn.Close()
+}
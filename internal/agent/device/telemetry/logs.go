@@ -0,0 +1,217 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	// maxLogBatchSize is the maximum number of log records sent in a single batch.
+	maxLogBatchSize = 1000
+	// maxLogMessageLength is the length past which log messages are truncated.
+	maxLogMessageLength = 16 * 1024
+
+	defaultLogPriority = v1beta1.TelemetryLogPriorityInfo
+)
+
+// journalReader reads the entries of the system journal.
+type journalReader interface {
+	Entries(ctx context.Context, options ...client.LogOptions) ([]client.JournalEntry, error)
+}
+
+// logCollector collects the entries of the journal following the last entry it forwarded, which it
+// tracks with a cursor persisted to disk.
+type logCollector struct {
+	journal    journalReader
+	rw         fileio.ReadWriter
+	cursorPath string
+}
+
+func newLogCollector(journal journalReader, rw fileio.ReadWriter, cursorPath string) *logCollector {
+	return &logCollector{
+		journal:    journal,
+		rw:         rw,
+		cursorPath: cursorPath,
+	}
+}
+
+// Collect returns the batches of log records written to the journal since the last collection, or
+// since the given time if there was none, along with the cursor of the last entry read.
+func (l *logCollector) Collect(ctx context.Context, spec *v1beta1.TelemetryLogsSpec, since time.Time, now time.Time) ([]plog.Logs, string, error) {
+	priority := defaultLogPriority
+	if spec.Priority != nil {
+		priority = *spec.Priority
+	}
+	opts := []client.LogOptions{client.WithLogPriority(string(priority))}
+
+	cursor, err := l.readCursor()
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != "" {
+		opts = append(opts, client.WithLogAfterCursor(cursor))
+	} else {
+		opts = append(opts, client.WithLogSince(since))
+	}
+
+	entries, err := l.journal.Entries(ctx, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) == 0 {
+		return nil, cursor, nil
+	}
+
+	journal := slices.Contains(spec.Sources, v1beta1.TelemetryLogsSourceJournal)
+	applications := slices.Contains(spec.Sources, v1beta1.TelemetryLogsSourceApplications)
+	var units []string
+	if spec.Units != nil {
+		units = *spec.Units
+	}
+
+	var batches []plog.Logs
+	var records plog.LogRecordSlice
+	observed := pcommon.NewTimestampFromTime(now)
+	for _, entry := range entries {
+		if _, isContainer := entry["CONTAINER_NAME"]; isContainer {
+			if !applications {
+				continue
+			}
+		} else if !journal || !matchesUnits(entry["_SYSTEMD_UNIT"], units) {
+			continue
+		}
+
+		if len(batches) == 0 || records.Len() >= maxLogBatchSize {
+			var ld plog.Logs
+			ld, records = newResourceLogs()
+			batches = append(batches, ld)
+		}
+		appendLogRecord(records, entry, observed)
+	}
+	return batches, entries[len(entries)-1].Cursor(), nil
+}
+
+// SaveCursor persists the cursor of the last entry forwarded.
+func (l *logCollector) SaveCursor(cursor string) error {
+	if cursor == "" {
+		return nil
+	}
+	if err := l.rw.WriteFile(l.cursorPath, []byte(cursor), fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing journal cursor: %w", err)
+	}
+	return nil
+}
+
+// ResetCursor removes the persisted cursor so that the next collection starts from its given time.
+func (l *logCollector) ResetCursor() error {
+	if err := l.rw.RemoveFile(l.cursorPath); err != nil {
+		return fmt.Errorf("removing journal cursor: %w", err)
+	}
+	return nil
+}
+
+func (l *logCollector) readCursor() (string, error) {
+	data, err := l.rw.ReadFile(l.cursorPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading journal cursor: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func newResourceLogs() (plog.Logs, plog.LogRecordSlice) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	setResourceAttributes(rl.Resource().Attributes())
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	return ld, sl.LogRecords()
+}
+
+// journalAttributes maps the fields of journal entries to the attributes of log records.
+var journalAttributes = map[string]string{
+	"_SYSTEMD_UNIT":     "systemd.unit",
+	"SYSLOG_IDENTIFIER": "syslog.identifier",
+	"_PID":              "process.pid",
+	"CONTAINER_NAME":    "container.name",
+	"CONTAINER_ID_FULL": "container.id",
+}
+
+func appendLogRecord(records plog.LogRecordSlice, entry client.JournalEntry, observed pcommon.Timestamp) {
+	record := records.AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(entry.Timestamp()))
+	record.SetObservedTimestamp(observed)
+
+	message := entry["MESSAGE"]
+	if len(message) > maxLogMessageLength {
+		message = message[:maxLogMessageLength]
+	}
+	record.Body().SetStr(message)
+
+	if priority, err := strconv.Atoi(entry["PRIORITY"]); err == nil {
+		severity, text := journalSeverity(priority)
+		record.SetSeverityNumber(severity)
+		record.SetSeverityText(text)
+	}
+
+	attrs := record.Attributes()
+	for field, attr := range journalAttributes {
+		if value, ok := entry[field]; ok {
+			attrs.PutStr(attr, value)
+		}
+	}
+}
+
+// journalSeverity maps the syslog priority of a journal entry to an OpenTelemetry severity.
+func journalSeverity(priority int) (plog.SeverityNumber, string) {
+	switch priority {
+	case 0:
+		return plog.SeverityNumberFatal4, string(v1beta1.TelemetryLogPriorityEmerg)
+	case 1:
+		return plog.SeverityNumberFatal2, string(v1beta1.TelemetryLogPriorityAlert)
+	case 2:
+		return plog.SeverityNumberFatal, string(v1beta1.TelemetryLogPriorityCrit)
+	case 3:
+		return plog.SeverityNumberError, string(v1beta1.TelemetryLogPriorityErr)
+	case 4:
+		return plog.SeverityNumberWarn, string(v1beta1.TelemetryLogPriorityWarning)
+	case 5:
+		return plog.SeverityNumberInfo2, string(v1beta1.TelemetryLogPriorityNotice)
+	case 6:
+		return plog.SeverityNumberInfo, string(v1beta1.TelemetryLogPriorityInfo)
+	default:
+		return plog.SeverityNumberDebug, string(v1beta1.TelemetryLogPriorityDebug)
+	}
+}
+
+// matchesUnits returns true if the unit matches one of the unit patterns, or if there are none.
+// Patterns without a suffix match services.
+func matchesUnits(unit string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if !strings.Contains(pattern, ".") {
+			pattern += ".service"
+		}
+		if matched, _ := filepath.Match(pattern, unit); matched {
+			return true
+		}
+	}
+	return false
+}
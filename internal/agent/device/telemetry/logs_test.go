@@ -0,0 +1,132 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestLogCollector(t *testing.T) {
+	output := `{"__CURSOR":"c1","__REALTIME_TIMESTAMP":"1700000000000000","PRIORITY":"6","_SYSTEMD_UNIT":"sshd.service","MESSAGE":"Accepted publickey"}
+{"__CURSOR":"c2","__REALTIME_TIMESTAMP":"1700000001000000","PRIORITY":"3","_SYSTEMD_UNIT":"crond.service","MESSAGE":"crond failed"}
+{"__CURSOR":"c3","__REALTIME_TIMESTAMP":"1700000002000000","PRIORITY":"4","CONTAINER_NAME":"web","_SYSTEMD_UNIT":"libpod-1234.scope","MESSAGE":"slow request"}
+`
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name            string
+		spec            v1beta1.TelemetryLogsSpec
+		cursor          string
+		expectedArgs    []string
+		expectedBodies  []string
+		expectedSevText []string
+	}{
+		{
+			name:            "journal and applications",
+			spec:            v1beta1.TelemetryLogsSpec{Sources: []v1beta1.TelemetryLogsSource{v1beta1.TelemetryLogsSourceJournal, v1beta1.TelemetryLogsSourceApplications}},
+			expectedArgs:    []string{"-p", "info", "--since", "2025-01-01 00:00:00"},
+			expectedBodies:  []string{"Accepted publickey", "crond failed", "slow request"},
+			expectedSevText: []string{"info", "err", "warning"},
+		},
+		{
+			name: "journal of some units from the cursor",
+			spec: v1beta1.TelemetryLogsSpec{
+				Sources:  []v1beta1.TelemetryLogsSource{v1beta1.TelemetryLogsSourceJournal},
+				Units:    &[]string{"ssh*", "libpod-*.scope"},
+				Priority: lo.ToPtr(v1beta1.TelemetryLogPriorityWarning),
+			},
+			cursor:          "c0",
+			expectedArgs:    []string{"-p", "warning", "--after-cursor", "c0"},
+			expectedBodies:  []string{"Accepted publickey"},
+			expectedSevText: []string{"info"},
+		},
+		{
+			name:            "applications",
+			spec:            v1beta1.TelemetryLogsSpec{Sources: []v1beta1.TelemetryLogsSource{v1beta1.TelemetryLogsSourceApplications}},
+			expectedArgs:    []string{"-p", "info", "--since", "2025-01-01 00:00:00"},
+			expectedBodies:  []string{"slow request"},
+			expectedSevText: []string{"warning"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			rw := newTestReadWriter(t)
+
+			args := append([]string{"-o", "json", "--no-pager"}, tc.expectedArgs...)
+			mockExec := executer.NewMockExecuter(ctrl)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/journalctl", args).Return(output, "", 0)
+
+			collector := newLogCollector(client.NewJournalctl(mockExec, v1beta1.RootUsername), rw, "/cursor")
+			require.NoError(collector.SaveCursor(tc.cursor))
+
+			batches, cursor, err := collector.Collect(context.Background(), &tc.spec, since, time.Now())
+			require.NoError(err)
+			require.Equal("c3", cursor)
+			require.Len(batches, 1)
+
+			records := batches[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			require.Equal(len(tc.expectedBodies), records.Len())
+			for i := range tc.expectedBodies {
+				require.Equal(tc.expectedBodies[i], records.At(i).Body().Str())
+				require.Equal(tc.expectedSevText[i], records.At(i).SeverityText())
+			}
+
+			require.NoError(collector.SaveCursor(cursor))
+			saved, err := collector.readCursor()
+			require.NoError(err)
+			require.Equal("c3", saved)
+
+			require.NoError(collector.ResetCursor())
+			saved, err = collector.readCursor()
+			require.NoError(err)
+			require.Empty(saved)
+		})
+	}
+}
+
+func TestAppendLogRecord(t *testing.T) {
+	require := require.New(t)
+	_, records := newResourceLogs()
+
+	entry := client.JournalEntry{
+		"__REALTIME_TIMESTAMP": "1700000000000000",
+		"PRIORITY":             "2",
+		"CONTAINER_NAME":       "web",
+		"_PID":                 "42",
+		"MESSAGE":              string(make([]byte, maxLogMessageLength+10)),
+	}
+	appendLogRecord(records, entry, 0)
+
+	record := records.At(0)
+	require.Equal(time.UnixMicro(1700000000000000).UTC(), record.Timestamp().AsTime())
+	require.Equal(plog.SeverityNumberFatal, record.SeverityNumber())
+	require.Len(record.Body().Str(), maxLogMessageLength)
+	name, ok := record.Attributes().Get("container.name")
+	require.True(ok)
+	require.Equal("web", name.Str())
+	pid, ok := record.Attributes().Get("process.pid")
+	require.True(ok)
+	require.Equal("42", pid.Str())
+}
+
+func TestMatchesUnits(t *testing.T) {
+	require := require.New(t)
+	require.True(matchesUnits("sshd.service", nil))
+	require.True(matchesUnits("sshd.service", []string{"sshd"}))
+	require.True(matchesUnits("sshd.service", []string{"sshd.service"}))
+	require.True(matchesUnits("getty@tty1.service", []string{"getty@*"}))
+	require.True(matchesUnits("podman.socket", []string{"*.socket"}))
+	require.False(matchesUnits("podman.socket", []string{"podman"}))
+	require.False(matchesUnits("", []string{"sshd"}))
+}
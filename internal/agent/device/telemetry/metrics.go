@@ -0,0 +1,232 @@
+package telemetry
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/resource"
+	"github.com/flightctl/flightctl/pkg/version"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	// serviceName is the OpenTelemetry service name of the telemetry sent by the agent.
+	serviceName = "flightctl-agent"
+	// scopeName is the instrumentation scope of the telemetry sent by the agent.
+	scopeName = "github.com/flightctl/flightctl/internal/agent/device/telemetry"
+
+	defaultProcNetDevPath = "/proc/net/dev"
+	// clockTicksPerSecond is the unit of the CPU times of /proc/stat (USER_HZ).
+	clockTicksPerSecond = 100
+	kilobyte            = 1024
+)
+
+// newResourceMetrics creates the metrics of the agent, returning the slice to append metrics to.
+func newResourceMetrics() (pmetric.Metrics, pmetric.MetricSlice) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	setResourceAttributes(rm.Resource().Attributes())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	return md, sm.Metrics()
+}
+
+func setResourceAttributes(attrs pcommon.Map) {
+	attrs.PutStr("service.name", serviceName)
+	attrs.PutStr("service.version", version.Get().String())
+}
+
+// hostCollector collects the metrics of the device following the OpenTelemetry semantic
+// conventions for system metrics.
+type hostCollector struct {
+	netDevPath string
+	rootPath   string
+}
+
+func newHostCollector() *hostCollector {
+	return &hostCollector{
+		netDevPath: defaultProcNetDevPath,
+		rootPath:   "/",
+	}
+}
+
+func (h *hostCollector) Collect(ctx context.Context, metrics pmetric.MetricSlice, now time.Time) error {
+	ts := pcommon.NewTimestampFromTime(now)
+
+	var cpu resource.CPUUsage
+	if err := resource.CollectCPUUsage(ctx, &cpu); err != nil {
+		return fmt.Errorf("collecting cpu usage: %w", err)
+	}
+	cpuTime := newSum(metrics, "system.cpu.time", "s", "Seconds each logical CPU spent on each mode.")
+	for state, ticks := range map[string]float64{
+		"user":      cpu.User + cpu.Nice,
+		"system":    cpu.System,
+		"idle":      cpu.Idle,
+		"wait":      cpu.Iowait,
+		"interrupt": cpu.Irq + cpu.Softirq,
+		"steal":     cpu.Steal,
+	} {
+		dp := cpuTime.DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(ticks / clockTicksPerSecond)
+		dp.Attributes().PutStr("state", state)
+	}
+
+	var memory resource.MemoryUsage
+	if err := resource.CollectMemoryUsage(ctx, &memory); err != nil {
+		return fmt.Errorf("collecting memory usage: %w", err)
+	}
+	memoryFree := memory.MemFree + memory.Buffers + memory.Cached + memory.SReclaimable - memory.Shmem
+	memoryUsed := memory.MemTotal - memoryFree
+	memoryUsage := newGauge(metrics, "system.memory.usage", "By", "Bytes of memory in use.")
+	addIntDataPoint(memoryUsage.DataPoints(), ts, int64(memoryUsed*kilobyte), "state", "used")
+	addIntDataPoint(memoryUsage.DataPoints(), ts, int64(memoryFree*kilobyte), "state", "free")
+	if memory.MemTotal > 0 {
+		memoryUtilization := newGauge(metrics, "system.memory.utilization", "1", "Fraction of memory in use.")
+		dp := memoryUtilization.DataPoints().AppendEmpty()
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(float64(memoryUsed) / float64(memory.MemTotal))
+		dp.Attributes().PutStr("state", "used")
+	}
+
+	disk, err := resource.DirUsage(h.rootPath)
+	if err != nil {
+		return fmt.Errorf("collecting filesystem usage: %w", err)
+	}
+	filesystemUsage := newGauge(metrics, "system.filesystem.usage", "By", "Bytes of filesystem space in use.")
+	addIntDataPoint(filesystemUsage.DataPoints(), ts, int64(disk.Used), "mountpoint", h.rootPath, "state", "used")
+	addIntDataPoint(filesystemUsage.DataPoints(), ts, int64(disk.Free), "mountpoint", h.rootPath, "state", "free")
+
+	interfaces, err := readNetDev(h.netDevPath)
+	if err != nil {
+		return fmt.Errorf("collecting network usage: %w", err)
+	}
+	networkIO := newSum(metrics, "system.network.io", "By", "Bytes transmitted and received by each network interface.")
+	for _, iface := range interfaces {
+		addIntDataPoint(networkIO.DataPoints(), ts, int64(iface.receivedBytes), "device", iface.name, "direction", "receive")
+		addIntDataPoint(networkIO.DataPoints(), ts, int64(iface.transmittedBytes), "device", iface.name, "direction", "transmit")
+	}
+	return nil
+}
+
+type netDevStats struct {
+	name             string
+	receivedBytes    uint64
+	transmittedBytes uint64
+}
+
+// readNetDev reads the bytes received and transmitted by the network interfaces of the device,
+// excluding the loopback interface.
+func readNetDev(path string) ([]netDevStats, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var stats []netDevStats
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, counters, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			// header lines
+			continue
+		}
+		name = strings.TrimSpace(name)
+		fields := strings.Fields(counters)
+		if name == "lo" || len(fields) < 9 {
+			continue
+		}
+		received, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing received bytes of %s: %w", name, err)
+		}
+		transmitted, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing transmitted bytes of %s: %w", name, err)
+		}
+		stats = append(stats, netDevStats{name: name, receivedBytes: received, transmittedBytes: transmitted})
+	}
+	return stats, scanner.Err()
+}
+
+// collectApplicationMetrics adds the metrics of the applications as last reported in the status
+// of the device.
+func collectApplicationMetrics(status *v1beta1.DeviceStatus, metrics pmetric.MetricSlice, now time.Time) {
+	if status == nil || len(status.Applications) == 0 {
+		return
+	}
+	ts := pcommon.NewTimestampFromTime(now)
+
+	cpuUtilization := newGauge(metrics, "flightctl.application.cpu.utilization", "1", "Fraction of the CPU capacity of the device used by the application.")
+	memoryUsage := newGauge(metrics, "flightctl.application.memory.usage", "By", "Bytes of memory used by the application.")
+	volumeUsage := newGauge(metrics, "flightctl.application.volume.usage", "By", "Bytes of disk space used by the volumes of the application.")
+	restarts := newSum(metrics, "flightctl.application.restarts", "{restart}", "Number of restarts of the application.")
+	appStatus := newGauge(metrics, "flightctl.application.status", "1", "Status of the application, 1 for its current status.")
+
+	for _, app := range status.Applications {
+		attrs := []string{"application.name", app.Name, "application.type", string(app.AppType)}
+		if app.Usage != nil {
+			dp := cpuUtilization.DataPoints().AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.SetDoubleValue(float64(app.Usage.CpuPercentage) / 100)
+			putAttributes(dp.Attributes(), attrs...)
+			addIntDataPoint(memoryUsage.DataPoints(), ts, app.Usage.MemoryBytes, attrs...)
+			if app.Usage.VolumeBytes != nil {
+				addIntDataPoint(volumeUsage.DataPoints(), ts, *app.Usage.VolumeBytes, attrs...)
+			}
+		}
+		addIntDataPoint(restarts.DataPoints(), ts, int64(app.Restarts), attrs...)
+		addIntDataPoint(appStatus.DataPoints(), ts, 1, append(attrs, "status", string(app.Status))...)
+	}
+
+	// drop the metrics which no application reported
+	metrics.RemoveIf(func(m pmetric.Metric) bool {
+		switch m.Type() {
+		case pmetric.MetricTypeGauge:
+			return m.Gauge().DataPoints().Len() == 0
+		case pmetric.MetricTypeSum:
+			return m.Sum().DataPoints().Len() == 0
+		}
+		return false
+	})
+}
+
+func newGauge(metrics pmetric.MetricSlice, name, unit, description string) pmetric.Gauge {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetUnit(unit)
+	m.SetDescription(description)
+	return m.SetEmptyGauge()
+}
+
+func newSum(metrics pmetric.MetricSlice, name, unit, description string) pmetric.Sum {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetUnit(unit)
+	m.SetDescription(description)
+	s := m.SetEmptySum()
+	s.SetIsMonotonic(true)
+	s.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	return s
+}
+
+func addIntDataPoint(dps pmetric.NumberDataPointSlice, ts pcommon.Timestamp, value int64, attrs ...string) {
+	dp := dps.AppendEmpty()
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(value)
+	putAttributes(dp.Attributes(), attrs...)
+}
+
+func putAttributes(m pcommon.Map, attrs ...string) {
+	for i := 0; i+1 < len(attrs); i += 2 {
+		m.PutStr(attrs[i], attrs[i+1])
+	}
+}
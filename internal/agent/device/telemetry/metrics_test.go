@@ -0,0 +1,94 @@
+package telemetry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestReadNetDev(t *testing.T) {
+	require := require.New(t)
+
+	netDev := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:  123456     100    0    0    0     0          0         0   123456     100    0    0    0     0       0          0
+  eth0: 9876543    5000    0    0    0     0          0         0  1234567    4000    0    0    0     0       0          0
+`
+	path := filepath.Join(t.TempDir(), "dev")
+	require.NoError(os.WriteFile(path, []byte(netDev), 0600))
+
+	stats, err := readNetDev(path)
+	require.NoError(err)
+	require.Equal([]netDevStats{{name: "eth0", receivedBytes: 9876543, transmittedBytes: 1234567}}, stats)
+}
+
+func TestCollectApplicationMetrics(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+
+	_, metrics := newResourceMetrics()
+	collectApplicationMetrics(nil, metrics, now)
+	require.Equal(0, metrics.Len())
+
+	status := &v1beta1.DeviceStatus{
+		Applications: []v1beta1.DeviceApplicationStatus{
+			{
+				Name:     "web",
+				AppType:  v1beta1.AppTypeCompose,
+				Status:   v1beta1.ApplicationStatusRunning,
+				Restarts: 2,
+				Usage: &v1beta1.ApplicationResourceUsage{
+					CpuPercentage: 50,
+					MemoryBytes:   1024,
+				},
+			},
+			{
+				Name:    "job",
+				AppType: v1beta1.AppTypeQuadlet,
+				Status:  v1beta1.ApplicationStatusError,
+			},
+		},
+	}
+	collectApplicationMetrics(status, metrics, now)
+
+	byName := map[string]pmetric.Metric{}
+	for i := 0; i < metrics.Len(); i++ {
+		byName[metrics.At(i).Name()] = metrics.At(i)
+	}
+	// no application reported the usage of its volumes
+	require.NotContains(byName, "flightctl.application.volume.usage")
+
+	cpu := byName["flightctl.application.cpu.utilization"].Gauge().DataPoints()
+	require.Equal(1, cpu.Len())
+	require.InDelta(0.5, cpu.At(0).DoubleValue(), 0.001)
+	name, _ := cpu.At(0).Attributes().Get("application.name")
+	require.Equal("web", name.Str())
+
+	restarts := byName["flightctl.application.restarts"].Sum().DataPoints()
+	require.Equal(2, restarts.Len())
+	require.Equal(int64(2), restarts.At(0).IntValue())
+
+	appStatus := byName["flightctl.application.status"].Gauge().DataPoints()
+	require.Equal(2, appStatus.Len())
+	statusAttr, _ := appStatus.At(1).Attributes().Get("status")
+	require.Equal(string(v1beta1.ApplicationStatusError), statusAttr.Str())
+
+	// volume usage is reported once available
+	status.Applications[0].Usage.VolumeBytes = lo.ToPtr(int64(4096))
+	_, metrics = newResourceMetrics()
+	collectApplicationMetrics(status, metrics, now)
+	found := false
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "flightctl.application.volume.usage" {
+			found = true
+			require.Equal(int64(4096), metrics.At(i).Gauge().DataPoints().At(0).IntValue())
+		}
+	}
+	require.True(found)
+}
@@ -0,0 +1,332 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/internal/agent/identity"
+	"github.com/flightctl/flightctl/pkg/log"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMetricsInterval is the interval between two collections of metrics if the device spec
+	// does not set one.
+	DefaultMetricsInterval = 60 * time.Second
+	// logsInterval is the interval between two collections of logs.
+	logsInterval = 15 * time.Second
+	// exportTimeout is the timeout of the export of a single batch.
+	exportTimeout = 30 * time.Second
+
+	telemetryDir = "telemetry"
+	bufferDir    = "buffer"
+	cursorFile   = "journal-cursor"
+)
+
+// Manager forwards the metrics and logs of the device to the telemetry gateway.
+type Manager interface {
+	// Run collects and forwards telemetry until the context is canceled.
+	Run(ctx context.Context)
+	// Sync applies the telemetry configuration of the device spec.
+	Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error
+}
+
+type manager struct {
+	endpoint      string
+	dataDir       string
+	maxBufferSize int64
+	newExporter   func() (exporter, error)
+	host          *hostCollector
+	logs          *logCollector
+	statusGetter  status.Getter
+	rw            fileio.ReadWriter
+	log           *log.PrefixLogger
+
+	mu        sync.Mutex
+	synced    bool
+	spec      *v1beta1.DeviceTelemetrySpec
+	limiter   *rate.Limiter
+	logsSince time.Time
+	updateCh  chan struct{}
+
+	buffer      *buffer
+	exporter    exporter
+	unreachable bool
+}
+
+// NewManager creates a telemetry manager forwarding telemetry to the telemetry gateway of the
+// agent configuration, authenticating with the management certificate of the device.
+func NewManager(
+	cfg *config.Config,
+	identityProvider identity.Provider,
+	statusGetter status.Getter,
+	journal journalReader,
+	rw fileio.ReadWriter,
+	log *log.PrefixLogger,
+) Manager {
+	dir := filepath.Join(cfg.DataDir, telemetryDir)
+	return &manager{
+		endpoint:      cfg.Telemetry.Endpoint,
+		dataDir:       dir,
+		maxBufferSize: cfg.Telemetry.MaxBufferSize,
+		newExporter: func() (exporter, error) {
+			tlsConfig, err := identityProvider.CreateTLSConfig(&cfg.ManagementService.Config)
+			if err != nil {
+				return nil, fmt.Errorf("creating telemetry TLS config: %w", err)
+			}
+			return newOTLPExporter(cfg.Telemetry.Endpoint, tlsConfig)
+		},
+		host:         newHostCollector(),
+		logs:         newLogCollector(journal, rw, filepath.Join(dir, cursorFile)),
+		statusGetter: statusGetter,
+		rw:           rw,
+		log:          log,
+		limiter:      rate.NewLimiter(rate.Inf, 0),
+		logsSince:    time.Now(),
+		updateCh:     make(chan struct{}, 1),
+	}
+}
+
+func (m *manager) Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec := desired.Telemetry
+	if m.synced && reflect.DeepEqual(m.spec, spec) {
+		return nil
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if spec != nil && spec.MaxUploadRate != nil {
+		bytesPerSecond, err := parseUploadRate(*spec.MaxUploadRate)
+		if err != nil {
+			return err
+		}
+		limiter = rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)
+	}
+
+	logsEnabled := spec != nil && spec.Logs != nil
+	wasLogsEnabled := m.spec != nil && m.spec.Logs != nil
+	if !logsEnabled {
+		// the logs written while forwarding is disabled are not forwarded once it is enabled again
+		if err := m.logs.ResetCursor(); err != nil {
+			return err
+		}
+	} else if m.synced && !wasLogsEnabled {
+		m.logsSince = time.Now()
+	}
+
+	m.spec = spec
+	m.limiter = limiter
+	m.synced = true
+
+	select {
+	case m.updateCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (m *manager) Run(ctx context.Context) {
+	if m.endpoint == "" {
+		m.log.Debug("Telemetry gateway endpoint not configured: telemetry is not forwarded")
+		return
+	}
+
+	var err error
+	m.buffer, err = newBuffer(m.rw, filepath.Join(m.dataDir, bufferDir), m.maxBufferSize, m.log)
+	if err != nil {
+		m.log.Errorf("Failed to create telemetry buffer: %v", err)
+		return
+	}
+	m.exporter, err = m.newExporter()
+	if err != nil {
+		m.log.Errorf("Failed to create telemetry exporter: %v", err)
+		return
+	}
+	defer m.exporter.Close()
+
+	m.log.Infof("Forwarding telemetry to %s", m.endpoint)
+
+	metricsTicker := time.NewTicker(m.metricsInterval())
+	defer metricsTicker.Stop()
+	logsTicker := time.NewTicker(logsInterval)
+	defer logsTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.updateCh:
+			metricsTicker.Reset(m.metricsInterval())
+		case <-metricsTicker.C:
+			m.collectMetrics(ctx, time.Now())
+			m.flush(ctx)
+		case <-logsTicker.C:
+			m.collectLogs(ctx, time.Now())
+			m.flush(ctx)
+		}
+	}
+}
+
+func (m *manager) currentSpec() (*v1beta1.DeviceTelemetrySpec, time.Time, *rate.Limiter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.spec, m.logsSince, m.limiter
+}
+
+func (m *manager) metricsInterval() time.Duration {
+	spec, _, _ := m.currentSpec()
+	if spec == nil || spec.Metrics == nil || spec.Metrics.Interval == nil {
+		return DefaultMetricsInterval
+	}
+	interval, err := time.ParseDuration(*spec.Metrics.Interval)
+	if err != nil || interval <= 0 {
+		return DefaultMetricsInterval
+	}
+	return interval
+}
+
+func (m *manager) collectMetrics(ctx context.Context, now time.Time) {
+	spec, _, _ := m.currentSpec()
+	if spec == nil || spec.Metrics == nil {
+		return
+	}
+
+	md, metrics := newResourceMetrics()
+	if slices.Contains(spec.Metrics.Sources, v1beta1.TelemetryMetricsSourceHost) {
+		if err := m.host.Collect(ctx, metrics, now); err != nil {
+			m.log.Warnf("Failed to collect host metrics: %v", err)
+		}
+	}
+	if slices.Contains(spec.Metrics.Sources, v1beta1.TelemetryMetricsSourceApplications) {
+		collectApplicationMetrics(m.statusGetter.Get(ctx), metrics, now)
+	}
+	if metrics.Len() == 0 {
+		return
+	}
+
+	data, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	if err != nil {
+		m.log.Errorf("Failed to marshal metrics: %v", err)
+		return
+	}
+	if err := m.buffer.Add(signalMetrics, data); err != nil {
+		m.log.Errorf("Failed to buffer metrics: %v", err)
+	}
+}
+
+func (m *manager) collectLogs(ctx context.Context, now time.Time) {
+	spec, since, _ := m.currentSpec()
+	if spec == nil || spec.Logs == nil {
+		return
+	}
+
+	batches, cursor, err := m.logs.Collect(ctx, spec.Logs, since, now)
+	if err != nil {
+		m.log.Warnf("Failed to collect logs: %v", err)
+		return
+	}
+	for _, ld := range batches {
+		data, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+		if err != nil {
+			m.log.Errorf("Failed to marshal logs: %v", err)
+			return
+		}
+		if err := m.buffer.Add(signalLogs, data); err != nil {
+			m.log.Errorf("Failed to buffer logs: %v", err)
+			return
+		}
+	}
+	if err := m.logs.SaveCursor(cursor); err != nil {
+		m.log.Errorf("Failed to save journal cursor: %v", err)
+	}
+}
+
+// flush exports the buffered batches from the oldest, until the buffer is empty or an export fails.
+func (m *manager) flush(ctx context.Context) {
+	_, _, limiter := m.currentSpec()
+	for ctx.Err() == nil {
+		b, err := m.buffer.Oldest()
+		if err != nil {
+			m.log.Errorf("Failed to read telemetry buffer: %v", err)
+			return
+		}
+		if b == nil {
+			return
+		}
+
+		if err := waitUploadRate(ctx, limiter, len(b.data)); err != nil {
+			return
+		}
+
+		exportCtx, cancel := context.WithTimeout(ctx, exportTimeout)
+		err = m.exporter.Export(exportCtx, b)
+		cancel()
+		if err != nil && !errors.Is(err, errPermanent) {
+			if !m.unreachable {
+				m.log.Warnf("Telemetry gateway unreachable, buffering telemetry: %v", err)
+				m.unreachable = true
+			}
+			return
+		}
+		if err != nil {
+			m.log.Warnf("Dropping %s batch: %v", b.signal, err)
+		}
+		if m.unreachable {
+			m.log.Infof("Telemetry gateway reachable again, forwarding %d buffered batches", m.buffer.Len())
+			m.unreachable = false
+		}
+		if err := m.buffer.Remove(b.seq); err != nil {
+			m.log.Errorf("Failed to remove batch from telemetry buffer: %v", err)
+			return
+		}
+	}
+}
+
+// waitUploadRate waits until size bytes may be uploaded, in chunks of at most the burst of the limiter.
+func waitUploadRate(ctx context.Context, limiter *rate.Limiter, size int) error {
+	if limiter.Limit() == rate.Inf {
+		return nil
+	}
+	for size > 0 {
+		n := min(size, limiter.Burst())
+		if err := limiter.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
+
+// parseUploadRate parses an upload rate in bytes per second, with an optional k, m or g unit.
+func parseUploadRate(s string) (int, error) {
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(s, "k"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(s, "m"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(s, "g"):
+		multiplier = 1 << 30
+	}
+	value, err := strconv.Atoi(strings.TrimRight(s, "kmg"))
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid telemetry upload rate %q", s)
+	}
+	return value * multiplier, nil
+}
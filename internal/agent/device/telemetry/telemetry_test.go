@@ -0,0 +1,167 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+type fakeExporter struct {
+	exported []*batch
+	err      error
+}
+
+func (f *fakeExporter) Export(_ context.Context, b *batch) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.exported = append(f.exported, b)
+	return nil
+}
+
+func (f *fakeExporter) Close() error {
+	return nil
+}
+
+func newTestManager(t *testing.T) (*manager, *fakeExporter) {
+	rw := newTestReadWriter(t)
+	log := log.NewPrefixLogger("test")
+	b, err := newBuffer(rw, "/telemetry/buffer", 1024, log)
+	require.NoError(t, err)
+	exp := &fakeExporter{}
+	return &manager{
+		endpoint: "telemetry.example.com:4317",
+		logs:     newLogCollector(nil, rw, "/telemetry/journal-cursor"),
+		rw:       rw,
+		log:      log,
+		limiter:  rate.NewLimiter(rate.Inf, 0),
+		updateCh: make(chan struct{}, 1),
+		buffer:   b,
+		exporter: exp,
+	}, exp
+}
+
+func TestManagerFlush(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m, exp := newTestManager(t)
+
+	require.NoError(m.buffer.Add(signalMetrics, []byte("metrics")))
+	require.NoError(m.buffer.Add(signalLogs, []byte("logs")))
+
+	// unreachable gateway: batches are kept
+	exp.err = errors.New("connection refused")
+	m.flush(ctx)
+	require.True(m.unreachable)
+	require.Equal(2, m.buffer.Len())
+
+	// rejected batches are dropped
+	exp.err = fmt.Errorf("%w: unimplemented", errPermanent)
+	m.flush(ctx)
+	require.False(m.unreachable)
+	require.Equal(0, m.buffer.Len())
+
+	require.NoError(m.buffer.Add(signalMetrics, []byte("metrics")))
+	require.NoError(m.buffer.Add(signalLogs, []byte("logs")))
+	exp.err = nil
+	m.flush(ctx)
+	require.Equal(0, m.buffer.Len())
+	require.Len(exp.exported, 2)
+	require.Equal(signalMetrics, exp.exported[0].signal)
+	require.Equal(signalLogs, exp.exported[1].signal)
+}
+
+func TestManagerSync(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m, _ := newTestManager(t)
+
+	require.NoError(m.logs.SaveCursor("c1"))
+
+	// logs are enabled after a restart: the cursor is kept
+	spec := &v1beta1.DeviceSpec{
+		Telemetry: &v1beta1.DeviceTelemetrySpec{
+			Metrics: &v1beta1.TelemetryMetricsSpec{
+				Sources:  []v1beta1.TelemetryMetricsSource{v1beta1.TelemetryMetricsSourceHost},
+				Interval: lo.ToPtr("30s"),
+			},
+			Logs: &v1beta1.TelemetryLogsSpec{
+				Sources: []v1beta1.TelemetryLogsSource{v1beta1.TelemetryLogsSourceJournal},
+			},
+			MaxUploadRate: lo.ToPtr("2k"),
+		},
+	}
+	require.NoError(m.Sync(ctx, spec))
+	require.Equal(30*time.Second, m.metricsInterval())
+	require.Equal(rate.Limit(2048), m.limiter.Limit())
+	require.Equal(2048, m.limiter.Burst())
+	cursor, err := m.logs.readCursor()
+	require.NoError(err)
+	require.Equal("c1", cursor)
+	require.Len(m.updateCh, 1)
+	<-m.updateCh
+
+	// unchanged spec
+	require.NoError(m.Sync(ctx, spec))
+	require.Len(m.updateCh, 0)
+
+	// logs are disabled: the cursor is reset
+	require.NoError(m.Sync(ctx, &v1beta1.DeviceSpec{}))
+	require.Equal(DefaultMetricsInterval, m.metricsInterval())
+	require.Equal(rate.Inf, m.limiter.Limit())
+	cursor, err = m.logs.readCursor()
+	require.NoError(err)
+	require.Empty(cursor)
+
+	// logs are enabled again: they are forwarded from now
+	before := time.Now()
+	require.NoError(m.Sync(ctx, spec))
+	require.False(m.logsSince.Before(before))
+}
+
+func TestParseUploadRate(t *testing.T) {
+	testCases := []struct {
+		rate     string
+		expected int
+		wantErr  bool
+	}{
+		{rate: "100", expected: 100},
+		{rate: "64k", expected: 64 * 1024},
+		{rate: "2m", expected: 2 * 1024 * 1024},
+		{rate: "1g", expected: 1024 * 1024 * 1024},
+		{rate: "0k", wantErr: true},
+		{rate: "k", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.rate, func(t *testing.T) {
+			value, err := parseUploadRate(tc.rate)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestWaitUploadRate(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// payloads larger than the burst are waited for in chunks
+	limiter := rate.NewLimiter(rate.Limit(1000), 1000)
+	require.NoError(waitUploadRate(ctx, limiter, 1000))
+
+	cancel()
+	require.Error(waitUploadRate(ctx, limiter, 5000))
+	require.NoError(waitUploadRate(ctx, rate.NewLimiter(rate.Inf, 0), 5000))
+}
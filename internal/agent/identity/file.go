@@ -3,6 +3,7 @@ package identity
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"fmt"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -50,7 +51,7 @@ type softwareExportableProvider struct {
 func newSoftwareExportableProvider() *softwareExportableProvider {
 	return &softwareExportableProvider{}
 }
-func (f *softwareExportableProvider) NewExportable(name string) (*Exportable, error) {
+func (f *softwareExportableProvider) NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error) {
 	_, priv, err := fccrypto.NewKeyPair()
 	if err != nil {
 		return nil, fmt.Errorf("creating key pair: %q: %w", name, err)
@@ -60,7 +61,7 @@ func (f *softwareExportableProvider) NewExportable(name string) (*Exportable, er
 		return nil, fmt.Errorf("expected crypto.Signer, got %T", priv)
 	}
 
-	csr, err := fccrypto.MakeCSR(signer, name)
+	csr, err := fccrypto.MakeCSR(signer, name, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating CSR: %w", err)
 	}
@@ -180,6 +181,41 @@ func (f *fileProvider) CreateGRPCClient(config *baseclient.Config) (grpc_v1.Rout
 	return baseclient.NewGRPCClientFromConfig(config, "")
 }
 
+func (f *fileProvider) CreateTLSConfig(config *baseclient.Config) (*tls.Config, error) {
+	configCopy := config.DeepCopy()
+	if err := configCopy.Flatten(); err != nil {
+		return nil, fmt.Errorf("flattening config: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		InsecureSkipVerify: configCopy.Service.InsecureSkipVerify, //nolint:gosec
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certPEM, err := f.rw.ReadFile(f.clientCertPath)
+			if err != nil {
+				return nil, fmt.Errorf("reading certificate file %q: %w", f.clientCertPath, err)
+			}
+			keyPEM, err := f.rw.ReadFile(f.clientKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("reading key file %q: %w", f.clientKeyPath, err)
+			}
+			tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("parsing client cert and key: %w", err)
+			}
+			return &tlsCert, nil
+		},
+	}
+	if len(configCopy.Service.CertificateAuthorityData) > 0 {
+		caPool, err := cert.NewPoolFromBytes(configCopy.Service.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("parsing CA certs: %w", err)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+	return tlsConfig, nil
+}
+
 func (f *fileProvider) WipeCredentials() error {
 	var errs []error
 
@@ -4,8 +4,10 @@ import (
 	"crypto"
 	"crypto/x509"
 	"encoding/pem"
+	"net"
 	"testing"
 
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,6 +75,25 @@ func TestSoftwareExportableProvider_NewExportable(t *testing.T) {
 	}
 }
 
+func TestSoftwareExportableProvider_NewExportable_WithSubjectAlternativeNames(t *testing.T) {
+	provider := newSoftwareExportableProvider()
+
+	result, err := provider.NewExportable("web.example.com",
+		fccrypto.WithDNSNames("web.example.com", "www.example.com"),
+		fccrypto.WithIPAddresses(net.ParseIP("192.168.1.10")),
+	)
+	require.NoError(t, err)
+
+	csr, err := result.CSR()
+	require.NoError(t, err)
+	parsedCSR, err := fccrypto.ParseCSR(csr)
+	require.NoError(t, err)
+	require.Equal(t, "web.example.com", parsedCSR.Subject.CommonName)
+	require.Equal(t, []string{"web.example.com", "www.example.com"}, parsedCSR.DNSNames)
+	require.Len(t, parsedCSR.IPAddresses, 1)
+	require.True(t, parsedCSR.IPAddresses[0].Equal(net.ParseIP("192.168.1.10")))
+}
+
 func TestSoftwareExportableProvider_NewExportable_GeneratesUniqueKeys(t *testing.T) {
 	provider := newSoftwareExportableProvider()
 
@@ -3,6 +3,7 @@ package identity
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"encoding/base32"
 	"errors"
 	"fmt"
@@ -63,8 +64,9 @@ func (e *Exportable) KeyPEM() ([]byte, error) {
 
 // ExportableProvider defines the interface for providing Exportable identities
 type ExportableProvider interface {
-	// NewExportable creates an Exportable for the specified name
-	NewExportable(name string) (*Exportable, error)
+	// NewExportable creates an Exportable for the specified name. The options customize the CSR,
+	// e.g. to request subject alternative names.
+	NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error)
 }
 
 // Provider defines the interface for identity providers that handle device authentication.
@@ -88,6 +90,10 @@ type Provider interface {
 	CreateManagementClient(config *base_client.Config, metricsCallback client.RPCMetricsCallback) (client.Management, error)
 	// CreateGRPCClient creates a fully configured gRPC client with this identity
 	CreateGRPCClient(config *base_client.Config) (grpc_v1.RouterServiceClient, error)
+	// CreateTLSConfig creates a TLS client configuration presenting this identity, for connections to
+	// other services of the management server such as the telemetry gateway. The certificate is
+	// loaded on each handshake so that renewed certificates are picked up.
+	CreateTLSConfig(config *base_client.Config) (*tls.Config, error)
 	// WipeCredentials securely removes all stored credentials (certificates and keys)
 	WipeCredentials() error
 	// WipeCertificateOnly securely removes only the certificate (not keys or CSR)
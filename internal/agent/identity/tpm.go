@@ -20,6 +20,7 @@ import (
 	agent_client "github.com/flightctl/flightctl/internal/api/client/agent"
 	base_client "github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/tpm"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
 	"google.golang.org/grpc"
@@ -72,7 +73,10 @@ func newTPMExportableProvider(client tpm.Client) *tpmExportableProvider {
 	}
 }
 
-func (t *tpmExportableProvider) NewExportable(name string) (*Exportable, error) {
+func (t *tpmExportableProvider) NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error) {
+	if len(opts) > 0 {
+		return nil, fmt.Errorf("creating application identity: %q: TPM-backed CSRs do not support CSR options", name)
+	}
 	csr, keyPem, err := t.client.CreateApplicationKey(name)
 	if err != nil {
 		return nil, fmt.Errorf("creating application identity: %q: %w", name, err)
@@ -488,6 +492,24 @@ func (t *tpmProvider) CreateGRPCClient(config *base_client.Config) (grpc_v1.Rout
 	return router, nil
 }
 
+func (t *tpmProvider) CreateTLSConfig(config *base_client.Config) (*tls.Config, error) {
+	configCopy, err := normalizeManagementConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("normalizing config: %w", err)
+	}
+
+	tlsConfig, err := t.createTLSConfig(configCopy)
+	if err != nil {
+		return nil, err
+	}
+	// the server name is that of the service being connected to, not of the management server
+	tlsConfig.ServerName = ""
+	tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return t.createCertificate()
+	}
+	return tlsConfig, nil
+}
+
 func (t *tpmProvider) WipeCredentials() error {
 	var errs []error
 
@@ -0,0 +1,29 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// AuditEventConverter converts between v1beta1 API types and domain types for AuditEvent resources.
+type AuditEventConverter interface {
+	ListFromDomain(*domain.AuditEventList) *apiv1beta1.AuditEventList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListAuditEventsParams) domain.ListAuditEventsParams
+}
+
+type auditEventConverter struct{}
+
+// NewAuditEventConverter creates a new AuditEventConverter.
+func NewAuditEventConverter() AuditEventConverter {
+	return &auditEventConverter{}
+}
+
+func (c *auditEventConverter) ListFromDomain(l *domain.AuditEventList) *apiv1beta1.AuditEventList {
+	return l
+}
+
+func (c *auditEventConverter) ListParamsToDomain(p apiv1beta1.ListAuditEventsParams) domain.ListAuditEventsParams {
+	return p
+}
@@ -0,0 +1,34 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// CertificateRevocationConverter converts between v1beta1 API types and domain types for CertificateRevocation resources.
+type CertificateRevocationConverter interface {
+	ListFromDomain(*domain.CertificateRevocationList) *apiv1beta1.CertificateRevocationList
+	RequestToDomain(apiv1beta1.CertificateRevocationRequest) domain.CertificateRevocationRequest
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListCertificateRevocationsParams) domain.ListCertificateRevocationsParams
+}
+
+type certificateRevocationConverter struct{}
+
+// NewCertificateRevocationConverter creates a new CertificateRevocationConverter.
+func NewCertificateRevocationConverter() CertificateRevocationConverter {
+	return &certificateRevocationConverter{}
+}
+
+func (c *certificateRevocationConverter) ListFromDomain(l *domain.CertificateRevocationList) *apiv1beta1.CertificateRevocationList {
+	return l
+}
+
+func (c *certificateRevocationConverter) RequestToDomain(r apiv1beta1.CertificateRevocationRequest) domain.CertificateRevocationRequest {
+	return r
+}
+
+func (c *certificateRevocationConverter) ListParamsToDomain(p apiv1beta1.ListCertificateRevocationsParams) domain.ListCertificateRevocationsParams {
+	return p
+}
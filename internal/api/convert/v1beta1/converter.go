@@ -3,14 +3,22 @@ package v1beta1
 // Converter aggregates all resource-specific converters for v1beta1 API.
 type Converter interface {
 	Device() DeviceConverter
+	DeviceBulkOperation() DeviceBulkOperationConverter
 	Fleet() FleetConverter
 	Repository() RepositoryConverter
 	EnrollmentRequest() EnrollmentRequestConverter
 	CertificateSigningRequest() CertificateSigningRequestConverter
+	CertificateRevocation() CertificateRevocationConverter
 	AuthProvider() AuthProviderConverter
 	ResourceSync() ResourceSyncConverter
+	SecretStore() SecretStoreConverter
+	EnrollmentApprovalPolicy() EnrollmentApprovalPolicyConverter
+	Role() RoleConverter
+	RoleBinding() RoleBindingConverter
+	ServiceAccount() ServiceAccountConverter
 	TemplateVersion() TemplateVersionConverter
 	Event() EventConverter
+	AuditEvent() AuditEventConverter
 	Organization() OrganizationConverter
 	Common() CommonConverter
 	Auth() AuthConverter
@@ -18,14 +26,22 @@ type Converter interface {
 
 type converterImpl struct {
 	device                    DeviceConverter
+	deviceBulkOperation       DeviceBulkOperationConverter
 	fleet                     FleetConverter
 	repository                RepositoryConverter
 	enrollmentRequest         EnrollmentRequestConverter
 	certificateSigningRequest CertificateSigningRequestConverter
+	certificateRevocation     CertificateRevocationConverter
 	authProvider              AuthProviderConverter
 	resourceSync              ResourceSyncConverter
+	secretStore               SecretStoreConverter
+	enrollmentApprovalPolicy  EnrollmentApprovalPolicyConverter
+	role                      RoleConverter
+	roleBinding               RoleBindingConverter
+	serviceAccount            ServiceAccountConverter
 	templateVersion           TemplateVersionConverter
 	event                     EventConverter
+	auditEvent                AuditEventConverter
 	organization              OrganizationConverter
 	common                    CommonConverter
 	auth                      AuthConverter
@@ -35,14 +51,22 @@ type converterImpl struct {
 func NewConverter() Converter {
 	return &converterImpl{
 		device:                    NewDeviceConverter(),
+		deviceBulkOperation:       NewDeviceBulkOperationConverter(),
 		fleet:                     NewFleetConverter(),
 		repository:                NewRepositoryConverter(),
 		enrollmentRequest:         NewEnrollmentRequestConverter(),
 		certificateSigningRequest: NewCertificateSigningRequestConverter(),
+		certificateRevocation:     NewCertificateRevocationConverter(),
 		authProvider:              NewAuthProviderConverter(),
 		resourceSync:              NewResourceSyncConverter(),
+		secretStore:               NewSecretStoreConverter(),
+		enrollmentApprovalPolicy:  NewEnrollmentApprovalPolicyConverter(),
+		role:                      NewRoleConverter(),
+		roleBinding:               NewRoleBindingConverter(),
+		serviceAccount:            NewServiceAccountConverter(),
 		templateVersion:           NewTemplateVersionConverter(),
 		event:                     NewEventConverter(),
+		auditEvent:                NewAuditEventConverter(),
 		organization:              NewOrganizationConverter(),
 		common:                    NewCommonConverter(),
 		auth:                      NewAuthConverter(),
@@ -53,6 +77,10 @@ func (c *converterImpl) Device() DeviceConverter {
 	return c.device
 }
 
+func (c *converterImpl) DeviceBulkOperation() DeviceBulkOperationConverter {
+	return c.deviceBulkOperation
+}
+
 func (c *converterImpl) Fleet() FleetConverter {
 	return c.fleet
 }
@@ -69,6 +97,10 @@ func (c *converterImpl) CertificateSigningRequest() CertificateSigningRequestCon
 	return c.certificateSigningRequest
 }
 
+func (c *converterImpl) CertificateRevocation() CertificateRevocationConverter {
+	return c.certificateRevocation
+}
+
 func (c *converterImpl) AuthProvider() AuthProviderConverter {
 	return c.authProvider
 }
@@ -77,6 +109,26 @@ func (c *converterImpl) ResourceSync() ResourceSyncConverter {
 	return c.resourceSync
 }
 
+func (c *converterImpl) SecretStore() SecretStoreConverter {
+	return c.secretStore
+}
+
+func (c *converterImpl) EnrollmentApprovalPolicy() EnrollmentApprovalPolicyConverter {
+	return c.enrollmentApprovalPolicy
+}
+
+func (c *converterImpl) Role() RoleConverter {
+	return c.role
+}
+
+func (c *converterImpl) RoleBinding() RoleBindingConverter {
+	return c.roleBinding
+}
+
+func (c *converterImpl) ServiceAccount() ServiceAccountConverter {
+	return c.serviceAccount
+}
+
 func (c *converterImpl) TemplateVersion() TemplateVersionConverter {
 	return c.templateVersion
 }
@@ -85,6 +137,10 @@ func (c *converterImpl) Event() EventConverter {
 	return c.event
 }
 
+func (c *converterImpl) AuditEvent() AuditEventConverter {
+	return c.auditEvent
+}
+
 func (c *converterImpl) Organization() OrganizationConverter {
 	return c.organization
 }
@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// DeviceBulkOperationConverter converts between v1beta1 API types and domain types for DeviceBulkOperation resources.
+type DeviceBulkOperationConverter interface {
+	ToDomain(apiv1beta1.DeviceBulkOperation) domain.DeviceBulkOperation
+	FromDomain(*domain.DeviceBulkOperation) *apiv1beta1.DeviceBulkOperation
+	ListFromDomain(*domain.DeviceBulkOperationList) *apiv1beta1.DeviceBulkOperationList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListDeviceBulkOperationsParams) domain.ListDeviceBulkOperationsParams
+}
+
+type deviceBulkOperationConverter struct{}
+
+// NewDeviceBulkOperationConverter creates a new DeviceBulkOperationConverter.
+func NewDeviceBulkOperationConverter() DeviceBulkOperationConverter {
+	return &deviceBulkOperationConverter{}
+}
+
+func (c *deviceBulkOperationConverter) ToDomain(o apiv1beta1.DeviceBulkOperation) domain.DeviceBulkOperation {
+	return o
+}
+
+func (c *deviceBulkOperationConverter) FromDomain(o *domain.DeviceBulkOperation) *apiv1beta1.DeviceBulkOperation {
+	return o
+}
+
+func (c *deviceBulkOperationConverter) ListFromDomain(l *domain.DeviceBulkOperationList) *apiv1beta1.DeviceBulkOperationList {
+	return l
+}
+
+func (c *deviceBulkOperationConverter) ListParamsToDomain(p apiv1beta1.ListDeviceBulkOperationsParams) domain.ListDeviceBulkOperationsParams {
+	return p
+}
@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// EnrollmentApprovalPolicyConverter converts between v1beta1 API types and domain types for EnrollmentApprovalPolicy resources.
+type EnrollmentApprovalPolicyConverter interface {
+	ToDomain(apiv1beta1.EnrollmentApprovalPolicy) domain.EnrollmentApprovalPolicy
+	FromDomain(*domain.EnrollmentApprovalPolicy) *apiv1beta1.EnrollmentApprovalPolicy
+	ListFromDomain(*domain.EnrollmentApprovalPolicyList) *apiv1beta1.EnrollmentApprovalPolicyList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListEnrollmentApprovalPoliciesParams) domain.ListEnrollmentApprovalPoliciesParams
+}
+
+type enrollmentApprovalPolicyConverter struct{}
+
+// NewEnrollmentApprovalPolicyConverter creates a new EnrollmentApprovalPolicyConverter.
+func NewEnrollmentApprovalPolicyConverter() EnrollmentApprovalPolicyConverter {
+	return &enrollmentApprovalPolicyConverter{}
+}
+
+func (c *enrollmentApprovalPolicyConverter) ToDomain(r apiv1beta1.EnrollmentApprovalPolicy) domain.EnrollmentApprovalPolicy {
+	return r
+}
+
+func (c *enrollmentApprovalPolicyConverter) FromDomain(r *domain.EnrollmentApprovalPolicy) *apiv1beta1.EnrollmentApprovalPolicy {
+	return r
+}
+
+func (c *enrollmentApprovalPolicyConverter) ListFromDomain(l *domain.EnrollmentApprovalPolicyList) *apiv1beta1.EnrollmentApprovalPolicyList {
+	return l
+}
+
+func (c *enrollmentApprovalPolicyConverter) ListParamsToDomain(p apiv1beta1.ListEnrollmentApprovalPoliciesParams) domain.ListEnrollmentApprovalPoliciesParams {
+	return p
+}
@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// RoleConverter converts between v1beta1 API types and domain types for Role resources.
+type RoleConverter interface {
+	ToDomain(apiv1beta1.Role) domain.Role
+	FromDomain(*domain.Role) *apiv1beta1.Role
+	ListFromDomain(*domain.RoleList) *apiv1beta1.RoleList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListRolesParams) domain.ListRolesParams
+}
+
+type roleConverter struct{}
+
+// NewRoleConverter creates a new RoleConverter.
+func NewRoleConverter() RoleConverter {
+	return &roleConverter{}
+}
+
+func (c *roleConverter) ToDomain(r apiv1beta1.Role) domain.Role {
+	return r
+}
+
+func (c *roleConverter) FromDomain(r *domain.Role) *apiv1beta1.Role {
+	return r
+}
+
+func (c *roleConverter) ListFromDomain(l *domain.RoleList) *apiv1beta1.RoleList {
+	return l
+}
+
+func (c *roleConverter) ListParamsToDomain(p apiv1beta1.ListRolesParams) domain.ListRolesParams {
+	return p
+}
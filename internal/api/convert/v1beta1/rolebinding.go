@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// RoleBindingConverter converts between v1beta1 API types and domain types for RoleBinding resources.
+type RoleBindingConverter interface {
+	ToDomain(apiv1beta1.RoleBinding) domain.RoleBinding
+	FromDomain(*domain.RoleBinding) *apiv1beta1.RoleBinding
+	ListFromDomain(*domain.RoleBindingList) *apiv1beta1.RoleBindingList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListRoleBindingsParams) domain.ListRoleBindingsParams
+}
+
+type roleBindingConverter struct{}
+
+// NewRoleBindingConverter creates a new RoleBindingConverter.
+func NewRoleBindingConverter() RoleBindingConverter {
+	return &roleBindingConverter{}
+}
+
+func (c *roleBindingConverter) ToDomain(r apiv1beta1.RoleBinding) domain.RoleBinding {
+	return r
+}
+
+func (c *roleBindingConverter) FromDomain(r *domain.RoleBinding) *apiv1beta1.RoleBinding {
+	return r
+}
+
+func (c *roleBindingConverter) ListFromDomain(l *domain.RoleBindingList) *apiv1beta1.RoleBindingList {
+	return l
+}
+
+func (c *roleBindingConverter) ListParamsToDomain(p apiv1beta1.ListRoleBindingsParams) domain.ListRoleBindingsParams {
+	return p
+}
@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// SecretStoreConverter converts between v1beta1 API types and domain types for SecretStore resources.
+type SecretStoreConverter interface {
+	ToDomain(apiv1beta1.SecretStore) domain.SecretStore
+	FromDomain(*domain.SecretStore) *apiv1beta1.SecretStore
+	ListFromDomain(*domain.SecretStoreList) *apiv1beta1.SecretStoreList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListSecretStoresParams) domain.ListSecretStoresParams
+}
+
+type secretStoreConverter struct{}
+
+// NewSecretStoreConverter creates a new SecretStoreConverter.
+func NewSecretStoreConverter() SecretStoreConverter {
+	return &secretStoreConverter{}
+}
+
+func (c *secretStoreConverter) ToDomain(r apiv1beta1.SecretStore) domain.SecretStore {
+	return r
+}
+
+func (c *secretStoreConverter) FromDomain(r *domain.SecretStore) *apiv1beta1.SecretStore {
+	return r
+}
+
+func (c *secretStoreConverter) ListFromDomain(l *domain.SecretStoreList) *apiv1beta1.SecretStoreList {
+	return l
+}
+
+func (c *secretStoreConverter) ListParamsToDomain(p apiv1beta1.ListSecretStoresParams) domain.ListSecretStoresParams {
+	return p
+}
@@ -0,0 +1,56 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// ServiceAccountConverter converts between v1beta1 API types and domain types for ServiceAccount resources.
+type ServiceAccountConverter interface {
+	ToDomain(apiv1beta1.ServiceAccount) domain.ServiceAccount
+	FromDomain(*domain.ServiceAccount) *apiv1beta1.ServiceAccount
+	ListFromDomain(*domain.ServiceAccountList) *apiv1beta1.ServiceAccountList
+
+	// Token conversions
+	TokenToDomain(apiv1beta1.ServiceAccountToken) domain.ServiceAccountToken
+	TokenFromDomain(*domain.ServiceAccountToken) *apiv1beta1.ServiceAccountToken
+	TokenListFromDomain(*domain.ServiceAccountTokenList) *apiv1beta1.ServiceAccountTokenList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListServiceAccountsParams) domain.ListServiceAccountsParams
+}
+
+type serviceAccountConverter struct{}
+
+// NewServiceAccountConverter creates a new ServiceAccountConverter.
+func NewServiceAccountConverter() ServiceAccountConverter {
+	return &serviceAccountConverter{}
+}
+
+func (c *serviceAccountConverter) ToDomain(sa apiv1beta1.ServiceAccount) domain.ServiceAccount {
+	return sa
+}
+
+func (c *serviceAccountConverter) FromDomain(sa *domain.ServiceAccount) *apiv1beta1.ServiceAccount {
+	return sa
+}
+
+func (c *serviceAccountConverter) ListFromDomain(l *domain.ServiceAccountList) *apiv1beta1.ServiceAccountList {
+	return l
+}
+
+func (c *serviceAccountConverter) TokenToDomain(t apiv1beta1.ServiceAccountToken) domain.ServiceAccountToken {
+	return t
+}
+
+func (c *serviceAccountConverter) TokenFromDomain(t *domain.ServiceAccountToken) *apiv1beta1.ServiceAccountToken {
+	return t
+}
+
+func (c *serviceAccountConverter) TokenListFromDomain(l *domain.ServiceAccountTokenList) *apiv1beta1.ServiceAccountTokenList {
+	return l
+}
+
+func (c *serviceAccountConverter) ListParamsToDomain(p apiv1beta1.ListServiceAccountsParams) domain.ListServiceAccountsParams {
+	return p
+}
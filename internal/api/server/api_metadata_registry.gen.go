@@ -8,18 +8,22 @@ import (
 	"github.com/flightctl/flightctl/internal/apimetadata"
 )
 const (
+	API_RESOURCE_AUDITEVENTS = "auditevents"
 	API_RESOURCE_AUTHPROVIDERS = "authproviders"
 	API_RESOURCE_CATALOGITEMS = "catalogitems"
 	API_RESOURCE_CATALOGS = "catalogs"
 	API_RESOURCE_CATALOGS_ITEMS = "catalogs/items"
+	API_RESOURCE_CERTIFICATEREVOCATIONS = "certificaterevocations"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS = "certificatesigningrequests"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS_APPROVAL = "certificatesigningrequests/approval"
+	API_RESOURCE_DEVICEBULKOPERATIONS = "devicebulkoperations"
 	API_RESOURCE_DEVICES = "devices"
 	API_RESOURCE_DEVICES_DECOMMISSION = "devices/decommission"
 	API_RESOURCE_DEVICES_LASTSEEN = "devices/lastseen"
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
+	API_RESOURCE_ENROLLMENTAPPROVALPOLICIES = "enrollmentapprovalpolicies"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
 	API_RESOURCE_ENROLLMENTREQUESTS_APPROVAL = "enrollmentrequests/approval"
 	API_RESOURCE_ENROLLMENTREQUESTS_STATUS = "enrollmentrequests/status"
@@ -31,6 +35,11 @@ const (
 	API_RESOURCE_ORGANIZATIONS = "organizations"
 	API_RESOURCE_REPOSITORIES = "repositories"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_ROLEBINDINGS = "rolebindings"
+	API_RESOURCE_ROLES = "roles"
+	API_RESOURCE_SECRETSTORES = "secretstores"
+	API_RESOURCE_SERVICEACCOUNTS = "serviceaccounts"
+	API_RESOURCE_SERVICEACCOUNTS_TOKENS = "serviceaccounts/tokens"
 )
 const (
 	API_ACTION_CREATE = "create"
@@ -54,6 +63,14 @@ var ServerURLPrefixes = []string{
 // APIMetadataMap provides endpoint metadata keyed by "METHOD:/path"
 // Uses pointers to avoid copy allocations on return
 var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
+	"GET:/auditevents": {
+		OperationID: "listAuditEvents",
+		Resource:    "auditevents",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/auth/config": {
 		OperationID: "authConfig",
 		Resource:    "",
@@ -270,6 +287,22 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1alpha1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/certificaterevocations": {
+		OperationID: "listCertificateRevocations",
+		Resource:    "certificaterevocations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/certificaterevocations": {
+		OperationID: "revokeCertificates",
+		Resource:    "certificaterevocations",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/certificatesigningrequests": {
 		OperationID: "listCertificateSigningRequests",
 		Resource:    "certificatesigningrequests",
@@ -334,6 +367,38 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/devicebulkoperations": {
+		OperationID: "listDeviceBulkOperations",
+		Resource:    "devicebulkoperations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/devicebulkoperations": {
+		OperationID: "createDeviceBulkOperation",
+		Resource:    "devicebulkoperations",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/devicebulkoperations/{name}": {
+		OperationID: "deleteDeviceBulkOperation",
+		Resource:    "devicebulkoperations",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/devicebulkoperations/{name}": {
+		OperationID: "getDeviceBulkOperation",
+		Resource:    "devicebulkoperations",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices": {
 		OperationID: "listDevices",
 		Resource:    "devices",
@@ -430,6 +495,54 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/enrollmentapprovalpolicies": {
+		OperationID: "listEnrollmentApprovalPolicies",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/enrollmentapprovalpolicies": {
+		OperationID: "createEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "deleteEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "getEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "patchEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "replaceEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/enrollmentconfig": {
 		OperationID: "getEnrollmentConfig",
 		Resource:    "",
@@ -734,6 +847,222 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/rolebindings": {
+		OperationID: "listRoleBindings",
+		Resource:    "rolebindings",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/rolebindings": {
+		OperationID: "createRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/rolebindings/{name}": {
+		OperationID: "deleteRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/rolebindings/{name}": {
+		OperationID: "getRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/rolebindings/{name}": {
+		OperationID: "patchRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/rolebindings/{name}": {
+		OperationID: "replaceRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/roles": {
+		OperationID: "listRoles",
+		Resource:    "roles",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/roles": {
+		OperationID: "createRole",
+		Resource:    "roles",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/roles/{name}": {
+		OperationID: "deleteRole",
+		Resource:    "roles",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/roles/{name}": {
+		OperationID: "getRole",
+		Resource:    "roles",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/roles/{name}": {
+		OperationID: "patchRole",
+		Resource:    "roles",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/roles/{name}": {
+		OperationID: "replaceRole",
+		Resource:    "roles",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secretstores": {
+		OperationID: "listSecretStores",
+		Resource:    "secretstores",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/secretstores": {
+		OperationID: "createSecretStore",
+		Resource:    "secretstores",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/secretstores/{name}": {
+		OperationID: "deleteSecretStore",
+		Resource:    "secretstores",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secretstores/{name}": {
+		OperationID: "getSecretStore",
+		Resource:    "secretstores",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/secretstores/{name}": {
+		OperationID: "patchSecretStore",
+		Resource:    "secretstores",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/secretstores/{name}": {
+		OperationID: "replaceSecretStore",
+		Resource:    "secretstores",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/serviceaccounts": {
+		OperationID: "listServiceAccounts",
+		Resource:    "serviceaccounts",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/serviceaccounts": {
+		OperationID: "createServiceAccount",
+		Resource:    "serviceaccounts",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/serviceaccounts/{name}": {
+		OperationID: "deleteServiceAccount",
+		Resource:    "serviceaccounts",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/serviceaccounts/{name}": {
+		OperationID: "getServiceAccount",
+		Resource:    "serviceaccounts",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PATCH:/serviceaccounts/{name}": {
+		OperationID: "patchServiceAccount",
+		Resource:    "serviceaccounts",
+		Action:      "patch",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/serviceaccounts/{name}": {
+		OperationID: "replaceServiceAccount",
+		Resource:    "serviceaccounts",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/serviceaccounts/{serviceaccount}/tokens": {
+		OperationID: "listServiceAccountTokens",
+		Resource:    "serviceaccounts/tokens",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/serviceaccounts/{serviceaccount}/tokens": {
+		OperationID: "createServiceAccountToken",
+		Resource:    "serviceaccounts/tokens",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/serviceaccounts/{serviceaccount}/tokens/{name}": {
+		OperationID: "deleteServiceAccountToken",
+		Resource:    "serviceaccounts/tokens",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "",
@@ -18,6 +18,9 @@ const ServerUrlApiv1 = "/api/v1"
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /auditevents)
+	ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams)
+
 	// (GET /auth/config)
 	AuthConfig(w http.ResponseWriter, r *http.Request)
 
@@ -51,6 +54,12 @@ type ServerInterface interface {
 	// (PUT /authproviders/{name})
 	ReplaceAuthProvider(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /certificaterevocations)
+	ListCertificateRevocations(w http.ResponseWriter, r *http.Request, params ListCertificateRevocationsParams)
+
+	// (POST /certificaterevocations)
+	RevokeCertificates(w http.ResponseWriter, r *http.Request)
+
 	// (GET /certificatesigningrequests)
 	ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams)
 
@@ -75,6 +84,18 @@ type ServerInterface interface {
 	// (POST /deviceactions/resume)
 	ResumeDevices(w http.ResponseWriter, r *http.Request)
 
+	// (GET /devicebulkoperations)
+	ListDeviceBulkOperations(w http.ResponseWriter, r *http.Request, params ListDeviceBulkOperationsParams)
+
+	// (POST /devicebulkoperations)
+	CreateDeviceBulkOperation(w http.ResponseWriter, r *http.Request, params CreateDeviceBulkOperationParams)
+
+	// (DELETE /devicebulkoperations/{name})
+	DeleteDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /devicebulkoperations/{name})
+	GetDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /devices)
 	ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams)
 
@@ -111,6 +132,24 @@ type ServerInterface interface {
 	// (PUT /devices/{name}/status)
 	ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /enrollmentapprovalpolicies)
+	ListEnrollmentApprovalPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentApprovalPoliciesParams)
+
+	// (POST /enrollmentapprovalpolicies)
+	CreateEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /enrollmentapprovalpolicies/{name})
+	DeleteEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /enrollmentapprovalpolicies/{name})
+	GetEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /enrollmentapprovalpolicies/{name})
+	PatchEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /enrollmentapprovalpolicies/{name})
+	ReplaceEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /enrollmentconfig)
 	GetEnrollmentConfig(w http.ResponseWriter, r *http.Request, params GetEnrollmentConfigParams)
 
@@ -225,6 +264,87 @@ type ServerInterface interface {
 	// (PUT /resourcesyncs/{name})
 	ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /rolebindings)
+	ListRoleBindings(w http.ResponseWriter, r *http.Request, params ListRoleBindingsParams)
+
+	// (POST /rolebindings)
+	CreateRoleBinding(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /rolebindings/{name})
+	DeleteRoleBinding(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /rolebindings/{name})
+	GetRoleBinding(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /rolebindings/{name})
+	PatchRoleBinding(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /rolebindings/{name})
+	ReplaceRoleBinding(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /roles)
+	ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams)
+
+	// (POST /roles)
+	CreateRole(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /roles/{name})
+	DeleteRole(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /roles/{name})
+	GetRole(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /roles/{name})
+	PatchRole(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /roles/{name})
+	ReplaceRole(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /secretstores)
+	ListSecretStores(w http.ResponseWriter, r *http.Request, params ListSecretStoresParams)
+
+	// (POST /secretstores)
+	CreateSecretStore(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /secretstores/{name})
+	DeleteSecretStore(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /secretstores/{name})
+	GetSecretStore(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /secretstores/{name})
+	PatchSecretStore(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /secretstores/{name})
+	ReplaceSecretStore(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /serviceaccounts)
+	ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams)
+
+	// (POST /serviceaccounts)
+	CreateServiceAccount(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /serviceaccounts/{name})
+	DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /serviceaccounts/{name})
+	GetServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /serviceaccounts/{name})
+	PatchServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /serviceaccounts/{name})
+	ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /serviceaccounts/{serviceaccount}/tokens)
+	ListServiceAccountTokens(w http.ResponseWriter, r *http.Request, serviceaccount string)
+
+	// (POST /serviceaccounts/{serviceaccount}/tokens)
+	CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string)
+
+	// (DELETE /serviceaccounts/{serviceaccount}/tokens/{name})
+	DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string, name string)
+
 	// (GET /version)
 	GetVersion(w http.ResponseWriter, r *http.Request)
 }
@@ -233,6 +353,11 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// (GET /auditevents)
+func (_ Unimplemented) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /auth/config)
 func (_ Unimplemented) AuthConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -288,6 +413,16 @@ func (_ Unimplemented) ReplaceAuthProvider(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /certificaterevocations)
+func (_ Unimplemented) ListCertificateRevocations(w http.ResponseWriter, r *http.Request, params ListCertificateRevocationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /certificaterevocations)
+func (_ Unimplemented) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /certificatesigningrequests)
 func (_ Unimplemented) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -328,6 +463,26 @@ func (_ Unimplemented) ResumeDevices(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /devicebulkoperations)
+func (_ Unimplemented) ListDeviceBulkOperations(w http.ResponseWriter, r *http.Request, params ListDeviceBulkOperationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /devicebulkoperations)
+func (_ Unimplemented) CreateDeviceBulkOperation(w http.ResponseWriter, r *http.Request, params CreateDeviceBulkOperationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /devicebulkoperations/{name})
+func (_ Unimplemented) DeleteDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /devicebulkoperations/{name})
+func (_ Unimplemented) GetDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /devices)
 func (_ Unimplemented) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -388,6 +543,36 @@ func (_ Unimplemented) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /enrollmentapprovalpolicies)
+func (_ Unimplemented) ListEnrollmentApprovalPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentApprovalPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /enrollmentapprovalpolicies)
+func (_ Unimplemented) CreateEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /enrollmentapprovalpolicies/{name})
+func (_ Unimplemented) DeleteEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /enrollmentapprovalpolicies/{name})
+func (_ Unimplemented) GetEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /enrollmentapprovalpolicies/{name})
+func (_ Unimplemented) PatchEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /enrollmentapprovalpolicies/{name})
+func (_ Unimplemented) ReplaceEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /enrollmentconfig)
 func (_ Unimplemented) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request, params GetEnrollmentConfigParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -579,6 +764,141 @@ func (_ Unimplemented) ReplaceResourceSync(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /rolebindings)
+func (_ Unimplemented) ListRoleBindings(w http.ResponseWriter, r *http.Request, params ListRoleBindingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /rolebindings)
+func (_ Unimplemented) CreateRoleBinding(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /rolebindings/{name})
+func (_ Unimplemented) DeleteRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /rolebindings/{name})
+func (_ Unimplemented) GetRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /rolebindings/{name})
+func (_ Unimplemented) PatchRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /rolebindings/{name})
+func (_ Unimplemented) ReplaceRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /roles)
+func (_ Unimplemented) ListRoles(w http.ResponseWriter, r *http.Request, params ListRolesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /roles)
+func (_ Unimplemented) CreateRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /roles/{name})
+func (_ Unimplemented) DeleteRole(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /roles/{name})
+func (_ Unimplemented) GetRole(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /roles/{name})
+func (_ Unimplemented) PatchRole(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /roles/{name})
+func (_ Unimplemented) ReplaceRole(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /secretstores)
+func (_ Unimplemented) ListSecretStores(w http.ResponseWriter, r *http.Request, params ListSecretStoresParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /secretstores)
+func (_ Unimplemented) CreateSecretStore(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /secretstores/{name})
+func (_ Unimplemented) DeleteSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /secretstores/{name})
+func (_ Unimplemented) GetSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /secretstores/{name})
+func (_ Unimplemented) PatchSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /secretstores/{name})
+func (_ Unimplemented) ReplaceSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /serviceaccounts)
+func (_ Unimplemented) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /serviceaccounts)
+func (_ Unimplemented) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /serviceaccounts/{name})
+func (_ Unimplemented) DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /serviceaccounts/{name})
+func (_ Unimplemented) GetServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /serviceaccounts/{name})
+func (_ Unimplemented) PatchServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /serviceaccounts/{name})
+func (_ Unimplemented) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /serviceaccounts/{serviceaccount}/tokens)
+func (_ Unimplemented) ListServiceAccountTokens(w http.ResponseWriter, r *http.Request, serviceaccount string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /serviceaccounts/{serviceaccount}/tokens)
+func (_ Unimplemented) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /serviceaccounts/{serviceaccount}/tokens/{name})
+func (_ Unimplemented) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /version)
 func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -593,6 +913,57 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventsParams
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AuthConfig operation middleware
 func (siw *ServerInterfaceWrapper) AuthConfig(w http.ResponseWriter, r *http.Request) {
 
@@ -865,6 +1236,47 @@ func (siw *ServerInterfaceWrapper) ReplaceAuthProvider(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// ListCertificateRevocations operation middleware
+func (siw *ServerInterfaceWrapper) ListCertificateRevocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCertificateRevocationsParams
+
+	// ------------- Optional query parameter "deviceName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deviceName", r.URL.Query(), &params.DeviceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deviceName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCertificateRevocations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeCertificates operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCertificates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListCertificateSigningRequests operation middleware
 func (siw *ServerInterfaceWrapper) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
 
@@ -1069,13 +1481,13 @@ func (siw *ServerInterfaceWrapper) ResumeDevices(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListDevices operation middleware
-func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+// ListDeviceBulkOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListDeviceBulkOperations(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListDevicesParams
+	var params ListDeviceBulkOperationsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -1109,16 +1521,8 @@ func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Optional query parameter "summaryOnly" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "summaryOnly", r.URL.Query(), &params.SummaryOnly)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "summaryOnly", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDevices(w, r, params)
+		siw.Handler.ListDeviceBulkOperations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1128,12 +1532,148 @@ func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateDevice operation middleware
-func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
+// CreateDeviceBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) CreateDeviceBulkOperation(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDevice(w, r)
-	}))
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDeviceBulkOperationParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDeviceBulkOperation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDeviceBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDeviceBulkOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDeviceBulkOperation(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeviceBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceBulkOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceBulkOperation(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDevicesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "summaryOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "summaryOnly", r.URL.Query(), &params.SummaryOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "summaryOnly", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDevice operation middleware
+func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDevice(w, r)
+	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -1403,40 +1943,13 @@ func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetEnrollmentConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEnrollmentConfigParams
-
-	// ------------- Optional query parameter "csr" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "csr", r.URL.Query(), &params.Csr)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "csr", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnrollmentConfig(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// ListEnrollmentRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
+// ListEnrollmentApprovalPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListEnrollmentApprovalPolicies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListEnrollmentRequestsParams
+	var params ListEnrollmentApprovalPoliciesParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -1471,7 +1984,7 @@ func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEnrollmentRequests(w, r, params)
+		siw.Handler.ListEnrollmentApprovalPolicies(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1481,11 +1994,11 @@ func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// CreateEnrollmentApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEnrollmentRequest(w, r)
+		siw.Handler.CreateEnrollmentApprovalPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1495,8 +2008,8 @@ func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// DeleteEnrollmentApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1510,7 +2023,7 @@ func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEnrollmentRequest(w, r, name)
+		siw.Handler.DeleteEnrollmentApprovalPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1520,8 +2033,8 @@ func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// GetEnrollmentApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1535,7 +2048,7 @@ func (siw *ServerInterfaceWrapper) GetEnrollmentRequest(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnrollmentRequest(w, r, name)
+		siw.Handler.GetEnrollmentApprovalPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1545,8 +2058,8 @@ func (siw *ServerInterfaceWrapper) GetEnrollmentRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PatchEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// PatchEnrollmentApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1560,7 +2073,7 @@ func (siw *ServerInterfaceWrapper) PatchEnrollmentRequest(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchEnrollmentRequest(w, r, name)
+		siw.Handler.PatchEnrollmentApprovalPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1570,8 +2083,8 @@ func (siw *ServerInterfaceWrapper) PatchEnrollmentRequest(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// ReplaceEnrollmentApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1585,7 +2098,7 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWrite
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceEnrollmentRequest(w, r, name)
+		siw.Handler.ReplaceEnrollmentApprovalPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1595,22 +2108,24 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// ApproveEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// GetEnrollmentConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnrollmentConfigParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "csr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csr", r.URL.Query(), &params.Csr)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "csr", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveEnrollmentRequest(w, r, name)
+		siw.Handler.GetEnrollmentConfig(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1620,22 +2135,48 @@ func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetEnrollmentRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+// ListEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEnrollmentRequestsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnrollmentRequestStatus(w, r, name)
+		siw.Handler.ListEnrollmentRequests(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1645,8 +2186,22 @@ func (siw *ServerInterfaceWrapper) GetEnrollmentRequestStatus(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// PatchEnrollmentRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) PatchEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+// CreateEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEnrollmentRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1660,7 +2215,7 @@ func (siw *ServerInterfaceWrapper) PatchEnrollmentRequestStatus(w http.ResponseW
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchEnrollmentRequestStatus(w, r, name)
+		siw.Handler.DeleteEnrollmentRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1670,8 +2225,8 @@ func (siw *ServerInterfaceWrapper) PatchEnrollmentRequestStatus(w http.ResponseW
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceEnrollmentRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+// GetEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1685,7 +2240,7 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.Respons
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceEnrollmentRequestStatus(w, r, name)
+		siw.Handler.GetEnrollmentRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1695,7 +2250,157 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// ListEvents operation middleware
+// PatchEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEnrollmentRequest(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceEnrollmentRequest(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveEnrollmentRequest(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnrollmentRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnrollmentRequestStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchEnrollmentRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEnrollmentRequestStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceEnrollmentRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceEnrollmentRequestStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEvents operation middleware
 func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
@@ -2073,7 +2778,688 @@ func (siw *ServerInterfaceWrapper) GetFleetStatus(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFleetStatus(w, r, name)
+		siw.Handler.GetFleetStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchFleetStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchFleetStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchFleetStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceFleetStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceFleetStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLabelsParams
+
+	// ------------- Required query parameter "kind" -------------
+
+	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "kind"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLabels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationsParams
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRepositoriesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRepositories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRepository operation middleware
+func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepository(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchRepository operation middleware
+func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchRepository(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceRepository operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceRepository(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListResourceSyncs operation middleware
+func (siw *ServerInterfaceWrapper) ListResourceSyncs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListResourceSyncsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListResourceSyncs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResourceSync(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) GetResourceSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRoleBindings operation middleware
+func (siw *ServerInterfaceWrapper) ListRoleBindings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleBindingsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRoleBindings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) CreateRoleBinding(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRoleBinding(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleBinding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRoleBinding(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) GetRoleBinding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoleBinding(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) PatchRoleBinding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchRoleBinding(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceRoleBinding operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceRoleBinding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceRoleBinding(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRolesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRoles(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2083,8 +3469,22 @@ func (siw *ServerInterfaceWrapper) GetFleetStatus(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PatchFleetStatus operation middleware
-func (siw *ServerInterfaceWrapper) PatchFleetStatus(w http.ResponseWriter, r *http.Request) {
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRole(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2098,7 +3498,7 @@ func (siw *ServerInterfaceWrapper) PatchFleetStatus(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchFleetStatus(w, r, name)
+		siw.Handler.DeleteRole(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2108,8 +3508,8 @@ func (siw *ServerInterfaceWrapper) PatchFleetStatus(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceFleetStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *http.Request) {
+// GetRole operation middleware
+func (siw *ServerInterfaceWrapper) GetRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2123,7 +3523,7 @@ func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceFleetStatus(w, r, name)
+		siw.Handler.GetRole(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2133,55 +3533,22 @@ func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListLabels operation middleware
-func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Request) {
+// PatchRole operation middleware
+func (siw *ServerInterfaceWrapper) PatchRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLabelsParams
-
-	// ------------- Required query parameter "kind" -------------
-
-	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "kind"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "kind", r.URL.Query(), &params.Kind)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "labelSelector" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "fieldSelector" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLabels(w, r, params)
+		siw.Handler.PatchRole(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2191,24 +3558,22 @@ func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListOrganizations operation middleware
-func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+// ReplaceRole operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListOrganizationsParams
-
-	// ------------- Optional query parameter "fieldSelector" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListOrganizations(w, r, params)
+		siw.Handler.ReplaceRole(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2218,13 +3583,13 @@ func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ListRepositories operation middleware
-func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+// ListSecretStores operation middleware
+func (siw *ServerInterfaceWrapper) ListSecretStores(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRepositoriesParams
+	var params ListSecretStoresParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -2259,7 +3624,7 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRepositories(w, r, params)
+		siw.Handler.ListSecretStores(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2269,11 +3634,11 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRepository operation middleware
-func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
+// CreateSecretStore operation middleware
+func (siw *ServerInterfaceWrapper) CreateSecretStore(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRepository(w, r)
+		siw.Handler.CreateSecretStore(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2283,8 +3648,8 @@ func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteRepository operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+// DeleteSecretStore operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSecretStore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2298,7 +3663,7 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRepository(w, r, name)
+		siw.Handler.DeleteSecretStore(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2308,8 +3673,8 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetRepository operation middleware
-func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+// GetSecretStore operation middleware
+func (siw *ServerInterfaceWrapper) GetSecretStore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2323,7 +3688,7 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRepository(w, r, name)
+		siw.Handler.GetSecretStore(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2333,8 +3698,8 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PatchRepository operation middleware
-func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *http.Request) {
+// PatchSecretStore operation middleware
+func (siw *ServerInterfaceWrapper) PatchSecretStore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2348,7 +3713,7 @@ func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchRepository(w, r, name)
+		siw.Handler.PatchSecretStore(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2358,8 +3723,8 @@ func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceRepository operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *http.Request) {
+// ReplaceSecretStore operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceSecretStore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2373,7 +3738,7 @@ func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceRepository(w, r, name)
+		siw.Handler.ReplaceSecretStore(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2383,13 +3748,13 @@ func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ListResourceSyncs operation middleware
-func (siw *ServerInterfaceWrapper) ListResourceSyncs(w http.ResponseWriter, r *http.Request) {
+// ListServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListResourceSyncsParams
+	var params ListServiceAccountsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -2424,7 +3789,7 @@ func (siw *ServerInterfaceWrapper) ListResourceSyncs(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListResourceSyncs(w, r, params)
+		siw.Handler.ListServiceAccounts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2434,11 +3799,11 @@ func (siw *ServerInterfaceWrapper) ListResourceSyncs(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+// CreateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateResourceSync(w, r)
+		siw.Handler.CreateServiceAccount(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2448,8 +3813,8 @@ func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *http.Request) {
+// DeleteServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2463,7 +3828,7 @@ func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteResourceSync(w, r, name)
+		siw.Handler.DeleteServiceAccount(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2473,8 +3838,8 @@ func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) GetResourceSync(w http.ResponseWriter, r *http.Request) {
+// GetServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2488,7 +3853,7 @@ func (siw *ServerInterfaceWrapper) GetResourceSync(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetResourceSync(w, r, name)
+		siw.Handler.GetServiceAccount(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2498,8 +3863,8 @@ func (siw *ServerInterfaceWrapper) GetResourceSync(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PatchResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *http.Request) {
+// PatchServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) PatchServiceAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2513,7 +3878,7 @@ func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchResourceSync(w, r, name)
+		siw.Handler.PatchServiceAccount(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2523,8 +3888,8 @@ func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r *http.Request) {
+// ReplaceServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2538,7 +3903,91 @@ func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceResourceSync(w, r, name)
+		siw.Handler.ReplaceServiceAccount(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServiceAccountTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccountTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceaccount" -------------
+	var serviceaccount string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceaccount", chi.URLParam(r, "serviceaccount"), &serviceaccount, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceaccount", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceAccountTokens(w, r, serviceaccount)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceAccountToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceaccount" -------------
+	var serviceaccount string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceaccount", chi.URLParam(r, "serviceaccount"), &serviceaccount, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceaccount", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccountToken(w, r, serviceaccount)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteServiceAccountToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serviceaccount" -------------
+	var serviceaccount string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceaccount", chi.URLParam(r, "serviceaccount"), &serviceaccount, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceaccount", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccountToken(w, r, serviceaccount, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2675,6 +4124,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auditevents", wrapper.ListAuditEvents)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/config", wrapper.AuthConfig)
 	})
@@ -2708,6 +4160,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/authproviders/{name}", wrapper.ReplaceAuthProvider)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/certificaterevocations", wrapper.ListCertificateRevocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/certificaterevocations", wrapper.RevokeCertificates)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/certificatesigningrequests", wrapper.ListCertificateSigningRequests)
 	})
@@ -2732,6 +4190,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/deviceactions/resume", wrapper.ResumeDevices)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devicebulkoperations", wrapper.ListDeviceBulkOperations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/devicebulkoperations", wrapper.CreateDeviceBulkOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/devicebulkoperations/{name}", wrapper.DeleteDeviceBulkOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devicebulkoperations/{name}", wrapper.GetDeviceBulkOperation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/devices", wrapper.ListDevices)
 	})
@@ -2768,6 +4238,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/devices/{name}/status", wrapper.ReplaceDeviceStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/enrollmentapprovalpolicies", wrapper.ListEnrollmentApprovalPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/enrollmentapprovalpolicies", wrapper.CreateEnrollmentApprovalPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/enrollmentapprovalpolicies/{name}", wrapper.DeleteEnrollmentApprovalPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/enrollmentapprovalpolicies/{name}", wrapper.GetEnrollmentApprovalPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/enrollmentapprovalpolicies/{name}", wrapper.PatchEnrollmentApprovalPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/enrollmentapprovalpolicies/{name}", wrapper.ReplaceEnrollmentApprovalPolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/enrollmentconfig", wrapper.GetEnrollmentConfig)
 	})
@@ -2882,6 +4370,87 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/resourcesyncs/{name}", wrapper.ReplaceResourceSync)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rolebindings", wrapper.ListRoleBindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rolebindings", wrapper.CreateRoleBinding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/rolebindings/{name}", wrapper.DeleteRoleBinding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rolebindings/{name}", wrapper.GetRoleBinding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/rolebindings/{name}", wrapper.PatchRoleBinding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/rolebindings/{name}", wrapper.ReplaceRoleBinding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles", wrapper.ListRoles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/roles", wrapper.CreateRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/roles/{name}", wrapper.DeleteRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles/{name}", wrapper.GetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/roles/{name}", wrapper.PatchRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/roles/{name}", wrapper.ReplaceRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/secretstores", wrapper.ListSecretStores)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/secretstores", wrapper.CreateSecretStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/secretstores/{name}", wrapper.DeleteSecretStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/secretstores/{name}", wrapper.GetSecretStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/secretstores/{name}", wrapper.PatchSecretStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/secretstores/{name}", wrapper.ReplaceSecretStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/serviceaccounts", wrapper.ListServiceAccounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/serviceaccounts", wrapper.CreateServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/serviceaccounts/{name}", wrapper.DeleteServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/serviceaccounts/{name}", wrapper.GetServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/serviceaccounts/{name}", wrapper.PatchServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/serviceaccounts/{name}", wrapper.ReplaceServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/serviceaccounts/{serviceaccount}/tokens", wrapper.ListServiceAccountTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/serviceaccounts/{serviceaccount}/tokens", wrapper.CreateServiceAccountToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/serviceaccounts/{serviceaccount}/tokens/{name}", wrapper.DeleteServiceAccountToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
 	})
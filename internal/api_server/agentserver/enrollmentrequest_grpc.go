@@ -10,6 +10,7 @@ import (
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	pb "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/tpm"
 	"github.com/flightctl/flightctl/internal/util"
@@ -66,7 +67,9 @@ func (s *AgentGrpcServer) updateEnrollmentRequestStatus(
 	if !ok {
 		orgId = store.NullOrgId
 	}
-	_, responseStatus := s.service.ReplaceEnrollmentRequestStatus(ctx, orgId, enrollmentRequestName, *enrollmentRequest)
+	// the TPM verification condition set by the server is only kept for internal requests
+	internalCtx := context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+	_, responseStatus := s.service.ReplaceEnrollmentRequestStatus(internalCtx, orgId, enrollmentRequestName, *enrollmentRequest)
 	if responseStatus.Code != http.StatusOK {
 		if err := s.sendErrorResponse(stream, streamErrorMessage); err != nil {
 			s.log.Errorf("Failed to send error response: %v", err)
@@ -234,6 +237,16 @@ func (s *AgentGrpcServer) updateStatusAndSendSuccess(ctx context.Context, stream
 		return err
 	}
 
+	// The verified TPM may satisfy the enrollment approval policies requiring TPM attestation. The
+	// challenge succeeded regardless of whether a policy approves the request.
+	orgId, ok := util.GetOrgIdFromContext(ctx)
+	if !ok {
+		orgId = store.NullOrgId
+	}
+	if _, responseStatus := s.service.ApproveEnrollmentRequestByPolicy(ctx, orgId, enrollmentRequestName); responseStatus.Code != http.StatusOK {
+		s.log.Errorf("Failed to apply enrollment approval policies to enrollment request %s: %s", enrollmentRequestName, responseStatus.Message)
+	}
+
 	successResp := &pb.ServerChallenge{
 		Payload: &pb.ServerChallenge_Success{
 			Success: &pb.ChallengeComplete{
@@ -371,8 +371,9 @@ func TestPerformTPMChallenge(t *testing.T) {
 				}, nil)
 
 				// 5. Server updates enrollment status to failed (due to challenge verification failure)
-				mockService.EXPECT().ReplaceEnrollmentRequestStatus(ctx, gomock.Any(), enrollmentRequestName, gomock.Any()).DoAndReturn(
+				mockService.EXPECT().ReplaceEnrollmentRequestStatus(gomock.Any(), gomock.Any(), enrollmentRequestName, gomock.Any()).DoAndReturn(
 					func(ctx context.Context, orgId uuid.UUID, name string, req api.EnrollmentRequest) (*api.EnrollmentRequest, api.Status) {
+						require.True(t, service.IsInternalRequest(ctx))
 						// Verify the status was updated to failed
 						require.Len(t, req.Status.Conditions, 1)
 						condition := req.Status.Conditions[0]
@@ -489,3 +490,33 @@ func TestReceiveAndVerifyResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateStatusAndSendSuccessAppliesApprovalPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := service.NewMockService(ctrl)
+	mockStream := grpc_v1.NewMockEnrollment_TPMChallengeServer(ctrl)
+	server := &AgentGrpcServer{
+		service: mockService,
+		log:     logrus.New(),
+	}
+	enrollmentRequest := &api.EnrollmentRequest{Status: &api.EnrollmentRequestStatus{}}
+
+	gomock.InOrder(
+		mockService.EXPECT().ReplaceEnrollmentRequestStatus(gomock.Any(), gomock.Any(), "test-request", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, name string, req api.EnrollmentRequest) (*api.EnrollmentRequest, api.Status) {
+				require.True(t, service.IsInternalRequest(ctx))
+				require.True(t, api.IsStatusConditionTrue(req.Status.Conditions, api.ConditionTypeEnrollmentRequestTPMVerified))
+				return &req, api.Status{Code: http.StatusOK}
+			}),
+		mockService.EXPECT().ApproveEnrollmentRequestByPolicy(gomock.Any(), gomock.Any(), "test-request").Return(
+			enrollmentRequest, api.Status{Code: http.StatusOK}),
+		mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *grpc_v1.ServerChallenge) error {
+			require.NotNil(t, msg.GetSuccess())
+			return nil
+		}),
+	)
+
+	require.NoError(t, server.updateStatusAndSendSuccess(context.Background(), mockStream, enrollmentRequest, "test-request"))
+}
@@ -247,7 +247,7 @@ func (s *AgentServer) prepareHTTPHandler(ctx context.Context, serviceHandler ser
 			// This closure "captures" the handlers created above.
 
 			// Check if this is an enrollment request
-			if isEnrollmentRequest(r) {
+			if isEnrollmentRequest(r) || agenttransportv1beta1.IsESTEnrollmentRequest(r) {
 				// Route to the pre-built enrollment handler
 				enrollmentAuthHandler.ServeHTTP(w, r)
 				return
@@ -342,6 +342,12 @@ func (s *AgentServer) prepareHTTPHandler(ctx context.Context, serviceHandler ser
 		r.Mount("/", negotiatedRouter)
 	})
 
+	// EST (RFC 7030) endpoints for devices that do not run the flightctl agent
+	router.Group(func(r chi.Router) {
+		rateLimit(r)
+		handlerV1Beta1.RegisterESTRoutes(r)
+	})
+
 	return otelhttp.NewHandler(router, "agent-http-server"), nil
 }
 
@@ -0,0 +1,236 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// maxGitWebhookBodySize is the maximum size of the payload of a push notification. Larger
+// notifications are rejected before their signature is verified.
+const maxGitWebhookBodySize = 1 << 20
+
+type gitProvider string
+
+const (
+	gitProviderGitHub gitProvider = "github"
+	gitProviderGitLab gitProvider = "gitlab"
+	gitProviderGitea  gitProvider = "gitea"
+)
+
+// gitPushPayload holds the fields of the push payloads of GitHub, GitLab and Gitea used to
+// identify the pushed repository and revision.
+type gitPushPayload struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Repository struct {
+		CloneURL   string `json:"clone_url"`
+		SshURL     string `json:"ssh_url"`
+		HtmlURL    string `json:"html_url"`
+		GitHttpURL string `json:"git_http_url"`
+		GitSshURL  string `json:"git_ssh_url"`
+		Homepage   string `json:"homepage"`
+	} `json:"repository"`
+	Project struct {
+		GitHttpURL string `json:"git_http_url"`
+		GitSshURL  string `json:"git_ssh_url"`
+		WebURL     string `json:"web_url"`
+	} `json:"project"`
+}
+
+func (p *gitPushPayload) urls() []string {
+	return lo.Compact([]string{
+		p.Repository.CloneURL, p.Repository.SshURL, p.Repository.HtmlURL,
+		p.Repository.GitHttpURL, p.Repository.GitSshURL, p.Repository.Homepage,
+		p.Project.GitHttpURL, p.Project.GitSshURL, p.Project.WebURL,
+	})
+}
+
+type gitWebhookResponse struct {
+	// Repositories lists the notified Repositories as "<organization ID>/<name>"
+	Repositories []string `json:"repositories"`
+}
+
+// GitWebhookHandler returns an HTTP handler receiving push notifications from GitHub, GitLab and
+// Gitea. The notifications are authenticated with the given secret, and each push emits an event
+// for the git Repositories whose URL matches the pushed repository, so that the ResourceSyncs,
+// fleets and devices tracking it are updated without waiting for the next poll. Since the secret
+// is shared by all organizations, the Repositories of every organization are matched, rather than
+// those of an organization chosen by the sender.
+func GitWebhookHandler(serviceHandler service.Service, secret string, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGitWebhookBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+
+		provider, eventType, ok := detectGitProvider(r.Header)
+		if !ok {
+			http.Error(w, "unsupported webhook: missing event header", http.StatusBadRequest)
+			return
+		}
+		if !verifyGitWebhook(provider, r.Header, body, secret) {
+			http.Error(w, "invalid webhook signature", http.StatusUnauthorized)
+			return
+		}
+		if !isGitPushEvent(provider, eventType) {
+			// Other events, such as pings, are acknowledged and ignored
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var payload gitPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil || payload.Ref == "" {
+			http.Error(w, "invalid push payload", http.StatusBadRequest)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), consts.EventActorCtxKey, "service:git-webhook")
+		ctx = context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+		orgs, status := serviceHandler.ListOrganizations(ctx, domain.ListOrganizationsParams{})
+		if status.Code != http.StatusOK {
+			log.Errorf("git webhook: failed to list organizations: %s", status.Message)
+			http.Error(w, "failed to list organizations", http.StatusInternalServerError)
+			return
+		}
+
+		notified := []string{}
+		for _, o := range orgs.Items {
+			orgId, err := uuid.Parse(lo.FromPtr(o.Metadata.Name))
+			if err != nil {
+				continue
+			}
+			repos, status := listMatchingGitRepositories(ctx, serviceHandler, orgId, payload.urls())
+			if status.Code != http.StatusOK {
+				log.Errorf("git webhook: failed to list repositories of organization %s: %s", orgId, status.Message)
+				http.Error(w, "failed to list repositories", http.StatusInternalServerError)
+				return
+			}
+			for _, name := range repos {
+				log.Infof("git webhook: push to %s of repository %s/%s received from %s", payload.Ref, orgId, name, provider)
+				serviceHandler.CreateEvent(ctx, orgId, common.GetRepositoryPushReceivedEvent(ctx, name, payload.Ref, payload.After))
+				notified = append(notified, fmt.Sprintf("%s/%s", orgId, name))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(gitWebhookResponse{Repositories: notified})
+	})
+}
+
+// detectGitProvider returns the git server that sent the webhook and the type of its event.
+// Gitea also sends GitHub's headers, so it is checked first.
+func detectGitProvider(header http.Header) (gitProvider, string, bool) {
+	if event := header.Get("X-Gitea-Event"); event != "" {
+		return gitProviderGitea, event, true
+	}
+	if event := header.Get("X-Gitlab-Event"); event != "" {
+		return gitProviderGitLab, event, true
+	}
+	if event := header.Get("X-GitHub-Event"); event != "" {
+		return gitProviderGitHub, event, true
+	}
+	return "", "", false
+}
+
+func verifyGitWebhook(provider gitProvider, header http.Header, body []byte, secret string) bool {
+	switch provider {
+	case gitProviderGitHub:
+		signature, ok := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+		return ok && verifyHMACSignature(body, secret, signature)
+	case gitProviderGitea:
+		return verifyHMACSignature(body, secret, header.Get("X-Gitea-Signature"))
+	case gitProviderGitLab:
+		token := header.Get("X-Gitlab-Token")
+		return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	default:
+		return false
+	}
+}
+
+func verifyHMACSignature(body []byte, secret, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func isGitPushEvent(provider gitProvider, eventType string) bool {
+	if provider == gitProviderGitLab {
+		return eventType == "Push Hook" || eventType == "Tag Push Hook"
+	}
+	return eventType == "push"
+}
+
+// listMatchingGitRepositories returns the names of the git Repositories whose URL is one of the
+// given URLs.
+func listMatchingGitRepositories(ctx context.Context, serviceHandler service.Service, orgId uuid.UUID, urls []string) ([]string, domain.Status) {
+	wanted := lo.SliceToMap(urls, func(u string) (string, struct{}) { return normalizeGitURL(u), struct{}{} })
+
+	var names []string
+	params := domain.ListRepositoriesParams{Limit: lo.ToPtr(int32(1000))}
+	for {
+		list, status := serviceHandler.ListRepositories(ctx, orgId, params)
+		if status.Code != http.StatusOK {
+			return nil, status
+		}
+		for _, repo := range list.Items {
+			gitSpec, err := repo.Spec.AsGitRepoSpec()
+			if err != nil || gitSpec.Type != domain.GitRepoSpecTypeGit {
+				continue
+			}
+			if _, ok := wanted[normalizeGitURL(gitSpec.Url)]; ok {
+				names = append(names, lo.FromPtr(repo.Metadata.Name))
+			}
+		}
+		if list.Metadata.Continue == nil {
+			return names, domain.StatusOK()
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// normalizeGitURL returns the host and path of a git URL, so that the HTTP(S), SSH and scp-like
+// URLs of a repository compare equal.
+func normalizeGitURL(rawURL string) string {
+	s := strings.TrimSpace(rawURL)
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" && u.Host != "" {
+		s = u.Hostname() + "/" + strings.TrimPrefix(u.Path, "/")
+	} else if userHost, path, ok := strings.Cut(s, ":"); ok {
+		// scp-like syntax, such as git@github.com:org/repo.git
+		_, host, found := strings.Cut(userHost, "@")
+		if !found {
+			host = userHost
+		}
+		s = host + "/" + strings.TrimPrefix(path, "/")
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "/"), ".git")
+	return strings.ToLower(s)
+}
@@ -0,0 +1,166 @@
+package apiserver
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/org"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testWebhookSecret = "s3cr3t"
+
+const testPushPayload = `{
+  "ref": "refs/heads/main",
+  "after": "0123456789abcdef",
+  "repository": {
+    "clone_url": "https://github.com/flightctl/flightctl-demos.git",
+    "ssh_url": "git@github.com:flightctl/flightctl-demos.git"
+  }
+}`
+
+func newGitRepository(name, url string) domain.Repository {
+	spec := domain.RepositorySpec{}
+	_ = spec.FromGitRepoSpec(domain.GitRepoSpec{Url: url})
+	return domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)}, Spec: spec}
+}
+
+func signPayload(body string) string {
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestNormalizeGitURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/flightctl/flightctl-demos.git", "github.com/flightctl/flightctl-demos"},
+		{"https://user@GitHub.com/flightctl/flightctl-demos/", "github.com/flightctl/flightctl-demos"},
+		{"git@github.com:flightctl/flightctl-demos.git", "github.com/flightctl/flightctl-demos"},
+		{"ssh://git@gitlab.example.com:2222/group/project.git", "gitlab.example.com/group/project"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			require.Equal(t, tt.want, normalizeGitURL(tt.url))
+		})
+	}
+}
+
+func TestGitWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		wantCode   int
+		wantEvents int
+	}{
+		{
+			name:       "GitHub push with valid signature",
+			headers:    map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": "sha256=" + signPayload(testPushPayload)},
+			wantCode:   http.StatusAccepted,
+			wantEvents: 1,
+		},
+		{
+			name:     "GitHub push with invalid signature",
+			headers:  map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": "sha256=" + signPayload("other")},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "GitHub ping",
+			headers:  map[string]string{"X-GitHub-Event": "ping", "X-Hub-Signature-256": "sha256=" + signPayload(testPushPayload)},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:       "Gitea push with valid signature",
+			headers:    map[string]string{"X-Gitea-Event": "push", "X-GitHub-Event": "push", "X-Gitea-Signature": signPayload(testPushPayload)},
+			wantCode:   http.StatusAccepted,
+			wantEvents: 1,
+		},
+		{
+			name:       "GitLab push with valid token",
+			headers:    map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": testWebhookSecret},
+			wantCode:   http.StatusAccepted,
+			wantEvents: 1,
+		},
+		{
+			name:     "GitLab push with invalid token",
+			headers:  map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": "wrong"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "unknown sender",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockSvc := service.NewMockService(ctrl)
+			otherOrgId := uuid.New()
+			if tt.wantCode == http.StatusAccepted {
+				mockSvc.EXPECT().ListOrganizations(gomock.Any(), gomock.Any()).Return(&domain.OrganizationList{
+					Items: []domain.Organization{
+						{Metadata: domain.ObjectMeta{Name: lo.ToPtr(org.DefaultID.String())}},
+						{Metadata: domain.ObjectMeta{Name: lo.ToPtr(otherOrgId.String())}},
+					},
+				}, domain.StatusOK())
+				mockSvc.EXPECT().ListRepositories(gomock.Any(), org.DefaultID, gomock.Any()).Return(&domain.RepositoryList{
+					Items: []domain.Repository{
+						newGitRepository("demos", "git@github.com:flightctl/flightctl-demos.git"),
+						newGitRepository("other", "https://github.com/flightctl/other.git"),
+					},
+				}, domain.StatusOK())
+				mockSvc.EXPECT().ListRepositories(gomock.Any(), otherOrgId, gomock.Any()).Return(&domain.RepositoryList{
+					Items: []domain.Repository{
+						newGitRepository("demos", "https://github.com/flightctl/flightctl-demos"),
+					},
+				}, domain.StatusOK())
+			}
+			mockSvc.EXPECT().CreateEvent(gomock.Any(), otherOrgId, gomock.Any()).Times(tt.wantEvents)
+			mockSvc.EXPECT().CreateEvent(gomock.Any(), org.DefaultID, gomock.Any()).
+				Do(func(_ any, _ any, event *domain.Event) {
+					require.Equal(t, domain.EventReasonRepositoryPushReceived, event.Reason)
+					require.Equal(t, "demos", event.InvolvedObject.Name)
+					details, err := event.Details.AsRepositoryPushReceivedDetails()
+					require.NoError(t, err)
+					require.Equal(t, "refs/heads/main", details.Ref)
+				}).Times(tt.wantEvents)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/git", bytes.NewBufferString(testPushPayload))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rr := httptest.NewRecorder()
+			GitWebhookHandler(mockSvc, testWebhookSecret, logrus.New()).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestGitWebhookHandler_BodyTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+
+	body := bytes.Repeat([]byte("a"), maxGitWebhookBodySize+1)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/git", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+signPayload(string(body)))
+	rr := httptest.NewRecorder()
+	GitWebhookHandler(mockSvc, testWebhookSecret, logrus.New()).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+}
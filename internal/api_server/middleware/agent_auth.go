@@ -13,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/crypto/signer"
 	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/org"
+	"github.com/flightctl/flightctl/internal/revocation"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/sirupsen/logrus"
 )
@@ -64,6 +65,16 @@ func (m *AgentAuthMiddleware) AuthenticateAgent(next http.Handler) http.Handler
 
 		// Create cache key from certificate fingerprint
 		cacheKey := m.createCacheKey(r.TLS)
+
+		// Revocations take effect immediately, including for identities that are already cached
+		if len(r.TLS.PeerCertificates) > 0 && revocation.Revocations.Instance().IsRevoked(r.TLS.PeerCertificates[0]) {
+			m.log.Warnf("Rejecting revoked client certificate: serial=%s", crypto.FormatSerialNumber(r.TLS.PeerCertificates[0].SerialNumber))
+			if cacheKey != "" {
+				m.cache.Delete(cacheKey)
+			}
+			http.Error(w, "client certificate has been revoked", http.StatusUnauthorized)
+			return
+		}
 		if cacheKey != "" {
 			// Check cache first
 			if item := m.cache.Get(cacheKey); item != nil {
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/revocation"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentAuthRejectsRevokedCertificate(t *testing.T) {
+	cert := &x509.Certificate{
+		Raw:            []byte("revoked-agent-cert"),
+		SerialNumber:   big.NewInt(0x1234),
+		AuthorityKeyId: []byte{0xab, 0xcd},
+	}
+	assert.NoError(t, revocation.Revocations.Instance().Add(context.Background(), domain.CertificateRevocation{
+		Issuer:       "abcd",
+		SerialNumber: "1234",
+		Reason:       domain.CertificateRevocationReasonKeyCompromise,
+		RevokedAt:    time.Now(),
+	}))
+
+	m := NewAgentAuthMiddleware(nil, logrus.New())
+	tlsState := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	cacheKey := m.createCacheKey(tlsState)
+	// The identity was authenticated before the certificate was revoked
+	m.cache.Set(cacheKey, &AgentIdentity{expirationDate: time.Now().Add(time.Hour)}, ttlcache.DefaultTTL)
+
+	called := false
+	handler := m.AuthenticateAgent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/devices/mydevice/rendered", nil)
+	req.TLS = tlsState
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Nil(t, m.cache.Get(cacheKey))
+}
@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/flightctl/flightctl/internal/util"
+	chi "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// auditConsoleResource is the resource of device console sessions, which are audited although
+	// they are opened by GET requests.
+	auditConsoleResource = "devices/console"
+	// auditConsoleVerb is the verb recorded for device console sessions.
+	auditConsoleVerb = "connect"
+	// maxAuditSummaryEntries bounds the number of fields listed in the summary of an audit event.
+	maxAuditSummaryEntries = 10
+)
+
+var auditPathPrefixes = []string{"/api/v1/", "/ws/v1/"}
+
+var auditVerbs = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// AuditRecorder records the audit events of user actions on the API.
+type AuditRecorder interface {
+	Record(ctx context.Context, orgId uuid.UUID, event *api.AuditEvent)
+}
+
+// Audit returns a middleware that records an audit event for every mutating API call and device console
+// session of an authenticated user. It must run after the identity and organization are set in the
+// request context.
+func Audit(recorder AuditRecorder, logger logrus.FieldLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			resource, name, verb, ok := resolveAuditTarget(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx := r.Context()
+			mappedIdentity, ok := contextutil.GetMappedIdentityFromContext(ctx)
+			if !ok || mappedIdentity == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			orgId, ok := util.GetOrgIdFromContext(ctx)
+			if !ok {
+				logger.Debugf("Audit: no organization in context for %s %s, skipping", r.Method, r.URL.Path)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var body []byte
+			if r.Body != nil && r.Body != http.NoBody {
+				var err error
+				body, err = io.ReadAll(r.Body)
+				if err != nil {
+					http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			start := time.Now()
+			ww := chi.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			statusCode := ww.Status()
+			if statusCode == 0 {
+				// the connection was hijacked, as done when upgrading to a websocket
+				statusCode = http.StatusSwitchingProtocols
+			}
+			outcome := api.AuditEventOutcomeSuccess
+			if statusCode >= http.StatusBadRequest {
+				outcome = api.AuditEventOutcomeFailure
+			}
+
+			var summary string
+			if resource == auditConsoleResource {
+				summary = fmt.Sprintf("console session lasted %s", time.Since(start).Round(time.Second))
+			} else {
+				summary = summarizeAuditBody(r.Method, body)
+				if name == "" && r.Method == http.MethodPost {
+					name = nameFromAuditBody(body)
+				}
+			}
+
+			event := &api.AuditEvent{
+				ApiVersion:   api.AuditEventAPIVersion,
+				Kind:         api.AuditEventKind,
+				Metadata:     api.ObjectMeta{Name: lo.ToPtr(uuid.NewString())},
+				Actor:        mappedIdentity.GetUsername(),
+				Verb:         verb,
+				Resource:     resource,
+				ResourceName: lo.EmptyableToPtr(name),
+				Summary:      lo.EmptyableToPtr(summary),
+				SourceIP:     lo.EmptyableToPtr(auditSourceIP(r)),
+				RequestId:    lo.EmptyableToPtr(chi.GetReqID(ctx)),
+				Outcome:      outcome,
+				StatusCode:   int32(statusCode), //nolint:gosec // HTTP status codes fit in an int32
+			}
+			// the request context may already be canceled once the client has its response
+			recorder.Record(context.WithoutCancel(ctx), orgId, event)
+		})
+	}
+}
+
+// resolveAuditTarget returns the resource, resource name, and verb of an audited request, and whether
+// the request is audited at all.
+func resolveAuditTarget(r *http.Request) (string, string, string, bool) {
+	var parts []string
+	for _, prefix := range auditPathPrefixes {
+		if rest, found := strings.CutPrefix(r.URL.Path, prefix); found {
+			parts = strings.Split(strings.Trim(rest, "/"), "/")
+			break
+		}
+	}
+	if len(parts) == 0 || parts[0] == "" {
+		return "", "", "", false
+	}
+
+	var name string
+	if len(parts) >= 2 {
+		name = parts[1]
+	}
+	resource := strings.ToLower(parts[0])
+	if len(parts) >= 3 {
+		resource += "/" + strings.ToLower(parts[2])
+	}
+	if resource == auditConsoleResource && r.Method == http.MethodGet {
+		return resource, name, auditConsoleVerb, true
+	}
+
+	verb, ok := auditVerbs[r.Method]
+	if !ok || resource == "auth" || strings.HasPrefix(resource, "auth/") {
+		return "", "", "", false
+	}
+	if metadata := server.MetadataResolver.Resolve(r); metadata != nil && metadata.Resource != "" && metadata.Action != "" {
+		resource = metadata.Resource
+		verb = metadata.Action
+	}
+	return resource, name, verb, true
+}
+
+// summarizeAuditBody summarizes the changes requested by the body of a mutating request: the operations
+// of a JSON patch, or the fields set by a resource.
+func summarizeAuditBody(method string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var entries []string
+	if method == http.MethodPatch {
+		var patch []struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}
+		if err := json.Unmarshal(body, &patch); err != nil {
+			return ""
+		}
+		for _, op := range patch {
+			entries = append(entries, fmt.Sprintf("%s %s", op.Op, op.Path))
+		}
+	} else {
+		var resource map[string]json.RawMessage
+		if err := json.Unmarshal(body, &resource); err != nil {
+			return ""
+		}
+		for _, key := range []string{"metadata", "spec"} {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(resource[key], &fields); err != nil {
+				continue
+			}
+			keys := lo.Keys(fields)
+			sort.Strings(keys)
+			for _, field := range keys {
+				entries = append(entries, fmt.Sprintf("set %s.%s", key, field))
+			}
+		}
+	}
+
+	if len(entries) > maxAuditSummaryEntries {
+		more := len(entries) - maxAuditSummaryEntries
+		entries = append(entries[:maxAuditSummaryEntries], fmt.Sprintf("and %d more", more))
+	}
+	return strings.Join(entries, ", ")
+}
+
+// nameFromAuditBody returns the name of the resource created by a request body, if any.
+func nameFromAuditBody(body []byte) string {
+	var resource struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return ""
+	}
+	return resource.Metadata.Name
+}
+
+// auditSourceIP returns the IP address a request originated from.
+func auditSourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
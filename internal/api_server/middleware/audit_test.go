@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuditRecorder struct {
+	orgIds []uuid.UUID
+	events []*api.AuditEvent
+}
+
+func (f *fakeAuditRecorder) Record(ctx context.Context, orgId uuid.UUID, event *api.AuditEvent) {
+	f.orgIds = append(f.orgIds, orgId)
+	f.events = append(f.events, event)
+}
+
+func newAuditRequest(method string, path string, body string, withIdentity bool) (*http.Request, uuid.UUID) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	orgId := uuid.New()
+	if !withIdentity {
+		return req, orgId
+	}
+	mappedIdentity := identity.NewMappedIdentity("alice", "alice", nil, nil, false, nil)
+	ctx := context.WithValue(req.Context(), consts.MappedIdentityCtxKey, mappedIdentity)
+	ctx = util.WithOrganizationID(ctx, orgId)
+	return req.WithContext(ctx), orgId
+}
+
+func TestAuditRecordsMutatingRequests(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		body            string
+		status          int
+		expectedVerb    string
+		expectedName    string
+		expectedSummary string
+		expectedOutcome api.AuditEventOutcome
+	}{
+		{
+			name:            "patch lists the operations",
+			method:          http.MethodPatch,
+			path:            "/api/v1/devices/dev-1",
+			body:            `[{"op":"add","path":"/metadata/labels/site","value":"north"}]`,
+			status:          http.StatusOK,
+			expectedVerb:    "patch",
+			expectedName:    "dev-1",
+			expectedSummary: "add /metadata/labels/site",
+			expectedOutcome: api.AuditEventOutcomeSuccess,
+		},
+		{
+			name:            "create takes the name from the body",
+			method:          http.MethodPost,
+			path:            "/api/v1/fleets",
+			body:            `{"metadata":{"name":"fleet-1","labels":{}},"spec":{"selector":{}}}`,
+			status:          http.StatusCreated,
+			expectedVerb:    "create",
+			expectedName:    "fleet-1",
+			expectedSummary: "set metadata.labels, set metadata.name, set spec.selector",
+			expectedOutcome: api.AuditEventOutcomeSuccess,
+		},
+		{
+			name:            "denied request is a failure",
+			method:          http.MethodDelete,
+			path:            "/api/v1/fleets/fleet-1",
+			status:          http.StatusForbidden,
+			expectedVerb:    "delete",
+			expectedName:    "fleet-1",
+			expectedOutcome: api.AuditEventOutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeAuditRecorder{}
+			handler := Audit(recorder, logrus.New())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			req, orgId := newAuditRequest(tt.method, tt.path, tt.body, true)
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Len(t, recorder.events, 1)
+			event := recorder.events[0]
+			assert.Equal(t, orgId, recorder.orgIds[0])
+			assert.Equal(t, "alice", event.Actor)
+			assert.Equal(t, tt.expectedVerb, event.Verb)
+			assert.Equal(t, tt.expectedName, lo.FromPtr(event.ResourceName))
+			assert.Equal(t, tt.expectedSummary, lo.FromPtr(event.Summary))
+			assert.Equal(t, tt.expectedOutcome, event.Outcome)
+			assert.Equal(t, int32(tt.status), event.StatusCode) //nolint:gosec
+		})
+	}
+}
+
+func TestAuditSkipsUnauditedRequests(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		withIdentity bool
+	}{
+		{name: "read request", method: http.MethodGet, path: "/api/v1/devices/dev-1", withIdentity: true},
+		{name: "authentication request", method: http.MethodPost, path: "/api/v1/auth/token", withIdentity: true},
+		{name: "request without identity", method: http.MethodDelete, path: "/api/v1/devices/dev-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeAuditRecorder{}
+			called := false
+			handler := Audit(recorder, logrus.New())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			}))
+			req, _ := newAuditRequest(tt.method, tt.path, "", tt.withIdentity)
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.True(t, called)
+			assert.Empty(t, recorder.events)
+		})
+	}
+}
+
+func TestSummarizeAuditBodyTruncates(t *testing.T) {
+	body := `[` + strings.Repeat(`{"op":"remove","path":"/x"},`, 12) + `{"op":"remove","path":"/x"}]`
+	summary := summarizeAuditBody(http.MethodPatch, []byte(body))
+	assert.True(t, strings.HasSuffix(summary, ", and 3 more"), summary)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/revocation"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -77,5 +78,8 @@ func ValidateClientTlsCert(ctx context.Context) (context.Context, error) {
 	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
 		return ctx, status.Error(codes.Unauthenticated, "failed to verify client certificate")
 	}
+	if revocation.Revocations.Instance().IsRevoked(tlsInfo.State.VerifiedChains[0][0]) {
+		return ctx, status.Error(codes.Unauthenticated, "client certificate has been revoked")
+	}
 	return ctx, nil
 }
@@ -0,0 +1,96 @@
+package apiserver
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/revocation"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	CRLPath  = "/pki/crl"
+	OCSPPath = "/pki/ocsp"
+
+	// maxOCSPRequestSize bounds the size of OCSP requests, which only reference a single
+	// certificate.
+	maxOCSPRequestSize = 4096
+)
+
+func revocationValidity(cfg *ca.Config) time.Duration {
+	if cfg != nil && cfg.Revocation != nil && cfg.Revocation.ValidityMinutes > 0 {
+		return time.Duration(cfg.Revocation.ValidityMinutes) * time.Minute
+	}
+	return time.Hour
+}
+
+// CRLHandler returns an HTTP handler serving the DER encoded certificate revocation list of the
+// CA, listing the revoked certificates that have not expired yet.
+func CRLHandler(caClient *crypto.CAClient, cfg *ca.Config, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now().UTC()
+		crl, err := caClient.CreateCRL(revocation.Revocations.Instance().List(), now, now.Add(revocationValidity(cfg)))
+		if err != nil {
+			log.WithError(err).Error("failed to create certificate revocation list")
+			http.Error(w, "failed to create certificate revocation list", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		_, _ = w.Write(crl)
+	})
+}
+
+// OCSPHandler returns an HTTP handler answering OCSP requests for the certificates issued by the
+// CA, sent either in the body of POST requests or base64 encoded in the path of GET requests as
+// described in RFC 6960.
+func OCSPHandler(caClient *crypto.CAClient, cfg *ca.Config, log logrus.FieldLogger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var der []byte
+		switch r.Method {
+		case http.MethodPost:
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxOCSPRequestSize))
+			if err != nil {
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			der = body
+		default:
+			encoded, err := url.PathUnescape(strings.TrimPrefix(strings.TrimPrefix(r.URL.EscapedPath(), OCSPPath), "/"))
+			if err != nil {
+				writeOCSPResponse(w, ocsp.MalformedRequestErrorResponse)
+				return
+			}
+			if der, err = base64.StdEncoding.DecodeString(encoded); err != nil {
+				writeOCSPResponse(w, ocsp.MalformedRequestErrorResponse)
+				return
+			}
+		}
+
+		request, err := ocsp.ParseRequest(der)
+		if err != nil {
+			writeOCSPResponse(w, ocsp.MalformedRequestErrorResponse)
+			return
+		}
+
+		now := time.Now().UTC()
+		response, err := caClient.CreateOCSPResponse(request, revocation.Revocations.Instance().Lookup, now, now.Add(revocationValidity(cfg)))
+		if err != nil {
+			log.WithError(err).Error("failed to create OCSP response")
+			writeOCSPResponse(w, ocsp.InternalErrorErrorResponse)
+			return
+		}
+		writeOCSPResponse(w, response)
+	})
+}
+
+func writeOCSPResponse(w http.ResponseWriter, response []byte) {
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	_, _ = w.Write(response)
+}
@@ -18,6 +18,7 @@ import (
 	serverv1alpha1 "github.com/flightctl/flightctl/internal/api/server/v1alpha1"
 	fcmiddleware "github.com/flightctl/flightctl/internal/api_server/middleware"
 	"github.com/flightctl/flightctl/internal/api_server/versioning"
+	"github.com/flightctl/flightctl/internal/audit"
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/auth/authn"
 	"github.com/flightctl/flightctl/internal/config"
@@ -161,6 +162,7 @@ func (s *Server) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed initializing auth: %w", err)
 	}
+	authN.SetServiceAccountProvider(authn.NewServiceAccountAuth(service.NewServiceAccountTokenAuthenticator(s.store, s.log), s.log))
 	s.authN = authN
 
 	// Create auth proxies (token and userinfo)
@@ -183,6 +185,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	// Start multiAuthZ to initialize cache lifecycle management
 	if multiAuthZ, ok := s.authZ.(*auth.MultiAuthZ); ok {
+		multiAuthZ.SetRoleSource(service.NewCustomRoleSource(s.store))
 		multiAuthZ.Start(ctx)
 		s.log.Debug("Started MultiAuthZ with context-based cache lifecycle")
 	}
@@ -203,8 +206,17 @@ func (s *Server) Run(ctx context.Context) error {
 		auth.CreateAuthNMiddleware(s.authN, s.log),
 		identityMappingMiddleware.MapIdentityToDB,
 		orgMiddleware,
-		auth.CreateAuthZMiddleware(s.authZ, s.log),
 	}
+	// audit before authorization, so that denied actions are recorded as well
+	if auditLog := s.cfg.Service.AuditLog; auditLog != nil && auditLog.Enabled {
+		auditRecorder, err := audit.NewRecorder(serviceHandler, auditLog, s.log.WithField("pkg", "audit"))
+		if err != nil {
+			return fmt.Errorf("failed initializing audit log: %w", err)
+		}
+		defer auditRecorder.Close()
+		authMiddewares = append(authMiddewares, fcmiddleware.Audit(auditRecorder, s.log))
+	}
+	authMiddewares = append(authMiddewares, auth.CreateAuthZMiddleware(s.authZ, s.log))
 
 	// general middleware stack for all route groups
 	// request size limits should come before logging to prevent DoS attacks from filling logs
@@ -337,6 +349,33 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	})
 
+	// git webhook: bypasses OpenAPI + auth, the payloads are authenticated with the webhook secret
+	if s.cfg.GitOps != nil && s.cfg.GitOps.Webhook != nil && s.cfg.GitOps.Webhook.Enabled {
+		webhook := s.cfg.GitOps.Webhook
+		router.Group(func(r chi.Router) {
+			ConfigureRateLimiterFromConfig(
+				r,
+				s.cfg.Service.RateLimit,
+				RateLimitScopeGeneral,
+			)
+			r.Method(http.MethodPost, webhook.Path, GitWebhookHandler(serviceHandler, string(webhook.Secret), s.log))
+		})
+	}
+
+	// certificate revocation: bypasses OpenAPI + auth, as relying parties check the certificates
+	// before trusting the devices presenting them
+	router.Group(func(r chi.Router) {
+		ConfigureRateLimiterFromConfig(
+			r,
+			s.cfg.Service.RateLimit,
+			RateLimitScopeGeneral,
+		)
+		r.Method(http.MethodGet, CRLPath, CRLHandler(s.ca, s.cfg.CA, s.log))
+		ocspHandler := OCSPHandler(s.ca, s.cfg.CA, s.log)
+		r.Method(http.MethodPost, OCSPPath, ocspHandler)
+		r.Method(http.MethodGet, OCSPPath+"/*", ocspHandler)
+	})
+
 	// ws handling
 	router.Group(func(r chi.Router) {
 		r.Use(fcmiddleware.CreateRouteExistsMiddleware(r))
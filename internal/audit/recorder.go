@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/api_server/middleware"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var _ middleware.AuditRecorder = (*Recorder)(nil)
+
+// fileRecord is a line of the audit log file. Unlike the audit events returned by the API, it carries
+// the organization of the event, as the file is shared by all organizations.
+type fileRecord struct {
+	OrgID uuid.UUID `json:"orgId"`
+	*domain.AuditEvent
+}
+
+// Recorder records audit events in the database and, if a file path is configured, in a rotating
+// JSON lines file.
+type Recorder struct {
+	serviceHandler service.Service
+	rotatingLog    *lumberjack.Logger
+	log            logrus.FieldLogger
+}
+
+// NewRecorder creates a Recorder writing to the database through the service handler and to the
+// rotating file configured in cfg, if any.
+func NewRecorder(serviceHandler service.Service, cfg *config.AuditLogConfig, log logrus.FieldLogger) (*Recorder, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("audit log config is required")
+	}
+
+	r := &Recorder{
+		serviceHandler: serviceHandler,
+		log:            log,
+	}
+	if cfg.FilePath != "" {
+		r.rotatingLog = &lumberjack.Logger{
+			Filename:   cfg.FilePath,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   true,
+		}
+	}
+	return r, nil
+}
+
+// Record records an audit event of an organization. Failures are logged, as they must not fail the
+// recorded action, which has already taken place.
+func (r *Recorder) Record(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) {
+	if r.rotatingLog != nil {
+		if err := r.writeRecord(fileRecord{OrgID: orgId, AuditEvent: event}); err != nil {
+			r.log.Errorf("failed writing audit event %s to file: %v", *event.Metadata.Name, err)
+		}
+	}
+
+	status := r.serviceHandler.CreateAuditEvent(ctx, orgId, event)
+	if status.Code >= http.StatusMultipleChoices {
+		r.log.Errorf("failed storing audit event %s: %s", *event.Metadata.Name, status.Message)
+	}
+}
+
+// Close closes the audit log file.
+func (r *Recorder) Close() error {
+	if r.rotatingLog != nil {
+		return r.rotatingLog.Close()
+	}
+	return nil
+}
+
+func (r *Recorder) writeRecord(record fileRecord) error {
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshaling audit event: %w", err)
+	}
+	recordBytes = append(recordBytes, '\n')
+	if _, err := r.rotatingLog.Write(recordBytes); err != nil {
+		return fmt.Errorf("writing audit event to rotating log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecorderWritesFileAndStore(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+
+	orgId := uuid.New()
+	event := &domain.AuditEvent{
+		ApiVersion: domain.AuditEventAPIVersion,
+		Kind:       domain.AuditEventKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(uuid.NewString())},
+		Actor:      "alice",
+		Verb:       "delete",
+		Resource:   "fleets",
+		Outcome:    domain.AuditEventOutcomeSuccess,
+		StatusCode: 200,
+	}
+	mockService.EXPECT().CreateAuditEvent(gomock.Any(), orgId, event).Return(domain.StatusCreated())
+
+	filePath := filepath.Join(t.TempDir(), "audit.log")
+	recorder, err := NewRecorder(mockService, &config.AuditLogConfig{Enabled: true, FilePath: filePath, MaxSizeMB: 1}, logrus.New())
+	require.NoError(err)
+	recorder.Record(context.Background(), orgId, event)
+	require.NoError(recorder.Close())
+
+	contents, err := os.ReadFile(filePath)
+	require.NoError(err)
+	var record map[string]interface{}
+	require.NoError(json.Unmarshal(contents, &record))
+	require.Equal(orgId.String(), record["orgId"])
+	require.Equal("alice", record["actor"])
+	require.Equal("fleets", record["resource"])
+}
@@ -57,6 +57,9 @@ type MultiAuth struct {
 	// Static providers - initialized once at startup, mapped by issuer
 	staticProviders map[string]common.AuthNMiddleware // issuer -> provider mapping
 
+	// Provider of the service account API tokens, which are routed by their prefix
+	serviceAccountProvider common.AuthNMiddleware
+
 	// Service for dynamic auth providers
 	authProviderService AuthProviderService
 
@@ -100,6 +103,12 @@ func NewMultiAuth(authProviderService AuthProviderService, tlsConfig *tls.Config
 func (m *MultiAuth) AddStaticProvider(issuer string, provider common.AuthNMiddleware) {
 	m.staticProviders[issuer] = provider
 }
+
+// SetServiceAccountProvider sets the provider that authenticates the API tokens of service accounts
+func (m *MultiAuth) SetServiceAccountProvider(provider common.AuthNMiddleware) {
+	m.serviceAccountProvider = provider
+}
+
 func (m *MultiAuth) IsEnabled() bool {
 	return true
 }
@@ -985,6 +994,14 @@ func (m *MultiAuth) isProviderEnabled(provider *api.AuthProvider) bool {
 // getPossibleProviders extracts possible providers from a token
 // Returns a list of providers and the parsed JWT token (nil if not a JWT)
 func (m *MultiAuth) getPossibleProviders(token string) ([]common.AuthNMiddleware, jwt.Token, error) {
+	// Service account tokens are opaque tokens with a well-known prefix
+	if IsServiceAccountToken(token) {
+		if m.serviceAccountProvider == nil || !m.serviceAccountProvider.IsEnabled() {
+			return []common.AuthNMiddleware{}, nil, fmt.Errorf("service account authentication is not enabled")
+		}
+		return []common.AuthNMiddleware{m.serviceAccountProvider}, nil, nil
+	}
+
 	// Try to parse as JWT token
 	parsedToken, err := parseToken(token)
 	if err != nil || parsedToken.Issuer() == "" {
@@ -0,0 +1,133 @@
+package authn
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ServiceAccountTokenPrefix is the prefix of the API tokens of service accounts, which tells
+	// them apart from the tokens issued by the identity providers.
+	ServiceAccountTokenPrefix = "fcsa_"
+
+	// ServiceAccountUsernamePrefix is the prefix of the username of a service account identity.
+	ServiceAccountUsernamePrefix = "system:serviceaccount:"
+
+	serviceAccountTokenBytes = 32
+)
+
+// ServiceAccountTokenInfo describes the service account a token belongs to and the roles it grants.
+type ServiceAccountTokenInfo struct {
+	OrgID          uuid.UUID
+	ServiceAccount string
+	TokenName      string
+	Roles          []string
+}
+
+// ServiceAccountTokenService looks up the service account of an API token.
+type ServiceAccountTokenService interface {
+	AuthenticateServiceAccountToken(ctx context.Context, token string) (*ServiceAccountTokenInfo, error)
+}
+
+// GenerateServiceAccountToken generates a new random service account API token.
+func GenerateServiceAccountToken() (string, error) {
+	b := make([]byte, serviceAccountTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return ServiceAccountTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashServiceAccountToken returns the hash under which a service account API token is stored.
+func HashServiceAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsServiceAccountToken returns true if the token is a service account API token.
+func IsServiceAccountToken(token string) bool {
+	return strings.HasPrefix(token, ServiceAccountTokenPrefix)
+}
+
+// ServiceAccountAuth authenticates the API tokens of service accounts.
+type ServiceAccountAuth struct {
+	tokenService ServiceAccountTokenService
+	log          logrus.FieldLogger
+}
+
+// Make sure we conform to the AuthNMiddleware interface
+var _ common.AuthNMiddleware = (*ServiceAccountAuth)(nil)
+
+func NewServiceAccountAuth(tokenService ServiceAccountTokenService, log logrus.FieldLogger) *ServiceAccountAuth {
+	return &ServiceAccountAuth{
+		tokenService: tokenService,
+		log:          log,
+	}
+}
+
+func (s *ServiceAccountAuth) authenticate(ctx context.Context, token string) (*ServiceAccountTokenInfo, error) {
+	if !IsServiceAccountToken(token) {
+		return nil, errors.New("not a service account token")
+	}
+	info, err := s.tokenService.AuthenticateServiceAccountToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, errors.New("invalid service account token")
+	}
+	return info, nil
+}
+
+func (s *ServiceAccountAuth) ValidateToken(ctx context.Context, token string) error {
+	_, err := s.authenticate(ctx, token)
+	return err
+}
+
+func (s *ServiceAccountAuth) GetIdentity(ctx context.Context, token string) (common.Identity, error) {
+	info, err := s.authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := info.OrgID.String()
+	organizations := []common.ReportedOrganization{
+		{
+			Name:         orgID,
+			IsInternalID: true,
+			ID:           orgID,
+			Roles:        info.Roles,
+		},
+	}
+	username := ServiceAccountUsernamePrefix + info.ServiceAccount
+	issuer := identity.NewIssuer(identity.AuthTypeServiceAccount, orgID)
+	return common.NewBaseIdentityWithIssuer(username, fmt.Sprintf("%s:%s", orgID, info.ServiceAccount), organizations, issuer), nil
+}
+
+func (s *ServiceAccountAuth) GetAuthToken(r *http.Request) (string, error) {
+	return common.ExtractBearerToken(r)
+}
+
+// GetAuthConfig returns no providers, as service accounts are not used to log in interactively.
+func (s *ServiceAccountAuth) GetAuthConfig() *api.AuthConfig {
+	return &api.AuthConfig{
+		ApiVersion: api.AuthConfigAPIVersion,
+	}
+}
+
+func (s *ServiceAccountAuth) IsEnabled() bool {
+	return true
+}
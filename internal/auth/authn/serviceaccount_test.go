@@ -0,0 +1,108 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeServiceAccountTokenService struct {
+	tokens map[string]*ServiceAccountTokenInfo
+	calls  int
+}
+
+func (f *fakeServiceAccountTokenService) AuthenticateServiceAccountToken(ctx context.Context, token string) (*ServiceAccountTokenInfo, error) {
+	f.calls++
+	info, ok := f.tokens[token]
+	if !ok {
+		return nil, errors.New("unknown service account token")
+	}
+	return info, nil
+}
+
+func TestGenerateServiceAccountToken(t *testing.T) {
+	token, err := GenerateServiceAccountToken()
+	require.NoError(t, err)
+	assert.True(t, IsServiceAccountToken(token))
+
+	other, err := GenerateServiceAccountToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+
+	assert.Equal(t, HashServiceAccountToken(token), HashServiceAccountToken(token))
+	assert.NotEqual(t, HashServiceAccountToken(token), HashServiceAccountToken(other))
+	assert.NotContains(t, HashServiceAccountToken(token), token)
+}
+
+func TestServiceAccountAuth_GetIdentity(t *testing.T) {
+	orgID := uuid.New()
+	tokens := &fakeServiceAccountTokenService{
+		tokens: map[string]*ServiceAccountTokenInfo{
+			"fcsa_valid": {OrgID: orgID, ServiceAccount: "ci", TokenName: "pipeline", Roles: []string{"flightctl-operator"}},
+		},
+	}
+	auth := NewServiceAccountAuth(tokens, logrus.New())
+
+	require.NoError(t, auth.ValidateToken(context.Background(), "fcsa_valid"))
+	assert.Error(t, auth.ValidateToken(context.Background(), "fcsa_unknown"))
+
+	// tokens without the prefix are never looked up
+	calls := tokens.calls
+	assert.Error(t, auth.ValidateToken(context.Background(), "valid"))
+	assert.Equal(t, calls, tokens.calls)
+
+	ident, err := auth.GetIdentity(context.Background(), "fcsa_valid")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:ci", ident.GetUsername())
+	assert.Equal(t, identity.AuthTypeServiceAccount, ident.GetIssuer().Type)
+	assert.False(t, ident.IsSuperAdmin())
+	require.Len(t, ident.GetOrganizations(), 1)
+	org := ident.GetOrganizations()[0]
+	assert.True(t, org.IsInternalID)
+	assert.Equal(t, orgID.String(), org.ID)
+	assert.Equal(t, []string{"flightctl-operator"}, org.Roles)
+}
+
+func TestMultiAuth_ServiceAccountTokenRouting(t *testing.T) {
+	orgID := uuid.New()
+	tokens := &fakeServiceAccountTokenService{
+		tokens: map[string]*ServiceAccountTokenInfo{
+			"fcsa_valid": {OrgID: orgID, ServiceAccount: "ci", TokenName: "pipeline", Roles: []string{"flightctl-viewer"}},
+		},
+	}
+	multiAuth := NewMultiAuth(nil, nil, logrus.New())
+
+	// an opaque token provider that accepts every token must not see service account tokens
+	aap := &MockAuthNMiddleware{issuer: "aap", valid: true, enabled: true}
+	multiAuth.AddStaticProvider("aap", aap)
+
+	// without a service account provider, service account tokens are rejected
+	assert.Error(t, multiAuth.ValidateToken(context.Background(), "fcsa_valid"))
+
+	saAuth := NewServiceAccountAuth(tokens, logrus.New())
+	multiAuth.SetServiceAccountProvider(saAuth)
+
+	provider, err := multiAuth.ValidateTokenAndGetProvider(context.Background(), "fcsa_valid")
+	require.NoError(t, err)
+	assert.Same(t, saAuth, provider)
+	assert.Error(t, multiAuth.ValidateToken(context.Background(), "fcsa_unknown"))
+
+	ident, err := multiAuth.GetIdentity(context.Background(), "fcsa_valid")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:ci", ident.GetUsername())
+
+	// other opaque tokens still go to the other providers
+	provider, err = multiAuth.ValidateTokenAndGetProvider(context.Background(), "opaque-token")
+	require.NoError(t, err)
+	assert.Same(t, aap, provider)
+
+	// the service account provider is not offered for interactive login
+	assert.Empty(t, saAuth.GetAuthConfig().Providers)
+	assert.Len(t, *multiAuth.GetAuthConfig().Providers, len(*aap.GetAuthConfig().Providers))
+}
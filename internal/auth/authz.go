@@ -26,6 +26,7 @@ type MultiAuthZ struct {
 	caCert                string
 	staticAuthZ           *authz.StaticAuthZ
 	staticAuthZOnce       sync.Once
+	roleSource            authz.RoleSource
 	log                   logrus.FieldLogger
 	ctx                   context.Context
 	started               bool
@@ -106,11 +107,21 @@ func (m *MultiAuthZ) getOpenShiftAuthZ(apiUrl string) (*authz.OpenShiftAuthZ, er
 func (m *MultiAuthZ) getStaticAuthZ() *authz.StaticAuthZ {
 	m.staticAuthZOnce.Do(func() {
 		m.log.Debug("Lazy-initializing static authZ")
-		m.staticAuthZ = authz.NewStaticAuthZ(m.log)
+		var opts []authz.StaticAuthZOption
+		if m.roleSource != nil {
+			opts = append(opts, authz.WithRoleSource(m.roleSource))
+		}
+		m.staticAuthZ = authz.NewStaticAuthZ(m.log, opts...)
 	})
 	return m.staticAuthZ
 }
 
+// SetRoleSource sets the source of the custom roles of the organizations, which grant permissions
+// in addition to the built-in roles. It must be called before the first authorization request.
+func (m *MultiAuthZ) SetRoleSource(roleSource authz.RoleSource) {
+	m.roleSource = roleSource
+}
+
 // Start initializes the MultiAuthZ with the given context for cache lifecycle management
 func (m *MultiAuthZ) Start(ctx context.Context) {
 	m.ctx = ctx
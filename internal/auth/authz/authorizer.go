@@ -0,0 +1,23 @@
+package authz
+
+import "context"
+
+// Authorizer checks whether the caller of a request is granted an operation on a resource.
+type Authorizer interface {
+	CheckPermission(ctx context.Context, resource string, op string) (bool, error)
+}
+
+type authorizerCtxKey struct{}
+
+// WithAuthorizer returns a copy of ctx carrying the authorizer of a request, so that the service can
+// check the permissions a request requires beyond the one checked for its endpoint.
+func WithAuthorizer(ctx context.Context, authorizer Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerCtxKey{}, authorizer)
+}
+
+// AuthorizerFromContext returns the authorizer of a request, or nil if the request wasn't authorized,
+// e.g. because it was made by the service itself.
+func AuthorizerFromContext(ctx context.Context) Authorizer {
+	authorizer, _ := ctx.Value(authorizerCtxKey{}).(Authorizer)
+	return authorizer
+}
@@ -0,0 +1,207 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRoleSource struct {
+	roles    []v1beta1.Role
+	bindings []v1beta1.RoleBinding
+}
+
+func (f *fakeRoleSource) GetCustomRoles(ctx context.Context, orgId uuid.UUID, username string, roles []string) ([]v1beta1.Role, []v1beta1.RoleBinding, error) {
+	return f.roles, f.bindings, nil
+}
+
+func newTestRole(name string, resources []string, verbs []string) v1beta1.Role {
+	return v1beta1.Role{
+		Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: v1beta1.RoleSpec{
+			Rules: []v1beta1.RoleRule{{Resources: resources, Verbs: verbs}},
+		},
+	}
+}
+
+func newTestRoleBinding(name string, roleRef string, labelSelector *string, subjects ...v1beta1.RoleBindingSubject) v1beta1.RoleBinding {
+	return v1beta1.RoleBinding{
+		Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: v1beta1.RoleBindingSpec{
+			RoleRef:       roleRef,
+			Subjects:      subjects,
+			LabelSelector: labelSelector,
+		},
+	}
+}
+
+func newTestIdentityContext(username string, roles []string) context.Context {
+	orgID := uuid.New()
+	testOrg := &model.Organization{
+		ID:          orgID,
+		ExternalID:  "test-org",
+		DisplayName: "Test Organization",
+	}
+	orgRoles := map[string][]string{orgID.String(): roles}
+	mappedIdentity := identity.NewMappedIdentity(username, username, []*model.Organization{testOrg}, orgRoles, false, nil)
+
+	ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+	return util.WithOrganizationID(ctx, orgID)
+}
+
+func TestStaticAuthZ_CheckPermission_CustomRoles(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	source := &fakeRoleSource{
+		roles: []v1beta1.Role{
+			newTestRole("fleet-editor", []string{"fleets"}, []string{"get", "list", "update"}),
+			newTestRole("device-patcher", []string{"devices"}, []string{"get", "list", "patch", "delete"}),
+		},
+		bindings: []v1beta1.RoleBinding{
+			newTestRoleBinding("alice-fleets", "fleet-editor", nil,
+				v1beta1.RoleBindingSubject{Kind: v1beta1.RoleBindingSubjectKindUser, Name: "alice"}),
+			newTestRoleBinding("north-devices", "device-patcher", lo.ToPtr("site=north"),
+				v1beta1.RoleBindingSubject{Kind: v1beta1.RoleBindingSubjectKindGroup, Name: "field-techs"}),
+			newTestRoleBinding("missing-role", "does-not-exist", nil,
+				v1beta1.RoleBindingSubject{Kind: v1beta1.RoleBindingSubjectKindUser, Name: "bob"}),
+		},
+	}
+	authZ := NewStaticAuthZ(log, WithRoleSource(source))
+
+	tests := []struct {
+		name          string
+		username      string
+		roles         []string
+		resource      string
+		op            string
+		withScope     bool
+		expected      bool
+		expectedScope []string
+	}{
+		{
+			name:     "identity provider role named like a custom role",
+			username: "carol",
+			roles:    []string{"fleet-editor"},
+			resource: "fleets",
+			op:       "update",
+			expected: true,
+		},
+		{
+			name:     "user binding without label selector",
+			username: "alice",
+			resource: "fleets",
+			op:       "list",
+			expected: true,
+		},
+		{
+			name:     "user binding does not grant verbs missing from the role",
+			username: "alice",
+			resource: "fleets",
+			op:       "delete",
+			expected: false,
+		},
+		{
+			name:     "binding referencing a missing role grants nothing",
+			username: "bob",
+			resource: "fleets",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:          "group binding with label selector records the scope",
+			username:      "dave",
+			roles:         []string{"field-techs"},
+			resource:      "devices",
+			op:            "patch",
+			withScope:     true,
+			expected:      true,
+			expectedScope: []string{"site=north"},
+		},
+		{
+			name:     "binding with label selector is denied without a scope to record",
+			username: "dave",
+			roles:    []string{"field-techs"},
+			resource: "devices",
+			op:       "list",
+			expected: false,
+		},
+		{
+			name:      "binding with label selector does not grant unscoped operations",
+			username:  "dave",
+			roles:     []string{"field-techs"},
+			resource:  "devices",
+			op:        "delete",
+			withScope: true,
+			expected:  false,
+		},
+		{
+			name:     "built-in role still applies",
+			username: "erin",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices",
+			op:       "list",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestIdentityContext(tt.username, tt.roles)
+			scope := &Scope{}
+			if tt.withScope {
+				ctx = WithScope(ctx, scope)
+			}
+
+			allowed, err := authZ.CheckPermission(ctx, tt.resource, tt.op)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, allowed)
+			assert.Equal(t, tt.expectedScope, scope.LabelSelectors)
+		})
+	}
+}
+
+func TestStaticAuthZ_GetUserPermissions_CustomRoles(t *testing.T) {
+	log := logrus.New()
+	source := &fakeRoleSource{
+		roles: []v1beta1.Role{
+			newTestRole("fleet-editor", []string{"fleets"}, []string{"get", "update"}),
+			newTestRole("device-patcher", []string{"devices"}, []string{"*"}),
+		},
+		bindings: []v1beta1.RoleBinding{
+			newTestRoleBinding("alice-fleets", "fleet-editor", nil,
+				v1beta1.RoleBindingSubject{Kind: v1beta1.RoleBindingSubjectKindUser, Name: "alice"}),
+			newTestRoleBinding("alice-devices", "device-patcher", lo.ToPtr("site=north"),
+				v1beta1.RoleBindingSubject{Kind: v1beta1.RoleBindingSubjectKindUser, Name: "alice"}),
+		},
+	}
+	authZ := NewStaticAuthZ(log, WithRoleSource(source))
+
+	permissionList, err := authZ.GetUserPermissions(newTestIdentityContext("alice", nil))
+
+	require.NoError(t, err)
+	permissions := make(map[string][]string)
+	for _, permission := range permissionList.Permissions {
+		permissions[permission.Resource] = permission.Operations
+	}
+	assert.ElementsMatch(t, []string{"get", "update"}, permissions["fleets"])
+	assert.ElementsMatch(t, scopedOperations, permissions["devices"])
+}
+
+func TestScopeFromContext(t *testing.T) {
+	assert.Nil(t, ScopeFromContext(context.Background()))
+	assert.Nil(t, ScopeFromContext(WithScope(context.Background(), &Scope{})))
+
+	scope := &Scope{LabelSelectors: []string{"site=north"}}
+	assert.Equal(t, scope, ScopeFromContext(WithScope(context.Background(), scope)))
+}
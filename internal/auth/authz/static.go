@@ -3,6 +3,7 @@ package authz
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -13,7 +14,18 @@ import (
 
 // StaticAuthZ implements role-based authorization using system groups
 type StaticAuthZ struct {
-	log logrus.FieldLogger
+	log        logrus.FieldLogger
+	roleSource RoleSource
+}
+
+// StaticAuthZOption configures a StaticAuthZ.
+type StaticAuthZOption func(*StaticAuthZ)
+
+// WithRoleSource makes StaticAuthZ also grant the permissions of the custom roles of the organizations.
+func WithRoleSource(roleSource RoleSource) StaticAuthZOption {
+	return func(s *StaticAuthZ) {
+		s.roleSource = roleSource
+	}
 }
 
 // Resource permissions based on K8s RBAC roles
@@ -32,6 +44,7 @@ var resourcePermissions = map[string]map[string][]string{
 		"fleets":                {"get", "list", "create", "update", "patch", "delete"},
 		"resourcesyncs":         {"get", "list", "create", "update", "patch", "delete"},
 		"repositories":          {"get", "list", "create", "update", "patch", "delete"},
+		"secretstores":          {"get", "list", "create", "update", "patch", "delete"},
 		"catalogs":              {"get", "list", "create", "update", "patch", "delete"},
 		"catalogitems":          {"get", "list", "create", "update", "patch", "delete"},
 		"imagebuilds":           {"get", "list", "create", "update", "patch", "delete"},
@@ -56,10 +69,49 @@ var resourcePermissions = map[string]map[string][]string{
 	},
 }
 
-func NewStaticAuthZ(log logrus.FieldLogger) *StaticAuthZ {
-	return &StaticAuthZ{
+// externalRoleNames maps the external names of the built-in roles to their internal names.
+var externalRoleNames = map[string]string{
+	v1beta1.ExternalRoleAdmin:     v1beta1.RoleAdmin,
+	v1beta1.ExternalRoleOrgAdmin:  v1beta1.RoleOrgAdmin,
+	v1beta1.ExternalRoleOperator:  v1beta1.RoleOperator,
+	v1beta1.ExternalRoleViewer:    v1beta1.RoleViewer,
+	v1beta1.ExternalRoleInstaller: v1beta1.RoleInstaller,
+}
+
+// BuiltInRolePermissions returns the permissions of a built-in role, named by its internal or external
+// name, keyed by resource. It reports false if the role is not a built-in role.
+func BuiltInRolePermissions(role string) (map[string][]string, bool) {
+	if internalName, ok := externalRoleNames[role]; ok {
+		role = internalName
+	}
+	permissions, ok := resourcePermissions[role]
+	return permissions, ok
+}
+
+// SpecificResources returns the resources for which a built-in role has permissions of its own
+// rather than those of the wildcard "*". A permission on "*" may therefore not extend to them, e.g.
+// the viewer role can get all resources but imageexports/download.
+func SpecificResources() []string {
+	var resources []string
+	for _, permissions := range resourcePermissions {
+		for resource := range permissions {
+			if resource != "*" && !slices.Contains(resources, resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+func NewStaticAuthZ(log logrus.FieldLogger, opts ...StaticAuthZOption) *StaticAuthZ {
+	s := &StaticAuthZ{
 		log: log,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op string) (bool, error) {
@@ -90,7 +142,7 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 
 	// 3. Get user's roles for the selected organization only
 	roles := mappedIdentity.GetRolesForOrg(orgID)
-	if len(roles) == 0 {
+	if len(roles) == 0 && s.roleSource == nil {
 		s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 			mappedIdentity.GetUsername(), orgID)
 		return false, nil
@@ -98,37 +150,18 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 
 	// Check if any of the user's roles in this org grant the required permission
 	for _, role := range roles {
-		if permissions, exists := resourcePermissions[role]; exists {
-			// First check for specific resource - if it exists, it takes precedence over wildcard
-			// This allows explicit denials via empty permission lists
-			if resourcePerms, exists := permissions[resource]; exists {
-				// If the resource has an empty permission list, it's an explicit denial - skip to next role
-				if len(resourcePerms) == 0 {
-					s.log.Debugf("StaticAuthZ: permission explicitly denied for user=%s, role=%s, org=%s, resource=%s, op=%s",
-						mappedIdentity.GetUsername(), role, orgID, resource, op)
-					continue
-				}
-				for _, allowedOp := range resourcePerms {
-					if allowedOp == "*" || allowedOp == op {
-						s.log.Debugf("StaticAuthZ: permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
-							mappedIdentity.GetUsername(), role, orgID, resource, op)
-						return true, nil
-					}
-				}
-				// Specific resource entry exists but doesn't grant the operation - continue to check other roles
-				continue
-			}
+		if permissions, exists := resourcePermissions[role]; exists && permissionsAllow(permissions, resource, op) {
+			s.log.Debugf("StaticAuthZ: permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
+				mappedIdentity.GetUsername(), role, orgID, resource, op)
+			return true, nil
+		}
+	}
 
-			// No specific resource entry - check for wildcard resource (*) - gives access to all resources
-			if resourcePerms, exists := permissions["*"]; exists {
-				for _, allowedOp := range resourcePerms {
-					if allowedOp == "*" || allowedOp == op {
-						s.log.Debugf("StaticAuthZ: permission granted via wildcard resource for user=%s, role=%s, org=%s, resource=%s, op=%s",
-							mappedIdentity.GetUsername(), role, orgID, resource, op)
-						return true, nil
-					}
-				}
-			}
+	// Fall back to the custom roles of the organization
+	if s.roleSource != nil {
+		allowed, err := s.checkCustomPermission(ctx, orgUUID, mappedIdentity.GetUsername(), roles, resource, op)
+		if err != nil || allowed {
+			return allowed, err
 		}
 	}
 
@@ -163,7 +196,7 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 
 		// Get user's roles for the selected organization only
 		userRoles = mappedIdentity.GetRolesForOrg(orgID)
-		if len(userRoles) == 0 {
+		if len(userRoles) == 0 && s.roleSource == nil {
 			s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 				mappedIdentity.GetUsername(), orgID)
 			return &v1beta1.PermissionList{Permissions: []v1beta1.Permission{}}, nil
@@ -176,30 +209,17 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 	mergedPermissions := make(map[string][]string)
 	for _, role := range userRoles {
 		if permissions, exists := resourcePermissions[role]; exists {
-			for resource, ops := range permissions {
-				if existingOps, exists := mergedPermissions[resource]; exists {
-					// Merge operations, avoiding duplicates
-					opsMap := make(map[string]bool)
-					for _, op := range existingOps {
-						opsMap[op] = true
-					}
-					for _, op := range ops {
-						opsMap[op] = true
-					}
-					mergedOps := make([]string, 0, len(opsMap))
-					for op := range opsMap {
-						mergedOps = append(mergedOps, op)
-					}
-					mergedPermissions[resource] = mergedOps
-				} else {
-					// Copy operations slice to avoid sharing
-					opsCopy := make([]string, len(ops))
-					copy(opsCopy, ops)
-					mergedPermissions[resource] = opsCopy
-				}
-			}
+			mergePermissions(mergedPermissions, permissions)
 		}
 	}
+	if s.roleSource != nil && !mappedIdentity.IsSuperAdmin() {
+		orgUUID, _ := util.GetOrgIdFromContext(ctx)
+		permissions, err := s.customPermissions(ctx, orgUUID, mappedIdentity.GetUsername(), userRoles)
+		if err != nil {
+			return nil, err
+		}
+		mergePermissions(mergedPermissions, permissions)
+	}
 
 	// Convert to API format with sorted resources
 	resources := make([]string, 0, len(mergedPermissions))
@@ -225,3 +245,33 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 		Permissions: apiPermissions,
 	}, nil
 }
+
+// mergePermissions merges a set of permissions into the merged permissions, avoiding duplicate operations.
+func mergePermissions(merged map[string][]string, permissions map[string][]string) {
+	for resource, ops := range permissions {
+		if _, exists := merged[resource]; !exists {
+			merged[resource] = []string{}
+		}
+		for _, op := range ops {
+			if !slices.Contains(merged[resource], op) {
+				merged[resource] = append(merged[resource], op)
+			}
+		}
+	}
+}
+
+// permissionsAllow reports whether a set of permissions grants an operation on a resource.
+// A specific resource entry takes precedence over the wildcard "*", so an empty permission
+// list explicitly denies access to the resource.
+func permissionsAllow(permissions map[string][]string, resource string, op string) bool {
+	resourcePerms, exists := permissions[resource]
+	if !exists {
+		resourcePerms = permissions["*"]
+	}
+	for _, allowedOp := range resourcePerms {
+		if allowedOp == "*" || allowedOp == op {
+			return true
+		}
+	}
+	return false
+}
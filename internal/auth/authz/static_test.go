@@ -381,6 +381,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "resourcesyncs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "secretstores",
+					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
+				},
 			},
 		},
 		{
@@ -9,6 +9,7 @@ import (
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/auth/authz"
 	"github.com/flightctl/flightctl/internal/auth/common"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -139,6 +140,9 @@ func CreateAuthZMiddleware(authZ AuthZMiddleware, log logrus.FieldLogger) func(h
 
 			// Add HTTP request to context for authorization checks
 			ctx := context.WithValue(r.Context(), common.ContextKey("http_request"), r)
+			// Record the scope of permissions restricted to the resources matching label selectors
+			scope := &authz.Scope{}
+			ctx = authz.WithScope(ctx, scope)
 
 			log.Debugf("AuthZMiddleware: checking authorization for path=%s, method=%s, resource=%s, action=%s",
 				r.URL.Path, r.Method, resource, action)
@@ -153,8 +157,12 @@ func CreateAuthZMiddleware(authZ AuthZMiddleware, log logrus.FieldLogger) func(h
 			log.Debugf("AuthZMiddleware: authorization granted for path=%s, method=%s, resource=%s, action=%s",
 				r.URL.Path, r.Method, resource, action)
 
-			// If authorized, proceed to the next handler
-			next.ServeHTTP(w, r)
+			// If authorized, proceed to the next handler, restricted to the scope of the permission if any
+			reqCtx := authz.WithAuthorizer(r.Context(), authZ)
+			if len(scope.LabelSelectors) > 0 {
+				reqCtx = authz.WithScope(reqCtx, scope)
+			}
+			next.ServeHTTP(w, r.WithContext(reqCtx))
 		}
 		return http.HandlerFunc(fn)
 	}
@@ -305,6 +305,21 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 	case ResourceSyncKind:
 		response, err := c.ReplaceResourceSyncWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case SecretStoreKind:
+		response, err := c.ReplaceSecretStoreWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case EnrollmentApprovalPolicyKind:
+		response, err := c.ReplaceEnrollmentApprovalPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case RoleKind:
+		response, err := c.ReplaceRoleWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case RoleBindingKind:
+		response, err := c.ReplaceRoleBindingWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case ServiceAccountKind:
+		response, err := c.ReplaceServiceAccountWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	case CertificateSigningRequestKind:
 		response, err := c.ReplaceCertificateSigningRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
@@ -359,6 +374,16 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclient.ReplaceResourceSyncResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceSecretStoreResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceEnrollmentApprovalPolicyResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceRoleResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceRoleBindingResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclient.ReplaceServiceAccountResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclient.ReplaceCertificateSigningRequestResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclient.ReplaceAuthProviderResponse:
@@ -297,6 +297,60 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 					}
 				}
 			}
+		case SecretStoreKind:
+			resp, err := c.ListSecretStoresWithResponse(context.Background(), &api.ListSecretStoresParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
+		case EnrollmentApprovalPolicyKind:
+			resp, err := c.ListEnrollmentApprovalPoliciesWithResponse(context.Background(), &api.ListEnrollmentApprovalPoliciesParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, policy := range resp.JSON200.Items {
+					if policy.Metadata.Name != nil {
+						names = append(names, *policy.Metadata.Name)
+					}
+				}
+			}
+		case RoleKind:
+			resp, err := c.ListRolesWithResponse(context.Background(), &api.ListRolesParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, role := range resp.JSON200.Items {
+					if role.Metadata.Name != nil {
+						names = append(names, *role.Metadata.Name)
+					}
+				}
+			}
+		case RoleBindingKind:
+			resp, err := c.ListRoleBindingsWithResponse(context.Background(), &api.ListRoleBindingsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, binding := range resp.JSON200.Items {
+					if binding.Metadata.Name != nil {
+						names = append(names, *binding.Metadata.Name)
+					}
+				}
+			}
+		case ServiceAccountKind:
+			resp, err := c.ListServiceAccountsWithResponse(context.Background(), &api.ListServiceAccountsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, sa := range resp.JSON200.Items {
+					if sa.Metadata.Name != nil {
+						names = append(names, *sa.Metadata.Name)
+					}
+				}
+			}
+		case DeviceBulkOperationKind:
+			resp, err := c.ListDeviceBulkOperationsWithResponse(context.Background(), &api.ListDeviceBulkOperationsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, op := range resp.JSON200.Items {
+					if op.Metadata.Name != nil {
+						names = append(names, *op.Metadata.Name)
+					}
+				}
+			}
 		case TemplateVersionKind:
 			if kna.FleetName != nil {
 				resp, err := c.ListTemplateVersionsWithResponse(context.Background(), *kna.FleetName, &api.ListTemplateVersionsParams{})
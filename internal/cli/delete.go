@@ -269,6 +269,18 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.DeleteRepositoryWithResponse(ctx, name)
 	case ResourceSyncKind:
 		response, err = c.DeleteResourceSyncWithResponse(ctx, name)
+	case SecretStoreKind:
+		response, err = c.DeleteSecretStoreWithResponse(ctx, name)
+	case EnrollmentApprovalPolicyKind:
+		response, err = c.DeleteEnrollmentApprovalPolicyWithResponse(ctx, name)
+	case RoleKind:
+		response, err = c.DeleteRoleWithResponse(ctx, name)
+	case RoleBindingKind:
+		response, err = c.DeleteRoleBindingWithResponse(ctx, name)
+	case ServiceAccountKind:
+		response, err = c.DeleteServiceAccountWithResponse(ctx, name)
+	case DeviceBulkOperationKind:
+		response, err = c.DeleteDeviceBulkOperationWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		response, err = c.DeleteCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
@@ -3,6 +3,7 @@ package display
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -75,10 +76,24 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printRepositoriesTable(w, data.(*apiclient.ListRepositoriesResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.ResourceSyncKind):
 		return f.printResourceSyncsTable(w, data.(*apiclient.ListResourceSyncsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.SecretStoreKind):
+		return f.printSecretStoresTable(w, data.(*apiclient.ListSecretStoresResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.EnrollmentApprovalPolicyKind):
+		return f.printEnrollmentApprovalPoliciesTable(w, data.(*apiclient.ListEnrollmentApprovalPoliciesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.RoleKind):
+		return f.printRolesTable(w, data.(*apiclient.ListRolesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.RoleBindingKind):
+		return f.printRoleBindingsTable(w, data.(*apiclient.ListRoleBindingsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.ServiceAccountKind):
+		return f.printServiceAccountsTable(w, data.(*apiclient.ListServiceAccountsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.DeviceBulkOperationKind):
+		return f.printDeviceBulkOperationsTable(w, data.(*apiclient.ListDeviceBulkOperationsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.CertificateSigningRequestKind):
 		return f.printCSRTable(w, data.(*apiclient.ListCertificateSigningRequestsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.EventKind):
 		return f.printEventsTable(w, data.(*apiclient.ListEventsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.AuditEventKind):
+		return f.printAuditEventsTable(w, data.(*apiclient.ListAuditEventsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
 		return f.printAuthProvidersTable(w, data.(*apiclient.ListAuthProvidersResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, string(imagebuilderapi.ResourceKindImageBuild)):
@@ -137,6 +152,18 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printRepositoriesTable(w, *data.(*apiclient.GetRepositoryResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.ResourceSyncKind):
 		return f.printResourceSyncsTable(w, *data.(*apiclient.GetResourceSyncResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.SecretStoreKind):
+		return f.printSecretStoresTable(w, *data.(*apiclient.GetSecretStoreResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.EnrollmentApprovalPolicyKind):
+		return f.printEnrollmentApprovalPoliciesTable(w, *data.(*apiclient.GetEnrollmentApprovalPolicyResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.RoleKind):
+		return f.printRolesTable(w, *data.(*apiclient.GetRoleResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.RoleBindingKind):
+		return f.printRoleBindingsTable(w, *data.(*apiclient.GetRoleBindingResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.ServiceAccountKind):
+		return f.printServiceAccountsTable(w, *data.(*apiclient.GetServiceAccountResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.DeviceBulkOperationKind):
+		return f.printDeviceBulkOperationsTable(w, *data.(*apiclient.GetDeviceBulkOperationResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.CertificateSigningRequestKind):
 		return f.printCSRTable(w, *data.(*apiclient.GetCertificateSigningRequestResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
@@ -273,6 +300,9 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 		selector := NoneString
 		if fleet.Spec.Selector != nil {
 			selector = strings.Join(util.LabelMapToArray(fleet.Spec.Selector.MatchLabels), ",")
+			if fleet.Spec.FieldSelector != nil {
+				selector = strings.Join(append([]string{selector}, util.LabelMapToArray(&fleet.Spec.FieldSelector.MatchFields)...), ",")
+			}
 		}
 		valid := "Unknown"
 		numDevices := "Unknown"
@@ -349,6 +379,131 @@ func (f *TableFormatter) printRepositoriesTable(w *tabwriter.Writer, repos ...ap
 	return nil
 }
 
+func (f *TableFormatter) printSecretStoresTable(w *tabwriter.Writer, secretStores ...api.SecretStore) error {
+	f.printHeaderRowLn(w, "NAME", "ADDRESS", "AUTH METHOD", "ACCESSIBLE")
+	for _, s := range secretStores {
+		accessible := "Unknown"
+		if s.Status != nil {
+			condition := api.FindStatusCondition(s.Status.Conditions, api.ConditionTypeSecretStoreAccessible)
+			if condition != nil {
+				accessible = string(condition.Status)
+			}
+		}
+
+		authMethod, err := s.Spec.Auth.Discriminator()
+		if err != nil {
+			authMethod = "unknown"
+		}
+
+		f.printTableRowLn(w,
+			*s.Metadata.Name,
+			s.Spec.Address,
+			authMethod,
+			accessible,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printEnrollmentApprovalPoliciesTable(w *tabwriter.Writer, policies ...api.EnrollmentApprovalPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "MATCH", "LABELS")
+	for _, p := range policies {
+		match := []string{}
+		if p.Spec.Match.TpmAttested != nil && *p.Spec.Match.TpmAttested {
+			match = append(match, "tpmAttested")
+		}
+		if p.Spec.Match.EnrollmentCredentials != nil {
+			match = append(match, "enrollmentCredentials")
+		}
+		if p.Spec.Match.AllowList != nil {
+			match = append(match, "allowList")
+		}
+		if p.Spec.Match.LabelSelector != nil {
+			match = append(match, "labelSelector")
+		}
+
+		f.printTableRowLn(w,
+			*p.Metadata.Name,
+			strings.Join(match, ","),
+			strings.Join(util.LabelMapToArray(p.Spec.Labels), ","),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printRolesTable(w *tabwriter.Writer, roles ...api.Role) error {
+	f.printHeaderRowLn(w, "NAME", "RULES")
+	for _, r := range roles {
+		rules := make([]string, 0, len(r.Spec.Rules))
+		for _, rule := range r.Spec.Rules {
+			rules = append(rules, fmt.Sprintf("%s:%s", strings.Join(rule.Resources, ","), strings.Join(rule.Verbs, ",")))
+		}
+
+		f.printTableRowLn(w,
+			*r.Metadata.Name,
+			strings.Join(rules, " "),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printRoleBindingsTable(w *tabwriter.Writer, bindings ...api.RoleBinding) error {
+	f.printHeaderRowLn(w, "NAME", "ROLE", "SUBJECTS", "LABEL SELECTOR")
+	for _, b := range bindings {
+		subjects := make([]string, 0, len(b.Spec.Subjects))
+		for _, subject := range b.Spec.Subjects {
+			subjects = append(subjects, fmt.Sprintf("%s/%s", subject.Kind, subject.Name))
+		}
+
+		f.printTableRowLn(w,
+			*b.Metadata.Name,
+			b.Spec.RoleRef,
+			strings.Join(subjects, ","),
+			util.DefaultIfNil(b.Spec.LabelSelector, NoneString),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printServiceAccountsTable(w *tabwriter.Writer, serviceAccounts ...api.ServiceAccount) error {
+	f.printHeaderRowLn(w, "NAME", "ROLES", "DESCRIPTION")
+	for _, sa := range serviceAccounts {
+		f.printTableRowLn(w,
+			*sa.Metadata.Name,
+			strings.Join(sa.Spec.Roles, ","),
+			util.DefaultIfNil(sa.Spec.Description, NoneString),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printDeviceBulkOperationsTable(w *tabwriter.Writer, operations ...api.DeviceBulkOperation) error {
+	f.printHeaderRowLn(w, "NAME", "OPERATION", "PHASE", "MATCHED", "PROCESSED", "FAILED", "AGE")
+	for _, op := range operations {
+		phase, matched, processed, failed := "Unknown", "0", "0", "0"
+		if op.Status != nil {
+			phase = string(op.Status.Phase)
+			matched = strconv.FormatInt(op.Status.MatchedDevices, 10)
+			processed = strconv.FormatInt(op.Status.ProcessedDevices, 10)
+			failed = strconv.FormatInt(op.Status.FailedDevices, 10)
+		}
+		age := NoneString
+		if op.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*op.Metadata.CreationTimestamp)
+		}
+		f.printTableRowLn(w,
+			util.DefaultIfNil(op.Metadata.Name, NoneString),
+			string(op.Spec.Operation),
+			phase,
+			matched,
+			processed,
+			failed,
+			age,
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printResourceSyncsTable(w *tabwriter.Writer, resourcesyncs ...api.ResourceSync) error {
 	f.printHeaderRowLn(w, "NAME", "REPOSITORY", "PATH", "REVISION", "ACCESSIBLE", "SYNCED", "LAST SYNC")
 
@@ -430,6 +585,22 @@ func (f *TableFormatter) printEventsTable(w *tabwriter.Writer, events ...api.Eve
 	return nil
 }
 
+func (f *TableFormatter) printAuditEventsTable(w *tabwriter.Writer, events ...api.AuditEvent) error {
+	f.printHeaderRowLn(w, "AGE", "ACTOR", "VERB", "RESOURCE", "NAME", "OUTCOME", "SUMMARY")
+	for _, e := range events {
+		f.printTableRowLn(w,
+			humanize.Time(*e.Metadata.CreationTimestamp),
+			e.Actor,
+			e.Verb,
+			e.Resource,
+			util.DefaultIfNil(e.ResourceName, NoneString),
+			string(e.Outcome),
+			util.DefaultIfNil(e.Summary, NoneString),
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printAuthConfigProvidersTable(w *tabwriter.Writer, authConfig *api.AuthConfig) error {
 	if authConfig == nil {
 		return fmt.Errorf("auth config is nil")
@@ -42,6 +42,11 @@ func getValidEditResourceKinds() []ResourceKind {
 		DeviceKind,
 		FleetKind,
 		RepositoryKind,
+		SecretStoreKind,
+		EnrollmentApprovalPolicyKind,
+		RoleKind,
+		RoleBindingKind,
+		ServiceAccountKind,
 		CertificateSigningRequestKind,
 	}
 }
@@ -146,7 +151,7 @@ func (o *EditOptions) Validate(args []string) error {
 
 	// Check if resource type supports editing
 	switch kind {
-	case DeviceKind, FleetKind, RepositoryKind, CertificateSigningRequestKind, AuthProviderKind:
+	case DeviceKind, FleetKind, RepositoryKind, SecretStoreKind, EnrollmentApprovalPolicyKind, RoleKind, RoleBindingKind, ServiceAccountKind, CertificateSigningRequestKind, AuthProviderKind:
 		// These are supported for editing
 	default:
 		return errEditNotAllowed{kind}
@@ -484,6 +489,21 @@ func (o *EditOptions) executePatchOperation(ctx context.Context, client *apiclie
 	case RepositoryKind:
 		response, err := client.PatchRepositoryWithBodyWithResponse(ctx, name, contentType, reader)
 		return o.extractResponseData(response, err)
+	case SecretStoreKind:
+		response, err := client.PatchSecretStoreWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
+	case EnrollmentApprovalPolicyKind:
+		response, err := client.PatchEnrollmentApprovalPolicyWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
+	case RoleKind:
+		response, err := client.PatchRoleWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
+	case RoleBindingKind:
+		response, err := client.PatchRoleBindingWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
+	case ServiceAccountKind:
+		response, err := client.PatchServiceAccountWithBodyWithResponse(ctx, name, contentType, reader)
+		return o.extractResponseData(response, err)
 	case CertificateSigningRequestKind:
 		response, err := client.PatchCertificateSigningRequestWithBodyWithResponse(ctx, name, contentType, reader)
 		return o.extractResponseData(response, err)
@@ -339,6 +339,8 @@ func (o *GetOptions) validateSingleResourceRestrictions(kind ResourceKind, names
 	switch kind {
 	case EventKind:
 		return fmt.Errorf("you cannot get individual events")
+	case AuditEventKind:
+		return fmt.Errorf("you cannot get individual audit events")
 	case OrganizationKind:
 		return fmt.Errorf("you cannot get individual organizations")
 	default:
@@ -740,6 +742,54 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.ListResourceSyncsWithResponse(ctx, &params)
+	case SecretStoreKind:
+		params := api.ListSecretStoresParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListSecretStoresWithResponse(ctx, &params)
+	case EnrollmentApprovalPolicyKind:
+		params := api.ListEnrollmentApprovalPoliciesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListEnrollmentApprovalPoliciesWithResponse(ctx, &params)
+	case RoleKind:
+		params := api.ListRolesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListRolesWithResponse(ctx, &params)
+	case RoleBindingKind:
+		params := api.ListRoleBindingsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListRoleBindingsWithResponse(ctx, &params)
+	case ServiceAccountKind:
+		params := api.ListServiceAccountsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListServiceAccountsWithResponse(ctx, &params)
+	case DeviceBulkOperationKind:
+		params := api.ListDeviceBulkOperationsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListDeviceBulkOperationsWithResponse(ctx, &params)
 	case CertificateSigningRequestKind:
 		params := api.ListCertificateSigningRequestsParams{
 			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
@@ -755,6 +805,13 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.ListEventsWithResponse(ctx, &params)
+	case AuditEventKind:
+		params := api.ListAuditEventsParams{
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListAuditEventsWithResponse(ctx, &params)
 	case AuthProviderKind:
 		params := api.ListAuthProvidersParams{
 			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
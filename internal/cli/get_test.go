@@ -496,6 +496,12 @@ func TestGetOptionsValidation(t *testing.T) {
 			expectError:   true,
 			errorContains: "you cannot get individual events",
 		},
+		{
+			name:          "get_individual_auditevent",
+			args:          []string{"auditevent", "test1"},
+			expectError:   true,
+			errorContains: "you cannot get individual audit events",
+		},
 		{
 			name:        "list_events_ok",
 			args:        []string{"events"},
@@ -24,10 +24,13 @@ type ResourceKind string
 
 const (
 	InvalidKind                   ResourceKind = ""
+	AuditEventKind                ResourceKind = "auditevent"
 	CatalogKind                   ResourceKind = "catalog"
 	CatalogItemKind               ResourceKind = "catalogitem"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
 	DeviceKind                    ResourceKind = "device"
+	DeviceBulkOperationKind       ResourceKind = "devicebulkoperation"
+	EnrollmentApprovalPolicyKind  ResourceKind = "enrollmentapprovalpolicy"
 	EnrollmentRequestKind         ResourceKind = "enrollmentrequest"
 	EventKind                     ResourceKind = "event"
 	AuthProviderKind              ResourceKind = "authprovider"
@@ -37,6 +40,10 @@ const (
 	OrganizationKind              ResourceKind = "organization"
 	RepositoryKind                ResourceKind = "repository"
 	ResourceSyncKind              ResourceKind = "resourcesync"
+	RoleKind                      ResourceKind = "role"
+	RoleBindingKind               ResourceKind = "rolebinding"
+	SecretStoreKind               ResourceKind = "secretstore"
+	ServiceAccountKind            ResourceKind = "serviceaccount"
 	TemplateVersionKind           ResourceKind = "templateversion"
 )
 
@@ -64,10 +71,13 @@ func ResourceKindFromString(kindLike string) (ResourceKind, error) {
 
 var (
 	resourceKindSet = map[ResourceKind]struct{}{
+		AuditEventKind:                {},
 		CatalogKind:                   {},
 		CatalogItemKind:               {},
 		CertificateSigningRequestKind: {},
 		DeviceKind:                    {},
+		DeviceBulkOperationKind:       {},
+		EnrollmentApprovalPolicyKind:  {},
 		EnrollmentRequestKind:         {},
 		EventKind:                     {},
 		AuthProviderKind:              {},
@@ -77,16 +87,23 @@ var (
 		OrganizationKind:              {},
 		RepositoryKind:                {},
 		ResourceSyncKind:              {},
+		RoleKind:                      {},
+		RoleBindingKind:               {},
+		SecretStoreKind:               {},
+		ServiceAccountKind:            {},
 		TemplateVersionKind:           {},
 	}
 
 	validResourceKinds = slices.Collect(maps.Keys(resourceKindSet))
 
 	pluralToKind = map[string]ResourceKind{
+		"auditevents":                AuditEventKind,
 		"catalogs":                   CatalogKind,
 		"catalogitems":               CatalogItemKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
 		"devices":                    DeviceKind,
+		"devicebulkoperations":       DeviceBulkOperationKind,
+		"enrollmentapprovalpolicies": EnrollmentApprovalPolicyKind,
 		"enrollmentrequests":         EnrollmentRequestKind,
 		"events":                     EventKind,
 		"authproviders":              AuthProviderKind,
@@ -96,14 +113,21 @@ var (
 		"organizations":              OrganizationKind,
 		"repositories":               RepositoryKind,
 		"resourcesyncs":              ResourceSyncKind,
+		"roles":                      RoleKind,
+		"rolebindings":               RoleBindingKind,
+		"secretstores":               SecretStoreKind,
+		"serviceaccounts":            ServiceAccountKind,
 		"templateversions":           TemplateVersionKind,
 	}
 
 	kindToPlural = map[ResourceKind]string{
+		AuditEventKind:                "auditevents",
 		CatalogKind:                   "catalogs",
 		CatalogItemKind:               "catalogitems",
 		CertificateSigningRequestKind: "certificatesigningrequests",
 		DeviceKind:                    "devices",
+		DeviceBulkOperationKind:       "devicebulkoperations",
+		EnrollmentApprovalPolicyKind:  "enrollmentapprovalpolicies",
 		EnrollmentRequestKind:         "enrollmentrequests",
 		EventKind:                     "events",
 		AuthProviderKind:              "authproviders",
@@ -113,6 +137,10 @@ var (
 		OrganizationKind:              "organizations",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
+		RoleKind:                      "roles",
+		RoleBindingKind:               "rolebindings",
+		SecretStoreKind:               "secretstores",
+		ServiceAccountKind:            "serviceaccounts",
 		TemplateVersionKind:           "templateversions",
 	}
 
@@ -121,15 +149,21 @@ var (
 		"ci":   CatalogItemKind,
 		"csr":  CertificateSigningRequestKind,
 		"dev":  DeviceKind,
+		"dbo":  DeviceBulkOperationKind,
+		"eap":  EnrollmentApprovalPolicyKind,
 		"er":   EnrollmentRequestKind,
 		"ev":   EventKind,
 		"ap":   AuthProviderKind,
+		"ae":   AuditEventKind,
 		"flt":  FleetKind,
 		"ib":   ImageBuildKind,
 		"ie":   ImageExportKind,
 		"org":  OrganizationKind,
 		"repo": RepositoryKind,
+		"rb":   RoleBindingKind,
 		"rs":   ResourceSyncKind,
+		"ss":   SecretStoreKind,
+		"sa":   ServiceAccountKind,
 		"tv":   TemplateVersionKind,
 	}
 )
@@ -327,6 +361,18 @@ func GetSingleResource(ctx context.Context, c *client.Client, kind ResourceKind,
 		return c.GetRepositoryWithResponse(ctx, name)
 	case ResourceSyncKind:
 		return c.GetResourceSyncWithResponse(ctx, name)
+	case SecretStoreKind:
+		return c.GetSecretStoreWithResponse(ctx, name)
+	case EnrollmentApprovalPolicyKind:
+		return c.GetEnrollmentApprovalPolicyWithResponse(ctx, name)
+	case RoleKind:
+		return c.GetRoleWithResponse(ctx, name)
+	case RoleBindingKind:
+		return c.GetRoleBindingWithResponse(ctx, name)
+	case ServiceAccountKind:
+		return c.GetServiceAccountWithResponse(ctx, name)
+	case DeviceBulkOperationKind:
+		return c.GetDeviceBulkOperationWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		return c.GetCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type LabelOptions struct {
+	GlobalOptions
+	LabelSelector string
+	FieldSelector string
+	DryRun        bool
+}
+
+func DefaultLabelOptions() *LabelOptions {
+	return &LabelOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		LabelSelector: "",
+		FieldSelector: "",
+		DryRun:        false,
+	}
+}
+
+func NewCmdLabel() *cobra.Command {
+	o := DefaultLabelOptions()
+	cmd := &cobra.Command{
+		Use:   "label devices KEY=VALUE ... KEY- ... (-l SELECTOR | --field-selector SELECTOR)",
+		Short: "Update the labels of the devices matching a selector.",
+		Long: `Update the labels of the devices matching a selector.
+
+A KEY=VALUE argument sets a label and a KEY- argument removes it. The labels are updated
+asynchronously by a device bulk operation, whose progress can be followed with
+'flightctl get devicebulkoperation NAME'.`,
+		Example: `  # Set the "team" label on the devices of a site
+  flightctl label devices -l site=factory-1 team=edge
+
+  # Set a label and remove another one
+  flightctl label devices -l site=factory-1 tier=canary stale-
+
+  # Count the devices that would be labeled without changing them
+  flightctl label devices -l site=factory-1 team=edge --dry-run`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *LabelOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, supporting operators like '=', '!=', and 'in' (e.g., -l='key1=value1,key2!=value2,key3 in (value3, value4)').")
+	fs.StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supporting operators like '=', '==', and '!=' (e.g., --field-selector='key1=value1,key2!=value2').")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only print the number of devices matching the selectors, without updating them.")
+}
+
+func (o *LabelOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *LabelOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return validateLabelArgs(o, args)
+}
+
+func validateLabelArgs(o *LabelOptions, args []string) error {
+	kind, err := ResourceKindFromString(args[0])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("kind must be Device")
+	}
+	if o.LabelSelector == "" && o.FieldSelector == "" {
+		return fmt.Errorf("at least one selector is required. Use --selector/-l or --field-selector")
+	}
+	_, _, err = parseLabelChanges(args[1:])
+	return err
+}
+
+// parseLabelChanges parses KEY=VALUE arguments into labels to set and KEY- arguments into labels to remove.
+func parseLabelChanges(args []string) (map[string]string, []string, error) {
+	setLabels := map[string]string{}
+	removeLabels := []string{}
+	for _, arg := range args {
+		if key, value, found := strings.Cut(arg, "="); found {
+			if key == "" {
+				return nil, nil, fmt.Errorf("invalid label %q: key must not be empty", arg)
+			}
+			setLabels[key] = value
+			continue
+		}
+		if key, found := strings.CutSuffix(arg, "-"); found && key != "" {
+			removeLabels = append(removeLabels, key)
+			continue
+		}
+		return nil, nil, fmt.Errorf("invalid label %q: expected KEY=VALUE or KEY-", arg)
+	}
+	for _, key := range removeLabels {
+		if _, ok := setLabels[key]; ok {
+			return nil, nil, fmt.Errorf("label %q cannot be both set and removed", key)
+		}
+	}
+	return setLabels, removeLabels, nil
+}
+
+func (o *LabelOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	setLabels, removeLabels, err := parseLabelChanges(args[1:])
+	if err != nil {
+		return err
+	}
+	update := api.DeviceBulkMetadataUpdate{}
+	if len(setLabels) > 0 {
+		update.SetLabels = &setLabels
+	}
+	if len(removeLabels) > 0 {
+		update.RemoveLabels = &removeLabels
+	}
+
+	operation := api.DeviceBulkOperation{
+		ApiVersion: api.DeviceBulkOperationAPIVersion,
+		Kind:       api.DeviceBulkOperationKind,
+		Spec: api.DeviceBulkOperationSpec{
+			LabelSelector: lo.EmptyableToPtr(o.LabelSelector),
+			FieldSelector: lo.EmptyableToPtr(o.FieldSelector),
+			Operation:     api.DeviceBulkOperationTypeUpdateMetadata,
+			Metadata:      &update,
+		},
+	}
+
+	params := &api.CreateDeviceBulkOperationParams{DryRun: lo.ToPtr(o.DryRun)}
+	response, err := c.CreateDeviceBulkOperationWithResponse(ctx, params, operation)
+	if err != nil {
+		return fmt.Errorf("labeling devices: %w", err)
+	}
+
+	if o.DryRun {
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+			return fmt.Errorf("labeling devices: %w", err)
+		}
+		if response.JSON200 == nil || response.JSON200.Status == nil {
+			return fmt.Errorf("labeling devices: empty response")
+		}
+		fmt.Printf("%d devices would be labeled (dry run)\n", response.JSON200.Status.MatchedDevices)
+		return nil
+	}
+
+	if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusCreated); err != nil {
+		return fmt.Errorf("labeling devices: %w", err)
+	}
+	if response.JSON201 == nil || response.JSON201.Status == nil {
+		return fmt.Errorf("labeling devices: empty response")
+	}
+	created := response.JSON201
+	fmt.Printf("%s/%s created for %d devices\n", DeviceBulkOperationKind, lo.FromPtr(created.Metadata.Name), created.Status.MatchedDevices)
+	fmt.Fprintf(os.Stderr, "Follow its progress with 'flightctl get %s/%s'.\n", DeviceBulkOperationKind, lo.FromPtr(created.Metadata.Name))
+	return nil
+}
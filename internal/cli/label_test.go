@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		opts          LabelOptions
+		errorContains string
+	}{
+		{
+			name: "valid set and remove",
+			args: []string{"devices", "team=edge", "stale-"},
+			opts: LabelOptions{LabelSelector: "site=factory-1"},
+		},
+		{
+			name: "valid with field selector (singular form)",
+			args: []string{"device", "team="},
+			opts: LabelOptions{FieldSelector: "metadata.name=dev1"},
+		},
+		{
+			name:          "invalid kind",
+			args:          []string{"fleets", "team=edge"},
+			opts:          LabelOptions{LabelSelector: "site=factory-1"},
+			errorContains: "kind must be Device",
+		},
+		{
+			name:          "missing selector",
+			args:          []string{"devices", "team=edge"},
+			errorContains: "at least one selector is required",
+		},
+		{
+			name:          "invalid label argument",
+			args:          []string{"devices", "team"},
+			opts:          LabelOptions{LabelSelector: "site=factory-1"},
+			errorContains: "expected KEY=VALUE or KEY-",
+		},
+		{
+			name:          "empty key",
+			args:          []string{"devices", "=edge"},
+			opts:          LabelOptions{LabelSelector: "site=factory-1"},
+			errorContains: "key must not be empty",
+		},
+		{
+			name:          "label set and removed",
+			args:          []string{"devices", "team=edge", "team-"},
+			opts:          LabelOptions{LabelSelector: "site=factory-1"},
+			errorContains: "cannot be both set and removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test the validation logic directly without calling GlobalOptions.Validate
+			err := validateLabelArgs(&tt.opts, tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
+
+func TestParseLabelChanges(t *testing.T) {
+	setLabels, removeLabels, err := parseLabelChanges([]string{"team=edge", "tier=a=b", "stale-"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "edge", "tier": "a=b"}, setLabels)
+	require.Equal(t, []string{"stale"}, removeLabels)
+}
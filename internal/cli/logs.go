@@ -17,7 +17,8 @@ import (
 
 type LogsOptions struct {
 	GlobalOptions
-	Follow bool
+	Follow       bool
+	Architecture string
 }
 
 func DefaultLogsOptions() *LogsOptions {
@@ -39,6 +40,9 @@ func NewCmdLogs() *cobra.Command {
   # Follow logs for an active imagebuild
   flightctl logs imagebuild/my-build -f
 
+  # Get the arm64 logs of a multi-architecture imagebuild
+  flightctl logs imagebuild/my-build --architecture arm64
+
   # Get logs for an imageexport
   flightctl logs imageexport/my-export
 
@@ -65,6 +69,7 @@ func NewCmdLogs() *cobra.Command {
 func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Specify if the logs should be streamed. Follows the logs until the build completes or the command is interrupted.")
+	fs.StringVar(&o.Architecture, "architecture", o.Architecture, "Only print the logs of the given architecture of a multi-architecture imagebuild.")
 }
 
 func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -91,6 +96,15 @@ func (o *LogsOptions) Validate(args []string) error {
 		return fmt.Errorf("resource name is required")
 	}
 
+	if o.Architecture != "" {
+		if kind != ImageBuildKind {
+			return fmt.Errorf("--architecture is only supported for imagebuild resources")
+		}
+		if !imagebuilderapi.ImageBuildArchitecture(o.Architecture).IsValid() {
+			return fmt.Errorf("unsupported architecture %q", o.Architecture)
+		}
+	}
+
 	return nil
 }
 
@@ -119,6 +133,10 @@ func (o *LogsOptions) Run(ctx context.Context, args []string) error {
 	switch kind {
 	case ImageBuildKind:
 		params := &imagebuilderapi.GetImageBuildLogParams{Follow: follow}
+		if o.Architecture != "" {
+			arch := imagebuilderapi.ImageBuildArchitecture(o.Architecture)
+			params.Architecture = &arch
+		}
 		resp, err = ibClient.GetImageBuildLog(ctx, name, params)
 	case ImageExportKind:
 		params := &imagebuilderapi.GetImageExportLogParams{Follow: follow}
@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	tokenActionCreate = "create"
+	tokenActionList   = "list"
+	tokenActionRevoke = "revoke"
+)
+
+var tokenExpirationRegexp = regexp.MustCompile(`^\d+d$`)
+
+type TokenOptions struct {
+	GlobalOptions
+	Name       string
+	Expiration string
+	Roles      []string
+}
+
+func DefaultTokenOptions() *TokenOptions {
+	return &TokenOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Name:          "",
+		Expiration:    "",
+		Roles:         []string{},
+	}
+}
+
+func NewCmdToken() *cobra.Command {
+	o := DefaultTokenOptions()
+	cmd := &cobra.Command{
+		Use:   "token (create SERVICEACCOUNT | list SERVICEACCOUNT | revoke SERVICEACCOUNT TOKEN)",
+		Short: "Manage the API tokens of a service account.",
+		Long:  "Create, list, and revoke the API tokens a service account authenticates with. The secret token is only printed when it is created.",
+		Example: `  # Create a token for the "ci" service account, valid for 30 days
+  flightctl token create ci --name pipeline --expiration 30d
+
+  # Create a token restricted to some of the roles of the service account
+  flightctl token create ci --role flightctl-viewer
+
+  # List the tokens of the "ci" service account
+  flightctl token list ci
+
+  # Revoke a token
+  flightctl token revoke ci pipeline`,
+		Args:      cobra.RangeArgs(2, 3),
+		ValidArgs: []string{tokenActionCreate, tokenActionList, tokenActionRevoke},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *TokenOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVarP(&o.Name, "name", "n", o.Name, "Specify a name for the created token. A name is generated if not specified.")
+	fs.StringVarP(&o.Expiration, "expiration", "x", o.Expiration, "Specify the lifetime of the created token in days, example: 30d. Defaults to 90d, and cannot exceed 365d.")
+	fs.StringArrayVarP(&o.Roles, "role", "r", o.Roles, "Restrict the created token to a role of the service account. Can be specified multiple times. Defaults to all the roles of the service account.")
+}
+
+func (o *TokenOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *TokenOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return validateTokenArgs(o, args)
+}
+
+func validateTokenArgs(o *TokenOptions, args []string) error {
+	switch args[0] {
+	case tokenActionCreate, tokenActionList:
+		if len(args) != 2 {
+			return fmt.Errorf("'token %s' expects exactly one service account name", args[0])
+		}
+	case tokenActionRevoke:
+		if len(args) != 3 {
+			return fmt.Errorf("'token revoke' expects a service account name and a token name")
+		}
+	default:
+		return fmt.Errorf("unknown token action %q, must be one of: %s", args[0], strings.Join([]string{tokenActionCreate, tokenActionList, tokenActionRevoke}, ", "))
+	}
+
+	if args[0] != tokenActionCreate && (o.Name != "" || o.Expiration != "" || len(o.Roles) > 0) {
+		return fmt.Errorf("--name, --expiration, and --role can only be used with 'token create'")
+	}
+	if o.Expiration != "" && !tokenExpirationRegexp.MatchString(o.Expiration) {
+		return fmt.Errorf("invalid expiration %q, must be a number of days, example: 30d", o.Expiration)
+	}
+	return nil
+}
+
+func (o *TokenOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	serviceAccount := args[1]
+	switch args[0] {
+	case tokenActionCreate:
+		token := api.ServiceAccountToken{
+			ApiVersion: api.ServiceAccountTokenAPIVersion,
+			Kind:       api.ServiceAccountTokenKind,
+		}
+		if o.Name != "" {
+			token.Metadata.Name = lo.ToPtr(o.Name)
+		}
+		if o.Expiration != "" {
+			days, err := strconv.Atoi(strings.TrimSuffix(o.Expiration, "d"))
+			if err != nil {
+				return fmt.Errorf("invalid expiration %q: %w", o.Expiration, err)
+			}
+			token.Spec.ExpirationTimestamp = lo.ToPtr(time.Now().Add(time.Duration(days) * 24 * time.Hour))
+		}
+		if len(o.Roles) > 0 {
+			token.Spec.Roles = lo.ToPtr(o.Roles)
+		}
+
+		response, err := c.CreateServiceAccountTokenWithResponse(ctx, serviceAccount, token)
+		if err != nil {
+			return fmt.Errorf("creating token for service account %s: %w", serviceAccount, err)
+		}
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusCreated); err != nil {
+			return fmt.Errorf("creating token for service account %s: %w", serviceAccount, err)
+		}
+		if response.JSON201 == nil || response.JSON201.Token == nil {
+			return fmt.Errorf("creating token for service account %s: empty response", serviceAccount)
+		}
+
+		created := response.JSON201
+		fmt.Fprintf(os.Stderr, "Created token %s for service account %s, expiring %s.\n",
+			lo.FromPtr(created.Metadata.Name), serviceAccount, lo.FromPtr(created.Spec.ExpirationTimestamp).Format(time.RFC3339))
+		fmt.Fprintln(os.Stderr, "Store the token securely, it cannot be retrieved again.")
+		fmt.Println(*created.Token)
+		return nil
+
+	case tokenActionList:
+		response, err := c.ListServiceAccountTokensWithResponse(ctx, serviceAccount)
+		if err != nil {
+			return fmt.Errorf("listing tokens of service account %s: %w", serviceAccount, err)
+		}
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+			return fmt.Errorf("listing tokens of service account %s: %w", serviceAccount, err)
+		}
+		if response.JSON200 == nil {
+			return fmt.Errorf("listing tokens of service account %s: empty response", serviceAccount)
+		}
+		return printTokensTable(response.JSON200.Items)
+
+	case tokenActionRevoke:
+		name := args[2]
+		response, err := c.DeleteServiceAccountTokenWithResponse(ctx, serviceAccount, name)
+		if err != nil {
+			return fmt.Errorf("revoking token %s of service account %s: %w", name, serviceAccount, err)
+		}
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+			return fmt.Errorf("revoking token %s of service account %s: %w", name, serviceAccount, err)
+		}
+		fmt.Printf("Revoked token %s of service account %s\n", name, serviceAccount)
+		return nil
+	}
+	return nil
+}
+
+func printTokensTable(tokens []api.ServiceAccountToken) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tROLES\tEXPIRES\tLAST USED")
+	for _, t := range tokens {
+		roles := "<all>"
+		if t.Spec.Roles != nil {
+			roles = strings.Join(*t.Spec.Roles, ",")
+		}
+		lastUsed := "<never>"
+		if t.Status != nil && t.Status.LastUsedTimestamp != nil {
+			lastUsed = humanize.Time(*t.Status.LastUsedTimestamp)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			lo.FromPtr(t.Metadata.Name),
+			roles,
+			lo.FromPtr(t.Spec.ExpirationTimestamp).Format(time.RFC3339),
+			lastUsed,
+		)
+	}
+	return w.Flush()
+}
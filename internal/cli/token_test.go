@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		opts          TokenOptions
+		errorContains string
+	}{
+		{
+			name: "valid create",
+			args: []string{"create", "ci"},
+			opts: TokenOptions{Name: "pipeline", Expiration: "30d", Roles: []string{"flightctl-viewer"}},
+		},
+		{
+			name: "valid list",
+			args: []string{"list", "ci"},
+		},
+		{
+			name: "valid revoke",
+			args: []string{"revoke", "ci", "pipeline"},
+		},
+		{
+			name:          "unknown action",
+			args:          []string{"rotate", "ci"},
+			errorContains: "unknown token action",
+		},
+		{
+			name:          "revoke without token name",
+			args:          []string{"revoke", "ci"},
+			errorContains: "expects a service account name and a token name",
+		},
+		{
+			name:          "create with token name argument",
+			args:          []string{"create", "ci", "pipeline"},
+			errorContains: "expects exactly one service account name",
+		},
+		{
+			name:          "invalid expiration",
+			args:          []string{"create", "ci"},
+			opts:          TokenOptions{Expiration: "30h"},
+			errorContains: "invalid expiration",
+		},
+		{
+			name:          "create flags with list",
+			args:          []string{"list", "ci"},
+			opts:          TokenOptions{Roles: []string{"flightctl-viewer"}},
+			errorContains: "can only be used with 'token create'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test the validation logic directly without calling GlobalOptions.Validate
+			err := validateTokenArgs(&tt.opts, tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errorContains)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package ca
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/flightctl/flightctl/internal/domain"
 )
 
@@ -9,8 +12,38 @@ type CAIdType int
 const (
 	InternalCA CAIdType = iota + 1
 	AsyncInternalCA
+	// PKCS11CA signs with a CA key held in an HSM accessed through a PKCS#11 module.
+	PKCS11CA
+	// RemoteCA delegates the signing to a remote CA exposing the step-ca sign API.
+	RemoteCA
 )
 
+var caIdTypeNames = map[string]CAIdType{
+	"internal":      InternalCA,
+	"asyncInternal": AsyncInternalCA,
+	"pkcs11":        PKCS11CA,
+	"remote":        RemoteCA,
+}
+
+// UnmarshalJSON accepts the CA type either as its number or as its name.
+func (t *CAIdType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var id int
+		if err := json.Unmarshal(data, &id); err != nil {
+			return fmt.Errorf("invalid CA type %s", string(data))
+		}
+		*t = CAIdType(id)
+		return nil
+	}
+	id, ok := caIdTypeNames[name]
+	if !ok {
+		return fmt.Errorf("unknown CA type %q", name)
+	}
+	*t = id
+	return nil
+}
+
 type InternalCfg struct {
 	CertFile         string `json:"certFile,omitempty"`
 	KeyFile          string `json:"keyFile,omitempty"`
@@ -19,24 +52,88 @@ type InternalCfg struct {
 	SerialFile       string `json:"serialFile,omitempty"`
 	CertValidityDays int    `json:"certValidityDays,omitempty"`
 	CertStore        string `json:"certStore,omitempty"`
+	// IntermediateOnly is set when the CA is an intermediate CA issued by a root kept offline.
+	// The CA is then never generated, and its certificate must chain to a root of CABundleFile.
+	IntermediateOnly bool `json:"intermediateOnly,omitempty"`
+}
+
+// PKCS11Cfg configures a CA whose private key is held in an HSM.
+type PKCS11Cfg struct {
+	// ModulePath is the path of the PKCS#11 module of the HSM, e.g. /usr/lib64/pkcs11/libsofthsm2.so.
+	ModulePath string `json:"modulePath,omitempty"`
+	// TokenLabel selects the token holding the CA key.
+	TokenLabel string `json:"tokenLabel,omitempty"`
+	// PinFile is the path of the file containing the PIN of the token user.
+	PinFile string `json:"pinFile,omitempty"`
+	// KeyLabel is the label of the CA key pair in the token.
+	KeyLabel string `json:"keyLabel,omitempty"`
+	// CertFile is the path of the PEM encoded certificate of the CA key, optionally followed by
+	// the certificates of its issuers.
+	CertFile string `json:"certFile,omitempty"`
+}
+
+// RemoteCfg configures a remote CA exposing the step-ca sign API. The certificates are
+// requested with one-time tokens signed by a JWK provisioner of the CA.
+type RemoteCfg struct {
+	// URL is the base URL of the CA, e.g. https://ca.example.com:9000.
+	URL string `json:"url,omitempty"`
+	// CABundleFile is the path of the PEM encoded certificates of the CA, starting with the CA
+	// issuing the certificates. They also authenticate the TLS connections to the CA.
+	CABundleFile string `json:"caBundleFile,omitempty"`
+	// ProvisionerName is the name of the JWK provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+	// ProvisionerKeyID is the key ID of the JWK provisioner.
+	ProvisionerKeyID string `json:"provisionerKeyID,omitempty"`
+	// ProvisionerKeyFile is the path of the PEM encoded private key of the JWK provisioner.
+	ProvisionerKeyFile string `json:"provisionerKeyFile,omitempty"`
+	// TimeoutSeconds bounds the duration of each request to the CA.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// RevocationCfg configures the enforcement and publication of revoked certificates.
+type RevocationCfg struct {
+	// RefreshIntervalSeconds is how often the revoked certificates are reloaded from the database,
+	// in addition to the notifications sent between the service instances on each revocation.
+	RefreshIntervalSeconds int `json:"refreshIntervalSeconds,omitempty"`
+	// ValidityMinutes is how long the published CRL and OCSP responses are valid, after which
+	// relying parties must fetch them again.
+	ValidityMinutes int `json:"validityMinutes,omitempty"`
+}
+
+// DeviceWorkloadCfg restricts the certificates issued to the applications running on the devices.
+type DeviceWorkloadCfg struct {
+	// AllowedDNSSuffixes restricts the DNS names of the certificates to these domains and their
+	// subdomains, e.g. apps.example.com. Any DNS name other than the reserved ones is allowed if empty.
+	AllowedDNSSuffixes []string `json:"allowedDNSSuffixes,omitempty"`
+	// ReservedNames are the hostnames and IP addresses of the service, which the certificates must
+	// never be issued for. They are derived from the service configuration.
+	ReservedNames []string `json:"-"`
 }
 
 type Config struct {
-	CAType                            CAIdType     `json:"type,omitempty"`
-	AdminCommonName                   string       `json:"adminCommonName,omitempty"`
-	ClientBootstrapCommonName         string       `json:"clientBootstrapCommonName,omitempty"`
-	ClientBootstrapCertName           string       `json:"clientBootstrapCertName,omitempty"`
-	DeviceEnrollmentSignerName        string       `json:"deviceEnrollmentSignerName,omitempty"`
-	ClientBootstrapCommonNamePrefix   string       `json:"clientBootstrapCommonNamePrefix,omitempty"`
-	DeviceManagementSignerName        string       `json:"deviceManagementSignerName,omitempty"`
-	DeviceManagementRenewalSignerName string       `json:"deviceManagementRenewalSignerName,omitempty"`
-	DeviceSvcClientSignerName         string       `json:"deviceSvcClientSignerName,omitempty"`
-	ServerSvcSignerName               string       `json:"serverSvcSignerName,omitempty"`
-	ClientBootstrapValidityDays       int          `json:"clientBootstrapValidityDays,omitempty"`
-	DeviceCommonNamePrefix            string       `json:"deviceCommonNamePrefix,omitempty"`
-	InternalConfig                    *InternalCfg `json:"internalConfig,omitempty"`
-	ServerCertValidityDays            int          `json:"serverCertValidityDays,omitempty"`
-	ExtraAllowedPrefixes              []string     `json:"extraAllowedPrefixes,omitempty"`
+	CAType                            CAIdType           `json:"type,omitempty"`
+	AdminCommonName                   string             `json:"adminCommonName,omitempty"`
+	ClientBootstrapCommonName         string             `json:"clientBootstrapCommonName,omitempty"`
+	ClientBootstrapCertName           string             `json:"clientBootstrapCertName,omitempty"`
+	DeviceEnrollmentSignerName        string             `json:"deviceEnrollmentSignerName,omitempty"`
+	ClientBootstrapCommonNamePrefix   string             `json:"clientBootstrapCommonNamePrefix,omitempty"`
+	DeviceManagementSignerName        string             `json:"deviceManagementSignerName,omitempty"`
+	DeviceManagementRenewalSignerName string             `json:"deviceManagementRenewalSignerName,omitempty"`
+	DeviceSvcClientSignerName         string             `json:"deviceSvcClientSignerName,omitempty"`
+	DeviceWorkloadSignerName          string             `json:"deviceWorkloadSignerName,omitempty"`
+	ServerSvcSignerName               string             `json:"serverSvcSignerName,omitempty"`
+	ClientBootstrapValidityDays       int                `json:"clientBootstrapValidityDays,omitempty"`
+	DeviceCommonNamePrefix            string             `json:"deviceCommonNamePrefix,omitempty"`
+	InternalConfig                    *InternalCfg       `json:"internalConfig,omitempty"`
+	PKCS11Config                      *PKCS11Cfg         `json:"pkcs11Config,omitempty"`
+	RemoteConfig                      *RemoteCfg         `json:"remoteConfig,omitempty"`
+	ServerCertValidityDays            int                `json:"serverCertValidityDays,omitempty"`
+	ExtraAllowedPrefixes              []string           `json:"extraAllowedPrefixes,omitempty"`
+	Revocation                        *RevocationCfg     `json:"revocation,omitempty"`
+	DeviceWorkload                    *DeviceWorkloadCfg `json:"deviceWorkload,omitempty"`
+	// PreviousCABundleFile is the path of the PEM encoded certificates of the CAs being rotated
+	// out. The certificates they issued keep authenticating devices until they are renewed.
+	PreviousCABundleFile string `json:"previousCABundleFile,omitempty"`
 }
 
 func NewDefault(tempDir string) *Config {
@@ -50,6 +147,7 @@ func NewDefault(tempDir string) *Config {
 		DeviceManagementSignerName:        "flightctl.io/device-enrollment",
 		DeviceManagementRenewalSignerName: "flightctl.io/device-management-renewal",
 		DeviceSvcClientSignerName:         "flightctl.io/device-svc-client",
+		DeviceWorkloadSignerName:          "flightctl.io/device-workload",
 		ServerSvcSignerName:               "flightctl.io/server-svc",
 		ClientBootstrapValidityDays:       365,
 		ServerCertValidityDays:            365,
@@ -62,6 +160,11 @@ func NewDefault(tempDir string) *Config {
 			SignerCertName:   "client-signer",
 			CertStore:        tempDir,
 		},
+		Revocation: &RevocationCfg{
+			RefreshIntervalSeconds: 60,
+			ValidityMinutes:        60,
+		},
+		DeviceWorkload: &DeviceWorkloadCfg{},
 	}
 	return c
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
@@ -136,6 +137,19 @@ type svcConfig struct {
 	RateLimit              *RateLimitConfig `json:"rateLimit,omitempty"`
 	TPMCAPaths             []string         `json:"tpmCAPaths,omitempty"`
 	HealthChecks           *HealthChecks    `json:"healthChecks,omitempty"`
+	AuditLog               *AuditLogConfig  `json:"auditLog,omitempty"`
+}
+
+// AuditLogConfig holds the configuration of the audit log of user actions on the API.
+type AuditLogConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// RetentionPeriod is how long audit events are kept in the database.
+	RetentionPeriod util.Duration `json:"retentionPeriod,omitempty"`
+	// FilePath is the path of the rotating file audit events are also written to. Empty disables the file.
+	FilePath   string `json:"filePath,omitempty"`
+	MaxSizeMB  int    `json:"maxSizeMB,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty"`
+	MaxAgeDays int    `json:"maxAgeDays,omitempty"`
 }
 
 // HealthChecks holds health check endpoint configuration.
@@ -414,6 +428,17 @@ type gitOpsConfig struct {
 	// IgnoreResourceUpdates lists JSON pointer paths that should be ignored
 	// when comparing desired vs. live resources during GitOps sync.
 	IgnoreResourceUpdates []string `json:"ignoreResourceUpdates,omitempty"`
+	// Webhook configures the endpoint receiving push notifications from git servers.
+	Webhook *gitWebhookConfig `json:"webhook,omitempty"`
+}
+
+type gitWebhookConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Path of the endpoint on the API server.
+	Path string `json:"path,omitempty"`
+	// Secret configured in the webhooks of the git servers. GitHub and Gitea sign payloads
+	// with it, while GitLab sends it as a token.
+	Secret api.SecureString `json:"secret,omitempty"`
 }
 
 type periodicTaskScheduleConfig struct {
@@ -442,7 +467,8 @@ type telemetryGatewayConfig struct {
 	TLS      telemetryGatewayTLSConfig `json:"tls,omitempty"`
 	Listen   telemetryGatewayListen    `json:"listen,omitempty"`
 	Export   *telemetryGatewayExport   `json:"export,omitempty"`
-	Forward  *telemetryGatewayForward  `json:"forward,omitempty"`
+	Forward  *TelemetryGatewayForward  `json:"forward,omitempty"`
+	Logs     *TelemetryGatewayLogs     `json:"logs,omitempty"`
 }
 
 type telemetryGatewayTLSConfig struct {
@@ -459,7 +485,7 @@ type telemetryGatewayExport struct {
 	Prometheus string `json:"prometheus,omitempty"`
 }
 
-type telemetryGatewayForward struct {
+type TelemetryGatewayForward struct {
 	Endpoint string                      `json:"endpoint,omitempty"`
 	TLS      *telemetryGatewayForwardTLS `json:"tls,omitempty"`
 }
@@ -471,6 +497,40 @@ type telemetryGatewayForwardTLS struct {
 	KeyFile               string `json:"keyFile,omitempty"`
 }
 
+// TelemetryGatewayLogs configures the pipeline of the logs forwarded by devices. Logs are routed to
+// the exporters of the first route whose label selector matches the labels of the device, or to the
+// default exporters if none matches.
+type TelemetryGatewayLogs struct {
+	File      *TelemetryGatewayLogsFile      `json:"file,omitempty"`
+	Forward   *TelemetryGatewayForward       `json:"forward,omitempty"`
+	RateLimit *TelemetryGatewayLogsRateLimit `json:"rateLimit,omitempty"`
+	Routes    []TelemetryGatewayLogsRoute    `json:"routes,omitempty"`
+	// DeviceCacheTTL is how long the attributes of a device are cached before being read again.
+	DeviceCacheTTL util.Duration `json:"deviceCacheTTL,omitempty"`
+}
+
+// TelemetryGatewayLogsFile exports logs as OTLP JSON lines to a local file rotated by size.
+type TelemetryGatewayLogsFile struct {
+	Path       string `json:"path,omitempty"`
+	MaxSizeMB  int    `json:"maxSizeMB,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty"`
+	MaxAgeDays int    `json:"maxAgeDays,omitempty"`
+	Compress   bool   `json:"compress,omitempty"`
+}
+
+// TelemetryGatewayLogsRateLimit limits the log records accepted from the devices of each organization.
+type TelemetryGatewayLogsRateLimit struct {
+	RecordsPerSecond float64 `json:"recordsPerSecond,omitempty"`
+	Burst            int     `json:"burst,omitempty"`
+}
+
+type TelemetryGatewayLogsRoute struct {
+	Name          string                    `json:"name,omitempty"`
+	LabelSelector string                    `json:"labelSelector,omitempty"`
+	File          *TelemetryGatewayLogsFile `json:"file,omitempty"`
+	Forward       *TelemetryGatewayForward  `json:"forward,omitempty"`
+}
+
 type ConfigOption func(*Config)
 
 func WithTracingEnabled() ConfigOption {
@@ -620,6 +680,12 @@ func NewDefault(opts ...ConfigOption) *Config {
 				LivenessPath:     "/healthz",
 				ReadinessTimeout: util.Duration(2 * time.Second),
 			},
+			AuditLog: &AuditLogConfig{
+				Enabled:         true,
+				RetentionPeriod: util.Duration(90 * 24 * time.Hour), // 90 days
+				MaxSizeMB:       100,
+				MaxBackups:      10,
+			},
 			// Rate limiting is disabled by default - set RateLimit to enable
 		},
 		ImageBuilderService: NewDefaultImageBuilderServiceConfig(),
@@ -646,6 +712,7 @@ func NewDefault(opts ...ConfigOption) *Config {
 			Listen: telemetryGatewayListen{Device: "0.0.0.0:4317"},
 			// Export: nil  (no Prom until explicitly set)
 			// Forward: nil (no upstream until explicitly set)
+			// Logs: nil (no logs pipeline until explicitly set)
 		},
 		Metrics: &metricsConfig{
 			Enabled: true,
@@ -691,6 +758,9 @@ func NewDefault(opts ...ConfigOption) *Config {
 			IgnoreResourceUpdates: []string{
 				"/metadata/resourceVersion",
 			},
+			Webhook: &gitWebhookConfig{
+				Path: "/webhooks/git",
+			},
 		},
 		Auth: &authConfig{
 			DynamicProviderCacheTTL: util.Duration(5 * time.Second),
@@ -743,10 +813,30 @@ func Load(cfgFile string) (*Config, error) {
 	if err := applyAuthDefaults(c); err != nil {
 		return nil, fmt.Errorf("applying auth defaults: %w", err)
 	}
+	applyDeviceWorkloadDefaults(c)
 
 	return c, nil
 }
 
+// applyDeviceWorkloadDefaults reserves the hostnames of the service, so that no certificate issued
+// to the applications of a device can impersonate the service.
+func applyDeviceWorkloadDefaults(c *Config) {
+	if c.CA == nil || c.Service == nil {
+		return
+	}
+	if c.CA.DeviceWorkload == nil {
+		c.CA.DeviceWorkload = &ca.DeviceWorkloadCfg{}
+	}
+	names := slices.Clone(c.Service.AltNames)
+	for _, baseUrl := range []string{c.Service.BaseUrl, c.Service.BaseAgentEndpointUrl, c.Service.BaseUIUrl} {
+		if u, err := url.Parse(baseUrl); err == nil && u.Hostname() != "" {
+			names = append(names, u.Hostname())
+		}
+	}
+	slices.Sort(names)
+	c.CA.DeviceWorkload.ReservedNames = slices.Compact(names)
+}
+
 func applyEnvVarOverrides(c *Config) {
 	if kvPass := os.Getenv("KV_PASSWORD"); kvPass != "" {
 		c.KV.Password = api.SecureString(kvPass)
@@ -763,6 +853,9 @@ func applyEnvVarOverrides(c *Config) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = api.SecureString(dbMigrationPass)
 	}
+	if webhookSecret := os.Getenv("GIT_WEBHOOK_SECRET"); webhookSecret != "" && c.GitOps != nil && c.GitOps.Webhook != nil {
+		c.GitOps.Webhook.Secret = api.SecureString(webhookSecret)
+	}
 	// CRYPTO_FORCE_FIPS environment variable sets the global crypto policy FIPS mode.
 	// This overrides auto-detection and applies to all cryptographic protocols.
 	// Valid values: "true", "1" (enable), "false", "0" (disable)
@@ -960,6 +1053,17 @@ func Validate(cfg *Config) error {
 				return fmt.Errorf("invalid ignoreResourceUpdates value: %s", path)
 			}
 		}
+		if wh := cfg.GitOps.Webhook; wh != nil && wh.Enabled {
+			if !strings.HasPrefix(wh.Path, "/") {
+				return fmt.Errorf("gitOps.webhook.path must start with '/'")
+			}
+			if strings.HasPrefix(wh.Path, "/api/") {
+				return fmt.Errorf("gitOps.webhook.path must not be under /api/")
+			}
+			if len(wh.Secret) == 0 {
+				return fmt.Errorf("gitOps.webhook.secret must be non-empty")
+			}
+		}
 	}
 
 	if cfg.Service != nil && cfg.Service.HealthChecks != nil && cfg.Service.HealthChecks.Enabled {
@@ -984,6 +1088,16 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.Service != nil && cfg.Service.AuditLog != nil && cfg.Service.AuditLog.Enabled {
+		al := cfg.Service.AuditLog
+		if al.RetentionPeriod <= 0 {
+			return fmt.Errorf("auditLog.retentionPeriod must be greater than 0")
+		}
+		if al.FilePath != "" && (al.MaxSizeMB < 0 || al.MaxBackups < 0 || al.MaxAgeDays < 0) {
+			return fmt.Errorf("auditLog.maxSizeMB, maxBackups and maxAgeDays must not be negative")
+		}
+	}
+
 	if cfg.ImageBuilderService != nil && cfg.ImageBuilderService.HealthChecks != nil && cfg.ImageBuilderService.HealthChecks.Enabled {
 		hc := cfg.ImageBuilderService.HealthChecks
 		if strings.TrimSpace(hc.ReadinessPath) == "" {
@@ -1012,6 +1126,12 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.CA != nil {
+		if err := validateCA(cfg.CA); err != nil {
+			return err
+		}
+	}
+
 	// Validate OIDC and OAuth2 provider role assignments
 	if cfg.Auth != nil {
 		if cfg.Auth.OIDC != nil {
@@ -1029,6 +1149,40 @@ func Validate(cfg *Config) error {
 	return nil
 }
 
+func validateCA(c *ca.Config) error {
+	switch c.CAType {
+	case 0, ca.InternalCA, ca.AsyncInternalCA:
+		if c.InternalConfig == nil {
+			return fmt.Errorf("ca.internalConfig must be set for the internal CA")
+		}
+		if c.InternalConfig.IntermediateOnly && c.InternalConfig.CABundleFile == "" {
+			return fmt.Errorf("ca.internalConfig.caBundleFile must be set for an intermediate CA")
+		}
+	case ca.PKCS11CA:
+		p := c.PKCS11Config
+		if p == nil {
+			return fmt.Errorf("ca.pkcs11Config must be set for the PKCS#11 CA")
+		}
+		if p.ModulePath == "" || p.TokenLabel == "" || p.KeyLabel == "" || p.PinFile == "" || p.CertFile == "" {
+			return fmt.Errorf("ca.pkcs11Config must set modulePath, tokenLabel, keyLabel, pinFile and certFile")
+		}
+	case ca.RemoteCA:
+		r := c.RemoteConfig
+		if r == nil {
+			return fmt.Errorf("ca.remoteConfig must be set for the remote CA")
+		}
+		if !strings.HasPrefix(r.URL, "https://") {
+			return fmt.Errorf("ca.remoteConfig.url must be an https URL")
+		}
+		if r.CABundleFile == "" || r.ProvisionerName == "" || r.ProvisionerKeyID == "" || r.ProvisionerKeyFile == "" {
+			return fmt.Errorf("ca.remoteConfig must set caBundleFile, provisionerName, provisionerKeyID and provisionerKeyFile")
+		}
+	default:
+		return fmt.Errorf("invalid ca.type: %d", c.CAType)
+	}
+	return nil
+}
+
 func validateAuthProviderRoleAssignment(roleAssignment api.AuthRoleAssignment, providerType string) error {
 	discriminator, err := roleAssignment.Discriminator()
 	if err != nil {
@@ -1046,13 +1200,13 @@ func validateAuthProviderRoleAssignment(roleAssignment api.AuthRoleAssignment, p
 		return fmt.Errorf("%s provider: invalid static role assignment: %w", providerType, err)
 	}
 
-	// Validate that all roles are in KnownExternalRoles
+	// Validate that all roles are known external roles or custom roles
 	for i, role := range staticAssignment.Roles {
 		if role == "" {
 			return fmt.Errorf("%s provider: role at index %d cannot be empty", providerType, i)
 		}
-		if !slices.Contains(api.KnownExternalRoles, role) {
-			return fmt.Errorf("%s provider: role at index %d is not a valid role: %s (must be one of: %v)", providerType, i, role, api.KnownExternalRoles)
+		if !api.IsAssignableRole(role) {
+			return fmt.Errorf("%s provider: role at index %d is not a valid role: %s (must be one of %v or the name of a custom role)", providerType, i, role, api.KnownExternalRoles)
 		}
 	}
 
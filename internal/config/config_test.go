@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -203,3 +205,33 @@ func TestConfig_String_HandlesNilClientSecrets(t *testing.T) {
 		t.Error("Should handle nil client secrets gracefully")
 	}
 }
+
+func TestValidate_CABackends(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{name: "internal by default", yaml: "ca:\n  clientBootstrapValidityDays: 30\n"},
+		{name: "intermediate without bundle", yaml: "ca:\n  internalConfig:\n    intermediateOnly: true\n    caBundleFile: \"\"\n", wantErr: "caBundleFile"},
+		{name: "pkcs11 by name", yaml: "ca:\n  type: pkcs11\n  pkcs11Config:\n    modulePath: /usr/lib64/pkcs11/libsofthsm2.so\n    tokenLabel: flightctl\n    keyLabel: client-signer\n    pinFile: /etc/flightctl/pkcs11-pin\n    certFile: /etc/flightctl/pki/client-signer.crt\n"},
+		{name: "pkcs11 without key", yaml: "ca:\n  type: 3\n  pkcs11Config:\n    modulePath: /usr/lib64/pkcs11/libsofthsm2.so\n", wantErr: "pkcs11Config"},
+		{name: "remote over http", yaml: "ca:\n  type: remote\n  remoteConfig:\n    url: http://ca.example.com\n", wantErr: "https"},
+		{name: "unknown type", yaml: "ca:\n  type: vault\n", wantErr: "unknown CA type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(tt.yaml), 0600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := NewFromFile(path)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRootCA(t *testing.T, dir, name string) *internalCA {
+	root, err := MakeSelfSignedCA(filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key"), "", name, 1)
+	require.NoError(t, err)
+	return root
+}
+
+// issueTestIntermediateCA issues an intermediate CA certificate for the public key.
+func issueTestIntermediateCA(t *testing.T, root *internalCA, publicKey any, name string) *x509.Certificate {
+	now := time.Now()
+	cert, err := root.signCertificate(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             now.Add(-time.Second),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		AuthorityKeyId:        root.Config.Certs[0].SubjectKeyId,
+	}, publicKey)
+	require.NoError(t, err)
+	return cert
+}
+
+func newTestCSR(t *testing.T, commonName string) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(t, err)
+	return csr
+}
+
+func writeTestCertificates(t *testing.T, path string, certs ...*x509.Certificate) {
+	certPEM, err := oscrypto.EncodeCertificates(certs...)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, certPEM, 0600))
+}
+
+func newIntermediateCAConfig(certStore string) *ca.Config {
+	return &ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        certStore,
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			CABundleFile:     "ca-bundle.crt",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+			IntermediateOnly: true,
+		},
+	}
+}
+
+func TestIntermediateOnlyCA(t *testing.T) {
+	require := require.New(t)
+	offline := t.TempDir()
+	certStore := t.TempDir()
+	root := newTestRootCA(t, offline, "offline-root")
+	cfg := newIntermediateCAConfig(certStore)
+
+	// The intermediate CA is never generated
+	_, _, err := EnsureCA(cfg)
+	require.Error(err)
+	_, err = os.Stat(filepath.Join(certStore, "ca.crt"))
+	require.True(os.IsNotExist(err))
+
+	publicKey, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(err)
+	intermediate := issueTestIntermediateCA(t, root, publicKey, "intermediate")
+	config := &TLSCertificateConfig{Certs: []*x509.Certificate{intermediate}, Key: privateKey}
+	require.NoError(config.WriteCertConfigFile(filepath.Join(certStore, "ca.crt"), filepath.Join(certStore, "ca.key")))
+
+	// The intermediate CA must chain to a root of the bundle
+	writeTestCertificates(t, filepath.Join(certStore, "ca-bundle.crt"), newTestRootCA(t, offline, "other-root").Config.Certs...)
+	_, _, err = EnsureCA(cfg)
+	require.Error(err)
+
+	writeTestCertificates(t, filepath.Join(certStore, "ca-bundle.crt"), intermediate, root.Config.Certs[0])
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(err)
+	require.False(fresh)
+
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), newTestCSR(t, "client"), 3600)
+	require.NoError(err)
+	roots := x509.NewCertPool()
+	roots.AddCert(root.Config.Certs[0])
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(intermediate)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	require.NoError(err)
+}
+
+func TestIntermediateOnlyCARejectsSelfSignedCA(t *testing.T) {
+	certStore := t.TempDir()
+	root := newTestRootCA(t, certStore, "ca")
+	writeTestCertificates(t, filepath.Join(certStore, "ca-bundle.crt"), root.Config.Certs...)
+
+	_, err := LoadInternalCA(newIntermediateCAConfig(certStore))
+	require.ErrorContains(t, err, "self-signed")
+}
+
+func TestPreviousCABundleAuthenticatesClients(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	previous := newTestRootCA(t, dir, "previous")
+	current := newTestRootCA(t, dir, "current")
+	currentClient := NewCAClient(&ca.Config{}, current)
+	previousCert, err := previous.IssueRequestedCertificateAsX509(context.Background(), newTestCSR(t, "device"), 3600, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	require.NoError(err)
+
+	cfg := &ca.Config{PreviousCABundleFile: filepath.Join(dir, "previous-bundle.crt")}
+	writeTestCertificates(t, cfg.PreviousCABundleFile, previous.Config.Certs...)
+	previousBundle, err := LoadPreviousCABundle(cfg)
+	require.NoError(err)
+	require.Len(previousBundle, 1)
+
+	serverConfig, err := currentClient.MakeServerCertificate(context.Background(), []string{"localhost"}, 1)
+	require.NoError(err)
+	_, agentTlsConfig, err := TLSConfigForServer(currentClient.GetCABundleX509(), serverConfig)
+	require.NoError(err)
+	verifyOptions := x509.VerifyOptions{Roots: agentTlsConfig.ClientCAs, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+	_, err = previousCert.Verify(verifyOptions)
+	require.Error(err)
+
+	AddClientCAs(agentTlsConfig, previousBundle)
+	_, err = previousCert.Verify(verifyOptions)
+	require.NoError(err)
+
+	previousBundle, err = LoadPreviousCABundle(&ca.Config{})
+	require.NoError(err)
+	require.Empty(previousBundle)
+}
+
+// fakeStepCA emulates the sign API of step-ca with a JWK provisioner whose certificate template
+// copies the extended key usages and extensions of the token.
+type fakeStepCA struct {
+	t                   *testing.T
+	issuer              *internalCA
+	provisionerKey      *ecdsa.PrivateKey
+	url                 string
+	dropExtensions      bool
+	lastTemplateData    RemoteCATemplateData
+	lastTokenAudience   []string
+	lastRequestNotAfter time.Time
+}
+
+func (f *fakeStepCA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	require := require.New(f.t)
+	require.Equal(remoteCASignPath, r.URL.Path)
+
+	var request remoteCASignRequest
+	require.NoError(json.NewDecoder(r.Body).Decode(&request))
+	token, err := jwt.Parse([]byte(request.OTT), jwt.WithKey(jwa.ES256, &f.provisionerKey.PublicKey), jwt.WithValidate(true))
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(remoteCAError{Message: err.Error()})
+		return
+	}
+	require.Equal("flightctl", token.Issuer())
+	f.lastTokenAudience = token.Audience()
+	f.lastRequestNotAfter = request.NotAfter
+	claim, ok := token.Get(RemoteCATokenClaim)
+	require.True(ok)
+	claimJSON, err := json.Marshal(claim)
+	require.NoError(err)
+	require.NoError(json.Unmarshal(claimJSON, &f.lastTemplateData))
+
+	block, _ := pem.Decode([]byte(request.CSR))
+	require.NotNil(block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(err)
+	require.Equal(token.Subject(), csr.Subject.CommonName)
+
+	template := &x509.Certificate{
+		Subject:        csr.Subject,
+		NotBefore:      request.NotBefore,
+		NotAfter:       request.NotAfter,
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		AuthorityKeyId: f.issuer.Config.Certs[0].SubjectKeyId,
+	}
+	for _, usage := range f.lastTemplateData.ExtKeyUsage {
+		for eku, name := range extKeyUsageNames {
+			if name == usage {
+				template.ExtKeyUsage = append(template.ExtKeyUsage, eku)
+			}
+		}
+	}
+	if !f.dropExtensions {
+		for _, ext := range f.lastTemplateData.Extensions {
+			var id asn1.ObjectIdentifier
+			for _, arc := range strings.Split(ext.ID, ".") {
+				n, err := strconv.Atoi(arc)
+				require.NoError(err)
+				id = append(id, n)
+			}
+			template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: id, Critical: ext.Critical, Value: ext.Value})
+		}
+	}
+	cert, err := f.issuer.signCertificate(template, csr.PublicKey)
+	require.NoError(err)
+	certPEM, err := fccrypto.EncodeCertificatePEM(cert)
+	require.NoError(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(remoteCASignResponse{Certificate: string(certPEM)})
+}
+
+func newTestRemoteCA(t *testing.T) (*CAClient, *fakeStepCA) {
+	require := require.New(t)
+	dir := t.TempDir()
+	issuer := newTestRootCA(t, dir, "step-ca")
+	provisionerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	fake := &fakeStepCA{t: t, issuer: issuer, provisionerKey: provisionerKey}
+
+	serverConfig, err := NewCAClient(&ca.Config{}, issuer).MakeServerCertificate(context.Background(), []string{"127.0.0.1"}, 1)
+	require.NoError(err)
+	certPEM, keyPEM, err := serverConfig.GetPEMBytes()
+	require.NoError(err)
+	serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(err)
+	server := httptest.NewUnstartedServer(fake)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	fake.url = server.URL
+
+	keyPEM, err = fccrypto.PEMEncodeKey(provisionerKey)
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(dir, "provisioner.key"), keyPEM, 0600))
+	writeTestCertificates(t, filepath.Join(dir, "step-ca-bundle.crt"), issuer.Config.Certs...)
+
+	caClient, fresh, err := EnsureCA(&ca.Config{
+		CAType: ca.RemoteCA,
+		RemoteConfig: &ca.RemoteCfg{
+			URL:                server.URL,
+			CABundleFile:       filepath.Join(dir, "step-ca-bundle.crt"),
+			ProvisionerName:    "flightctl",
+			ProvisionerKeyID:   "provisioner-key-id",
+			ProvisionerKeyFile: filepath.Join(dir, "provisioner.key"),
+		},
+	})
+	require.NoError(err)
+	require.False(fresh)
+	return caClient, fake
+}
+
+func TestRemoteCAIssuesCertificate(t *testing.T) {
+	require := require.New(t)
+	caClient, fake := newTestRemoteCA(t)
+
+	csr := newTestCSR(t, "device:remote-ca-fingerprint")
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), csr, 3600,
+		signer.WithExtension(signer.OIDSignerName, "flightctl.io/device-enrollment"))
+	require.NoError(err)
+
+	require.Equal([]string{fake.url + remoteCASignPath}, fake.lastTokenAudience)
+	require.Equal([]string{"clientAuth"}, fake.lastTemplateData.ExtKeyUsage)
+	require.WithinDuration(time.Now().Add(time.Hour), fake.lastRequestNotAfter, time.Minute)
+	require.Equal("device:remote-ca-fingerprint", cert.Subject.CommonName)
+	require.Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	signerName, err := signer.GetSignerNameExtension(cert)
+	require.NoError(err)
+	require.Equal("flightctl.io/device-enrollment", signerName)
+	require.Equal(fake.issuer.Config.Certs[0].Raw, caClient.GetCABundleX509()[0].Raw)
+}
+
+func TestRemoteCARejectsCertificateWithoutExtensions(t *testing.T) {
+	caClient, fake := newTestRemoteCA(t)
+	fake.dropExtensions = true
+
+	_, err := caClient.IssueRequestedClientCertificate(context.Background(), newTestCSR(t, "device:remote-ca-fingerprint"), 3600,
+		signer.WithExtension(signer.OIDSignerName, "flightctl.io/device-enrollment"))
+	require.ErrorContains(t, err, "missing extension")
+}
@@ -29,6 +29,7 @@ type CAClient struct {
 	caBackend CABackend
 	Cfg       *ca.Config
 	signers   *signer.CASigners
+	workload  workloadCA
 }
 
 func (caClient *CAClient) Config() *ca.Config {
@@ -46,10 +47,20 @@ func NewCAClient(cfg *ca.Config, caBackend CABackend) *CAClient {
 
 // EnsureCA() tries to load or generate a CA and connect to it.
 // If the CA is successfully loaded or generated it returns a valid CA instance, a flag signifying
-// was it loaded or generated and a nil error.
+// was it loaded or generated and a nil error. Only the internal CA is ever generated.
 // In case of errors a non-nil error is returned.
 func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
-	caBackend, fresh, err := ensureInternalCA(cfg)
+	var (
+		caBackend CABackend
+		fresh     bool
+		err       error
+	)
+	switch cfg.CAType {
+	case ca.PKCS11CA, ca.RemoteCA:
+		caBackend, err = LoadCA(cfg)
+	default:
+		caBackend, fresh, err = ensureInternalCA(cfg)
+	}
 	if err != nil {
 		return nil, fresh, err
 	}
@@ -62,6 +73,40 @@ func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
 	return ca, fresh, nil
 }
 
+// LoadCA connects to the CA backend selected by the configuration.
+func LoadCA(cfg *ca.Config) (CABackend, error) {
+	switch cfg.CAType {
+	case ca.PKCS11CA:
+		return loadPKCS11CA(cfg)
+	case ca.RemoteCA:
+		return newRemoteCA(cfg)
+	default:
+		return LoadInternalCA(cfg)
+	}
+}
+
+// LoadCertificates reads the PEM encoded certificates of a file.
+func LoadCertificates(path string) ([]*x509.Certificate, error) {
+	certPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificates from %s: %w", path, err)
+	}
+	certs, err := oscrypto.CertsFromPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificates from %s: %w", path, err)
+	}
+	return certs, nil
+}
+
+// LoadPreviousCABundle returns the certificates of the CAs being rotated out, which are trusted
+// to authenticate the devices that did not renew their certificates yet.
+func LoadPreviousCABundle(cfg *ca.Config) ([]*x509.Certificate, error) {
+	if cfg.PreviousCABundleFile == "" {
+		return nil, nil
+	}
+	return LoadCertificates(cfg.PreviousCABundleFile)
+}
+
 func (caClient *CAClient) GetSigner(name string) signer.Signer {
 	return caClient.signers.GetSigner(name)
 }
@@ -265,8 +310,8 @@ func (caClient *CAClient) GetCABundleX509() []*x509.Certificate {
 }
 
 func (caClient *CAClient) GetCABundle() ([]byte, error) {
-	// If CABundleFile is configured, read it directly
-	if caClient.Cfg.InternalConfig.CABundleFile != "" {
+	// If CABundleFile is configured for the internal CA, read it directly
+	if caClient.Cfg.CAType != ca.PKCS11CA && caClient.Cfg.CAType != ca.RemoteCA && caClient.Cfg.InternalConfig.CABundleFile != "" {
 		caBundlePath := CertStorePath(caClient.Cfg.InternalConfig.CABundleFile, caClient.Cfg.InternalConfig.CertStore)
 		caBundleBytes, err := os.ReadFile(caBundlePath)
 		if err != nil {
@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -32,8 +33,15 @@ func ensureInternalCA(cfg *ca.Config) (CABackend, bool, error) {
 	}
 	ca, err := GetCA(caCertFile, caKeyFile, caSerialFile)
 	if err == nil {
+		if cfg.InternalConfig.IntermediateOnly {
+			err = verifyIntermediateCA(cfg.InternalConfig, ca.Config.Certs)
+		}
 		return ca, false, err
 	}
+	if cfg.InternalConfig.IntermediateOnly {
+		// The root is offline: the intermediate CA must be issued out of band
+		return nil, false, fmt.Errorf("loading intermediate CA: %w", err)
+	}
 	ca, err = MakeSelfSignedCA(caCertFile, caKeyFile, caSerialFile, cfg.InternalConfig.SignerCertName, cfg.InternalConfig.CertValidityDays)
 	if err != nil {
 		return nil, false, err
@@ -61,7 +69,61 @@ func LoadInternalCA(cfg *ca.Config) (CABackend, error) {
 	if len(cfg.InternalConfig.SerialFile) > 0 {
 		caSerialFile = CertStorePath(cfg.InternalConfig.SerialFile, cfg.InternalConfig.CertStore)
 	}
-	return GetCA(caCertFile, caKeyFile, caSerialFile)
+	ca, err := GetCA(caCertFile, caKeyFile, caSerialFile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.InternalConfig.IntermediateOnly {
+		if err := verifyIntermediateCA(cfg.InternalConfig, ca.Config.Certs); err != nil {
+			return nil, err
+		}
+	}
+	return ca, nil
+}
+
+// verifyIntermediateCA verifies that the CA certificate is issued by one of the roots of the CA
+// bundle, possibly through the other intermediates following it in the certificate file.
+func verifyIntermediateCA(cfg *ca.InternalCfg, certs []*x509.Certificate) error {
+	if cfg.CABundleFile == "" {
+		return errors.New("an intermediate CA requires a CA bundle holding its root")
+	}
+	bundle, err := LoadCertificates(CertStorePath(cfg.CABundleFile, cfg.CertStore))
+	if err != nil {
+		return err
+	}
+	return verifyCAChain(certs, bundle)
+}
+
+// verifyCAChain verifies that the first certificate is a CA certificate chaining to a
+// self-signed root of the bundle.
+func verifyCAChain(certs []*x509.Certificate, bundle []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return errors.New("no CA certificate")
+	}
+	if !certs[0].IsCA {
+		return fmt.Errorf("certificate %q is not a CA certificate", certs[0].Subject.CommonName)
+	}
+	if bytes.Equal(certs[0].RawIssuer, certs[0].RawSubject) {
+		return fmt.Errorf("CA certificate %q is self-signed", certs[0].Subject.CommonName)
+	}
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	for _, cert := range append(certs[1:], bundle...) {
+		if bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil {
+			roots.AddCert(cert)
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("verifying the chain of CA certificate %q: %w", certs[0].Subject.CommonName, err)
+	}
+	return nil
 }
 
 func GetCA(certFile, keyFile, serialFile string) (*internalCA, error) {
@@ -135,7 +197,7 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 
 		SerialNumber: big.NewInt(serial),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 
@@ -217,3 +279,11 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 func (caBackend *internalCA) GetCABundleX509() []*x509.Certificate {
 	return caBackend.Config.Certs
 }
+
+func (caBackend *internalCA) RevocationSigner() (*x509.Certificate, crypto.Signer, error) {
+	key, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported CA key type %T", caBackend.Config.Key)
+	}
+	return caBackend.Config.Certs[0], key, nil
+}
@@ -0,0 +1,66 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ThalesGroup/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+// loadPKCS11CA returns a CA signing with a key held in an HSM. The key never leaves the HSM:
+// the certificates, CRLs and OCSP responses are signed by the PKCS#11 module, and the certificate
+// of the key is read from the filesystem.
+func loadPKCS11CA(cfg *ca.Config) (CABackend, error) {
+	pkcs11Cfg := cfg.PKCS11Config
+	if pkcs11Cfg == nil {
+		return nil, errors.New("the PKCS#11 CA requires a pkcs11Config")
+	}
+
+	certs, err := LoadCertificates(pkcs11Cfg.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	if !certs[0].IsCA {
+		return nil, fmt.Errorf("certificate %q is not a CA certificate", certs[0].Subject.CommonName)
+	}
+
+	pin, err := os.ReadFile(pkcs11Cfg.PinFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading PKCS#11 PIN: %w", err)
+	}
+	pkcs11Ctx, err := crypto11.Configure(&crypto11.Config{
+		Path:       pkcs11Cfg.ModulePath,
+		TokenLabel: pkcs11Cfg.TokenLabel,
+		Pin:        strings.TrimSpace(string(pin)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("opening PKCS#11 token %q: %w", pkcs11Cfg.TokenLabel, err)
+	}
+	key, err := pkcs11Ctx.FindKeyPair(nil, []byte(pkcs11Cfg.KeyLabel))
+	if err == nil && key == nil {
+		err = errors.New("not found")
+	}
+	if err != nil {
+		_ = pkcs11Ctx.Close()
+		return nil, fmt.Errorf("finding PKCS#11 key %q: %w", pkcs11Cfg.KeyLabel, err)
+	}
+
+	publicKey, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(certs[0].PublicKey) {
+		_ = pkcs11Ctx.Close()
+		return nil, fmt.Errorf("PKCS#11 key %q does not match the CA certificate %q", pkcs11Cfg.KeyLabel, certs[0].Subject.CommonName)
+	}
+
+	// The serial numbers are random, as several API servers may share the HSM
+	return &internalCA{
+		Config:          &TLSCertificateConfig{Certs: certs, Key: key},
+		SerialGenerator: &oscrypto.RandomSerialGenerator{},
+	}, nil
+}
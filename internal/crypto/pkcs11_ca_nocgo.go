@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package crypto
+
+import (
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+func loadPKCS11CA(cfg *ca.Config) (CABackend, error) {
+	return nil, errors.New("the PKCS#11 CA is not supported by this build, which was built without cgo")
+}
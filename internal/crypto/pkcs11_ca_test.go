@@ -0,0 +1,95 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto/elliptic"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/ThalesGroup/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/stretchr/testify/require"
+)
+
+var softHSMModulePaths = []string{
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// initSoftHSMToken initializes a SoftHSM token in a temporary directory and returns the path of
+// the SoftHSM module, skipping the test when SoftHSM is not installed.
+func initSoftHSMToken(t *testing.T, label, pin string) string {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	for _, path := range softHSMModulePaths {
+		if modulePath != "" {
+			break
+		}
+		if _, err := os.Stat(path); err == nil {
+			modulePath = path
+		}
+	}
+	util, err := exec.LookPath("softhsm2-util")
+	if modulePath == "" || err != nil {
+		t.Skip("SoftHSM is not installed")
+	}
+
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	out, err := exec.Command(util, "--init-token", "--free", "--label", label, "--pin", pin, "--so-pin", pin+pin).CombinedOutput() // #nosec G204
+	require.NoError(t, err, string(out))
+	return modulePath
+}
+
+func TestPKCS11CA(t *testing.T) {
+	require := require.New(t)
+	modulePath := initSoftHSMToken(t, "flightctl", "1234")
+
+	pkcs11Ctx, err := crypto11.Configure(&crypto11.Config{Path: modulePath, TokenLabel: "flightctl", Pin: "1234"})
+	require.NoError(err)
+	key, err := pkcs11Ctx.GenerateECDSAKeyPairWithLabel([]byte{1}, []byte("client-signer"), elliptic.P256())
+	require.NoError(err)
+
+	dir := t.TempDir()
+	root := newTestRootCA(t, dir, "offline-root")
+	intermediate := issueTestIntermediateCA(t, root, key.Public(), "client-signer")
+	require.NoError(pkcs11Ctx.Close())
+
+	cfg := &ca.Config{
+		CAType: ca.PKCS11CA,
+		PKCS11Config: &ca.PKCS11Cfg{
+			ModulePath: modulePath,
+			TokenLabel: "flightctl",
+			PinFile:    filepath.Join(dir, "pin"),
+			KeyLabel:   "client-signer",
+			CertFile:   filepath.Join(dir, "client-signer.crt"),
+		},
+	}
+	require.NoError(os.WriteFile(cfg.PKCS11Config.PinFile, []byte("1234\n"), 0600))
+	writeTestCertificates(t, cfg.PKCS11Config.CertFile, intermediate, root.Config.Certs[0])
+
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(err)
+	require.False(fresh)
+
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), newTestCSR(t, "client"), 3600)
+	require.NoError(err)
+	require.NoError(cert.CheckSignatureFrom(intermediate))
+	require.Equal(caClient.IssuerID(), CertificateIssuerID(cert))
+
+	// The key must match the certificate
+	writeTestCertificates(t, cfg.PKCS11Config.CertFile, root.Config.Certs[0])
+	_, err = LoadCA(cfg)
+	require.ErrorContains(err, "does not match")
+}
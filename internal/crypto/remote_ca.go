@@ -0,0 +1,299 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	remoteCASignPath       = "/1.0/sign"
+	remoteCATokenLifetime  = 5 * time.Minute
+	remoteCADefaultTimeout = 30 * time.Second
+
+	// RemoteCATokenClaim is the claim of the one-time tokens carrying the extended key usages and
+	// the extensions of the requested certificates, which the certificate template of the
+	// provisioner copies into the certificates.
+	RemoteCATokenClaim = "flightctl"
+)
+
+var extKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageClientAuth: "clientAuth",
+	x509.ExtKeyUsageServerAuth: "serverAuth",
+}
+
+// RemoteCAExtension is an extension of a requested certificate, in the format of the
+// certificate templates of step-ca.
+type RemoteCAExtension struct {
+	ID       string `json:"id"`
+	Critical bool   `json:"critical"`
+	Value    []byte `json:"value"`
+}
+
+// RemoteCATemplateData is the value of the RemoteCATokenClaim claim.
+type RemoteCATemplateData struct {
+	ExtKeyUsage []string            `json:"extKeyUsage"`
+	Extensions  []RemoteCAExtension `json:"extensions"`
+}
+
+type remoteCASignRequest struct {
+	CSR       string    `json:"csr"`
+	OTT       string    `json:"ott"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+}
+
+type remoteCASignResponse struct {
+	Certificate string `json:"crt"`
+}
+
+type remoteCAError struct {
+	Message string `json:"message"`
+}
+
+// remoteCA delegates the signing of the certificates to a remote CA exposing the step-ca sign
+// API. The CA authorizes each request with a one-time token signed by a JWK provisioner.
+type remoteCA struct {
+	cfg             *ca.RemoteCfg
+	bundle          []*x509.Certificate
+	rootFingerprint string
+	key             jwk.Key
+	client          *http.Client
+}
+
+func newRemoteCA(cfg *ca.Config) (CABackend, error) {
+	remoteCfg := cfg.RemoteConfig
+	if remoteCfg == nil {
+		return nil, errors.New("the remote CA requires a remoteConfig")
+	}
+	if remoteCfg.URL == "" || remoteCfg.ProvisionerName == "" || remoteCfg.ProvisionerKeyID == "" {
+		return nil, errors.New("the remote CA requires a url, a provisionerName and a provisionerKeyID")
+	}
+
+	bundle, err := LoadCertificates(remoteCfg.CABundleFile)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := fccrypto.LoadKey(remoteCfg.ProvisionerKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading provisioner key: %w", err)
+	}
+	key, err := jwk.FromRaw(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading provisioner key: %w", err)
+	}
+	alg, err := remoteCASignatureAlgorithm(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.KeyIDKey, remoteCfg.ProvisionerKeyID); err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.AlgorithmKey, alg); err != nil {
+		return nil, err
+	}
+
+	roots := x509.NewCertPool()
+	for _, cert := range bundle {
+		roots.AddCert(cert)
+	}
+	timeout := remoteCADefaultTimeout
+	if remoteCfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(remoteCfg.TimeoutSeconds) * time.Second
+	}
+
+	// The last certificate of the bundle is the root, whose fingerprint binds the tokens to the CA
+	fingerprint := sha256.Sum256(bundle[len(bundle)-1].Raw)
+	return &remoteCA{
+		cfg:             remoteCfg,
+		bundle:          bundle,
+		rootFingerprint: hex.EncodeToString(fingerprint[:]),
+		key:             key,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+			},
+		},
+	}, nil
+}
+
+func remoteCASignatureAlgorithm(key crypto.PrivateKey) (jwa.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwa.ES256, nil
+		case elliptic.P384():
+			return jwa.ES384, nil
+		}
+	case ed25519.PrivateKey:
+		return jwa.EdDSA, nil
+	}
+	return "", fmt.Errorf("unsupported provisioner key type %T", key)
+}
+
+func (r *remoteCA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	now := time.Now()
+	// The options are applied to a local template to collect the extensions the remote CA must
+	// add to the certificate
+	template := &x509.Certificate{
+		Subject:     csr.Subject,
+		NotBefore:   now.Add(-time.Second),
+		NotAfter:    now.Add(time.Duration(expirySeconds) * time.Second),
+		ExtKeyUsage: usage,
+	}
+	for _, opt := range opts {
+		if err := opt(template); err != nil {
+			return nil, fmt.Errorf("applying cert option: %w", err)
+		}
+	}
+
+	token, err := r.token(template, csr, now)
+	if err != nil {
+		return nil, fmt.Errorf("creating remote CA token: %w", err)
+	}
+	body, err := json.Marshal(remoteCASignRequest{
+		CSR:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})),
+		OTT:       token,
+		NotBefore: template.NotBefore.UTC(),
+		NotAfter:  template.NotAfter.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.signURL(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting certificate from remote CA: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading remote CA response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var caErr remoteCAError
+		_ = json.Unmarshal(respBody, &caErr)
+		return nil, fmt.Errorf("remote CA refused the certificate request: %s: %s", resp.Status, caErr.Message)
+	}
+
+	var signResp remoteCASignResponse
+	if err := json.Unmarshal(respBody, &signResp); err != nil {
+		return nil, fmt.Errorf("decoding remote CA response: %w", err)
+	}
+	cert, err := fccrypto.ParseCertificatePEM([]byte(signResp.Certificate))
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate issued by remote CA: %w", err)
+	}
+	if err := r.verifyIssuedCertificate(cert, csr, template); err != nil {
+		return nil, fmt.Errorf("certificate issued by remote CA: %w", err)
+	}
+	return cert, nil
+}
+
+func (r *remoteCA) signURL() string {
+	return strings.TrimSuffix(r.cfg.URL, "/") + remoteCASignPath
+}
+
+func (r *remoteCA) token(template *x509.Certificate, csr *x509.CertificateRequest, now time.Time) (string, error) {
+	data := RemoteCATemplateData{
+		ExtKeyUsage: []string{},
+		Extensions:  []RemoteCAExtension{},
+	}
+	for _, usage := range template.ExtKeyUsage {
+		name, ok := extKeyUsageNames[usage]
+		if !ok {
+			return "", fmt.Errorf("unsupported extended key usage %v", usage)
+		}
+		data.ExtKeyUsage = append(data.ExtKeyUsage, name)
+	}
+	for _, ext := range template.ExtraExtensions {
+		data.Extensions = append(data.Extensions, RemoteCAExtension{ID: ext.Id.String(), Critical: ext.Critical, Value: ext.Value})
+	}
+
+	sans := append([]string{csr.Subject.CommonName}, csr.DNSNames...)
+	for _, ip := range csr.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	token, err := jwt.NewBuilder().
+		Issuer(r.cfg.ProvisionerName).
+		Subject(csr.Subject.CommonName).
+		Audience([]string{r.signURL()}).
+		IssuedAt(now).
+		NotBefore(now).
+		Expiration(now.Add(remoteCATokenLifetime)).
+		JwtID(uuid.NewString()).
+		Claim("sans", sans).
+		Claim("sha", r.rootFingerprint).
+		Claim(RemoteCATokenClaim, data).
+		Build()
+	if err != nil {
+		return "", err
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(r.key.Algorithm(), r.key))
+	if err != nil {
+		return "", err
+	}
+	return string(signed), nil
+}
+
+// verifyIssuedCertificate verifies that the remote CA issued the requested certificate, which
+// detects a provisioner template that does not copy the extensions.
+func (r *remoteCA) verifyIssuedCertificate(cert *x509.Certificate, csr *x509.CertificateRequest, template *x509.Certificate) error {
+	if err := cert.CheckSignatureFrom(r.bundle[0]); err != nil {
+		return fmt.Errorf("not issued by CA %q: %w", r.bundle[0].Subject.CommonName, err)
+	}
+	publicKey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(csr.PublicKey) {
+		return errors.New("public key does not match the certificate request")
+	}
+	if cert.Subject.CommonName != csr.Subject.CommonName {
+		return fmt.Errorf("common name %q does not match the certificate request", cert.Subject.CommonName)
+	}
+	for _, ext := range template.ExtraExtensions {
+		found := false
+		for _, certExt := range cert.Extensions {
+			if certExt.Id.Equal(ext.Id) && bytes.Equal(certExt.Value, ext.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("missing extension %s, check the certificate template of the provisioner", ext.Id)
+		}
+	}
+	return nil
+}
+
+func (r *remoteCA) GetCABundleX509() []*x509.Certificate {
+	return r.bundle
+}
@@ -0,0 +1,162 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"golang.org/x/crypto/ocsp"
+)
+
+// revocationSigner is implemented by the CA backends holding the key of the CA, which is used to
+// sign the CRLs and OCSP responses publishing the revoked certificates.
+type revocationSigner interface {
+	RevocationSigner() (*x509.Certificate, crypto.Signer, error)
+}
+
+var revocationReasonCodes = map[domain.CertificateRevocationReason]int{
+	domain.CertificateRevocationReasonUnspecified:          ocsp.Unspecified,
+	domain.CertificateRevocationReasonKeyCompromise:        ocsp.KeyCompromise,
+	domain.CertificateRevocationReasonAffiliationChanged:   ocsp.AffiliationChanged,
+	domain.CertificateRevocationReasonSuperseded:           ocsp.Superseded,
+	domain.CertificateRevocationReasonCessationOfOperation: ocsp.CessationOfOperation,
+	domain.CertificateRevocationReasonPrivilegeWithdrawn:   ocsp.PrivilegeWithdrawn,
+}
+
+// FormatSerialNumber returns the canonical representation of a certificate serial number used to
+// record revocations: lowercase hexadecimal without leading zeros or separators.
+func FormatSerialNumber(serialNumber *big.Int) string {
+	return serialNumber.Text(16)
+}
+
+// ParseSerialNumber parses a hexadecimal certificate serial number, optionally separated by
+// colons as printed by openssl.
+func ParseSerialNumber(s string) (*big.Int, error) {
+	serialNumber, ok := new(big.Int).SetString(strings.ReplaceAll(s, ":", ""), 16)
+	if !ok || serialNumber.Sign() < 0 {
+		return nil, fmt.Errorf("invalid certificate serial number %q", s)
+	}
+	return serialNumber, nil
+}
+
+// CertificateIssuerID returns the key identifier of the CA that issued the certificate, in
+// lowercase hexadecimal.
+func CertificateIssuerID(cert *x509.Certificate) string {
+	return hex.EncodeToString(cert.AuthorityKeyId)
+}
+
+// IssuerID returns the key identifier of the CA, which matches the CertificateIssuerID of the
+// certificates it issues.
+func (caClient *CAClient) IssuerID() string {
+	bundle := caClient.caBackend.GetCABundleX509()
+	if len(bundle) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(bundle[0].SubjectKeyId)
+}
+
+func (caClient *CAClient) revocationSigner() (*x509.Certificate, crypto.Signer, error) {
+	rs, ok := caClient.caBackend.(revocationSigner)
+	if !ok {
+		return nil, nil, errors.New("the CA backend does not support signing revocation information")
+	}
+	return rs.RevocationSigner()
+}
+
+// CreateCRL returns a DER encoded certificate revocation list signed by the CA listing the given
+// revocations of the certificates it issued. Revocations of certificates issued by other CAs are
+// skipped.
+func (caClient *CAClient) CreateCRL(revocations []domain.CertificateRevocation, thisUpdate, nextUpdate time.Time) ([]byte, error) {
+	issuer, key, err := caClient.revocationSigner()
+	if err != nil {
+		return nil, err
+	}
+	issuerID := caClient.IssuerID()
+
+	entries := make([]x509.RevocationListEntry, 0, len(revocations))
+	for _, revocation := range revocations {
+		if revocation.Issuer != issuerID {
+			continue
+		}
+		serialNumber, err := ParseSerialNumber(revocation.SerialNumber)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serialNumber,
+			RevocationTime: revocation.RevokedAt,
+			ReasonCode:     revocationReasonCodes[revocation.Reason],
+		})
+	}
+
+	// CAs created before revocation support lack the CRL signing key usage, which the standard
+	// library requires on the issuer. The CRL only references the subject and key identifier of
+	// the issuer, so it is signed on behalf of a copy carrying the key usage.
+	crlIssuer := *issuer
+	crlIssuer.KeyUsage |= x509.KeyUsageCRLSign
+
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(thisUpdate.UnixMilli()),
+		ThisUpdate:                thisUpdate,
+		NextUpdate:                nextUpdate,
+	}
+	return x509.CreateRevocationList(rand.Reader, template, &crlIssuer, key)
+}
+
+// CreateOCSPResponse returns a DER encoded OCSP response signed by the CA answering the request.
+// The lookup function returns the revocation of a certificate given the key identifier of its
+// issuer and its serial number, or nil if it is not revoked. Requests for certificates issued by
+// other CAs are answered with the unauthorized response.
+func (caClient *CAClient) CreateOCSPResponse(request *ocsp.Request, lookup func(issuer, serialNumber string) *domain.CertificateRevocation, thisUpdate, nextUpdate time.Time) ([]byte, error) {
+	issuer, key, err := caClient.revocationSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	keyHash, err := issuerKeyHash(issuer, request.HashAlgorithm)
+	if err != nil || !bytes.Equal(keyHash, request.IssuerKeyHash) {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: request.SerialNumber,
+		ThisUpdate:   thisUpdate,
+		NextUpdate:   nextUpdate,
+		IssuerHash:   request.HashAlgorithm,
+	}
+	if revocation := lookup(caClient.IssuerID(), FormatSerialNumber(request.SerialNumber)); revocation != nil {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = revocation.RevokedAt
+		template.RevocationReason = revocationReasonCodes[revocation.Reason]
+	}
+	return ocsp.CreateResponse(issuer, issuer, template, key)
+}
+
+// issuerKeyHash returns the hash of the public key of the issuer as referenced by OCSP requests.
+func issuerKeyHash(issuer *x509.Certificate, hash crypto.Hash) ([]byte, error) {
+	if !hash.Available() {
+		return nil, fmt.Errorf("unsupported hash algorithm %v", hash)
+	}
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, fmt.Errorf("parsing issuer public key: %w", err)
+	}
+	h := hash.New()
+	h.Write(spki.PublicKey.RightAlign())
+	return h.Sum(nil), nil
+}
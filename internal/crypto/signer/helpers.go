@@ -44,7 +44,7 @@ func SignAsPEM(ctx context.Context, ca CA, req SignRequest) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return crypto.EncodeCertificatePEM(cert)
+	return encodeCertificateChainPEM(ca, cert)
 }
 
 // SignVerifiedAsPEM verifies, signs, and returns the signed certificate in PEM format.
@@ -53,7 +53,21 @@ func SignVerifiedAsPEM(ctx context.Context, ca CA, req SignRequest) ([]byte, err
 	if err != nil {
 		return nil, err
 	}
-	return crypto.EncodeCertificatePEM(cert)
+	return encodeCertificateChainPEM(ca, cert)
+}
+
+// encodeCertificateChainPEM encodes a certificate in PEM format, followed by the intermediate CAs
+// that issued it and that are not part of the CA bundle.
+func encodeCertificateChainPEM(ca CA, cert *x509.Certificate) ([]byte, error) {
+	var chainPEM []byte
+	for _, c := range append([]*x509.Certificate{cert}, ca.IntermediatesFor(cert)...) {
+		certPEM, err := crypto.EncodeCertificatePEM(c)
+		if err != nil {
+			return nil, err
+		}
+		chainPEM = append(chainPEM, certPEM...)
+	}
+	return chainPEM, nil
 }
 
 // signerFor retrieves the signer from the CA based on the request's signer name.
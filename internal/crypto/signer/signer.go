@@ -30,6 +30,8 @@ type CA interface {
 	PeerCertificateSignerFromCtx(ctx context.Context) Signer
 	IssueRequestedClientCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error)
 	IssueRequestedServerCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error)
+	IssueRequestedWorkloadCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error)
+	IntermediatesFor(cert *x509.Certificate) []*x509.Certificate
 }
 
 type CASigners struct {
@@ -73,6 +75,13 @@ func NewCASigners(ca CA) *CASigners {
 					),
 				),
 			),
+			cfg.DeviceWorkloadSignerName: WithSignerNameValidation(
+				WithCertificateReuse(
+					WithCSRValidation(
+						WithSignerNameExtension(NewSignerDeviceWorkload)(ca),
+					),
+				),
+			),
 			cfg.ServerSvcSignerName: WithSignerNameValidation(
 				WithCertificateReuse(
 					WithCSRValidation(
@@ -153,6 +162,14 @@ func (s *chainSignerCA) IssueRequestedServerCertificate(ctx context.Context, csr
 	return s.next.IssueRequestedServerCertificate(ctx, csr, expirySeconds, opts...)
 }
 
+func (s *chainSignerCA) IssueRequestedWorkloadCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error) {
+	return s.next.IssueRequestedWorkloadCertificate(ctx, csr, expirySeconds, opts...)
+}
+
+func (s *chainSignerCA) IntermediatesFor(cert *x509.Certificate) []*x509.Certificate {
+	return s.next.IntermediatesFor(cert)
+}
+
 func (s *chainSigner) Name() string {
 	if s.name != nil {
 		return s.name()
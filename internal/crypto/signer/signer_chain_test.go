@@ -56,9 +56,19 @@ func (m *mockCA) IssueRequestedClientCertificate(ctx context.Context, csr *x509.
 }
 
 func (m *mockCA) IssueRequestedServerCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error) {
-	return &x509.Certificate{Subject: csr.Subject}, nil
+	cert := &x509.Certificate{Subject: csr.Subject}
+	for _, o := range opts {
+		_ = o(cert)
+	}
+	return cert, nil
 }
 
+func (m *mockCA) IssueRequestedWorkloadCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error) {
+	return m.IssueRequestedServerCertificate(ctx, csr, expirySeconds, opts...)
+}
+
+func (m *mockCA) IntermediatesFor(cert *x509.Certificate) []*x509.Certificate { return nil }
+
 // mockSigner is a minimal signer used to exercise wrapper chains only.
 // It returns nil on Verify and calls through to CA IssueRequestedClientCertificate on Sign.
 type mockSigner struct {
@@ -219,6 +229,46 @@ func TestSignerChains(t *testing.T) {
 			verifyOnly: true,
 			wantErr:    true,
 		},
+		{
+			name: "device_workload_injects_device_fingerprint",
+			build: func() (context.Context, SignRequest) {
+				csr := makeCSR(t, "web.example.com", uuid.Nil)
+				req, err := NewSignRequest(cfg.DeviceWorkloadSignerName, *csr, WithResourceName("web-0001"))
+				if err != nil {
+					t.Fatalf("NewSignRequest: %v", err)
+				}
+				peerCN, err := CNFromDeviceFingerprint(cfg, "abcdef0123456789")
+				if err != nil {
+					t.Fatalf("CNFromDeviceFingerprint: %v", err)
+				}
+				peer := &x509.Certificate{Subject: pkix.Name{CommonName: peerCN}}
+				peer.ExtraExtensions = append(peer.ExtraExtensions, pkix.Extension{Id: OIDSignerName, Value: mustASN1(t, cfg.DeviceManagementSignerName)})
+				ctx := context.WithValue(withOrgCtx(orgID), consts.TLSPeerCertificateCtxKey, peer)
+				return ctx, req
+			},
+			assert: func(cert *x509.Certificate) {
+				fingerprint, err := GetDeviceFingerprintExtension(cert)
+				if err != nil {
+					t.Fatalf("Device fingerprint extension missing: %v", err)
+				}
+				if fingerprint != "abcdef0123456789" {
+					t.Fatalf("unexpected device fingerprint: got %q", fingerprint)
+				}
+			},
+		},
+		{
+			name: "device_workload_rejects_requests_without_device_certificate",
+			build: func() (context.Context, SignRequest) {
+				csr := makeCSR(t, "web.example.com", uuid.Nil)
+				req, err := NewSignRequest(cfg.DeviceWorkloadSignerName, *csr, WithResourceName("web-0001"))
+				if err != nil {
+					t.Fatalf("NewSignRequest: %v", err)
+				}
+				return withOrgCtx(orgID), req
+			},
+			verifyOnly: true,
+			wantErr:    true,
+		},
 	}
 
 	for _, tc := range cases {
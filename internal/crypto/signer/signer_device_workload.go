@@ -0,0 +1,135 @@
+package signer
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const signerDeviceWorkloadExpiryDays int32 = 365
+
+// SignerDeviceWorkload issues certificates for the applications and services running on a device.
+// Requests are only accepted from a device presenting its device-management client certificate, and
+// the issued certificates carry the fingerprint of that device. They are server certificates issued
+// by the workload intermediate CA, which cannot be issued for the names of the service.
+type SignerDeviceWorkload struct {
+	name string
+	ca   CA
+}
+
+func NewSignerDeviceWorkload(CAClient CA) Signer {
+	cfg := CAClient.Config()
+	return &SignerDeviceWorkload{name: cfg.DeviceWorkloadSignerName, ca: CAClient}
+}
+
+func (s *SignerDeviceWorkload) Name() string {
+	return s.name
+}
+
+func (s *SignerDeviceWorkload) Verify(ctx context.Context, request SignRequest) error {
+	if _, err := s.deviceFingerprintFromCtx(ctx); err != nil {
+		return err
+	}
+
+	x509CSR := request.X509()
+	if x509CSR.Subject.CommonName == "" && len(x509CSR.DNSNames) == 0 && len(x509CSR.IPAddresses) == 0 {
+		return fmt.Errorf("CSR must specify a CommonName, DNS names or IP addresses")
+	}
+	return s.verifyNames(&x509CSR)
+}
+
+func (s *SignerDeviceWorkload) Sign(ctx context.Context, request SignRequest) (*x509.Certificate, error) {
+	fingerprint, err := s.deviceFingerprintFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expirySeconds := signerDeviceWorkloadExpiryDays * 24 * 60 * 60
+	if request.ExpirationSeconds() != nil && *request.ExpirationSeconds() < expirySeconds {
+		expirySeconds = *request.ExpirationSeconds()
+	}
+
+	x509CSR := request.X509()
+	if err := s.verifyNames(&x509CSR); err != nil {
+		return nil, err
+	}
+	return s.ca.IssueRequestedWorkloadCertificate(
+		ctx,
+		&x509CSR,
+		int(expirySeconds),
+		WithExtension(OIDDeviceFingerprint, fingerprint),
+	)
+}
+
+// verifyNames ensures that a CSR requests no name of the service, and only DNS names within the
+// allowed DNS suffixes if any.
+func (s *SignerDeviceWorkload) verifyNames(csr *x509.CertificateRequest) error {
+	cfg := lo.FromPtr(s.ca.Config().DeviceWorkload)
+
+	for _, name := range append([]string{csr.Subject.CommonName}, csr.DNSNames...) {
+		if slices.ContainsFunc(cfg.ReservedNames, func(reserved string) bool { return matchesDomain(name, reserved) }) {
+			return fmt.Errorf("%q is a name of the service", name)
+		}
+		// a wildcard name covers the names of the service within its domain
+		if base, ok := strings.CutPrefix(name, "*."); ok && slices.ContainsFunc(cfg.ReservedNames, func(reserved string) bool { return matchesDomain(reserved, base) }) {
+			return fmt.Errorf("%q covers a name of the service", name)
+		}
+	}
+	for _, ip := range csr.IPAddresses {
+		if slices.ContainsFunc(cfg.ReservedNames, func(reserved string) bool { return ip.Equal(net.ParseIP(reserved)) }) {
+			return fmt.Errorf("%q is an address of the service", ip)
+		}
+	}
+	if len(cfg.AllowedDNSSuffixes) == 0 {
+		return nil
+	}
+	for _, name := range csr.DNSNames {
+		if !slices.ContainsFunc(cfg.AllowedDNSSuffixes, func(suffix string) bool { return matchesDomain(name, suffix) }) {
+			return fmt.Errorf("DNS name %q is not within the allowed DNS suffixes %v", name, cfg.AllowedDNSSuffixes)
+		}
+	}
+	return nil
+}
+
+// matchesDomain reports whether a name is a domain or one of its subdomains.
+func matchesDomain(name, domain string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	domain = strings.ToLower(strings.TrimPrefix(strings.TrimSuffix(domain, "."), "."))
+	return domain != "" && (name == domain || strings.HasSuffix(name, "."+domain))
+}
+
+// deviceFingerprintFromCtx returns the fingerprint of the device presenting a valid device-management
+// client certificate (initial or renewal).
+func (s *SignerDeviceWorkload) deviceFingerprintFromCtx(ctx context.Context) (string, error) {
+	cfg := s.ca.Config()
+
+	peerSigner := s.ca.PeerCertificateSignerFromCtx(ctx)
+	got := "<nil>"
+	if peerSigner != nil {
+		got = peerSigner.Name()
+	}
+	if peerSigner == nil || !IsDeviceManagementClientCertSigner(cfg, peerSigner) {
+		return "", fmt.Errorf(
+			"unexpected client certificate signer: expected %q or %q, got %q",
+			cfg.DeviceManagementSignerName,
+			cfg.DeviceManagementRenewalSignerName,
+			got,
+		)
+	}
+
+	peerCertificate, err := PeerCertificateFromCtx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get peer certificate from context: %w", err)
+	}
+
+	fingerprint, err := DeviceFingerprintFromCN(cfg, peerCertificate.Subject.CommonName)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract device fingerprint from peer certificate CN: %w", err)
+	}
+	return fingerprint, nil
+}
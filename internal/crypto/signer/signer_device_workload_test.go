@@ -0,0 +1,50 @@
+package signer
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+func TestSignerDeviceWorkloadVerifyNames(t *testing.T) {
+	mock := newMockCA(t)
+	mock.cfg.DeviceWorkload = &ca.DeviceWorkloadCfg{
+		AllowedDNSSuffixes: []string{"example.com"},
+		ReservedNames:      []string{"api.example.com", "10.0.0.1"},
+	}
+	s := &SignerDeviceWorkload{name: mock.cfg.DeviceWorkloadSignerName, ca: mock}
+
+	tests := []struct {
+		name        string
+		commonName  string
+		dnsNames    []string
+		ipAddresses []string
+		wantErr     bool
+	}{
+		{name: "workload names", commonName: "web", dnsNames: []string{"web.apps.example.com"}, ipAddresses: []string{"10.0.0.2"}},
+		{name: "name of the service", commonName: "web", dnsNames: []string{"API.example.com."}, wantErr: true},
+		{name: "subdomain of the service", commonName: "web", dnsNames: []string{"web.api.example.com"}, wantErr: true},
+		{name: "wildcard covering the service", commonName: "web", dnsNames: []string{"*.example.com"}, wantErr: true},
+		{name: "common name of the service", commonName: "api.example.com", wantErr: true},
+		{name: "address of the service", commonName: "web", ipAddresses: []string{"10.0.0.1"}, wantErr: true},
+		{name: "name outside the allowed suffixes", commonName: "web", dnsNames: []string{"web.example.org"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csr := &x509.CertificateRequest{Subject: pkix.Name{CommonName: tt.commonName}, DNSNames: tt.dnsNames}
+			for _, ip := range tt.ipAddresses {
+				csr.IPAddresses = append(csr.IPAddresses, net.ParseIP(ip))
+			}
+			err := s.verifyNames(csr)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -72,3 +72,11 @@ func TLSConfigForClient(caBundleX509 []*x509.Certificate, clientConfig *TLSCerti
 	}
 	return tlsConfig, nil
 }
+
+// AddClientCAs trusts additional CAs to issue the client certificates authenticated by a TLS
+// configuration returned by TLSConfigForServer.
+func AddClientCAs(tlsConfig *tls.Config, caBundleX509 []*x509.Certificate) {
+	for _, caCert := range caBundleX509 {
+		tlsConfig.ClientCAs.AddCert(caCert)
+	}
+}
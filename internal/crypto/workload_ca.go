@@ -0,0 +1,148 @@
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+const (
+	workloadCACommonName = "flightctl-device-workload-ca"
+	// workloadCAValidity is the validity of the workload intermediate CA. It is renewed once it is
+	// valid for less than workloadCARenewBefore, which covers the validity of any workload certificate.
+	workloadCAValidity    = 2 * 365 * 24 * time.Hour
+	workloadCARenewBefore = 365 * 24 * time.Hour
+)
+
+// workloadCA is the intermediate CA issuing the certificates of the applications running on the
+// devices. Each API server has its own, issued by the CA of the service. Its name constraints exclude
+// the hostnames of the service and its only extended key usage is server authentication, so that
+// the agents never accept the certificates it issues for the service, and the service never accepts
+// them as client certificates.
+type workloadCA struct {
+	mu       sync.Mutex
+	current  *internalCA
+	previous *x509.Certificate
+}
+
+// IssueRequestedWorkloadCertificate issues a server certificate from the workload intermediate CA.
+func (caClient *CAClient) IssueRequestedWorkloadCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...CertOption) (*x509.Certificate, error) {
+	issuer, err := caClient.ensureWorkloadCA(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("issuing the workload intermediate CA: %w", err)
+	}
+	return issuer.IssueRequestedCertificateAsX509(ctx, csr, expirySeconds, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, opts...)
+}
+
+// IntermediatesFor returns the certificates of the intermediate CAs that issued a certificate and
+// that are not part of the CA bundle, so that they can be served along with the certificate.
+func (caClient *CAClient) IntermediatesFor(cert *x509.Certificate) []*x509.Certificate {
+	caClient.workload.mu.Lock()
+	defer caClient.workload.mu.Unlock()
+
+	candidates := []*x509.Certificate{caClient.workload.previous}
+	if caClient.workload.current != nil {
+		candidates = append(candidates, caClient.workload.current.Config.Certs[0])
+	}
+	for _, candidate := range candidates {
+		if candidate != nil && cert.CheckSignatureFrom(candidate) == nil {
+			return []*x509.Certificate{candidate}
+		}
+	}
+	return nil
+}
+
+// ensureWorkloadCA returns the workload intermediate CA, issuing a new one if there is none yet or if
+// it expires too soon to issue certificates of the longest validity.
+func (caClient *CAClient) ensureWorkloadCA(ctx context.Context) (*internalCA, error) {
+	caClient.workload.mu.Lock()
+	defer caClient.workload.mu.Unlock()
+
+	current := caClient.workload.current
+	if current != nil && time.Until(current.Config.Certs[0].NotAfter) > workloadCARenewBefore {
+		return current, nil
+	}
+
+	publicKey, privateKey, err := fccrypto.NewKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("generating key pair: %w", err)
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", privateKey)
+	}
+	csrPEM, err := fccrypto.MakeCSR(signer, workloadCACommonName)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := caClient.caBackend.IssueRequestedCertificateAsX509(ctx, csr, int(workloadCAValidity.Seconds()),
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, withWorkloadCAConstraints(caClient.Cfg.DeviceWorkload))
+	if err != nil {
+		return nil, err
+	}
+	if !cert.IsCA || !hasWorkloadCAConstraints(cert, caClient.Cfg.DeviceWorkload) {
+		return nil, errors.New("the CA did not issue a name-constrained intermediate CA certificate")
+	}
+	if publicKey, ok := publicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !publicKey.Equal(cert.PublicKey) {
+		return nil, errors.New("the intermediate CA certificate does not match its key")
+	}
+
+	if current != nil {
+		caClient.workload.previous = current.Config.Certs[0]
+	}
+	caClient.workload.current = &internalCA{
+		Config:          &TLSCertificateConfig{Certs: []*x509.Certificate{cert}, Key: privateKey},
+		SerialGenerator: &oscrypto.RandomSerialGenerator{},
+	}
+	return caClient.workload.current, nil
+}
+
+// withWorkloadCAConstraints makes a certificate an intermediate CA that can only issue certificates
+// for names other than the reserved ones, within the allowed DNS suffixes if any.
+func withWorkloadCAConstraints(cfg *ca.DeviceWorkloadCfg) CertOption {
+	return func(cert *x509.Certificate) error {
+		cert.IsCA = true
+		cert.MaxPathLenZero = true
+		cert.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+		cert.PermittedDNSDomainsCritical = true
+		if cfg == nil {
+			return nil
+		}
+		cert.PermittedDNSDomains = cfg.AllowedDNSSuffixes
+		for _, name := range cfg.ReservedNames {
+			if ip := net.ParseIP(name); ip != nil {
+				if ip4 := ip.To4(); ip4 != nil {
+					ip = ip4
+				}
+				cert.ExcludedIPRanges = append(cert.ExcludedIPRanges, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+				continue
+			}
+			cert.ExcludedDNSDomains = append(cert.ExcludedDNSDomains, name)
+		}
+		return nil
+	}
+}
+
+// hasWorkloadCAConstraints reports whether an issued intermediate CA certificate kept the name
+// constraints it was requested with, as a remote CA may drop them.
+func hasWorkloadCAConstraints(cert *x509.Certificate, cfg *ca.DeviceWorkloadCfg) bool {
+	if cfg == nil {
+		return true
+	}
+	return len(cert.PermittedDNSDomains) == len(cfg.AllowedDNSSuffixes) &&
+		len(cert.ExcludedDNSDomains)+len(cert.ExcludedIPRanges) == len(cfg.ReservedNames)
+}
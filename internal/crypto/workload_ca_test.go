@@ -0,0 +1,92 @@
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorkloadCSR(t *testing.T, dnsNames []string, ipAddresses []net.IP) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "workload"},
+		DNSNames:    dnsNames,
+		IPAddresses: ipAddresses,
+	}, key)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(t, err)
+	return csr
+}
+
+func TestIssueRequestedWorkloadCertificate(t *testing.T) {
+	cfg := ca.NewDefault(t.TempDir())
+	cfg.DeviceWorkload = &ca.DeviceWorkloadCfg{
+		AllowedDNSSuffixes: []string{"example.com"},
+		ReservedNames:      []string{"api.example.com", "10.0.0.1"},
+	}
+	caClient, _, err := EnsureCA(cfg)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	for _, cert := range caClient.GetCABundleX509() {
+		roots.AddCert(cert)
+	}
+	verify := func(cert *x509.Certificate, name string, usage x509.ExtKeyUsage) error {
+		intermediates := x509.NewCertPool()
+		for _, intermediate := range caClient.IntermediatesFor(cert) {
+			intermediates.AddCert(intermediate)
+		}
+		_, err := cert.Verify(x509.VerifyOptions{DNSName: name, Roots: roots, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{usage}})
+		return err
+	}
+
+	tests := []struct {
+		name        string
+		dnsNames    []string
+		ipAddresses []net.IP
+		verifyName  string
+		usage       x509.ExtKeyUsage
+		wantErr     bool
+	}{
+		{"workload name", []string{"web.apps.example.com"}, nil, "web.apps.example.com", x509.ExtKeyUsageServerAuth, false},
+		{"not a client certificate", []string{"web.apps.example.com"}, nil, "", x509.ExtKeyUsageClientAuth, true},
+		{"name of the service", []string{"api.example.com"}, nil, "api.example.com", x509.ExtKeyUsageServerAuth, true},
+		{"subdomain of the service", []string{"web.api.example.com"}, nil, "web.api.example.com", x509.ExtKeyUsageServerAuth, true},
+		{"address of the service", nil, []net.IP{net.ParseIP("10.0.0.1")}, "10.0.0.1", x509.ExtKeyUsageServerAuth, true},
+		{"name outside the allowed suffixes", []string{"web.example.org"}, nil, "web.example.org", x509.ExtKeyUsageServerAuth, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert, err := caClient.IssueRequestedWorkloadCertificate(context.Background(), newTestWorkloadCSR(t, tt.dnsNames, tt.ipAddresses), 3600)
+			require.NoError(t, err)
+			require.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+			require.Len(t, caClient.IntermediatesFor(cert), 1)
+
+			err = verify(cert, tt.verifyName, tt.usage)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntermediatesForCertificatesOfTheCA(t *testing.T) {
+	caClient, _, err := EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(t, err)
+
+	cert, err := caClient.IssueRequestedServerCertificate(context.Background(), newTestCSR(t, "server"), 3600)
+	require.NoError(t, err)
+	require.Empty(t, caClient.IntermediatesFor(cert))
+}
@@ -10,6 +10,7 @@ type ComposeApplication = v1beta1.ComposeApplication
 type QuadletApplication = v1beta1.QuadletApplication
 type ContainerApplication = v1beta1.ContainerApplication
 type HelmApplication = v1beta1.HelmApplication
+type SystemdApplication = v1beta1.SystemdApplication
 type ImageApplicationProviderSpec = v1beta1.ImageApplicationProviderSpec
 type InlineApplicationProviderSpec = v1beta1.InlineApplicationProviderSpec
 
@@ -29,6 +30,43 @@ type ApplicationPort = v1beta1.ApplicationPort
 type ApplicationResources = v1beta1.ApplicationResources
 type ApplicationResourceLimits = v1beta1.ApplicationResourceLimits
 
+// ========== Application Dependencies ==========
+
+type ApplicationDependencies = v1beta1.ApplicationDependencies
+
+// ========== Application Host Access ==========
+
+type ApplicationHostAccess = v1beta1.ApplicationHostAccess
+
+// ========== Application Health Probes ==========
+
+type ApplicationHealthProbes = v1beta1.ApplicationHealthProbes
+type ApplicationProbe = v1beta1.ApplicationProbe
+type ApplicationProbeFailureAction = v1beta1.ApplicationProbeFailureAction
+type ApplicationProbeResult = v1beta1.ApplicationProbeResult
+type ApplicationProbeStatus = v1beta1.ApplicationProbeStatus
+type ApplicationProbesStatus = v1beta1.ApplicationProbesStatus
+type ExecProbe = v1beta1.ExecProbe
+type HttpGetProbe = v1beta1.HttpGetProbe
+type TcpSocketProbe = v1beta1.TcpSocketProbe
+
+const (
+	ApplicationProbeFailureActionNone    = v1beta1.ApplicationProbeFailureActionNone
+	ApplicationProbeFailureActionRestart = v1beta1.ApplicationProbeFailureActionRestart
+)
+
+const (
+	ApplicationProbeResultFailure = v1beta1.ApplicationProbeResultFailure
+	ApplicationProbeResultSuccess = v1beta1.ApplicationProbeResultSuccess
+	ApplicationProbeResultUnknown = v1beta1.ApplicationProbeResultUnknown
+)
+
+// ========== Application Resource Usage ==========
+
+type ApplicationResourceMonitors = v1beta1.ApplicationResourceMonitors
+type ApplicationResourceStatus = v1beta1.ApplicationResourceStatus
+type ApplicationResourceUsage = v1beta1.ApplicationResourceUsage
+
 // ========== Application Volume Types ==========
 
 type ApplicationVolume = v1beta1.ApplicationVolume
@@ -88,6 +126,7 @@ const (
 	AppTypeContainer = v1beta1.AppTypeContainer
 	AppTypeHelm      = v1beta1.AppTypeHelm
 	AppTypeQuadlet   = v1beta1.AppTypeQuadlet
+	AppTypeSystemd   = v1beta1.AppTypeSystemd
 )
 
 // ========== Image Pull Policy ==========
@@ -0,0 +1,17 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type AuditEvent = v1beta1.AuditEvent
+type AuditEventList = v1beta1.AuditEventList
+
+// ========== AuditEvent Enums ==========
+
+type AuditEventOutcome = v1beta1.AuditEventOutcome
+
+const (
+	AuditEventOutcomeSuccess = v1beta1.AuditEventOutcomeSuccess
+	AuditEventOutcomeFailure = v1beta1.AuditEventOutcomeFailure
+)
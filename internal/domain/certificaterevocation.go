@@ -0,0 +1,22 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type CertificateRevocation = v1beta1.CertificateRevocation
+type CertificateRevocationList = v1beta1.CertificateRevocationList
+type CertificateRevocationRequest = v1beta1.CertificateRevocationRequest
+
+// ========== Revocation Reasons ==========
+
+type CertificateRevocationReason = v1beta1.CertificateRevocationReason
+
+const (
+	CertificateRevocationReasonUnspecified          = v1beta1.CertificateRevocationReasonUnspecified
+	CertificateRevocationReasonKeyCompromise        = v1beta1.CertificateRevocationReasonKeyCompromise
+	CertificateRevocationReasonAffiliationChanged   = v1beta1.CertificateRevocationReasonAffiliationChanged
+	CertificateRevocationReasonSuperseded           = v1beta1.CertificateRevocationReasonSuperseded
+	CertificateRevocationReasonCessationOfOperation = v1beta1.CertificateRevocationReasonCessationOfOperation
+	CertificateRevocationReasonPrivilegeWithdrawn   = v1beta1.CertificateRevocationReasonPrivilegeWithdrawn
+)
@@ -39,6 +39,7 @@ const (
 	ConditionTypeResourceSyncAccessible               = v1beta1.ConditionTypeResourceSyncAccessible
 	ConditionTypeResourceSyncResourceParsed           = v1beta1.ConditionTypeResourceSyncResourceParsed
 	ConditionTypeResourceSyncSynced                   = v1beta1.ConditionTypeResourceSyncSynced
+	ConditionTypeSecretStoreAccessible                = v1beta1.ConditionTypeSecretStoreAccessible
 )
 
 // Condition utilities (re-exported)
@@ -88,10 +89,16 @@ const (
 	ResourceKindCatalog                   ResourceKind = "Catalog" // v1alpha1-only resource
 	ResourceKindCertificateSigningRequest              = v1beta1.ResourceKindCertificateSigningRequest
 	ResourceKindDevice                                 = v1beta1.ResourceKindDevice
+	ResourceKindDeviceBulkOperation                    = v1beta1.ResourceKindDeviceBulkOperation
+	ResourceKindEnrollmentApprovalPolicy               = v1beta1.ResourceKindEnrollmentApprovalPolicy
 	ResourceKindEnrollmentRequest                      = v1beta1.ResourceKindEnrollmentRequest
 	ResourceKindFleet                                  = v1beta1.ResourceKindFleet
 	ResourceKindRepository                             = v1beta1.ResourceKindRepository
 	ResourceKindResourceSync                           = v1beta1.ResourceKindResourceSync
+	ResourceKindRole                                   = v1beta1.ResourceKindRole
+	ResourceKindRoleBinding                            = v1beta1.ResourceKindRoleBinding
+	ResourceKindSecretStore                            = v1beta1.ResourceKindSecretStore
+	ResourceKindServiceAccount                         = v1beta1.ResourceKindServiceAccount
 	ResourceKindTemplateVersion                        = v1beta1.ResourceKindTemplateVersion
 )
 
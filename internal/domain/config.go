@@ -9,15 +9,19 @@ type GitConfigProviderSpec = v1beta1.GitConfigProviderSpec
 type HttpConfigProviderSpec = v1beta1.HttpConfigProviderSpec
 type InlineConfigProviderSpec = v1beta1.InlineConfigProviderSpec
 type KubernetesSecretProviderSpec = v1beta1.KubernetesSecretProviderSpec
+type OciConfigProviderSpec = v1beta1.OciConfigProviderSpec
+type SecretConfigProviderSpec = v1beta1.SecretConfigProviderSpec
 
 // ConfigProviderType discriminator type
 type ConfigProviderType = v1beta1.ConfigProviderType
 
 const (
-	GitConfigProviderType        = v1beta1.GitConfigProviderType
-	HttpConfigProviderType       = v1beta1.HttpConfigProviderType
-	InlineConfigProviderType     = v1beta1.InlineConfigProviderType
-	KubernetesSecretProviderType = v1beta1.KubernetesSecretProviderType
+	GitConfigProviderType         = v1beta1.GitConfigProviderType
+	HttpConfigProviderType        = v1beta1.HttpConfigProviderType
+	InlineConfigProviderType      = v1beta1.InlineConfigProviderType
+	KubernetesSecretProviderType  = v1beta1.KubernetesSecretProviderType
+	OciConfigProviderType         = v1beta1.OciConfigProviderType
+	SecretStoreConfigProviderType = v1beta1.SecretStoreConfigProviderType
 )
 
 // ========== File Types ==========
@@ -9,6 +9,13 @@ import (
 
 const APIGroup = v1beta1.APIGroup
 
+// ========== CertificateRevocation ==========
+
+const (
+	CertificateRevocationAPIVersion = v1beta1.CertificateRevocationAPIVersion
+	CertificateRevocationListKind   = v1beta1.CertificateRevocationListKind
+)
+
 // ========== CertificateSigningRequest ==========
 
 const (
@@ -27,15 +34,16 @@ const (
 
 // Device annotation keys
 const (
-	DeviceAnnotationConsole                 = v1beta1.DeviceAnnotationConsole
-	DeviceAnnotationRenderedVersion         = v1beta1.DeviceAnnotationRenderedVersion
-	DeviceAnnotationAwaitingReconnect       = v1beta1.DeviceAnnotationAwaitingReconnect
-	DeviceAnnotationConflictPaused          = v1beta1.DeviceAnnotationConflictPaused
-	DeviceAnnotationTemplateVersion         = v1beta1.DeviceAnnotationTemplateVersion
-	DeviceAnnotationRenderedTemplateVersion = v1beta1.DeviceAnnotationRenderedTemplateVersion
-	DeviceAnnotationRenderedSpecHash        = v1beta1.DeviceAnnotationRenderedSpecHash
-	DeviceAnnotationSelectedForRollout      = v1beta1.DeviceAnnotationSelectedForRollout
-	DeviceAnnotationLastRolloutError        = v1beta1.DeviceAnnotationLastRolloutError
+	DeviceAnnotationConsole                     = v1beta1.DeviceAnnotationConsole
+	DeviceAnnotationRenderedVersion             = v1beta1.DeviceAnnotationRenderedVersion
+	DeviceAnnotationAwaitingReconnect           = v1beta1.DeviceAnnotationAwaitingReconnect
+	DeviceAnnotationConflictPaused              = v1beta1.DeviceAnnotationConflictPaused
+	DeviceAnnotationTemplateVersion             = v1beta1.DeviceAnnotationTemplateVersion
+	DeviceAnnotationRenderedTemplateVersion     = v1beta1.DeviceAnnotationRenderedTemplateVersion
+	DeviceAnnotationRenderedSpecHash            = v1beta1.DeviceAnnotationRenderedSpecHash
+	DeviceAnnotationSelectedForRollout          = v1beta1.DeviceAnnotationSelectedForRollout
+	DeviceAnnotationLastRolloutError            = v1beta1.DeviceAnnotationLastRolloutError
+	DeviceAnnotationResourceSyncManagedMetadata = v1beta1.DeviceAnnotationResourceSyncManagedMetadata
 )
 
 const DeviceDisconnectedTimeout = v1beta1.DeviceDisconnectedTimeout
@@ -47,6 +55,16 @@ const (
 	EnrollmentRequestAPIVersion = v1beta1.EnrollmentRequestAPIVersion
 	EnrollmentRequestKind       = v1beta1.EnrollmentRequestKind
 	EnrollmentRequestListKind   = v1beta1.EnrollmentRequestListKind
+
+	EnrollmentRequestAnnotationEnrollmentCredential = v1beta1.EnrollmentRequestAnnotationEnrollmentCredential
+)
+
+// ========== EnrollmentApprovalPolicy ==========
+
+const (
+	EnrollmentApprovalPolicyAPIVersion = v1beta1.EnrollmentApprovalPolicyAPIVersion
+	EnrollmentApprovalPolicyKind       = v1beta1.EnrollmentApprovalPolicyKind
+	EnrollmentApprovalPolicyListKind   = v1beta1.EnrollmentApprovalPolicyListKind
 )
 
 // ========== Fleet ==========
@@ -78,6 +96,14 @@ const (
 	EventAnnotationDelayDeviceRender = v1beta1.EventAnnotationDelayDeviceRender
 )
 
+// ========== AuditEvent ==========
+
+const (
+	AuditEventAPIVersion = v1beta1.AuditEventAPIVersion
+	AuditEventKind       = v1beta1.AuditEventKind
+	AuditEventListKind   = v1beta1.AuditEventListKind
+)
+
 // ========== Repository ==========
 
 const (
@@ -86,6 +112,50 @@ const (
 	RepositoryListKind   = v1beta1.RepositoryListKind
 )
 
+// ========== SecretStore ==========
+
+const (
+	SecretStoreAPIVersion = v1beta1.SecretStoreAPIVersion
+	SecretStoreKind       = v1beta1.SecretStoreKind
+	SecretStoreListKind   = v1beta1.SecretStoreListKind
+)
+
+// ========== Role ==========
+
+const (
+	RoleAPIVersion = v1beta1.RoleAPIVersion
+	RoleKind       = v1beta1.RoleKind
+	RoleListKind   = v1beta1.RoleListKind
+)
+
+// ========== RoleBinding ==========
+
+const (
+	RoleBindingAPIVersion = v1beta1.RoleBindingAPIVersion
+	RoleBindingKind       = v1beta1.RoleBindingKind
+	RoleBindingListKind   = v1beta1.RoleBindingListKind
+)
+
+// ========== ServiceAccount ==========
+
+const (
+	ServiceAccountAPIVersion = v1beta1.ServiceAccountAPIVersion
+	ServiceAccountKind       = v1beta1.ServiceAccountKind
+	ServiceAccountListKind   = v1beta1.ServiceAccountListKind
+
+	ServiceAccountTokenAPIVersion = v1beta1.ServiceAccountTokenAPIVersion
+	ServiceAccountTokenKind       = v1beta1.ServiceAccountTokenKind
+	ServiceAccountTokenListKind   = v1beta1.ServiceAccountTokenListKind
+)
+
+// ========== DeviceBulkOperation ==========
+
+const (
+	DeviceBulkOperationAPIVersion = v1beta1.DeviceBulkOperationAPIVersion
+	DeviceBulkOperationKind       = v1beta1.DeviceBulkOperationKind
+	DeviceBulkOperationListKind   = v1beta1.DeviceBulkOperationListKind
+)
+
 // ========== AuthProvider ==========
 
 const (
@@ -224,9 +294,19 @@ const (
 	TPMChallengeSucceededReason = v1beta1.TPMChallengeSucceededReason
 )
 
+// ========== Enrollment Request Approval Reasons ==========
+
+const (
+	EnrollmentRequestManuallyApprovedReason = v1beta1.EnrollmentRequestManuallyApprovedReason
+	EnrollmentRequestPolicyApprovedReason   = v1beta1.EnrollmentRequestPolicyApprovedReason
+)
+
 // ========== ResourceSync Reasons ==========
 
-const ResourceSyncNewHashDetectedReason = v1beta1.ResourceSyncNewHashDetectedReason
+const (
+	ResourceSyncNewHashDetectedReason = v1beta1.ResourceSyncNewHashDetectedReason
+	ResourceSyncDryRunReason          = v1beta1.ResourceSyncDryRunReason
+)
 
 // ========== Device Text ==========
 
@@ -28,11 +28,22 @@ type DeviceLastSeen = v1beta1.DeviceLastSeen
 type DeviceOsStatus = v1beta1.DeviceOsStatus
 type DeviceSystemInfo = v1beta1.DeviceSystemInfo
 type CustomDeviceInfo = v1beta1.CustomDeviceInfo
+type DeviceSystemInfoExtensions = v1beta1.DeviceSystemInfoExtensions
+type DeviceCertificateStatus = v1beta1.DeviceCertificateStatus
 
 // ========== Spec Subtypes ==========
 
 type DeviceOsSpec = v1beta1.DeviceOsSpec
 type DeviceUpdatePolicySpec = v1beta1.DeviceUpdatePolicySpec
+type DeviceCertificateSpec = v1beta1.DeviceCertificateSpec
+type CertificateIssuerSpec = v1beta1.CertificateIssuerSpec
+type CertificateIssuerType = v1beta1.CertificateIssuerType
+type AcmeIssuerSpec = v1beta1.AcmeIssuerSpec
+type CertificateDestination = v1beta1.CertificateDestination
+type CertificateFileDestination = v1beta1.CertificateFileDestination
+type CertificateApplicationVolumeDestination = v1beta1.CertificateApplicationVolumeDestination
+type CertificateApplicationSecretDestination = v1beta1.CertificateApplicationSecretDestination
+type CertificateReloadSpec = v1beta1.CertificateReloadSpec
 
 // ========== Operations ==========
 
@@ -130,6 +141,13 @@ const (
 	DeviceLifecycleHookBeforeUpdating  = v1beta1.DeviceLifecycleHookBeforeUpdating
 )
 
+// ========== Certificate Issuer Constants ==========
+
+const (
+	CertificateIssuerTypeAcme      = v1beta1.CertificateIssuerTypeAcme
+	CertificateIssuerTypeFlightctl = v1beta1.CertificateIssuerTypeFlightctl
+)
+
 // ========== Event Details Types ==========
 
 type DeviceMultipleOwnersDetectedDetails = v1beta1.DeviceMultipleOwnersDetectedDetails
@@ -0,0 +1,29 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type DeviceBulkOperation = v1beta1.DeviceBulkOperation
+type DeviceBulkOperationList = v1beta1.DeviceBulkOperationList
+type DeviceBulkOperationSpec = v1beta1.DeviceBulkOperationSpec
+type DeviceBulkOperationStatus = v1beta1.DeviceBulkOperationStatus
+type DeviceBulkOperationFailure = v1beta1.DeviceBulkOperationFailure
+type DeviceBulkMetadataUpdate = v1beta1.DeviceBulkMetadataUpdate
+
+// ========== Enum Types ==========
+
+type DeviceBulkOperationType = v1beta1.DeviceBulkOperationType
+type DeviceBulkOperationPhase = v1beta1.DeviceBulkOperationPhase
+
+const (
+	DeviceBulkOperationTypeUpdateMetadata = v1beta1.DeviceBulkOperationTypeUpdateMetadata
+	DeviceBulkOperationTypeDecommission   = v1beta1.DeviceBulkOperationTypeDecommission
+	DeviceBulkOperationTypeResume         = v1beta1.DeviceBulkOperationTypeResume
+	DeviceBulkOperationTypeRerender       = v1beta1.DeviceBulkOperationTypeRerender
+
+	DeviceBulkOperationPhasePending   = v1beta1.DeviceBulkOperationPhasePending
+	DeviceBulkOperationPhaseRunning   = v1beta1.DeviceBulkOperationPhaseRunning
+	DeviceBulkOperationPhaseCompleted = v1beta1.DeviceBulkOperationPhaseCompleted
+	DeviceBulkOperationPhaseFailed    = v1beta1.DeviceBulkOperationPhaseFailed
+)
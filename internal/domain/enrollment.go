@@ -14,6 +14,14 @@ type EnrollmentRequestStatus = v1beta1.EnrollmentRequestStatus
 type EnrollmentRequestApproval = v1beta1.EnrollmentRequestApproval
 type EnrollmentRequestApprovalStatus = v1beta1.EnrollmentRequestApprovalStatus
 
+// ========== Enrollment Approval Policy ==========
+
+type EnrollmentApprovalPolicy = v1beta1.EnrollmentApprovalPolicy
+type EnrollmentApprovalPolicyList = v1beta1.EnrollmentApprovalPolicyList
+type EnrollmentApprovalPolicySpec = v1beta1.EnrollmentApprovalPolicySpec
+type EnrollmentApprovalPolicyMatch = v1beta1.EnrollmentApprovalPolicyMatch
+type EnrollmentApprovalAllowList = v1beta1.EnrollmentApprovalAllowList
+
 // ========== Enrollment Config ==========
 
 type EnrollmentConfig = v1beta1.EnrollmentConfig
@@ -34,9 +34,18 @@ const (
 
 // Event reason constants
 const (
+	EventReasonDeviceApplicationCPUCritical    = v1beta1.EventReasonDeviceApplicationCPUCritical
+	EventReasonDeviceApplicationCPUNormal      = v1beta1.EventReasonDeviceApplicationCPUNormal
+	EventReasonDeviceApplicationCPUWarning     = v1beta1.EventReasonDeviceApplicationCPUWarning
 	EventReasonDeviceApplicationDegraded       = v1beta1.EventReasonDeviceApplicationDegraded
 	EventReasonDeviceApplicationError          = v1beta1.EventReasonDeviceApplicationError
 	EventReasonDeviceApplicationHealthy        = v1beta1.EventReasonDeviceApplicationHealthy
+	EventReasonDeviceApplicationMemoryCritical = v1beta1.EventReasonDeviceApplicationMemoryCritical
+	EventReasonDeviceApplicationMemoryNormal   = v1beta1.EventReasonDeviceApplicationMemoryNormal
+	EventReasonDeviceApplicationMemoryWarning  = v1beta1.EventReasonDeviceApplicationMemoryWarning
+	EventReasonDeviceBulkOperationCompleted    = v1beta1.EventReasonDeviceBulkOperationCompleted
+	EventReasonDeviceBulkOperationFailed       = v1beta1.EventReasonDeviceBulkOperationFailed
+	EventReasonDeviceBulkOperationProgressed   = v1beta1.EventReasonDeviceBulkOperationProgressed
 	EventReasonDeviceCPUCritical               = v1beta1.EventReasonDeviceCPUCritical
 	EventReasonDeviceCPUNormal                 = v1beta1.EventReasonDeviceCPUNormal
 	EventReasonDeviceCPUWarning                = v1beta1.EventReasonDeviceCPUWarning
@@ -58,11 +67,13 @@ const (
 	EventReasonDeviceMemoryWarning             = v1beta1.EventReasonDeviceMemoryWarning
 	EventReasonDeviceMultipleOwnersDetected    = v1beta1.EventReasonDeviceMultipleOwnersDetected
 	EventReasonDeviceMultipleOwnersResolved    = v1beta1.EventReasonDeviceMultipleOwnersResolved
+	EventReasonDeviceRenderRequested           = v1beta1.EventReasonDeviceRenderRequested
 	EventReasonDeviceSpecInvalid               = v1beta1.EventReasonDeviceSpecInvalid
 	EventReasonDeviceSpecValid                 = v1beta1.EventReasonDeviceSpecValid
 	EventReasonDeviceUpdateFailed              = v1beta1.EventReasonDeviceUpdateFailed
 	EventReasonEnrollmentRequestApprovalFailed = v1beta1.EventReasonEnrollmentRequestApprovalFailed
 	EventReasonEnrollmentRequestApproved       = v1beta1.EventReasonEnrollmentRequestApproved
+	EventReasonFleetImageArchitectureMismatch  = v1beta1.EventReasonFleetImageArchitectureMismatch
 	EventReasonFleetInvalid                    = v1beta1.EventReasonFleetInvalid
 	EventReasonFleetRolloutBatchCompleted      = v1beta1.EventReasonFleetRolloutBatchCompleted
 	EventReasonFleetRolloutBatchDispatched     = v1beta1.EventReasonFleetRolloutBatchDispatched
@@ -75,8 +86,10 @@ const (
 	EventReasonInternalTaskFailed              = v1beta1.EventReasonInternalTaskFailed
 	EventReasonInternalTaskPermanentlyFailed   = v1beta1.EventReasonInternalTaskPermanentlyFailed
 	EventReasonReferencedRepositoryUpdated     = v1beta1.EventReasonReferencedRepositoryUpdated
+	EventReasonReferencedSecretUpdated         = v1beta1.EventReasonReferencedSecretUpdated
 	EventReasonRepositoryAccessible            = v1beta1.EventReasonRepositoryAccessible
 	EventReasonRepositoryInaccessible          = v1beta1.EventReasonRepositoryInaccessible
+	EventReasonRepositoryPushReceived          = v1beta1.EventReasonRepositoryPushReceived
 	EventReasonResourceCreated                 = v1beta1.EventReasonResourceCreated
 	EventReasonResourceCreationFailed          = v1beta1.EventReasonResourceCreationFailed
 	EventReasonResourceDeleted                 = v1beta1.EventReasonResourceDeleted
@@ -95,20 +108,26 @@ const (
 
 // ========== Event Details Types ==========
 
+type DeviceBulkOperationProgressedDetails = v1beta1.DeviceBulkOperationProgressedDetails
+type DeviceBulkOperationProgressedDetailsDetailType = v1beta1.DeviceBulkOperationProgressedDetailsDetailType
 type InternalTaskFailedDetails = v1beta1.InternalTaskFailedDetails
 type InternalTaskFailedDetailsDetailType = v1beta1.InternalTaskFailedDetailsDetailType
 type InternalTaskPermanentlyFailedDetails = v1beta1.InternalTaskPermanentlyFailedDetails
 type InternalTaskPermanentlyFailedDetailsDetailType = v1beta1.InternalTaskPermanentlyFailedDetailsDetailType
 type ReferencedRepositoryUpdatedDetails = v1beta1.ReferencedRepositoryUpdatedDetails
 type ReferencedRepositoryUpdatedDetailsDetailType = v1beta1.ReferencedRepositoryUpdatedDetailsDetailType
+type RepositoryPushReceivedDetails = v1beta1.RepositoryPushReceivedDetails
+type RepositoryPushReceivedDetailsDetailType = v1beta1.RepositoryPushReceivedDetailsDetailType
 type ResourceUpdatedDetails = v1beta1.ResourceUpdatedDetails
 type ResourceUpdatedDetailsDetailType = v1beta1.ResourceUpdatedDetailsDetailType
 type ResourceUpdatedDetailsUpdatedFields = v1beta1.ResourceUpdatedDetailsUpdatedFields
 
 const (
+	DeviceBulkOperationProgressed = v1beta1.DeviceBulkOperationProgressed
 	InternalTaskFailed            = v1beta1.InternalTaskFailed
 	InternalTaskPermanentlyFailed = v1beta1.InternalTaskPermanentlyFailed
 	ReferencedRepositoryUpdated   = v1beta1.ReferencedRepositoryUpdated
+	RepositoryPushReceived        = v1beta1.RepositoryPushReceived
 	ResourceUpdated               = v1beta1.ResourceUpdated
 
 	// Updated field constants with prefix (descriptive)
@@ -117,6 +136,7 @@ const (
 	UpdatedFieldSpec         = v1beta1.Spec
 	UpdatedFieldSpecSelector = v1beta1.SpecSelector
 	UpdatedFieldSpecTemplate = v1beta1.SpecTemplate
+	UpdatedFieldSystemInfo   = v1beta1.StatusSystemInfo
 
 	// Direct aliases for compatibility
 	Labels       = v1beta1.Labels
@@ -124,6 +144,7 @@ const (
 	Spec         = v1beta1.Spec
 	SpecSelector = v1beta1.SpecSelector
 	SpecTemplate = v1beta1.SpecTemplate
+	SystemInfo   = v1beta1.StatusSystemInfo
 )
 
 // ========== Utility Functions ==========
@@ -137,6 +158,10 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonEnrollmentRequestApprovalFailed: {},
 	EventReasonDeviceApplicationDegraded:       {},
 	EventReasonDeviceApplicationError:          {},
+	EventReasonDeviceApplicationCPUCritical:    {},
+	EventReasonDeviceApplicationCPUWarning:     {},
+	EventReasonDeviceApplicationMemoryCritical: {},
+	EventReasonDeviceApplicationMemoryWarning:  {},
 	EventReasonDeviceCPUCritical:               {},
 	EventReasonDeviceCPUWarning:                {},
 	EventReasonDeviceMemoryCritical:            {},
@@ -147,6 +172,7 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonDeviceConflictPaused:            {},
 	EventReasonDeviceSpecInvalid:               {},
 	EventReasonFleetInvalid:                    {},
+	EventReasonFleetImageArchitectureMismatch:  {},
 	EventReasonDeviceMultipleOwnersDetected:    {},
 	EventReasonDeviceUpdateFailed:              {},
 	EventReasonInternalTaskFailed:              {},
@@ -155,6 +181,7 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonResourceSyncParsingFailed:       {},
 	EventReasonResourceSyncSyncFailed:          {},
 	EventReasonFleetRolloutFailed:              {},
+	EventReasonDeviceBulkOperationFailed:       {},
 }
 
 // GetEventType determines the event type based on the event reason
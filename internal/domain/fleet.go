@@ -7,8 +7,15 @@ import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 type Fleet = v1beta1.Fleet
 type FleetList = v1beta1.FleetList
 type FleetSpec = v1beta1.FleetSpec
+type FleetFieldSelector = v1beta1.FleetFieldSelector
 type FleetStatus = v1beta1.FleetStatus
 
+// Fleet selector utilities (re-exported)
+var (
+	IsFleetSelectorField       = v1beta1.IsFleetSelectorField
+	FleetSelectorFieldsChanged = v1beta1.FleetSelectorFieldsChanged
+)
+
 // ========== Rollout Types ==========
 
 type RolloutPolicy = v1beta1.RolloutPolicy
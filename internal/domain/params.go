@@ -4,9 +4,13 @@ import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 
 // ========== List Params ==========
 
+type ListAuditEventsParams = v1beta1.ListAuditEventsParams
 type ListAuthProvidersParams = v1beta1.ListAuthProvidersParams
+type ListCertificateRevocationsParams = v1beta1.ListCertificateRevocationsParams
 type ListCertificateSigningRequestsParams = v1beta1.ListCertificateSigningRequestsParams
+type ListDeviceBulkOperationsParams = v1beta1.ListDeviceBulkOperationsParams
 type ListDevicesParams = v1beta1.ListDevicesParams
+type ListEnrollmentApprovalPoliciesParams = v1beta1.ListEnrollmentApprovalPoliciesParams
 type ListEnrollmentRequestsParams = v1beta1.ListEnrollmentRequestsParams
 type ListEventsParams = v1beta1.ListEventsParams
 type ListFleetsParams = v1beta1.ListFleetsParams
@@ -14,6 +18,10 @@ type ListLabelsParams = v1beta1.ListLabelsParams
 type ListOrganizationsParams = v1beta1.ListOrganizationsParams
 type ListRepositoriesParams = v1beta1.ListRepositoriesParams
 type ListResourceSyncsParams = v1beta1.ListResourceSyncsParams
+type ListRoleBindingsParams = v1beta1.ListRoleBindingsParams
+type ListRolesParams = v1beta1.ListRolesParams
+type ListSecretStoresParams = v1beta1.ListSecretStoresParams
+type ListServiceAccountsParams = v1beta1.ListServiceAccountsParams
 type ListTemplateVersionsParams = v1beta1.ListTemplateVersionsParams
 
 // ========== Get Params ==========
@@ -30,3 +38,10 @@ const (
 	Asc  = v1beta1.Asc
 	Desc = v1beta1.Desc
 )
+
+type ListAuditEventsParamsOrder = v1beta1.ListAuditEventsParamsOrder
+
+const (
+	AuditEventsOrderAsc  = v1beta1.AuditEventsOrderAsc
+	AuditEventsOrderDesc = v1beta1.AuditEventsOrderDesc
+)
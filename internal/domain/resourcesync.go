@@ -8,6 +8,8 @@ type ResourceSync = v1beta1.ResourceSync
 type ResourceSyncList = v1beta1.ResourceSyncList
 type ResourceSyncSpec = v1beta1.ResourceSyncSpec
 type ResourceSyncStatus = v1beta1.ResourceSyncStatus
+type ResourceSyncPlan = v1beta1.ResourceSyncPlan
+type ResourceSyncPlannedChange = v1beta1.ResourceSyncPlannedChange
 
 // ========== Event Details ==========
 
@@ -0,0 +1,24 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type Role = v1beta1.Role
+type RoleList = v1beta1.RoleList
+type RoleSpec = v1beta1.RoleSpec
+type RoleRule = v1beta1.RoleRule
+
+type RoleBinding = v1beta1.RoleBinding
+type RoleBindingList = v1beta1.RoleBindingList
+type RoleBindingSpec = v1beta1.RoleBindingSpec
+type RoleBindingSubject = v1beta1.RoleBindingSubject
+
+// ========== Subject Kinds ==========
+
+type RoleBindingSubjectKind = v1beta1.RoleBindingSubjectKind
+
+const (
+	RoleBindingSubjectKindUser  = v1beta1.RoleBindingSubjectKindUser
+	RoleBindingSubjectKindGroup = v1beta1.RoleBindingSubjectKindGroup
+)
@@ -0,0 +1,32 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type SecretStore = v1beta1.SecretStore
+type SecretStoreList = v1beta1.SecretStoreList
+type SecretStoreSpec = v1beta1.SecretStoreSpec
+type SecretStoreStatus = v1beta1.SecretStoreStatus
+type SecretStoreTlsConfig = v1beta1.SecretStoreTlsConfig
+
+// ========== Key/Value Engine Version ==========
+
+type SecretStoreSpecKvVersion = v1beta1.SecretStoreSpecKvVersion
+
+const (
+	SecretStoreKvVersion1 = v1beta1.SecretStoreKvVersion1
+	SecretStoreKvVersion2 = v1beta1.SecretStoreKvVersion2
+)
+
+// ========== Auth Types ==========
+
+type SecretStoreAuth = v1beta1.SecretStoreAuth
+type SecretStoreAuthMethod = v1beta1.SecretStoreAuthMethod
+type TokenSecretStoreAuth = v1beta1.TokenSecretStoreAuth
+type AppRoleSecretStoreAuth = v1beta1.AppRoleSecretStoreAuth
+
+const (
+	SecretStoreAuthMethodToken   = v1beta1.SecretStoreAuthMethodToken
+	SecretStoreAuthMethodAppRole = v1beta1.SecretStoreAuthMethodAppRole
+)
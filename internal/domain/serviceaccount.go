@@ -0,0 +1,14 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type ServiceAccount = v1beta1.ServiceAccount
+type ServiceAccountList = v1beta1.ServiceAccountList
+type ServiceAccountSpec = v1beta1.ServiceAccountSpec
+
+type ServiceAccountToken = v1beta1.ServiceAccountToken
+type ServiceAccountTokenList = v1beta1.ServiceAccountTokenList
+type ServiceAccountTokenSpec = v1beta1.ServiceAccountTokenSpec
+type ServiceAccountTokenStatus = v1beta1.ServiceAccountTokenStatus
@@ -1,11 +1,12 @@
 package identity
 
 const (
-	AuthTypeK8s       = "k8s"
-	AuthTypeOIDC      = "OIDC"
-	AuthTypeOAuth2    = "OAuth2"
-	AuthTypeAAP       = "AAPGateway"
-	AuthTypeOpenShift = "openshift"
+	AuthTypeK8s            = "k8s"
+	AuthTypeOIDC           = "OIDC"
+	AuthTypeOAuth2         = "OAuth2"
+	AuthTypeAAP            = "AAPGateway"
+	AuthTypeOpenShift      = "openshift"
+	AuthTypeServiceAccount = "ServiceAccount"
 )
 
 // Issuer represents the source that produced an identity
@@ -317,6 +317,14 @@ func (siw *ServerInterfaceWrapper) GetImageBuildLog(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "architecture" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "architecture", r.URL.Query(), &params.Architecture)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "architecture", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetImageBuildLog(w, r, name, params)
 	}))
@@ -16,6 +16,7 @@ type ImageBuildSource = api.ImageBuildSource
 type ImageBuildDestination = api.ImageBuildDestination
 type ImageBuildBinding = api.ImageBuildBinding
 type ImageBuildUserConfiguration = api.ImageBuildUserConfiguration
+type ImageBuildArchitecture = api.ImageBuildArchitecture
 
 // ========== Status Types ==========
 
@@ -23,6 +24,7 @@ type ImageBuildStatus = api.ImageBuildStatus
 type ImageBuildCondition = api.ImageBuildCondition
 type ImageBuildConditionType = api.ImageBuildConditionType
 type ImageBuildConditionReason = api.ImageBuildConditionReason
+type ImageBuildArchitectureStatus = api.ImageBuildArchitectureStatus
 
 // ========== Binding Types ==========
 
@@ -41,6 +43,16 @@ const (
 	Late             = api.Late
 )
 
+// ========== Architecture Constants ==========
+
+const (
+	ImageBuildArchitectureAmd64   = api.ImageBuildArchitectureAmd64
+	ImageBuildArchitectureArm64   = api.ImageBuildArchitectureArm64
+	ImageBuildArchitecturePpc64le = api.ImageBuildArchitecturePpc64le
+	ImageBuildArchitectureS390x   = api.ImageBuildArchitectureS390x
+	DefaultImageBuildArchitecture = api.DefaultImageBuildArchitecture
+)
+
 // ========== Condition Type Constants ==========
 
 const (
@@ -104,3 +116,23 @@ func FindImageBuildStatusCondition(conditions []ImageBuildCondition, conditionTy
 func SetImageBuildStatusCondition(conditions *[]ImageBuildCondition, newCondition ImageBuildCondition) bool {
 	return api.SetImageBuildStatusCondition(conditions, newCondition)
 }
+
+// FindImageBuildArchitectureStatus finds the status entry for arch in the given slice
+func FindImageBuildArchitectureStatus(statuses []ImageBuildArchitectureStatus, arch ImageBuildArchitecture) *ImageBuildArchitectureStatus {
+	return api.FindImageBuildArchitectureStatus(statuses, arch)
+}
+
+// SetImageBuildArchitectureCondition sets newCondition on the status entry for arch
+func SetImageBuildArchitectureCondition(statuses *[]ImageBuildArchitectureStatus, arch ImageBuildArchitecture, newCondition ImageBuildCondition, manifestDigest *string) {
+	api.SetImageBuildArchitectureCondition(statuses, arch, newCondition, manifestDigest)
+}
+
+// ArchitectureLogPrefix returns the log line prefix used for the given architecture
+func ArchitectureLogPrefix(arch ImageBuildArchitecture) string {
+	return api.ArchitectureLogPrefix(arch)
+}
+
+// FilterArchitectureLogs returns only the log lines produced for the given architecture
+func FilterArchitectureLogs(logs string, arch ImageBuildArchitecture) string {
+	return api.FilterArchitectureLogs(logs, arch)
+}
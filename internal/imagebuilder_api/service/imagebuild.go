@@ -551,6 +551,7 @@ func (s *imageBuildService) validate(ctx context.Context, orgId uuid.UUID, image
 	}
 	errs = append(errs, ValidateImageName(&imageBuild.Spec.Destination.ImageName, "spec.destination.imageName")...)
 	errs = append(errs, ValidateImageTag(&imageBuild.Spec.Destination.ImageTag, "spec.destination.imageTag")...)
+	errs = append(errs, ValidateArchitectures(imageBuild.Spec.Architectures, "spec.architectures")...)
 
 	// Validate userConfiguration if provided
 	if imageBuild.Spec.UserConfiguration != nil {
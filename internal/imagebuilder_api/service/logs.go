@@ -159,3 +159,60 @@ func (r *redisLogStreamReader) Stream(ctx context.Context, w io.Writer) error {
 		}
 	}
 }
+
+// architectureLogStreamReader wraps a LogStreamReader and only returns the log lines
+// produced while building a single architecture of a multi-architecture ImageBuild
+type architectureLogStreamReader struct {
+	reader       LogStreamReader
+	architecture domain.ImageBuildArchitecture
+}
+
+// NewArchitectureLogStreamReader returns a LogStreamReader that filters reader to the given architecture
+func NewArchitectureLogStreamReader(reader LogStreamReader, architecture domain.ImageBuildArchitecture) LogStreamReader {
+	return &architectureLogStreamReader{
+		reader:       reader,
+		architecture: architecture,
+	}
+}
+
+// ReadAll reads all available logs and returns only the lines for the architecture
+func (r *architectureLogStreamReader) ReadAll(ctx context.Context) (string, error) {
+	logs, err := r.reader.ReadAll(ctx)
+	if err != nil {
+		return "", err
+	}
+	return domain.FilterArchitectureLogs(logs, r.architecture), nil
+}
+
+// Stream streams only the lines for the architecture to the writer
+func (r *architectureLogStreamReader) Stream(ctx context.Context, w io.Writer) error {
+	return r.reader.Stream(ctx, &architectureLogWriter{w: w, architecture: r.architecture})
+}
+
+// architectureLogWriter filters each write down to the lines for a single architecture.
+// Each write is expected to contain whole lines, which is how log entries are stored.
+type architectureLogWriter struct {
+	w            io.Writer
+	architecture domain.ImageBuildArchitecture
+}
+
+func (w *architectureLogWriter) Write(p []byte) (int, error) {
+	filtered := string(p)
+	// The completion marker is not prefixed and must always reach the client
+	if filtered != domain.LogStreamCompleteMarker {
+		filtered = domain.FilterArchitectureLogs(filtered, w.architecture)
+	}
+	if filtered != "" {
+		if _, err := w.w.Write([]byte(filtered)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush forwards flushes to the underlying writer if it supports them
+func (w *architectureLogWriter) Flush() {
+	if flusher, ok := w.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
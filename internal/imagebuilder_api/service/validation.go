@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -77,6 +78,40 @@ func fieldPathFor(path string) *field.Path {
 	return field.NewPath(fields[0], fields[1:]...)
 }
 
+// ValidateArchitectures validates the list of architectures requested for an ImageBuild.
+// The list is optional, but if set it must:
+// - Not be empty
+// - Only contain architectures supported by the image builder
+// - Not contain duplicates
+func ValidateArchitectures(architectures *[]domain.ImageBuildArchitecture, path string) []error {
+	if architectures == nil {
+		return nil
+	}
+	if len(*architectures) == 0 {
+		return []error{field.Required(fieldPathFor(path), "must list at least one architecture when set")}
+	}
+
+	var errs []error
+	seen := make(map[domain.ImageBuildArchitecture]struct{}, len(*architectures))
+	for i, arch := range *architectures {
+		if !arch.IsValid() {
+			errs = append(errs, field.NotSupported(fieldPathFor(path).Index(i), arch, []string{
+				string(domain.ImageBuildArchitectureAmd64),
+				string(domain.ImageBuildArchitectureArm64),
+				string(domain.ImageBuildArchitecturePpc64le),
+				string(domain.ImageBuildArchitectureS390x),
+			}))
+			continue
+		}
+		if _, ok := seen[arch]; ok {
+			errs = append(errs, field.Duplicate(fieldPathFor(path).Index(i), arch))
+			continue
+		}
+		seen[arch] = struct{}{}
+	}
+	return errs
+}
+
 const (
 	// Maximum length for username (reasonable limit for system usernames)
 	usernameMaxLength int = 256
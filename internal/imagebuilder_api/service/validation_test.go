@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1068,3 +1069,53 @@ func TestValidatePublicKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateArchitectures(t *testing.T) {
+	tests := []struct {
+		name          string
+		architectures *[]domain.ImageBuildArchitecture
+		wantErr       bool
+	}{
+		{
+			name:          "nil defaults to amd64",
+			architectures: nil,
+			wantErr:       false,
+		},
+		{
+			name:          "single architecture",
+			architectures: &[]domain.ImageBuildArchitecture{domain.ImageBuildArchitectureArm64},
+			wantErr:       false,
+		},
+		{
+			name:          "multiple architectures",
+			architectures: &[]domain.ImageBuildArchitecture{domain.ImageBuildArchitectureAmd64, domain.ImageBuildArchitectureArm64},
+			wantErr:       false,
+		},
+		{
+			name:          "empty list",
+			architectures: &[]domain.ImageBuildArchitecture{},
+			wantErr:       true,
+		},
+		{
+			name:          "unsupported architecture",
+			architectures: &[]domain.ImageBuildArchitecture{"x86_64"},
+			wantErr:       true,
+		},
+		{
+			name:          "duplicate architecture",
+			architectures: &[]domain.ImageBuildArchitecture{domain.ImageBuildArchitectureArm64, domain.ImageBuildArchitectureArm64},
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateArchitectures(tt.architectures, "spec.architectures")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors for %v", tt.architectures)
+			} else {
+				assert.Empty(t, errs, "expected no validation errors, got: %v", errs)
+			}
+		})
+	}
+}
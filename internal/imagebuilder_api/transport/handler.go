@@ -136,6 +136,18 @@ func (h *TransportHandler) GetImageBuildLog(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// Restrict the output to a single architecture of a multi-architecture build
+	if params.Architecture != nil {
+		if !params.Architecture.IsValid() {
+			h.SetResponse(w, nil, service.StatusBadRequest(fmt.Sprintf("unsupported architecture %q", *params.Architecture)))
+			return
+		}
+		if reader != nil {
+			reader = service.NewArchitectureLogStreamReader(reader, *params.Architecture)
+		}
+		logs = domain.FilterArchitectureLogs(logs, *params.Architecture)
+	}
+
 	// If we have a reader (active build with follow=true), stream via SSE
 	if reader != nil {
 		// Set SSE headers
@@ -5,6 +5,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -143,6 +144,7 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 		imageBuild.Status.Conditions = &[]domain.ImageBuildCondition{}
 	}
 	domain.SetImageBuildStatusCondition(imageBuild.Status.Conditions, buildingCondition)
+	initArchitectureStatuses(imageBuild, now)
 
 	// Synchronously update status to Building - this will fail if resource_version changed
 	_, err = c.imageBuilderService.ImageBuild().UpdateStatus(ctx, orgID, imageBuild)
@@ -234,6 +236,7 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 
 	// Update ImageBuild status with the pushed image reference and mark as Completed
 	statusUpdater.UpdateImageReference(imageRef)
+	reportManifestDigests(buildCtx, imageBuild, imageRef, podmanWorker, statusUpdater, log)
 
 	// Mark as Completed
 	now = time.Now().UTC()
@@ -525,11 +528,15 @@ type podmanWorker struct {
 }
 
 // statusWriter is a thread-safe writer that captures output to a buffer
-// and streams it to the status updater for progress tracking
+// and streams it to the status updater for progress tracking.
+// If logPrefix is set, every line streamed to the updater is prefixed with it; partial
+// lines are held back until they are complete so that prefixes never split a line.
 type statusWriter struct {
 	mu            sync.Mutex
 	buf           *bytes.Buffer
 	statusUpdater *statusUpdater
+	logPrefix     string
+	partial       []byte
 }
 
 // Write implements io.Writer to handle the stream safely
@@ -542,11 +549,50 @@ func (w *statusWriter) Write(p []byte) (n int, err error) {
 
 	// 2. Stream to updater
 	if w.statusUpdater != nil {
-		w.statusUpdater.ReportOutput(p)
+		if w.logPrefix == "" {
+			w.statusUpdater.ReportOutput(p)
+		} else if out := w.prefixCompleteLines(p); len(out) > 0 {
+			w.statusUpdater.ReportOutput(out)
+		}
 	}
 	return len(p), nil
 }
 
+// Flush streams any held back partial line to the status updater
+func (w *statusWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.statusUpdater == nil || len(w.partial) == 0 {
+		return
+	}
+	out := append([]byte(w.logPrefix), w.partial...)
+	w.partial = nil
+	w.statusUpdater.ReportOutput(append(out, '\n'))
+}
+
+// prefixCompleteLines returns the complete lines in the held back partial line plus p,
+// each prefixed with logPrefix, and keeps the trailing incomplete line for later
+func (w *statusWriter) prefixCompleteLines(p []byte) []byte {
+	data := append(w.partial, p...)
+	lastNewline := bytes.LastIndexByte(data, '\n')
+	if lastNewline < 0 {
+		w.partial = data
+		return nil
+	}
+	w.partial = append([]byte(nil), data[lastNewline+1:]...)
+
+	var out bytes.Buffer
+	for _, line := range bytes.SplitAfter(data[:lastNewline+1], []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		out.WriteString(w.logPrefix)
+		out.Write(line)
+	}
+	return out.Bytes()
+}
+
 // validateImageRefComponents validates the components of an image reference
 // This is a defense-in-depth check - user input is already validated at the service layer
 func validateImageRefComponents(imageName, imageTag string) error {
@@ -563,6 +609,12 @@ func validateImageRefComponents(imageName, imageTag string) error {
 // It streams output to the status updater to track progress
 // envVars is a map of environment variable names to values (e.g., {"REGISTRY_AUTH_FILE": "/build/auth.json"})
 func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger, phaseName string, envVars map[string]string, args ...string) error {
+	return w.runInWorkerWithLogPrefix(ctx, log, phaseName, "", envVars, args...)
+}
+
+// runInWorkerWithLogPrefix is like runInWorker, but prefixes every output line streamed to the
+// status updater with logPrefix so that the output of concurrent phases can be told apart
+func (w *podmanWorker) runInWorkerWithLogPrefix(ctx context.Context, log logrus.FieldLogger, phaseName string, logPrefix string, envVars map[string]string, args ...string) error {
 	// We use "podman exec" to run inside the running container
 	execArgs := []string{"exec"}
 
@@ -583,6 +635,7 @@ func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger,
 	writer := &statusWriter{
 		buf:           &outputBuffer,
 		statusUpdater: w.statusUpdater,
+		logPrefix:     logPrefix,
 	}
 
 	// Assign the SAME writer to both stdout and stderr.
@@ -590,7 +643,9 @@ func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger,
 	cmd.Stderr = writer
 
 	// Run() handles the starting, streaming, and waiting automatically.
-	if err := cmd.Run(); err != nil {
+	err := cmd.Run()
+	writer.Flush()
+	if err != nil {
 		output := outputBuffer.String()
 		log.Debugf("%s output:\n%s", phaseName, output)
 		return fmt.Errorf("%s failed: %w. Output: %s", phaseName, err, output)
@@ -661,7 +716,6 @@ ignore_chown_errors = "true"
 
 	// Container paths
 	containerBuildDir := "/build"
-	containerOutDir := "/output"
 	containerStorageDir := "/var/lib/containers"
 
 	// Generate a unique container name so we can reference it easily
@@ -899,8 +953,45 @@ func writeBuildContextFiles(tmpDir string, containerfileResult *ContainerfileRes
 	return nil
 }
 
+// initArchitectureStatuses seeds the per-architecture status of the ImageBuild with a Building
+// condition for every architecture in the spec. For single-architecture builds the deprecated
+// status.architecture field is also set for backward compatibility.
+func initArchitectureStatuses(imageBuild *domain.ImageBuild, now time.Time) {
+	architectures := imageBuild.Spec.GetArchitectures()
+	statuses := make([]domain.ImageBuildArchitectureStatus, 0, len(architectures))
+	for _, arch := range architectures {
+		domain.SetImageBuildArchitectureCondition(&statuses, arch, domain.ImageBuildCondition{
+			Type:               domain.ImageBuildConditionTypeReady,
+			Status:             domain.ConditionStatusFalse,
+			Reason:             string(domain.ImageBuildConditionReasonPending),
+			Message:            "Waiting for build to start",
+			LastTransitionTime: now,
+		}, nil)
+	}
+	imageBuild.Status.Architectures = &statuses
+	if len(architectures) == 1 {
+		imageBuild.Status.Architecture = lo.ToPtr(string(architectures[0]))
+	} else {
+		imageBuild.Status.Architecture = nil
+	}
+}
+
+// reportArchitectureCondition reports the Ready condition of a single architecture to the status updater
+func (w *podmanWorker) reportArchitectureCondition(arch domain.ImageBuildArchitecture, status domain.ConditionStatus, reason domain.ImageBuildConditionReason, message string, manifestDigest *string) {
+	if w.statusUpdater == nil {
+		return
+	}
+	w.statusUpdater.UpdateArchitectureCondition(arch, domain.ImageBuildCondition{
+		Type:               domain.ImageBuildConditionTypeReady,
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: time.Now().UTC(),
+	}, manifestDigest)
+}
+
 // buildImageWithPodman builds the image using podman in a container-in-container setup.
-// It creates a manifest list, builds for AMD64 platform, and handles authentication.
+// It creates a manifest list, builds one image per requested architecture into it, and handles authentication.
 func (c *Consumer) buildImageWithPodman(
 	ctx context.Context,
 	orgID uuid.UUID,
@@ -931,15 +1022,11 @@ func (c *Consumer) buildImageWithPodman(
 	destRegistryHostname := destOciSpec.Registry
 	imageRef := fmt.Sprintf("%s/%s:%s", destRegistryHostname, spec.Destination.ImageName, spec.Destination.ImageTag)
 
-	// Determine platform from ImageBuild status architecture, default to linux/amd64
-	platform := "linux/amd64"
-	if imageBuild.Status != nil && imageBuild.Status.Architecture != nil && *imageBuild.Status.Architecture != "" {
-		platform = *imageBuild.Status.Architecture
-	}
+	architectures := spec.GetArchitectures()
 
 	log.WithFields(logrus.Fields{
-		"imageRef": imageRef,
-		"platform": platform,
+		"imageRef":      imageRef,
+		"architectures": architectures,
 	}).Info("Starting podman build")
 
 	// Write build context files (Containerfile, agent-config.yaml, user-publickey.txt)
@@ -953,9 +1040,6 @@ func (c *Consumer) buildImageWithPodman(
 		return err
 	}
 
-	// Container paths
-	containerBuildDir := "/build"
-
 	// ociSpec.Registry is already the hostname (no scheme)
 	sourceRegistryHostname := ociSpec.Registry
 
@@ -970,8 +1054,6 @@ func (c *Consumer) buildImageWithPodman(
 		}
 	}
 
-	containerContainerfilePath := filepath.Join(containerBuildDir, "Containerfile")
-
 	// ---------------------------------------------------------
 	// PHASE 1: BUILD (Manifest + Build)
 	// ---------------------------------------------------------
@@ -986,13 +1068,50 @@ func (c *Consumer) buildImageWithPodman(
 		log.Debug("Manifest list already exists, continuing")
 	}
 
-	// B. Build
-	// Authentication is handled via podman login above
+	// B. Build one image per architecture into the manifest list
+	for _, arch := range architectures {
+		if err := c.buildArchitectureWithPodman(ctx, arch, imageRef, ociSpec, containerfileResult, podmanWorker, spec.IsMultiArch(), log); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Phase: Build Completed")
+
+	return nil
+}
+
+// buildArchitectureWithPodman builds the image for a single architecture and adds it to the
+// manifest list imageRef. For multi-architecture builds the output is prefixed with the
+// architecture so that logs can be filtered per architecture.
+func (c *Consumer) buildArchitectureWithPodman(
+	ctx context.Context,
+	arch domain.ImageBuildArchitecture,
+	imageRef string,
+	ociSpec *coredomain.OciRepoSpec,
+	containerfileResult *ContainerfileResult,
+	podmanWorker *podmanWorker,
+	multiArch bool,
+	log logrus.FieldLogger,
+) error {
+	log = log.WithField("architecture", arch)
+	log.Info("Building architecture")
+	podmanWorker.reportArchitectureCondition(arch, domain.ConditionStatusFalse, domain.ImageBuildConditionReasonBuilding, "Build is in progress", nil)
+
+	logPrefix := ""
+	if multiArch {
+		logPrefix = domain.ArchitectureLogPrefix(arch)
+	}
+
+	// Container paths
+	containerBuildDir := "/build"
+	containerContainerfilePath := filepath.Join(containerBuildDir, "Containerfile")
+
+	// Authentication is handled via podman login
 	// Build arguments are passed via --build-arg for safer execution (no shell injection risk)
 	args := containerfileResult.BuildArgs
 	podmanBuildArgs := []string{
 		"build",
-		"--platform", platform,
+		"--platform", arch.Platform(),
 		"--manifest", imageRef,
 	}
 
@@ -1028,12 +1147,13 @@ func (c *Consumer) buildImageWithPodman(
 		containerBuildDir,
 	)
 
-	if err := podmanWorker.runInWorker(ctx, log, "build", nil, podmanBuildArgs...); err != nil {
+	if err := podmanWorker.runInWorkerWithLogPrefix(ctx, log, fmt.Sprintf("build (%s)", arch), logPrefix, nil, podmanBuildArgs...); err != nil {
+		podmanWorker.reportArchitectureCondition(arch, domain.ConditionStatusFalse, domain.ImageBuildConditionReasonFailed, err.Error(), nil)
 		return err
 	}
 
-	log.Info("Phase: Build Completed")
-
+	podmanWorker.reportArchitectureCondition(arch, domain.ConditionStatusFalse, domain.ImageBuildConditionReasonPushing, "Build completed, waiting for push", nil)
+	log.Info("Architecture build completed")
 	return nil
 }
 
@@ -1098,6 +1218,9 @@ func (c *Consumer) pushImageWithPodman(
 		log.Debug("Using --tls-verify=false due to SkipServerVerification")
 	}
 
+	// Record the digest of the pushed manifest (list) so it can be reported in the status
+	pushArgs = append(pushArgs, "--digestfile", filepath.Join(containerOutDir, pushDigestFile))
+
 	pushArgs = append(pushArgs, imageRef)
 	if err := podmanWorker.runInWorker(ctx, log, "push", nil, pushArgs...); err != nil {
 		return "", err
@@ -1107,3 +1230,62 @@ func (c *Consumer) pushImageWithPodman(
 
 	return imageRef, nil
 }
+
+// pushDigestFile is the name of the file in the worker's output directory that podman push
+// writes the digest of the pushed manifest (list) to
+const pushDigestFile = "push-digest"
+
+// containerOutDir is the path of the worker's output directory inside the worker container
+const containerOutDir = "/output"
+
+// manifestListEntry is the subset of a manifest list entry needed to report per-architecture digests
+type manifestListEntry struct {
+	Digest   string `json:"digest"`
+	Platform struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform"`
+}
+
+// reportManifestDigests reports the digest of the pushed manifest list and the digest of the image
+// built for each architecture, and marks each architecture as Completed.
+// Failures to read the digests are logged but do not fail the build since the image has been pushed.
+func reportManifestDigests(
+	ctx context.Context,
+	imageBuild *domain.ImageBuild,
+	imageRef string,
+	podmanWorker *podmanWorker,
+	statusUpdater *statusUpdater,
+	log logrus.FieldLogger,
+) {
+	digestPath := filepath.Join(podmanWorker.TmpOutDir, pushDigestFile)
+	if digest, err := os.ReadFile(digestPath); err != nil {
+		log.WithError(err).Warn("Failed to read pushed manifest digest")
+	} else if d := strings.TrimSpace(string(digest)); d != "" {
+		statusUpdater.UpdateManifestDigest(d)
+	}
+
+	digests := map[string]string{}
+	out, err := exec.CommandContext(ctx, "podman", "exec", podmanWorker.ContainerName, "podman", "manifest", "inspect", imageRef).Output()
+	if err != nil {
+		log.WithError(err).Warn("Failed to inspect manifest list")
+	} else {
+		var manifestList struct {
+			Manifests []manifestListEntry `json:"manifests"`
+		}
+		if err := json.Unmarshal(out, &manifestList); err != nil {
+			log.WithError(err).Warn("Failed to parse manifest list")
+		}
+		for _, m := range manifestList.Manifests {
+			digests[m.Platform.Architecture] = m.Digest
+		}
+	}
+
+	for _, arch := range imageBuild.Spec.GetArchitectures() {
+		var digest *string
+		if d, ok := digests[string(arch)]; ok {
+			digest = lo.ToPtr(d)
+		}
+		podmanWorker.reportArchitectureCondition(arch, domain.ConditionStatusTrue, domain.ImageBuildConditionReasonCompleted, "Build completed successfully", digest)
+	}
+}
@@ -15,11 +15,20 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// architectureStatusUpdate represents an update of the status of a single architecture
+type architectureStatusUpdate struct {
+	Architecture   domain.ImageBuildArchitecture
+	Condition      domain.ImageBuildCondition
+	ManifestDigest *string
+}
+
 // statusUpdateRequest represents a request to update the ImageBuild status
 type statusUpdateRequest struct {
-	Condition      *domain.ImageBuildCondition
-	LastSeen       *time.Time
-	ImageReference *string
+	Condition          *domain.ImageBuildCondition
+	LastSeen           *time.Time
+	ImageReference     *string
+	ManifestDigest     *string
+	ArchitectureUpdate *architectureStatusUpdate
 	// done is closed when the update has been processed (used for terminal conditions)
 	done chan struct{}
 }
@@ -111,6 +120,8 @@ func (u *statusUpdater) run(cfg *config.Config) {
 	// Track pending updates
 	var pendingCondition *domain.ImageBuildCondition
 	var pendingImageReference *string
+	var pendingManifestDigest *string
+	var pendingArchitectureUpdates []architectureStatusUpdate
 	lastSeenUpdateTime := time.Now().UTC()
 
 	// Track the last time output was received - updated when new output arrives
@@ -138,11 +149,13 @@ func (u *statusUpdater) run(cfg *config.Config) {
 					// Store a copy of the time we're setting
 					lastSetLastSeenCopy := *lastOutputTime
 					lastSetLastSeen = &lastSetLastSeenCopy
-					u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference)
+					u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest, pendingArchitectureUpdates)
 					// Also persist logs to DB periodically
 					u.persistLogsToDB()
-					pendingCondition = nil      // Clear after update
-					pendingImageReference = nil // Clear after update
+					pendingCondition = nil           // Clear after update
+					pendingImageReference = nil      // Clear after update
+					pendingManifestDigest = nil      // Clear after update
+					pendingArchitectureUpdates = nil // Clear after update
 				}
 			}
 		case output := <-u.outputChan:
@@ -167,11 +180,19 @@ func (u *statusUpdater) run(cfg *config.Config) {
 			if req.ImageReference != nil {
 				pendingImageReference = req.ImageReference
 			}
-			// Update immediately when condition or image reference changes
-			if req.Condition != nil || req.ImageReference != nil {
-				u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference)
-				pendingCondition = nil      // Clear after update
-				pendingImageReference = nil // Clear after update
+			if req.ManifestDigest != nil {
+				pendingManifestDigest = req.ManifestDigest
+			}
+			if req.ArchitectureUpdate != nil {
+				pendingArchitectureUpdates = append(pendingArchitectureUpdates, *req.ArchitectureUpdate)
+			}
+			// Update immediately when condition, image reference, manifest digest or architecture status changes
+			if req.Condition != nil || req.ImageReference != nil || req.ManifestDigest != nil || req.ArchitectureUpdate != nil {
+				u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest, pendingArchitectureUpdates)
+				pendingCondition = nil           // Clear after update
+				pendingImageReference = nil      // Clear after update
+				pendingManifestDigest = nil      // Clear after update
+				pendingArchitectureUpdates = nil // Clear after update
 			}
 			// Signal completion if done channel exists (used for synchronous updates)
 			if req.done != nil {
@@ -181,12 +202,13 @@ func (u *statusUpdater) run(cfg *config.Config) {
 	}
 }
 
-// updateStatus performs the actual database update, merging conditions, LastSeen, and ImageReference
+// updateStatus performs the actual database update, merging conditions, LastSeen, ImageReference,
+// ManifestDigest and per-architecture status
 // Note: Uses u.ctx (updaterCtx) which is derived from the consumer context, NOT the build context.
 // When cancelBuild() is called, only buildCtx is canceled - updaterCtx remains valid until
 // cleanupStatusUpdater() is called, which happens AFTER processImageBuild() returns.
 // This ensures we can still write the final status (e.g., Canceled) after the build is canceled.
-func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, lastSeen *time.Time, imageReference *string) {
+func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, lastSeen *time.Time, imageReference *string, manifestDigest *string, architectureUpdates []architectureStatusUpdate) {
 	// Load current status from database
 	imageBuild, status := u.imageBuildService.Get(u.ctx, u.orgID, u.imageBuildName, false)
 	if imageBuild == nil || !imagebuilderapi.IsStatusOK(status) {
@@ -205,6 +227,7 @@ func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, last
 	}
 
 	// Update condition if provided
+	var unfinishedArchitecturesCondition *domain.ImageBuildCondition
 	if condition != nil {
 		if imageBuild.Status.Conditions == nil {
 			imageBuild.Status.Conditions = &[]domain.ImageBuildCondition{}
@@ -234,6 +257,10 @@ func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, last
 				condition.Reason == string(domain.ImageBuildConditionReasonCanceled) {
 				u.persistLogsToDB()
 				u.writeStreamCompleteMarker()
+				// A failed or canceled build also ends the architectures that were still in progress
+				if condition.Reason != string(domain.ImageBuildConditionReasonCompleted) {
+					unfinishedArchitecturesCondition = condition
+				}
 				// Signal cancellation completion to the API (for cancel-then-delete flow)
 				if condition.Reason == string(domain.ImageBuildConditionReasonCanceled) {
 					u.signalCanceled()
@@ -247,6 +274,24 @@ func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, last
 		imageBuild.Status.ImageReference = imageReference
 	}
 
+	// Update ManifestDigest if provided
+	if manifestDigest != nil {
+		imageBuild.Status.ManifestDigest = manifestDigest
+	}
+
+	// Update per-architecture status if provided
+	if len(architectureUpdates) > 0 {
+		if imageBuild.Status.Architectures == nil {
+			imageBuild.Status.Architectures = &[]domain.ImageBuildArchitectureStatus{}
+		}
+		for _, update := range architectureUpdates {
+			domain.SetImageBuildArchitectureCondition(imageBuild.Status.Architectures, update.Architecture, update.Condition, update.ManifestDigest)
+		}
+	}
+	if unfinishedArchitecturesCondition != nil && imageBuild.Status.Architectures != nil {
+		endUnfinishedArchitectures(imageBuild.Status.Architectures, *unfinishedArchitecturesCondition)
+	}
+
 	// Write updated status atomically
 	_, err := u.imageBuildService.UpdateStatus(u.ctx, u.orgID, imageBuild)
 	if err != nil {
@@ -254,6 +299,20 @@ func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, last
 	}
 }
 
+// endUnfinishedArchitectures sets the terminal condition of the build on every architecture whose build
+// had not finished yet, so that no architecture is left Pending, Building or Pushing once the build ended.
+func endUnfinishedArchitectures(statuses *[]domain.ImageBuildArchitectureStatus, condition domain.ImageBuildCondition) {
+	for _, archStatus := range *statuses {
+		current := domain.FindImageBuildStatusCondition(archStatus.Conditions, domain.ImageBuildConditionTypeReady)
+		if current != nil && (current.Reason == string(domain.ImageBuildConditionReasonCompleted) ||
+			current.Reason == string(domain.ImageBuildConditionReasonFailed) ||
+			current.Reason == string(domain.ImageBuildConditionReasonCanceled)) {
+			continue
+		}
+		domain.SetImageBuildArchitectureCondition(statuses, archStatus.Architecture, condition, nil)
+	}
+}
+
 // UpdateCondition sends a condition update request to the updater goroutine
 // For terminal conditions (Completed, Failed, Canceled), this blocks until the update is processed.
 // This ensures the final status is written before the caller returns and triggers cleanup.
@@ -290,6 +349,30 @@ func (u *statusUpdater) UpdateImageReference(imageReference string) {
 	}
 }
 
+// UpdateManifestDigest sends a manifest digest update request to the updater goroutine
+func (u *statusUpdater) UpdateManifestDigest(manifestDigest string) {
+	select {
+	case u.updateChan <- statusUpdateRequest{ManifestDigest: &manifestDigest}:
+	case <-u.ctx.Done():
+		// Context canceled, ignore update
+	}
+}
+
+// UpdateArchitectureCondition sends an update of the status of a single architecture to the updater goroutine.
+// manifestDigest may be nil if the digest of the architecture's image is not known yet.
+func (u *statusUpdater) UpdateArchitectureCondition(arch domain.ImageBuildArchitecture, condition domain.ImageBuildCondition, manifestDigest *string) {
+	update := architectureStatusUpdate{
+		Architecture:   arch,
+		Condition:      condition,
+		ManifestDigest: manifestDigest,
+	}
+	select {
+	case u.updateChan <- statusUpdateRequest{ArchitectureUpdate: &update}:
+	case <-u.ctx.Done():
+		// Context canceled, ignore update
+	}
+}
+
 // ReportOutput sends task output to the central output handler
 // This marks that progress has been made and LastSeen should be updated
 // Exported for testing purposes.
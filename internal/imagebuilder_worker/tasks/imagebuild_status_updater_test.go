@@ -569,12 +569,61 @@ func TestStatusUpdater_updateStatus_withFailedCondition(t *testing.T) {
 		LastTransitionTime: time.Now().UTC(),
 	}
 
-	updater.updateStatus(&failedCondition, nil, nil)
+	updater.updateStatus(&failedCondition, nil, nil, nil, nil)
 
 	// Should have persisted logs when condition is Failed
 	assert.Equal(t, 1, mockService.getUpdateLogsCallsCount())
 }
 
+func TestStatusUpdater_updateStatus_failedConditionEndsUnfinishedArchitectures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newCondition := func(reason api.ImageBuildConditionReason, message string) api.ImageBuildCondition {
+		return api.ImageBuildCondition{
+			Type:               api.ImageBuildConditionTypeReady,
+			Status:             v1beta1.ConditionStatusFalse,
+			Reason:             string(reason),
+			Message:            message,
+			LastTransitionTime: time.Now().UTC(),
+		}
+	}
+
+	name := "test-build"
+	imageBuild := &api.ImageBuild{
+		Metadata: v1beta1.ObjectMeta{Name: &name},
+		Status: &api.ImageBuildStatus{
+			Architectures: &[]api.ImageBuildArchitectureStatus{
+				{Architecture: api.ImageBuildArchitectureAmd64, Conditions: []api.ImageBuildCondition{newCondition(api.ImageBuildConditionReasonPushing, "Build completed, waiting for push")}},
+				{Architecture: api.ImageBuildArchitectureArm64, Conditions: []api.ImageBuildCondition{newCondition(api.ImageBuildConditionReasonBuilding, "Build is in progress")}},
+			},
+		},
+	}
+
+	mockService := newMockImageBuildServiceForStatusUpdater(ctrl, imageBuild)
+	updater := &statusUpdater{
+		imageBuildService: mockService,
+		orgID:             uuid.New(),
+		imageBuildName:    name,
+		log:               logrus.NewEntry(logrus.New()),
+	}
+
+	// The arm64 build fails, which fails the whole build
+	failedCondition := newCondition(api.ImageBuildConditionReasonFailed, "build failed")
+	updater.updateStatus(&failedCondition, nil, nil, nil, []architectureStatusUpdate{
+		{Architecture: api.ImageBuildArchitectureArm64, Condition: newCondition(api.ImageBuildConditionReasonFailed, "arm64 build failed")},
+	})
+
+	architectures := *mockService.imageBuild.Status.Architectures
+	amd64 := api.FindImageBuildStatusCondition(architectures[0].Conditions, api.ImageBuildConditionTypeReady)
+	require.NotNil(t, amd64)
+	assert.Equal(t, string(api.ImageBuildConditionReasonFailed), amd64.Reason)
+	assert.Equal(t, "build failed", amd64.Message)
+	arm64 := api.FindImageBuildStatusCondition(architectures[1].Conditions, api.ImageBuildConditionTypeReady)
+	require.NotNil(t, arm64)
+	assert.Equal(t, "arm64 build failed", arm64.Message)
+}
+
 func TestStatusUpdater_writeStreamCompleteMarker(t *testing.T) {
 	t.Run("writes completion marker to Redis", func(t *testing.T) {
 		orgID := uuid.New()
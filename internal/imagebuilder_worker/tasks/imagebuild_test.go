@@ -1,15 +1,18 @@
 package tasks
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	api "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/uuid"
@@ -35,13 +38,22 @@ func (m *mockStore) Repository() store.Repository {
 func (m *mockStore) Device() store.Device                                       { return nil }
 func (m *mockStore) EnrollmentRequest() store.EnrollmentRequest                 { return nil }
 func (m *mockStore) CertificateSigningRequest() store.CertificateSigningRequest { return nil }
+func (m *mockStore) CertificateRevocation() store.CertificateRevocation         { return nil }
 func (m *mockStore) Fleet() store.Fleet                                         { return nil }
 func (m *mockStore) TemplateVersion() store.TemplateVersion                     { return nil }
 func (m *mockStore) ResourceSync() store.ResourceSync                           { return nil }
 func (m *mockStore) Event() store.Event                                         { return nil }
+func (m *mockStore) AuditEvent() store.AuditEvent                               { return nil }
+func (m *mockStore) ServiceAccount() store.ServiceAccount                       { return nil }
+func (m *mockStore) ServiceAccountToken() store.ServiceAccountToken             { return nil }
+func (m *mockStore) DeviceBulkOperation() store.DeviceBulkOperation             { return nil }
 func (m *mockStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *mockStore) Organization() store.Organization                           { return nil }
 func (m *mockStore) AuthProvider() store.AuthProvider                           { return nil }
+func (m *mockStore) SecretStore() store.SecretStore                             { return nil }
+func (m *mockStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy   { return nil }
+func (m *mockStore) Role() store.Role                                           { return nil }
+func (m *mockStore) RoleBinding() store.RoleBinding                             { return nil }
 func (m *mockStore) Catalog() store.Catalog                                     { return nil }
 func (m *mockStore) RunMigrations(context.Context) error                        { return nil }
 func (m *mockStore) CheckHealth(context.Context) error                          { return nil }
@@ -430,3 +442,82 @@ func TestInstallCACertInWorker_ValidBase64FailsWithoutContainer(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to create cert dir in container")
 }
+
+func TestStatusWriter_PrefixesCompleteLines(t *testing.T) {
+	updater := &statusUpdater{
+		outputChan: make(chan []byte, 10),
+		ctx:        context.Background(),
+	}
+	var buf bytes.Buffer
+	writer := &statusWriter{
+		buf:           &buf,
+		statusUpdater: updater,
+		logPrefix:     domain.ArchitectureLogPrefix(domain.ImageBuildArchitectureArm64),
+	}
+
+	_, err := writer.Write([]byte("STEP 1/3\nSTEP 2"))
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("/3\nSTEP 3/3"))
+	require.NoError(t, err)
+	writer.Flush()
+	close(updater.outputChan)
+
+	var streamed bytes.Buffer
+	for output := range updater.outputChan {
+		streamed.Write(output)
+	}
+	require.Equal(t, "[arm64] STEP 1/3\n[arm64] STEP 2/3\n[arm64] STEP 3/3\n", streamed.String())
+	require.Equal(t, "STEP 1/3\nSTEP 2/3\nSTEP 3/3", buf.String(), "captured output must not be prefixed")
+}
+
+func TestStatusWriter_NoPrefixPassesThrough(t *testing.T) {
+	updater := &statusUpdater{
+		outputChan: make(chan []byte, 10),
+		ctx:        context.Background(),
+	}
+	var buf bytes.Buffer
+	writer := &statusWriter{buf: &buf, statusUpdater: updater}
+
+	_, err := writer.Write([]byte("partial"))
+	require.NoError(t, err)
+	writer.Flush()
+	close(updater.outputChan)
+
+	var outputs []string
+	for output := range updater.outputChan {
+		outputs = append(outputs, string(output))
+	}
+	require.Equal(t, []string{"partial"}, outputs)
+}
+
+func TestInitArchitectureStatuses(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("defaults to a single amd64 build", func(t *testing.T) {
+		imageBuild := &domain.ImageBuild{Status: &domain.ImageBuildStatus{}}
+		initArchitectureStatuses(imageBuild, now)
+
+		require.NotNil(t, imageBuild.Status.Architectures)
+		require.Len(t, *imageBuild.Status.Architectures, 1)
+		require.Equal(t, domain.ImageBuildArchitectureAmd64, (*imageBuild.Status.Architectures)[0].Architecture)
+		require.Equal(t, "amd64", lo.FromPtr(imageBuild.Status.Architecture))
+	})
+
+	t.Run("multi-architecture build", func(t *testing.T) {
+		imageBuild := &domain.ImageBuild{
+			Spec: domain.ImageBuildSpec{
+				Architectures: &[]domain.ImageBuildArchitecture{domain.ImageBuildArchitectureAmd64, domain.ImageBuildArchitectureArm64},
+			},
+			Status: &domain.ImageBuildStatus{Architecture: lo.ToPtr("amd64")},
+		}
+		initArchitectureStatuses(imageBuild, now)
+
+		require.Len(t, *imageBuild.Status.Architectures, 2)
+		for _, archStatus := range *imageBuild.Status.Architectures {
+			cond := domain.FindImageBuildStatusCondition(archStatus.Conditions, domain.ImageBuildConditionTypeReady)
+			require.NotNil(t, cond)
+			require.Equal(t, string(domain.ImageBuildConditionReasonPending), cond.Reason)
+		}
+		require.Nil(t, imageBuild.Status.Architecture)
+	})
+}
@@ -33,6 +33,10 @@ func (m *MockStore) CertificateSigningRequest() store.CertificateSigningRequest
 	return nil
 }
 
+func (m *MockStore) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
 func (m *MockStore) Fleet() store.Fleet {
 	return nil
 }
@@ -53,6 +57,22 @@ func (m *MockStore) Event() store.Event {
 	return nil
 }
 
+func (m *MockStore) AuditEvent() store.AuditEvent {
+	return nil
+}
+
+func (m *MockStore) ServiceAccount() store.ServiceAccount {
+	return nil
+}
+
+func (m *MockStore) ServiceAccountToken() store.ServiceAccountToken {
+	return nil
+}
+
+func (m *MockStore) DeviceBulkOperation() store.DeviceBulkOperation {
+	return nil
+}
+
 func (m *MockStore) Checkpoint() store.Checkpoint {
 	return nil
 }
@@ -65,6 +85,22 @@ func (m *MockStore) AuthProvider() store.AuthProvider {
 	return nil
 }
 
+func (m *MockStore) SecretStore() store.SecretStore {
+	return nil
+}
+
+func (m *MockStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	return nil
+}
+
+func (m *MockStore) Role() store.Role {
+	return nil
+}
+
+func (m *MockStore) RoleBinding() store.RoleBinding {
+	return nil
+}
+
 func (m *MockStore) Catalog() store.Catalog {
 	return nil
 }
@@ -146,10 +146,30 @@ func (m *MockFleetStoreWrapper) CertificateSigningRequest() store.CertificateSig
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) Event() store.Event {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) AuditEvent() store.AuditEvent {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) ServiceAccount() store.ServiceAccount {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) ServiceAccountToken() store.ServiceAccountToken {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) DeviceBulkOperation() store.DeviceBulkOperation {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) TemplateVersion() store.TemplateVersion {
 	return nil
 }
@@ -166,6 +186,22 @@ func (m *MockFleetStoreWrapper) AuthProvider() store.AuthProvider {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) SecretStore() store.SecretStore {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) Role() store.Role {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) RoleBinding() store.RoleBinding {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) Catalog() store.Catalog {
 	return nil
 }
@@ -29,13 +29,22 @@ func (m *MockRepositoryStore) Repository() store.Repository {
 func (m *MockRepositoryStore) Device() store.Device                                       { return nil }
 func (m *MockRepositoryStore) EnrollmentRequest() store.EnrollmentRequest                 { return nil }
 func (m *MockRepositoryStore) CertificateSigningRequest() store.CertificateSigningRequest { return nil }
+func (m *MockRepositoryStore) CertificateRevocation() store.CertificateRevocation         { return nil }
 func (m *MockRepositoryStore) Fleet() store.Fleet                                         { return nil }
 func (m *MockRepositoryStore) TemplateVersion() store.TemplateVersion                     { return nil }
 func (m *MockRepositoryStore) ResourceSync() store.ResourceSync                           { return nil }
 func (m *MockRepositoryStore) Event() store.Event                                         { return nil }
+func (m *MockRepositoryStore) AuditEvent() store.AuditEvent                               { return nil }
+func (m *MockRepositoryStore) ServiceAccount() store.ServiceAccount                       { return nil }
+func (m *MockRepositoryStore) ServiceAccountToken() store.ServiceAccountToken             { return nil }
+func (m *MockRepositoryStore) DeviceBulkOperation() store.DeviceBulkOperation             { return nil }
 func (m *MockRepositoryStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *MockRepositoryStore) Organization() store.Organization                           { return nil }
 func (m *MockRepositoryStore) AuthProvider() store.AuthProvider                           { return nil }
+func (m *MockRepositoryStore) SecretStore() store.SecretStore                             { return nil }
+func (m *MockRepositoryStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy   { return nil }
+func (m *MockRepositoryStore) Role() store.Role                                           { return nil }
+func (m *MockRepositoryStore) RoleBinding() store.RoleBinding                             { return nil }
 func (m *MockRepositoryStore) Catalog() store.Catalog                                     { return nil }
 func (m *MockRepositoryStore) RunMigrations(context.Context) error                        { return nil }
 func (m *MockRepositoryStore) Close() error                                               { return nil }
@@ -25,20 +25,31 @@ func (m *MockResourceSyncStore) EnrollmentRequest() store.EnrollmentRequest { re
 func (m *MockResourceSyncStore) CertificateSigningRequest() store.CertificateSigningRequest {
 	return nil
 }
+func (m *MockResourceSyncStore) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
 func (m *MockResourceSyncStore) Fleet() store.Fleet                     { return nil }
 func (m *MockResourceSyncStore) TemplateVersion() store.TemplateVersion { return nil }
 func (m *MockResourceSyncStore) Repository() store.Repository           { return nil }
 func (m *MockResourceSyncStore) ResourceSync() store.ResourceSync {
 	return &MockResourceSync{results: m.results}
 }
-func (m *MockResourceSyncStore) Event() store.Event                  { return nil }
-func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint        { return nil }
-func (m *MockResourceSyncStore) Organization() store.Organization    { return nil }
-func (m *MockResourceSyncStore) AuthProvider() store.AuthProvider    { return nil }
-func (m *MockResourceSyncStore) Catalog() store.Catalog              { return nil }
-func (m *MockResourceSyncStore) RunMigrations(context.Context) error { return nil }
-func (m *MockResourceSyncStore) Close() error                        { return nil }
-func (m *MockResourceSyncStore) CheckHealth(context.Context) error   { return nil }
+func (m *MockResourceSyncStore) Event() store.Event                                       { return nil }
+func (m *MockResourceSyncStore) AuditEvent() store.AuditEvent                             { return nil }
+func (m *MockResourceSyncStore) ServiceAccount() store.ServiceAccount                     { return nil }
+func (m *MockResourceSyncStore) ServiceAccountToken() store.ServiceAccountToken           { return nil }
+func (m *MockResourceSyncStore) DeviceBulkOperation() store.DeviceBulkOperation           { return nil }
+func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint                             { return nil }
+func (m *MockResourceSyncStore) Organization() store.Organization                         { return nil }
+func (m *MockResourceSyncStore) AuthProvider() store.AuthProvider                         { return nil }
+func (m *MockResourceSyncStore) SecretStore() store.SecretStore                           { return nil }
+func (m *MockResourceSyncStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy { return nil }
+func (m *MockResourceSyncStore) Role() store.Role                                         { return nil }
+func (m *MockResourceSyncStore) RoleBinding() store.RoleBinding                           { return nil }
+func (m *MockResourceSyncStore) Catalog() store.Catalog                                   { return nil }
+func (m *MockResourceSyncStore) RunMigrations(context.Context) error                      { return nil }
+func (m *MockResourceSyncStore) Close() error                                             { return nil }
+func (m *MockResourceSyncStore) CheckHealth(context.Context) error                        { return nil }
 
 type MockResourceSync struct {
 	results []store.CountByResourceSyncOrgAndStatusResult
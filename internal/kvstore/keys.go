@@ -94,3 +94,49 @@ type AwaitingReconnectionKey struct {
 func (a *AwaitingReconnectionKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/device/%s/awaiting-reconnect", a.OrgID, a.DeviceName)
 }
+
+type SecretStoreSecretKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	SecretStore     string
+	Version         string
+	Path            string
+}
+
+func (k *SecretStoreSecretKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/secretstore-data/%s/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.SecretStore, k.Version, k.Path)
+}
+
+type SecretStoreVersionKey struct {
+	OrgID       uuid.UUID
+	SecretStore string
+	Path        string
+}
+
+func (k *SecretStoreVersionKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/secretstore-version/%s/%s", k.OrgID, k.SecretStore, k.Path)
+}
+
+type OciArtifactKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	ConfigName      string
+	Digest          string
+}
+
+func (k *OciArtifactKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/oci-data/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.ConfigName, k.Digest)
+}
+
+type OciArtifactDigestKey struct {
+	OrgID      uuid.UUID
+	Repository string
+	Artifact   string
+	Reference  string
+}
+
+func (k *OciArtifactDigestKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/oci-digest/%s/%s/%s", k.OrgID, k.Repository, k.Artifact, k.Reference)
+}
@@ -85,7 +85,7 @@ func (s *Server) Run(ctx context.Context) error {
 	serviceHandler := service.WrapWithTracing(service.NewServiceHandler(s.store, workerClient, kvStore, nil, s.log, "", "", []string{}))
 
 	// Initialize the task executors
-	periodicTaskExecutors := InitializeTaskExecutors(s.log, serviceHandler, s.cfg, queuesProvider, workerClient, nil)
+	periodicTaskExecutors := InitializeTaskExecutors(s.log, serviceHandler, kvStore, s.cfg, queuesProvider, workerClient, nil)
 
 	// Create channel manager for task distribution
 	channelManagerConfig := ChannelManagerConfig{
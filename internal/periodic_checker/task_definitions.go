@@ -7,6 +7,7 @@ import (
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/instrumentation/metrics/worker"
+	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/rollout/device_selection"
 	"github.com/flightctl/flightctl/internal/rollout/disruption_budget"
 	"github.com/flightctl/flightctl/internal/service"
@@ -33,7 +34,10 @@ const (
 	PeriodicTaskTypeRolloutDeviceSelection PeriodicTaskType = "rollout-device-selection"
 	PeriodicTaskTypeDisruptionBudget       PeriodicTaskType = "disruption-budget"
 	PeriodicTaskTypeEventCleanup           PeriodicTaskType = "event-cleanup"
+	PeriodicTaskTypeAuditEventCleanup      PeriodicTaskType = "audit-event-cleanup"
 	PeriodicTaskTypeQueueMaintenance       PeriodicTaskType = "queue-maintenance"
+	PeriodicTaskTypeSecretStoreWatcher     PeriodicTaskType = "secret-store-watcher"
+	PeriodicTaskTypeOciArtifactWatcher     PeriodicTaskType = "oci-artifact-watcher"
 )
 
 type PeriodicTaskMetadata struct {
@@ -48,7 +52,10 @@ var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
 	PeriodicTaskTypeRolloutDeviceSelection: {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
 	PeriodicTaskTypeDisruptionBudget:       {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
 	PeriodicTaskTypeEventCleanup:           {Interval: tasks.EventCleanupPollingInterval, SystemWide: true},
+	PeriodicTaskTypeAuditEventCleanup:      {Interval: tasks.AuditEventCleanupPollingInterval, SystemWide: true},
 	PeriodicTaskTypeQueueMaintenance:       {Interval: QueueMaintenanceInterval, SystemWide: true},
+	PeriodicTaskTypeSecretStoreWatcher:     {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeOciArtifactWatcher:     {Interval: 2 * time.Minute, SystemWide: false},
 }
 
 // MergeTasksWithConfig merges configured task intervals with defaults.
@@ -165,6 +172,22 @@ func (e *EventCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogg
 	eventCleanup.Poll(taskCtx)
 }
 
+type AuditEventCleanupExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	auditLog       *config.AuditLogConfig
+}
+
+func (e *AuditEventCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	if e.auditLog == nil || !e.auditLog.Enabled {
+		return
+	}
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeAuditEventCleanup)
+	// Note: Audit event cleanup is system-wide, orgId is not used
+	auditEventCleanup := tasks.NewAuditEventCleanup(e.log, e.serviceHandler, e.auditLog.RetentionPeriod)
+	auditEventCleanup.Poll(taskCtx)
+}
+
 type QueueMaintenanceExecutor struct {
 	log            logrus.FieldLogger
 	serviceHandler service.Service
@@ -185,7 +208,31 @@ func (e *QueueMaintenanceExecutor) Execute(ctx context.Context, log logrus.Field
 	}
 }
 
-func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Service, cfg *config.Config, queuesProvider queues.Provider, workerClient worker_client.WorkerClient, workerMetrics *worker.WorkerCollector) map[PeriodicTaskType]PeriodicTaskExecutor {
+type SecretStoreWatcherExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	kvStore        kvstore.KVStore
+}
+
+func (e *SecretStoreWatcherExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeSecretStoreWatcher)
+	secretStoreWatcher := tasks.NewSecretStoreWatcher(e.log, e.serviceHandler, e.kvStore, nil)
+	secretStoreWatcher.Poll(taskCtx, orgId)
+}
+
+type OciArtifactWatcherExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	kvStore        kvstore.KVStore
+}
+
+func (e *OciArtifactWatcherExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeOciArtifactWatcher)
+	ociArtifactWatcher := tasks.NewOciArtifactWatcher(e.log, e.serviceHandler, e.kvStore)
+	ociArtifactWatcher.Poll(taskCtx, orgId)
+}
+
+func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Service, kvStore kvstore.KVStore, cfg *config.Config, queuesProvider queues.Provider, workerClient worker_client.WorkerClient, workerMetrics *worker.WorkerCollector) map[PeriodicTaskType]PeriodicTaskExecutor {
 	return map[PeriodicTaskType]PeriodicTaskExecutor{
 		PeriodicTaskTypeRepositoryTester: &RepositoryTesterExecutor{
 			log:            log.WithField("pkg", "repository-tester"),
@@ -213,6 +260,11 @@ func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Serv
 			serviceHandler:       serviceHandler,
 			eventRetentionPeriod: cfg.Service.EventRetentionPeriod,
 		},
+		PeriodicTaskTypeAuditEventCleanup: &AuditEventCleanupExecutor{
+			log:            log.WithField("pkg", "audit-event-cleanup"),
+			serviceHandler: serviceHandler,
+			auditLog:       cfg.Service.AuditLog,
+		},
 		PeriodicTaskTypeQueueMaintenance: &QueueMaintenanceExecutor{
 			log:            log.WithField("pkg", "queue-maintenance"),
 			serviceHandler: serviceHandler,
@@ -220,5 +272,15 @@ func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Serv
 			workerClient:   workerClient,
 			workerMetrics:  workerMetrics,
 		},
+		PeriodicTaskTypeSecretStoreWatcher: &SecretStoreWatcherExecutor{
+			log:            log.WithField("pkg", "secret-store-watcher"),
+			serviceHandler: serviceHandler,
+			kvStore:        kvStore,
+		},
+		PeriodicTaskTypeOciArtifactWatcher: &OciArtifactWatcherExecutor{
+			log:            log.WithField("pkg", "oci-artifact-watcher"),
+			serviceHandler: serviceHandler,
+			kvStore:        kvStore,
+		},
 	}
 }
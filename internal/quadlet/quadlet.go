@@ -73,6 +73,10 @@ const (
 	PublishPortKey = "PublishPort"
 	// DriverKey is the key name for specifying a Volume driver
 	DriverKey = "Driver"
+	// AddDeviceKey is the key name for passing a host device to a container
+	AddDeviceKey = "AddDevice"
+	// AddCapabilityKey is the key name for adding a Linux capability to a container
+	AddCapabilityKey = "AddCapability"
 )
 
 // Sections maps quadlet section names to their corresponding file extensions.
@@ -73,6 +73,7 @@ func servicesManifest(config *RendererConfig) []InstallAction {
 
 		// Telemetry Gateway service
 		{Action: ActionCopyFile, Source: "deploy/podman/flightctl-telemetry-gateway/flightctl-telemetry-gateway.container", Destination: filepath.Join(config.QuadletFilesOutputDir, "flightctl-telemetry-gateway.container"), Template: true, Mode: RegularFileMode},
+		{Action: ActionCopyFile, Source: "deploy/podman/flightctl-telemetry-gateway/flightctl-telemetry-gateway-logs.volume", Destination: filepath.Join(config.QuadletFilesOutputDir, "flightctl-telemetry-gateway-logs.volume"), Template: false, Mode: RegularFileMode},
 		{Action: ActionCopyDir, Source: "deploy/podman/flightctl-telemetry-gateway/flightctl-telemetry-gateway-config/", Destination: filepath.Join(config.ReadOnlyConfigOutputDir, "flightctl-telemetry-gateway/"), Template: false, Mode: RegularFileMode},
 
 		// Prometheus service
@@ -0,0 +1,211 @@
+package revocation
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	queueName = "certificate_revocation_notifier"
+
+	defaultRefreshInterval = time.Minute
+)
+
+type entry struct {
+	revocation domain.CertificateRevocation
+	seq        uint64
+}
+
+// Registry keeps the revoked certificates that have not expired in memory, so that the endpoints
+// authenticating devices can reject them on every request without querying the database.
+// Revocations are broadcast to the registries of all the service instances as soon as they are
+// recorded, and the registry is periodically reloaded from the database in case a notification
+// was missed.
+type Registry struct {
+	mu              sync.RWMutex
+	entries         map[string]entry
+	seq             uint64
+	store           store.CertificateRevocation
+	publisher       queues.PubSubPublisher
+	subscriber      queues.PubSubSubscriber
+	refreshInterval time.Duration
+	log             logrus.FieldLogger
+}
+
+type RegistryType struct {
+	util.Singleton[Registry]
+}
+
+// Revocations is the registry of the process. Until it is initialized, no certificate is
+// considered revoked.
+var Revocations RegistryType
+
+func (r *RegistryType) Initialize(ctx context.Context,
+	st store.Store,
+	provider queues.Provider,
+	refreshInterval time.Duration,
+	log logrus.FieldLogger) error {
+	publisher, err := provider.NewPubSubPublisher(ctx, queueName)
+	if err != nil {
+		return fmt.Errorf("failed to create publisher for certificate revocations: %w", err)
+	}
+	subscriber, err := provider.NewPubSubSubscriber(ctx, queueName)
+	if err != nil {
+		publisher.Close()
+		return fmt.Errorf("failed to create subscriber for certificate revocations: %w", err)
+	}
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	_ = r.GetOrInit(&Registry{
+		entries:         map[string]entry{},
+		store:           st.CertificateRevocation(),
+		publisher:       publisher,
+		subscriber:      subscriber,
+		refreshInterval: refreshInterval,
+		log:             log,
+	})
+	return nil
+}
+
+func key(issuer, serialNumber string) string {
+	return issuer + "/" + serialNumber
+}
+
+// Start loads the revocations from the database, subscribes to the revocations recorded by the
+// other service instances and periodically reloads the revocations until the context is done.
+func (r *Registry) Start(ctx context.Context) error {
+	if err := r.Refresh(ctx); err != nil {
+		return err
+	}
+	if _, err := r.subscriber.Subscribe(ctx, r.consumeHandler); err != nil {
+		return fmt.Errorf("failed to subscribe to certificate revocations: %w", err)
+	}
+	go func() {
+		ticker := time.NewTicker(r.refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				r.subscriber.Close()
+				r.publisher.Close()
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil {
+					r.log.WithError(err).Error("failed to reload certificate revocations")
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Refresh replaces the revocations with the ones recorded in the database, dropping those of
+// expired certificates. Revocations added while the database is queried are kept.
+func (r *Registry) Refresh(ctx context.Context) error {
+	r.mu.RLock()
+	startSeq := r.seq
+	r.mu.RUnlock()
+
+	revocations, err := r.store.ListUnexpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := make(map[string]entry, len(revocations))
+	for _, revocation := range revocations {
+		entries[key(revocation.Issuer, revocation.SerialNumber)] = entry{revocation: revocation}
+	}
+	for k, e := range r.entries {
+		if e.seq > startSeq {
+			entries[k] = e
+		}
+	}
+	r.entries = entries
+	return nil
+}
+
+func (r *Registry) add(revocations []domain.CertificateRevocation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.entries == nil {
+		r.entries = map[string]entry{}
+	}
+	for _, revocation := range revocations {
+		r.seq++
+		r.entries[key(revocation.Issuer, revocation.SerialNumber)] = entry{revocation: revocation, seq: r.seq}
+	}
+}
+
+// Add registers revocations that were recorded in the database, and notifies the registries of
+// the other service instances.
+func (r *Registry) Add(ctx context.Context, revocations ...domain.CertificateRevocation) error {
+	if len(revocations) == 0 {
+		return nil
+	}
+	r.add(revocations)
+	if r.publisher == nil {
+		return nil
+	}
+	payload, err := json.Marshal(revocations)
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(ctx, payload)
+}
+
+func (r *Registry) consumeHandler(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+	var revocations []domain.CertificateRevocation
+	if err := json.Unmarshal(payload, &revocations); err != nil {
+		log.WithError(err).Error("failed to unmarshal certificate revocations")
+		return err
+	}
+	r.add(revocations)
+	return nil
+}
+
+// Lookup returns the revocation of the certificate with the given serial number issued by the CA
+// with the given key identifier, or nil if it is not revoked.
+func (r *Registry) Lookup(issuer, serialNumber string) *domain.CertificateRevocation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.entries[key(issuer, serialNumber)]
+	if !ok {
+		return nil
+	}
+	revocation := e.revocation
+	return &revocation
+}
+
+// IsRevoked returns whether the certificate is revoked.
+func (r *Registry) IsRevoked(cert *x509.Certificate) bool {
+	return r.Lookup(crypto.CertificateIssuerID(cert), crypto.FormatSerialNumber(cert.SerialNumber)) != nil
+}
+
+// List returns the revocations ordered by revocation time.
+func (r *Registry) List() []domain.CertificateRevocation {
+	r.mu.RLock()
+	revocations := make([]domain.CertificateRevocation, 0, len(r.entries))
+	for _, e := range r.entries {
+		revocations = append(revocations, e.revocation)
+	}
+	r.mu.RUnlock()
+	sort.Slice(revocations, func(i, j int) bool {
+		return revocations[i].RevokedAt.Before(revocations[j].RevokedAt)
+	})
+	return revocations
+}
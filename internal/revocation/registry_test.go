@@ -0,0 +1,82 @@
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRevocationStore struct {
+	store.CertificateRevocation
+	revocations []domain.CertificateRevocation
+	onList      func()
+}
+
+func (s *fakeRevocationStore) ListUnexpired(ctx context.Context, now time.Time) ([]domain.CertificateRevocation, error) {
+	if s.onList != nil {
+		s.onList()
+	}
+	return s.revocations, nil
+}
+
+func newRevocation(serialNumber string, revokedAt time.Time) domain.CertificateRevocation {
+	return domain.CertificateRevocation{
+		Issuer:       "abcd",
+		SerialNumber: serialNumber,
+		Reason:       domain.CertificateRevocationReasonKeyCompromise,
+		RevokedAt:    revokedAt,
+	}
+}
+
+func TestRegistryRefresh(t *testing.T) {
+	require := require.New(t)
+	now := time.Now()
+	st := &fakeRevocationStore{revocations: []domain.CertificateRevocation{newRevocation("1", now.Add(-time.Hour))}}
+	r := &Registry{store: st, log: log.InitLogs()}
+
+	r.add([]domain.CertificateRevocation{newRevocation("2", now.Add(-2*time.Hour))})
+	// A revocation added while the database is queried is kept, the ones added before are replaced
+	st.onList = func() { r.add([]domain.CertificateRevocation{newRevocation("3", now)}) }
+	require.NoError(r.Refresh(context.Background()))
+
+	require.NotNil(r.Lookup("abcd", "1"))
+	require.Nil(r.Lookup("abcd", "2"))
+	require.NotNil(r.Lookup("abcd", "3"))
+	require.Nil(r.Lookup("efgh", "1"))
+
+	revocations := r.List()
+	require.Len(revocations, 2)
+	require.Equal("1", revocations[0].SerialNumber)
+	require.Equal("3", revocations[1].SerialNumber)
+}
+
+func TestRegistryConsumeNotification(t *testing.T) {
+	require := require.New(t)
+	r := &Registry{log: log.InitLogs()}
+
+	payload, err := json.Marshal([]domain.CertificateRevocation{newRevocation("a1", time.Now())})
+	require.NoError(err)
+	require.NoError(r.consumeHandler(context.Background(), payload, r.log))
+	require.Error(r.consumeHandler(context.Background(), []byte("not json"), r.log))
+
+	revocation := r.Lookup("abcd", "a1")
+	require.NotNil(revocation)
+	require.Equal(domain.CertificateRevocationReasonKeyCompromise, revocation.Reason)
+}
+
+func TestZeroRegistry(t *testing.T) {
+	require := require.New(t)
+	r := &Registry{}
+
+	require.NoError(r.Add(context.Background(), newRevocation("ff", time.Now())))
+	require.NotNil(r.Lookup("abcd", "ff"))
+	require.Empty((&Registry{}).List())
+	require.Nil((&Registry{}).Lookup("abcd", big.NewInt(255).Text(16)))
+}
@@ -445,9 +445,9 @@ func (b *batchSelection) Approve(ctx context.Context) error {
 }
 
 // A group of device is considered as completed successfully if the rendered template version is the same as the
-// template version of the fleet and same-rendered-version is true
+// template version of the fleet, same-rendered-version is true and the health probes of the applications pass
 func (b *batchSelection) isUpdateCompletedSuccessfully(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.SameRenderedVersion
+	return c.SameTemplateVersion && c.SameRenderedVersion && !c.ApplicationsUnhealthy
 }
 
 func (b *batchSelection) isFailed(c domain.DeviceCompletionCount) bool {
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateAuditEvent(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) domain.Status {
+	err := h.store.AuditEvent().Create(ctx, orgId, event)
+	return StoreErrorToApiStatus(err, true, domain.AuditEventKind, event.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListAuditEvents(ctx context.Context, orgId uuid.UUID, params domain.ListAuditEventsParams) (*domain.AuditEventList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, nil, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	// default is to sort created_at with desc
+	listParams.SortColumns = []store.SortColumn{store.SortByCreatedAt, store.SortByName}
+	listParams.SortOrder = lo.ToPtr(store.SortDesc)
+	if params.Order != nil {
+		listParams.SortOrder = lo.ToPtr(map[domain.ListAuditEventsParamsOrder]store.SortOrder{domain.AuditEventsOrderAsc: store.SortAsc, domain.AuditEventsOrderDesc: store.SortDesc}[*params.Order])
+	}
+
+	result, err := h.store.AuditEvent().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) DeleteAuditEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status) {
+	numDeleted, err := h.store.AuditEvent().DeleteOlderThan(ctx, cutoffTime)
+	return numDeleted, StoreErrorToApiStatus(err, false, domain.AuditEventKind, nil)
+}
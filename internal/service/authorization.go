@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// checkCallerPermission checks that the caller of a request is granted an operation on a resource,
+// for requests that make the service act on resources other than the one of their endpoint. The
+// permission must not be restricted by a label selector. Requests that weren't authorized by the
+// API server, e.g. those made by tasks, are not checked.
+func checkCallerPermission(ctx context.Context, resource string, op string) domain.Status {
+	authorizer := authz.AuthorizerFromContext(ctx)
+	if authorizer == nil {
+		return domain.StatusOK()
+	}
+	allowed, err := authorizer.CheckPermission(authz.WithScope(ctx, nil), resource, op)
+	if err != nil {
+		return domain.StatusInternalServerError(fmt.Sprintf("failed to check permission: %v", err))
+	}
+	if !allowed {
+		return domain.StatusForbidden(fmt.Sprintf("permission %s:%s is required", resource, op))
+	}
+	return domain.StatusOK()
+}
@@ -9,6 +9,7 @@ import (
 	authprovider "github.com/flightctl/flightctl/internal/auth/provider"
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -257,6 +258,9 @@ func (h *ServiceHandler) PatchAuthProvider(ctx context.Context, orgId uuid.UUID,
 }
 
 func (h *ServiceHandler) DeleteAuthProvider(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.AuthProvider().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
 
 	err := h.store.AuthProvider().Delete(ctx, orgId, name, h.callbackAuthProviderDeleted)
 	return StoreErrorToApiStatus(err, false, domain.AuthProviderKind, &name)
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/revocation"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// issuedCertificate is a certificate issued by the service, along with the name of the device it
+// was issued to, if any.
+type issuedCertificate struct {
+	cert       *x509.Certificate
+	deviceName string
+}
+
+// (GET /api/v1/certificaterevocations)
+func (h *ServiceHandler) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateRevocationsParams) (*domain.CertificateRevocationList, domain.Status) {
+	result, err := h.store.CertificateRevocation().List(ctx, orgId, params.DeviceName)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	return result, domain.StatusOK()
+}
+
+// (POST /api/v1/certificaterevocations)
+func (h *ServiceHandler) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status) {
+	if errs := request.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	reason := lo.FromPtrOr(request.Reason, domain.CertificateRevocationReasonUnspecified)
+
+	var certs []issuedCertificate
+	if request.DeviceName != nil && *request.DeviceName != "" {
+		name := *request.DeviceName
+		if _, err := h.store.Device().Get(ctx, orgId, name); err != nil {
+			return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+		}
+		var err error
+		if certs, err = h.listDeviceCertificates(ctx, orgId, name); err != nil {
+			return nil, domain.StatusInternalServerError(err.Error())
+		}
+	} else {
+		serialNumber, err := crypto.ParseSerialNumber(*request.SerialNumber)
+		if err != nil {
+			return nil, domain.StatusBadRequest(err.Error())
+		}
+		cert, err := h.findIssuedCertificate(ctx, orgId, crypto.FormatSerialNumber(serialNumber))
+		if err != nil {
+			return nil, domain.StatusInternalServerError(err.Error())
+		}
+		if cert == nil {
+			return nil, domain.StatusResourceNotFound("Certificate", *request.SerialNumber)
+		}
+		certs = []issuedCertificate{*cert}
+	}
+
+	revocations, err := h.revokeCertificates(ctx, orgId, certs, reason)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, true, "CertificateRevocation", nil)
+	}
+	return &domain.CertificateRevocationList{
+		ApiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.CertificateRevocationAPIVersion),
+		Kind:       domain.CertificateRevocationListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      revocations,
+	}, domain.StatusOK()
+}
+
+// revokeCertificates records the revocation of the certificates that have not expired yet and
+// notifies the revocation registries of the service instances, so that the certificates are
+// rejected immediately.
+func (h *ServiceHandler) revokeCertificates(ctx context.Context, orgId uuid.UUID, certs []issuedCertificate, reason domain.CertificateRevocationReason) ([]domain.CertificateRevocation, error) {
+	now := time.Now().UTC()
+	revocations := []domain.CertificateRevocation{}
+	seen := map[string]bool{}
+	for _, c := range certs {
+		issuer := crypto.CertificateIssuerID(c.cert)
+		serialNumber := crypto.FormatSerialNumber(c.cert.SerialNumber)
+		if c.cert.NotAfter.Before(now) || seen[issuer+"/"+serialNumber] {
+			continue
+		}
+		seen[issuer+"/"+serialNumber] = true
+		revocations = append(revocations, domain.CertificateRevocation{
+			Issuer:       issuer,
+			SerialNumber: serialNumber,
+			DeviceName:   lo.EmptyableToPtr(c.deviceName),
+			Reason:       reason,
+			RevokedAt:    now,
+			NotAfter:     lo.ToPtr(c.cert.NotAfter.UTC()),
+		})
+	}
+	if len(revocations) == 0 {
+		return revocations, nil
+	}
+
+	if err := h.store.CertificateRevocation().Create(ctx, orgId, revocations); err != nil {
+		return nil, err
+	}
+	if err := revocation.Revocations.Instance().Add(ctx, revocations...); err != nil {
+		h.log.WithError(err).Warn("failed to notify the service instances of certificate revocations")
+	}
+	for _, r := range revocations {
+		h.log.Infof("revoked certificate %s of device %q in org %s: %s", r.SerialNumber, lo.FromPtr(r.DeviceName), orgId, r.Reason)
+	}
+	return revocations, nil
+}
+
+// revokeDeviceCertificates revokes the certificates previously collected for a device, logging
+// failures as the operation triggering the revocation has already been performed.
+func (h *ServiceHandler) revokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, certs []issuedCertificate, reason domain.CertificateRevocationReason) {
+	if _, err := h.revokeCertificates(ctx, orgId, certs, reason); err != nil {
+		h.log.WithError(err).Errorf("failed to revoke the certificates of device %s/%s", orgId, name)
+	}
+}
+
+// revokeCertificatesIfDecommissioned revokes the certificates of a device once it reports it
+// completed decommissioning. The certificates remain valid while the device is decommissioning,
+// as the agent needs them to fetch the decommissioning request and report its progress.
+func (h *ServiceHandler) revokeCertificatesIfDecommissioned(ctx context.Context, orgId uuid.UUID, oldDevice, newDevice *domain.Device) {
+	isDecommissioned := func(d *domain.Device) bool {
+		return d != nil && d.Status != nil && d.Status.Lifecycle.Status == domain.DeviceLifecycleStatusDecommissioned
+	}
+	if !isDecommissioned(newDevice) || isDecommissioned(oldDevice) {
+		return
+	}
+	name := lo.FromPtr(newDevice.Metadata.Name)
+	certs, err := h.listDeviceCertificates(ctx, orgId, name)
+	if err != nil {
+		h.log.WithError(err).Errorf("failed to list the certificates of decommissioned device %s/%s", orgId, name)
+		return
+	}
+	h.revokeDeviceCertificates(ctx, orgId, name, certs, domain.CertificateRevocationReasonCessationOfOperation)
+}
+
+// listDeviceCertificates returns the certificates issued to a device: the management certificate
+// issued when its enrollment request was approved, and the certificates issued for the
+// certificate signing requests it created, such as the renewals of its management certificate.
+func (h *ServiceHandler) listDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string) ([]issuedCertificate, error) {
+	var certs []issuedCertificate
+
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, err
+	}
+	if er != nil && er.Status != nil && er.Status.Certificate != nil {
+		if cert, err := fccrypto.ParsePEMCertificate([]byte(*er.Status.Certificate)); err == nil {
+			certs = append(certs, issuedCertificate{cert: cert, deviceName: name})
+		}
+	}
+
+	fieldSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"metadata.owner": util.ResourceOwner(domain.DeviceKind, name)})
+	if err != nil {
+		return nil, err
+	}
+	err = h.forEachCertificateSigningRequest(ctx, orgId, fieldSelector, func(csr *domain.CertificateSigningRequest) {
+		if cert := certificateOfCertificateSigningRequest(csr); cert != nil {
+			certs = append(certs, issuedCertificate{cert: cert, deviceName: name})
+		}
+	})
+	return certs, err
+}
+
+// findIssuedCertificate looks for the certificate with the given serial number issued by the CA
+// to an enrollment request or certificate signing request of the organization. It returns nil if
+// no such certificate was issued in the organization.
+func (h *ServiceHandler) findIssuedCertificate(ctx context.Context, orgId uuid.UUID, serialNumber string) (*issuedCertificate, error) {
+	issuer := h.ca.IssuerID()
+	matches := func(cert *x509.Certificate) bool {
+		return cert != nil && crypto.CertificateIssuerID(cert) == issuer && crypto.FormatSerialNumber(cert.SerialNumber) == serialNumber
+	}
+
+	listParams := store.ListParams{Limit: MaxRecordsPerListRequest}
+	for {
+		ers, err := h.store.EnrollmentRequest().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ers.Items {
+			er := &ers.Items[i]
+			if er.Status == nil || er.Status.Certificate == nil {
+				continue
+			}
+			if cert, err := fccrypto.ParsePEMCertificate([]byte(*er.Status.Certificate)); err == nil && matches(cert) {
+				return &issuedCertificate{cert: cert, deviceName: lo.FromPtr(er.Metadata.Name)}, nil
+			}
+		}
+		if ers.Metadata.Continue == nil {
+			break
+		}
+		if listParams.Continue, err = store.ParseContinueString(ers.Metadata.Continue); err != nil {
+			return nil, err
+		}
+	}
+
+	var found *issuedCertificate
+	err := h.forEachCertificateSigningRequest(ctx, orgId, nil, func(csr *domain.CertificateSigningRequest) {
+		if cert := certificateOfCertificateSigningRequest(csr); found == nil && matches(cert) {
+			deviceName := ""
+			if kind, owner, err := util.GetResourceOwner(csr.Metadata.Owner); err == nil && kind == domain.DeviceKind {
+				deviceName = owner
+			}
+			found = &issuedCertificate{cert: cert, deviceName: deviceName}
+		}
+	})
+	return found, err
+}
+
+func (h *ServiceHandler) forEachCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, fieldSelector *selector.FieldSelector, fn func(csr *domain.CertificateSigningRequest)) error {
+	listParams := store.ListParams{Limit: MaxRecordsPerListRequest, FieldSelector: fieldSelector}
+	for {
+		csrs, err := h.store.CertificateSigningRequest().List(ctx, orgId, listParams)
+		if err != nil {
+			return err
+		}
+		for i := range csrs.Items {
+			fn(&csrs.Items[i])
+		}
+		if csrs.Metadata.Continue == nil {
+			return nil
+		}
+		if listParams.Continue, err = store.ParseContinueString(csrs.Metadata.Continue); err != nil {
+			return err
+		}
+	}
+}
+
+func certificateOfCertificateSigningRequest(csr *domain.CertificateSigningRequest) *x509.Certificate {
+	if csr.Status == nil || csr.Status.Certificate == nil || len(*csr.Status.Certificate) == 0 {
+		return nil
+	}
+	cert, err := fccrypto.ParsePEMCertificate(*csr.Status.Certificate)
+	if err != nil {
+		return nil
+	}
+	return cert
+}
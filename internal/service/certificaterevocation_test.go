@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/revocation"
+	"github.com/flightctl/flightctl/internal/store"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+func newRevocationTestHandler(t *testing.T) (*ServiceHandler, context.Context) {
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(t, err)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, caClient)
+	serviceHandler.agentGate = semaphore.NewWeighted(MaxConcurrentAgents)
+	return serviceHandler, ctx
+}
+
+// createEnrolledDevice approves an enrollment request for a new device and returns the
+// management certificate issued to it.
+func createEnrolledDevice(t *testing.T, ctx context.Context, serviceHandler *ServiceHandler, name string) *x509.Certificate {
+	require := require.New(t)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: name},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}, privateKey)
+	require.NoError(err)
+
+	_, status := serviceHandler.CreateEnrollmentRequest(ctx, store.NullOrgId, domain.EnrollmentRequest{
+		ApiVersion: "v1beta1",
+		Kind:       domain.EnrollmentRequestKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.EnrollmentRequestSpec{
+			Csr:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})),
+			DeviceStatus: lo.ToPtr(domain.NewDeviceStatus()),
+		},
+	})
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.ApproveEnrollmentRequest(ctx, store.NullOrgId, name, domain.EnrollmentRequestApproval{Approved: true})
+	require.Equal(domain.StatusOK(), status)
+
+	er, err := serviceHandler.store.EnrollmentRequest().Get(ctx, store.NullOrgId, name)
+	require.NoError(err)
+	require.NotNil(er.Status.Certificate)
+	cert, err := fccrypto.ParsePEMCertificate([]byte(*er.Status.Certificate))
+	require.NoError(err)
+	return cert
+}
+
+func TestRevokeCertificatesInvalidRequest(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newRevocationTestHandler(t)
+
+	requests := []domain.CertificateRevocationRequest{
+		{},
+		{DeviceName: lo.ToPtr("mydevice"), SerialNumber: lo.ToPtr("1a2b")},
+		{SerialNumber: lo.ToPtr("not-hex")},
+		{DeviceName: lo.ToPtr("mydevice"), Reason: lo.ToPtr(domain.CertificateRevocationReason("lost"))},
+	}
+	for _, request := range requests {
+		_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, request)
+		require.Equal(statusBadRequestCode, status.Code)
+	}
+}
+
+func TestRevokeCertificatesOfDevice(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newRevocationTestHandler(t)
+	cert := createEnrolledDevice(t, ctx, serviceHandler, "revoked-device-fingerprint")
+
+	result, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		DeviceName: lo.ToPtr("revoked-device-fingerprint"),
+		Reason:     lo.ToPtr(domain.CertificateRevocationReasonKeyCompromise),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(result.Items, 1)
+	require.Equal(crypto.FormatSerialNumber(cert.SerialNumber), result.Items[0].SerialNumber)
+	require.Equal(serviceHandler.ca.IssuerID(), result.Items[0].Issuer)
+	require.Equal("revoked-device-fingerprint", lo.FromPtr(result.Items[0].DeviceName))
+	require.Equal(domain.CertificateRevocationReasonKeyCompromise, result.Items[0].Reason)
+	require.True(revocation.Revocations.Instance().IsRevoked(cert))
+
+	list, status := serviceHandler.ListCertificateRevocations(ctx, store.NullOrgId, domain.ListCertificateRevocationsParams{DeviceName: lo.ToPtr("revoked-device-fingerprint")})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(list.Items, 1)
+}
+
+func TestRevokeCertificatesOfUnknownDevice(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newRevocationTestHandler(t)
+
+	_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{DeviceName: lo.ToPtr("unknown")})
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestRevokeCertificateBySerialNumber(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newRevocationTestHandler(t)
+	cert := createEnrolledDevice(t, ctx, serviceHandler, "serial-device-fingerprint")
+	other := createEnrolledDevice(t, ctx, serviceHandler, "other-device-fingerprint")
+
+	result, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		SerialNumber: lo.ToPtr(crypto.FormatSerialNumber(cert.SerialNumber)),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(result.Items, 1)
+	require.Equal("serial-device-fingerprint", lo.FromPtr(result.Items[0].DeviceName))
+	require.Equal(domain.CertificateRevocationReasonUnspecified, result.Items[0].Reason)
+	require.True(revocation.Revocations.Instance().IsRevoked(cert))
+	require.False(revocation.Revocations.Instance().IsRevoked(other))
+
+	_, status = serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		SerialNumber: lo.ToPtr("ffffffffffffffff"),
+	})
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestRevokeCertificatesOnDecommission(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newRevocationTestHandler(t)
+	cert := createEnrolledDevice(t, ctx, serviceHandler, "decommissioned-device-fingerprint")
+
+	device, err := serviceHandler.store.Device().Get(ctx, store.NullOrgId, "decommissioned-device-fingerprint")
+	require.NoError(err)
+	device.Status.Lifecycle.Status = domain.DeviceLifecycleStatusDecommissioning
+	_, status := serviceHandler.ReplaceDeviceStatus(ctx, store.NullOrgId, "decommissioned-device-fingerprint", *device)
+	require.Equal(domain.StatusOK(), status)
+	require.False(revocation.Revocations.Instance().IsRevoked(cert))
+
+	device.Status.Lifecycle.Status = domain.DeviceLifecycleStatusDecommissioned
+	_, status = serviceHandler.ReplaceDeviceStatus(ctx, store.NullOrgId, "decommissioned-device-fingerprint", *device)
+	require.Equal(domain.StatusOK(), status)
+	require.True(revocation.Revocations.Instance().IsRevoked(cert))
+
+	list, status := serviceHandler.ListCertificateRevocations(ctx, store.NullOrgId, domain.ListCertificateRevocationsParams{})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(list.Items, 1)
+	require.Equal(domain.CertificateRevocationReasonCessationOfOperation, list.Items[0].Reason)
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
+	"slices"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/crypto/signer"
@@ -146,6 +149,53 @@ func (h *ServiceHandler) verifyTPMCSRRequest(ctx context.Context, orgId uuid.UUI
 	return nil
 }
 
+// verifyDeviceWorkloadCSR ensures that a CSR for the device workload signer requests a certificate
+// that is declared in the spec of the device owning the CSR.
+func (h *ServiceHandler) verifyDeviceWorkloadCSR(ctx context.Context, orgId uuid.UUID, csr *domain.CertificateSigningRequest, request signer.SignRequest) error {
+	if csr.Spec.SignerName != h.ca.Cfg.DeviceWorkloadSignerName {
+		return nil
+	}
+
+	kind, owner, err := util.GetResourceOwner(csr.Metadata.Owner)
+	if err != nil || kind != domain.DeviceKind {
+		return fmt.Errorf("CSRs for signer %q must be owned by a %s", csr.Spec.SignerName, domain.DeviceKind)
+	}
+	device, err := h.store.Device().Get(ctx, orgId, owner)
+	if err != nil {
+		return fmt.Errorf("unable to find CSR's owner: %s", owner)
+	}
+
+	x509CSR := request.X509()
+	if device.Spec == nil || !slices.ContainsFunc(lo.FromPtr(device.Spec.Certificates), func(cert domain.DeviceCertificateSpec) bool {
+		return deviceWorkloadCertificateMatches(cert, &x509CSR)
+	}) {
+		return fmt.Errorf("the CSR does not match any certificate issued by %s declared in the spec of device %s", domain.CertificateIssuerTypeFlightctl, owner)
+	}
+	return nil
+}
+
+// deviceWorkloadCertificateMatches reports whether a CSR requests the subject names declared by a
+// certificate issued by the Flight Control CA.
+func deviceWorkloadCertificateMatches(cert domain.DeviceCertificateSpec, csr *x509.CertificateRequest) bool {
+	if cert.Issuer != nil && cert.Issuer.Type != domain.CertificateIssuerTypeFlightctl {
+		return false
+	}
+	if csr.Subject.CommonName != cert.GetCommonName() {
+		return false
+	}
+	for _, dnsName := range csr.DNSNames {
+		if !slices.Contains(lo.FromPtr(cert.DnsNames), dnsName) {
+			return false
+		}
+	}
+	for _, ip := range csr.IPAddresses {
+		if !slices.ContainsFunc(lo.FromPtr(cert.IpAddresses), func(declared string) bool { return ip.Equal(net.ParseIP(declared)) }) {
+			return false
+		}
+	}
+	return len(csr.URIs) == 0 && len(csr.EmailAddresses) == 0
+}
+
 func (h *ServiceHandler) CreateCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, csr domain.CertificateSigningRequest) (*domain.CertificateSigningRequest, domain.Status) {
 	// don't set fields that are managed by the service for external requests
 	if !IsInternalRequest(ctx) {
@@ -179,6 +229,9 @@ func (h *ServiceHandler) CreateCertificateSigningRequest(ctx context.Context, or
 			return nil, domain.StatusBadRequest(err.Error())
 		}
 	}
+	if err := h.verifyDeviceWorkloadCSR(ctx, orgId, &csr, request); err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
 
 	result, err := h.store.CertificateSigningRequest().Create(ctx, orgId, &csr, h.callbackCertificateSigningRequestUpdated)
 	if err != nil {
@@ -251,6 +304,9 @@ func (h *ServiceHandler) PatchCertificateSigningRequest(ctx context.Context, org
 			return nil, domain.StatusBadRequest(err.Error())
 		}
 	}
+	if err := h.verifyDeviceWorkloadCSR(ctx, orgId, newObj, request); err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
 
 	result, err := h.store.CertificateSigningRequest().Update(ctx, orgId, newObj, h.callbackCertificateSigningRequestUpdated)
 	if err != nil {
@@ -305,6 +361,9 @@ func (h *ServiceHandler) ReplaceCertificateSigningRequest(ctx context.Context, o
 			return nil, domain.StatusBadRequest(err.Error())
 		}
 	}
+	if err := h.verifyDeviceWorkloadCSR(ctx, orgId, &csr, request); err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
 
 	result, created, err := h.store.CertificateSigningRequest().CreateOrUpdate(ctx, orgId, &csr, h.callbackCertificateSigningRequestUpdated)
 	if err != nil {
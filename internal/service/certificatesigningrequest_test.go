@@ -0,0 +1,138 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorkloadCSR(t *testing.T, commonName string, dnsNames []string, ipAddresses []net.IP) *x509.CertificateRequest {
+	require := require.New(t)
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: commonName},
+		DNSNames:    dnsNames,
+		IPAddresses: ipAddresses,
+	}, privateKey)
+	require.NoError(err)
+	csr, err := x509.ParseCertificateRequest(csrBytes)
+	require.NoError(err)
+	return csr
+}
+
+func TestDeviceWorkloadCertificateMatches(t *testing.T) {
+	cert := domain.DeviceCertificateSpec{
+		Name:        "web",
+		DnsNames:    &[]string{"web.example.com", "www.example.com"},
+		IpAddresses: &[]string{"192.168.1.10"},
+	}
+
+	tests := []struct {
+		name        string
+		cert        domain.DeviceCertificateSpec
+		csr         *x509.CertificateRequest
+		wantMatched bool
+	}{
+		{
+			name:        "declared names",
+			cert:        cert,
+			csr:         newTestWorkloadCSR(t, "web.example.com", []string{"web.example.com", "www.example.com"}, []net.IP{net.ParseIP("192.168.1.10")}),
+			wantMatched: true,
+		},
+		{
+			name:        "subset of declared names",
+			cert:        cert,
+			csr:         newTestWorkloadCSR(t, "web.example.com", []string{"web.example.com"}, nil),
+			wantMatched: true,
+		},
+		{
+			name:        "undeclared common name",
+			cert:        cert,
+			csr:         newTestWorkloadCSR(t, "api.example.com", []string{"web.example.com"}, nil),
+			wantMatched: false,
+		},
+		{
+			name:        "undeclared DNS name",
+			cert:        cert,
+			csr:         newTestWorkloadCSR(t, "web.example.com", []string{"web.example.com", "api.example.com"}, nil),
+			wantMatched: false,
+		},
+		{
+			name:        "undeclared IP address",
+			cert:        cert,
+			csr:         newTestWorkloadCSR(t, "web.example.com", nil, []net.IP{net.ParseIP("192.168.1.11")}),
+			wantMatched: false,
+		},
+		{
+			name: "certificate issued by ACME",
+			cert: domain.DeviceCertificateSpec{
+				Name:     "web",
+				DnsNames: &[]string{"web.example.com"},
+				Issuer:   &domain.CertificateIssuerSpec{Type: domain.CertificateIssuerTypeAcme},
+			},
+			csr:         newTestWorkloadCSR(t, "web.example.com", []string{"web.example.com"}, nil),
+			wantMatched: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantMatched, deviceWorkloadCertificateMatches(tt.cert, tt.csr))
+		})
+	}
+}
+
+func TestVerifyDeviceWorkloadCSR(t *testing.T) {
+	require := require.New(t)
+	caClient, _, err := crypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(err)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, caClient)
+	orgId := store.NullOrgId
+
+	device := domain.Device{
+		ApiVersion: "v1beta1",
+		Kind:       domain.DeviceKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr("device-0001")},
+		Spec: &domain.DeviceSpec{
+			Certificates: &[]domain.DeviceCertificateSpec{{Name: "web", DnsNames: &[]string{"web.example.com"}}},
+		},
+	}
+	_, err = serviceHandler.store.Device().Create(ctx, orgId, &device, nil)
+	require.NoError(err)
+
+	newCSR := func(commonName string, owner *string) (*domain.CertificateSigningRequest, signer.SignRequest) {
+		x509CSR := newTestWorkloadCSR(t, commonName, []string{commonName}, nil)
+		request, err := signer.NewSignRequest(caClient.Cfg.DeviceWorkloadSignerName, *x509CSR)
+		require.NoError(err)
+		return &domain.CertificateSigningRequest{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("web-0001"), Owner: owner},
+			Spec:     domain.CertificateSigningRequestSpec{SignerName: caClient.Cfg.DeviceWorkloadSignerName},
+		}, request
+	}
+
+	csr, request := newCSR("web.example.com", util.SetResourceOwner(domain.DeviceKind, "device-0001"))
+	require.NoError(serviceHandler.verifyDeviceWorkloadCSR(ctx, orgId, csr, request))
+
+	csr, request = newCSR("api.example.com", util.SetResourceOwner(domain.DeviceKind, "device-0001"))
+	require.ErrorContains(serviceHandler.verifyDeviceWorkloadCSR(ctx, orgId, csr, request), "does not match any certificate")
+
+	csr, request = newCSR("web.example.com", util.SetResourceOwner(domain.DeviceKind, "device-0002"))
+	require.ErrorContains(serviceHandler.verifyDeviceWorkloadCSR(ctx, orgId, csr, request), "unable to find CSR's owner")
+
+	csr, request = newCSR("web.example.com", nil)
+	require.ErrorContains(serviceHandler.verifyDeviceWorkloadCSR(ctx, orgId, csr, request), "must be owned by a Device")
+}
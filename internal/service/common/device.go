@@ -64,6 +64,18 @@ var (
 		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskWarning, Details: DiskIsWarning},
 		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceDiskNormal, Details: DiskIsNormal},
 	}
+
+	applicationCPUStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceApplicationCPUCritical, Details: CPUIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationCPUWarning, Details: CPUIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationCPUNormal, Details: CPUIsNormal},
+	}
+
+	applicationMemoryStatus = statusType{
+		domain.DeviceResourceStatusCritical: ResourceUpdate{Reason: domain.EventReasonDeviceApplicationMemoryCritical, Details: MemoryIsCritical},
+		domain.DeviceResourceStatusWarning:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationMemoryWarning, Details: MemoryIsWarning},
+		domain.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: domain.EventReasonDeviceApplicationMemoryNormal, Details: MemoryIsNormal},
+	}
 )
 
 func UpdateServiceSideStatus(ctx context.Context, orgId uuid.UUID, device *domain.Device, st store.Store, log logrus.FieldLogger) bool {
@@ -411,6 +423,7 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 	for _, check := range resourceChecks {
 		checkResourceStatus(oldDevice, newDevice, check.statusMap, check.getter, &resourceUpdates)
 	}
+	checkApplicationResourceStatuses(oldDevice, newDevice, &resourceUpdates)
 
 	return resourceUpdates
 }
@@ -430,16 +443,52 @@ func checkResourceStatus(oldDevice, newDevice *domain.Device, statusMap statusTy
 		oldStatus = getter(oldDevice)
 	}
 
-	newStatus := getter(newDevice)
+	if update, ok := resourceStatusUpdate(oldStatus, getter(newDevice), statusMap); ok {
+		*resourceUpdates = append(*resourceUpdates, update)
+	}
+}
+
+// Generate events for the transitions of the resources of each application, following the same
+// rules as for the resources of the device
+func checkApplicationResourceStatuses(oldDevice, newDevice *domain.Device, resourceUpdates *ResourceUpdates) {
+	oldResources := make(map[string]domain.ApplicationResourceStatus)
+	if oldDevice != nil && oldDevice.Status != nil {
+		for _, app := range oldDevice.Status.Applications {
+			if app.Resources != nil {
+				oldResources[app.Name] = *app.Resources
+			}
+		}
+	}
+
+	for _, app := range newDevice.Status.Applications {
+		if app.Resources == nil {
+			continue
+		}
+		oldCPU, oldMemory := domain.DeviceResourceStatusUnknown, domain.DeviceResourceStatusUnknown
+		if old, ok := oldResources[app.Name]; ok {
+			oldCPU, oldMemory = old.Cpu, old.Memory
+		}
+		if update, ok := resourceStatusUpdate(oldCPU, app.Resources.Cpu, applicationCPUStatus); ok {
+			update.Details = fmt.Sprintf("Application %s: %s", app.Name, update.Details)
+			*resourceUpdates = append(*resourceUpdates, update)
+		}
+		if update, ok := resourceStatusUpdate(oldMemory, app.Resources.Memory, applicationMemoryStatus); ok {
+			update.Details = fmt.Sprintf("Application %s: %s", app.Name, update.Details)
+			*resourceUpdates = append(*resourceUpdates, update)
+		}
+	}
+}
+
+func resourceStatusUpdate(oldStatus, newStatus domain.DeviceResourceStatusType, statusMap statusType) (ResourceUpdate, bool) {
 	if oldStatus == newStatus ||
 		(oldStatus == domain.DeviceResourceStatusUnknown && newStatus == domain.DeviceResourceStatusHealthy) {
-		return
+		return ResourceUpdate{}, false
 	}
 	if update, ok := statusMap[newStatus]; ok {
-		*resourceUpdates = append(*resourceUpdates, update)
-	} else if update, ok = statusMap[domain.DeviceResourceStatusHealthy]; ok {
-		*resourceUpdates = append(*resourceUpdates, update)
+		return update, true
 	}
+	update, ok := statusMap[domain.DeviceResourceStatusHealthy]
+	return update, ok
 }
 
 // EmitMultipleOwnersEvents emits events for MultipleOwners condition changes
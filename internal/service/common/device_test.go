@@ -121,6 +121,45 @@ func TestComputeDeviceStatusChanges_StatusTransition(t *testing.T) {
 	assert.Contains(t, updates[0].Details, "update failed")
 }
 
+func TestComputeDeviceStatusChanges_ApplicationResources(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	newDevice := func(cpu, memory domain.DeviceResourceStatusType) *domain.Device {
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr("test-device"),
+			},
+			Status: &domain.DeviceStatus{
+				Applications: []domain.DeviceApplicationStatus{
+					{Name: "app1", Resources: &domain.ApplicationResourceStatus{Cpu: cpu, Memory: memory}},
+					{Name: "app2"},
+				},
+			},
+		}
+	}
+
+	// Applications starting healthy don't emit events
+	oldDevice := &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-device")}, Status: &domain.DeviceStatus{}}
+	updates := ComputeDeviceStatusChanges(ctx, oldDevice, newDevice(domain.DeviceResourceStatusHealthy, domain.DeviceResourceStatusHealthy), orgId, nil)
+	assert.Empty(t, updates)
+
+	// Alerts firing emit events naming the application
+	updates = ComputeDeviceStatusChanges(ctx,
+		newDevice(domain.DeviceResourceStatusHealthy, domain.DeviceResourceStatusHealthy),
+		newDevice(domain.DeviceResourceStatusCritical, domain.DeviceResourceStatusHealthy), orgId, nil)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, domain.EventReasonDeviceApplicationCPUCritical, updates[0].Reason)
+	assert.Equal(t, "Application app1: "+CPUIsCritical, updates[0].Details)
+
+	// Alerts resolving emit events returning to normal
+	updates = ComputeDeviceStatusChanges(ctx,
+		newDevice(domain.DeviceResourceStatusCritical, domain.DeviceResourceStatusWarning),
+		newDevice(domain.DeviceResourceStatusCritical, domain.DeviceResourceStatusHealthy), orgId, nil)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, domain.EventReasonDeviceApplicationMemoryNormal, updates[0].Reason)
+}
+
 func TestUpdateServerSideDeviceStatus_PostRestoreState(t *testing.T) {
 	// This test validates the critical post-restore state where ALL three conditions must be true:
 	// 1. awaitingReconnect annotation = "true"
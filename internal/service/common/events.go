@@ -295,6 +295,17 @@ func GetDeviceConflictResolvedEvent(ctx context.Context, deviceName string) *dom
 	})
 }
 
+// GetDeviceRenderRequestedEvent creates an event for a device being requested to be rendered again
+func GetDeviceRenderRequestedEvent(ctx context.Context, deviceName string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonDeviceRenderRequested,
+		message:      "Device render was requested.",
+		details:      nil,
+	})
+}
+
 // GetDeviceConflictPausedEvent creates an event for device being paused due to version conflict
 func GetDeviceConflictPausedEvent(ctx context.Context, deviceName string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -330,6 +341,20 @@ func GetFleetSpecInvalidEvent(ctx context.Context, fleetName string, message str
 	})
 }
 
+// GetFleetImageArchitectureMismatchEvent creates an event for a fleet whose OS image is not available
+// for all architectures reported by the fleet's devices
+func GetFleetImageArchitectureMismatchEvent(ctx context.Context, fleetName string, image string, missingArchitectures []string) *domain.Event {
+	msg := fmt.Sprintf("Fleet OS image %q is not available for device architecture(s): %s.", image, strings.Join(missingArchitectures, ", "))
+
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: fleetName,
+		reason:       domain.EventReasonFleetImageArchitectureMismatch,
+		message:      msg,
+		details:      nil,
+	})
+}
+
 // ResourceSync event functions
 func GetResourceSyncCommitDetectedEvent(ctx context.Context, resourceName string, commitHash string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -593,6 +618,38 @@ func GetReferencedRepositoryUpdatedEvent(ctx context.Context, kind domain.Resour
 	})
 }
 
+// GetRepositoryPushReceivedEvent creates an event for a push to a repository reported by a git webhook
+func GetRepositoryPushReceivedEvent(ctx context.Context, name, ref, commit string) *domain.Event {
+	details := domain.RepositoryPushReceivedDetails{
+		DetailType: domain.RepositoryPushReceived,
+		Ref:        ref,
+		Commit:     lo.EmptyableToPtr(commit),
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromRepositoryPushReceivedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.RepositoryKind,
+		resourceName: name,
+		reason:       domain.EventReasonRepositoryPushReceived,
+		message:      fmt.Sprintf("Push to %s received.", ref),
+		details:      &eventDetails,
+	})
+}
+
+// GetReferencedSecretUpdatedEvent creates an event for a new version of a secret referenced by a device
+func GetReferencedSecretUpdatedEvent(ctx context.Context, deviceName, secretStoreName, path string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonReferencedSecretUpdated,
+		message:      fmt.Sprintf("Referenced secret %s in secret store %s updated.", path, secretStoreName),
+		details:      nil,
+	})
+}
+
 // GetSystemRestoredEvent creates an event for system restoration completion
 // Associates the event with a system-level resource using the System kind
 func GetSystemRestoredEvent(ctx context.Context, devicesUpdated int64) *domain.Event {
@@ -604,3 +661,48 @@ func GetSystemRestoredEvent(ctx context.Context, devicesUpdated int64) *domain.E
 		details:      nil,
 	})
 }
+
+// GetDeviceBulkOperationProgressedEvent creates an event for a batch of devices processed by a bulk operation,
+// which triggers the processing of the next batch
+func GetDeviceBulkOperationProgressedEvent(ctx context.Context, name string, cont string, processed, failed int64) *domain.Event {
+	details := domain.DeviceBulkOperationProgressedDetails{
+		DetailType:       domain.DeviceBulkOperationProgressed,
+		Continue:         lo.EmptyableToPtr(cont),
+		ProcessedDevices: processed,
+		FailedDevices:    failed,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromDeviceBulkOperationProgressedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceBulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonDeviceBulkOperationProgressed,
+		message:      fmt.Sprintf("Device bulk operation processed %d devices.", processed),
+		details:      &eventDetails,
+	})
+}
+
+// GetDeviceBulkOperationCompletedEvent creates an event for a bulk operation applied to all its devices successfully
+func GetDeviceBulkOperationCompletedEvent(ctx context.Context, name string, processed int64) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceBulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonDeviceBulkOperationCompleted,
+		message:      fmt.Sprintf("Device bulk operation completed for %d devices.", processed),
+		details:      nil,
+	})
+}
+
+// GetDeviceBulkOperationFailedEvent creates an event for a bulk operation that failed for some of its devices
+func GetDeviceBulkOperationFailedEvent(ctx context.Context, name string, processed, failed int64) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceBulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonDeviceBulkOperationFailed,
+		message:      fmt.Sprintf("Device bulk operation failed for %d of %d devices.", failed, processed),
+		details:      nil,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/flightctl/flightctl/internal/auth/authz"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -57,6 +58,11 @@ func (h *ServiceHandler) ListDevices(ctx context.Context, orgId uuid.UUID, param
 	if status.Code != http.StatusOK {
 		return nil, status
 	}
+	scopeSelectors, err := deviceScopeSelectors(ctx)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	storeParams.ScopeSelectors = scopeSelectors
 
 	// Check if SummaryOnly is true
 	if params.SummaryOnly != nil && *params.SummaryOnly {
@@ -152,9 +158,45 @@ func (h *ServiceHandler) ListDevicesByServiceCondition(ctx context.Context, orgI
 
 func (h *ServiceHandler) GetDevice(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
 	result, err := h.store.Device().Get(ctx, orgId, name)
+	if err == nil && !deviceInScope(ctx, result) {
+		return nil, StoreErrorToApiStatus(flterrors.ErrResourceNotFound, false, domain.DeviceKind, &name)
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
+// deviceScopeSelectors returns the label selectors of the scope restricting the request to the
+// devices matching any of them, or nil if the request is unrestricted.
+func deviceScopeSelectors(ctx context.Context) ([]*selector.LabelSelector, error) {
+	scope := authz.ScopeFromContext(ctx)
+	if scope == nil {
+		return nil, nil
+	}
+	selectors := make([]*selector.LabelSelector, 0, len(scope.LabelSelectors))
+	for _, labelSelector := range scope.LabelSelectors {
+		ls, err := selector.NewLabelSelector(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q of role binding: %w", labelSelector, err)
+		}
+		selectors = append(selectors, ls)
+	}
+	return selectors, nil
+}
+
+// deviceInScope reports whether a device matches the scope restricting the request, if any.
+func deviceInScope(ctx context.Context, device *domain.Device) bool {
+	selectors, err := deviceScopeSelectors(ctx)
+	if err != nil {
+		return false
+	}
+	if selectors == nil {
+		return true
+	}
+	labels := lo.FromPtr(device.Metadata.Labels)
+	return lo.SomeBy(selectors, func(ls *selector.LabelSelector) bool {
+		return ls.Matches(labels)
+	})
+}
+
 func DeviceVerificationCallback(ctx context.Context, before, after *domain.Device) error {
 	// Ensure the device wasn't decommissioned
 	if before != nil && before.Spec != nil && before.Spec.Decommissioning != nil {
@@ -214,7 +256,15 @@ func (h *ServiceHandler) UpdateDevice(ctx context.Context, orgId uuid.UUID, name
 }
 
 func (h *ServiceHandler) DeleteDevice(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
-	_, err := h.store.Device().Delete(ctx, orgId, name, h.callbackDeviceDeleted)
+	// The certificates of the device are collected before its enrollment request is deleted along with it
+	certs, err := h.listDeviceCertificates(ctx, orgId, name)
+	if err != nil {
+		h.log.WithError(err).Errorf("failed to list the certificates of device %s/%s", orgId, name)
+	}
+	deleted, err := h.store.Device().Delete(ctx, orgId, name, h.callbackDeviceDeleted)
+	if err == nil && deleted {
+		h.revokeDeviceCertificates(ctx, orgId, name, certs, domain.CertificateRevocationReasonCessationOfOperation)
+	}
 	return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -279,6 +329,9 @@ func (h *ServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUI
 	_ = common.UpdateServiceSideStatus(ctx, orgId, deviceToStore, h.store, h.log)
 
 	result, err := h.store.Device().UpdateStatus(ctx, orgId, deviceToStore, h.callbackDeviceUpdated)
+	if err == nil {
+		h.revokeCertificatesIfDecommissioned(ctx, orgId, originalDevice, result)
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -320,6 +373,9 @@ func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID,
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.store, h.log)
 
 	result, err := h.store.Device().Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
+	if err == nil {
+		h.revokeCertificatesIfDecommissioned(ctx, orgId, currentObj, result)
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -382,6 +438,9 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name
 	if err != nil {
 		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 	}
+	if !deviceInScope(ctx, currentObj) {
+		return nil, StoreErrorToApiStatus(flterrors.ErrResourceNotFound, false, domain.DeviceKind, &name)
+	}
 
 	newObj := &domain.Device{}
 	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/devices/"+name)
@@ -407,6 +466,9 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name
 	if newObj.Spec != nil && newObj.Spec.Decommissioning != nil {
 		return nil, domain.StatusBadRequest("spec.decommissioning cannot be changed via patch request")
 	}
+	if !deviceInScope(ctx, newObj) {
+		return nil, domain.StatusForbidden("the patched device would no longer match the label selectors the request is restricted to")
+	}
 
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -642,6 +704,19 @@ func (h *ServiceHandler) ResumeDevices(ctx context.Context, orgId uuid.UUID, req
 	}, domain.StatusOK()
 }
 
+// RequestDeviceRender makes the workers render a device again, even if its spec hasn't changed since it
+// was last rendered.
+func (h *ServiceHandler) RequestDeviceRender(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	// the device is not rendered again if its spec hash hasn't changed since the last render
+	if err := h.store.Device().UpdateAnnotations(ctx, orgId, name, nil, []string{domain.DeviceAnnotationRenderedSpecHash}); err != nil {
+		return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if h.eventHandler != nil {
+		h.eventHandler.CreateEvent(ctx, orgId, common.GetDeviceRenderRequestedEvent(ctx, name))
+	}
+	return domain.StatusOK()
+}
+
 // callbackDeviceUpdated is the device-specific callback that handles device events
 func (h *ServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleDeviceUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// CreateDeviceBulkOperation counts the devices matching the selectors of an operation and creates it. The
+// workers are notified of its creation and apply it to the devices asynchronously. In dry-run mode, the
+// operation is returned with the number of matching devices without being created.
+func (h *ServiceHandler) CreateDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.DeviceBulkOperation, dryRun bool) (*domain.DeviceBulkOperation, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&operation.Metadata)
+	if operation.Metadata.Name == nil {
+		operation.Metadata.Name = lo.ToPtr(fmt.Sprintf("bulk-%s", uuid.NewString()[:8]))
+	}
+
+	if errs := operation.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	scope, status := authorizeDeviceBulkOperation(ctx, operation.Spec.Operation)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	listParams, status := prepareListParams(nil, operation.Spec.LabelSelector, operation.Spec.FieldSelector, nil)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+	scopeSelectors, err := deviceScopeSelectors(authz.WithScope(ctx, &authz.Scope{LabelSelectors: scope}))
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	listParams.ScopeSelectors = scopeSelectors
+	matched, err := h.store.Device().Count(ctx, orgId, *listParams)
+	if err != nil {
+		var se *selector.SelectorError
+		if selector.AsSelectorError(err, &se) {
+			return nil, domain.StatusBadRequest(se.Error())
+		}
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to count matching devices: %v", err))
+	}
+
+	operation.Status = &domain.DeviceBulkOperationStatus{
+		Phase:          domain.DeviceBulkOperationPhasePending,
+		MatchedDevices: matched,
+	}
+	if len(scope) > 0 {
+		operation.Status.ScopeLabelSelectors = &scope
+	}
+	if dryRun {
+		return &operation, domain.StatusOK()
+	}
+
+	result, err := h.store.DeviceBulkOperation().Create(ctx, orgId, &operation, h.callbackDeviceBulkOperationCreated)
+	return result, StoreErrorToApiStatus(err, true, domain.DeviceBulkOperationKind, operation.Metadata.Name)
+}
+
+// authorizeDeviceBulkOperation checks that the caller holds the permission of the endpoint applying the
+// operation to a single device, and returns the label selectors restricting it, if any. Only patching
+// devices can be granted by label-scoped role bindings, the other operations require an unrestricted
+// permission. Requests that weren't authorized by the API server are not checked.
+func authorizeDeviceBulkOperation(ctx context.Context, operation domain.DeviceBulkOperationType) ([]string, domain.Status) {
+	switch operation {
+	case domain.DeviceBulkOperationTypeDecommission:
+		return nil, checkCallerPermission(ctx, "devices/decommission", "update")
+	case domain.DeviceBulkOperationTypeResume:
+		return nil, checkCallerPermission(ctx, "devices/resume", "update")
+	case domain.DeviceBulkOperationTypeRerender:
+		return nil, checkCallerPermission(ctx, "devices", "update")
+	}
+
+	authorizer := authz.AuthorizerFromContext(ctx)
+	if authorizer == nil {
+		return nil, domain.StatusOK()
+	}
+	scope := &authz.Scope{}
+	allowed, err := authorizer.CheckPermission(authz.WithScope(ctx, scope), "devices", "patch")
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to check permission: %v", err))
+	}
+	if !allowed {
+		return nil, domain.StatusForbidden("permission devices:patch is required")
+	}
+	return scope.LabelSelectors, domain.StatusOK()
+}
+
+func (h *ServiceHandler) ListDeviceBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceBulkOperationsParams) (*domain.DeviceBulkOperationList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.DeviceBulkOperation().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, domain.Status) {
+	result, err := h.store.DeviceBulkOperation().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.DeviceBulkOperationKind, &name)
+}
+
+func (h *ServiceHandler) DeleteDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.DeviceBulkOperation().Delete(ctx, orgId, name, h.callbackDeviceBulkOperationDeleted)
+	return StoreErrorToApiStatus(err, false, domain.DeviceBulkOperationKind, &name)
+}
+
+// ReplaceDeviceBulkOperationStatus records the progress of an operation, as reported by the workers applying it.
+func (h *ServiceHandler) ReplaceDeviceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status) {
+	if name != lo.FromPtr(operation.Metadata.Name) {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+	if operation.Status == nil {
+		return nil, domain.StatusBadRequest("status must be specified")
+	}
+
+	result, err := h.store.DeviceBulkOperation().UpdateStatus(ctx, orgId, &operation)
+	return result, StoreErrorToApiStatus(err, false, domain.DeviceBulkOperationKind, &name)
+}
+
+// callbackDeviceBulkOperationCreated is the device bulk operation-specific callback that handles device bulk operation creation events
+func (h *ServiceHandler) callbackDeviceBulkOperationCreated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleDeviceBulkOperationCreatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackDeviceBulkOperationDeleted is the device bulk operation-specific callback that handles device bulk operation deletion events
+func (h *ServiceHandler) callbackDeviceBulkOperationDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDeviceBulkOperation(name string) domain.DeviceBulkOperation {
+	return domain.DeviceBulkOperation{
+		ApiVersion: "v1beta1",
+		Kind:       domain.DeviceBulkOperationKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.DeviceBulkOperationSpec{
+			LabelSelector: lo.ToPtr("site=factory-1"),
+			Operation:     domain.DeviceBulkOperationTypeUpdateMetadata,
+			Metadata:      &domain.DeviceBulkMetadataUpdate{SetLabels: &map[string]string{"team": "edge"}},
+		},
+	}
+}
+
+func newTestBulkOperationStore() *TestStore {
+	devices := []domain.Device{
+		{Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev1")}},
+		{Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev2")}},
+	}
+	return &TestStore{devices: &DummyDevice{devices: &devices}}
+}
+
+func TestCreateDeviceBulkOperationDryRun(t *testing.T) {
+	require := require.New(t)
+	testStore := newTestBulkOperationStore()
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+
+	result, status := serviceHandler.CreateDeviceBulkOperation(ctx, store.NullOrgId, newTestDeviceBulkOperation("bulk"), true)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(int64(2), result.Status.MatchedDevices)
+	require.Equal(domain.DeviceBulkOperationPhasePending, result.Status.Phase)
+
+	_, status = serviceHandler.GetDeviceBulkOperation(ctx, store.NullOrgId, "bulk")
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestCreateDeviceBulkOperation(t *testing.T) {
+	require := require.New(t)
+	testStore := newTestBulkOperationStore()
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+
+	operation := newTestDeviceBulkOperation("")
+	operation.Metadata.Name = nil
+	result, status := serviceHandler.CreateDeviceBulkOperation(ctx, store.NullOrgId, operation, false)
+	require.Equal(statusCreatedCode, status.Code)
+	require.NotEmpty(lo.FromPtr(result.Metadata.Name))
+
+	created, status := serviceHandler.GetDeviceBulkOperation(ctx, store.NullOrgId, *result.Metadata.Name)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(int64(2), created.Status.MatchedDevices)
+
+	events, _ := testStore.Event().List(ctx, store.NullOrgId, store.ListParams{})
+	require.Len(events.Items, 1)
+	require.Equal(domain.EventReasonResourceCreated, events.Items[0].Reason)
+}
+
+func TestCreateDeviceBulkOperationInvalid(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, newTestBulkOperationStore(), nil)
+
+	operation := newTestDeviceBulkOperation("bulk")
+	operation.Spec.Metadata = nil
+	_, status := serviceHandler.CreateDeviceBulkOperation(ctx, store.NullOrgId, operation, false)
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestCreateDeviceBulkOperationRequiresDevicePermissions(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, newTestBulkOperationStore(), nil)
+	orgId := store.NullOrgId
+
+	decommission := newTestDeviceBulkOperation("decommission")
+	decommission.Spec.Operation = domain.DeviceBulkOperationTypeDecommission
+	decommission.Spec.Metadata = nil
+	decommission.Spec.Decommission = &domain.DeviceDecommission{Target: domain.DeviceDecommissionTargetTypeUnenroll}
+
+	callerCtx := authz.WithAuthorizer(ctx, fakeAuthorizer{permissions: []string{"devicebulkoperations:create", "devices:patch"}})
+	_, status := serviceHandler.CreateDeviceBulkOperation(callerCtx, orgId, decommission, false)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "devices/decommission:update")
+	_, status = serviceHandler.CreateDeviceBulkOperation(callerCtx, orgId, newTestDeviceBulkOperation("metadata"), false)
+	require.Equal(statusCreatedCode, status.Code)
+
+	callerCtx = authz.WithAuthorizer(ctx, fakeAuthorizer{permissions: []string{"devicebulkoperations:create", "devices/decommission:update"}})
+	_, status = serviceHandler.CreateDeviceBulkOperation(callerCtx, orgId, newTestDeviceBulkOperation("metadata-2"), false)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "devices:patch")
+	_, status = serviceHandler.CreateDeviceBulkOperation(callerCtx, orgId, decommission, false)
+	require.Equal(statusCreatedCode, status.Code)
+}
+
+func TestCreateDeviceBulkOperationRecordsTheScopeOfTheCaller(t *testing.T) {
+	require := require.New(t)
+	testStore := newTestBulkOperationStore()
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+
+	_, status := serviceHandler.CreateRole(ctx, orgId, newTestRole("device-patcher", []string{"devices"}, []string{"get", "list", "patch"}))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("north", "device-patcher", lo.ToPtr("site=north"),
+		domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "test"}))
+	require.Equal(domain.StatusCreated(), status)
+
+	ctx = authz.WithAuthorizer(ctx, authz.NewStaticAuthZ(logrus.New(), authz.WithRoleSource(NewCustomRoleSource(testStore))))
+	result, status := serviceHandler.CreateDeviceBulkOperation(ctx, orgId, newTestDeviceBulkOperation("bulk"), false)
+	require.Equal(statusCreatedCode, status.Code)
+	require.Equal([]string{"site=north"}, lo.FromPtr(result.Status.ScopeLabelSelectors))
+
+	decommission := newTestDeviceBulkOperation("decommission")
+	decommission.Spec.Operation = domain.DeviceBulkOperationTypeDecommission
+	decommission.Spec.Metadata = nil
+	decommission.Spec.Decommission = &domain.DeviceDecommission{Target: domain.DeviceDecommissionTargetTypeUnenroll}
+	_, status = serviceHandler.CreateDeviceBulkOperation(ctx, orgId, decommission, false)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+}
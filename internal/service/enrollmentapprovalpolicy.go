@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	systemInfoProductNameKey   = "productName"
+	systemInfoProductSerialKey = "productSerial"
+)
+
+func (h *ServiceHandler) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&policy.Metadata)
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.EnrollmentApprovalPolicy().Create(ctx, orgId, &policy, h.callbackEnrollmentApprovalPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentApprovalPolicyKind, policy.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EnrollmentApprovalPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	result, err := h.store.EnrollmentApprovalPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&policy.Metadata)
+	}
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *policy.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.EnrollmentApprovalPolicy().CreateOrUpdate(ctx, orgId, &policy, h.callbackEnrollmentApprovalPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.EnrollmentApprovalPolicy().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err := h.store.EnrollmentApprovalPolicy().Delete(ctx, orgId, name, h.callbackEnrollmentApprovalPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+func (h *ServiceHandler) PatchEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	currentObj, err := h.store.EnrollmentApprovalPolicy().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+	}
+
+	newObj := &domain.EnrollmentApprovalPolicy{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/enrollmentapprovalpolicies/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.EnrollmentApprovalPolicy().Update(ctx, orgId, newObj, h.callbackEnrollmentApprovalPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+// ApproveEnrollmentRequestByPolicy approves an enrollment request matching an EnrollmentApprovalPolicy
+// of its organization. The agent server calls it once the device has solved its TPM challenge, as
+// the verified TPM may satisfy the policies requiring TPM attestation.
+func (h *ServiceHandler) ApproveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status) {
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+	return h.approveEnrollmentRequestByPolicy(ctx, orgId, er), domain.StatusOK()
+}
+
+// approveEnrollmentRequestByPolicy approves an enrollment request matching an EnrollmentApprovalPolicy
+// of its organization and creates its device. The policies are evaluated in name order and the first
+// matching policy approves the request. It returns the enrollment request, which is unchanged if no
+// policy matched or the approval failed.
+func (h *ServiceHandler) approveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest) *domain.EnrollmentRequest {
+	if er == nil || er.Status == nil || er.Metadata.Name == nil {
+		return er
+	}
+	if domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) {
+		return er
+	}
+	name := *er.Metadata.Name
+
+	policies, err := h.store.EnrollmentApprovalPolicy().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		h.log.Errorf("failed to list enrollment approval policies for enrollment request %s: %v", name, err)
+		return er
+	}
+	slices.SortFunc(policies.Items, func(a, b domain.EnrollmentApprovalPolicy) int {
+		return strings.Compare(lo.FromPtr(a.Metadata.Name), lo.FromPtr(b.Metadata.Name))
+	})
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if !enrollmentApprovalPolicyMatches(policy, er) {
+			continue
+		}
+
+		// approve a copy, so that a failed approval leaves the enrollment request unchanged
+		approved := *er
+		approved.Status = &domain.EnrollmentRequestStatus{
+			Certificate: er.Status.Certificate,
+			Conditions:  slices.Clone(er.Status.Conditions),
+		}
+		approval := domain.EnrollmentRequestApprovalStatus{
+			Approved:   true,
+			Labels:     lo.ToPtr(util.EnsureMap(maps.Clone(lo.FromPtr(policy.Spec.Labels)))),
+			ApprovedAt: time.Now(),
+			ApprovedBy: fmt.Sprintf("%s/%s", domain.EnrollmentApprovalPolicyKind, lo.FromPtr(policy.Metadata.Name)),
+		}
+		if err := approveAndSignEnrollmentRequest(ctx, h.ca, &approved, &approval, domain.EnrollmentRequestPolicyApprovedReason); err != nil {
+			status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
+			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return er
+		}
+		if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, &approved); err != nil {
+			status := domain.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err))
+			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return er
+		}
+
+		result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &approved, h.callbackEnrollmentRequestApproved)
+		if err != nil {
+			return er
+		}
+		h.log.Infof("Enrollment request %s approved by %s", name, approval.ApprovedBy)
+		return result
+	}
+	return er
+}
+
+// enrollmentApprovalPolicyMatches reports whether an enrollment request meets all the conditions of a policy.
+func enrollmentApprovalPolicyMatches(policy *domain.EnrollmentApprovalPolicy, er *domain.EnrollmentRequest) bool {
+	match := policy.Spec.Match
+	if !lo.FromPtr(match.TpmAttested) && match.EnrollmentCredentials == nil && match.AllowList == nil && match.LabelSelector == nil {
+		return false
+	}
+	// the values matched by an allow list or a label selector are reported by the device, so they
+	// can only be trusted if the device attested its identity with a TPM
+	if (match.AllowList != nil || match.LabelSelector != nil) && !lo.FromPtr(match.TpmAttested) {
+		return false
+	}
+
+	if lo.FromPtr(match.TpmAttested) && !domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified) {
+		return false
+	}
+	if match.EnrollmentCredentials != nil {
+		credential, ok := lo.FromPtr(er.Metadata.Annotations)[domain.EnrollmentRequestAnnotationEnrollmentCredential]
+		if !ok || !slices.Contains(*match.EnrollmentCredentials, credential) {
+			return false
+		}
+	}
+	if match.AllowList != nil {
+		productName, productSerial := enrollmentRequestProduct(er)
+		if serials := lo.FromPtr(match.AllowList.SerialNumbers); len(serials) > 0 && (productSerial == "" || !slices.Contains(serials, productSerial)) {
+			return false
+		}
+		if names := lo.FromPtr(match.AllowList.ProductNames); len(names) > 0 && (productName == "" || !slices.Contains(names, productName)) {
+			return false
+		}
+	}
+	if match.LabelSelector != nil && !match.LabelSelector.Matches(lo.FromPtr(er.Spec.Labels)) {
+		return false
+	}
+	return true
+}
+
+// enrollmentRequestProduct returns the product name and serial number of the device of an enrollment
+// request. The values signed by the TPM in a TCG CSR take precedence over the system info reported by
+// the agent.
+func enrollmentRequestProduct(er *domain.EnrollmentRequest) (string, string) {
+	var productName, productSerial string
+	if er.Spec.DeviceStatus != nil {
+		productName = er.Spec.DeviceStatus.SystemInfo.AdditionalProperties[systemInfoProductNameKey]
+		productSerial = er.Spec.DeviceStatus.SystemInfo.AdditionalProperties[systemInfoProductSerialKey]
+	}
+
+	if csrBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr); isTPM {
+		if parsed, err := tpm.ParseTCGCSR(csrBytes); err == nil && parsed.CSRContents != nil && parsed.CSRContents.Payload != nil {
+			if model := string(parsed.CSRContents.Payload.ProdModel); model != "" {
+				productName = model
+			}
+			if serial := string(parsed.CSRContents.Payload.ProdSerial); serial != "" {
+				productSerial = serial
+			}
+		}
+	}
+	return productName, productSerial
+}
+
+// callbackEnrollmentApprovalPolicyUpdated is the enrollment approval policy-specific callback that handles enrollment approval policy update events
+func (h *ServiceHandler) callbackEnrollmentApprovalPolicyUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEnrollmentApprovalPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEnrollmentApprovalPolicyDeleted is the enrollment approval policy-specific callback that handles enrollment approval policy deletion events
+func (h *ServiceHandler) callbackEnrollmentApprovalPolicyDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
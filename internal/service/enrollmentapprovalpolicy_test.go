@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnrollmentApprovalPolicy(name string, match domain.EnrollmentApprovalPolicyMatch, labels map[string]string) domain.EnrollmentApprovalPolicy {
+	return domain.EnrollmentApprovalPolicy{
+		ApiVersion: "v1beta1",
+		Kind:       domain.EnrollmentApprovalPolicyKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.EnrollmentApprovalPolicySpec{
+			Match:  match,
+			Labels: lo.ToPtr(labels),
+		},
+	}
+}
+
+func newTestPolicyEnrollmentRequest(t *testing.T, name string, labels map[string]string) domain.EnrollmentRequest {
+	require := require.New(t)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrTemplate := x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: name},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, privateKey)
+	require.NoError(err)
+
+	return domain.EnrollmentRequest{
+		ApiVersion: "v1beta1",
+		Kind:       domain.EnrollmentRequestKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.EnrollmentRequestSpec{
+			Csr:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})),
+			DeviceStatus: lo.ToPtr(domain.NewDeviceStatus()),
+			Labels:       lo.ToPtr(labels),
+		},
+	}
+}
+
+// withTestEnrollmentCredential records the enrollment credential an enrollment request was submitted
+// with, as the service does for requests authenticated with an enrollment certificate.
+func withTestEnrollmentCredential(ctx context.Context, er *domain.EnrollmentRequest, credential string) context.Context {
+	er.Metadata.Annotations = &map[string]string{domain.EnrollmentRequestAnnotationEnrollmentCredential: credential}
+	return context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+}
+
+func newTestEnrollmentApprovalPolicyHandler(t *testing.T) (*ServiceHandler, context.Context) {
+	require := require.New(t)
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(err)
+
+	return newTestServiceHandler(t, &TestStore{}, caClient)
+}
+
+func TestCreateEnrollmentApprovalPolicyWithoutMatchConditions(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestEnrollmentApprovalPolicyHandler(t)
+
+	policy := newTestEnrollmentApprovalPolicy("factory-a-policy", domain.EnrollmentApprovalPolicyMatch{}, nil)
+	_, status := serviceHandler.CreateEnrollmentApprovalPolicy(ctx, store.NullOrgId, policy)
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestEnrollmentApprovalPolicyApprovesMatchingEnrollmentRequest(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestEnrollmentApprovalPolicyHandler(t)
+	orgId := store.NullOrgId
+
+	policy := newTestEnrollmentApprovalPolicy("factory-a-policy", domain.EnrollmentApprovalPolicyMatch{
+		EnrollmentCredentials: &[]string{"factory-a"},
+	}, map[string]string{"fleet": "production"})
+	_, status := serviceHandler.CreateEnrollmentApprovalPolicy(ctx, orgId, policy)
+	require.Equal(domain.StatusCreated(), status)
+
+	er := newTestPolicyEnrollmentRequest(t, "factory-a-device-0001", map[string]string{"site": "factory-a"})
+	result, status := serviceHandler.CreateEnrollmentRequest(withTestEnrollmentCredential(ctx, &er, "factory-a"), orgId, er)
+	require.Equal(domain.StatusCreated(), status)
+	require.NotNil(result.Status.Approval)
+	require.True(result.Status.Approval.Approved)
+	require.Equal("EnrollmentApprovalPolicy/factory-a-policy", result.Status.Approval.ApprovedBy)
+	require.NotNil(result.Status.Certificate)
+
+	condition := domain.FindStatusCondition(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved)
+	require.NotNil(condition)
+	require.Equal(domain.ConditionStatusTrue, condition.Status)
+	require.Equal(domain.EnrollmentRequestPolicyApprovedReason, condition.Reason)
+
+	device, err := serviceHandler.store.Device().Get(ctx, orgId, "factory-a-device-0001")
+	require.NoError(err)
+	require.Equal("production", lo.FromPtr(device.Metadata.Labels)["fleet"])
+	require.Equal("factory-a", lo.FromPtr(device.Metadata.Labels)["site"])
+}
+
+func TestEnrollmentApprovalPolicyLeavesNonMatchingEnrollmentRequestPending(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestEnrollmentApprovalPolicyHandler(t)
+	orgId := store.NullOrgId
+
+	policy := newTestEnrollmentApprovalPolicy("factory-a-policy", domain.EnrollmentApprovalPolicyMatch{
+		EnrollmentCredentials: &[]string{"factory-a"},
+	}, nil)
+	_, status := serviceHandler.CreateEnrollmentApprovalPolicy(ctx, orgId, policy)
+	require.Equal(domain.StatusCreated(), status)
+
+	er := newTestPolicyEnrollmentRequest(t, "factory-b-device-0001", map[string]string{"site": "factory-b"})
+	result, status := serviceHandler.CreateEnrollmentRequest(withTestEnrollmentCredential(ctx, &er, "factory-b"), orgId, er)
+	require.Equal(domain.StatusCreated(), status)
+	require.Nil(result.Status.Approval)
+	require.False(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+
+	_, err := serviceHandler.store.Device().Get(ctx, orgId, "factory-b-device-0001")
+	require.Error(err)
+}
+
+func TestEnrollmentApprovalPolicyMatches(t *testing.T) {
+	tpmVerified := []domain.Condition{{Type: domain.ConditionTypeEnrollmentRequestTPMVerified, Status: domain.ConditionStatusTrue}}
+	systemInfo := map[string]string{systemInfoProductNameKey: "edge-gateway", systemInfoProductSerialKey: "SN-0001"}
+
+	tests := []struct {
+		name        string
+		match       domain.EnrollmentApprovalPolicyMatch
+		conditions  []domain.Condition
+		credential  string
+		systemInfo  map[string]string
+		wantMatched bool
+	}{
+		{
+			name:        "no conditions never match",
+			match:       domain.EnrollmentApprovalPolicyMatch{},
+			wantMatched: false,
+		},
+		{
+			name:        "tpm attested request",
+			match:       domain.EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true)},
+			conditions:  tpmVerified,
+			wantMatched: true,
+		},
+		{
+			name:        "unattested request",
+			match:       domain.EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true)},
+			wantMatched: false,
+		},
+		{
+			name:        "listed enrollment credential",
+			match:       domain.EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-a"}},
+			credential:  "factory-a",
+			wantMatched: true,
+		},
+		{
+			name:        "unlisted enrollment credential",
+			match:       domain.EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-a"}},
+			credential:  "factory-b",
+			wantMatched: false,
+		},
+		{
+			name: "allow-listed serial number and product name",
+			match: domain.EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), AllowList: &domain.EnrollmentApprovalAllowList{
+				SerialNumbers: &[]string{"SN-0001"},
+				ProductNames:  &[]string{"edge-gateway"},
+			}},
+			conditions:  tpmVerified,
+			systemInfo:  systemInfo,
+			wantMatched: true,
+		},
+		{
+			name: "serial number not allow-listed",
+			match: domain.EnrollmentApprovalPolicyMatch{TpmAttested: lo.ToPtr(true), AllowList: &domain.EnrollmentApprovalAllowList{
+				SerialNumbers: &[]string{"SN-0002"},
+			}},
+			conditions:  tpmVerified,
+			systemInfo:  systemInfo,
+			wantMatched: false,
+		},
+		{
+			name: "allow list without tpm attestation never matches",
+			match: domain.EnrollmentApprovalPolicyMatch{AllowList: &domain.EnrollmentApprovalAllowList{
+				SerialNumbers: &[]string{"SN-0001"},
+			}},
+			conditions:  tpmVerified,
+			systemInfo:  systemInfo,
+			wantMatched: false,
+		},
+		{
+			name: "all conditions must match",
+			match: domain.EnrollmentApprovalPolicyMatch{
+				TpmAttested:           lo.ToPtr(true),
+				EnrollmentCredentials: &[]string{"factory-a"},
+			},
+			credential:  "factory-a",
+			wantMatched: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestEnrollmentApprovalPolicy("test-policy", tt.match, nil)
+			er := newTestPolicyEnrollmentRequest(t, "test-device-fingerprint-long", nil)
+			er.Status = &domain.EnrollmentRequestStatus{Conditions: tt.conditions}
+			if tt.credential != "" {
+				er.Metadata.Annotations = &map[string]string{domain.EnrollmentRequestAnnotationEnrollmentCredential: tt.credential}
+			}
+			if tt.systemInfo != nil {
+				er.Spec.DeviceStatus.SystemInfo.AdditionalProperties = tt.systemInfo
+			}
+			require.Equal(t, tt.wantMatched, enrollmentApprovalPolicyMatches(&policy, &er))
+		})
+	}
+}
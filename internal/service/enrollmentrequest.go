@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -64,7 +65,7 @@ func (h *ServiceHandler) verifyTPMEnrollmentRequest(er *domain.EnrollmentRequest
 	return nil
 }
 
-func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus) error {
+func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus, reason string) error {
 	if enrollmentRequest == nil {
 		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
 	}
@@ -104,7 +105,7 @@ func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, e
 	condition := domain.Condition{
 		Type:    domain.ConditionTypeEnrollmentRequestApproved,
 		Status:  domain.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
+		Reason:  reason,
 		Message: "Approved by " + approval.ApprovedBy,
 	}
 	domain.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
@@ -270,6 +271,17 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 		NilOutManagedObjectMetaProperties(&er.Metadata)
 	}
 
+	// Record the enrollment credential the request was submitted with for the enrollment approval policies
+	if h.ca != nil {
+		if peerSigner := h.ca.PeerCertificateSignerFromCtx(ctx); peerSigner != nil && peerSigner.Name() == h.ca.Cfg.DeviceEnrollmentSignerName {
+			if peerCertificate, err := h.ca.PeerCertificateFromCtx(ctx); err == nil {
+				annotations := util.EnsureMap(lo.FromPtr(er.Metadata.Annotations))
+				annotations[domain.EnrollmentRequestAnnotationEnrollmentCredential] = strings.TrimPrefix(peerCertificate.Subject.CommonName, h.ca.Cfg.ClientBootstrapCommonNamePrefix)
+				er.Metadata.Annotations = &annotations
+			}
+		}
+	}
+
 	// Check if knownRenderedVersion is provided and not "0", add awaitingReconnect annotation
 	if er.Spec.KnownRenderedVersion != nil && *er.Spec.KnownRenderedVersion != "" && *er.Spec.KnownRenderedVersion != "0" {
 		annotations := util.EnsureMap(lo.FromPtr(er.Metadata.Annotations))
@@ -302,7 +314,10 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 
 	// Use fromAPI=false for internal requests to preserve annotations
 	result, err := h.store.EnrollmentRequest().CreateWithFromAPI(ctx, orgId, &er, false, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	if err != nil {
+		return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	}
+	return h.approveEnrollmentRequestByPolicy(ctx, orgId, result), domain.StatusCreated()
 }
 
 func (h *ServiceHandler) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
@@ -466,7 +481,7 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 		}
 		approvalStatusToReturn = &approvalStatus
 
-		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus)
+		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus, domain.EnrollmentRequestManuallyApprovedReason)
 		if err != nil {
 			status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
 			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
@@ -489,6 +504,24 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	addStatusIfNeeded(&er)
 
+	// the TPM verification is only recorded by the agent server once the device solved its challenge,
+	// so external requests keep the stored condition rather than setting their own
+	if !IsInternalRequest(ctx) {
+		existing, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+		}
+		status := *er.Status
+		status.Conditions = slices.Clone(status.Conditions)
+		er.Status = &status
+		domain.RemoveStatusCondition(&er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified)
+		if existing.Status != nil {
+			if condition := domain.FindStatusCondition(existing.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified); condition != nil {
+				er.Status.Conditions = append(er.Status.Conditions, *condition)
+			}
+		}
+	}
+
 	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
 	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
 }
@@ -132,6 +132,31 @@ func TestNotFoundReplaceEnrollmentRequestStatus(t *testing.T) {
 	require.Equal(statusNotFoundCode, status.Code)
 }
 
+func TestReplaceEnrollmentRequestStatusKeepsTPMVerification(t *testing.T) {
+	require := require.New(t)
+	notVerified := domain.Condition{
+		Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+		Status: domain.ConditionStatusFalse,
+		Reason: domain.TPMChallengeRequiredReason,
+	}
+	serviceHandler, ctx, testOrgId, er := createTestEnrollmentRequest(t, "foo", &domain.EnrollmentRequestStatus{Conditions: []domain.Condition{notVerified}})
+
+	er.Status = &domain.EnrollmentRequestStatus{Conditions: []domain.Condition{{
+		Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+		Status: domain.ConditionStatusTrue,
+		Reason: domain.TPMChallengeSucceededReason,
+	}}}
+	result, status := serviceHandler.ReplaceEnrollmentRequestStatus(ctx, testOrgId, "foo", er)
+	require.Equal(statusSuccessCode, status.Code)
+	require.False(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified))
+	require.False(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+
+	internalCtx := context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+	result, status = serviceHandler.ReplaceEnrollmentRequestStatus(internalCtx, testOrgId, "foo", er)
+	require.Equal(statusSuccessCode, status.Code)
+	require.True(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified))
+}
+
 func TestEnrollmentRequestPatchInvalidRequests(t *testing.T) {
 	require := require.New(t)
 
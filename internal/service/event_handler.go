@@ -116,6 +116,15 @@ func (h *EventHandler) HandleDeviceUpdatedEvents(ctx context.Context, resourceKi
 
 			h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.DeviceKind, name, updateDetails, h.log, annotations))
 		}
+
+		// Changes of the status fields that fleets may select devices by are not recorded, but handed
+		// to the workers so that the fleet membership of the device is re-evaluated
+		if h.workerClient != nil && domain.FleetSelectorFieldsChanged(oldDevice, newDevice) {
+			systemInfoDetails := &domain.ResourceUpdatedDetails{
+				UpdatedFields: []domain.ResourceUpdatedDetailsUpdatedFields{domain.SystemInfo},
+			}
+			h.workerClient.EmitEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.DeviceKind, name, systemInfoDetails, h.log, nil))
+		}
 	}
 }
 
@@ -166,7 +175,7 @@ func (h *EventHandler) HandleFleetUpdatedEvents(ctx context.Context, resourceKin
 					updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SpecTemplate)
 					removeSpec = true
 				}
-				if !reflect.DeepEqual(oldFleet.Spec.Selector, newFleet.Spec.Selector) {
+				if !reflect.DeepEqual(oldFleet.Spec.Selector, newFleet.Spec.Selector) || !reflect.DeepEqual(oldFleet.Spec.FieldSelector, newFleet.Spec.FieldSelector) {
 					updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SpecSelector)
 					removeSpec = true
 				}
@@ -515,6 +524,125 @@ func (h *EventHandler) HandleTemplateVersionUpdatedEvents(ctx context.Context, r
 	}
 }
 
+//////////////////////////////////////////////////////
+//                 SecretStore Events               //
+//////////////////////////////////////////////////////
+
+// HandleSecretStoreUpdatedEvents handles secret store update event emission logic
+func (h *EventHandler) HandleSecretStoreUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldSecretStore, newSecretStore *domain.SecretStore
+				ok                             bool
+			)
+			if oldSecretStore, newSecretStore, ok = castResources[domain.SecretStore](oldResource, newResource); ok && oldSecretStore != nil && newSecretStore != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldSecretStore.Metadata, newSecretStore.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleEnrollmentApprovalPolicyUpdatedEvents handles enrollment approval policy update event emission logic
+func (h *EventHandler) HandleEnrollmentApprovalPolicyUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldPolicy, newPolicy *domain.EnrollmentApprovalPolicy
+				ok                   bool
+			)
+			if oldPolicy, newPolicy, ok = castResources[domain.EnrollmentApprovalPolicy](oldResource, newResource); ok && oldPolicy != nil && newPolicy != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldPolicy.Metadata, newPolicy.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleRoleUpdatedEvents handles role update event emission logic
+func (h *EventHandler) HandleRoleUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldRole, newRole *domain.Role
+				ok               bool
+			)
+			if oldRole, newRole, ok = castResources[domain.Role](oldResource, newResource); ok && oldRole != nil && newRole != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldRole.Metadata, newRole.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleRoleBindingUpdatedEvents handles role binding update event emission logic
+func (h *EventHandler) HandleRoleBindingUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldBinding, newBinding *domain.RoleBinding
+				ok                     bool
+			)
+			if oldBinding, newBinding, ok = castResources[domain.RoleBinding](oldResource, newResource); ok && oldBinding != nil && newBinding != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldBinding.Metadata, newBinding.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleServiceAccountUpdatedEvents handles service account update event emission logic
+func (h *EventHandler) HandleServiceAccountUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		// Compute ResourceUpdatedDetails for updates
+		var updateDetails *domain.ResourceUpdatedDetails
+		if !created {
+			var (
+				oldServiceAccount, newServiceAccount *domain.ServiceAccount
+				ok                                   bool
+			)
+			if oldServiceAccount, newServiceAccount, ok = castResources[domain.ServiceAccount](oldResource, newResource); ok && oldServiceAccount != nil && newServiceAccount != nil {
+				updateDetails = h.computeResourceUpdatedDetails(oldServiceAccount.Metadata, newServiceAccount.Metadata)
+			}
+		}
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// HandleDeviceBulkOperationCreatedEvents handles device bulk operation creation event emission logic
+func (h *EventHandler) HandleDeviceBulkOperationCreatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, _, _ interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+	} else {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, nil, h.log, nil))
+	}
+}
+
 // HandleCatalogUpdatedEvents handles catalog update event emission logic
 func (h *EventHandler) HandleCatalogUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	if err != nil {
@@ -599,10 +727,10 @@ func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, orgI
 		if domain.IsStatusConditionTrue(newConditions, domain.ConditionTypeResourceSyncSynced) {
 			h.CreateEvent(ctx, orgId, common.GetResourceSyncSyncedEvent(ctx, name))
 		} else {
-			// Only emit failure event if it's an actual failure, not just "NewHashDetected"
+			// Only emit failure event if it's an actual failure, not just "NewHashDetected" or "DryRun"
 			// "NewHashDetected" is a normal state change, not a failure
 			// The commit detected event is already emitted when the hash changes
-			if newSynced != nil && newSynced.Reason != domain.ResourceSyncNewHashDetectedReason {
+			if newSynced != nil && newSynced.Reason != domain.ResourceSyncNewHashDetectedReason && newSynced.Reason != domain.ResourceSyncDryRunReason {
 				message := "Resource sync failed"
 				if newSynced.Message != "" {
 					message = newSynced.Message
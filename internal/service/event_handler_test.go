@@ -17,12 +17,14 @@ import (
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/worker_client"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"golang.org/x/sync/semaphore"
 )
 
@@ -260,6 +262,72 @@ func TestEventHandler_HandleDeviceUpdatedEmptyOldDevice(t *testing.T) {
 	assert.Equal(t, 1, len(events.Items))
 }
 
+func TestEventHandler_HandleDeviceUpdatedFleetSelectorFields(t *testing.T) {
+	ctx := context.Background()
+	testOrgId := uuid.New()
+
+	tests := []struct {
+		name        string
+		updateFn    func(d *domain.Device)
+		wantEmitted bool
+	}{
+		{
+			name:     "boot ID changed",
+			updateFn: func(d *domain.Device) { d.Status.SystemInfo.BootID = "5" },
+		},
+		{
+			name:        "architecture changed",
+			updateFn:    func(d *domain.Device) { d.Status.SystemInfo.Architecture = "arm64" },
+			wantEmitted: true,
+		},
+		{
+			name: "custom info changed",
+			updateFn: func(d *domain.Device) {
+				d.Status.SystemInfo.CustomInfo = &domain.CustomDeviceInfo{"siteCode": "nyc-01"}
+			},
+			wantEmitted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			workerClient := worker_client.NewMockWorkerClient(ctrl)
+			testStore := &TestStore{}
+			eventHandler := NewEventHandler(testStore, workerClient, logrus.New())
+
+			var emitted []*domain.Event
+			workerClient.EXPECT().EmitEvent(gomock.Any(), testOrgId, gomock.Any()).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+				emitted = append(emitted, event)
+			}).AnyTimes()
+
+			oldDevice := prepareDevice(uuid.New(), "foo")
+			newDevice := prepareDevice(uuid.New(), "foo")
+			tt.updateFn(newDevice)
+			eventHandler.HandleDeviceUpdatedEvents(ctx, domain.DeviceKind, testOrgId, "foo", oldDevice, newDevice, false, nil)
+
+			var systemInfoUpdated bool
+			for _, event := range emitted {
+				if event.Reason != domain.EventReasonResourceUpdated || event.Details == nil {
+					continue
+				}
+				details, err := event.Details.AsResourceUpdatedDetails()
+				require.NoError(err)
+				systemInfoUpdated = systemInfoUpdated || lo.Contains(details.UpdatedFields, domain.SystemInfo)
+			}
+			require.Equal(tt.wantEmitted, systemInfoUpdated)
+
+			// the change is not recorded as an event
+			events, err := testStore.Event().List(ctx, testOrgId, store.ListParams{})
+			require.NoError(err)
+			for _, event := range events.Items {
+				require.NotEqual(domain.EventReasonResourceUpdated, event.Reason)
+			}
+		})
+	}
+}
+
 func TestEventHandler_DeviceDisconnectedEventDeduplication(t *testing.T) {
 	serviceHandler := serviceHandler()
 
@@ -59,6 +59,21 @@ func (mr *MockServiceMockRecorder) ApproveEnrollmentRequest(ctx, orgId, name, ap
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEnrollmentRequest", reflect.TypeOf((*MockService)(nil).ApproveEnrollmentRequest), ctx, orgId, name, approval)
 }
 
+// ApproveEnrollmentRequestByPolicy mocks base method.
+func (m *MockService) ApproveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveEnrollmentRequestByPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.EnrollmentRequest)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ApproveEnrollmentRequestByPolicy indicates an expected call of ApproveEnrollmentRequestByPolicy.
+func (mr *MockServiceMockRecorder) ApproveEnrollmentRequestByPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEnrollmentRequestByPolicy", reflect.TypeOf((*MockService)(nil).ApproveEnrollmentRequestByPolicy), ctx, orgId, name)
+}
+
 // CountDevices mocks base method.
 func (m *MockService) CountDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (int64, domain.Status) {
 	m.ctrl.T.Helper()
@@ -89,6 +104,20 @@ func (mr *MockServiceMockRecorder) CountDevicesByLabels(ctx, orgId, params, anno
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDevicesByLabels", reflect.TypeOf((*MockService)(nil).CountDevicesByLabels), ctx, orgId, params, annotationSelector, groupBy)
 }
 
+// CreateAuditEvent mocks base method.
+func (m *MockService) CreateAuditEvent(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", ctx, orgId, event)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockServiceMockRecorder) CreateAuditEvent(ctx, orgId, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockService)(nil).CreateAuditEvent), ctx, orgId, event)
+}
+
 // CreateAuthProvider mocks base method.
 func (m *MockService) CreateAuthProvider(ctx context.Context, orgId uuid.UUID, authProvider domain.AuthProvider) (*domain.AuthProvider, domain.Status) {
 	m.ctrl.T.Helper()
@@ -164,6 +193,36 @@ func (mr *MockServiceMockRecorder) CreateDevice(ctx, orgId, device any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockService)(nil).CreateDevice), ctx, orgId, device)
 }
 
+// CreateDeviceBulkOperation mocks base method.
+func (m *MockService) CreateDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.DeviceBulkOperation, dryRun bool) (*domain.DeviceBulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceBulkOperation", ctx, orgId, operation, dryRun)
+	ret0, _ := ret[0].(*domain.DeviceBulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceBulkOperation indicates an expected call of CreateDeviceBulkOperation.
+func (mr *MockServiceMockRecorder) CreateDeviceBulkOperation(ctx, orgId, operation, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceBulkOperation", reflect.TypeOf((*MockService)(nil).CreateDeviceBulkOperation), ctx, orgId, operation, dryRun)
+}
+
+// CreateEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnrollmentApprovalPolicy", ctx, orgId, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateEnrollmentApprovalPolicy indicates an expected call of CreateEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) CreateEnrollmentApprovalPolicy(ctx, orgId, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).CreateEnrollmentApprovalPolicy), ctx, orgId, policy)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockService) CreateEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -236,6 +295,81 @@ func (mr *MockServiceMockRecorder) CreateResourceSync(ctx, orgId, rs any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSync", reflect.TypeOf((*MockService)(nil).CreateResourceSync), ctx, orgId, rs)
 }
 
+// CreateRole mocks base method.
+func (m *MockService) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, orgId, role)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockServiceMockRecorder) CreateRole(ctx, orgId, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockService)(nil).CreateRole), ctx, orgId, role)
+}
+
+// CreateRoleBinding mocks base method.
+func (m *MockService) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoleBinding", ctx, orgId, binding)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateRoleBinding indicates an expected call of CreateRoleBinding.
+func (mr *MockServiceMockRecorder) CreateRoleBinding(ctx, orgId, binding any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleBinding", reflect.TypeOf((*MockService)(nil).CreateRoleBinding), ctx, orgId, binding)
+}
+
+// CreateSecretStore mocks base method.
+func (m *MockService) CreateSecretStore(ctx context.Context, orgId uuid.UUID, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecretStore", ctx, orgId, secretStore)
+	ret0, _ := ret[0].(*domain.SecretStore)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateSecretStore indicates an expected call of CreateSecretStore.
+func (mr *MockServiceMockRecorder) CreateSecretStore(ctx, orgId, secretStore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretStore", reflect.TypeOf((*MockService)(nil).CreateSecretStore), ctx, orgId, secretStore)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockService) CreateServiceAccount(ctx context.Context, orgId uuid.UUID, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, orgId, serviceAccount)
+	ret0, _ := ret[0].(*domain.ServiceAccount)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockServiceMockRecorder) CreateServiceAccount(ctx, orgId, serviceAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockService)(nil).CreateServiceAccount), ctx, orgId, serviceAccount)
+}
+
+// CreateServiceAccountToken mocks base method.
+func (m *MockService) CreateServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, token domain.ServiceAccountToken) (*domain.ServiceAccountToken, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccountToken", ctx, orgId, serviceAccount, token)
+	ret0, _ := ret[0].(*domain.ServiceAccountToken)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateServiceAccountToken indicates an expected call of CreateServiceAccountToken.
+func (mr *MockServiceMockRecorder) CreateServiceAccountToken(ctx, orgId, serviceAccount, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccountToken", reflect.TypeOf((*MockService)(nil).CreateServiceAccountToken), ctx, orgId, serviceAccount, token)
+}
+
 // CreateTemplateVersion mocks base method.
 func (m *MockService) CreateTemplateVersion(ctx context.Context, orgId uuid.UUID, tv domain.TemplateVersion, immediateRollout bool) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -266,6 +400,21 @@ func (mr *MockServiceMockRecorder) DecommissionDevice(ctx, orgId, name, decom an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionDevice", reflect.TypeOf((*MockService)(nil).DecommissionDevice), ctx, orgId, name, decom)
 }
 
+// DeleteAuditEventsOlderThan mocks base method.
+func (m *MockService) DeleteAuditEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuditEventsOlderThan", ctx, cutoffTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// DeleteAuditEventsOlderThan indicates an expected call of DeleteAuditEventsOlderThan.
+func (mr *MockServiceMockRecorder) DeleteAuditEventsOlderThan(ctx, cutoffTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuditEventsOlderThan", reflect.TypeOf((*MockService)(nil).DeleteAuditEventsOlderThan), ctx, cutoffTime)
+}
+
 // DeleteAuthProvider mocks base method.
 func (m *MockService) DeleteAuthProvider(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -336,6 +485,34 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, orgId, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, orgId, name)
 }
 
+// DeleteDeviceBulkOperation mocks base method.
+func (m *MockService) DeleteDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteDeviceBulkOperation indicates an expected call of DeleteDeviceBulkOperation.
+func (mr *MockServiceMockRecorder) DeleteDeviceBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceBulkOperation", reflect.TypeOf((*MockService)(nil).DeleteDeviceBulkOperation), ctx, orgId, name)
+}
+
+// DeleteEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentApprovalPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteEnrollmentApprovalPolicy indicates an expected call of DeleteEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) DeleteEnrollmentApprovalPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentApprovalPolicy), ctx, orgId, name)
+}
+
 // DeleteEnrollmentRequest mocks base method.
 func (m *MockService) DeleteEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -407,6 +584,76 @@ func (mr *MockServiceMockRecorder) DeleteResourceSync(ctx, orgId, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSync", reflect.TypeOf((*MockService)(nil).DeleteResourceSync), ctx, orgId, name)
 }
 
+// DeleteRole mocks base method.
+func (m *MockService) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockServiceMockRecorder) DeleteRole(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockService)(nil).DeleteRole), ctx, orgId, name)
+}
+
+// DeleteRoleBinding mocks base method.
+func (m *MockService) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleBinding", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteRoleBinding indicates an expected call of DeleteRoleBinding.
+func (mr *MockServiceMockRecorder) DeleteRoleBinding(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleBinding", reflect.TypeOf((*MockService)(nil).DeleteRoleBinding), ctx, orgId, name)
+}
+
+// DeleteSecretStore mocks base method.
+func (m *MockService) DeleteSecretStore(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretStore", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteSecretStore indicates an expected call of DeleteSecretStore.
+func (mr *MockServiceMockRecorder) DeleteSecretStore(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretStore", reflect.TypeOf((*MockService)(nil).DeleteSecretStore), ctx, orgId, name)
+}
+
+// DeleteServiceAccount mocks base method.
+func (m *MockService) DeleteServiceAccount(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceAccount", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteServiceAccount indicates an expected call of DeleteServiceAccount.
+func (mr *MockServiceMockRecorder) DeleteServiceAccount(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccount", reflect.TypeOf((*MockService)(nil).DeleteServiceAccount), ctx, orgId, name)
+}
+
+// DeleteServiceAccountToken mocks base method.
+func (m *MockService) DeleteServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceAccountToken", ctx, orgId, serviceAccount, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteServiceAccountToken indicates an expected call of DeleteServiceAccountToken.
+func (mr *MockServiceMockRecorder) DeleteServiceAccountToken(ctx, orgId, serviceAccount, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountToken", reflect.TypeOf((*MockService)(nil).DeleteServiceAccountToken), ctx, orgId, serviceAccount, name)
+}
+
 // DeleteTemplateVersion mocks base method.
 func (m *MockService) DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -586,6 +833,21 @@ func (mr *MockServiceMockRecorder) GetDevice(ctx, orgId, name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockService)(nil).GetDevice), ctx, orgId, name)
 }
 
+// GetDeviceBulkOperation mocks base method.
+func (m *MockService) GetDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.DeviceBulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceBulkOperation indicates an expected call of GetDeviceBulkOperation.
+func (mr *MockServiceMockRecorder) GetDeviceBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceBulkOperation", reflect.TypeOf((*MockService)(nil).GetDeviceBulkOperation), ctx, orgId, name)
+}
+
 // GetDeviceCompletionCounts mocks base method.
 func (m *MockService) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, domain.Status) {
 	m.ctrl.T.Helper()
@@ -661,6 +923,21 @@ func (mr *MockServiceMockRecorder) GetDevicesSummary(ctx, orgId, params, annotat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevicesSummary", reflect.TypeOf((*MockService)(nil).GetDevicesSummary), ctx, orgId, params, annotationSelector)
 }
 
+// GetEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentApprovalPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetEnrollmentApprovalPolicy indicates an expected call of GetEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) GetEnrollmentApprovalPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).GetEnrollmentApprovalPolicy), ctx, orgId, name)
+}
+
 // GetEnrollmentConfig mocks base method.
 func (m *MockService) GetEnrollmentConfig(ctx context.Context, orgId uuid.UUID, params domain.GetEnrollmentConfigParams) (*domain.EnrollmentConfig, domain.Status) {
 	m.ctrl.T.Helper()
@@ -841,6 +1118,66 @@ func (mr *MockServiceMockRecorder) GetResourceSync(ctx, orgId, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSync", reflect.TypeOf((*MockService)(nil).GetResourceSync), ctx, orgId, name)
 }
 
+// GetRole mocks base method.
+func (m *MockService) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockServiceMockRecorder) GetRole(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockService)(nil).GetRole), ctx, orgId, name)
+}
+
+// GetRoleBinding mocks base method.
+func (m *MockService) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleBinding", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetRoleBinding indicates an expected call of GetRoleBinding.
+func (mr *MockServiceMockRecorder) GetRoleBinding(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleBinding", reflect.TypeOf((*MockService)(nil).GetRoleBinding), ctx, orgId, name)
+}
+
+// GetSecretStore mocks base method.
+func (m *MockService) GetSecretStore(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretStore", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.SecretStore)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetSecretStore indicates an expected call of GetSecretStore.
+func (mr *MockServiceMockRecorder) GetSecretStore(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretStore", reflect.TypeOf((*MockService)(nil).GetSecretStore), ctx, orgId, name)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockService) GetServiceAccount(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.ServiceAccount)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockServiceMockRecorder) GetServiceAccount(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockService)(nil).GetServiceAccount), ctx, orgId, name)
+}
+
 // GetTemplateVersion mocks base method.
 func (m *MockService) GetTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -886,6 +1223,21 @@ func (mr *MockServiceMockRecorder) ListAllCatalogItems(ctx, orgId, params any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCatalogItems", reflect.TypeOf((*MockService)(nil).ListAllCatalogItems), ctx, orgId, params)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockService) ListAuditEvents(ctx context.Context, orgId uuid.UUID, params domain.ListAuditEventsParams) (*domain.AuditEventList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.AuditEventList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockServiceMockRecorder) ListAuditEvents(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockService)(nil).ListAuditEvents), ctx, orgId, params)
+}
+
 // ListAuthProviders mocks base method.
 func (m *MockService) ListAuthProviders(ctx context.Context, orgId uuid.UUID, params domain.ListAuthProvidersParams) (*domain.AuthProviderList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -931,6 +1283,21 @@ func (mr *MockServiceMockRecorder) ListCatalogs(ctx, orgId, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCatalogs", reflect.TypeOf((*MockService)(nil).ListCatalogs), ctx, orgId, params)
 }
 
+// ListCertificateRevocations mocks base method.
+func (m *MockService) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateRevocationsParams) (*domain.CertificateRevocationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificateRevocations", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.CertificateRevocationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListCertificateRevocations indicates an expected call of ListCertificateRevocations.
+func (mr *MockServiceMockRecorder) ListCertificateRevocations(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificateRevocations", reflect.TypeOf((*MockService)(nil).ListCertificateRevocations), ctx, orgId, params)
+}
+
 // ListCertificateSigningRequests mocks base method.
 func (m *MockService) ListCertificateSigningRequests(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateSigningRequestsParams) (*domain.CertificateSigningRequestList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -961,6 +1328,21 @@ func (mr *MockServiceMockRecorder) ListConnectivityChangedDevices(ctx, orgId, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectivityChangedDevices", reflect.TypeOf((*MockService)(nil).ListConnectivityChangedDevices), ctx, orgId, params, cutoffTime)
 }
 
+// ListDeviceBulkOperations mocks base method.
+func (m *MockService) ListDeviceBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceBulkOperationsParams) (*domain.DeviceBulkOperationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeviceBulkOperations", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.DeviceBulkOperationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListDeviceBulkOperations indicates an expected call of ListDeviceBulkOperations.
+func (mr *MockServiceMockRecorder) ListDeviceBulkOperations(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeviceBulkOperations", reflect.TypeOf((*MockService)(nil).ListDeviceBulkOperations), ctx, orgId, params)
+}
+
 // ListDevices mocks base method.
 func (m *MockService) ListDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1006,6 +1388,21 @@ func (mr *MockServiceMockRecorder) ListDisruptionBudgetFleets(ctx, orgId any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisruptionBudgetFleets", reflect.TypeOf((*MockService)(nil).ListDisruptionBudgetFleets), ctx, orgId)
 }
 
+// ListEnrollmentApprovalPolicies mocks base method.
+func (m *MockService) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentApprovalPolicies", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicyList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListEnrollmentApprovalPolicies indicates an expected call of ListEnrollmentApprovalPolicies.
+func (mr *MockServiceMockRecorder) ListEnrollmentApprovalPolicies(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentApprovalPolicies", reflect.TypeOf((*MockService)(nil).ListEnrollmentApprovalPolicies), ctx, orgId, params)
+}
+
 // ListEnrollmentRequests mocks base method.
 func (m *MockService) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1126,6 +1523,81 @@ func (mr *MockServiceMockRecorder) ListResourceSyncs(ctx, orgId, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSyncs", reflect.TypeOf((*MockService)(nil).ListResourceSyncs), ctx, orgId, params)
 }
 
+// ListRoleBindings mocks base method.
+func (m *MockService) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleBindings", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.RoleBindingList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListRoleBindings indicates an expected call of ListRoleBindings.
+func (mr *MockServiceMockRecorder) ListRoleBindings(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockService)(nil).ListRoleBindings), ctx, orgId, params)
+}
+
+// ListRoles mocks base method.
+func (m *MockService) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.RoleList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockServiceMockRecorder) ListRoles(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockService)(nil).ListRoles), ctx, orgId, params)
+}
+
+// ListSecretStores mocks base method.
+func (m *MockService) ListSecretStores(ctx context.Context, orgId uuid.UUID, params domain.ListSecretStoresParams) (*domain.SecretStoreList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretStores", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.SecretStoreList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListSecretStores indicates an expected call of ListSecretStores.
+func (mr *MockServiceMockRecorder) ListSecretStores(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretStores", reflect.TypeOf((*MockService)(nil).ListSecretStores), ctx, orgId, params)
+}
+
+// ListServiceAccountTokens mocks base method.
+func (m *MockService) ListServiceAccountTokens(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccountTokens", ctx, orgId, serviceAccount)
+	ret0, _ := ret[0].(*domain.ServiceAccountTokenList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListServiceAccountTokens indicates an expected call of ListServiceAccountTokens.
+func (mr *MockServiceMockRecorder) ListServiceAccountTokens(ctx, orgId, serviceAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccountTokens", reflect.TypeOf((*MockService)(nil).ListServiceAccountTokens), ctx, orgId, serviceAccount)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockService) ListServiceAccounts(ctx context.Context, orgId uuid.UUID, params domain.ListServiceAccountsParams) (*domain.ServiceAccountList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.ServiceAccountList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockServiceMockRecorder) ListServiceAccounts(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockService)(nil).ListServiceAccounts), ctx, orgId, params)
+}
+
 // ListTemplateVersions mocks base method.
 func (m *MockService) ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1298,6 +1770,21 @@ func (mr *MockServiceMockRecorder) PatchDeviceStatus(ctx, orgId, name, patch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeviceStatus", reflect.TypeOf((*MockService)(nil).PatchDeviceStatus), ctx, orgId, name, patch)
 }
 
+// PatchEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) PatchEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchEnrollmentApprovalPolicy", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchEnrollmentApprovalPolicy indicates an expected call of PatchEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) PatchEnrollmentApprovalPolicy(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).PatchEnrollmentApprovalPolicy), ctx, orgId, name, patch)
+}
+
 // PatchEnrollmentRequest mocks base method.
 func (m *MockService) PatchEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1358,6 +1845,66 @@ func (mr *MockServiceMockRecorder) PatchResourceSync(ctx, orgId, name, patch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchResourceSync", reflect.TypeOf((*MockService)(nil).PatchResourceSync), ctx, orgId, name, patch)
 }
 
+// PatchRole mocks base method.
+func (m *MockService) PatchRole(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchRole", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchRole indicates an expected call of PatchRole.
+func (mr *MockServiceMockRecorder) PatchRole(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRole", reflect.TypeOf((*MockService)(nil).PatchRole), ctx, orgId, name, patch)
+}
+
+// PatchRoleBinding mocks base method.
+func (m *MockService) PatchRoleBinding(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchRoleBinding", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchRoleBinding indicates an expected call of PatchRoleBinding.
+func (mr *MockServiceMockRecorder) PatchRoleBinding(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRoleBinding", reflect.TypeOf((*MockService)(nil).PatchRoleBinding), ctx, orgId, name, patch)
+}
+
+// PatchSecretStore mocks base method.
+func (m *MockService) PatchSecretStore(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.SecretStore, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSecretStore", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.SecretStore)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchSecretStore indicates an expected call of PatchSecretStore.
+func (mr *MockServiceMockRecorder) PatchSecretStore(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSecretStore", reflect.TypeOf((*MockService)(nil).PatchSecretStore), ctx, orgId, name, patch)
+}
+
+// PatchServiceAccount mocks base method.
+func (m *MockService) PatchServiceAccount(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ServiceAccount, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchServiceAccount", ctx, orgId, name, patch)
+	ret0, _ := ret[0].(*domain.ServiceAccount)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PatchServiceAccount indicates an expected call of PatchServiceAccount.
+func (mr *MockServiceMockRecorder) PatchServiceAccount(ctx, orgId, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceAccount", reflect.TypeOf((*MockService)(nil).PatchServiceAccount), ctx, orgId, name, patch)
+}
+
 // ReplaceAuthProvider mocks base method.
 func (m *MockService) ReplaceAuthProvider(ctx context.Context, orgId uuid.UUID, name string, authProvider domain.AuthProvider) (*domain.AuthProvider, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1448,6 +1995,21 @@ func (mr *MockServiceMockRecorder) ReplaceDevice(ctx, orgId, name, device, field
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDevice", reflect.TypeOf((*MockService)(nil).ReplaceDevice), ctx, orgId, name, device, fieldsToUnset)
 }
 
+// ReplaceDeviceBulkOperationStatus mocks base method.
+func (m *MockService) ReplaceDeviceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDeviceBulkOperationStatus", ctx, orgId, name, operation)
+	ret0, _ := ret[0].(*domain.DeviceBulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceDeviceBulkOperationStatus indicates an expected call of ReplaceDeviceBulkOperationStatus.
+func (mr *MockServiceMockRecorder) ReplaceDeviceBulkOperationStatus(ctx, orgId, name, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceBulkOperationStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceBulkOperationStatus), ctx, orgId, name, operation)
+}
+
 // ReplaceDeviceStatus mocks base method.
 func (m *MockService) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, device domain.Device) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1463,6 +2025,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceStatus(ctx, orgId, name, device
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceStatus), ctx, orgId, name, device)
 }
 
+// ReplaceEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEnrollmentApprovalPolicy", ctx, orgId, name, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceEnrollmentApprovalPolicy indicates an expected call of ReplaceEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) ReplaceEnrollmentApprovalPolicy(ctx, orgId, name, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentApprovalPolicy), ctx, orgId, name, policy)
+}
+
 // ReplaceEnrollmentRequest mocks base method.
 func (m *MockService) ReplaceEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1583,6 +2160,95 @@ func (mr *MockServiceMockRecorder) ReplaceResourceSyncStatus(ctx, orgId, name, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResourceSyncStatus", reflect.TypeOf((*MockService)(nil).ReplaceResourceSyncStatus), ctx, orgId, name, resourceSync)
 }
 
+// ReplaceRole mocks base method.
+func (m *MockService) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRole", ctx, orgId, name, role)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceRole indicates an expected call of ReplaceRole.
+func (mr *MockServiceMockRecorder) ReplaceRole(ctx, orgId, name, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRole", reflect.TypeOf((*MockService)(nil).ReplaceRole), ctx, orgId, name, role)
+}
+
+// ReplaceRoleBinding mocks base method.
+func (m *MockService) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRoleBinding", ctx, orgId, name, binding)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceRoleBinding indicates an expected call of ReplaceRoleBinding.
+func (mr *MockServiceMockRecorder) ReplaceRoleBinding(ctx, orgId, name, binding any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRoleBinding", reflect.TypeOf((*MockService)(nil).ReplaceRoleBinding), ctx, orgId, name, binding)
+}
+
+// ReplaceSecretStore mocks base method.
+func (m *MockService) ReplaceSecretStore(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSecretStore", ctx, orgId, name, secretStore)
+	ret0, _ := ret[0].(*domain.SecretStore)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceSecretStore indicates an expected call of ReplaceSecretStore.
+func (mr *MockServiceMockRecorder) ReplaceSecretStore(ctx, orgId, name, secretStore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSecretStore", reflect.TypeOf((*MockService)(nil).ReplaceSecretStore), ctx, orgId, name, secretStore)
+}
+
+// ReplaceSecretStoreStatusByError mocks base method.
+func (m *MockService) ReplaceSecretStoreStatusByError(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore, err error) (*domain.SecretStore, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSecretStoreStatusByError", ctx, orgId, name, secretStore, err)
+	ret0, _ := ret[0].(*domain.SecretStore)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceSecretStoreStatusByError indicates an expected call of ReplaceSecretStoreStatusByError.
+func (mr *MockServiceMockRecorder) ReplaceSecretStoreStatusByError(ctx, orgId, name, secretStore, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSecretStoreStatusByError", reflect.TypeOf((*MockService)(nil).ReplaceSecretStoreStatusByError), ctx, orgId, name, secretStore, err)
+}
+
+// ReplaceServiceAccount mocks base method.
+func (m *MockService) ReplaceServiceAccount(ctx context.Context, orgId uuid.UUID, name string, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceServiceAccount", ctx, orgId, name, serviceAccount)
+	ret0, _ := ret[0].(*domain.ServiceAccount)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceServiceAccount indicates an expected call of ReplaceServiceAccount.
+func (mr *MockServiceMockRecorder) ReplaceServiceAccount(ctx, orgId, name, serviceAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceServiceAccount", reflect.TypeOf((*MockService)(nil).ReplaceServiceAccount), ctx, orgId, name, serviceAccount)
+}
+
+// RequestDeviceRender mocks base method.
+func (m *MockService) RequestDeviceRender(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeviceRender", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// RequestDeviceRender indicates an expected call of RequestDeviceRender.
+func (mr *MockServiceMockRecorder) RequestDeviceRender(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeviceRender", reflect.TypeOf((*MockService)(nil).RequestDeviceRender), ctx, orgId, name)
+}
+
 // ResumeDevices mocks base method.
 func (m *MockService) ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1598,6 +2264,21 @@ func (mr *MockServiceMockRecorder) ResumeDevices(ctx, orgId, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDevices", reflect.TypeOf((*MockService)(nil).ResumeDevices), ctx, orgId, request)
 }
 
+// RevokeCertificates mocks base method.
+func (m *MockService) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificates", ctx, orgId, request)
+	ret0, _ := ret[0].(*domain.CertificateRevocationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RevokeCertificates indicates an expected call of RevokeCertificates.
+func (mr *MockServiceMockRecorder) RevokeCertificates(ctx, orgId, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificates", reflect.TypeOf((*MockService)(nil).RevokeCertificates), ctx, orgId, request)
+}
+
 // SetCheckpoint mocks base method.
 func (m *MockService) SetCheckpoint(ctx context.Context, consumer, key string, value []byte) domain.Status {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -76,6 +77,10 @@ func (h *ServiceHandler) ReplaceRepository(ctx context.Context, orgId uuid.UUID,
 }
 
 func (h *ServiceHandler) DeleteRepository(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.Repository().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
 	err := h.store.Repository().Delete(ctx, orgId, name, h.callbackRepositoryDeleted)
 	return StoreErrorToApiStatus(err, false, domain.RepositoryKind, &name)
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -10,6 +11,40 @@ import (
 	"gorm.io/gorm"
 )
 
+// resourceSyncKindResources maps the kinds a ResourceSync may manage to their API resources.
+var resourceSyncKindResources = map[string]string{
+	domain.FleetKind:                    "fleets",
+	domain.RepositoryKind:               "repositories",
+	domain.SecretStoreKind:              "secretstores",
+	domain.AuthProviderKind:             "authproviders",
+	domain.EnrollmentApprovalPolicyKind: "enrollmentapprovalpolicies",
+	domain.DeviceKind:                   "devices",
+	"Catalog":                           "catalogs",
+	"CatalogItem":                       "catalogitems",
+}
+
+// authorizeResourceSyncKinds checks that the caller may write the resources of every kind the
+// ResourceSync manages, since the ResourceSync creates, replaces and deletes them on its behalf.
+// The labels and annotations of devices are patched, they are never created nor deleted.
+func authorizeResourceSyncKinds(ctx context.Context, rs *domain.ResourceSync) domain.Status {
+	for _, kind := range rs.Spec.ManagedKinds() {
+		resource, ok := resourceSyncKindResources[kind]
+		if !ok {
+			continue
+		}
+		ops := []string{"create", "update", "delete"}
+		if kind == domain.DeviceKind {
+			ops = []string{"patch"}
+		}
+		for _, op := range ops {
+			if status := checkCallerPermission(ctx, resource, op); status.Code != http.StatusOK {
+				return status
+			}
+		}
+	}
+	return domain.StatusOK()
+}
+
 func (h *ServiceHandler) CreateResourceSync(ctx context.Context, orgId uuid.UUID, rs domain.ResourceSync) (*domain.ResourceSync, domain.Status) {
 	// don't set fields that are managed by the service
 	rs.Status = nil
@@ -18,6 +53,9 @@ func (h *ServiceHandler) CreateResourceSync(ctx context.Context, orgId uuid.UUID
 	if errs := rs.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if status := authorizeResourceSyncKinds(ctx, &rs); status.Code != http.StatusOK {
+		return nil, status
+	}
 
 	result, err := h.store.ResourceSync().Create(ctx, orgId, &rs, h.callbackResourceSyncUpdated)
 	return result, StoreErrorToApiStatus(err, true, domain.ResourceSyncKind, rs.Metadata.Name)
@@ -61,6 +99,9 @@ func (h *ServiceHandler) ReplaceResourceSync(ctx context.Context, orgId uuid.UUI
 	if name != *rs.Metadata.Name {
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
+	if status := authorizeResourceSyncKinds(ctx, &rs); status.Code != http.StatusOK {
+		return nil, status
+	}
 
 	result, created, err := h.store.ResourceSync().CreateOrUpdate(ctx, orgId, &rs, h.callbackResourceSyncUpdated)
 	return result, StoreErrorToApiStatus(err, created, domain.ResourceSyncKind, &name)
@@ -101,6 +142,9 @@ func (h *ServiceHandler) PatchResourceSync(ctx context.Context, orgId uuid.UUID,
 	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if status := authorizeResourceSyncKinds(ctx, newObj); status.Code != http.StatusOK {
+		return nil, status
+	}
 
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/auth/authz"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/google/uuid"
@@ -268,3 +270,57 @@ func TestResourceSyncReplaceAllowsRepositoryUpdate(t *testing.T) {
 	require.Equal(statusSuccessCode, status.Code)
 	require.Equal("catalog-mixed-test-1774364233", replaced.Spec.Repository)
 }
+
+// fakeAuthorizer grants the permissions it lists, as "resource:op".
+type fakeAuthorizer struct {
+	permissions []string
+}
+
+// CheckPermission grants "*:<op>" for any resource, as the built-in roles do.
+func (a fakeAuthorizer) CheckPermission(ctx context.Context, resource string, op string) (bool, error) {
+	return lo.Contains(a.permissions, resource+":"+op) || lo.Contains(a.permissions, "*:"+op), nil
+}
+
+func TestResourceSyncRequiresPermissionsForManagedKinds(t *testing.T) {
+	require := require.New(t)
+	testOrgID := uuid.New()
+
+	testStore := &TestStore{}
+	serviceHandler := ServiceHandler{
+		eventHandler: NewEventHandler(testStore, nil, logrus.New()),
+		store:        testStore,
+		log:          logrus.New(),
+	}
+
+	resourceSync := domain.ResourceSync{
+		ApiVersion: "v1beta1",
+		Kind:       "ResourceSync",
+		Metadata: domain.ObjectMeta{
+			Name: lo.ToPtr("auth-sync"),
+		},
+		Spec: domain.ResourceSyncSpec{
+			Repository:     "repo",
+			TargetRevision: "main",
+			Path:           "/",
+			Kinds:          &[]string{domain.FleetKind, domain.AuthProviderKind},
+		},
+	}
+	fleetPermissions := []string{"fleets:create", "fleets:update", "fleets:delete"}
+
+	ctx := authz.WithAuthorizer(context.Background(), fakeAuthorizer{permissions: fleetPermissions})
+	_, status := serviceHandler.CreateResourceSync(ctx, testOrgID, resourceSync)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "authproviders:create")
+
+	resourceSync.Spec.Kinds = &[]string{domain.FleetKind}
+	_, status = serviceHandler.CreateResourceSync(ctx, testOrgID, resourceSync)
+	require.Equal(statusCreatedCode, status.Code)
+
+	patch := domain.PatchRequest{{Op: "add", Path: "/spec/kinds/-", Value: lo.ToPtr[any](domain.AuthProviderKind)}}
+	_, status = serviceHandler.PatchResourceSync(ctx, testOrgID, "auth-sync", patch)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+
+	ctx = authz.WithAuthorizer(context.Background(), fakeAuthorizer{permissions: append(fleetPermissions, "authproviders:create", "authproviders:update", "authproviders:delete")})
+	_, status = serviceHandler.PatchResourceSync(ctx, testOrgID, "auth-sync", patch)
+	require.Equal(statusSuccessCode, status.Code)
+}
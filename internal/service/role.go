@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&role.Metadata)
+
+	if errs := role.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := authorizeRoleRules(ctx, role.Spec.Rules); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Role().Create(ctx, orgId, &role, h.callbackRoleUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.RoleKind, role.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Role().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	result, err := h.store.Role().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&role.Metadata)
+	}
+
+	if errs := role.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *role.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+	if status := authorizeRoleRules(ctx, role.Spec.Rules); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, created, err := h.store.Role().CreateOrUpdate(ctx, orgId, &role, h.callbackRoleUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.RoleKind, &name)
+}
+
+func (h *ServiceHandler) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.Role().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err := h.store.Role().Delete(ctx, orgId, name, h.callbackRoleDeleted)
+	return StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+}
+
+func (h *ServiceHandler) PatchRole(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Role, domain.Status) {
+	currentObj, err := h.store.Role().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+	}
+
+	newObj := &domain.Role{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/roles/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := authorizeRoleRules(ctx, newObj.Spec.Rules); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.Role().Update(ctx, orgId, newObj, h.callbackRoleUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+}
+
+// authorizeRoleRules ensures that the caller holds every operation granted by the rules of a role,
+// so that creating, updating or binding a role never grants more than the caller has. A rule on the
+// wildcard resource "*" also grants the resources the caller may be explicitly denied, so the caller
+// must hold the operation on each of them as well.
+func authorizeRoleRules(ctx context.Context, rules []domain.RoleRule) domain.Status {
+	for _, rule := range rules {
+		for _, resource := range rule.Resources {
+			resources := []string{resource}
+			if resource == "*" {
+				resources = append(resources, authz.SpecificResources()...)
+			}
+			for _, resource := range resources {
+				for _, verb := range rule.Verbs {
+					if status := checkCallerPermission(ctx, resource, verb); status != domain.StatusOK() {
+						return status
+					}
+				}
+			}
+		}
+	}
+	return domain.StatusOK()
+}
+
+// callbackRoleUpdated is the role-specific callback that handles role update events
+func (h *ServiceHandler) callbackRoleUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleRoleUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackRoleDeleted is the role-specific callback that handles role deletion events
+func (h *ServiceHandler) callbackRoleDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// CustomRoleSource provides the custom roles and role bindings of the organizations to the authorizer.
+type CustomRoleSource struct {
+	store store.Store
+}
+
+// Make sure we conform to the authz.RoleSource interface
+var _ authz.RoleSource = (*CustomRoleSource)(nil)
+
+// NewCustomRoleSource creates a CustomRoleSource reading the roles and role bindings from the store.
+func NewCustomRoleSource(store store.Store) *CustomRoleSource {
+	return &CustomRoleSource{store: store}
+}
+
+// GetCustomRoles returns the role bindings of an organization granting their role to the user or to one
+// of its roles, and the roles they reference or that are named after one of the roles of the user.
+func (s *CustomRoleSource) GetCustomRoles(ctx context.Context, orgId uuid.UUID, username string, roles []string) ([]domain.Role, []domain.RoleBinding, error) {
+	subjects := make([]domain.RoleBindingSubject, 0, len(roles)+1)
+	if username != "" {
+		subjects = append(subjects, domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: username})
+	}
+	for _, role := range roles {
+		subjects = append(subjects, domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindGroup, Name: role})
+	}
+	bindings, err := s.store.RoleBinding().ListBySubjects(ctx, orgId, subjects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := slices.Clone(roles)
+	for _, binding := range bindings {
+		names = append(names, binding.Spec.RoleRef)
+	}
+	slices.Sort(names)
+	customRoles, err := s.store.Role().ListByNames(ctx, orgId, slices.Compact(names))
+	if err != nil {
+		return nil, nil, err
+	}
+	return customRoles, bindings, nil
+}
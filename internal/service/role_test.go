@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRole(name string, resources []string, verbs []string) domain.Role {
+	return domain.Role{
+		ApiVersion: "v1beta1",
+		Kind:       domain.RoleKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.RoleSpec{
+			Rules: []domain.RoleRule{{Resources: resources, Verbs: verbs}},
+		},
+	}
+}
+
+func newTestRoleBinding(name string, roleRef string, labelSelector *string, subjects ...domain.RoleBindingSubject) domain.RoleBinding {
+	return domain.RoleBinding{
+		ApiVersion: "v1beta1",
+		Kind:       domain.RoleBindingKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.RoleBindingSpec{
+			RoleRef:       roleRef,
+			Subjects:      subjects,
+			LabelSelector: labelSelector,
+		},
+	}
+}
+
+func TestCreateRoleWithBuiltInName(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	role := newTestRole(domain.RoleAdmin, []string{"devices"}, []string{"get"})
+	_, status := serviceHandler.CreateRole(ctx, store.NullOrgId, role)
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestCreateRoleBindingWithInvalidLabelSelector(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	binding := newTestRoleBinding("north", "device-patcher", lo.ToPtr("site in north"),
+		domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "alice"})
+	_, status := serviceHandler.CreateRoleBinding(ctx, store.NullOrgId, binding)
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestWildcardRolesCannotGrantWhatTheCallerIsDenied(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	viewer := identity.NewMappedIdentity("viewer", "viewer-uid", []*model.Organization{},
+		map[string][]string{orgId.String(): {domain.RoleViewer}}, false, nil)
+	ctx = context.WithValue(ctx, consts.MappedIdentityCtxKey, viewer)
+	ctx = authz.WithAuthorizer(ctx, authz.NewStaticAuthZ(logrus.New()))
+
+	// the viewer role can get all resources but imageexports/download
+	_, status := serviceHandler.CreateRole(ctx, orgId, newTestRole("reader", []string{"*"}, []string{"get"}))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "imageexports/download:get")
+
+	_, status = serviceHandler.CreateRole(ctx, orgId, newTestRole("device-reader", []string{"devices"}, []string{"get", "list"}))
+	require.Equal(domain.StatusCreated(), status)
+}
+
+func TestCustomRoleSourceReturnsRolesAndBindings(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+
+	_, status := serviceHandler.CreateRole(ctx, orgId, newTestRole("device-patcher", []string{"devices"}, []string{"get", "patch"}))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("north", "device-patcher", lo.ToPtr("site=north"),
+		domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindGroup, Name: "field-techs"}))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRole(ctx, orgId, newTestRole("device-reader", []string{"devices"}, []string{"get"}))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("south", "device-reader", nil,
+		domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "bob"}))
+	require.Equal(domain.StatusCreated(), status)
+
+	roles, bindings, err := NewCustomRoleSource(testStore).GetCustomRoles(ctx, orgId, "alice", []string{"field-techs"})
+	require.NoError(err)
+	require.Len(roles, 1)
+	require.Equal("device-patcher", lo.FromPtr(roles[0].Metadata.Name))
+	require.Len(bindings, 1)
+	require.Equal("site=north", lo.FromPtr(bindings[0].Spec.LabelSelector))
+
+	roles, bindings, err = NewCustomRoleSource(testStore).GetCustomRoles(ctx, orgId, "carol", []string{"device-reader"})
+	require.NoError(err)
+	require.Len(roles, 1)
+	require.Equal("device-reader", lo.FromPtr(roles[0].Metadata.Name))
+	require.Empty(bindings)
+}
+
+func TestDeviceScopeRestrictsGetAndPatch(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	for name, site := range map[string]string{"north-1": "north", "south-1": "south"} {
+		_, err := serviceHandler.store.Device().Create(ctx, orgId, &domain.Device{
+			ApiVersion: "v1beta1",
+			Kind:       domain.DeviceKind,
+			Metadata: domain.ObjectMeta{
+				Name:   lo.ToPtr(name),
+				Labels: &map[string]string{"site": site},
+			},
+			Spec: &domain.DeviceSpec{},
+		}, nil)
+		require.NoError(err)
+	}
+	ctx = authz.WithScope(ctx, &authz.Scope{LabelSelectors: []string{"site=north"}})
+
+	_, status := serviceHandler.GetDevice(ctx, orgId, "north-1")
+	require.Equal(statusSuccessCode, status.Code)
+	_, status = serviceHandler.GetDevice(ctx, orgId, "south-1")
+	require.Equal(statusNotFoundCode, status.Code)
+
+	var value interface{} = "maintenance"
+	patch := domain.PatchRequest{{Op: "add", Path: "/metadata/labels/state", Value: &value}}
+	_, status = serviceHandler.PatchDevice(ctx, orgId, "north-1", patch)
+	require.Equal(statusSuccessCode, status.Code)
+	_, status = serviceHandler.PatchDevice(ctx, orgId, "south-1", patch)
+	require.Equal(statusNotFoundCode, status.Code)
+
+	// a patch moving the device out of the scope is rejected
+	value = "south"
+	patch = domain.PatchRequest{{Op: "replace", Path: "/metadata/labels/site", Value: &value}}
+	_, status = serviceHandler.PatchDevice(ctx, orgId, "north-1", patch)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+}
+
+func TestRolesCannotGrantMoreThanTheCaller(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	// internal requests create roles without checks
+	_, status := serviceHandler.CreateRole(ctx, orgId, newTestRole("device-admin", []string{"devices"}, []string{"*"}))
+	require.Equal(domain.StatusCreated(), status)
+
+	ctx = authz.WithAuthorizer(ctx, fakeAuthorizer{permissions: []string{"devices:get", "devices:patch"}})
+
+	_, status = serviceHandler.CreateRole(ctx, orgId, newTestRole("device-patcher", []string{"devices"}, []string{"get", "patch"}))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRole(ctx, orgId, newTestRole("device-remover", []string{"devices"}, []string{"get", "delete"}))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "devices:delete")
+
+	patch := domain.PatchRequest{{Op: "add", Path: "/spec/rules/0/verbs/-", Value: lo.ToPtr[any]("delete")}}
+	_, status = serviceHandler.PatchRole(ctx, orgId, "device-patcher", patch)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+
+	alice := domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "alice"}
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("alice-patcher", "device-patcher", nil, alice))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("alice-admin", "device-admin", lo.ToPtr("site=north"), alice))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+
+	patch = domain.PatchRequest{{Op: "replace", Path: "/spec/roleRef", Value: lo.ToPtr[any]("device-admin")}}
+	_, status = serviceHandler.PatchRoleBinding(ctx, orgId, "alice-patcher", patch)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	// the operations of a role created later aren't checked against the caller of the binding
+	_, status = serviceHandler.CreateRoleBinding(ctx, orgId, newTestRoleBinding("alice-later", "device-remover", nil, alice))
+	require.Equal(statusBadRequestCode, status.Code)
+	require.Contains(status.Message, "device-remover")
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&binding.Metadata)
+
+	if errs := binding.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.authorizeRoleBinding(ctx, orgId, &binding); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.RoleBinding().Create(ctx, orgId, &binding, h.callbackRoleBindingUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.RoleBindingKind, binding.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.RoleBinding().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	result, err := h.store.RoleBinding().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&binding.Metadata)
+	}
+
+	if errs := binding.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *binding.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+	if status := h.authorizeRoleBinding(ctx, orgId, &binding); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, created, err := h.store.RoleBinding().CreateOrUpdate(ctx, orgId, &binding, h.callbackRoleBindingUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.RoleBindingKind, &name)
+}
+
+func (h *ServiceHandler) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.RoleBinding().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err := h.store.RoleBinding().Delete(ctx, orgId, name, h.callbackRoleBindingDeleted)
+	return StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+}
+
+func (h *ServiceHandler) PatchRoleBinding(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.RoleBinding, domain.Status) {
+	currentObj, err := h.store.RoleBinding().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+	}
+
+	newObj := &domain.RoleBinding{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/rolebindings/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.authorizeRoleBinding(ctx, orgId, newObj); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.RoleBinding().Update(ctx, orgId, newObj, h.callbackRoleBindingUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+}
+
+// authorizeRoleBinding ensures that the caller holds every operation granted by the role a binding
+// refers to. The role must exist, as the operations it is later created with are not checked against
+// the caller of the binding.
+func (h *ServiceHandler) authorizeRoleBinding(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding) domain.Status {
+	if authz.AuthorizerFromContext(ctx) == nil {
+		return domain.StatusOK()
+	}
+	role, err := h.store.Role().Get(ctx, orgId, binding.Spec.RoleRef)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return domain.StatusBadRequest(fmt.Sprintf("spec.roleRef: role %q does not exist", binding.Spec.RoleRef))
+	}
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.RoleKind, &binding.Spec.RoleRef)
+	}
+	return authorizeRoleRules(ctx, role.Spec.Rules)
+}
+
+// callbackRoleBindingUpdated is the role binding-specific callback that handles role binding update events
+func (h *ServiceHandler) callbackRoleBindingUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleRoleBindingUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackRoleBindingDeleted is the role binding-specific callback that handles role binding deletion events
+func (h *ServiceHandler) callbackRoleBindingDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateSecretStore(ctx context.Context, orgId uuid.UUID, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	// don't set fields that are managed by the service
+	secretStore.Status = nil
+	NilOutManagedObjectMetaProperties(&secretStore.Metadata)
+
+	if errs := secretStore.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.SecretStore().Create(ctx, orgId, &secretStore, h.callbackSecretStoreUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.SecretStoreKind, secretStore.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListSecretStores(ctx context.Context, orgId uuid.UUID, params domain.ListSecretStoresParams) (*domain.SecretStoreList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.SecretStore().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetSecretStore(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, domain.Status) {
+	result, err := h.store.SecretStore().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.SecretStoreKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceSecretStore(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		secretStore.Status = nil
+		NilOutManagedObjectMetaProperties(&secretStore.Metadata)
+	}
+
+	if errs := secretStore.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *secretStore.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	// Preserve credentials from the existing secret store if the new one contains masked placeholders
+	existingSecretStore, err := h.store.SecretStore().Get(ctx, orgId, name)
+	if err == nil {
+		if preserveErr := secretStore.PreserveSensitiveData(existingSecretStore); preserveErr != nil {
+			return nil, domain.StatusInternalServerError(preserveErr.Error())
+		}
+	}
+
+	result, created, err := h.store.SecretStore().CreateOrUpdate(ctx, orgId, &secretStore, h.callbackSecretStoreUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.SecretStoreKind, &name)
+}
+
+func (h *ServiceHandler) DeleteSecretStore(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.SecretStore().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err := h.store.SecretStore().Delete(ctx, orgId, name, h.callbackSecretStoreDeleted)
+	return StoreErrorToApiStatus(err, false, domain.SecretStoreKind, &name)
+}
+
+func (h *ServiceHandler) PatchSecretStore(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.SecretStore, domain.Status) {
+	currentObj, err := h.store.SecretStore().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.SecretStoreKind, &name)
+	}
+
+	newObj := &domain.SecretStore{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/secretstores/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	// Preserve credentials from the existing secret store if the new one contains masked placeholders
+	if preserveErr := newObj.PreserveSensitiveData(currentObj); preserveErr != nil {
+		return nil, domain.StatusInternalServerError(preserveErr.Error())
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.SecretStore().Update(ctx, orgId, newObj, h.callbackSecretStoreUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.SecretStoreKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceSecretStoreStatusByError(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore, err error) (*domain.SecretStore, domain.Status) {
+	if name != lo.FromPtr(secretStore.Metadata.Name) {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	// This is the only Condition for SecretStore
+	changed := domain.SetStatusConditionByError(&secretStore.Status.Conditions, domain.ConditionTypeSecretStoreAccessible, "Accessible", "Inaccessible", err)
+	if !changed {
+		// Nothing to do
+		return &secretStore, domain.StatusOK()
+	}
+
+	result, err := h.store.SecretStore().UpdateStatus(ctx, orgId, &secretStore, h.callbackSecretStoreUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.SecretStoreKind, &name)
+}
+
+// callbackSecretStoreUpdated is the secret store-specific callback that handles secret store update events
+func (h *ServiceHandler) callbackSecretStoreUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleSecretStoreUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackSecretStoreDeleted is the secret store-specific callback that handles secret store deletion events
+func (h *ServiceHandler) callbackSecretStoreDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
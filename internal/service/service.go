@@ -11,6 +11,10 @@ import (
 )
 
 type Service interface {
+	// CertificateRevocation
+	ListCertificateRevocations(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateRevocationsParams) (*domain.CertificateRevocationList, domain.Status)
+	RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status)
+
 	// CertificateSigningRequest
 	ListCertificateSigningRequests(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateSigningRequestsParams) (*domain.CertificateSigningRequestList, domain.Status)
 	CreateCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, csr domain.CertificateSigningRequest) (*domain.CertificateSigningRequest, domain.Status)
@@ -37,6 +41,7 @@ type Service interface {
 	DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission) (*domain.Device, domain.Status)
 
 	ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status)
+	RequestDeviceRender(ctx context.Context, orgId uuid.UUID, name string) domain.Status
 	UpdateDeviceAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status
 	UpdateRenderedDevice(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) domain.Status
 	SetDeviceServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status
@@ -66,6 +71,49 @@ type Service interface {
 	GetEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status)
 	ApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, approval domain.EnrollmentRequestApproval) (*domain.EnrollmentRequestApprovalStatus, domain.Status)
 	ReplaceEnrollmentRequestStatus(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status)
+	ApproveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status)
+
+	// EnrollmentApprovalPolicy
+	CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status)
+	ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status)
+	GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status)
+	ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status)
+	DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentApprovalPolicy, domain.Status)
+
+	// Role
+	CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status)
+	ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status)
+	GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status)
+	ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status)
+	DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchRole(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Role, domain.Status)
+
+	// RoleBinding
+	CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status)
+	ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status)
+	GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status)
+	ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status)
+	DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchRoleBinding(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.RoleBinding, domain.Status)
+
+	// ServiceAccount
+	CreateServiceAccount(ctx context.Context, orgId uuid.UUID, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status)
+	ListServiceAccounts(ctx context.Context, orgId uuid.UUID, params domain.ListServiceAccountsParams) (*domain.ServiceAccountList, domain.Status)
+	GetServiceAccount(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, domain.Status)
+	ReplaceServiceAccount(ctx context.Context, orgId uuid.UUID, name string, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status)
+	DeleteServiceAccount(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchServiceAccount(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ServiceAccount, domain.Status)
+	CreateServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, token domain.ServiceAccountToken) (*domain.ServiceAccountToken, domain.Status)
+	ListServiceAccountTokens(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, domain.Status)
+	DeleteServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) domain.Status
+
+	// DeviceBulkOperation
+	CreateDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.DeviceBulkOperation, dryRun bool) (*domain.DeviceBulkOperation, domain.Status)
+	ListDeviceBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceBulkOperationsParams) (*domain.DeviceBulkOperationList, domain.Status)
+	GetDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, domain.Status)
+	DeleteDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	ReplaceDeviceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status)
 
 	// Fleet
 	CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status)
@@ -97,6 +145,15 @@ type Service interface {
 	GetRepositoryFleetReferences(ctx context.Context, orgId uuid.UUID, name string) (*domain.FleetList, domain.Status)
 	GetRepositoryDeviceReferences(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceList, domain.Status)
 
+	// SecretStore
+	CreateSecretStore(ctx context.Context, orgId uuid.UUID, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status)
+	ListSecretStores(ctx context.Context, orgId uuid.UUID, params domain.ListSecretStoresParams) (*domain.SecretStoreList, domain.Status)
+	GetSecretStore(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, domain.Status)
+	ReplaceSecretStore(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status)
+	DeleteSecretStore(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	PatchSecretStore(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.SecretStore, domain.Status)
+	ReplaceSecretStoreStatusByError(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore, err error) (*domain.SecretStore, domain.Status)
+
 	// AuthProvider
 	CreateAuthProvider(ctx context.Context, orgId uuid.UUID, authProvider domain.AuthProvider) (*domain.AuthProvider, domain.Status)
 	ListAuthProviders(ctx context.Context, orgId uuid.UUID, params domain.ListAuthProvidersParams) (*domain.AuthProviderList, domain.Status)
@@ -150,6 +207,11 @@ type Service interface {
 	ListEvents(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status)
 	DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status)
 
+	// AuditEvent
+	CreateAuditEvent(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) domain.Status
+	ListAuditEvents(ctx context.Context, orgId uuid.UUID, params domain.ListAuditEventsParams) (*domain.AuditEventList, domain.Status)
+	DeleteAuditEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status)
+
 	// Checkpoint
 	GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status)
 	SetCheckpoint(ctx context.Context, consumer string, key string, value []byte) domain.Status
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/auth/authn"
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultServiceAccountTokenExpiration is the lifetime of a service account token that doesn't set its expiration
+	DefaultServiceAccountTokenExpiration = 90 * 24 * time.Hour
+	// MaxServiceAccountTokenExpiration is the longest lifetime a service account token can have
+	MaxServiceAccountTokenExpiration = 365 * 24 * time.Hour
+
+	// serviceAccountTokenLastUsedPrecision limits how often the last use of a token is recorded
+	serviceAccountTokenLastUsedPrecision = time.Minute
+)
+
+func (h *ServiceHandler) CreateServiceAccount(ctx context.Context, orgId uuid.UUID, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&serviceAccount.Metadata)
+
+	if errs := serviceAccount.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.authorizeServiceAccountRoles(ctx, orgId, serviceAccount.Spec.Roles); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.ServiceAccount().Create(ctx, orgId, &serviceAccount, h.callbackServiceAccountUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.ServiceAccountKind, serviceAccount.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListServiceAccounts(ctx context.Context, orgId uuid.UUID, params domain.ListServiceAccountsParams) (*domain.ServiceAccountList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.ServiceAccount().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetServiceAccount(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, domain.Status) {
+	result, err := h.store.ServiceAccount().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceServiceAccount(ctx context.Context, orgId uuid.UUID, name string, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&serviceAccount.Metadata)
+	}
+
+	if errs := serviceAccount.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *serviceAccount.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+	if status := h.authorizeServiceAccountRoles(ctx, orgId, serviceAccount.Spec.Roles); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, created, err := h.store.ServiceAccount().CreateOrUpdate(ctx, orgId, &serviceAccount, h.callbackServiceAccountUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.ServiceAccountKind, &name)
+}
+
+func (h *ServiceHandler) DeleteServiceAccount(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if existing, err := h.store.ServiceAccount().Get(ctx, orgId, name); err == nil && existing.Metadata.Owner != nil && !IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err := h.store.ServiceAccount().Delete(ctx, orgId, name, h.callbackServiceAccountDeleted)
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &name)
+	}
+
+	// the tokens of a deleted service account must not keep authenticating
+	err = h.store.ServiceAccountToken().DeleteAll(ctx, orgId, name)
+	return StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &name)
+}
+
+func (h *ServiceHandler) PatchServiceAccount(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ServiceAccount, domain.Status) {
+	currentObj, err := h.store.ServiceAccount().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &name)
+	}
+
+	newObj := &domain.ServiceAccount{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/serviceaccounts/"+name)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.authorizeServiceAccountRoles(ctx, orgId, newObj.Spec.Roles); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.ServiceAccount().Update(ctx, orgId, newObj, h.callbackServiceAccountUpdated)
+	return result, StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &name)
+}
+
+// authorizeServiceAccountRoles ensures that the caller holds every operation granted by the roles of a
+// service account, so that a service account never grants more than the caller has. Custom roles
+// must exist, as the operations they are later created with are not checked against the caller.
+func (h *ServiceHandler) authorizeServiceAccountRoles(ctx context.Context, orgId uuid.UUID, roles []string) domain.Status {
+	if authz.AuthorizerFromContext(ctx) == nil {
+		return domain.StatusOK()
+	}
+	for _, name := range roles {
+		if permissions, ok := authz.BuiltInRolePermissions(name); ok {
+			resources := lo.Keys(permissions)
+			slices.Sort(resources)
+			rules := make([]domain.RoleRule, 0, len(resources))
+			for _, resource := range resources {
+				rules = append(rules, domain.RoleRule{Resources: []string{resource}, Verbs: permissions[resource]})
+			}
+			if status := authorizeRoleRules(ctx, rules); status != domain.StatusOK() {
+				return status
+			}
+			continue
+		}
+
+		role, err := h.store.Role().Get(ctx, orgId, name)
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			return domain.StatusBadRequest(fmt.Sprintf("spec.roles: role %q does not exist", name))
+		}
+		if err != nil {
+			return StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+		}
+		if status := authorizeRoleRules(ctx, role.Spec.Rules); status != domain.StatusOK() {
+			return status
+		}
+	}
+	return domain.StatusOK()
+}
+
+// callbackServiceAccountUpdated is the service account-specific callback that handles service account update events
+func (h *ServiceHandler) callbackServiceAccountUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleServiceAccountUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackServiceAccountDeleted is the service account-specific callback that handles service account deletion events
+func (h *ServiceHandler) callbackServiceAccountDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+func (h *ServiceHandler) CreateServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, token domain.ServiceAccountToken) (*domain.ServiceAccountToken, domain.Status) {
+	sa, err := h.store.ServiceAccount().Get(ctx, orgId, serviceAccount)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &serviceAccount)
+	}
+
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&token.Metadata)
+	token.Status = nil
+	token.Token = nil
+	if token.Metadata.Name == nil || *token.Metadata.Name == "" {
+		token.Metadata.Name = lo.ToPtr(fmt.Sprintf("token-%s", uuid.NewString()[:8]))
+	}
+
+	if errs := token.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if token.Spec.Roles != nil {
+		for _, role := range *token.Spec.Roles {
+			if !slices.Contains(sa.Spec.Roles, role) {
+				return nil, domain.StatusBadRequest(fmt.Sprintf("spec.roles: role %q is not granted to service account %q", role, serviceAccount))
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	if token.Spec.ExpirationTimestamp == nil {
+		token.Spec.ExpirationTimestamp = lo.ToPtr(now.Add(DefaultServiceAccountTokenExpiration))
+	}
+	if !token.Spec.ExpirationTimestamp.After(now) {
+		return nil, domain.StatusBadRequest("spec.expirationTimestamp: must be in the future")
+	}
+	if token.Spec.ExpirationTimestamp.After(now.Add(MaxServiceAccountTokenExpiration)) {
+		return nil, domain.StatusBadRequest("spec.expirationTimestamp: must be at most one year in the future")
+	}
+
+	// a token acts with its roles, so the caller must hold them just like when creating the service account
+	roles := sa.Spec.Roles
+	if token.Spec.Roles != nil {
+		roles = *token.Spec.Roles
+	}
+	if status := h.authorizeServiceAccountRoles(ctx, orgId, roles); status != domain.StatusOK() {
+		return nil, status
+	}
+
+	secret, err := authn.GenerateServiceAccountToken()
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+
+	result, err := h.store.ServiceAccountToken().Create(ctx, orgId, serviceAccount, authn.HashServiceAccountToken(secret), &token)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, true, domain.ServiceAccountTokenKind, token.Metadata.Name)
+	}
+
+	// the secret token is only ever returned here, only its hash is stored
+	result.Token = &secret
+	return result, domain.StatusCreated()
+}
+
+func (h *ServiceHandler) ListServiceAccountTokens(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, domain.Status) {
+	if _, err := h.store.ServiceAccount().Get(ctx, orgId, serviceAccount); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.ServiceAccountKind, &serviceAccount)
+	}
+
+	result, err := h.store.ServiceAccountToken().List(ctx, orgId, serviceAccount)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	return result, domain.StatusOK()
+}
+
+func (h *ServiceHandler) DeleteServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) domain.Status {
+	err := h.store.ServiceAccountToken().Delete(ctx, orgId, serviceAccount, name)
+	return StoreErrorToApiStatus(err, false, domain.ServiceAccountTokenKind, &name)
+}
+
+// ServiceAccountTokenAuthenticator looks up the service accounts of API tokens for the authenticator.
+type ServiceAccountTokenAuthenticator struct {
+	store store.Store
+	log   logrus.FieldLogger
+}
+
+// Make sure we conform to the authn.ServiceAccountTokenService interface
+var _ authn.ServiceAccountTokenService = (*ServiceAccountTokenAuthenticator)(nil)
+
+// NewServiceAccountTokenAuthenticator creates a ServiceAccountTokenAuthenticator reading the tokens from the store.
+func NewServiceAccountTokenAuthenticator(store store.Store, log logrus.FieldLogger) *ServiceAccountTokenAuthenticator {
+	return &ServiceAccountTokenAuthenticator{store: store, log: log}
+}
+
+// AuthenticateServiceAccountToken returns the service account of a token and the roles the token grants.
+// Tokens that are unknown, expired, or whose service account was deleted are rejected.
+func (a *ServiceAccountTokenAuthenticator) AuthenticateServiceAccountToken(ctx context.Context, token string) (*authn.ServiceAccountTokenInfo, error) {
+	record, err := a.store.ServiceAccountToken().GetByHash(ctx, authn.HashServiceAccountToken(token))
+	if err != nil {
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			return nil, errors.New("unknown service account token")
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if !record.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("service account token %q has expired", record.Name)
+	}
+
+	sa, err := a.store.ServiceAccount().Get(ctx, record.OrgID, record.ServiceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("service account %q of token %q: %w", record.ServiceAccount, record.Name, err)
+	}
+
+	// a token never grants more than its service account currently has, even if the roles of the
+	// service account were reduced after the token was created
+	roles := sa.Spec.Roles
+	if tokenRoles := record.GetRoles(); tokenRoles != nil {
+		roles = lo.Intersect(tokenRoles, sa.Spec.Roles)
+	}
+
+	if record.LastUsedAt == nil || now.Sub(*record.LastUsedAt) >= serviceAccountTokenLastUsedPrecision {
+		if err := a.store.ServiceAccountToken().UpdateLastUsed(ctx, record.OrgID, record.ServiceAccount, record.Name, now); err != nil {
+			a.log.WithError(err).Warnf("failed to record the use of service account token %q", record.Name)
+		}
+	}
+
+	return &authn.ServiceAccountTokenInfo{
+		OrgID:          record.OrgID,
+		ServiceAccount: record.ServiceAccount,
+		TokenName:      record.Name,
+		Roles:          roles,
+	}, nil
+}
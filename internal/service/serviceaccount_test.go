@@ -0,0 +1,199 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServiceAccount(name string, roles ...string) domain.ServiceAccount {
+	return domain.ServiceAccount{
+		ApiVersion: "v1beta1",
+		Kind:       domain.ServiceAccountKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:       domain.ServiceAccountSpec{Roles: roles},
+	}
+}
+
+func newTestServiceAccountToken(name string, roles ...string) domain.ServiceAccountToken {
+	token := domain.ServiceAccountToken{
+		ApiVersion: "v1beta1",
+		Kind:       domain.ServiceAccountTokenKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+	}
+	if len(roles) > 0 {
+		token.Spec.Roles = lo.ToPtr(roles)
+	}
+	return token
+}
+
+func TestCreateServiceAccountWithAdminRole(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	_, status := serviceHandler.CreateServiceAccount(ctx, store.NullOrgId, newTestServiceAccount("ci", "flightctl-admin"))
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestServiceAccountsCannotGrantMoreThanTheCaller(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	// internal requests create roles without checks
+	_, status := serviceHandler.CreateRole(ctx, orgId, newTestRole("device-patcher", []string{"devices"}, []string{"get", "patch"}))
+	require.Equal(domain.StatusCreated(), status)
+
+	ctx = authz.WithAuthorizer(ctx, fakeAuthorizer{permissions: []string{"*:get", "*:list", "devices:get"}})
+
+	_, status = serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+	_, status = serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("deployer", "flightctl-operator"))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	_, status = serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("patcher", "device-patcher"))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	require.Contains(status.Message, "devices:patch")
+	_, status = serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("remover", "device-remover"))
+	require.Equal(statusBadRequestCode, status.Code)
+	require.Contains(status.Message, "device-remover")
+
+	_, status = serviceHandler.ReplaceServiceAccount(ctx, orgId, "ci", newTestServiceAccount("ci", "flightctl-viewer", "operator"))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+
+	patch := domain.PatchRequest{{Op: "add", Path: "/spec/roles/-", Value: lo.ToPtr[any]("device-patcher")}}
+	_, status = serviceHandler.PatchServiceAccount(ctx, orgId, "ci", patch)
+	require.Equal(int32(http.StatusForbidden), status.Code)
+}
+
+func TestCreateServiceAccountTokenWithRoleOutsideServiceAccount(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	_, status := serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("pipeline", "flightctl-operator"))
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestCreateServiceAccountTokenRequiresItsRoles(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	// internal requests create service accounts without checks
+	_, status := serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-viewer", "flightctl-org-admin"))
+	require.Equal(domain.StatusCreated(), status)
+
+	ctx = authz.WithAuthorizer(ctx, fakeAuthorizer{permissions: []string{"serviceaccounts/tokens:create", "*:get", "*:list"}})
+
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("admin"))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("admin", "flightctl-org-admin"))
+	require.Equal(int32(http.StatusForbidden), status.Code)
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("viewer", "flightctl-viewer"))
+	require.Equal(statusCreatedCode, status.Code)
+}
+
+func TestCreateServiceAccountTokenForUnknownServiceAccount(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	_, status := serviceHandler.CreateServiceAccountToken(ctx, store.NullOrgId, "ci", newTestServiceAccountToken("pipeline"))
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestCreateServiceAccountTokenExpiration(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	orgId := store.NullOrgId
+
+	_, status := serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+
+	token := newTestServiceAccountToken("expired")
+	token.Spec.ExpirationTimestamp = lo.ToPtr(time.Now().Add(-time.Hour))
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", token)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	token = newTestServiceAccountToken("too-long")
+	token.Spec.ExpirationTimestamp = lo.ToPtr(time.Now().Add(2 * MaxServiceAccountTokenExpiration))
+	_, status = serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", token)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	created, status := serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("default"))
+	require.Equal(domain.StatusCreated(), status)
+	expiresIn := time.Until(lo.FromPtr(created.Spec.ExpirationTimestamp))
+	require.InDelta(DefaultServiceAccountTokenExpiration.Seconds(), expiresIn.Seconds(), time.Minute.Seconds())
+}
+
+func TestServiceAccountTokenAuthentication(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+	authenticator := NewServiceAccountTokenAuthenticator(testStore, logrus.New())
+
+	_, status := serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-operator", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+
+	created, status := serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("pipeline", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+	require.NotEmpty(lo.FromPtr(created.Token))
+
+	// the secret token is only returned on creation
+	list, status := serviceHandler.ListServiceAccountTokens(ctx, orgId, "ci")
+	require.Equal(domain.StatusOK(), status)
+	require.Len(list.Items, 1)
+	require.Nil(list.Items[0].Token)
+
+	info, err := authenticator.AuthenticateServiceAccountToken(ctx, *created.Token)
+	require.NoError(err)
+	require.Equal(orgId, info.OrgID)
+	require.Equal("ci", info.ServiceAccount)
+	require.Equal("pipeline", info.TokenName)
+	require.Equal([]string{"flightctl-viewer"}, info.Roles)
+
+	list, status = serviceHandler.ListServiceAccountTokens(ctx, orgId, "ci")
+	require.Equal(domain.StatusOK(), status)
+	require.NotNil(list.Items[0].Status)
+	require.NotNil(list.Items[0].Status.LastUsedTimestamp)
+
+	_, err = authenticator.AuthenticateServiceAccountToken(ctx, *created.Token+"x")
+	require.Error(err)
+
+	status = serviceHandler.DeleteServiceAccountToken(ctx, orgId, "ci", "pipeline")
+	require.Equal(domain.StatusOK(), status)
+	_, err = authenticator.AuthenticateServiceAccountToken(ctx, *created.Token)
+	require.Error(err)
+}
+
+func TestDeleteServiceAccountRevokesTokens(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+	authenticator := NewServiceAccountTokenAuthenticator(testStore, logrus.New())
+
+	_, status := serviceHandler.CreateServiceAccount(ctx, orgId, newTestServiceAccount("ci", "flightctl-viewer"))
+	require.Equal(domain.StatusCreated(), status)
+	created, status := serviceHandler.CreateServiceAccountToken(ctx, orgId, "ci", newTestServiceAccountToken("pipeline"))
+	require.Equal(domain.StatusCreated(), status)
+
+	info, err := authenticator.AuthenticateServiceAccountToken(ctx, *created.Token)
+	require.NoError(err)
+	require.Equal([]string{"flightctl-viewer"}, info.Roles)
+
+	status = serviceHandler.DeleteServiceAccount(ctx, orgId, "ci")
+	require.Equal(domain.StatusOK(), status)
+	_, err = authenticator.AuthenticateServiceAccountToken(ctx, *created.Token)
+	require.Error(err)
+}
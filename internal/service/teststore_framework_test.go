@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -30,6 +32,14 @@ type TestStore struct {
 	resourceSyncVals   *DummyResourceSync
 	enrollmentRequests *DummyEnrollmentRequest
 	organizations      *DummyOrganization
+	csrs               *DummyCertificateSigningRequest
+	revocations        *DummyCertificateRevocation
+	approvalPolicies   *DummyEnrollmentApprovalPolicy
+	roles              *DummyRole
+	roleBindings       *DummyRoleBinding
+	serviceAccounts    *DummyServiceAccount
+	saTokens           *DummyServiceAccountToken
+	bulkOperations     *DummyDeviceBulkOperation
 }
 
 type DummyDevice struct {
@@ -62,6 +72,46 @@ type DummyEnrollmentRequest struct {
 	enrollmentRequests *[]domain.EnrollmentRequest
 }
 
+type DummyCertificateSigningRequest struct {
+	store.CertificateSigningRequest
+	csrs *[]domain.CertificateSigningRequest
+}
+
+type DummyCertificateRevocation struct {
+	store.CertificateRevocation
+	revocations *[]domain.CertificateRevocation
+}
+
+type DummyEnrollmentApprovalPolicy struct {
+	store.EnrollmentApprovalPolicy
+	approvalPolicies *[]domain.EnrollmentApprovalPolicy
+}
+
+type DummyRole struct {
+	store.Role
+	roles *[]domain.Role
+}
+
+type DummyRoleBinding struct {
+	store.RoleBinding
+	roleBindings *[]domain.RoleBinding
+}
+
+type DummyServiceAccount struct {
+	store.ServiceAccount
+	serviceAccounts *[]domain.ServiceAccount
+}
+
+type DummyServiceAccountToken struct {
+	store.ServiceAccountToken
+	tokens *[]model.ServiceAccountToken
+}
+
+type DummyDeviceBulkOperation struct {
+	store.DeviceBulkOperation
+	operations *[]domain.DeviceBulkOperation
+}
+
 type DummyCatalog struct {
 	store.Catalog
 	catalogs *[]domain.Catalog
@@ -99,6 +149,30 @@ func (s *TestStore) init() {
 	if s.organizations == nil {
 		s.organizations = &DummyOrganization{organizations: &[]*model.Organization{}}
 	}
+	if s.csrs == nil {
+		s.csrs = &DummyCertificateSigningRequest{csrs: &[]domain.CertificateSigningRequest{}}
+	}
+	if s.revocations == nil {
+		s.revocations = &DummyCertificateRevocation{revocations: &[]domain.CertificateRevocation{}}
+	}
+	if s.approvalPolicies == nil {
+		s.approvalPolicies = &DummyEnrollmentApprovalPolicy{approvalPolicies: &[]domain.EnrollmentApprovalPolicy{}}
+	}
+	if s.roles == nil {
+		s.roles = &DummyRole{roles: &[]domain.Role{}}
+	}
+	if s.roleBindings == nil {
+		s.roleBindings = &DummyRoleBinding{roleBindings: &[]domain.RoleBinding{}}
+	}
+	if s.serviceAccounts == nil {
+		s.serviceAccounts = &DummyServiceAccount{serviceAccounts: &[]domain.ServiceAccount{}}
+	}
+	if s.saTokens == nil {
+		s.saTokens = &DummyServiceAccountToken{tokens: &[]model.ServiceAccountToken{}}
+	}
+	if s.bulkOperations == nil {
+		s.bulkOperations = &DummyDeviceBulkOperation{operations: &[]domain.DeviceBulkOperation{}}
+	}
 }
 
 func (s *TestStore) Fleet() store.Fleet {
@@ -141,6 +215,46 @@ func (s *TestStore) Organization() store.Organization {
 	return s.organizations
 }
 
+func (s *TestStore) CertificateSigningRequest() store.CertificateSigningRequest {
+	s.init()
+	return s.csrs
+}
+
+func (s *TestStore) CertificateRevocation() store.CertificateRevocation {
+	s.init()
+	return s.revocations
+}
+
+func (s *TestStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	s.init()
+	return s.approvalPolicies
+}
+
+func (s *TestStore) Role() store.Role {
+	s.init()
+	return s.roles
+}
+
+func (s *TestStore) RoleBinding() store.RoleBinding {
+	s.init()
+	return s.roleBindings
+}
+
+func (s *TestStore) ServiceAccount() store.ServiceAccount {
+	s.init()
+	return s.serviceAccounts
+}
+
+func (s *TestStore) ServiceAccountToken() store.ServiceAccountToken {
+	s.init()
+	return s.saTokens
+}
+
+func (s *TestStore) DeviceBulkOperation() store.DeviceBulkOperation {
+	s.init()
+	return s.bulkOperations
+}
+
 // --------------------------------------> Event
 
 func (s *DummyEvent) Create(ctx context.Context, orgId uuid.UUID, event *domain.Event) error {
@@ -173,6 +287,10 @@ func (s *DummyDevice) Get(ctx context.Context, orgId uuid.UUID, name string) (*d
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyDevice) Count(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (int64, error) {
+	return int64(len(*s.devices)), nil
+}
+
 func (s *DummyDevice) GetWithTimestamp(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error) {
 	return s.Get(ctx, orgId, name)
 }
@@ -632,13 +750,283 @@ func (s *DummyEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UU
 			if callbackEvent != nil {
 				callbackEvent(ctx, domain.EnrollmentRequestKind, orgId, lo.FromPtr(er.Metadata.Name), oldEr, er, false, nil)
 			}
-			oldEr.Status = er.Status
+			(*s.enrollmentRequests)[i].Status = er.Status
 			return er, nil
 		}
 	}
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyEnrollmentRequest) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentRequestList, error) {
+	var list domain.EnrollmentRequestList
+	deepCopy(*s.enrollmentRequests, &list.Items)
+	return &list, nil
+}
+
+// --------------------------------------> CertificateSigningRequest
+
+func (s *DummyCertificateSigningRequest) Create(ctx context.Context, orgId uuid.UUID, csr *domain.CertificateSigningRequest, callbackEvent store.EventCallback) (*domain.CertificateSigningRequest, error) {
+	var c domain.CertificateSigningRequest
+	deepCopy(csr, &c)
+	*s.csrs = append(*s.csrs, c)
+	return csr, nil
+}
+
+func (s *DummyCertificateSigningRequest) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.CertificateSigningRequestList, error) {
+	var list domain.CertificateSigningRequestList
+	deepCopy(*s.csrs, &list.Items)
+	return &list, nil
+}
+
+// --------------------------------------> CertificateRevocation
+
+func (s *DummyCertificateRevocation) Create(ctx context.Context, orgId uuid.UUID, revocations []domain.CertificateRevocation) error {
+	for _, revocation := range revocations {
+		if !lo.ContainsBy(*s.revocations, func(r domain.CertificateRevocation) bool {
+			return r.Issuer == revocation.Issuer && r.SerialNumber == revocation.SerialNumber
+		}) {
+			*s.revocations = append(*s.revocations, revocation)
+		}
+	}
+	return nil
+}
+
+func (s *DummyCertificateRevocation) List(ctx context.Context, orgId uuid.UUID, deviceName *string) (*domain.CertificateRevocationList, error) {
+	items := lo.Filter(*s.revocations, func(r domain.CertificateRevocation, _ int) bool {
+		return deviceName == nil || lo.FromPtr(r.DeviceName) == *deviceName
+	})
+	return &domain.CertificateRevocationList{Items: items}, nil
+}
+
+// --------------------------------------> EnrollmentApprovalPolicy
+
+func (s *DummyEnrollmentApprovalPolicy) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, error) {
+	for _, policy := range *s.approvalPolicies {
+		if name == *policy.Metadata.Name {
+			var p domain.EnrollmentApprovalPolicy
+			deepCopy(policy, &p)
+			return &p, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyEnrollmentApprovalPolicy) Create(ctx context.Context, orgId uuid.UUID, policy *domain.EnrollmentApprovalPolicy, callbackEvent store.EventCallback) (*domain.EnrollmentApprovalPolicy, error) {
+	var p domain.EnrollmentApprovalPolicy
+	deepCopy(policy, &p)
+	*s.approvalPolicies = append(*s.approvalPolicies, p)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.EnrollmentApprovalPolicyKind, orgId, lo.FromPtr(policy.Metadata.Name), nil, policy, true, nil)
+	}
+	return policy, nil
+}
+
+func (s *DummyEnrollmentApprovalPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentApprovalPolicyList, error) {
+	var list domain.EnrollmentApprovalPolicyList
+	deepCopy(*s.approvalPolicies, &list.Items)
+	return &list, nil
+}
+
+// --------------------------------------> Role
+
+func (s *DummyRole) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, error) {
+	for _, role := range *s.roles {
+		if name == *role.Metadata.Name {
+			var r domain.Role
+			deepCopy(role, &r)
+			return &r, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyRole) Create(ctx context.Context, orgId uuid.UUID, role *domain.Role, callbackEvent store.EventCallback) (*domain.Role, error) {
+	var r domain.Role
+	deepCopy(role, &r)
+	*s.roles = append(*s.roles, r)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.RoleKind, orgId, lo.FromPtr(role.Metadata.Name), nil, role, true, nil)
+	}
+	return role, nil
+}
+
+func (s *DummyRole) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.RoleList, error) {
+	var list domain.RoleList
+	deepCopy(*s.roles, &list.Items)
+	return &list, nil
+}
+
+func (s *DummyRole) ListByNames(ctx context.Context, orgId uuid.UUID, names []string) ([]domain.Role, error) {
+	var roles []domain.Role
+	for _, role := range *s.roles {
+		if slices.Contains(names, lo.FromPtr(role.Metadata.Name)) {
+			var r domain.Role
+			deepCopy(role, &r)
+			roles = append(roles, r)
+		}
+	}
+	return roles, nil
+}
+
+// --------------------------------------> RoleBinding
+
+func (s *DummyRoleBinding) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, error) {
+	for _, binding := range *s.roleBindings {
+		if name == *binding.Metadata.Name {
+			var b domain.RoleBinding
+			deepCopy(binding, &b)
+			return &b, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyRoleBinding) Create(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, callbackEvent store.EventCallback) (*domain.RoleBinding, error) {
+	var b domain.RoleBinding
+	deepCopy(binding, &b)
+	*s.roleBindings = append(*s.roleBindings, b)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.RoleBindingKind, orgId, lo.FromPtr(binding.Metadata.Name), nil, binding, true, nil)
+	}
+	return binding, nil
+}
+
+func (s *DummyRoleBinding) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.RoleBindingList, error) {
+	var list domain.RoleBindingList
+	deepCopy(*s.roleBindings, &list.Items)
+	return &list, nil
+}
+
+func (s *DummyRoleBinding) ListBySubjects(ctx context.Context, orgId uuid.UUID, subjects []domain.RoleBindingSubject) ([]domain.RoleBinding, error) {
+	var bindings []domain.RoleBinding
+	for _, binding := range *s.roleBindings {
+		if slices.ContainsFunc(binding.Spec.Subjects, func(subject domain.RoleBindingSubject) bool {
+			return slices.Contains(subjects, subject)
+		}) {
+			var b domain.RoleBinding
+			deepCopy(binding, &b)
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings, nil
+}
+
+// --------------------------------------> ServiceAccount
+
+func (s *DummyServiceAccount) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, error) {
+	for _, sa := range *s.serviceAccounts {
+		if name == *sa.Metadata.Name {
+			var r domain.ServiceAccount
+			deepCopy(sa, &r)
+			return &r, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyServiceAccount) Create(ctx context.Context, orgId uuid.UUID, sa *domain.ServiceAccount, callbackEvent store.EventCallback) (*domain.ServiceAccount, error) {
+	var r domain.ServiceAccount
+	deepCopy(sa, &r)
+	*s.serviceAccounts = append(*s.serviceAccounts, r)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.ServiceAccountKind, orgId, lo.FromPtr(sa.Metadata.Name), nil, sa, true, nil)
+	}
+	return sa, nil
+}
+
+func (s *DummyServiceAccount) Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) error {
+	for i, sa := range *s.serviceAccounts {
+		if name == *sa.Metadata.Name {
+			*s.serviceAccounts = append((*s.serviceAccounts)[:i], (*s.serviceAccounts)[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// --------------------------------------> ServiceAccountToken
+
+func (s *DummyServiceAccountToken) Create(ctx context.Context, orgId uuid.UUID, serviceAccount string, tokenHash string, token *domain.ServiceAccountToken) (*domain.ServiceAccountToken, error) {
+	record := model.NewServiceAccountTokenFromApiResource(orgId, serviceAccount, tokenHash, token)
+	record.CreatedAt = time.Now()
+	*s.tokens = append(*s.tokens, *record)
+	return record.ToApiResource(), nil
+}
+
+func (s *DummyServiceAccountToken) List(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, error) {
+	var records []model.ServiceAccountToken
+	for _, t := range *s.tokens {
+		if t.ServiceAccount == serviceAccount {
+			records = append(records, t)
+		}
+	}
+	list := model.ServiceAccountTokensToApiResource(records)
+	return &list, nil
+}
+
+func (s *DummyServiceAccountToken) GetByHash(ctx context.Context, tokenHash string) (*model.ServiceAccountToken, error) {
+	for _, t := range *s.tokens {
+		if t.TokenHash == tokenHash {
+			record := t
+			return &record, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyServiceAccountToken) UpdateLastUsed(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string, lastUsed time.Time) error {
+	for i, t := range *s.tokens {
+		if t.ServiceAccount == serviceAccount && t.Name == name {
+			(*s.tokens)[i].LastUsedAt = lo.ToPtr(lastUsed)
+		}
+	}
+	return nil
+}
+
+func (s *DummyServiceAccountToken) Delete(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) error {
+	for i, t := range *s.tokens {
+		if t.ServiceAccount == serviceAccount && t.Name == name {
+			*s.tokens = append((*s.tokens)[:i], (*s.tokens)[i+1:]...)
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
+func (s *DummyServiceAccountToken) DeleteAll(ctx context.Context, orgId uuid.UUID, serviceAccount string) error {
+	remaining := []model.ServiceAccountToken{}
+	for _, t := range *s.tokens {
+		if t.ServiceAccount != serviceAccount {
+			remaining = append(remaining, t)
+		}
+	}
+	*s.tokens = remaining
+	return nil
+}
+
+// --------------------------------------> DeviceBulkOperation
+
+func (s *DummyDeviceBulkOperation) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, error) {
+	for _, op := range *s.operations {
+		if name == *op.Metadata.Name {
+			var o domain.DeviceBulkOperation
+			deepCopy(op, &o)
+			return &o, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyDeviceBulkOperation) Create(ctx context.Context, orgId uuid.UUID, op *domain.DeviceBulkOperation, callbackEvent store.EventCallback) (*domain.DeviceBulkOperation, error) {
+	var o domain.DeviceBulkOperation
+	deepCopy(op, &o)
+	*s.operations = append(*s.operations, o)
+	if callbackEvent != nil {
+		callbackEvent(ctx, domain.DeviceBulkOperationKind, orgId, lo.FromPtr(op.Metadata.Name), nil, op, true, nil)
+	}
+	return op, nil
+}
+
 // --------------------------------------> Organization
 
 func (s *DummyOrganization) InitialMigration(ctx context.Context) error {
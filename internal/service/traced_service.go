@@ -44,6 +44,20 @@ func endSpan(span trace.Span, st domain.Status) {
 	span.End()
 }
 
+// --- CertificateRevocation ---
+func (t *TracedService) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID, p domain.ListCertificateRevocationsParams) (*domain.CertificateRevocationList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListCertificateRevocations")
+	resp, st := t.inner.ListCertificateRevocations(ctx, orgId, p)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status) {
+	ctx, span := startSpan(ctx, "RevokeCertificates")
+	resp, st := t.inner.RevokeCertificates(ctx, orgId, request)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- CertificateSigningRequest ---
 func (t *TracedService) ListCertificateSigningRequests(ctx context.Context, orgId uuid.UUID, p domain.ListCertificateSigningRequestsParams) (*domain.CertificateSigningRequestList, domain.Status) {
 	ctx, span := startSpan(ctx, "ListCertificateSigningRequests")
@@ -201,6 +215,12 @@ func (t *TracedService) ResumeDevices(ctx context.Context, orgId uuid.UUID, requ
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) RequestDeviceRender(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "RequestDeviceRender")
+	st := t.inner.RequestDeviceRender(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
 func (t *TracedService) UpdateDeviceAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status {
 	ctx, span := startSpan(ctx, "UpdateDeviceAnnotations")
 	st := t.inner.UpdateDeviceAnnotations(ctx, orgId, name, annotations, deleteKeys)
@@ -337,6 +357,215 @@ func (t *TracedService) ReplaceEnrollmentRequestStatus(ctx context.Context, orgI
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) ApproveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentRequest, domain.Status) {
+	ctx, span := startSpan(ctx, "ApproveEnrollmentRequestByPolicy")
+	resp, st := t.inner.ApproveEnrollmentRequestByPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- EnrollmentApprovalPolicy ---
+func (t *TracedService) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateEnrollmentApprovalPolicy")
+	resp, st := t.inner.CreateEnrollmentApprovalPolicy(ctx, orgId, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListEnrollmentApprovalPolicies")
+	resp, st := t.inner.ListEnrollmentApprovalPolicies(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "GetEnrollmentApprovalPolicy")
+	resp, st := t.inner.GetEnrollmentApprovalPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEnrollmentApprovalPolicy")
+	resp, st := t.inner.ReplaceEnrollmentApprovalPolicy(ctx, orgId, name, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteEnrollmentApprovalPolicy")
+	st := t.inner.DeleteEnrollmentApprovalPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchEnrollmentApprovalPolicy")
+	resp, st := t.inner.PatchEnrollmentApprovalPolicy(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- Role ---
+func (t *TracedService) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateRole")
+	resp, st := t.inner.CreateRole(ctx, orgId, role)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListRoles")
+	resp, st := t.inner.ListRoles(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "GetRole")
+	resp, st := t.inner.GetRole(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceRole")
+	resp, st := t.inner.ReplaceRole(ctx, orgId, name, role)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteRole")
+	st := t.inner.DeleteRole(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchRole(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchRole")
+	resp, st := t.inner.PatchRole(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- RoleBinding ---
+func (t *TracedService) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateRoleBinding")
+	resp, st := t.inner.CreateRoleBinding(ctx, orgId, binding)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListRoleBindings")
+	resp, st := t.inner.ListRoleBindings(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "GetRoleBinding")
+	resp, st := t.inner.GetRoleBinding(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceRoleBinding")
+	resp, st := t.inner.ReplaceRoleBinding(ctx, orgId, name, binding)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteRoleBinding")
+	st := t.inner.DeleteRoleBinding(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchRoleBinding(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchRoleBinding")
+	resp, st := t.inner.PatchRoleBinding(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- ServiceAccount ---
+func (t *TracedService) CreateServiceAccount(ctx context.Context, orgId uuid.UUID, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateServiceAccount")
+	resp, st := t.inner.CreateServiceAccount(ctx, orgId, serviceAccount)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListServiceAccounts(ctx context.Context, orgId uuid.UUID, params domain.ListServiceAccountsParams) (*domain.ServiceAccountList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListServiceAccounts")
+	resp, st := t.inner.ListServiceAccounts(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetServiceAccount(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, domain.Status) {
+	ctx, span := startSpan(ctx, "GetServiceAccount")
+	resp, st := t.inner.GetServiceAccount(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceServiceAccount(ctx context.Context, orgId uuid.UUID, name string, serviceAccount domain.ServiceAccount) (*domain.ServiceAccount, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceServiceAccount")
+	resp, st := t.inner.ReplaceServiceAccount(ctx, orgId, name, serviceAccount)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteServiceAccount(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteServiceAccount")
+	st := t.inner.DeleteServiceAccount(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchServiceAccount(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ServiceAccount, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchServiceAccount")
+	resp, st := t.inner.PatchServiceAccount(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) CreateServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, token domain.ServiceAccountToken) (*domain.ServiceAccountToken, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateServiceAccountToken")
+	resp, st := t.inner.CreateServiceAccountToken(ctx, orgId, serviceAccount, token)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListServiceAccountTokens(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListServiceAccountTokens")
+	resp, st := t.inner.ListServiceAccountTokens(ctx, orgId, serviceAccount)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteServiceAccountToken(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteServiceAccountToken")
+	st := t.inner.DeleteServiceAccountToken(ctx, orgId, serviceAccount, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- DeviceBulkOperation ---
+
+func (t *TracedService) CreateDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.DeviceBulkOperation, dryRun bool) (*domain.DeviceBulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceBulkOperation")
+	resp, st := t.inner.CreateDeviceBulkOperation(ctx, orgId, operation, dryRun)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListDeviceBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListDeviceBulkOperationsParams) (*domain.DeviceBulkOperationList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListDeviceBulkOperations")
+	resp, st := t.inner.ListDeviceBulkOperations(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceBulkOperation")
+	resp, st := t.inner.GetDeviceBulkOperation(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteDeviceBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteDeviceBulkOperation")
+	st := t.inner.DeleteDeviceBulkOperation(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) ReplaceDeviceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceDeviceBulkOperationStatus")
+	resp, st := t.inner.ReplaceDeviceBulkOperationStatus(ctx, orgId, name, operation)
+	endSpan(span, st)
+	return resp, st
+}
 
 // --- Fleet ---
 func (t *TracedService) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
@@ -490,6 +719,50 @@ func (t *TracedService) GetRepositoryDeviceReferences(ctx context.Context, orgId
 	return resp, st
 }
 
+// --- SecretStore ---
+func (t *TracedService) CreateSecretStore(ctx context.Context, orgId uuid.UUID, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateSecretStore")
+	resp, st := t.inner.CreateSecretStore(ctx, orgId, secretStore)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListSecretStores(ctx context.Context, orgId uuid.UUID, params domain.ListSecretStoresParams) (*domain.SecretStoreList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListSecretStores")
+	resp, st := t.inner.ListSecretStores(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetSecretStore(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, domain.Status) {
+	ctx, span := startSpan(ctx, "GetSecretStore")
+	resp, st := t.inner.GetSecretStore(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceSecretStore(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore) (*domain.SecretStore, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceSecretStore")
+	resp, st := t.inner.ReplaceSecretStore(ctx, orgId, name, secretStore)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteSecretStore(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteSecretStore")
+	st := t.inner.DeleteSecretStore(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchSecretStore(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.SecretStore, domain.Status) {
+	ctx, span := startSpan(ctx, "PatchSecretStore")
+	resp, st := t.inner.PatchSecretStore(ctx, orgId, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceSecretStoreStatusByError(ctx context.Context, orgId uuid.UUID, name string, secretStore domain.SecretStore, err error) (*domain.SecretStore, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceSecretStoreStatusByError")
+	resp, st := t.inner.ReplaceSecretStoreStatusByError(ctx, orgId, name, secretStore, err)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- ResourceSync ---
 func (t *TracedService) CreateResourceSync(ctx context.Context, orgId uuid.UUID, rs domain.ResourceSync) (*domain.ResourceSync, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateResourceSync")
@@ -683,6 +956,26 @@ func (t *TracedService) DeleteEventsOlderThan(ctx context.Context, cutoffTime ti
 	return resp, st
 }
 
+// --- AuditEvent ---
+func (t *TracedService) CreateAuditEvent(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) domain.Status {
+	ctx, span := startSpan(ctx, "CreateAuditEvent")
+	st := t.inner.CreateAuditEvent(ctx, orgId, event)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) ListAuditEvents(ctx context.Context, orgId uuid.UUID, params domain.ListAuditEventsParams) (*domain.AuditEventList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListAuditEvents")
+	resp, st := t.inner.ListAuditEvents(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteAuditEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status) {
+	ctx, span := startSpan(ctx, "DeleteAuditEventsOlderThan")
+	resp, st := t.inner.DeleteAuditEventsOlderThan(ctx, cutoffTime)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- Checkpoint ---
 func (t *TracedService) GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, domain.Status) {
 	ctx, span := startSpan(ctx, "GetCheckpoint")
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type AuditEvent interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, event *domain.AuditEvent) error
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.AuditEventList, error)
+	DeleteOlderThan(ctx context.Context, cutoffTime time.Time) (int64, error)
+}
+
+type AuditEventStore struct {
+	dbHandler    *gorm.DB
+	log          logrus.FieldLogger
+	genericStore *GenericStore[*model.AuditEvent, model.AuditEvent, domain.AuditEvent, domain.AuditEventList]
+}
+
+// Make sure we conform to AuditEvent interface
+var _ AuditEvent = (*AuditEventStore)(nil)
+
+func NewAuditEvent(db *gorm.DB, log logrus.FieldLogger) AuditEvent {
+	genericStore := NewGenericStore[*model.AuditEvent, model.AuditEvent, domain.AuditEvent, domain.AuditEventList](
+		db,
+		log,
+		model.NewAuditEventFromApiResource,
+		(*model.AuditEvent).ToApiResource,
+		model.AuditEventsToApiResource,
+	)
+	return &AuditEventStore{dbHandler: db, log: log, genericStore: genericStore}
+}
+
+func (s *AuditEventStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *AuditEventStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.AuditEvent{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AuditEventStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.AuditEvent) error {
+	m, _ := model.NewAuditEventFromApiResource(resource)
+	m.OrgID = orgId
+	return s.getDB(ctx).Create(&m).Error
+}
+
+func (s *AuditEventStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.AuditEventList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+// DeleteOlderThan deletes audit events older than the provided timestamp
+func (s *AuditEventStore) DeleteOlderThan(ctx context.Context, cutoffTime time.Time) (int64, error) {
+	// Delete audit events older than the cutoff time
+	result := s.getDB(ctx).Unscoped().Where("created_at < ?", cutoffTime).Delete(&model.AuditEvent{})
+
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete audit events: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
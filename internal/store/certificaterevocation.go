@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CertificateRevocation interface {
+	InitialMigration(ctx context.Context) error
+	Create(ctx context.Context, orgId uuid.UUID, revocations []domain.CertificateRevocation) error
+	List(ctx context.Context, orgId uuid.UUID, deviceName *string) (*domain.CertificateRevocationList, error)
+	ListUnexpired(ctx context.Context, now time.Time) ([]domain.CertificateRevocation, error)
+}
+
+type CertificateRevocationStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to CertificateRevocation interface
+var _ CertificateRevocation = (*CertificateRevocationStore)(nil)
+
+func NewCertificateRevocation(db *gorm.DB, log logrus.FieldLogger) CertificateRevocation {
+	return &CertificateRevocationStore{dbHandler: db, log: log}
+}
+
+func (s *CertificateRevocationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *CertificateRevocationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+	return db.AutoMigrate(&model.CertificateRevocation{})
+}
+
+// Create records the revocations. Certificates that are already revoked keep their original
+// revocation time and reason.
+func (s *CertificateRevocationStore) Create(ctx context.Context, orgId uuid.UUID, revocations []domain.CertificateRevocation) error {
+	if len(revocations) == 0 {
+		return nil
+	}
+	records := make([]*model.CertificateRevocation, 0, len(revocations))
+	for i := range revocations {
+		records = append(records, model.NewCertificateRevocationFromApiResource(orgId, &revocations[i]))
+	}
+	result := s.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&records)
+	return ErrorFromGormError(result.Error)
+}
+
+func (s *CertificateRevocationStore) List(ctx context.Context, orgId uuid.UUID, deviceName *string) (*domain.CertificateRevocationList, error) {
+	var records []model.CertificateRevocation
+	query := s.getDB(ctx).Where("org_id = ?", orgId)
+	if deviceName != nil {
+		query = query.Where("device_name = ?", *deviceName)
+	}
+	if err := query.Order("revoked_at DESC").Find(&records).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	list := model.CertificateRevocationsToApiResource(records)
+	return &list, nil
+}
+
+// ListUnexpired returns the revocations of all the organizations whose certificates have not
+// expired at the given time, which are the ones that still have to be enforced and published.
+func (s *CertificateRevocationStore) ListUnexpired(ctx context.Context, now time.Time) ([]domain.CertificateRevocation, error) {
+	var records []model.CertificateRevocation
+	err := s.getDB(ctx).Where("not_after IS NULL OR not_after > ?", now).Order("revoked_at").Find(&records).Error
+	if err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	revocations := make([]domain.CertificateRevocation, len(records))
+	for i := range records {
+		revocations[i] = *records[i].ToApiResource()
+	}
+	return revocations, nil
+}
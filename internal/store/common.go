@@ -126,6 +126,23 @@ func (lq *listQuery) BuildNoOrder(ctx context.Context, db *gorm.DB, orgId uuid.U
 		query = query.Where(q, p...)
 	}
 
+	if len(listParams.ScopeSelectors) > 0 {
+		var (
+			queries []string
+			params  []any
+		)
+		for _, ls := range listParams.ScopeSelectors {
+			q, p, err := ls.Parse(ctx,
+				selector.NewHiddenSelectorName("metadata.labels"), lq.resolver)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, "("+q+")")
+			params = append(params, p...)
+		}
+		query = query.Where(strings.Join(queries, " OR "), params...)
+	}
+
 	return query, nil
 }
 
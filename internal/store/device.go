@@ -533,6 +533,7 @@ func (s *DeviceStore) Count(ctx context.Context, orgId uuid.UUID, listParams Lis
 // - updating_reason: it is the reason field from a condition having type 'Updating'
 // - same_rendered_version: it is the result of comparison for equality between the annotation 'device-controller/renderedVersion' and the field 'status.config.renderedVersion'
 // - update_timed_out: it is a boolean value indicating if the update of the device has been timed out
+// - applications_unhealthy: it is a boolean value indicating if an application of the device hasn't passed its readiness probe or has failed its liveness probe
 func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	var (
 		results            []domain.DeviceCompletionCount
@@ -548,7 +549,10 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
                                  status -> 'config' ->> 'renderedVersion' = annotations->>'%s' AS same_rendered_version,
                                  elem ->> 'reason' as updating_reason,
                                  annotations->>'%s' = ? as same_template_version,
-								 ? as update_timed_out
+								 ? as update_timed_out,
+								 (d.status->'applications' @> '[{"probes": {"readiness": {"result": "Unknown"}}}]' OR
+								  d.status->'applications' @> '[{"probes": {"readiness": {"result": "Failure"}}}]' OR
+								  d.status->'applications' @> '[{"probes": {"liveness": {"result": "Failure"}}}]') IS TRUE as applications_unhealthy
                           from devices d LEFT JOIN LATERAL (
                             SELECT elem
 						    FROM jsonb_array_elements(d.status->'conditions') AS elem
@@ -557,7 +561,7 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
 							) subquery ON TRUE
 						     where
 						        org_id = ? and owner = ? and annotations ? '%s' and deleted_at is null
-						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out`,
+						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out, applications_unhealthy`,
 		domain.DeviceAnnotationRenderedVersion, domain.DeviceAnnotationRenderedTemplateVersion, domain.DeviceAnnotationSelectedForRollout),
 		templateVersion,
 		updateTimeoutValue,
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type DeviceBulkOperation interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, operation *domain.DeviceBulkOperation, eventCallback EventCallback) (*domain.DeviceBulkOperation, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DeviceBulkOperationList, error)
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, operation *domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type DeviceBulkOperationStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.DeviceBulkOperation, model.DeviceBulkOperation, domain.DeviceBulkOperation, domain.DeviceBulkOperationList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to DeviceBulkOperation interface
+var _ DeviceBulkOperation = (*DeviceBulkOperationStore)(nil)
+
+func NewDeviceBulkOperation(db *gorm.DB, log logrus.FieldLogger) DeviceBulkOperation {
+	genericStore := NewGenericStore[*model.DeviceBulkOperation, model.DeviceBulkOperation, domain.DeviceBulkOperation, domain.DeviceBulkOperationList](
+		db,
+		log,
+		model.NewDeviceBulkOperationFromApiResource,
+		(*model.DeviceBulkOperation).ToApiResource,
+		model.DeviceBulkOperationsToApiResource,
+	)
+	return &DeviceBulkOperationStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.DeviceBulkOperationKind, log)}
+}
+
+func (s *DeviceBulkOperationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *DeviceBulkOperationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.DeviceBulkOperation{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for DeviceBulkOperation labels
+	if !db.Migrator().HasIndex(&model.DeviceBulkOperation{}, "idx_device_bulk_operations_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_device_bulk_operations_labels ON device_bulk_operations USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.DeviceBulkOperation{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *DeviceBulkOperationStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceBulkOperation, eventCallback EventCallback) (*domain.DeviceBulkOperation, error) {
+	operation, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, operation, true, err)
+	return operation, err
+}
+
+func (s *DeviceBulkOperationStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceBulkOperation, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *DeviceBulkOperationStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DeviceBulkOperationList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *DeviceBulkOperationStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, error) {
+	return s.genericStore.UpdateStatus(ctx, orgId, resource)
+}
+
+func (s *DeviceBulkOperationStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.DeviceBulkOperation{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
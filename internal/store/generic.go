@@ -25,7 +25,7 @@ type IntegrationTestCallback func()
 // A is the API resource, for example: domain.Device
 // AL is the API list, for example: domain.DeviceList
 type Model interface {
-	model.AuthProvider | model.Catalog | model.CertificateSigningRequest | model.Device | model.EnrollmentRequest | model.Fleet | model.Repository | model.ResourceSync | model.TemplateVersion | model.Event
+	model.AuthProvider | model.Catalog | model.CertificateSigningRequest | model.Device | model.DeviceBulkOperation | model.EnrollmentApprovalPolicy | model.EnrollmentRequest | model.Fleet | model.Repository | model.ResourceSync | model.Role | model.RoleBinding | model.SecretStore | model.ServiceAccount | model.TemplateVersion | model.Event | model.AuditEvent
 }
 type extInt[M any] interface {
 	model.ResourceInterface
@@ -364,7 +364,7 @@ func (s *GenericStore[P, M, A, AL]) UpdateStatus(ctx context.Context, orgId uuid
 
 func hasSpecColumn[M Model]() bool {
 	switch any(new(M)).(type) {
-	case *model.Event:
+	case *model.Event, *model.AuditEvent:
 		return false
 	default:
 		return true
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type AuditEvent struct {
+	Resource
+	Actor        string `gorm:"type:string;index" selector:"actor"`
+	Verb         string `gorm:"type:string;index" selector:"verb"`
+	APIResource  string `gorm:"column:api_resource;type:string;index:idx_audit_resource" selector:"resource"`
+	ResourceName string `gorm:"type:string;index:idx_audit_resource" selector:"resourceName"`
+	Summary      string `gorm:"type:text"`
+	SourceIP     string `gorm:"type:string" selector:"sourceIP"`
+	RequestID    string `gorm:"type:string"`
+	Outcome      string `gorm:"type:string;index" selector:"outcome"`
+	StatusCode   int32  `selector:"statusCode"`
+}
+
+func (e AuditEvent) String() string {
+	val, _ := json.Marshal(e)
+	return string(val)
+}
+
+func NewAuditEventFromApiResource(resource *domain.AuditEvent) (*AuditEvent, error) {
+	if resource == nil {
+		return &AuditEvent{}, nil
+	}
+	return &AuditEvent{
+		Resource: Resource{
+			Name:        *resource.Metadata.Name,
+			Annotations: lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+		},
+		Actor:        resource.Actor,
+		Verb:         resource.Verb,
+		APIResource:  resource.Resource,
+		ResourceName: lo.FromPtr(resource.ResourceName),
+		Summary:      lo.FromPtr(resource.Summary),
+		SourceIP:     lo.FromPtr(resource.SourceIP),
+		RequestID:    lo.FromPtr(resource.RequestId),
+		Outcome:      string(resource.Outcome),
+		StatusCode:   resource.StatusCode,
+	}, nil
+}
+
+func AuditEventAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.AuditEventAPIVersion)
+}
+
+func (e *AuditEvent) ToApiResource(opts ...APIResourceOption) (*domain.AuditEvent, error) {
+	if e == nil {
+		return &domain.AuditEvent{}, nil
+	}
+
+	return &domain.AuditEvent{
+		ApiVersion: AuditEventAPIVersion(),
+		Kind:       domain.AuditEventKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(e.Name),
+			Annotations:       lo.ToPtr(util.EnsureMap(e.Resource.Annotations)),
+			CreationTimestamp: lo.ToPtr(e.CreatedAt.UTC()),
+		},
+		Actor:        e.Actor,
+		Verb:         e.Verb,
+		Resource:     e.APIResource,
+		ResourceName: lo.EmptyableToPtr(e.ResourceName),
+		Summary:      lo.EmptyableToPtr(e.Summary),
+		SourceIP:     lo.EmptyableToPtr(e.SourceIP),
+		RequestId:    lo.EmptyableToPtr(e.RequestID),
+		Outcome:      domain.AuditEventOutcome(e.Outcome),
+		StatusCode:   e.StatusCode,
+	}, nil
+}
+
+func AuditEventsToApiResource(events []AuditEvent, cont *string, numRemaining *int64) (domain.AuditEventList, error) {
+	eventList := make([]domain.AuditEvent, len(events))
+	for i, event := range events {
+		apiResource, _ := event.ToApiResource()
+		eventList[i] = *apiResource
+	}
+	ret := domain.AuditEventList{
+		ApiVersion: AuditEventAPIVersion(),
+		Kind:       domain.AuditEventListKind,
+		Items:      eventList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (e *AuditEvent) GetKind() string {
+	return domain.AuditEventKind
+}
+
+func (e *AuditEvent) HasNilSpec() bool {
+	return true
+}
+
+func (e *AuditEvent) HasSameSpecAs(otherResource any) bool {
+	return true
+}
+
+func (e *AuditEvent) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
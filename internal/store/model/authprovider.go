@@ -74,6 +74,7 @@ func (a *AuthProvider) ToApiResource(opts ...APIResourceOption) (*domain.AuthPro
 		Metadata: domain.ObjectMeta{
 			Name:              lo.ToPtr(a.Name),
 			CreationTimestamp: lo.ToPtr(a.CreatedAt.UTC()),
+			Owner:             a.Owner,
 			Labels:            lo.ToPtr(util.EnsureMap(a.Resource.Labels)),
 			Annotations:       lo.ToPtr(util.EnsureMap(a.Resource.Annotations)),
 			Generation:        a.Generation,
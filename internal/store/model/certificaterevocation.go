@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// CertificateRevocation records a revoked certificate. Serial numbers are unique per issuing CA,
+// so the revocations are keyed by the key identifier of the CA and the serial number.
+type CertificateRevocation struct {
+	Issuer       string       `gorm:"type:string;primaryKey"`
+	SerialNumber string       `gorm:"type:string;primaryKey"`
+	OrgID        uuid.UUID    `gorm:"type:uuid;index:idx_certificate_revocations_org_device,priority:1"`
+	Organization Organization `gorm:"foreignKey:OrgID"`
+	DeviceName   string       `gorm:"type:string;index:idx_certificate_revocations_org_device,priority:2"`
+	Reason       string       `gorm:"type:string"`
+	RevokedAt    time.Time    `gorm:"not null"`
+	NotAfter     *time.Time   `gorm:"index"`
+}
+
+func (r CertificateRevocation) String() string {
+	val, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("CertificateRevocation<marshal-error:%v>", err)
+	}
+	return string(val)
+}
+
+func NewCertificateRevocationFromApiResource(orgId uuid.UUID, resource *domain.CertificateRevocation) *CertificateRevocation {
+	return &CertificateRevocation{
+		Issuer:       resource.Issuer,
+		SerialNumber: resource.SerialNumber,
+		OrgID:        orgId,
+		DeviceName:   lo.FromPtr(resource.DeviceName),
+		Reason:       string(resource.Reason),
+		RevokedAt:    resource.RevokedAt.UTC(),
+		NotAfter:     resource.NotAfter,
+	}
+}
+
+func (r *CertificateRevocation) ToApiResource() *domain.CertificateRevocation {
+	var notAfter *time.Time
+	if r.NotAfter != nil {
+		notAfter = lo.ToPtr(r.NotAfter.UTC())
+	}
+	return &domain.CertificateRevocation{
+		Issuer:       r.Issuer,
+		SerialNumber: r.SerialNumber,
+		DeviceName:   lo.EmptyableToPtr(r.DeviceName),
+		Reason:       domain.CertificateRevocationReason(r.Reason),
+		RevokedAt:    r.RevokedAt.UTC(),
+		NotAfter:     notAfter,
+	}
+}
+
+func CertificateRevocationsToApiResource(revocations []CertificateRevocation) domain.CertificateRevocationList {
+	items := make([]domain.CertificateRevocation, len(revocations))
+	for i := range revocations {
+		items[i] = *revocations[i].ToApiResource()
+	}
+	return domain.CertificateRevocationList{
+		ApiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.CertificateRevocationAPIVersion),
+		Kind:       domain.CertificateRevocationListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      items,
+	}
+}
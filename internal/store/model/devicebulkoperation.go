@@ -0,0 +1,148 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type DeviceBulkOperation struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.DeviceBulkOperationSpec] `gorm:"type:jsonb"`
+
+	// The last reported state, stored as opaque JSON object.
+	Status *JSONField[domain.DeviceBulkOperationStatus] `gorm:"type:jsonb"`
+}
+
+func (d *DeviceBulkOperation) String() string {
+	val, _ := json.Marshal(d)
+	return string(val)
+}
+
+func NewDeviceBulkOperationFromApiResource(resource *domain.DeviceBulkOperation) (*DeviceBulkOperation, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &DeviceBulkOperation{}, nil
+	}
+
+	status := domain.DeviceBulkOperationStatus{Phase: domain.DeviceBulkOperationPhasePending}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &DeviceBulkOperation{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func DeviceBulkOperationAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.DeviceBulkOperationAPIVersion)
+}
+
+func (d *DeviceBulkOperation) ToApiResource(opts ...APIResourceOption) (*domain.DeviceBulkOperation, error) {
+	if d == nil {
+		return &domain.DeviceBulkOperation{}, nil
+	}
+
+	var spec domain.DeviceBulkOperationSpec
+	if d.Spec != nil {
+		spec = d.Spec.Data
+	}
+
+	status := domain.DeviceBulkOperationStatus{Phase: domain.DeviceBulkOperationPhasePending}
+	if d.Status != nil {
+		status = d.Status.Data
+	}
+
+	return &domain.DeviceBulkOperation{
+		ApiVersion: DeviceBulkOperationAPIVersion(),
+		Kind:       domain.DeviceBulkOperationKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(d.Name),
+			CreationTimestamp: lo.ToPtr(d.CreatedAt.UTC()),
+			Owner:             d.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(d.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(d.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(d.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(d.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func DeviceBulkOperationsToApiResource(operations []DeviceBulkOperation, cont *string, numRemaining *int64) (domain.DeviceBulkOperationList, error) {
+	operationList := make([]domain.DeviceBulkOperation, len(operations))
+	for i, operation := range operations {
+		apiResource, err := operation.ToApiResource()
+		if err != nil {
+			return domain.DeviceBulkOperationList{
+				ApiVersion: DeviceBulkOperationAPIVersion(),
+				Kind:       domain.DeviceBulkOperationListKind,
+				Items:      []domain.DeviceBulkOperation{},
+			}, err
+		}
+		operationList[i] = *apiResource
+	}
+	ret := domain.DeviceBulkOperationList{
+		ApiVersion: DeviceBulkOperationAPIVersion(),
+		Kind:       domain.DeviceBulkOperationListKind,
+		Items:      operationList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (d *DeviceBulkOperation) GetKind() string {
+	return domain.DeviceBulkOperationKind
+}
+
+func (d *DeviceBulkOperation) HasNilSpec() bool {
+	return d.Spec == nil
+}
+
+func (d *DeviceBulkOperation) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*DeviceBulkOperation) // Assert that the other resource is a *DeviceBulkOperation
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if d.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (d.Spec == nil && other.Spec != nil) || (d.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(d.Spec.Data, other.Spec.Data)
+}
+
+func (d *DeviceBulkOperation) GetStatusAsJson() ([]byte, error) {
+	return d.Status.MarshalJSON()
+}
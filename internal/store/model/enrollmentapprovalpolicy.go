@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type EnrollmentApprovalPolicy struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.EnrollmentApprovalPolicySpec] `gorm:"type:jsonb"`
+}
+
+func (p EnrollmentApprovalPolicy) String() string {
+	val, _ := json.Marshal(p)
+	return string(val)
+}
+
+func NewEnrollmentApprovalPolicyFromApiResource(resource *domain.EnrollmentApprovalPolicy) (*EnrollmentApprovalPolicy, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EnrollmentApprovalPolicy{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EnrollmentApprovalPolicy{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func EnrollmentApprovalPolicyAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.EnrollmentApprovalPolicyAPIVersion)
+}
+
+func (p *EnrollmentApprovalPolicy) ToApiResource(opts ...APIResourceOption) (*domain.EnrollmentApprovalPolicy, error) {
+	if p == nil {
+		return &domain.EnrollmentApprovalPolicy{}, nil
+	}
+
+	var spec domain.EnrollmentApprovalPolicySpec
+	if p.Spec != nil {
+		spec = p.Spec.Data
+	}
+
+	return &domain.EnrollmentApprovalPolicy{
+		ApiVersion: EnrollmentApprovalPolicyAPIVersion(),
+		Kind:       domain.EnrollmentApprovalPolicyKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(p.Name),
+			CreationTimestamp: lo.ToPtr(p.CreatedAt.UTC()),
+			Owner:             p.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(p.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(p.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(p.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(p.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func EnrollmentApprovalPoliciesToApiResource(policies []EnrollmentApprovalPolicy, cont *string, numRemaining *int64) (domain.EnrollmentApprovalPolicyList, error) {
+	policyList := make([]domain.EnrollmentApprovalPolicy, len(policies))
+	for i, policy := range policies {
+		apiResource, err := policy.ToApiResource()
+		if err != nil {
+			return domain.EnrollmentApprovalPolicyList{
+				ApiVersion: EnrollmentApprovalPolicyAPIVersion(),
+				Kind:       domain.EnrollmentApprovalPolicyListKind,
+				Items:      []domain.EnrollmentApprovalPolicy{},
+			}, err
+		}
+		policyList[i] = *apiResource
+	}
+	ret := domain.EnrollmentApprovalPolicyList{
+		ApiVersion: EnrollmentApprovalPolicyAPIVersion(),
+		Kind:       domain.EnrollmentApprovalPolicyListKind,
+		Items:      policyList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (p *EnrollmentApprovalPolicy) GetKind() string {
+	return domain.EnrollmentApprovalPolicyKind
+}
+
+func (p *EnrollmentApprovalPolicy) HasNilSpec() bool {
+	return p.Spec == nil
+}
+
+func (p *EnrollmentApprovalPolicy) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EnrollmentApprovalPolicy) // Assert that the other resource is a *EnrollmentApprovalPolicy
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if p.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (p.Spec == nil && other.Spec != nil) || (p.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(p.Spec.Data, other.Spec.Data)
+}
+
+// GetStatusAsJson returns nil as EnrollmentApprovalPolicy has no status.
+func (p *EnrollmentApprovalPolicy) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
@@ -169,11 +169,18 @@ type ResourceInterface interface {
 }
 
 var _ ResourceInterface = (*Device)(nil)
+var _ ResourceInterface = (*DeviceBulkOperation)(nil)
 var _ ResourceInterface = (*Fleet)(nil)
 var _ ResourceInterface = (*Catalog)(nil)
 var _ ResourceInterface = (*CertificateSigningRequest)(nil)
+var _ ResourceInterface = (*EnrollmentApprovalPolicy)(nil)
 var _ ResourceInterface = (*EnrollmentRequest)(nil)
 var _ ResourceInterface = (*Repository)(nil)
 var _ ResourceInterface = (*ResourceSync)(nil)
+var _ ResourceInterface = (*Role)(nil)
+var _ ResourceInterface = (*RoleBinding)(nil)
+var _ ResourceInterface = (*ServiceAccount)(nil)
+var _ ResourceInterface = (*SecretStore)(nil)
 var _ ResourceInterface = (*TemplateVersion)(nil)
 var _ ResourceInterface = (*Event)(nil)
+var _ ResourceInterface = (*AuditEvent)(nil)
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type Role struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.RoleSpec] `gorm:"type:jsonb"`
+}
+
+func (r *Role) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
+
+func NewRoleFromApiResource(resource *domain.Role) (*Role, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &Role{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &Role{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func RoleAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.RoleAPIVersion)
+}
+
+func (r *Role) ToApiResource(opts ...APIResourceOption) (*domain.Role, error) {
+	if r == nil {
+		return &domain.Role{}, nil
+	}
+
+	var spec domain.RoleSpec
+	if r.Spec != nil {
+		spec = r.Spec.Data
+	}
+
+	return &domain.Role{
+		ApiVersion: RoleAPIVersion(),
+		Kind:       domain.RoleKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(r.Name),
+			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
+			Owner:             r.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func RolesToApiResource(roles []Role, cont *string, numRemaining *int64) (domain.RoleList, error) {
+	roleList := make([]domain.Role, len(roles))
+	for i, role := range roles {
+		apiResource, err := role.ToApiResource()
+		if err != nil {
+			return domain.RoleList{
+				ApiVersion: RoleAPIVersion(),
+				Kind:       domain.RoleListKind,
+				Items:      []domain.Role{},
+			}, err
+		}
+		roleList[i] = *apiResource
+	}
+	ret := domain.RoleList{
+		ApiVersion: RoleAPIVersion(),
+		Kind:       domain.RoleListKind,
+		Items:      roleList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (r *Role) GetKind() string {
+	return domain.RoleKind
+}
+
+func (r *Role) HasNilSpec() bool {
+	return r.Spec == nil
+}
+
+func (r *Role) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Role) // Assert that the other resource is a *Role
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if r.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (r.Spec == nil && other.Spec != nil) || (r.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(r.Spec.Data, other.Spec.Data)
+}
+
+// GetStatusAsJson returns nil as Role has no status.
+func (r *Role) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type RoleBinding struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.RoleBindingSpec] `gorm:"type:jsonb"`
+}
+
+func (b RoleBinding) String() string {
+	val, _ := json.Marshal(b)
+	return string(val)
+}
+
+func NewRoleBindingFromApiResource(resource *domain.RoleBinding) (*RoleBinding, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &RoleBinding{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &RoleBinding{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func RoleBindingAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.RoleBindingAPIVersion)
+}
+
+func (b *RoleBinding) ToApiResource(opts ...APIResourceOption) (*domain.RoleBinding, error) {
+	if b == nil {
+		return &domain.RoleBinding{}, nil
+	}
+
+	var spec domain.RoleBindingSpec
+	if b.Spec != nil {
+		spec = b.Spec.Data
+	}
+
+	return &domain.RoleBinding{
+		ApiVersion: RoleBindingAPIVersion(),
+		Kind:       domain.RoleBindingKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(b.Name),
+			CreationTimestamp: lo.ToPtr(b.CreatedAt.UTC()),
+			Owner:             b.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(b.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(b.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(b.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(b.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func RoleBindingsToApiResource(bindings []RoleBinding, cont *string, numRemaining *int64) (domain.RoleBindingList, error) {
+	bindingList := make([]domain.RoleBinding, len(bindings))
+	for i, binding := range bindings {
+		apiResource, err := binding.ToApiResource()
+		if err != nil {
+			return domain.RoleBindingList{
+				ApiVersion: RoleBindingAPIVersion(),
+				Kind:       domain.RoleBindingListKind,
+				Items:      []domain.RoleBinding{},
+			}, err
+		}
+		bindingList[i] = *apiResource
+	}
+	ret := domain.RoleBindingList{
+		ApiVersion: RoleBindingAPIVersion(),
+		Kind:       domain.RoleBindingListKind,
+		Items:      bindingList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (b *RoleBinding) GetKind() string {
+	return domain.RoleBindingKind
+}
+
+func (b *RoleBinding) HasNilSpec() bool {
+	return b.Spec == nil
+}
+
+func (b *RoleBinding) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*RoleBinding) // Assert that the other resource is a *RoleBinding
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if b.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (b.Spec == nil && other.Spec != nil) || (b.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(b.Spec.Data, other.Spec.Data)
+}
+
+// GetStatusAsJson returns nil as RoleBinding has no status.
+func (b *RoleBinding) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
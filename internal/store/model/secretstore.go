@@ -0,0 +1,148 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type SecretStore struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.SecretStoreSpec] `gorm:"type:jsonb"`
+
+	// The last reported state, stored as opaque JSON object.
+	Status *JSONField[domain.SecretStoreStatus] `gorm:"type:jsonb"`
+}
+
+func (s SecretStore) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+func NewSecretStoreFromApiResource(resource *domain.SecretStore) (*SecretStore, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &SecretStore{}, nil
+	}
+
+	status := domain.SecretStoreStatus{Conditions: []domain.Condition{}}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &SecretStore{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func SecretStoreAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.SecretStoreAPIVersion)
+}
+
+func (s *SecretStore) ToApiResource(opts ...APIResourceOption) (*domain.SecretStore, error) {
+	if s == nil {
+		return &domain.SecretStore{}, nil
+	}
+
+	var spec domain.SecretStoreSpec
+	if s.Spec != nil {
+		spec = s.Spec.Data
+	}
+
+	status := domain.SecretStoreStatus{Conditions: []domain.Condition{}}
+	if s.Status != nil {
+		status = s.Status.Data
+	}
+
+	return &domain.SecretStore{
+		ApiVersion: SecretStoreAPIVersion(),
+		Kind:       domain.SecretStoreKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(s.Name),
+			CreationTimestamp: lo.ToPtr(s.CreatedAt.UTC()),
+			Owner:             s.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(s.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(s.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func SecretStoresToApiResource(stores []SecretStore, cont *string, numRemaining *int64) (domain.SecretStoreList, error) {
+	secretStoreList := make([]domain.SecretStore, len(stores))
+	for i, secretStore := range stores {
+		apiResource, err := secretStore.ToApiResource()
+		if err != nil {
+			return domain.SecretStoreList{
+				ApiVersion: SecretStoreAPIVersion(),
+				Kind:       domain.SecretStoreListKind,
+				Items:      []domain.SecretStore{},
+			}, err
+		}
+		secretStoreList[i] = *apiResource
+	}
+	ret := domain.SecretStoreList{
+		ApiVersion: SecretStoreAPIVersion(),
+		Kind:       domain.SecretStoreListKind,
+		Items:      secretStoreList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (s *SecretStore) GetKind() string {
+	return domain.SecretStoreKind
+}
+
+func (s *SecretStore) HasNilSpec() bool {
+	return s.Spec == nil
+}
+
+func (s *SecretStore) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*SecretStore) // Assert that the other resource is a *SecretStore
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if s.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (s.Spec == nil && other.Spec != nil) || (s.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(s.Spec.Data, other.Spec.Data)
+}
+
+func (s *SecretStore) GetStatusAsJson() ([]byte, error) {
+	return s.Status.MarshalJSON()
+}
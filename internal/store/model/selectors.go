@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -13,6 +14,9 @@ import (
 // that map field paths to their corresponding types.
 type selectorToTypeMap map[selector.SelectorName]selector.SelectorType
 
+// selectorKeyPattern matches the keys of JSON objects that can be selected.
+var selectorKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
 var (
 	deviceStatusSelectors = selectorToTypeMap{
 		selector.NewSelectorName("status.summary.status"):             selector.String,
@@ -20,6 +24,11 @@ var (
 		selector.NewSelectorName("status.updated.status"):             selector.String,
 		selector.NewSelectorName("status.lifecycle.status"):           selector.String,
 	}
+	// deviceStatusSelectorPrefixes are the fields of the device status whose nested keys, reported by
+	// the device, can be selected as strings.
+	deviceStatusSelectorPrefixes = []selector.SelectorName{
+		selector.NewSelectorName("status.systemInfo"),
+	}
 	fleetSpecSelectors = selectorToTypeMap{
 		selector.NewSelectorName("spec.template.spec.os.image"): selector.String,
 	}
@@ -60,9 +69,25 @@ func (m *Device) ResolveSelector(name selector.SelectorName) (*selector.Selector
 	if typ, exists := deviceStatusSelectors[name]; exists {
 		return makeJSONBSelectorField(name, typ)
 	}
+	for _, prefix := range deviceStatusSelectorPrefixes {
+		nested, found := strings.CutPrefix(name.String(), prefix.String()+".")
+		if !found {
+			continue
+		}
+		for _, key := range strings.Split(nested, ".") {
+			if !selectorKeyPattern.MatchString(key) {
+				return nil, fmt.Errorf("invalid key %q in selector %q", key, name.String())
+			}
+		}
+		return makeJSONBSelectorField(name, selector.String)
+	}
 	return nil, fmt.Errorf("unable to resolve selector for device")
 }
 
+func (m *Device) ListSelectorPrefixes() selector.SelectorNameSet {
+	return selector.NewSelectorFieldNameSet().Add(deviceStatusSelectorPrefixes...)
+}
+
 func (m *Device) ListSelectors() selector.SelectorNameSet {
 	keys := make([]selector.SelectorName, 0, len(deviceStatusSelectors))
 	for sn := range deviceStatusSelectors {
@@ -162,3 +162,50 @@ func getArrayTypeConstant(t reflect.Type) selector.SelectorType {
 		return selector.Unknown
 	}
 }
+
+func TestDeviceStatusSelectorPrefixes(t *testing.T) {
+	resolver, err := selector.SelectorFieldResolver(&Device{})
+	if err != nil {
+		t.Fatalf("creating resolver: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		selector  string
+		wantField string
+		wantErr   bool
+	}{
+		{
+			name:      "extension field",
+			selector:  "status.systemInfo.extensions.usb.count",
+			wantField: "status -> 'systemInfo' -> 'extensions' -> 'usb' ->> 'count'",
+		},
+		{
+			name:      "system info key",
+			selector:  "status.systemInfo.productSerial",
+			wantField: "status -> 'systemInfo' ->> 'productSerial'",
+		},
+		{
+			name:     "invalid key",
+			selector: "status.systemInfo.extensions.usb'count",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := resolver.ResolveFields(selector.NewSelectorName(tt.selector))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error resolving %q", tt.selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolving %q: %v", tt.selector, err)
+			}
+			if len(fields) != 1 || fields[0].FieldName != tt.wantField || fields[0].Type != selector.String {
+				t.Fatalf("unexpected fields resolving %q: %+v", tt.selector, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type ServiceAccount struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.ServiceAccountSpec] `gorm:"type:jsonb"`
+}
+
+func (r *ServiceAccount) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
+
+func NewServiceAccountFromApiResource(resource *domain.ServiceAccount) (*ServiceAccount, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &ServiceAccount{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &ServiceAccount{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func ServiceAccountAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.ServiceAccountAPIVersion)
+}
+
+func (r *ServiceAccount) ToApiResource(opts ...APIResourceOption) (*domain.ServiceAccount, error) {
+	if r == nil {
+		return &domain.ServiceAccount{}, nil
+	}
+
+	var spec domain.ServiceAccountSpec
+	if r.Spec != nil {
+		spec = r.Spec.Data
+	}
+
+	return &domain.ServiceAccount{
+		ApiVersion: ServiceAccountAPIVersion(),
+		Kind:       domain.ServiceAccountKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(r.Name),
+			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
+			Owner:             r.Owner,
+			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func ServiceAccountsToApiResource(serviceAccounts []ServiceAccount, cont *string, numRemaining *int64) (domain.ServiceAccountList, error) {
+	serviceAccountList := make([]domain.ServiceAccount, len(serviceAccounts))
+	for i, serviceAccount := range serviceAccounts {
+		apiResource, err := serviceAccount.ToApiResource()
+		if err != nil {
+			return domain.ServiceAccountList{
+				ApiVersion: ServiceAccountAPIVersion(),
+				Kind:       domain.ServiceAccountListKind,
+				Items:      []domain.ServiceAccount{},
+			}, err
+		}
+		serviceAccountList[i] = *apiResource
+	}
+	ret := domain.ServiceAccountList{
+		ApiVersion: ServiceAccountAPIVersion(),
+		Kind:       domain.ServiceAccountListKind,
+		Items:      serviceAccountList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (r *ServiceAccount) GetKind() string {
+	return domain.ServiceAccountKind
+}
+
+func (r *ServiceAccount) HasNilSpec() bool {
+	return r.Spec == nil
+}
+
+func (r *ServiceAccount) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*ServiceAccount) // Assert that the other resource is a *ServiceAccount
+	if !ok {
+		return false // Not the same type, so specs cannot be the same
+	}
+	if other == nil {
+		return false
+	}
+	if r.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (r.Spec == nil && other.Spec != nil) || (r.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(r.Spec.Data, other.Spec.Data)
+}
+
+// GetStatusAsJson returns nil as ServiceAccount has no status.
+func (r *ServiceAccount) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ServiceAccountToken records an API token of a service account. Only the hash of the secret token is
+// stored, which is how the token is looked up when it authenticates a request.
+type ServiceAccountToken struct {
+	OrgID          uuid.UUID            `gorm:"type:uuid;primaryKey"`
+	Organization   Organization         `gorm:"foreignKey:OrgID"`
+	ServiceAccount string               `gorm:"type:string;primaryKey"`
+	Name           string               `gorm:"type:string;primaryKey"`
+	TokenHash      string               `gorm:"type:string;not null;uniqueIndex"`
+	Roles          *JSONField[[]string] `gorm:"type:jsonb"`
+	CreatedAt      time.Time            `gorm:"not null"`
+	ExpiresAt      time.Time            `gorm:"not null"`
+	LastUsedAt     *time.Time
+}
+
+func (t ServiceAccountToken) String() string {
+	val, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Sprintf("ServiceAccountToken<marshal-error:%v>", err)
+	}
+	return string(val)
+}
+
+func ServiceAccountTokenAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.ServiceAccountTokenAPIVersion)
+}
+
+func NewServiceAccountTokenFromApiResource(orgId uuid.UUID, serviceAccount string, tokenHash string, resource *domain.ServiceAccountToken) *ServiceAccountToken {
+	token := &ServiceAccountToken{
+		OrgID:          orgId,
+		ServiceAccount: serviceAccount,
+		Name:           lo.FromPtr(resource.Metadata.Name),
+		TokenHash:      tokenHash,
+		ExpiresAt:      lo.FromPtr(resource.Spec.ExpirationTimestamp).UTC(),
+	}
+	if resource.Spec.Roles != nil {
+		token.Roles = MakeJSONField(*resource.Spec.Roles)
+	}
+	return token
+}
+
+// GetRoles returns the roles the token is restricted to, or nil if it is not restricted.
+func (t *ServiceAccountToken) GetRoles() []string {
+	if t.Roles == nil {
+		return nil
+	}
+	return t.Roles.Data
+}
+
+func (t *ServiceAccountToken) ToApiResource() *domain.ServiceAccountToken {
+	token := &domain.ServiceAccountToken{
+		ApiVersion: ServiceAccountTokenAPIVersion(),
+		Kind:       domain.ServiceAccountTokenKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(t.Name),
+			CreationTimestamp: lo.ToPtr(t.CreatedAt.UTC()),
+		},
+		Spec: domain.ServiceAccountTokenSpec{
+			ExpirationTimestamp: lo.ToPtr(t.ExpiresAt.UTC()),
+		},
+	}
+	if t.Roles != nil {
+		token.Spec.Roles = lo.ToPtr(t.Roles.Data)
+	}
+	if t.LastUsedAt != nil {
+		token.Status = &domain.ServiceAccountTokenStatus{LastUsedTimestamp: lo.ToPtr(t.LastUsedAt.UTC())}
+	}
+	return token
+}
+
+func ServiceAccountTokensToApiResource(tokens []ServiceAccountToken) domain.ServiceAccountTokenList {
+	items := make([]domain.ServiceAccountToken, len(tokens))
+	for i := range tokens {
+		items[i] = *tokens[i].ToApiResource()
+	}
+	return domain.ServiceAccountTokenList{
+		ApiVersion: ServiceAccountTokenAPIVersion(),
+		Kind:       domain.ServiceAccountTokenListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      items,
+	}
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RoleBinding interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error)
+	Update(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleBindingList, error)
+	ListBySubjects(ctx context.Context, orgId uuid.UUID, subjects []domain.RoleBindingSubject) ([]domain.RoleBinding, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type RoleBindingStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.RoleBinding, model.RoleBinding, domain.RoleBinding, domain.RoleBindingList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to RoleBinding interface
+var _ RoleBinding = (*RoleBindingStore)(nil)
+
+func NewRoleBinding(db *gorm.DB, log logrus.FieldLogger) RoleBinding {
+	genericStore := NewGenericStore[*model.RoleBinding, model.RoleBinding, domain.RoleBinding, domain.RoleBindingList](
+		db,
+		log,
+		model.NewRoleBindingFromApiResource,
+		(*model.RoleBinding).ToApiResource,
+		model.RoleBindingsToApiResource,
+	)
+	return &RoleBindingStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.RoleBindingKind, log)}
+}
+
+func (s *RoleBindingStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *RoleBindingStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.RoleBinding{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for RoleBinding labels
+	if !db.Migrator().HasIndex(&model.RoleBinding{}, "idx_role_bindings_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_role_bindings_labels ON role_bindings USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.RoleBinding{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for RoleBinding annotations
+	if !db.Migrator().HasIndex(&model.RoleBinding{}, "idx_role_bindings_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_role_bindings_annotations ON role_bindings USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.RoleBinding{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for RoleBinding subjects
+	if !db.Migrator().HasIndex(&model.RoleBinding{}, "idx_role_bindings_subjects") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_role_bindings_subjects ON role_bindings USING GIN ((spec->'subjects'))").Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *RoleBindingStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error) {
+	binding, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, binding, true, err)
+	return binding, err
+}
+
+func (s *RoleBindingStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error) {
+	newBinding, oldBinding, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldBinding, newBinding, false, err)
+	return newBinding, err
+}
+
+func (s *RoleBindingStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, bool, error) {
+	newBinding, oldBinding, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldBinding, newBinding, created, err)
+	return newBinding, created, err
+}
+
+func (s *RoleBindingStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *RoleBindingStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleBindingList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+// ListBySubjects returns the role bindings of an organization that grant their role to any of the subjects.
+func (s *RoleBindingStore) ListBySubjects(ctx context.Context, orgId uuid.UUID, subjects []domain.RoleBindingSubject) ([]domain.RoleBinding, error) {
+	if len(subjects) == 0 {
+		return []domain.RoleBinding{}, nil
+	}
+
+	conditions := make([]string, 0, len(subjects))
+	args := make([]interface{}, 0, len(subjects))
+	for _, subject := range subjects {
+		value, err := json.Marshal([]domain.RoleBindingSubject{subject})
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, "spec->'subjects' @> ?::jsonb")
+		args = append(args, string(value))
+	}
+
+	var bindings []model.RoleBinding
+	result := s.getDB(ctx).Where("org_id = ? AND spec IS NOT NULL", orgId).
+		Where(strings.Join(conditions, " OR "), args...).
+		Order("name").
+		Find(&bindings)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	list, err := model.RoleBindingsToApiResource(bindings, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (s *RoleBindingStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.RoleBinding{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
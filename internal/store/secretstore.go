@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type SecretStore interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, secretStore *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error)
+	Update(ctx context.Context, orgId uuid.UUID, secretStore *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, secretStore *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretStoreList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error)
+}
+
+type SecretStoreStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.SecretStore, model.SecretStore, domain.SecretStore, domain.SecretStoreList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to SecretStore interface
+var _ SecretStore = (*SecretStoreStore)(nil)
+
+func NewSecretStore(db *gorm.DB, log logrus.FieldLogger) SecretStore {
+	genericStore := NewGenericStore[*model.SecretStore, model.SecretStore, domain.SecretStore, domain.SecretStoreList](
+		db,
+		log,
+		model.NewSecretStoreFromApiResource,
+		(*model.SecretStore).ToApiResource,
+		model.SecretStoresToApiResource,
+	)
+	return &SecretStoreStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.SecretStoreKind, log)}
+}
+
+func (s *SecretStoreStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *SecretStoreStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.SecretStore{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for SecretStore labels
+	if !db.Migrator().HasIndex(&model.SecretStore{}, "idx_secret_stores_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_secret_stores_labels ON secret_stores USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.SecretStore{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for SecretStore annotations
+	if !db.Migrator().HasIndex(&model.SecretStore{}, "idx_secret_stores_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_secret_stores_annotations ON secret_stores USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.SecretStore{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SecretStoreStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error) {
+	secretStore, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, secretStore, true, err)
+	return secretStore, err
+}
+
+func (s *SecretStoreStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error) {
+	newSecretStore, oldSecretStore, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecretStore, newSecretStore, false, err)
+	return newSecretStore, err
+}
+
+func (s *SecretStoreStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, bool, error) {
+	newSecretStore, oldSecretStore, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecretStore, newSecretStore, created, err)
+	return newSecretStore, created, err
+}
+
+func (s *SecretStoreStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.SecretStore, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *SecretStoreStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.SecretStoreList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *SecretStoreStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.SecretStore{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *SecretStoreStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.SecretStore, eventCallback EventCallback) (*domain.SecretStore, error) {
+	// Get the old resource to compare conditions
+	var oldSecretStore *domain.SecretStore
+	existingResource, err := s.Get(ctx, orgId, lo.FromPtr(resource.Metadata.Name))
+	if err == nil && existingResource != nil {
+		oldSecretStore = existingResource
+	}
+
+	newSecretStore, err := s.genericStore.UpdateStatus(ctx, orgId, resource)
+	if err != nil {
+		return newSecretStore, err
+	}
+
+	// Call the event callback to emit condition-specific events
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecretStore, newSecretStore, false, err)
+
+	return newSecretStore, err
+}
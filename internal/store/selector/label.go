@@ -11,6 +11,7 @@ import (
 	"github.com/flightctl/flightctl/pkg/k8s/selector/selection"
 	"github.com/flightctl/flightctl/pkg/queryparser"
 	"github.com/flightctl/flightctl/pkg/queryparser/sqljsonb"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
 )
 
 type LabelSelector struct {
@@ -96,6 +97,11 @@ func NewLabelSelector(input string) (*LabelSelector, error) {
 	}, nil
 }
 
+// Matches reports whether the LabelSelector matches the given set of labels.
+func (ls *LabelSelector) Matches(set map[string]string) bool {
+	return ls.selector.Matches(k8sLabels.Set(set))
+}
+
 // Parse converts the LabelSelector into a SQL query with parameters.
 // The method uses a provided resolver to determine the correct labels field.
 //
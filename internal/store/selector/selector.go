@@ -78,7 +78,7 @@ func (sr *selectorFieldResolver) ResolveFields(name SelectorName) ([]*SelectorFi
 		var err error
 
 		// Attempt to resolve using selectorResolver if available, otherwise fallback to resolve function
-		if sr.selectorResolver != nil && sr.selectorResolver.ListSelectors().Contains(selectorName) {
+		if sr.selectorResolver != nil && (sr.selectorResolver.ListSelectors().Contains(selectorName) || sr.isNestedCustomSelector(selectorName)) {
 			resolvedField, err = sr.selectorResolver.ResolveSelector(selectorName)
 		} else {
 			resolvedField, err = sr.resolveSelector(selectorName)
@@ -114,6 +114,20 @@ func (sr *selectorFieldResolver) ResolveFields(name SelectorName) ([]*SelectorFi
 	return fields, nil
 }
 
+// isNestedCustomSelector reports whether the selector is nested under a prefix resolved by the selectorResolver.
+func (sr *selectorFieldResolver) isNestedCustomSelector(name SelectorName) bool {
+	prefixResolver, ok := sr.selectorResolver.(SelectorPrefixResolver)
+	if !ok {
+		return false
+	}
+	for _, prefix := range prefixResolver.ListSelectorPrefixes().List() {
+		if strings.HasPrefix(name.String(), prefix.String()+".") {
+			return true
+		}
+	}
+	return false
+}
+
 func (sr *selectorFieldResolver) resolveSelector(name SelectorName) (*SelectorField, error) {
 	if resolvedField, exists := sr.schemaFields[name]; exists {
 		selectorType, ok := schemaTypeResolution[resolvedField.DataType]
@@ -95,6 +95,13 @@ type SelectorResolver interface {
 	ListSelectors() SelectorNameSet
 }
 
+// SelectorPrefixResolver defines an interface for SelectorResolvers also resolving the selectors
+// nested under a path, such as the keys of objects reported by devices which are not known in advance.
+type SelectorPrefixResolver interface {
+	// ListSelectorPrefixes returns the selectors whose nested selectors are resolved by ResolveSelector.
+	ListSelectorPrefixes() SelectorNameSet
+}
+
 // SelectorName represents the name of a selector.
 type SelectorName interface {
 	String() string // Returns the string representation of the selector name.
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ServiceAccount interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, serviceAccount *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, error)
+	Update(ctx context.Context, orgId uuid.UUID, serviceAccount *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, serviceAccount *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.ServiceAccountList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type ServiceAccountStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.ServiceAccount, model.ServiceAccount, domain.ServiceAccount, domain.ServiceAccountList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to ServiceAccount interface
+var _ ServiceAccount = (*ServiceAccountStore)(nil)
+
+func NewServiceAccount(db *gorm.DB, log logrus.FieldLogger) ServiceAccount {
+	genericStore := NewGenericStore[*model.ServiceAccount, model.ServiceAccount, domain.ServiceAccount, domain.ServiceAccountList](
+		db,
+		log,
+		model.NewServiceAccountFromApiResource,
+		(*model.ServiceAccount).ToApiResource,
+		model.ServiceAccountsToApiResource,
+	)
+	return &ServiceAccountStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.ServiceAccountKind, log)}
+}
+
+func (s *ServiceAccountStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *ServiceAccountStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.ServiceAccount{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for ServiceAccount labels
+	if !db.Migrator().HasIndex(&model.ServiceAccount{}, "idx_service_accounts_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_service_accounts_labels ON service_accounts USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.ServiceAccount{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for ServiceAccount annotations
+	if !db.Migrator().HasIndex(&model.ServiceAccount{}, "idx_service_accounts_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_service_accounts_annotations ON service_accounts USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.ServiceAccount{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ServiceAccountStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, error) {
+	serviceAccount, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, serviceAccount, true, err)
+	return serviceAccount, err
+}
+
+func (s *ServiceAccountStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, error) {
+	newServiceAccount, oldServiceAccount, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldServiceAccount, newServiceAccount, false, err)
+	return newServiceAccount, err
+}
+
+func (s *ServiceAccountStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.ServiceAccount, eventCallback EventCallback) (*domain.ServiceAccount, bool, error) {
+	newServiceAccount, oldServiceAccount, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldServiceAccount, newServiceAccount, created, err)
+	return newServiceAccount, created, err
+}
+
+func (s *ServiceAccountStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.ServiceAccount, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *ServiceAccountStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.ServiceAccountList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *ServiceAccountStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.ServiceAccount{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
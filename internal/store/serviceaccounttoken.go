@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ServiceAccountToken interface {
+	InitialMigration(ctx context.Context) error
+	Create(ctx context.Context, orgId uuid.UUID, serviceAccount string, tokenHash string, token *domain.ServiceAccountToken) (*domain.ServiceAccountToken, error)
+	List(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, error)
+	GetByHash(ctx context.Context, tokenHash string) (*model.ServiceAccountToken, error)
+	UpdateLastUsed(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string, lastUsed time.Time) error
+	Delete(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) error
+	DeleteAll(ctx context.Context, orgId uuid.UUID, serviceAccount string) error
+}
+
+type ServiceAccountTokenStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to ServiceAccountToken interface
+var _ ServiceAccountToken = (*ServiceAccountTokenStore)(nil)
+
+func NewServiceAccountToken(db *gorm.DB, log logrus.FieldLogger) ServiceAccountToken {
+	return &ServiceAccountTokenStore{dbHandler: db, log: log}
+}
+
+func (s *ServiceAccountTokenStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *ServiceAccountTokenStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+	return db.AutoMigrate(&model.ServiceAccountToken{})
+}
+
+func (s *ServiceAccountTokenStore) Create(ctx context.Context, orgId uuid.UUID, serviceAccount string, tokenHash string, token *domain.ServiceAccountToken) (*domain.ServiceAccountToken, error) {
+	record := model.NewServiceAccountTokenFromApiResource(orgId, serviceAccount, tokenHash, token)
+	if err := s.getDB(ctx).Create(record).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return record.ToApiResource(), nil
+}
+
+func (s *ServiceAccountTokenStore) List(ctx context.Context, orgId uuid.UUID, serviceAccount string) (*domain.ServiceAccountTokenList, error) {
+	var records []model.ServiceAccountToken
+	err := s.getDB(ctx).Where("org_id = ? AND service_account = ?", orgId, serviceAccount).Order("created_at DESC").Find(&records).Error
+	if err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	list := model.ServiceAccountTokensToApiResource(records)
+	return &list, nil
+}
+
+// GetByHash returns the token with the given hash, in any organization.
+func (s *ServiceAccountTokenStore) GetByHash(ctx context.Context, tokenHash string) (*model.ServiceAccountToken, error) {
+	var record model.ServiceAccountToken
+	if err := s.getDB(ctx).Where("token_hash = ?", tokenHash).Take(&record).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return &record, nil
+}
+
+func (s *ServiceAccountTokenStore) UpdateLastUsed(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string, lastUsed time.Time) error {
+	result := s.getDB(ctx).Model(&model.ServiceAccountToken{}).
+		Where("org_id = ? AND service_account = ? AND name = ?", orgId, serviceAccount, name).
+		Update("last_used_at", lastUsed.UTC())
+	return ErrorFromGormError(result.Error)
+}
+
+func (s *ServiceAccountTokenStore) Delete(ctx context.Context, orgId uuid.UUID, serviceAccount string, name string) error {
+	result := s.getDB(ctx).Where("org_id = ? AND service_account = ? AND name = ?", orgId, serviceAccount, name).Delete(&model.ServiceAccountToken{})
+	if result.Error != nil {
+		return ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return flterrors.ErrResourceNotFound
+	}
+	return nil
+}
+
+// DeleteAll deletes all the tokens of a service account.
+func (s *ServiceAccountTokenStore) DeleteAll(ctx context.Context, orgId uuid.UUID, serviceAccount string) error {
+	result := s.getDB(ctx).Where("org_id = ? AND service_account = ?", orgId, serviceAccount).Delete(&model.ServiceAccountToken{})
+	return ErrorFromGormError(result.Error)
+}
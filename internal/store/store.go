@@ -23,15 +23,24 @@ type Store interface {
 	Device() Device
 	EnrollmentRequest() EnrollmentRequest
 	CertificateSigningRequest() CertificateSigningRequest
+	CertificateRevocation() CertificateRevocation
 	Fleet() Fleet
 	TemplateVersion() TemplateVersion
 	Repository() Repository
 	ResourceSync() ResourceSync
 	Catalog() Catalog
 	Event() Event
+	AuditEvent() AuditEvent
 	Checkpoint() Checkpoint
 	Organization() Organization
 	AuthProvider() AuthProvider
+	SecretStore() SecretStore
+	EnrollmentApprovalPolicy() EnrollmentApprovalPolicy
+	Role() Role
+	RoleBinding() RoleBinding
+	ServiceAccount() ServiceAccount
+	ServiceAccountToken() ServiceAccountToken
+	DeviceBulkOperation() DeviceBulkOperation
 	RunMigrations(context.Context) error
 	CheckHealth(context.Context) error
 	Close() error
@@ -41,15 +50,24 @@ type DataStore struct {
 	device                    Device
 	enrollmentRequest         EnrollmentRequest
 	certificateSigningRequest CertificateSigningRequest
+	certificateRevocation     CertificateRevocation
 	fleet                     Fleet
 	templateVersion           TemplateVersion
 	repository                Repository
 	resourceSync              ResourceSync
 	catalog                   Catalog
 	event                     Event
+	auditEvent                AuditEvent
 	checkpoint                Checkpoint
 	organization              Organization
 	authProvider              AuthProvider
+	secretStore               SecretStore
+	enrollmentApprovalPolicy  EnrollmentApprovalPolicy
+	role                      Role
+	roleBinding               RoleBinding
+	serviceAccount            ServiceAccount
+	serviceAccountToken       ServiceAccountToken
+	deviceBulkOperation       DeviceBulkOperation
 
 	db *gorm.DB
 }
@@ -59,15 +77,24 @@ func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
 		device:                    NewDevice(db, log),
 		enrollmentRequest:         NewEnrollmentRequest(db, log),
 		certificateSigningRequest: NewCertificateSigningRequest(db, log),
+		certificateRevocation:     NewCertificateRevocation(db, log),
 		fleet:                     NewFleet(db, log),
 		templateVersion:           NewTemplateVersion(db, log),
 		repository:                NewRepository(db, log),
 		resourceSync:              NewResourceSync(db, log),
 		catalog:                   NewCatalog(db, log),
 		event:                     NewEvent(db, log),
+		auditEvent:                NewAuditEvent(db, log),
 		checkpoint:                NewCheckpoint(db, log),
 		organization:              NewOrganization(db),
 		authProvider:              NewAuthProvider(db, log),
+		secretStore:               NewSecretStore(db, log),
+		enrollmentApprovalPolicy:  NewEnrollmentApprovalPolicy(db, log),
+		role:                      NewRole(db, log),
+		roleBinding:               NewRoleBinding(db, log),
+		serviceAccount:            NewServiceAccount(db, log),
+		serviceAccountToken:       NewServiceAccountToken(db, log),
+		deviceBulkOperation:       NewDeviceBulkOperation(db, log),
 		db:                        db,
 	}
 }
@@ -88,6 +115,10 @@ func (s *DataStore) CertificateSigningRequest() CertificateSigningRequest {
 	return s.certificateSigningRequest
 }
 
+func (s *DataStore) CertificateRevocation() CertificateRevocation {
+	return s.certificateRevocation
+}
+
 func (s *DataStore) Fleet() Fleet {
 	return s.fleet
 }
@@ -108,6 +139,10 @@ func (s *DataStore) Event() Event {
 	return s.event
 }
 
+func (s *DataStore) AuditEvent() AuditEvent {
+	return s.auditEvent
+}
+
 func (s *DataStore) Checkpoint() Checkpoint {
 	return s.checkpoint
 }
@@ -120,6 +155,34 @@ func (s *DataStore) AuthProvider() AuthProvider {
 	return s.authProvider
 }
 
+func (s *DataStore) SecretStore() SecretStore {
+	return s.secretStore
+}
+
+func (s *DataStore) EnrollmentApprovalPolicy() EnrollmentApprovalPolicy {
+	return s.enrollmentApprovalPolicy
+}
+
+func (s *DataStore) Role() Role {
+	return s.role
+}
+
+func (s *DataStore) RoleBinding() RoleBinding {
+	return s.roleBinding
+}
+
+func (s *DataStore) ServiceAccount() ServiceAccount {
+	return s.serviceAccount
+}
+
+func (s *DataStore) ServiceAccountToken() ServiceAccountToken {
+	return s.serviceAccountToken
+}
+
+func (s *DataStore) DeviceBulkOperation() DeviceBulkOperation {
+	return s.deviceBulkOperation
+}
+
 // CheckHealth verifies database connectivity and ensures the instance is not in recovery.
 func (s *DataStore) CheckHealth(ctx context.Context) error {
 	if s.db == nil {
@@ -192,6 +255,9 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.Event().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.AuditEvent().InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := s.Checkpoint().InitialMigration(ctx); err != nil {
 		return err
 	}
@@ -201,6 +267,30 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.AuthProvider().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.SecretStore().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.EnrollmentApprovalPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.Role().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.RoleBinding().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.ServiceAccount().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.ServiceAccountToken().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.DeviceBulkOperation().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.CertificateRevocation().InitialMigration(ctx); err != nil {
+		return err
+	}
 	return s.customizeMigration(ctx)
 }
 
@@ -247,6 +337,8 @@ type ListParams struct {
 	AnnotationSelector *selector.AnnotationSelector
 	SortOrder          *SortOrder
 	SortColumns        []SortColumn
+	// ScopeSelectors restrict the results to the resources whose labels match any of the selectors.
+	ScopeSelectors []*selector.LabelSelector
 }
 
 type Continue struct {
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AuditEventCleanupPollingInterval is the interval at which the audit event cleanup task runs.
+	AuditEventCleanupPollingInterval = 1 * time.Hour
+	AuditEventCleanupTaskName        = "audit-event-cleanup"
+)
+
+type AuditEventCleanup struct {
+	log             logrus.FieldLogger
+	serviceHandler  service.Service
+	retentionPeriod util.Duration
+}
+
+func NewAuditEventCleanup(log logrus.FieldLogger, serviceHandler service.Service, retentionPeriod util.Duration) *AuditEventCleanup {
+	return &AuditEventCleanup{
+		log:             log,
+		serviceHandler:  serviceHandler,
+		retentionPeriod: retentionPeriod,
+	}
+}
+
+// Poll deletes audit events older than the configured retention period
+func (t *AuditEventCleanup) Poll(ctx context.Context) {
+	t.log.Infof("Running AuditEventCleanup Polling (retention period: %s)", t.retentionPeriod.String())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cutoffTime := time.Now().Add(-time.Duration(t.retentionPeriod))
+	numDeleted, status := t.serviceHandler.DeleteAuditEventsOlderThan(ctx, cutoffTime)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("failed to clean up audit events: %s", status.Message)
+		return
+	}
+	t.log.Infof("cleaned up %d audit events", numDeleted)
+}
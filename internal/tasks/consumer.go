@@ -104,6 +104,20 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldHandleRepositoryPush(ctx, eventWithOrgId.Event, log) {
+			taskName = "repositoryPush"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return repositoryPush(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, cfg, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
+		if shouldRunDeviceBulkOperation(ctx, eventWithOrgId.Event, log) {
+			taskName = "deviceBulkOperation"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return deviceBulkOperation(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 
 		// Emit InternalTaskFailedEvent for any unhandled task failures
 		// This serves as a safety net while preserving specific error handling within tasks
@@ -212,9 +226,9 @@ func shouldReconcileDeviceOwnership(ctx context.Context, event domain.Event, log
 		return true
 	}
 
-	// If a device's labels were updated, return true
+	// If a device's labels or the status fields that fleets may select it by were updated, return true
 	if event.Reason == domain.EventReasonResourceUpdated && event.InvolvedObject.Kind == domain.DeviceKind {
-		return hasUpdatedFields(event.Details, log, domain.Labels)
+		return hasUpdatedFields(event.Details, log, domain.Labels, domain.SystemInfo)
 	}
 
 	return false
@@ -244,10 +258,10 @@ func shouldRenderDevice(ctx context.Context, event domain.Event, log logrus.Fiel
 		return false
 	}
 
-	if lo.Contains([]domain.EventReason{domain.EventReasonReferencedRepositoryUpdated,
+	if lo.Contains([]domain.EventReason{domain.EventReasonReferencedRepositoryUpdated, domain.EventReasonReferencedSecretUpdated,
 		domain.EventReasonResourceCreated,
 		domain.EventReasonFleetRolloutDeviceSelected, domain.EventReasonDeviceConflictResolved,
-		domain.EventReasonDeviceDecommissioned}, event.Reason) {
+		domain.EventReasonDeviceDecommissioned, domain.EventReasonDeviceRenderRequested}, event.Reason) {
 		return true
 	}
 
@@ -284,6 +298,20 @@ func shouldUpdateRepositoryReferers(ctx context.Context, event domain.Event, log
 	return false
 }
 
+func shouldHandleRepositoryPush(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	// If a git webhook reported a push to a repository, return true
+	return event.Reason == domain.EventReasonRepositoryPushReceived && event.InvolvedObject.Kind == domain.RepositoryKind
+}
+
+func shouldRunDeviceBulkOperation(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	if event.InvolvedObject.Kind != domain.DeviceBulkOperationKind {
+		return false
+	}
+
+	// If a device bulk operation was created or processed a batch of devices, return true
+	return event.Reason == domain.EventReasonResourceCreated || event.Reason == domain.EventReasonDeviceBulkOperationProgressed
+}
+
 func hasUpdatedFields(details *domain.EventDetails, log logrus.FieldLogger, fields ...domain.ResourceUpdatedDetailsUpdatedFields) bool {
 	if details == nil {
 		return false
@@ -258,6 +258,11 @@ func TestShouldRenderDevice(t *testing.T) {
 			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, "device1"),
 			expected: false,
 		},
+		{
+			name:     "DeviceRenderRequested",
+			event:    createTestEvent(domain.DeviceKind, domain.EventReasonDeviceRenderRequested, "device1"),
+			expected: true,
+		},
 		{
 			name:     "FleetRolloutDeviceSelected",
 			event:    createTestEvent(domain.DeviceKind, domain.EventReasonFleetRolloutDeviceSelected, "device1"),
@@ -366,6 +371,43 @@ func TestShouldUpdateRepositoryReferers(t *testing.T) {
 	}
 }
 
+func TestShouldRunDeviceBulkOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected bool
+	}{
+		{
+			name:     "DeviceBulkOperationCreated",
+			event:    createTestEvent(domain.DeviceBulkOperationKind, domain.EventReasonResourceCreated, "bulk1"),
+			expected: true,
+		},
+		{
+			name:     "DeviceBulkOperationProgressed",
+			event:    createTestEvent(domain.DeviceBulkOperationKind, domain.EventReasonDeviceBulkOperationProgressed, "bulk1"),
+			expected: true,
+		},
+		{
+			name:     "DeviceBulkOperationDeleted",
+			event:    createTestEvent(domain.DeviceBulkOperationKind, domain.EventReasonResourceDeleted, "bulk1"),
+			expected: false,
+		},
+		{
+			name:     "DeviceCreated",
+			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceCreated, "device1"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldRunDeviceBulkOperation(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestHasUpdatedFields(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,246 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DeviceBulkOperationBatchSize is the number of devices a bulk operation is applied to per task run
+	DeviceBulkOperationBatchSize = 100
+	// maxDeviceBulkOperationFailures is the number of per-device failures recorded in the status of a bulk operation
+	maxDeviceBulkOperationFailures = 50
+)
+
+// The deviceBulkOperation task applies a DeviceBulkOperation to the devices matching its selectors,
+// one batch at a time. The first batch is triggered by the creation of the operation. After each
+// batch, the progress of the operation is recorded in its status and a DeviceBulkOperationProgressed
+// event carrying the position of the next batch is emitted, which triggers the next batch. After the
+// last batch, the operation is marked Completed or Failed.
+//
+// This task is idempotent: the position of a batch and the number of devices processed before it
+// are carried by the triggering event rather than stored, so a retried batch is applied to the same
+// devices and records the same progress, and the per-device actions are safely repeatable.
+
+func deviceBulkOperation(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, log logrus.FieldLogger) error {
+	logic := NewDeviceBulkOperationLogic(log, serviceHandler, orgId, event)
+
+	if err := logic.ProcessBatch(ctx); err != nil {
+		log.Errorf("failed to process device bulk operation %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+type DeviceBulkOperationLogic struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	orgId          uuid.UUID
+	event          domain.Event
+	batchSize      int
+}
+
+func NewDeviceBulkOperationLogic(log logrus.FieldLogger, serviceHandler service.Service, orgId uuid.UUID, event domain.Event) DeviceBulkOperationLogic {
+	return DeviceBulkOperationLogic{log: log, serviceHandler: serviceHandler, orgId: orgId, event: event, batchSize: DeviceBulkOperationBatchSize}
+}
+
+func (t *DeviceBulkOperationLogic) SetBatchSize(batchSize int) {
+	t.batchSize = batchSize
+}
+
+func (t *DeviceBulkOperationLogic) ProcessBatch(ctx context.Context) error {
+	name := t.event.InvolvedObject.Name
+	operation, status := t.serviceHandler.GetDeviceBulkOperation(ctx, t.orgId, name)
+	if status.Code == http.StatusNotFound {
+		t.log.Infof("device bulk operation %s was deleted, stopping", name)
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching device bulk operation: %s", status.Message)
+	}
+
+	opStatus := lo.FromPtr(operation.Status)
+	if opStatus.Phase == domain.DeviceBulkOperationPhaseCompleted || opStatus.Phase == domain.DeviceBulkOperationPhaseFailed {
+		t.log.Infof("device bulk operation %s already finished with phase %s", name, opStatus.Phase)
+		return nil
+	}
+
+	// the operation only applies to the devices the user who created it could access
+	ctx = authz.WithScope(ctx, &authz.Scope{LabelSelectors: lo.FromPtr(opStatus.ScopeLabelSelectors)})
+
+	var cont *string
+	var processed, failed int64
+	if t.event.Reason == domain.EventReasonDeviceBulkOperationProgressed {
+		if t.event.Details == nil {
+			return fmt.Errorf("event has no details")
+		}
+		details, err := t.event.Details.AsDeviceBulkOperationProgressedDetails()
+		if err != nil {
+			return fmt.Errorf("parsing event details: %w", err)
+		}
+		cont = details.Continue
+		processed = details.ProcessedDevices
+		failed = details.FailedDevices
+	}
+
+	devices, status := t.serviceHandler.ListDevices(ctx, t.orgId, domain.ListDevicesParams{
+		LabelSelector: operation.Spec.LabelSelector,
+		FieldSelector: operation.Spec.FieldSelector,
+		Limit:         lo.ToPtr(int32(t.batchSize)),
+		Continue:      cont,
+	}, nil)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching devices: %s", status.Message)
+	}
+
+	failures := lo.FromPtr(opStatus.Failures)
+	for i := range devices.Items {
+		device := &devices.Items[i]
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		processed++
+		if err := t.applyToDevice(ctx, operation, device); err != nil {
+			t.log.Warnf("device bulk operation %s failed for device %s: %v", name, deviceName, err)
+			failed++
+			failures = appendDeviceBulkOperationFailure(failures, deviceName, err.Error())
+		}
+	}
+
+	if opStatus.Phase == domain.DeviceBulkOperationPhasePending {
+		opStatus.Phase = domain.DeviceBulkOperationPhaseRunning
+		opStatus.StartTime = lo.ToPtr(time.Now())
+	}
+	opStatus.ProcessedDevices = processed
+	opStatus.FailedDevices = failed
+	if len(failures) > 0 {
+		opStatus.Failures = &failures
+	}
+
+	next := devices.Metadata.Continue
+	finished := next == nil
+	if finished {
+		opStatus.CompletionTime = lo.ToPtr(time.Now())
+		if failed == 0 {
+			opStatus.Phase = domain.DeviceBulkOperationPhaseCompleted
+			opStatus.Message = lo.ToPtr(fmt.Sprintf("Applied to %d devices.", processed))
+		} else {
+			opStatus.Phase = domain.DeviceBulkOperationPhaseFailed
+			opStatus.Message = lo.ToPtr(fmt.Sprintf("Failed for %d of %d devices.", failed, processed))
+		}
+	}
+
+	operation.Status = &opStatus
+	if _, status := t.serviceHandler.ReplaceDeviceBulkOperationStatus(ctx, t.orgId, name, *operation); status.Code != http.StatusOK {
+		return fmt.Errorf("updating device bulk operation status: %s", status.Message)
+	}
+
+	switch {
+	case !finished:
+		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetDeviceBulkOperationProgressedEvent(ctx, name, *next, processed, failed))
+	case failed == 0:
+		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetDeviceBulkOperationCompletedEvent(ctx, name, processed))
+	default:
+		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetDeviceBulkOperationFailedEvent(ctx, name, processed, failed))
+	}
+
+	return nil
+}
+
+// applyToDevice applies the operation to a single device.
+func (t *DeviceBulkOperationLogic) applyToDevice(ctx context.Context, operation *domain.DeviceBulkOperation, device *domain.Device) error {
+	deviceName := lo.FromPtr(device.Metadata.Name)
+
+	switch operation.Spec.Operation {
+	case domain.DeviceBulkOperationTypeUpdateMetadata:
+		return t.updateMetadata(ctx, lo.FromPtr(operation.Spec.Metadata), device)
+	case domain.DeviceBulkOperationTypeDecommission:
+		if device.Spec != nil && device.Spec.Decommissioning != nil {
+			// decommissioning was already requested, e.g. by a retried batch
+			return nil
+		}
+		_, status := t.serviceHandler.DecommissionDevice(ctx, t.orgId, deviceName, lo.FromPtr(operation.Spec.Decommission))
+		if status.Code == http.StatusConflict {
+			return nil
+		}
+		return statusToError(status)
+	case domain.DeviceBulkOperationTypeResume:
+		_, status := t.serviceHandler.ResumeDevices(ctx, t.orgId, domain.DeviceResumeRequest{
+			FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.name=%s", deviceName)),
+		})
+		return statusToError(status)
+	case domain.DeviceBulkOperationTypeRerender:
+		return statusToError(t.serviceHandler.RequestDeviceRender(ctx, t.orgId, deviceName))
+	default:
+		return fmt.Errorf("unsupported operation %q", operation.Spec.Operation)
+	}
+}
+
+// updateMetadata patches the labels of the device and updates its annotations.
+func (t *DeviceBulkOperationLogic) updateMetadata(ctx context.Context, update domain.DeviceBulkMetadataUpdate, device *domain.Device) error {
+	deviceName := lo.FromPtr(device.Metadata.Name)
+
+	labels := lo.FromPtr(device.Metadata.Labels)
+	patch := domain.PatchRequest{}
+	if device.Metadata.Labels == nil && len(lo.FromPtr(update.SetLabels)) > 0 {
+		patch = append(patch, domain.PatchRequest{{Op: domain.PatchOpAdd, Path: "/metadata/labels", Value: map[string]string{}}}...)
+	}
+	for k, v := range lo.FromPtr(update.SetLabels) {
+		patch = append(patch, domain.PatchRequest{{Op: domain.PatchOpAdd, Path: "/metadata/labels/" + escapeJSONPointer(k), Value: v}}...)
+	}
+	for _, k := range lo.FromPtr(update.RemoveLabels) {
+		if _, ok := labels[k]; !ok {
+			continue
+		}
+		patch = append(patch, domain.PatchRequest{{Op: domain.PatchOpRemove, Path: "/metadata/labels/" + escapeJSONPointer(k)}}...)
+	}
+	if len(patch) > 0 {
+		if _, status := t.serviceHandler.PatchDevice(ctx, t.orgId, deviceName, patch); status.Code != http.StatusOK {
+			return statusToError(status)
+		}
+	}
+
+	setAnnotations := lo.FromPtr(update.SetAnnotations)
+	removeAnnotations := lo.FromPtr(update.RemoveAnnotations)
+	if len(setAnnotations) > 0 || len(removeAnnotations) > 0 {
+		return statusToError(t.serviceHandler.UpdateDeviceAnnotations(ctx, t.orgId, deviceName, setAnnotations, removeAnnotations))
+	}
+	return nil
+}
+
+// appendDeviceBulkOperationFailure records the failure of a device, replacing any earlier failure of
+// the same device, as long as the number of recorded failures is below the limit.
+func appendDeviceBulkOperationFailure(failures []domain.DeviceBulkOperationFailure, deviceName, message string) []domain.DeviceBulkOperationFailure {
+	for i := range failures {
+		if failures[i].Device == deviceName {
+			failures[i].Message = message
+			return failures
+		}
+	}
+	if len(failures) >= maxDeviceBulkOperationFailures {
+		return failures
+	}
+	return append(failures, domain.DeviceBulkOperationFailure{Device: deviceName, Message: message})
+}
+
+// escapeJSONPointer escapes a key for use as a JSON pointer reference token (RFC 6901).
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func statusToError(status domain.Status) error {
+	if status.Code >= http.StatusOK && status.Code < http.StatusMultipleChoices {
+		return nil
+	}
+	return fmt.Errorf("%s", status.Message)
+}
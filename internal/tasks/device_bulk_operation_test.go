@@ -0,0 +1,164 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newBulkOperation(name string, phase domain.DeviceBulkOperationPhase) *domain.DeviceBulkOperation {
+	return &domain.DeviceBulkOperation{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.DeviceBulkOperationSpec{
+			LabelSelector: lo.ToPtr("site=factory-1"),
+			Operation:     domain.DeviceBulkOperationTypeUpdateMetadata,
+			Metadata: &domain.DeviceBulkMetadataUpdate{
+				SetLabels:    &map[string]string{"team/owner": "edge"},
+				RemoveLabels: &[]string{"stale"},
+			},
+		},
+		Status: &domain.DeviceBulkOperationStatus{Phase: phase, MatchedDevices: 3},
+	}
+}
+
+func newBulkDevice(name string, labels map[string]string) domain.Device {
+	return domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Labels: &labels}}
+}
+
+func TestDeviceBulkOperationLogic_FirstBatch(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+	orgId := uuid.New()
+	ctx := context.Background()
+
+	event := servicecommon.GetResourceCreatedOrUpdatedSuccessEvent(ctx, true, domain.DeviceBulkOperationKind, "bulk", nil, logrus.New(), nil)
+	logic := NewDeviceBulkOperationLogic(logrus.New(), mockSvc, orgId, *event)
+	logic.SetBatchSize(2)
+
+	// The operation is restricted to the devices the user who created it could patch
+	operation := newBulkOperation("bulk", domain.DeviceBulkOperationPhasePending)
+	operation.Status.ScopeLabelSelectors = &[]string{"line=a"}
+	mockSvc.EXPECT().GetDeviceBulkOperation(gomock.Any(), orgId, "bulk").Return(operation, domain.StatusOK())
+	mockSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Nil()).DoAndReturn(
+		func(ctx context.Context, _ uuid.UUID, params domain.ListDevicesParams, _ any) (*domain.DeviceList, domain.Status) {
+			require.Equal([]string{"line=a"}, authz.ScopeFromContext(ctx).LabelSelectors)
+			require.Equal("site=factory-1", lo.FromPtr(params.LabelSelector))
+			require.Equal(int32(2), lo.FromPtr(params.Limit))
+			require.Nil(params.Continue)
+			return &domain.DeviceList{
+				Metadata: domain.ListMeta{Continue: lo.ToPtr("next")},
+				Items:    []domain.Device{newBulkDevice("dev1", map[string]string{"stale": "true"}), newBulkDevice("dev2", map[string]string{})},
+			}, domain.StatusOK()
+		})
+
+	// Labels that aren't set on a device aren't removed, and keys are escaped in the patch paths
+	mockSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev1", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			require.NotNil(authz.ScopeFromContext(ctx))
+			require.Len(patch, 2)
+			require.Equal("/metadata/labels/team~1owner", patch[0].Path)
+			require.Equal(domain.PatchOpRemove, patch[1].Op)
+			return nil, domain.StatusOK()
+		})
+	mockSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev2", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			require.Len(patch, 1)
+			return nil, domain.StatusBadRequest("invalid label")
+		})
+
+	mockSvc.EXPECT().ReplaceDeviceBulkOperationStatus(gomock.Any(), orgId, "bulk", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _ string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status) {
+			require.Equal(domain.DeviceBulkOperationPhaseRunning, operation.Status.Phase)
+			require.NotNil(operation.Status.StartTime)
+			require.Nil(operation.Status.CompletionTime)
+			require.Equal(int64(2), operation.Status.ProcessedDevices)
+			require.Equal(int64(1), operation.Status.FailedDevices)
+			require.Equal([]domain.DeviceBulkOperationFailure{{Device: "dev2", Message: "invalid label"}}, lo.FromPtr(operation.Status.Failures))
+			return &operation, domain.StatusOK()
+		})
+	mockSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+		require.Equal(domain.EventReasonDeviceBulkOperationProgressed, event.Reason)
+		details, err := event.Details.AsDeviceBulkOperationProgressedDetails()
+		require.NoError(err)
+		require.Equal("next", lo.FromPtr(details.Continue))
+		require.Equal(int64(2), details.ProcessedDevices)
+		require.Equal(int64(1), details.FailedDevices)
+	})
+
+	require.NoError(logic.ProcessBatch(ctx))
+}
+
+func TestDeviceBulkOperationLogic_LastBatch(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+	orgId := uuid.New()
+	ctx := context.Background()
+
+	event := servicecommon.GetDeviceBulkOperationProgressedEvent(ctx, "bulk", "next", 2, 0)
+	logic := NewDeviceBulkOperationLogic(logrus.New(), mockSvc, orgId, *event)
+
+	operation := newBulkOperation("bulk", domain.DeviceBulkOperationPhaseRunning)
+	operation.Spec.Operation = domain.DeviceBulkOperationTypeRerender
+	operation.Spec.Metadata = nil
+	mockSvc.EXPECT().GetDeviceBulkOperation(gomock.Any(), orgId, "bulk").Return(operation, domain.StatusOK())
+	mockSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Nil()).DoAndReturn(
+		func(ctx context.Context, _ uuid.UUID, params domain.ListDevicesParams, _ any) (*domain.DeviceList, domain.Status) {
+			require.Nil(authz.ScopeFromContext(ctx))
+			require.Equal("next", lo.FromPtr(params.Continue))
+			return &domain.DeviceList{Items: []domain.Device{newBulkDevice("dev3", nil)}}, domain.StatusOK()
+		})
+	mockSvc.EXPECT().RequestDeviceRender(gomock.Any(), orgId, "dev3").Return(domain.StatusOK())
+	mockSvc.EXPECT().ReplaceDeviceBulkOperationStatus(gomock.Any(), orgId, "bulk", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _ string, operation domain.DeviceBulkOperation) (*domain.DeviceBulkOperation, domain.Status) {
+			require.Equal(domain.DeviceBulkOperationPhaseCompleted, operation.Status.Phase)
+			require.NotNil(operation.Status.CompletionTime)
+			require.Equal(int64(3), operation.Status.ProcessedDevices)
+			return &operation, domain.StatusOK()
+		})
+	mockSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+		require.Equal(domain.EventReasonDeviceBulkOperationCompleted, event.Reason)
+	})
+
+	require.NoError(logic.ProcessBatch(ctx))
+}
+
+func TestDeviceBulkOperationLogic_SkipsFinishedOrDeleted(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+	orgId := uuid.New()
+	ctx := context.Background()
+
+	event := servicecommon.GetDeviceBulkOperationProgressedEvent(ctx, "bulk", "next", 2, 0)
+	logic := NewDeviceBulkOperationLogic(logrus.New(), mockSvc, orgId, *event)
+
+	mockSvc.EXPECT().GetDeviceBulkOperation(gomock.Any(), orgId, "bulk").Return(newBulkOperation("bulk", domain.DeviceBulkOperationPhaseCompleted), domain.StatusOK())
+	require.NoError(logic.ProcessBatch(ctx))
+
+	mockSvc.EXPECT().GetDeviceBulkOperation(gomock.Any(), orgId, "bulk").Return(nil, domain.StatusResourceNotFound(domain.DeviceBulkOperationKind, "bulk"))
+	require.NoError(logic.ProcessBatch(ctx))
+}
+
+func TestAppendDeviceBulkOperationFailure(t *testing.T) {
+	require := require.New(t)
+
+	failures := appendDeviceBulkOperationFailure(nil, "dev1", "first")
+	failures = appendDeviceBulkOperationFailure(failures, "dev1", "second")
+	require.Equal([]domain.DeviceBulkOperationFailure{{Device: "dev1", Message: "second"}}, failures)
+
+	for i := 0; i < 2*maxDeviceBulkOperationFailures; i++ {
+		failures = appendDeviceBulkOperationFailure(failures, uuid.NewString(), "failed")
+	}
+	require.Len(failures, maxDeviceBulkOperationFailures)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -38,7 +39,10 @@ import (
 //   and application specs.
 // - External inputs (e.g., Git repositories, HTTP endpoints, Kubernetes secrets) are frozen per
 //   fleet/template version using a KV store. Writes to the store use SetNX to prevent changes
-//   after freezing, and to detect inconsistencies.
+//   after freezing, and to detect inconsistencies. Secrets from a SecretStore are additionally
+//   frozen per secret version, and OCI artifacts per manifest digest. Secret versions and artifact
+//   digests are part of the rendered spec hash, so that a new secret version or a moved artifact
+//   tag results in a new rendered version.
 // - The rendered output and device condition status are safely overwritten or retried without
 //   side effects.
 //
@@ -72,10 +76,30 @@ type DeviceRenderLogic struct {
 	templateVersion *string
 	deviceConfig    *[]domain.ConfigProviderSpec
 	applications    *[]domain.ApplicationProviderSpec
+	// secretStoreClient creates clients for the SecretStores referenced by the device
+	secretStoreClient SecretStoreClientFactory
+	// secrets caches the secrets read from SecretStores, keyed by store and path
+	secrets map[string]*resolvedSecret
+	// artifacts caches the digests that OCI artifact references resolve to, keyed by repository,
+	// artifact and reference
+	artifacts map[string]*resolvedArtifact
+}
+
+type resolvedSecret struct {
+	data    map[string]string
+	version string
+	err     error
+}
+
+type resolvedArtifact struct {
+	ociSpec *domain.OciRepoSpec
+	digest  string
+	err     error
 }
 
 func NewDeviceRenderLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
-	return DeviceRenderLogic{log: log, serviceHandler: serviceHandler, k8sClient: k8sClient, kvStore: kvStore, cfg: cfg, orgId: orgId, event: event}
+	return DeviceRenderLogic{log: log, serviceHandler: serviceHandler, k8sClient: k8sClient, kvStore: kvStore, cfg: cfg, orgId: orgId, event: event,
+		secretStoreClient: NewSecretStoreClient}
 }
 
 func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
@@ -84,8 +108,8 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		return fmt.Errorf("failed getting device %s/%s: %s", t.orgId, t.event.InvolvedObject.Name, status.Message)
 	}
 
-	// Calculate hash including device spec to detect changes
-	specHash := hashRenderedWithSpec(device.Spec)
+	// Calculate hash including device spec, referenced secret versions and artifact digests to detect changes
+	specHash := hashRenderedWithVersions(hashRenderedWithSpec(device.Spec), t.resolveReferencedVersions(ctx, device.Spec))
 
 	// If device.Spec or device.Spec.Config are nil, we still want to render an empty ignition config
 	if device.Spec != nil {
@@ -293,6 +317,10 @@ func (t *DeviceRenderLogic) renderConfigItem(ctx context.Context, configItem *do
 		return t.renderInlineConfig(configItem, ignitionConfig)
 	case domain.HttpConfigProviderType:
 		return t.renderHttpProviderConfig(ctx, configItem, ignitionConfig)
+	case domain.SecretStoreConfigProviderType:
+		return t.renderSecretStoreConfig(ctx, configItem, ignitionConfig)
+	case domain.OciConfigProviderType:
+		return t.renderOciConfig(ctx, configItem, ignitionConfig)
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -313,6 +341,8 @@ func renderApplication(_ context.Context, app *domain.ApplicationProviderSpec) (
 		_, err = (*app).AsComposeApplication()
 	case domain.AppTypeQuadlet:
 		_, err = (*app).AsQuadletApplication()
+	case domain.AppTypeSystemd:
+		_, err = (*app).AsSystemdApplication()
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported application type: %q", ErrUnknownApplicationType, appType)
 	}
@@ -466,6 +496,258 @@ func (t *DeviceRenderLogic) renderK8sConfig(ctx context.Context, configItem *dom
 	return &k8sSpec.Name, nil, nil
 }
 
+func (t *DeviceRenderLogic) renderSecretStoreConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	ref := secretSpec.SecretStoreRef
+
+	secret := t.resolveSecret(ctx, ref.SecretStore, ref.Path)
+	if secret.err != nil {
+		return &secretSpec.Name, nil, secret.err
+	}
+	secretData := secret.data
+
+	if t.ownerFleet != nil {
+		key := kvstore.SecretStoreSecretKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			SecretStore:     ref.SecretStore,
+			Version:         secret.version,
+			Path:            ref.Path,
+		}
+		secretDataToStore, err := json.Marshal(secretData)
+		if err != nil {
+			return &secretSpec.Name, nil, fmt.Errorf("failed marshalling secret %s/%s: %w", ref.SecretStore, ref.Path, err)
+		}
+		frozenData, err := t.kvStore.GetOrSetNX(ctx, key.ComposeKey(), secretDataToStore)
+		if err != nil {
+			return &secretSpec.Name, nil, fmt.Errorf("failed storing secret %s/%s: %w", ref.SecretStore, ref.Path, err)
+		}
+		if err = json.Unmarshal(frozenData, &secretData); err != nil {
+			return &secretSpec.Name, nil, fmt.Errorf("failed parsing cached secret data: %w", err)
+		}
+	}
+
+	keys := lo.Keys(secretData)
+	if ref.Keys != nil {
+		keys = *ref.Keys
+	}
+	sort.Strings(keys)
+
+	mode := 0o600
+	if ref.Mode != nil {
+		mode = *ref.Mode
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return &secretSpec.Name, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	base := filepath.Clean(ref.MountPath)
+	for _, name := range keys {
+		contents, ok := secretData[name]
+		if !ok {
+			return &secretSpec.Name, nil, fmt.Errorf("key %q not found in secret %s/%s", name, ref.SecretStore, ref.Path)
+		}
+		// enforce filename (no path segments)
+		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
+			return &secretSpec.Name, nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
+		}
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return &secretSpec.Name, nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
+		}
+		ignitionWrapper.SetFile(dest, []byte(contents), mode, false, ref.User.String(), ref.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return &secretSpec.Name, nil, nil
+}
+
+// resolveSecret reads the latest version of the secret at path from the named SecretStore. The
+// result, including any error, is cached for the duration of the render.
+func (t *DeviceRenderLogic) resolveSecret(ctx context.Context, secretStoreName string, path string) *resolvedSecret {
+	if t.secrets == nil {
+		t.secrets = map[string]*resolvedSecret{}
+	}
+	cacheKey := secretStoreName + ":" + path
+	if secret, ok := t.secrets[cacheKey]; ok {
+		return secret
+	}
+
+	secret := &resolvedSecret{}
+	t.secrets[cacheKey] = secret
+
+	secretStore, status := t.serviceHandler.GetSecretStore(ctx, t.orgId, secretStoreName)
+	if status.Code != http.StatusOK {
+		secret.err = fmt.Errorf("failed fetching specified SecretStore definition %s/%s: %s", t.orgId, secretStoreName, status.Message)
+		return secret
+	}
+	client, err := t.secretStoreClient(secretStore)
+	if err != nil {
+		secret.err = fmt.Errorf("failed creating client for SecretStore %s: %w", secretStoreName, err)
+		return secret
+	}
+	secret.data, secret.version, err = client.ReadSecret(ctx, path)
+	if err != nil {
+		secret.err = fmt.Errorf("failed reading secret %s/%s: %w", secretStoreName, path, err)
+	}
+	return secret
+}
+
+// resolveReferencedVersions returns the current versions of the versioned secrets and the digests
+// of the OCI artifacts referenced by the device spec. Secrets are keyed by store and path, and
+// artifacts by repository, artifact and reference. References that can't be resolved are left
+// out; the error is reported when rendering the config item.
+func (t *DeviceRenderLogic) resolveReferencedVersions(ctx context.Context, deviceSpec *domain.DeviceSpec) map[string]string {
+	versions := map[string]string{}
+	if deviceSpec == nil || deviceSpec.Config == nil {
+		return versions
+	}
+	for _, configItem := range *deviceSpec.Config {
+		configType, err := configItem.Type()
+		if err != nil {
+			continue
+		}
+		switch configType {
+		case domain.SecretStoreConfigProviderType:
+			secretSpec, err := configItem.AsSecretConfigProviderSpec()
+			if err != nil {
+				continue
+			}
+			ref := secretSpec.SecretStoreRef
+			secret := t.resolveSecret(ctx, ref.SecretStore, ref.Path)
+			if secret.err == nil && secret.version != "" {
+				versions[ref.SecretStore+":"+ref.Path] = secret.version
+			}
+		case domain.OciConfigProviderType:
+			ociSpec, err := configItem.AsOciConfigProviderSpec()
+			if err != nil {
+				continue
+			}
+			ref := ociSpec.OciRef
+			artifact := t.resolveArtifact(ctx, ref.Repository, ref.Artifact, ref.Reference)
+			if artifact.err == nil {
+				versions["ociRef:"+ref.Repository+"/"+ref.Artifact+":"+ref.Reference] = artifact.digest
+			}
+		}
+	}
+	return versions
+}
+
+func (t *DeviceRenderLogic) renderOciConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, error) {
+	ociConfigProviderSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	name := &ociConfigProviderSpec.Name
+	ref := ociConfigProviderSpec.OciRef
+
+	artifact := t.resolveArtifact(ctx, ref.Repository, ref.Artifact, ref.Reference)
+	if artifact.err != nil {
+		return name, &ref.Repository, artifact.err
+	}
+
+	var files map[string]ociArtifactFile
+	var artifactKey kvstore.OciArtifactKey
+	if t.ownerFleet != nil {
+		artifactKey = kvstore.OciArtifactKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			ConfigName:      ociConfigProviderSpec.Name,
+			Digest:          artifact.digest,
+		}
+		cachedFiles, err := t.kvStore.Get(ctx, artifactKey.ComposeKey())
+		if err != nil {
+			return name, &ref.Repository, fmt.Errorf("failed fetching cached artifact data: %w", err)
+		}
+		if cachedFiles != nil {
+			if err = json.Unmarshal(cachedFiles, &files); err != nil {
+				return name, &ref.Repository, fmt.Errorf("failed parsing cached artifact data: %w", err)
+			}
+		}
+	}
+
+	if files == nil {
+		files, err = fetchOciArtifactFiles(ctx, artifact.ociSpec, ref.Artifact, artifact.digest, lo.FromPtr(ref.Layers))
+		if err != nil {
+			return name, &ref.Repository, fmt.Errorf("failed fetching artifact %s: %w", ref.Artifact, err)
+		}
+		files, err = filterOciArtifactFiles(files, lo.FromPtr(ref.Paths))
+		if err != nil {
+			return name, &ref.Repository, fmt.Errorf("failed selecting files of artifact %s: %w", ref.Artifact, err)
+		}
+
+		if t.ownerFleet != nil {
+			filesToStore, err := json.Marshal(files)
+			if err != nil {
+				return name, &ref.Repository, fmt.Errorf("failed marshalling artifact data: %w", err)
+			}
+			frozenFiles, err := t.kvStore.GetOrSetNX(ctx, artifactKey.ComposeKey(), filesToStore)
+			if err != nil {
+				return name, &ref.Repository, fmt.Errorf("failed storing artifact data: %w", err)
+			}
+			if err = json.Unmarshal(frozenFiles, &files); err != nil {
+				return name, &ref.Repository, fmt.Errorf("failed parsing cached artifact data: %w", err)
+			}
+		}
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return name, &ref.Repository, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	base := filepath.Clean(ref.MountPath)
+	fileNames := lo.Keys(files)
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		file := files[fileName]
+		dest := filepath.Join(base, fileName)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return name, &ref.Repository, fmt.Errorf("invalid path from OCI artifact: %w", err)
+		}
+		mode := file.Mode
+		if mode == 0 {
+			mode = 0o644
+		}
+		ignitionWrapper.SetFile(dest, file.Content, mode, false, ref.User.String(), ref.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return name, &ref.Repository, nil
+}
+
+// resolveArtifact resolves an OCI artifact reference in the named Repository to a manifest digest.
+// The result, including any error, is cached for the duration of the render.
+func (t *DeviceRenderLogic) resolveArtifact(ctx context.Context, repositoryName string, artifactName string, reference string) *resolvedArtifact {
+	if t.artifacts == nil {
+		t.artifacts = map[string]*resolvedArtifact{}
+	}
+	cacheKey := repositoryName + "/" + artifactName + ":" + reference
+	if artifact, ok := t.artifacts[cacheKey]; ok {
+		return artifact
+	}
+
+	artifact := &resolvedArtifact{}
+	t.artifacts[cacheKey] = artifact
+
+	repo, status := t.serviceHandler.GetRepository(ctx, t.orgId, repositoryName)
+	if status.Code != http.StatusOK {
+		artifact.err = fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, repositoryName, status.Message)
+		return artifact
+	}
+	artifact.ociSpec, artifact.err = getOciRepoSpec(repo)
+	if artifact.err != nil {
+		return artifact
+	}
+	artifact.digest, artifact.err = resolveOciArtifactDigest(ctx, artifact.ociSpec, artifactName, reference)
+	return artifact
+}
+
 func (t *DeviceRenderLogic) renderInlineConfig(configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -742,6 +1024,22 @@ func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byt
 	return renderedConfig, nil
 }
 
+// hashRenderedWithVersions combines the spec hash with the versions of the referenced secrets and
+// artifacts. The spec hash is returned unchanged if there are no versions.
+func hashRenderedWithVersions(specHash string, versions map[string]string) string {
+	if len(versions) == 0 {
+		return specHash
+	}
+	keys := lo.Keys(versions)
+	sort.Strings(keys)
+	h := sha256.New()
+	h.Write([]byte(specHash))
+	for _, k := range keys {
+		h.Write([]byte("\n" + k + "@" + versions[k]))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // hashRenderedWithSpec creates a hash of the device spec to detect changes
 func hashRenderedWithSpec(deviceSpec *domain.DeviceSpec) string {
 	if deviceSpec == nil {
@@ -269,6 +269,9 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	deviceApps, appErrs := f.getDeviceApps(device, templateVersion)
 	errs = append(errs, appErrs...)
 
+	deviceCerts, certErrs := getDeviceCertificates(device, templateVersion)
+	errs = append(errs, certErrs...)
+
 	if len(errs) > 0 {
 		annotations := map[string]string{
 			domain.DeviceAnnotationLastRolloutError: errors.Join(errs...).Error(),
@@ -287,6 +290,8 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 		Resources:    templateVersion.Status.Resources,
 		Applications: deviceApps,
 		UpdatePolicy: templateVersion.Status.UpdatePolicy,
+		Telemetry:    templateVersion.Status.Telemetry,
+		Certificates: deviceCerts,
 	}
 
 	errs = newDeviceSpec.Validate(false)
@@ -342,6 +347,8 @@ func (f FleetRolloutsLogic) getDeviceApps(device *domain.Device, templateVersion
 			newAppItem, errs = f.replaceComposeApplicationParameters(device, appItem)
 		case domain.AppTypeQuadlet:
 			newAppItem, errs = f.replaceQuadletApplicationParameters(device, appItem)
+		case domain.AppTypeSystemd:
+			newAppItem, errs = f.replaceSystemdApplicationParameters(device, appItem)
 		default:
 			errs = append(errs, fmt.Errorf("unsupported app type for app %d: %s", appIndex, appType))
 		}
@@ -359,6 +366,53 @@ func (f FleetRolloutsLogic) getDeviceApps(device *domain.Device, templateVersion
 	return &deviceApps, nil
 }
 
+// getDeviceCertificates renders the parameters in the subject names of the certificates of the template version.
+func getDeviceCertificates(device *domain.Device, templateVersion *domain.TemplateVersion) (*[]domain.DeviceCertificateSpec, []error) {
+	if templateVersion.Status.Certificates == nil {
+		return nil, nil
+	}
+
+	deviceCerts := make([]domain.DeviceCertificateSpec, 0, len(*templateVersion.Status.Certificates))
+	certErrs := []error{}
+	for _, cert := range *templateVersion.Status.Certificates {
+		if cert.CommonName != nil {
+			commonName, err := replaceParametersInString(*cert.CommonName, device)
+			if err != nil {
+				certErrs = append(certErrs, fmt.Errorf("failed replacing parameters in common name of certificate %s: %w", cert.Name, err))
+			}
+			cert.CommonName = &commonName
+		}
+		if cert.DnsNames != nil {
+			dnsNames := make([]string, 0, len(*cert.DnsNames))
+			for _, dnsName := range *cert.DnsNames {
+				newDNSName, err := replaceParametersInString(dnsName, device)
+				if err != nil {
+					certErrs = append(certErrs, fmt.Errorf("failed replacing parameters in DNS name of certificate %s: %w", cert.Name, err))
+				}
+				dnsNames = append(dnsNames, newDNSName)
+			}
+			cert.DnsNames = &dnsNames
+		}
+		if cert.IpAddresses != nil {
+			ipAddresses := make([]string, 0, len(*cert.IpAddresses))
+			for _, ipAddress := range *cert.IpAddresses {
+				newIPAddress, err := replaceParametersInString(ipAddress, device)
+				if err != nil {
+					certErrs = append(certErrs, fmt.Errorf("failed replacing parameters in IP address of certificate %s: %w", cert.Name, err))
+				}
+				ipAddresses = append(ipAddresses, newIPAddress)
+			}
+			cert.IpAddresses = &ipAddresses
+		}
+		deviceCerts = append(deviceCerts, cert)
+	}
+
+	if len(certErrs) > 0 {
+		return nil, certErrs
+	}
+	return &deviceCerts, nil
+}
+
 func replaceEnvVarsMap(device *domain.Device, envVars *map[string]string) (*map[string]string, []error) {
 	if envVars == nil {
 		return nil, nil
@@ -441,6 +495,36 @@ func (f FleetRolloutsLogic) replaceHelmApplicationParameters(device *domain.Devi
 	return &newItem, nil
 }
 
+func (f FleetRolloutsLogic) replaceSystemdApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+	systemdApp, err := app.AsSystemdApplication()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert to systemd application: %w", err)}
+	}
+	appName := lo.FromPtr(systemdApp.Name)
+
+	var errs []error
+
+	systemdApp.Image, err = replaceParametersInString(systemdApp.Image, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in image for app %s: %w", appName, err))
+	}
+
+	newEnvVars, envErrs := replaceEnvVarsMap(device, systemdApp.EnvVars)
+	errs = append(errs, envErrs...)
+	systemdApp.EnvVars = newEnvVars
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	var newItem domain.ApplicationProviderSpec
+	if err := newItem.FromSystemdApplication(systemdApp); err != nil {
+		return nil, []error{fmt.Errorf("failed converting systemd application: %w", err)}
+	}
+
+	return &newItem, nil
+}
+
 func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	composeApp, err := app.AsComposeApplication()
 	if err != nil {
@@ -712,6 +796,10 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 			newConfigItem, errs = f.replaceInlineConfigParameters(device, configItem)
 		case domain.HttpConfigProviderType:
 			newConfigItem, errs = f.replaceHTTPConfigParameters(device, configItem)
+		case domain.SecretStoreConfigProviderType:
+			newConfigItem, errs = f.replaceSecretStoreConfigParameters(device, configItem)
+		case domain.OciConfigProviderType:
+			newConfigItem, errs = f.replaceOciConfigParameters(device, configItem)
 		default:
 			errs = append(errs, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType))
 		}
@@ -796,6 +884,73 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	return &newConfigItem, nil
 }
 
+func (f FleetRolloutsLogic) replaceSecretStoreConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to secret store config: %w", err)}
+	}
+
+	errs := []error{}
+
+	secretSpec.SecretStoreRef.Path, err = replaceParametersInString(secretSpec.SecretStoreRef.Path, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in path in secret store config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.SecretStoreRef.MountPath, err = replaceParametersInString(secretSpec.SecretStoreRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in secret store config %s: %w", secretSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromSecretConfigProviderSpec(secretSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting secret store config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
+func (f FleetRolloutsLogic) replaceOciConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to OCI config: %w", err)}
+	}
+
+	errs := []error{}
+
+	ociSpec.OciRef.Artifact, err = replaceParametersInString(ociSpec.OciRef.Artifact, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in artifact in OCI config %s: %w", ociSpec.Name, err))
+	}
+
+	ociSpec.OciRef.Reference, err = replaceParametersInString(ociSpec.OciRef.Reference, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in reference in OCI config %s: %w", ociSpec.Name, err))
+	}
+
+	ociSpec.OciRef.MountPath, err = replaceParametersInString(ociSpec.OciRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in OCI config %s: %w", ociSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromOciConfigProviderSpec(ociSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting OCI config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
 func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -793,6 +793,37 @@ func TestFleetRolloutsLogic_ReplaceContainerApplicationParameters(t *testing.T)
 	}
 }
 
+func TestGetDeviceCertificates(t *testing.T) {
+	require := require.New(t)
+	device := createTestDeviceWithLabels("device-0001", "fleet/test", map[string]string{"site": "factory-a"})
+	templateVersion := &domain.TemplateVersion{
+		Status: &domain.TemplateVersionStatus{
+			Certificates: &[]domain.DeviceCertificateSpec{
+				{
+					Name:        "web",
+					CommonName:  lo.ToPtr("{{ .metadata.name }}"),
+					DnsNames:    &[]string{"{{ .metadata.name }}.{{ index .metadata.labels \"site\" }}.example.com", "web.example.com"},
+					IpAddresses: &[]string{"192.168.1.10"},
+					Destination: domain.CertificateDestination{File: &domain.CertificateFileDestination{CertPath: "/etc/web/tls.crt", KeyPath: "/etc/web/tls.key"}},
+				},
+			},
+		},
+	}
+
+	certs, errs := getDeviceCertificates(device, templateVersion)
+	require.Empty(errs)
+	require.NotNil(certs)
+	require.Len(*certs, 1)
+	cert := (*certs)[0]
+	require.Equal("device-0001", lo.FromPtr(cert.CommonName))
+	require.Equal([]string{"device-0001.factory-a.example.com", "web.example.com"}, lo.FromPtr(cert.DnsNames))
+	require.Equal([]string{"192.168.1.10"}, lo.FromPtr(cert.IpAddresses))
+
+	// The template version must not be modified by rendering.
+	require.Equal("{{ .metadata.name }}", lo.FromPtr((*templateVersion.Status.Certificates)[0].CommonName))
+	require.Equal("{{ .metadata.name }}.{{ index .metadata.labels \"site\" }}.example.com", (*(*templateVersion.Status.Certificates)[0].DnsNames)[0])
+}
+
 func TestFleetRolloutsLogic_ReplaceHelmApplicationParameters(t *testing.T) {
 	tests := []struct {
 		name          string
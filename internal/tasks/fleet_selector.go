@@ -9,7 +9,7 @@ package tasks
 // - Implement batch device updates instead of individual ReplaceDevice calls for better performance
 //
 // The task ensures:
-// 1. Devices that match fleet selectors are assigned the correct owner
+// 1. Devices that match fleet selectors (labels and, optionally, allow-listed status fields) are assigned the correct owner
 // 2. Devices with multiple matching fleets have MultipleOwners condition set
 // 3. Orphaned devices (no longer matching any fleet) have their owner removed
 //
@@ -17,7 +17,7 @@ package tasks
 // 1. Fleet create/update/delete:
 //    Reference kind: Fleet
 //    Task description: Iterate devices that match the fleet's selector and set owners/conditions as necessary
-// 2. Device create/update (no work needed for delete):
+// 2. Device create/update of labels or selectable status fields (no work needed for delete):
 //    Reference kind: Device
 //    Task description: Iterate fleets and set the device's owner/conditions as necessary
 
@@ -120,7 +120,7 @@ func (f FleetSelectorMatchingLogic) DeviceLabelsUpdated(ctx context.Context) err
 		f.log.Errorf("%s", errorMsg)
 		return fmt.Errorf("%s", errorMsg)
 	}
-	matchingFleets := findMatchingFleets(*device.Metadata.Labels, fleets)
+	matchingFleets := findMatchingFleets(device, fleets)
 
 	var processedWithErrors bool
 	// Handle different cases based on number of matching fleets
@@ -430,8 +430,8 @@ func (f FleetSelectorMatchingLogic) recomputeDeviceOwnership(ctx context.Context
 		return f.handleUnlabeledDevice(ctx, device)
 	}
 
-	// Find all fleets that match the device's labels
-	matchingFleets := findMatchingFleets(*device.Metadata.Labels, allFleets)
+	// Find all fleets that match the device's labels and status fields
+	matchingFleets := findMatchingFleets(device, allFleets)
 
 	// Get current owner fleet
 	currentOwnerFleet, isOwnerAFleet, err := getOwnerFleet(device)
@@ -580,11 +580,12 @@ func labelSelectorFromLabelMap(labels map[string]string) *string {
 	return lo.ToPtr(strings.Join(parts, ","))
 }
 
-func findMatchingFleets(labels map[string]string, fleets []domain.Fleet) []string {
+func findMatchingFleets(device *domain.Device, fleets []domain.Fleet) []string {
 	// Find all fleets with a selector that the device matches
+	labels := lo.FromPtr(device.Metadata.Labels)
 	var matchingFleets []string
 	for _, fleet := range fleets {
-		if util.LabelsMatchLabelSelector(labels, getMatchLabelsSafe(&fleet)) {
+		if util.LabelsMatchLabelSelector(labels, getMatchLabelsSafe(&fleet)) && device.MatchesFleetFieldSelector(fleet.Spec.FieldSelector) {
 			matchingFleets = append(matchingFleets, *fleet.Metadata.Name)
 		}
 	}
@@ -620,6 +621,10 @@ func (f FleetSelectorMatchingLogic) handleOrphanedDevices(ctx context.Context, f
 		LabelSelector: lo.ToPtr(fmt.Sprintf("(%s) != (%s)", strings.Join(keys, ","), strings.Join(values, ","))),
 		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *util.SetResourceOwner(domain.FleetKind, f.event.InvolvedObject.Name))),
 	}
+	if fleet.Spec.FieldSelector != nil {
+		// Devices matching the labels may no longer match the status fields, so all owned devices are re-examined
+		listParams.LabelSelector = nil
+	}
 
 	devicesProcessed, errors := 0, 0
 	for {
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/containers/image/v5/docker/reference"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -52,10 +56,12 @@ type FleetValidateLogic struct {
 	orgId          uuid.UUID
 	event          domain.Event
 	templateConfig *[]domain.ConfigProviderSpec
+	// imageArchitectures resolves the architectures of the fleet's OS image
+	imageArchitectures ImageArchitecturesFunc
 }
 
 func NewFleetValidateLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, orgId uuid.UUID, event domain.Event) FleetValidateLogic {
-	return FleetValidateLogic{log: log, serviceHandler: serviceHandler, k8sClient: k8sClient, orgId: orgId, event: event}
+	return FleetValidateLogic{log: log, serviceHandler: serviceHandler, k8sClient: k8sClient, orgId: orgId, event: event, imageArchitectures: getImageArchitectures}
 }
 
 func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Context) error {
@@ -79,6 +85,9 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		return t.setStatus(ctx, validationErr)
 	}
 
+	// The OS image architecture check is advisory only and never fails the fleet validation
+	t.warnOnImageArchitectureMismatch(ctx, fleet)
+
 	templateVersion := domain.TemplateVersion{
 		Metadata: domain.ObjectMeta{
 			Name:  &templateVersionName,
@@ -87,10 +96,12 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		Spec: domain.TemplateVersionSpec{Fleet: *fleet.Metadata.Name},
 		Status: &domain.TemplateVersionStatus{
 			Applications: fleet.Spec.Template.Spec.Applications,
+			Certificates: fleet.Spec.Template.Spec.Certificates,
 			Config:       fleet.Spec.Template.Spec.Config,
 			Os:           fleet.Spec.Template.Spec.Os,
 			Resources:    fleet.Spec.Template.Spec.Resources,
 			Systemd:      fleet.Spec.Template.Spec.Systemd,
+			Telemetry:    fleet.Spec.Template.Spec.Telemetry,
 			UpdatePolicy: fleet.Spec.Template.Spec.UpdatePolicy,
 		},
 	}
@@ -141,6 +152,111 @@ func (t *FleetValidateLogic) setStatus(ctx context.Context, validationErr error)
 	return validationErr
 }
 
+// warnOnImageArchitectureMismatch emits a warning event if the fleet's OS image is not published for
+// every architecture reported by the fleet's devices. Errors are logged and otherwise ignored.
+func (t *FleetValidateLogic) warnOnImageArchitectureMismatch(ctx context.Context, fleet *domain.Fleet) {
+	osSpec := fleet.Spec.Template.Spec.Os
+	if osSpec == nil || osSpec.Image == "" || t.imageArchitectures == nil {
+		return
+	}
+	// Images that depend on per-device template parameters cannot be resolved at the fleet level
+	if strings.Contains(osSpec.Image, "{{") {
+		return
+	}
+
+	deviceArchitectures, err := t.listFleetDeviceArchitectures(ctx, *fleet.Metadata.Name)
+	if err != nil {
+		t.log.Warnf("failed listing device architectures of fleet %s/%s: %v", t.orgId, *fleet.Metadata.Name, err)
+		return
+	}
+	if len(deviceArchitectures) == 0 {
+		return
+	}
+
+	ociSpec, err := t.findOciRepoSpecForImage(ctx, osSpec.Image)
+	if err != nil {
+		t.log.Warnf("failed finding repository for image %q of fleet %s/%s: %v", osSpec.Image, t.orgId, *fleet.Metadata.Name, err)
+		return
+	}
+
+	imageArchitectures, err := t.imageArchitectures(ctx, osSpec.Image, ociSpec)
+	if err != nil {
+		t.log.Warnf("failed inspecting architectures of image %q of fleet %s/%s: %v", osSpec.Image, t.orgId, *fleet.Metadata.Name, err)
+		return
+	}
+
+	missing, _ := lo.Difference(deviceArchitectures, imageArchitectures)
+	if len(missing) == 0 {
+		return
+	}
+	t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetFleetImageArchitectureMismatchEvent(ctx, *fleet.Metadata.Name, osSpec.Image, missing))
+}
+
+// listFleetDeviceArchitectures returns the sorted, distinct architectures reported by the devices owned by the fleet
+func (t *FleetValidateLogic) listFleetDeviceArchitectures(ctx context.Context, fleetName string) ([]string, error) {
+	listParams := domain.ListDevicesParams{
+		Limit:         lo.ToPtr(int32(ItemsPerPage)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *util.SetResourceOwner(domain.FleetKind, fleetName))),
+	}
+
+	architectures := map[string]struct{}{}
+	for {
+		devices, status := t.serviceHandler.ListDevices(ctx, t.orgId, listParams, nil)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("failed listing devices: %s", status.Message)
+		}
+		for _, device := range devices.Items {
+			if device.Status != nil && device.Status.SystemInfo.Architecture != "" {
+				architectures[device.Status.SystemInfo.Architecture] = struct{}{}
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+
+	result := lo.Keys(architectures)
+	sort.Strings(result)
+	return result, nil
+}
+
+// findOciRepoSpecForImage returns the spec of the OCI repository whose registry hosts the image,
+// or nil if there is none and the registry should be accessed anonymously
+func (t *FleetValidateLogic) findOciRepoSpecForImage(ctx context.Context, image string) (*domain.OciRepoSpec, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference: %w", err)
+	}
+	registry := reference.Domain(named)
+
+	listParams := domain.ListRepositoriesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		repositories, status := t.serviceHandler.ListRepositories(ctx, t.orgId, listParams)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("failed listing repositories: %s", status.Message)
+		}
+		for _, repository := range repositories.Items {
+			repoType, err := repository.Spec.Discriminator()
+			if err != nil || domain.RepoSpecType(repoType) != domain.RepoSpecTypeOci {
+				continue
+			}
+			ociSpec, err := repository.Spec.AsOciRepoSpec()
+			if err != nil {
+				continue
+			}
+			if ociSpec.Registry == registry {
+				return &ociSpec, nil
+			}
+		}
+		if repositories.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = repositories.Metadata.Continue
+	}
+	return nil, nil
+}
+
 func (t *FleetValidateLogic) validateConfig(ctx context.Context) ([]string, error) {
 	if t.templateConfig == nil {
 		return nil, nil
@@ -193,6 +309,10 @@ func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem
 		return t.validateInlineConfig(configItem)
 	case domain.HttpConfigProviderType:
 		return t.validateHttpProviderConfig(ctx, configItem)
+	case domain.SecretStoreConfigProviderType:
+		return t.validateSecretStoreConfig(ctx, configItem)
+	case domain.OciConfigProviderType:
+		return t.validateOciConfig(ctx, configItem)
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -232,6 +352,39 @@ func (t *FleetValidateLogic) validateK8sConfig(ctx context.Context, configItem *
 	return &k8sSpec.Name, nil, nil
 }
 
+func (t *FleetValidateLogic) validateSecretStoreConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	// The secret path may depend on per-device parameters, so only the store itself can be checked here
+	_, status := t.serviceHandler.GetSecretStore(ctx, t.orgId, secretSpec.SecretStoreRef.SecretStore)
+	if status.Code != http.StatusOK {
+		return &secretSpec.Name, nil, fmt.Errorf("failed fetching specified SecretStore definition %s/%s: %s", t.orgId, secretSpec.SecretStoreRef.SecretStore, status.Message)
+	}
+
+	return &secretSpec.Name, nil, nil
+}
+
+func (t *FleetValidateLogic) validateOciConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	repo, status := t.serviceHandler.GetRepository(ctx, t.orgId, ociSpec.OciRef.Repository)
+	if status.Code != http.StatusOK {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, ociSpec.OciRef.Repository, status.Message)
+	}
+	_, err = getOciRepoSpec(repo)
+	if err != nil {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, err
+	}
+
+	return &ociSpec.Name, &ociSpec.OciRef.Repository, nil
+}
+
 func (t *FleetValidateLogic) validateInlineConfig(configItem *domain.ConfigProviderSpec) (*string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
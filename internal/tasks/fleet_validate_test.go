@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 	"testing"
@@ -68,6 +69,9 @@ func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_ImmediateRollou
 			// Mock OverwriteFleetRepositoryRefs to succeed
 			mockService.EXPECT().OverwriteFleetRepositoryRefs(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(domain.Status{Code: http.StatusOK})
 
+			// Mock ListDevices to return no devices, skipping the OS image architecture check
+			mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.DeviceList{}, domain.Status{Code: http.StatusOK})
+
 			// Mock CreateTemplateVersion to capture the immediateRollout parameter
 			var capturedImmediateRollout bool
 			mockService.EXPECT().CreateTemplateVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
@@ -147,3 +151,88 @@ func TestGenerateTemplateVersionName(t *testing.T) {
 		})
 	}
 }
+
+func TestFleetValidateLogic_WarnOnImageArchitectureMismatch(t *testing.T) {
+	newDevice := func(arch string) domain.Device {
+		return domain.Device{
+			Status: &domain.DeviceStatus{
+				SystemInfo: domain.DeviceSystemInfo{Architecture: arch},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		deviceArchitecture []string
+		imageArchitectures []string
+		imageErr           error
+		expectedMissing    string
+	}{
+		{
+			name:               "image covers all device architectures",
+			deviceArchitecture: []string{"amd64", "arm64", "amd64"},
+			imageArchitectures: []string{"amd64", "arm64"},
+		},
+		{
+			name:               "image lacks a device architecture",
+			deviceArchitecture: []string{"amd64", "arm64"},
+			imageArchitectures: []string{"amd64"},
+			expectedMissing:    "arm64",
+		},
+		{
+			name:               "image inspection fails",
+			deviceArchitecture: []string{"arm64"},
+			imageErr:           errors.New("registry unavailable"),
+		},
+		{
+			name: "no devices reporting an architecture",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fleetName := "test-fleet"
+			fleet := createTestFleet(fleetName, nil)
+			event := createTestEvent(domain.FleetKind, "some-reason", fleetName)
+			mockService := service.NewMockService(ctrl)
+
+			devices := &domain.DeviceList{}
+			for _, arch := range tt.deviceArchitecture {
+				devices.Items = append(devices.Items, newDevice(arch))
+			}
+			mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(devices, domain.Status{Code: http.StatusOK})
+			if len(tt.deviceArchitecture) > 0 {
+				mockService.EXPECT().ListRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.RepositoryList{}, domain.Status{Code: http.StatusOK})
+			}
+
+			var capturedEvent *domain.Event
+			if tt.expectedMissing != "" {
+				mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+					func(ctx context.Context, orgId uuid.UUID, event *domain.Event) {
+						capturedEvent = event
+					})
+			}
+
+			logic := NewFleetValidateLogic(logrus.New(), mockService, k8sclient.NewMockK8SClient(ctrl), uuid.New(), event)
+			logic.imageArchitectures = func(ctx context.Context, image string, ociSpec *domain.OciRepoSpec) ([]string, error) {
+				assert.Equal(t, "test-image:latest", image)
+				assert.Nil(t, ociSpec)
+				return tt.imageArchitectures, tt.imageErr
+			}
+
+			logic.warnOnImageArchitectureMismatch(context.Background(), fleet)
+
+			if tt.expectedMissing == "" {
+				assert.Nil(t, capturedEvent)
+				return
+			}
+			require.NotNil(t, capturedEvent)
+			assert.Equal(t, domain.EventReasonFleetImageArchitectureMismatch, capturedEvent.Reason)
+			assert.Equal(t, domain.Warning, capturedEvent.Type)
+			assert.Contains(t, capturedEvent.Message, tt.expectedMissing)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+)
+
+const (
+	dockerHubDomain   = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+// manifestAcceptHeader lists the manifest media types understood by getImageArchitectures
+var manifestAcceptHeader = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ImageArchitecturesFunc returns the architectures an OCI image is published for. The ociSpec
+// holds the connection settings of the registry hosting the image and may be nil.
+type ImageArchitecturesFunc func(ctx context.Context, image string, ociSpec *domain.OciRepoSpec) ([]string, error)
+
+type imageManifest struct {
+	MediaType string `json:"mediaType"`
+	// Populated for image indexes and manifest lists
+	Manifests []struct {
+		Platform *struct {
+			Architecture string `json:"architecture"`
+		} `json:"platform,omitempty"`
+	} `json:"manifests,omitempty"`
+	// Populated for single-architecture image manifests
+	Config *struct {
+		Digest string `json:"digest"`
+	} `json:"config,omitempty"`
+}
+
+// getImageArchitectures inspects an image in its registry and returns the architectures it is
+// available for. Image indexes and manifest lists report one architecture per entry, while
+// single-architecture images report the architecture stored in their image config.
+func getImageArchitectures(ctx context.Context, image string, ociSpec *domain.OciRepoSpec) ([]string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference %q: %w", image, err)
+	}
+	named = reference.TagNameOnly(named)
+
+	manifestRef := ""
+	if digested, ok := named.(reference.Digested); ok {
+		manifestRef = digested.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		manifestRef = tagged.Tag()
+	}
+
+	if ociSpec == nil {
+		ociSpec = &domain.OciRepoSpec{Registry: reference.Domain(named)}
+	}
+	registry, repoURL, err := newRegistryReader(ociSpec, reference.Path(named))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := registry.get(ctx, repoURL.JoinPath("manifests", manifestRef).String(), manifestAcceptHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest for image %q: %w", image, err)
+	}
+	var manifest imageManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest for image %q: %w", image, err)
+	}
+
+	architectures := map[string]struct{}{}
+	switch {
+	case len(manifest.Manifests) > 0:
+		for _, m := range manifest.Manifests {
+			// Attestation manifests are recorded with an "unknown" platform
+			if m.Platform != nil && m.Platform.Architecture != "" && m.Platform.Architecture != "unknown" {
+				architectures[m.Platform.Architecture] = struct{}{}
+			}
+		}
+	case manifest.Config != nil && manifest.Config.Digest != "":
+		configBody, err := registry.get(ctx, repoURL.JoinPath("blobs", manifest.Config.Digest).String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch config for image %q: %w", image, err)
+		}
+		var config struct {
+			Architecture string `json:"architecture"`
+		}
+		if err := json.Unmarshal(configBody, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse config for image %q: %w", image, err)
+		}
+		if config.Architecture != "" {
+			architectures[config.Architecture] = struct{}{}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported manifest for image %q", image)
+	}
+
+	result := lo.Keys(architectures)
+	sort.Strings(result)
+	return result, nil
+}
+
+// registryReader performs authenticated GET requests against a single registry repository,
+// exchanging credentials for a bearer token the first time the registry asks for one.
+type registryReader struct {
+	client     *http.Client
+	repository string
+	ociAuth    *domain.OciAuth
+	token      string
+	// maxBodySize limits the size of the responses read, if non-zero
+	maxBodySize int64
+}
+
+// newRegistryReader creates a registryReader for the repository at path in the registry described
+// by ociSpec, and returns it together with the repository's base URL in the registry API.
+func newRegistryReader(ociSpec *domain.OciRepoSpec, path string) (*registryReader, *url.URL, error) {
+	client, err := newOciRegistryClient(ociSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host := ociSpec.Registry
+	if host == dockerHubDomain {
+		host = dockerHubRegistry
+	}
+	scheme := "https"
+	if ociSpec.Scheme != nil {
+		scheme = string(*ociSpec.Scheme)
+	}
+	repoURL := (&url.URL{Scheme: scheme, Host: host}).JoinPath("v2", path)
+	registry := &registryReader{
+		client:     client,
+		repository: path,
+		ociAuth:    ociSpec.OciAuth,
+	}
+	return registry, repoURL, nil
+}
+
+func (r *registryReader) get(ctx context.Context, target string, accept []string) ([]byte, error) {
+	resp, err := r.do(ctx, target, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized && r.token == "" {
+		if err := r.authenticate(resp.Header.Get("Www-Authenticate")); err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		resp, err = r.do(ctx, target, accept)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	if r.maxBodySize == 0 {
+		return io.ReadAll(resp.Body)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, r.maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > r.maxBodySize {
+		return nil, fmt.Errorf("response exceeds the maximum size of %d bytes", r.maxBodySize)
+	}
+	return body, nil
+}
+
+func (r *registryReader) do(ctx context.Context, target string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for _, mediaType := range accept {
+		req.Header.Add("Accept", mediaType)
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to registry: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *registryReader) authenticate(wwwAuth string) error {
+	realm, service, err := parseWwwAuthenticate(wwwAuth)
+	if err != nil {
+		return fmt.Errorf("failed to parse www-authenticate header: %w", err)
+	}
+
+	var username, password *string
+	if r.ociAuth != nil {
+		dockerAuth, err := r.ociAuth.AsDockerAuth()
+		if err != nil {
+			return fmt.Errorf("failed to parse docker auth config: %w", err)
+		}
+		username = &dockerAuth.Username
+		password = &dockerAuth.Password
+	}
+
+	token, err := ociRepoTester.getToken(r.client, realm, service, "repository:"+r.repository+":pull", username, password)
+	if err != nil {
+		return err
+	}
+	r.token = token
+	return nil
+}
@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOciRepoSpec(server *httptest.Server) *domain.OciRepoSpec {
+	return &domain.OciRepoSpec{
+		Registry: strings.TrimPrefix(server.URL, "http://"),
+		Scheme:   lo.ToPtr(domain.OciRepoSpecScheme("http")),
+		Type:     domain.OciRepoSpecTypeOci,
+	}
+}
+
+func TestGetImageArchitectures_ImageIndex(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("/v2/org/os/manifests/v1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/vnd.oci.image.index.v1+json")
+		fmt.Fprint(w, `{"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[
+			{"platform":{"architecture":"arm64","os":"linux"}},
+			{"platform":{"architecture":"amd64","os":"linux"}},
+			{"platform":{"architecture":"unknown","os":"unknown"}}]}`)
+	}))
+	defer server.Close()
+
+	ociSpec := newTestOciRepoSpec(server)
+	archs, err := getImageArchitectures(context.Background(), ociSpec.Registry+"/org/os:v1", ociSpec)
+	require.NoError(err)
+	require.Equal([]string{"amd64", "arm64"}, archs)
+}
+
+func TestGetImageArchitectures_SingleManifestWithTokenAuth(t *testing.T) {
+	require := require.New(t)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			require.Equal("repository:org/os:pull", r.URL.Query().Get("scope"))
+			fmt.Fprint(w, `{"token":"secret"}`)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("Www-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/org/os/manifests/latest":
+			fmt.Fprint(w, `{"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"digest":"sha256:abc"}}`)
+		case "/v2/org/os/blobs/sha256:abc":
+			fmt.Fprint(w, `{"architecture":"arm64","os":"linux"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ociSpec := newTestOciRepoSpec(server)
+	archs, err := getImageArchitectures(context.Background(), ociSpec.Registry+"/org/os", ociSpec)
+	require.NoError(err)
+	require.Equal([]string{"arm64"}, archs)
+}
+
+func TestGetImageArchitectures_ManifestNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ociSpec := newTestOciRepoSpec(server)
+	_, err := getImageArchitectures(context.Background(), ociSpec.Registry+"/org/os:v1", ociSpec)
+	require.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package tasks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+)
+
+const (
+	// maxOciArtifactSize limits the size of the layers of a config artifact, both compressed and extracted
+	maxOciArtifactSize = 10 * 1024 * 1024
+	// maxOciManifestSize limits the size of the manifest of a config artifact
+	maxOciManifestSize = 4 * 1024 * 1024
+
+	// ociImageTitleAnnotation holds the file name of a layer, as set by tools such as ORAS
+	ociImageTitleAnnotation = "org.opencontainers.image.title"
+	// orasUnpackAnnotation marks layers holding a tarball of a directory
+	orasUnpackAnnotation = "io.deis.oras.content.unpack"
+)
+
+// ociArtifactManifestAcceptHeader lists the manifest media types understood by fetchOciArtifactFiles
+var ociArtifactManifestAcceptHeader = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociArtifactManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+	// Populated for image indexes, which are not supported as config artifacts
+	Manifests []ociDescriptor `json:"manifests,omitempty"`
+}
+
+// ociArtifactFile is a file extracted from a config artifact.
+type ociArtifactFile struct {
+	Content []byte `json:"content"`
+	Mode    int    `json:"mode"`
+}
+
+// resolveOciArtifactDigest returns the digest of the manifest that the reference, a tag or a
+// digest, points to in the artifact's repository.
+func resolveOciArtifactDigest(ctx context.Context, ociSpec *domain.OciRepoSpec, artifact string, reference string) (string, error) {
+	if isOciDigest(reference) {
+		return reference, nil
+	}
+
+	registry, repoURL, err := newRegistryReader(ociSpec, artifact)
+	if err != nil {
+		return "", err
+	}
+	registry.maxBodySize = maxOciManifestSize
+	body, err := registry.get(ctx, repoURL.JoinPath("manifests", reference).String(), ociArtifactManifestAcceptHeader)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch manifest for artifact %s:%s: %w", artifact, reference, err)
+	}
+	return sha256Digest(body), nil
+}
+
+// fetchOciArtifactFiles downloads the artifact with the given manifest digest and returns its files,
+// keyed by their path relative to the artifact's root. Layers annotated with a title are files of
+// that name, unless they are marked for unpacking; other layers are tarballs that are extracted.
+// If layers is not empty, only the layers with the listed titles are extracted.
+func fetchOciArtifactFiles(ctx context.Context, ociSpec *domain.OciRepoSpec, artifact string, digest string, layers []string) (map[string]ociArtifactFile, error) {
+	registry, repoURL, err := newRegistryReader(ociSpec, artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	registry.maxBodySize = maxOciManifestSize
+	body, err := registry.get(ctx, repoURL.JoinPath("manifests", digest).String(), ociArtifactManifestAcceptHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest for artifact %s@%s: %w", artifact, digest, err)
+	}
+	if sha256Digest(body) != digest {
+		return nil, fmt.Errorf("manifest for artifact %s@%s does not match its digest", artifact, digest)
+	}
+	var manifest ociArtifactManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest for artifact %s@%s: %w", artifact, digest, err)
+	}
+	if len(manifest.Manifests) > 0 {
+		return nil, fmt.Errorf("artifact %s@%s is an image index, which is not supported", artifact, digest)
+	}
+
+	selected := lo.Filter(manifest.Layers, func(layer ociDescriptor, _ int) bool {
+		return len(layers) == 0 || lo.Contains(layers, layer.Annotations[ociImageTitleAnnotation])
+	})
+	if len(layers) > 0 && len(selected) < len(layers) {
+		titles := lo.Map(selected, func(layer ociDescriptor, _ int) string { return layer.Annotations[ociImageTitleAnnotation] })
+		missing, _ := lo.Difference(layers, titles)
+		return nil, fmt.Errorf("layers %v not found in artifact %s@%s", missing, artifact, digest)
+	}
+	totalSize := lo.SumBy(selected, func(layer ociDescriptor) int64 { return layer.Size })
+	if totalSize > maxOciArtifactSize {
+		return nil, fmt.Errorf("artifact %s@%s exceeds the maximum size of %d bytes", artifact, digest, maxOciArtifactSize)
+	}
+
+	files := map[string]ociArtifactFile{}
+	extracted := &extractedSize{remaining: maxOciArtifactSize}
+	registry.maxBodySize = maxOciArtifactSize
+	for _, layer := range selected {
+		blob, err := registry.get(ctx, repoURL.JoinPath("blobs", layer.Digest).String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch layer %s of artifact %s@%s: %w", layer.Digest, artifact, digest, err)
+		}
+		if sha256Digest(blob) != layer.Digest {
+			return nil, fmt.Errorf("layer %s of artifact %s@%s does not match its digest", layer.Digest, artifact, digest)
+		}
+
+		title, hasTitle := layer.Annotations[ociImageTitleAnnotation]
+		if hasTitle && layer.Annotations[orasUnpackAnnotation] != "true" {
+			name, err := cleanArtifactPath(title)
+			if err != nil {
+				return nil, fmt.Errorf("invalid title of layer %s: %w", layer.Digest, err)
+			}
+			if err := extracted.add(int64(len(blob))); err != nil {
+				return nil, err
+			}
+			files[name] = ociArtifactFile{Content: blob, Mode: 0o644}
+			continue
+		}
+		if err := extractTarLayer(blob, files, extracted); err != nil {
+			return nil, fmt.Errorf("failed to extract layer %s of artifact %s@%s: %w", layer.Digest, artifact, digest, err)
+		}
+	}
+	return files, nil
+}
+
+// extractTarLayer extracts the regular files of an optionally gzip-compressed tarball into files.
+// Other entries, such as directories and links, are skipped.
+func extractTarLayer(blob []byte, files map[string]ociArtifactFile, extracted *extractedSize) error {
+	var reader io.Reader = bytes.NewReader(blob)
+	if bytes.HasPrefix(blob, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, err := cleanArtifactPath(header.Name)
+		if err != nil {
+			return fmt.Errorf("invalid file name %q: %w", header.Name, err)
+		}
+		if err := extracted.add(header.Size); err != nil {
+			return err
+		}
+		content, err := io.ReadAll(io.LimitReader(tarReader, header.Size))
+		if err != nil {
+			return err
+		}
+		files[name] = ociArtifactFile{Content: content, Mode: int(header.Mode & 0o7777)}
+	}
+}
+
+// extractedSize tracks the size of the files extracted from an artifact against the maximum.
+type extractedSize struct {
+	remaining int64
+}
+
+func (e *extractedSize) add(size int64) error {
+	e.remaining -= size
+	if e.remaining < 0 {
+		return fmt.Errorf("extracted artifact exceeds the maximum size of %d bytes", maxOciArtifactSize)
+	}
+	return nil
+}
+
+// filterOciArtifactFiles returns the files that are, or are below, one of the given paths. All
+// files are returned if no paths are given.
+func filterOciArtifactFiles(files map[string]ociArtifactFile, paths []string) (map[string]ociArtifactFile, error) {
+	if len(paths) == 0 {
+		return files, nil
+	}
+	filtered := map[string]ociArtifactFile{}
+	for _, p := range paths {
+		cleanPath, err := cleanArtifactPath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", p, err)
+		}
+		found := false
+		for name, file := range files {
+			if name == cleanPath || strings.HasPrefix(name, cleanPath+"/") {
+				filtered[name] = file
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("path %q not found in artifact", p)
+		}
+	}
+	return filtered, nil
+}
+
+// cleanArtifactPath normalizes a path within an artifact to a relative path that can't
+// escape the artifact's root.
+func cleanArtifactPath(p string) (string, error) {
+	cleanPath := strings.TrimPrefix(path.Clean("/"+p), "/")
+	if cleanPath == "" {
+		return "", fmt.Errorf("path must not be empty")
+	}
+	return cleanPath, nil
+}
+
+func isOciDigest(reference string) bool {
+	return strings.Contains(reference, ":")
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// getOciRepoSpec returns the spec of an OCI repository.
+func getOciRepoSpec(repo *domain.Repository) (*domain.OciRepoSpec, error) {
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("failed getting type of repository %s: %w", lo.FromPtr(repo.Metadata.Name), err)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeOci {
+		return nil, fmt.Errorf("repository %s is of type %q, expected %q", lo.FromPtr(repo.Metadata.Name), repoType, domain.RepoSpecTypeOci)
+	}
+	ociSpec, err := repo.Spec.AsOciRepoSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing OCI repository %s: %w", lo.FromPtr(repo.Metadata.Name), err)
+	}
+	return &ociSpec, nil
+}
@@ -0,0 +1,143 @@
+package tasks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o640, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+// newTestArtifactServer serves the artifact "org/config" with tag "v1", made of a layer holding
+// the file "app.conf" and a layer holding a tarball of the "conf.d" directory.
+func newTestArtifactServer(t *testing.T, tarball []byte) (*httptest.Server, string) {
+	appConf := []byte("key=value\n")
+	blobs := map[string][]byte{
+		sha256Digest(appConf): appConf,
+		sha256Digest(tarball): tarball,
+	}
+	manifest, err := json.Marshal(ociArtifactManifest{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Layers: []ociDescriptor{
+			{
+				MediaType:   "application/vnd.oci.image.layer.v1.tar",
+				Digest:      sha256Digest(appConf),
+				Size:        int64(len(appConf)),
+				Annotations: map[string]string{ociImageTitleAnnotation: "app.conf"},
+			},
+			{
+				MediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+				Digest:      sha256Digest(tarball),
+				Size:        int64(len(tarball)),
+				Annotations: map[string]string{ociImageTitleAnnotation: "conf.d", orasUnpackAnnotation: "true"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	manifestDigest := sha256Digest(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/org/config/manifests/v1", "/v2/org/config/manifests/" + manifestDigest:
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = w.Write(manifest)
+			return
+		}
+		for digest, blob := range blobs {
+			if r.URL.Path == "/v2/org/config/blobs/"+digest {
+				_, _ = w.Write(blob)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	return server, manifestDigest
+}
+
+func TestResolveOciArtifactDigest(t *testing.T) {
+	require := require.New(t)
+	server, manifestDigest := newTestArtifactServer(t, newTestTarGz(t, nil))
+
+	digest, err := resolveOciArtifactDigest(context.Background(), newTestOciRepoSpec(server), "org/config", "v1")
+	require.NoError(err)
+	require.Equal(manifestDigest, digest)
+
+	// Digests are returned as is
+	digest, err = resolveOciArtifactDigest(context.Background(), newTestOciRepoSpec(server), "org/config", "sha256:0123")
+	require.NoError(err)
+	require.Equal("sha256:0123", digest)
+
+	_, err = resolveOciArtifactDigest(context.Background(), newTestOciRepoSpec(server), "org/config", "missing")
+	require.Error(err)
+}
+
+func TestFetchOciArtifactFiles(t *testing.T) {
+	require := require.New(t)
+	tarball := newTestTarGz(t, map[string]string{
+		"conf.d/a.conf":           "a",
+		"conf.d/../../etc/passwd": "escaped",
+	})
+	server, manifestDigest := newTestArtifactServer(t, tarball)
+
+	files, err := fetchOciArtifactFiles(context.Background(), newTestOciRepoSpec(server), "org/config", manifestDigest, nil)
+	require.NoError(err)
+	require.Equal(map[string]ociArtifactFile{
+		"app.conf":      {Content: []byte("key=value\n"), Mode: 0o644},
+		"conf.d/a.conf": {Content: []byte("a"), Mode: 0o640},
+		// Paths can't escape the artifact's root
+		"etc/passwd": {Content: []byte("escaped"), Mode: 0o640},
+	}, files)
+
+	files, err = fetchOciArtifactFiles(context.Background(), newTestOciRepoSpec(server), "org/config", manifestDigest, []string{"app.conf"})
+	require.NoError(err)
+	require.Len(files, 1)
+	require.Contains(files, "app.conf")
+
+	_, err = fetchOciArtifactFiles(context.Background(), newTestOciRepoSpec(server), "org/config", manifestDigest, []string{"missing"})
+	require.ErrorContains(err, "missing")
+
+	_, err = fetchOciArtifactFiles(context.Background(), newTestOciRepoSpec(server), "org/config", sha256Digest([]byte("other")), nil)
+	require.Error(err)
+}
+
+func TestFilterOciArtifactFiles(t *testing.T) {
+	require := require.New(t)
+	files := map[string]ociArtifactFile{
+		"app.conf":        {Content: []byte("app")},
+		"conf.d/a.conf":   {Content: []byte("a")},
+		"conf.d/b.conf":   {Content: []byte("b")},
+		"conf.dist/c.txt": {Content: []byte("c")},
+	}
+
+	filtered, err := filterOciArtifactFiles(files, nil)
+	require.NoError(err)
+	require.Equal(files, filtered)
+
+	filtered, err = filterOciArtifactFiles(files, []string{"conf.d", "/app.conf"})
+	require.NoError(err)
+	require.ElementsMatch([]string{"app.conf", "conf.d/a.conf", "conf.d/b.conf"}, lo.Keys(filtered))
+
+	_, err = filterOciArtifactFiles(files, []string{"missing"})
+	require.ErrorContains(err, "not found")
+}
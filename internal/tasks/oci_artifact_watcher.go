@@ -0,0 +1,166 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The OciArtifactWatcher periodically resolves the tags of the OCI artifacts referenced by the
+// configuration of an organization's devices. The digest last seen for each tag is kept in the KV
+// store, and when a tag moves to a new digest, a ReferencedRepositoryUpdated event is emitted for
+// every device referencing it so that the device is rendered again.
+//
+// References by digest never move and are not watched. The first time a tag is seen its digest is
+// only recorded, as the devices referencing it already rendered the digest current at the time.
+type OciArtifactWatcher struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	kvStore        kvstore.KVStore
+}
+
+func NewOciArtifactWatcher(log logrus.FieldLogger, serviceHandler service.Service, kvStore kvstore.KVStore) *OciArtifactWatcher {
+	return &OciArtifactWatcher{
+		log:            log,
+		serviceHandler: serviceHandler,
+		kvStore:        kvStore,
+	}
+}
+
+type artifactRef struct {
+	repository string
+	artifact   string
+	reference  string
+}
+
+func (r artifactRef) String() string {
+	return r.repository + "/" + r.artifact + ":" + r.reference
+}
+
+func (w *OciArtifactWatcher) Poll(ctx context.Context, orgId uuid.UUID) {
+	log := log.WithReqIDFromCtx(ctx, w.log)
+
+	log.Info("Running OciArtifactWatcher")
+
+	referers, err := w.listArtifactReferers(ctx, orgId)
+	if err != nil {
+		log.Errorf("error fetching devices: %v", err)
+		return
+	}
+
+	refs := lo.Keys(referers)
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].String() < refs[j].String()
+	})
+	ociSpecs := map[string]*domain.OciRepoSpec{}
+	for _, ref := range refs {
+		ociSpec, ok := ociSpecs[ref.repository]
+		if !ok {
+			ociSpec = w.getOciRepoSpec(ctx, log, orgId, ref.repository)
+			ociSpecs[ref.repository] = ociSpec
+		}
+		if ociSpec == nil {
+			continue
+		}
+		if w.artifactTagMoved(ctx, log, orgId, ociSpec, ref) {
+			for _, deviceName := range referers[ref] {
+				w.serviceHandler.CreateEvent(ctx, orgId, servicecommon.GetReferencedRepositoryUpdatedEvent(ctx, domain.DeviceKind, deviceName, ref.repository))
+			}
+		}
+	}
+}
+
+// listArtifactReferers returns the names of the devices referencing each artifact tag.
+func (w *OciArtifactWatcher) listArtifactReferers(ctx context.Context, orgId uuid.UUID) (map[artifactRef][]string, error) {
+	referers := map[artifactRef][]string{}
+	listParams := domain.ListDevicesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		devices, status := w.serviceHandler.ListDevices(ctx, orgId, listParams, nil)
+		if status.Code != http.StatusOK {
+			return nil, service.ApiStatusToErr(status)
+		}
+
+		for _, device := range devices.Items {
+			if device.Spec == nil || device.Spec.Config == nil {
+				continue
+			}
+			for _, configItem := range *device.Spec.Config {
+				configType, err := configItem.Type()
+				if err != nil || configType != domain.OciConfigProviderType {
+					continue
+				}
+				ociSpec, err := configItem.AsOciConfigProviderSpec()
+				if err != nil || isOciDigest(ociSpec.OciRef.Reference) {
+					continue
+				}
+				ref := artifactRef{repository: ociSpec.OciRef.Repository, artifact: ociSpec.OciRef.Artifact, reference: ociSpec.OciRef.Reference}
+				referers[ref] = append(referers[ref], lo.FromPtr(device.Metadata.Name))
+			}
+		}
+
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+	return referers, nil
+}
+
+func (w *OciArtifactWatcher) getOciRepoSpec(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, repositoryName string) *domain.OciRepoSpec {
+	repo, status := w.serviceHandler.GetRepository(ctx, orgId, repositoryName)
+	if status.Code != http.StatusOK {
+		log.Warnf("failed fetching repository %s: %s", repositoryName, status.Message)
+		return nil
+	}
+	ociSpec, err := getOciRepoSpec(repo)
+	if err != nil {
+		log.Warnf("%v", err)
+		return nil
+	}
+	return ociSpec
+}
+
+// artifactTagMoved resolves the tag to its current digest, records it as the last digest seen,
+// and returns true if it differs from the previously recorded digest.
+func (w *OciArtifactWatcher) artifactTagMoved(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, ociSpec *domain.OciRepoSpec, ref artifactRef) bool {
+	digest, err := resolveOciArtifactDigest(ctx, ociSpec, ref.artifact, ref.reference)
+	if err != nil {
+		log.Warnf("failed resolving artifact %s: %v", ref, err)
+		return false
+	}
+
+	key := kvstore.OciArtifactDigestKey{OrgID: orgId, Repository: ref.repository, Artifact: ref.artifact, Reference: ref.reference}
+	lastSeen, err := w.kvStore.Get(ctx, key.ComposeKey())
+	if err != nil {
+		log.Errorf("failed fetching last seen digest of artifact %s: %v", ref, err)
+		return false
+	}
+	if string(lastSeen) == digest {
+		return false
+	}
+
+	if lastSeen != nil {
+		if err := w.kvStore.Delete(ctx, key.ComposeKey()); err != nil {
+			log.Errorf("failed deleting last seen digest of artifact %s: %v", ref, err)
+			return false
+		}
+	}
+	if _, err := w.kvStore.SetNX(ctx, key.ComposeKey(), []byte(digest)); err != nil {
+		log.Errorf("failed storing digest of artifact %s: %v", ref, err)
+		return false
+	}
+	if lastSeen == nil {
+		return false
+	}
+	log.Infof("artifact %s moved from %s to %s", ref, lastSeen, digest)
+	return true
+}
@@ -0,0 +1,154 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The repositoryPush task is triggered when a git webhook reports a push to a repository.
+// Instead of waiting for the next periodic poll, it immediately syncs the ResourceSyncs that
+// track the pushed revision of the repository, and notifies the fleets and the devices not
+// owned by a fleet whose git configuration tracks it, so that they are re-rendered. Devices
+// owned by a fleet are updated through the rollout of the fleet's new template version.
+//
+// This task is idempotent: ResourceSyncs skip commits they have already synced, and the
+// notifications are safely repeatable.
+
+func repositoryPush(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, cfg *config.Config, log logrus.FieldLogger) error {
+	logic := NewRepositoryPushLogic(log, serviceHandler, cfg, orgId, event)
+
+	if err := logic.HandleRepositoryPush(ctx); err != nil {
+		log.Errorf("failed to handle push to repository %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+type RepositoryPushLogic struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	cfg            *config.Config
+	orgId          uuid.UUID
+	event          domain.Event
+}
+
+func NewRepositoryPushLogic(log logrus.FieldLogger, serviceHandler service.Service, cfg *config.Config, orgId uuid.UUID, event domain.Event) RepositoryPushLogic {
+	return RepositoryPushLogic{log: log, serviceHandler: serviceHandler, cfg: cfg, orgId: orgId, event: event}
+}
+
+func (t *RepositoryPushLogic) HandleRepositoryPush(ctx context.Context) error {
+	if t.event.Details == nil {
+		return fmt.Errorf("event has no details")
+	}
+	details, err := t.event.Details.AsRepositoryPushReceivedDetails()
+	if err != nil {
+		return fmt.Errorf("parsing event details: %w", err)
+	}
+	repoName := t.event.InvolvedObject.Name
+
+	if err := t.syncResourceSyncs(ctx, repoName, details.Ref); err != nil {
+		return err
+	}
+
+	fleets, status := t.serviceHandler.GetRepositoryFleetReferences(ctx, t.orgId, repoName)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching fleets: %s", status.Message)
+	}
+	for _, fleet := range fleets.Items {
+		if fleet.Spec.Template.Spec.Config == nil || !tracksRevision(*fleet.Spec.Template.Spec.Config, repoName, details.Ref) {
+			continue
+		}
+		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetReferencedRepositoryUpdatedEvent(ctx, domain.FleetKind, *fleet.Metadata.Name, repoName))
+	}
+
+	devices, status := t.serviceHandler.GetRepositoryDeviceReferences(ctx, t.orgId, repoName)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching devices: %s", status.Message)
+	}
+	for _, device := range devices.Items {
+		if device.Metadata.Owner != nil || device.Spec == nil || device.Spec.Config == nil || !tracksRevision(*device.Spec.Config, repoName, details.Ref) {
+			continue
+		}
+		t.serviceHandler.CreateEvent(ctx, t.orgId, servicecommon.GetReferencedRepositoryUpdatedEvent(ctx, domain.DeviceKind, *device.Metadata.Name, repoName))
+	}
+
+	return nil
+}
+
+// syncResourceSyncs syncs the ResourceSyncs that track the pushed revision of the repository.
+func (t *RepositoryPushLogic) syncResourceSyncs(ctx context.Context, repoName, ref string) error {
+	var ignoreResourceUpdates []string
+	if t.cfg != nil && t.cfg.GitOps != nil {
+		ignoreResourceUpdates = t.cfg.GitOps.IgnoreResourceUpdates
+	}
+	resourceSync := NewResourceSync(t.serviceHandler, t.log, t.cfg, ignoreResourceUpdates)
+
+	params := domain.ListResourceSyncsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		list, status := t.serviceHandler.ListResourceSyncs(ctx, t.orgId, params)
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("fetching resourcesyncs: %s", status.Message)
+		}
+		for i := range list.Items {
+			rs := &list.Items[i]
+			if rs.Spec.Repository != repoName || !revisionMatchesRef(rs.Spec.TargetRevision, ref) {
+				continue
+			}
+			t.log.Infof("resourcesync/%s: syncing after push to %s", lo.FromPtr(rs.Metadata.Name), ref)
+			if err := resourceSync.run(ctx, t.log, t.orgId, rs); err != nil {
+				t.log.Errorf("resourcesync/%s: error during run: %v", lo.FromPtr(rs.Metadata.Name), err)
+			}
+		}
+		if list.Metadata.Continue == nil {
+			return nil
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// tracksRevision returns true if any of the git config providers reads the pushed revision of
+// the repository.
+func tracksRevision(configs []domain.ConfigProviderSpec, repoName, ref string) bool {
+	for _, c := range configs {
+		if t, err := c.Type(); err != nil || t != domain.GitConfigProviderType {
+			continue
+		}
+		gitSpec, err := c.AsGitConfigProviderSpec()
+		if err != nil {
+			continue
+		}
+		if gitSpec.GitRef.Repository != repoName {
+			continue
+		}
+		// A templated revision can't be resolved without a device, so assume it may match
+		if strings.Contains(gitSpec.GitRef.TargetRevision, "{{") || revisionMatchesRef(gitSpec.GitRef.TargetRevision, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// revisionMatchesRef returns true if the revision names the pushed git reference, either in
+// full (refs/heads/main) or by its short name (main).
+func revisionMatchesRef(revision, ref string) bool {
+	if revision == ref {
+		return true
+	}
+	for _, prefix := range []string{"refs/heads/", "refs/tags/"} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok && revision == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newGitConfig(t *testing.T, repository, revision string) []domain.ConfigProviderSpec {
+	var spec domain.GitConfigProviderSpec
+	spec.Name = "config"
+	spec.GitRef.Repository = repository
+	spec.GitRef.TargetRevision = revision
+	spec.GitRef.Path = "/etc"
+	config := domain.ConfigProviderSpec{}
+	require.NoError(t, config.FromGitConfigProviderSpec(spec))
+	return []domain.ConfigProviderSpec{config}
+}
+
+func TestRevisionMatchesRef(t *testing.T) {
+	require := require.New(t)
+
+	require.True(revisionMatchesRef("main", "refs/heads/main"))
+	require.True(revisionMatchesRef("refs/heads/main", "refs/heads/main"))
+	require.True(revisionMatchesRef("v1.0", "refs/tags/v1.0"))
+	require.False(revisionMatchesRef("main", "refs/heads/develop"))
+	require.False(revisionMatchesRef("0123abcd", "refs/heads/main"))
+}
+
+func TestRepositoryPushLogic_NotifiesTrackingResources(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockSvc := service.NewMockService(ctrl)
+	orgId := uuid.New()
+	ctx := context.Background()
+
+	event := servicecommon.GetRepositoryPushReceivedEvent(ctx, "configs", "refs/heads/main", "0123abcd")
+	logic := NewRepositoryPushLogic(logrus.New(), mockSvc, nil, orgId, *event)
+
+	// A ResourceSync tracking another branch isn't synced
+	mockSvc.EXPECT().ListResourceSyncs(gomock.Any(), orgId, gomock.Any()).Return(&domain.ResourceSyncList{
+		Items: []domain.ResourceSync{{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("rs")},
+			Spec:     domain.ResourceSyncSpec{Repository: "configs", TargetRevision: "develop", Path: "/"},
+		}},
+	}, domain.StatusOK())
+
+	newFleet := func(name, revision string) domain.Fleet {
+		fleet := domain.Fleet{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)}}
+		fleet.Spec.Template.Spec.Config = lo.ToPtr(newGitConfig(t, "configs", revision))
+		return fleet
+	}
+	mockSvc.EXPECT().GetRepositoryFleetReferences(gomock.Any(), orgId, "configs").Return(&domain.FleetList{
+		Items: []domain.Fleet{newFleet("tracking", "main"), newFleet("pinned", "v1.0")},
+	}, domain.StatusOK())
+
+	newDevice := func(name string, owner *string) domain.Device {
+		return domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Owner: owner},
+			Spec:     &domain.DeviceSpec{Config: lo.ToPtr(newGitConfig(t, "configs", "main"))},
+		}
+	}
+	mockSvc.EXPECT().GetRepositoryDeviceReferences(gomock.Any(), orgId, "configs").Return(&domain.DeviceList{
+		Items: []domain.Device{newDevice("standalone", nil), newDevice("fleet-member", lo.ToPtr("Fleet/tracking"))},
+	}, domain.StatusOK())
+
+	var notified []string
+	mockSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+		require.Equal(domain.EventReasonReferencedRepositoryUpdated, event.Reason)
+		notified = append(notified, event.InvolvedObject.Kind+"/"+event.InvolvedObject.Name)
+	}).Times(2)
+
+	require.NoError(logic.HandleRepositoryPush(ctx))
+	require.ElementsMatch([]string{"Fleet/tracking", "Device/standalone"}, notified)
+}
@@ -215,32 +215,9 @@ func (r *OciRepoTester) TestAccess(repository *domain.Repository) error {
 	}
 	v2URL := baseURL.JoinPath("/v2/").String()
 
-	tlsConfig := &tls.Config{
-		MinVersion: tls.VersionTLS12,
-	}
-	if ociSpec.SkipServerVerification != nil {
-		tlsConfig.InsecureSkipVerify = *ociSpec.SkipServerVerification
-	}
-	if ociSpec.CaCrt != nil {
-		ca, err := base64.StdEncoding.DecodeString(*ociSpec.CaCrt)
-		if err != nil {
-			return fmt.Errorf("failed to decode CA certificate: %w", err)
-		}
-		rootCAs, err := x509.SystemCertPool()
-		if err != nil {
-			return fmt.Errorf("failed to get system cert pool: %w", err)
-		}
-		if rootCAs == nil {
-			rootCAs = x509.NewCertPool()
-		}
-		rootCAs.AppendCertsFromPEM(ca)
-		tlsConfig.RootCAs = rootCAs
-	}
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-		},
+	client, err := newOciRegistryClient(&ociSpec)
+	if err != nil {
+		return err
 	}
 
 	// Step 1: Call /v2/ without auth (OCI Distribution Spec)
@@ -273,6 +250,37 @@ func (r *OciRepoTester) TestAccess(repository *domain.Repository) error {
 	return r.authenticateDocker(client, v2URL, resp, nil)
 }
 
+// newOciRegistryClient returns an HTTP client configured with the TLS settings of the OCI repository spec
+func newOciRegistryClient(ociSpec *domain.OciRepoSpec) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if ociSpec.SkipServerVerification != nil {
+		tlsConfig.InsecureSkipVerify = *ociSpec.SkipServerVerification
+	}
+	if ociSpec.CaCrt != nil {
+		ca, err := base64.StdEncoding.DecodeString(*ociSpec.CaCrt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode CA certificate: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get system cert pool: %w", err)
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		rootCAs.AppendCertsFromPEM(ca)
+		tlsConfig.RootCAs = rootCAs
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
 // authenticateDocker performs Docker registry token-based authentication (Bearer token exchange)
 func (r *OciRepoTester) authenticateDocker(client *http.Client, v2URL string, initialResp *http.Response, ociAuth *domain.OciAuth) error {
 	// Docker registries return 401 with Www-Authenticate header for token exchange
@@ -299,7 +307,7 @@ func (r *OciRepoTester) authenticateDocker(client *http.Client, v2URL string, in
 	}
 
 	// Get token from auth endpoint
-	token, err := r.getToken(client, realm, service, username, password)
+	token, err := r.getToken(client, realm, service, "repository:"+uuid.New().String()+":pull", username, password)
 	if err != nil {
 		return err
 	}
@@ -348,8 +356,8 @@ func parseWwwAuthenticate(header string) (realm, service string, err error) {
 	return realm, service, nil
 }
 
-// getToken gets an auth token from the registry's auth endpoint
-func (r *OciRepoTester) getToken(client *http.Client, realm, service string, username, password *string) (string, error) {
+// getToken gets an auth token for the given scope from the registry's auth endpoint
+func (r *OciRepoTester) getToken(client *http.Client, realm, service, scope string, username, password *string) (string, error) {
 	// Parse the realm URL
 	authURL, err := url.Parse(realm)
 	if err != nil {
@@ -362,7 +370,7 @@ func (r *OciRepoTester) getToken(client *http.Client, realm, service string, use
 		query.Set("service", service)
 	}
 
-	query.Set("scope", "repository:"+uuid.New().String()+":pull")
+	query.Set("scope", scope)
 	authURL.RawQuery = query.Encode()
 
 	req, err := http.NewRequest("GET", authURL.String(), nil)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/config"
@@ -35,7 +36,7 @@ type ResourceSync struct {
 type GenericResourceMap map[string]interface{}
 
 var validFileExtensions = []string{"json", "yaml", "yml"}
-var supportedResources = []string{domain.FleetKind, domain.CatalogKind, domain.CatalogItemKind}
+var supportedResources = []string{domain.FleetKind, domain.CatalogKind, domain.CatalogItemKind, domain.RepositoryKind, domain.SecretStoreKind, domain.AuthProviderKind, domain.DeviceKind}
 
 func NewResourceSync(serviceHandler service.Service, log logrus.FieldLogger, cfg *config.Config, ignoreResourceUpdates []string) *ResourceSync {
 	return &ResourceSync{
@@ -100,10 +101,22 @@ func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, orgId uu
 		return nil
 	}
 
-	syncType := domain.ResourceSyncTypeFleet
-	if rs.Spec.Type != nil {
-		syncType = *rs.Spec.Type
+	// Resources of the kinds synced by default by a ResourceSync of another type mean the
+	// ResourceSync has the wrong type, and resources of kinds that can't be managed by a
+	// ResourceSync of its type are rejected. Resources of kinds that aren't managed are skipped.
+	syncType := rs.Spec.GetType()
+	if unexpected := unexpectedKinds(filterByKinds(resources, otherTypesDefaultKinds(syncType)...)); len(unexpected) > 0 {
+		err := fmt.Errorf("resource %s: sync type is %s but found unexpected kind(s): %v", resourceName, syncType, unexpected)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
+		return err
+	}
+	if unsupported := unexpectedKinds(resources, syncType.ManageableKinds()...); len(unsupported) > 0 {
+		err := fmt.Errorf("resource %s: found kind(s) %v that can't be managed by a resource sync of type %s, must be one of %v", resourceName, unsupported, syncType, syncType.ManageableKinds())
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
+		return err
 	}
+	resources = filterByKinds(resources, rs.Spec.ManagedKinds()...)
+	rs.Status.Plan = nil
 
 	switch syncType {
 	case domain.ResourceSyncTypeFleet:
@@ -115,16 +128,21 @@ func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, orgId uu
 	}
 }
 
+// fleetDependencyKinds lists the kinds that fleets may reference. They are applied before the
+// fleets and deleted after them.
+var fleetDependencyKinds = []string{domain.RepositoryKind, domain.SecretStoreKind, domain.AuthProviderKind}
+
+// fleetDependentKinds lists the kinds that may reference fleets. They are applied after the
+// fleets and deleted before them.
+var fleetDependentKinds = []string{domain.EnrollmentApprovalPolicyKind, domain.DeviceKind}
+
 func (r *ResourceSync) syncFleetResources(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, resourceName string) error {
 	fleetResources := filterByKind(resources, domain.FleetKind)
 
-	if unexpected := unexpectedKinds(resources, domain.FleetKind); len(unexpected) > 0 {
-		err := fmt.Errorf("resource %s: sync type is fleet but found unexpected kind(s): %v", resourceName, unexpected)
-		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
-		return err
-	}
-
 	fleets, err := r.parseFleets(fleetResources)
+	if err == nil {
+		err = r.validateOwnedKinds(ctx, resources)
+	}
 	if err != nil {
 		parseErr := fmt.Errorf("resource %s: error: %w", resourceName, err)
 		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", parseErr)
@@ -133,9 +151,31 @@ func (r *ResourceSync) syncFleetResources(ctx context.Context, log logrus.FieldL
 	}
 	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", nil)
 
+	if lo.FromPtr(rs.Spec.DryRun) {
+		return r.planResources(ctx, log, orgId, rs, resources, resourceName)
+	}
+
+	toRemove := map[string][]string{}
+	for _, kind := range fleetDependencyKinds {
+		if toRemove[kind], err = r.syncOwnedKind(ctx, log, orgId, rs, kind, filterByKind(resources, kind), resourceName); err != nil {
+			return err
+		}
+	}
 	if err := r.SyncFleets(ctx, log, orgId, rs, fleets, resourceName); err != nil {
 		return err
 	}
+	for _, kind := range fleetDependentKinds {
+		if toRemove[kind], err = r.syncOwnedKind(ctx, log, orgId, rs, kind, filterByKind(resources, kind), resourceName); err != nil {
+			return err
+		}
+	}
+
+	for _, kind := range append(slices.Clone(fleetDependentKinds), lo.Reverse(slices.Clone(fleetDependencyKinds))...) {
+		if err := r.deleteStaleOwnedKind(ctx, log, orgId, rs, kind, toRemove[kind], resourceName); err != nil {
+			return err
+		}
+	}
+
 	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", nil)
 	rs.Status.ObservedGeneration = rs.Metadata.Generation
 	return nil
@@ -145,12 +185,6 @@ func (r *ResourceSync) syncCatalogResources(ctx context.Context, log logrus.Fiel
 	catalogResources := filterByKind(resources, domain.CatalogKind)
 	itemResources := filterByKind(resources, domain.CatalogItemKind)
 
-	if unexpected := unexpectedKinds(resources, domain.CatalogKind, domain.CatalogItemKind); len(unexpected) > 0 {
-		err := fmt.Errorf("resource %s: sync type is catalog but found unexpected kind(s): %v", resourceName, unexpected)
-		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
-		return err
-	}
-
 	catalogs, err := r.parseCatalogs(catalogResources)
 	if err != nil {
 		parseErr := fmt.Errorf("resource %s: error: %w", resourceName, err)
@@ -168,6 +202,10 @@ func (r *ResourceSync) syncCatalogResources(ctx context.Context, log logrus.Fiel
 	}
 	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", nil)
 
+	if lo.FromPtr(rs.Spec.DryRun) {
+		return r.planResources(ctx, log, orgId, rs, resources, resourceName)
+	}
+
 	catalogsToRemove, err := r.SyncCatalogs(ctx, log, orgId, rs, catalogs, resourceName)
 	if err != nil {
 		return err
@@ -189,6 +227,17 @@ func (r *ResourceSync) syncCatalogResources(ctx context.Context, log logrus.Fiel
 	return nil
 }
 
+// otherTypesDefaultKinds returns the kinds synced by default by ResourceSyncs of other types.
+func otherTypesDefaultKinds(syncType domain.ResourceSyncType) []string {
+	var kinds []string
+	for _, t := range []domain.ResourceSyncType{domain.ResourceSyncTypeFleet, domain.ResourceSyncTypeCatalog} {
+		if t != syncType {
+			kinds = append(kinds, t.DefaultKinds()...)
+		}
+	}
+	return kinds
+}
+
 // unexpectedKinds returns any resource kinds not in the allowed set.
 func unexpectedKinds(resources []GenericResourceMap, allowed ...string) []string {
 	allowedSet := make(map[string]struct{}, len(allowed))
@@ -421,7 +470,14 @@ func (r *ResourceSync) parseAndValidateResources(rs *domain.ResourceSync, repo *
 		return nil, err
 	}
 	var resources []GenericResourceMap
-	if fileInfo.IsDir() {
+	rs.Status.RenderedResources = nil
+	if fileInfo.IsDir() && hasKustomization(mfs, path) {
+		resources, err = r.renderKustomization(mfs, path)
+		if err == nil {
+			rs.Status.RenderedResources = lo.ToPtr(renderedResourcesToYAML(resources))
+			resources = r.filterSupportedResources(resources)
+		}
+	} else if fileInfo.IsDir() {
 		resources, err = r.extractResourcesFromDir(mfs, path)
 	} else {
 		resources, err = r.extractResourcesFromFile(mfs, path)
@@ -435,6 +491,37 @@ func (r *ResourceSync) parseAndValidateResources(rs *domain.ResourceSync, repo *
 }
 
 func (r *ResourceSync) extractResourcesFromDir(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
+	resources, err := decodeResourceDir(mfs, path)
+	if err != nil {
+		return nil, err
+	}
+	return r.filterSupportedResources(resources), nil
+}
+
+func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
+	resources, err := decodeResourceFile(mfs, path)
+	if err != nil {
+		return nil, err
+	}
+	return r.filterSupportedResources(resources), nil
+}
+
+// filterSupportedResources returns the resources of supported kinds, without the ignored fields.
+func (r *ResourceSync) filterSupportedResources(resources []GenericResourceMap) []GenericResourceMap {
+	genericResources := []GenericResourceMap{}
+	for _, resource := range resources {
+		kind, _ := resource["kind"].(string)
+		if !slices.Contains(supportedResources, kind) {
+			// Skip unsupported kinds to allow mixed-content repos
+			continue
+		}
+		resource = RemoveIgnoredFields(resource, r.ignoreResourceUpdates)
+		genericResources = append(genericResources, resource)
+	}
+	return genericResources
+}
+
+func decodeResourceDir(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
 	genericResources := []GenericResourceMap{}
 	files, err := mfs.ReadDir(path)
 	if err != nil {
@@ -443,13 +530,13 @@ func (r *ResourceSync) extractResourcesFromDir(mfs billy.Filesystem, path string
 	for _, file := range files {
 		fullPath := mfs.Join(path, file.Name())
 		if file.IsDir() {
-			subResources, err := r.extractResourcesFromDir(mfs, fullPath)
+			subResources, err := decodeResourceDir(mfs, fullPath)
 			if err != nil {
 				return nil, err
 			}
 			genericResources = append(genericResources, subResources...)
-		} else if isValidFile(file.Name()) {
-			resources, err := r.extractResourcesFromFile(mfs, fullPath)
+		} else if isValidFile(file.Name()) && !isKustomizationFile(file.Name()) {
+			resources, err := decodeResourceFile(mfs, fullPath)
 			if err != nil {
 				return nil, err
 			}
@@ -459,7 +546,7 @@ func (r *ResourceSync) extractResourcesFromDir(mfs billy.Filesystem, path string
 	return genericResources, nil
 }
 
-func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
+func decodeResourceFile(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
 	genericResources := []GenericResourceMap{}
 
 	file, err := mfs.Open(path)
@@ -476,7 +563,7 @@ func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path strin
 		if err != nil {
 			break
 		}
-		kind, kindok := resource["kind"].(string)
+		_, kindok := resource["kind"].(string)
 		meta, metaok := resource["metadata"].(map[string]interface{})
 		if !kindok || !metaok {
 			return nil, fmt.Errorf("invalid resource definition at '%s'", path)
@@ -485,19 +572,6 @@ func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path strin
 		if !nameok {
 			return nil, fmt.Errorf("invalid resource definition at '%s'. resource name missing", path)
 		}
-		isSupportedResource := false
-		for _, supportedResource := range supportedResources {
-			if kind == supportedResource {
-				isSupportedResource = true
-				break
-			}
-		}
-		if !isSupportedResource {
-			// Skip unsupported kinds to allow mixed-content repos
-			continue
-		}
-
-		resource = RemoveIgnoredFields(resource, r.ignoreResourceUpdates)
 		genericResources = append(genericResources, resource)
 	}
 	if !errors.Is(err, io.EOF) {
@@ -633,6 +707,14 @@ func filterByKind(resources []GenericResourceMap, kind string) []GenericResource
 	return filtered
 }
 
+// filterByKinds returns the resources of any of the given kinds.
+func filterByKinds(resources []GenericResourceMap, kinds ...string) []GenericResourceMap {
+	return lo.Filter(resources, func(r GenericResourceMap, _ int) bool {
+		k, ok := r["kind"].(string)
+		return ok && slices.Contains(kinds, k)
+	})
+}
+
 func (r *ResourceSync) parseCatalogs(resources []GenericResourceMap) ([]*domain.Catalog, error) {
 	catalogs := make([]*domain.Catalog, 0)
 	names := make(map[string]bool)
@@ -0,0 +1,703 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ownedKind describes how a ResourceSync manages the resources of a kind. Resources are
+// identified by a key, which is their name, or "catalog/name" for CatalogItems.
+type ownedKind struct {
+	// getOwner returns the owner of the resource with the given key, and whether it exists
+	getOwner func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error)
+	// listOwned returns the keys of the resources owned by the given owner
+	listOwned func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error)
+	// validate validates a resource read from the repository. Nil for the kinds synced by
+	// SyncFleets, SyncCatalogs and SyncCatalogItems, as are apply and remove.
+	validate func(ctx context.Context, resource GenericResourceMap) error
+	// apply creates or replaces the resource and makes the given owner its owner
+	apply func(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error
+	// remove deletes the resource with the given key
+	remove func(ctx context.Context, orgId uuid.UUID, key string) error
+	// existingOnly is set for kinds whose resources are never created, only updated if they exist
+	existingOnly bool
+	// adoptUnowned is set for kinds whose existing resources without an owner are taken over by
+	// the ResourceSync. Existing resources of the other kinds are only updated if it owns them.
+	adoptUnowned bool
+}
+
+// conflicts reports whether an existing resource with the given owner can't be managed by the
+// ResourceSync with the given owner.
+func (k ownedKind) conflicts(existingOwner *string, exists bool, owner string) bool {
+	if !exists {
+		return false
+	}
+	if existingOwner == nil {
+		return !k.existingOnly && !k.adoptUnowned
+	}
+	return *existingOwner != owner
+}
+
+// reservedDeviceAnnotationPrefixes lists the prefixes of the device annotations that are managed
+// by the service and can't be set by a ResourceSync.
+var reservedDeviceAnnotationPrefixes = []string{"device-controller/", "fleet-controller/", "resourcesync-controller/"}
+
+// managedDeviceMetadata records the labels and annotations of a device that a ResourceSync
+// manages, so that they are removed when they are removed from the repository.
+type managedDeviceMetadata struct {
+	Owner       string   `json:"owner"`
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+func (r *ResourceSync) ownedKinds() map[string]ownedKind {
+	return map[string]ownedKind{
+		domain.FleetKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				fleet, status := r.serviceHandler.GetFleet(ctx, orgId, key, domain.GetFleetParams{})
+				return ownerOf(fleet, status, func(f *domain.Fleet) *string { return f.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListFleetsParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListFleets(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(f domain.Fleet, _ int) string { return lo.FromPtr(f.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			adoptUnowned: true,
+		},
+		domain.CatalogKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				catalog, status := r.serviceHandler.GetCatalog(ctx, orgId, key)
+				return ownerOf(catalog, status, func(c *domain.Catalog) *string { return c.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListCatalogsParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListCatalogs(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(c domain.Catalog, _ int) string { return lo.FromPtr(c.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			adoptUnowned: true,
+		},
+		domain.CatalogItemKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				catalogName, itemName, _ := strings.Cut(key, "/")
+				item, status := r.serviceHandler.GetCatalogItem(ctx, orgId, catalogName, itemName)
+				return ownerOf(item, status, func(i *domain.CatalogItem) *string { return i.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListAllCatalogItemsParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListAllCatalogItems(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(i domain.CatalogItem, _ int) string {
+						return fmt.Sprintf("%s/%s", i.Metadata.Catalog, lo.FromPtr(i.Metadata.Name))
+					}), list.Metadata.Continue, status
+				})
+			},
+			adoptUnowned: true,
+		},
+		domain.RepositoryKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				repo, status := r.serviceHandler.GetRepository(ctx, orgId, key)
+				return ownerOf(repo, status, func(r *domain.Repository) *string { return r.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListRepositoriesParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListRepositories(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(r domain.Repository, _ int) string { return lo.FromPtr(r.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			validate: func(ctx context.Context, resource GenericResourceMap) error {
+				repo, err := decodeResource[domain.Repository](resource)
+				if err != nil {
+					return err
+				}
+				return errors.Join(repo.Validate()...)
+			},
+			apply: func(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error {
+				repo, err := decodeResource[domain.Repository](resource)
+				if err != nil {
+					return err
+				}
+				name := lo.FromPtr(repo.Metadata.Name)
+				updated, status := r.serviceHandler.ReplaceRepository(resourceSyncRequestCtx(ctx), orgId, name, *repo)
+				if err := replaceStatusToErr(domain.RepositoryKind, status); err != nil {
+					return err
+				}
+				if updated != nil && lo.FromPtr(updated.Metadata.Owner) != owner {
+					updated.Metadata.Owner = &owner
+					_, status = r.serviceHandler.ReplaceRepository(ctx, orgId, name, *updated)
+					return service.ApiStatusToErr(status)
+				}
+				return nil
+			},
+			remove: func(ctx context.Context, orgId uuid.UUID, key string) error {
+				return service.ApiStatusToErr(r.serviceHandler.DeleteRepository(ctx, orgId, key))
+			},
+		},
+		domain.SecretStoreKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				secretStore, status := r.serviceHandler.GetSecretStore(ctx, orgId, key)
+				return ownerOf(secretStore, status, func(s *domain.SecretStore) *string { return s.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListSecretStoresParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListSecretStores(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(s domain.SecretStore, _ int) string { return lo.FromPtr(s.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			validate: func(ctx context.Context, resource GenericResourceMap) error {
+				secretStore, err := decodeResource[domain.SecretStore](resource)
+				if err != nil {
+					return err
+				}
+				return errors.Join(secretStore.Validate()...)
+			},
+			apply: func(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error {
+				secretStore, err := decodeResource[domain.SecretStore](resource)
+				if err != nil {
+					return err
+				}
+				name := lo.FromPtr(secretStore.Metadata.Name)
+				updated, status := r.serviceHandler.ReplaceSecretStore(resourceSyncRequestCtx(ctx), orgId, name, *secretStore)
+				if err := replaceStatusToErr(domain.SecretStoreKind, status); err != nil {
+					return err
+				}
+				if updated != nil && lo.FromPtr(updated.Metadata.Owner) != owner {
+					updated.Metadata.Owner = &owner
+					_, status = r.serviceHandler.ReplaceSecretStore(ctx, orgId, name, *updated)
+					return service.ApiStatusToErr(status)
+				}
+				return nil
+			},
+			remove: func(ctx context.Context, orgId uuid.UUID, key string) error {
+				return service.ApiStatusToErr(r.serviceHandler.DeleteSecretStore(ctx, orgId, key))
+			},
+		},
+		domain.AuthProviderKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				authProvider, status := r.serviceHandler.GetAuthProvider(ctx, orgId, key)
+				return ownerOf(authProvider, status, func(a *domain.AuthProvider) *string { return a.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListAuthProvidersParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListAuthProviders(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(a domain.AuthProvider, _ int) string { return lo.FromPtr(a.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			validate: func(ctx context.Context, resource GenericResourceMap) error {
+				authProvider, err := decodeResource[domain.AuthProvider](resource)
+				if err != nil {
+					return err
+				}
+				return errors.Join(authProvider.Validate(ctx)...)
+			},
+			apply: func(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error {
+				authProvider, err := decodeResource[domain.AuthProvider](resource)
+				if err != nil {
+					return err
+				}
+				name := lo.FromPtr(authProvider.Metadata.Name)
+				updated, status := r.serviceHandler.ReplaceAuthProvider(resourceSyncRequestCtx(ctx), orgId, name, *authProvider)
+				if err := replaceStatusToErr(domain.AuthProviderKind, status); err != nil {
+					return err
+				}
+				if updated != nil && lo.FromPtr(updated.Metadata.Owner) != owner {
+					updated.Metadata.Owner = &owner
+					_, status = r.serviceHandler.ReplaceAuthProvider(ctx, orgId, name, *updated)
+					return service.ApiStatusToErr(status)
+				}
+				return nil
+			},
+			remove: func(ctx context.Context, orgId uuid.UUID, key string) error {
+				return service.ApiStatusToErr(r.serviceHandler.DeleteAuthProvider(ctx, orgId, key))
+			},
+		},
+		domain.EnrollmentApprovalPolicyKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				policy, status := r.serviceHandler.GetEnrollmentApprovalPolicy(ctx, orgId, key)
+				return ownerOf(policy, status, func(p *domain.EnrollmentApprovalPolicy) *string { return p.Metadata.Owner })
+			},
+			listOwned: func(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+				params := domain.ListEnrollmentApprovalPoliciesParams{Limit: lo.ToPtr(int32(ItemsPerPage)), FieldSelector: ownerFieldSelector(owner)}
+				return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+					params.Continue = cont
+					list, status := r.serviceHandler.ListEnrollmentApprovalPolicies(ctx, orgId, params)
+					if status.Code != http.StatusOK {
+						return nil, nil, status
+					}
+					return lo.Map(list.Items, func(p domain.EnrollmentApprovalPolicy, _ int) string { return lo.FromPtr(p.Metadata.Name) }), list.Metadata.Continue, status
+				})
+			},
+			validate: func(ctx context.Context, resource GenericResourceMap) error {
+				policy, err := decodeResource[domain.EnrollmentApprovalPolicy](resource)
+				if err != nil {
+					return err
+				}
+				return errors.Join(policy.Validate()...)
+			},
+			apply: func(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error {
+				policy, err := decodeResource[domain.EnrollmentApprovalPolicy](resource)
+				if err != nil {
+					return err
+				}
+				name := lo.FromPtr(policy.Metadata.Name)
+				updated, status := r.serviceHandler.ReplaceEnrollmentApprovalPolicy(resourceSyncRequestCtx(ctx), orgId, name, *policy)
+				if err := replaceStatusToErr(domain.EnrollmentApprovalPolicyKind, status); err != nil {
+					return err
+				}
+				if updated != nil && lo.FromPtr(updated.Metadata.Owner) != owner {
+					updated.Metadata.Owner = &owner
+					_, status = r.serviceHandler.ReplaceEnrollmentApprovalPolicy(ctx, orgId, name, *updated)
+					return service.ApiStatusToErr(status)
+				}
+				return nil
+			},
+			remove: func(ctx context.Context, orgId uuid.UUID, key string) error {
+				return service.ApiStatusToErr(r.serviceHandler.DeleteEnrollmentApprovalPolicy(ctx, orgId, key))
+			},
+		},
+		domain.DeviceKind: {
+			getOwner: func(ctx context.Context, orgId uuid.UUID, key string) (*string, bool, error) {
+				device, status := r.serviceHandler.GetDevice(ctx, orgId, key)
+				return ownerOf(device, status, func(d *domain.Device) *string {
+					managed, err := getManagedDeviceMetadata(d)
+					if err != nil || managed == nil {
+						return nil
+					}
+					return &managed.Owner
+				})
+			},
+			listOwned:    r.listDevicesWithManagedMetadata,
+			validate:     validateDeviceMetadata,
+			apply:        r.applyDeviceMetadata,
+			remove:       r.removeDeviceMetadata,
+			existingOnly: true,
+		},
+	}
+}
+
+// syncOwnedKind creates or updates the resources of a kind that is not synced by SyncFleets,
+// SyncCatalogs or SyncCatalogItems, and returns the keys of the resources owned by the
+// ResourceSync that are no longer in the repository. Deleting those is left to the caller, so
+// that resources are deleted after the resources that may reference them.
+func (r *ResourceSync) syncOwnedKind(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, kind string, resources []GenericResourceMap, resourceName string) ([]string, error) {
+	handler := r.ownedKinds()[kind]
+	owner := util.ResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	syncErr := func(err error) ([]string, error) {
+		err = fmt.Errorf("resource %s: %w", resourceName, err)
+		log.Error(err)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+		return nil, err
+	}
+
+	var conflicts []string
+	toApply := make([]GenericResourceMap, 0, len(resources))
+	for _, resource := range resources {
+		key := resourceKey(resource)
+		existingOwner, exists, err := handler.getOwner(ctx, orgId, key)
+		if err != nil {
+			return syncErr(fmt.Errorf("failed to check existing %s %q: %w", kind, key, err))
+		}
+		if !exists && handler.existingOnly {
+			log.Warnf("Resource %s: %s %q not found, skipping", resourceName, kind, key)
+			continue
+		}
+		if handler.conflicts(existingOwner, exists, owner) {
+			conflicts = append(conflicts, key)
+			continue
+		}
+		toApply = append(toApply, resource)
+	}
+	if len(conflicts) > 0 {
+		return syncErr(fmt.Errorf("%s name(s) %v conflict with existing resources that are not managed by this resource sync", kind, conflicts))
+	}
+
+	owned, err := handler.listOwned(ctx, orgId, owner)
+	if err != nil {
+		return syncErr(fmt.Errorf("failed to list owned %s resources: %w", kind, err))
+	}
+
+	if len(toApply) > 0 {
+		log.Infof("Resource %s: applying %d %s resources", resourceName, len(toApply), kind)
+		var errs []error
+		for _, resource := range toApply {
+			if err := handler.apply(ctx, orgId, owner, resource); err != nil {
+				errs = append(errs, fmt.Errorf("%s %q: %w", kind, resourceKey(resource), err))
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return syncErr(fmt.Errorf("failed to apply %s resources: %w", kind, err))
+		}
+	}
+
+	keys := lo.Map(resources, func(resource GenericResourceMap, _ int) string { return resourceKey(resource) })
+	toRemove, _ := lo.Difference(owned, keys)
+	return toRemove, nil
+}
+
+// deleteStaleOwnedKind deletes the resources of a kind that are no longer in the repository.
+func (r *ResourceSync) deleteStaleOwnedKind(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, kind string, toRemove []string, resourceName string) error {
+	if len(toRemove) == 0 {
+		return nil
+	}
+	handler := r.ownedKinds()[kind]
+	log.Infof("Resource %s: found #%d %s resources to remove. removing", resourceName, len(toRemove), kind)
+	deleteCtx := context.WithValue(ctx, consts.ResourceSyncRequestCtxKey, true)
+	for _, key := range toRemove {
+		if err := handler.remove(deleteCtx, orgId, key); err != nil {
+			err = fmt.Errorf("resource %s: failed to remove old %s %s: %w", resourceName, kind, key, err)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOwnedKinds validates the resources of the kinds that are not synced by SyncFleets,
+// SyncCatalogs or SyncCatalogItems, and that no two resources of a kind have the same name.
+func (r *ResourceSync) validateOwnedKinds(ctx context.Context, resources []GenericResourceMap) error {
+	kinds := r.ownedKinds()
+	keys := map[string]bool{}
+	for _, resource := range resources {
+		kind, _ := resource["kind"].(string)
+		handler, ok := kinds[kind]
+		if !ok || handler.validate == nil {
+			continue
+		}
+		key := resourceKey(resource)
+		if keys[kind+"/"+key] {
+			return fmt.Errorf("found multiple %s definitions with name '%s'", kind, key)
+		}
+		keys[kind+"/"+key] = true
+		if err := handler.validate(ctx, resource); err != nil {
+			return fmt.Errorf("failed validating %s %s: %w", kind, key, err)
+		}
+	}
+	return nil
+}
+
+// planResources computes the changes required to sync the resources without applying them,
+// and reports them in the status of the ResourceSync.
+func (r *ResourceSync) planResources(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, resourceName string) error {
+	owner := util.ResourceOwner(domain.ResourceSyncKind, resourceName)
+	plan := domain.ResourceSyncPlan{
+		Creates: []domain.ResourceSyncPlannedChange{},
+		Updates: []domain.ResourceSyncPlannedChange{},
+		Deletes: []domain.ResourceSyncPlannedChange{},
+	}
+
+	kinds := r.ownedKinds()
+	for _, kind := range rs.Spec.GetType().ManageableKinds() {
+		handler := kinds[kind]
+		keys := lo.Map(filterByKind(resources, kind), func(resource GenericResourceMap, _ int) string { return resourceKey(resource) })
+		var conflicts []string
+		for _, key := range keys {
+			existingOwner, exists, err := handler.getOwner(ctx, orgId, key)
+			if err != nil {
+				return r.planFailed(log, rs, resourceName, fmt.Errorf("failed to check existing %s %q: %w", kind, key, err))
+			}
+			switch {
+			case handler.conflicts(existingOwner, exists, owner):
+				conflicts = append(conflicts, key)
+			case exists:
+				plan.Updates = append(plan.Updates, domain.ResourceSyncPlannedChange{Kind: kind, Name: key})
+			case !handler.existingOnly:
+				plan.Creates = append(plan.Creates, domain.ResourceSyncPlannedChange{Kind: kind, Name: key})
+			}
+		}
+		if len(conflicts) > 0 {
+			return r.planFailed(log, rs, resourceName, fmt.Errorf("%s name(s) %v conflict with existing resources that are not managed by this resource sync", kind, conflicts))
+		}
+
+		owned, err := handler.listOwned(ctx, orgId, owner)
+		if err != nil {
+			return r.planFailed(log, rs, resourceName, fmt.Errorf("failed to list owned %s resources: %w", kind, err))
+		}
+		toRemove, _ := lo.Difference(owned, keys)
+		for _, key := range toRemove {
+			plan.Deletes = append(plan.Deletes, domain.ResourceSyncPlannedChange{Kind: kind, Name: key})
+		}
+	}
+
+	rs.Status.Plan = &plan
+	domain.SetStatusCondition(&rs.Status.Conditions, domain.Condition{
+		Type:    domain.ConditionTypeResourceSyncSynced,
+		Status:  domain.ConditionStatusFalse,
+		Reason:  domain.ResourceSyncDryRunReason,
+		Message: fmt.Sprintf("dry run: %d creates, %d updates and %d deletes planned", len(plan.Creates), len(plan.Updates), len(plan.Deletes)),
+	})
+	rs.Status.ObservedGeneration = rs.Metadata.Generation
+	log.Infof("Resource %s: dry run planned %d creates, %d updates and %d deletes", resourceName, len(plan.Creates), len(plan.Updates), len(plan.Deletes))
+	return nil
+}
+
+func (r *ResourceSync) planFailed(log logrus.FieldLogger, rs *domain.ResourceSync, resourceName string, err error) error {
+	err = fmt.Errorf("resource %s: dry run failed: %w", resourceName, err)
+	log.Error(err)
+	rs.Status.Plan = nil
+	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+	return err
+}
+
+func (r *ResourceSync) listDevicesWithManagedMetadata(ctx context.Context, orgId uuid.UUID, owner string) ([]string, error) {
+	params := domain.ListDevicesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	annotationSelector := selector.NewAnnotationSelectorOrDie(domain.DeviceAnnotationResourceSyncManagedMetadata)
+	return listOwnedKeys(func(cont *string) ([]string, *string, domain.Status) {
+		params.Continue = cont
+		list, status := r.serviceHandler.ListDevices(ctx, orgId, params, annotationSelector)
+		if status.Code != http.StatusOK {
+			return nil, nil, status
+		}
+		var names []string
+		for i := range list.Items {
+			managed, err := getManagedDeviceMetadata(&list.Items[i])
+			if err == nil && managed != nil && managed.Owner == owner {
+				names = append(names, lo.FromPtr(list.Items[i].Metadata.Name))
+			}
+		}
+		return names, list.Metadata.Continue, status
+	})
+}
+
+func validateDeviceMetadata(ctx context.Context, resource GenericResourceMap) error {
+	device, err := decodeResource[domain.Device](resource)
+	if err != nil {
+		return err
+	}
+	allErrs := validation.ValidateResourceName(device.Metadata.Name)
+	allErrs = append(allErrs, validation.ValidateLabels(device.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(device.Metadata.Annotations)...)
+	for key := range lo.FromPtr(device.Metadata.Annotations) {
+		if lo.SomeBy(reservedDeviceAnnotationPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }) {
+			allErrs = append(allErrs, fmt.Errorf("metadata.annotations: annotation %q is managed by the service", key))
+		}
+	}
+	return errors.Join(allErrs...)
+}
+
+// applyDeviceMetadata sets the labels and annotations of an existing device to those of the
+// device in the repository, and removes those it set previously that are no longer listed.
+func (r *ResourceSync) applyDeviceMetadata(ctx context.Context, orgId uuid.UUID, owner string, resource GenericResourceMap) error {
+	desired, err := decodeResource[domain.Device](resource)
+	if err != nil {
+		return err
+	}
+	name := lo.FromPtr(desired.Metadata.Name)
+	device, status := r.serviceHandler.GetDevice(ctx, orgId, name)
+	if status.Code != http.StatusOK {
+		return service.ApiStatusToErr(status)
+	}
+	managed, err := getManagedDeviceMetadata(device)
+	if err != nil {
+		return err
+	}
+	if managed == nil {
+		managed = &managedDeviceMetadata{}
+	}
+
+	desiredLabels := lo.FromPtr(desired.Metadata.Labels)
+	labels := maps.Clone(lo.FromPtr(device.Metadata.Labels))
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for _, key := range managed.Labels {
+		delete(labels, key)
+	}
+	maps.Copy(labels, desiredLabels)
+	if !maps.Equal(labels, lo.FromPtr(device.Metadata.Labels)) {
+		patch := domain.PatchRequest{{Op: domain.PatchOpAdd, Path: "/metadata/labels", Value: labels}}
+		if _, status := r.serviceHandler.PatchDevice(ctx, orgId, name, patch); status.Code != http.StatusOK {
+			return service.ApiStatusToErr(status)
+		}
+	}
+
+	desiredAnnotations := lo.FromPtr(desired.Metadata.Annotations)
+	newManaged := managedDeviceMetadata{
+		Owner:       owner,
+		Labels:      sortedKeys(desiredLabels),
+		Annotations: sortedKeys(desiredAnnotations),
+	}
+	managedJson, err := json.Marshal(newManaged)
+	if err != nil {
+		return err
+	}
+	annotations := maps.Clone(desiredAnnotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[domain.DeviceAnnotationResourceSyncManagedMetadata] = string(managedJson)
+	deleteKeys, _ := lo.Difference(managed.Annotations, newManaged.Annotations)
+	return service.ApiStatusToErr(r.serviceHandler.UpdateDeviceAnnotations(ctx, orgId, name, annotations, deleteKeys))
+}
+
+// removeDeviceMetadata removes the labels and annotations that a ResourceSync set on a device.
+func (r *ResourceSync) removeDeviceMetadata(ctx context.Context, orgId uuid.UUID, name string) error {
+	device, status := r.serviceHandler.GetDevice(ctx, orgId, name)
+	if status.Code == http.StatusNotFound {
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return service.ApiStatusToErr(status)
+	}
+	managed, err := getManagedDeviceMetadata(device)
+	if err != nil || managed == nil {
+		return err
+	}
+
+	labels := maps.Clone(lo.FromPtr(device.Metadata.Labels))
+	for _, key := range managed.Labels {
+		delete(labels, key)
+	}
+	if !maps.Equal(labels, lo.FromPtr(device.Metadata.Labels)) {
+		patch := domain.PatchRequest{{Op: domain.PatchOpAdd, Path: "/metadata/labels", Value: labels}}
+		if _, status := r.serviceHandler.PatchDevice(ctx, orgId, name, patch); status.Code != http.StatusOK {
+			return service.ApiStatusToErr(status)
+		}
+	}
+	deleteKeys := append(slices.Clone(managed.Annotations), domain.DeviceAnnotationResourceSyncManagedMetadata)
+	return service.ApiStatusToErr(r.serviceHandler.UpdateDeviceAnnotations(ctx, orgId, name, nil, deleteKeys))
+}
+
+// getManagedDeviceMetadata returns the labels and annotations of the device managed by a
+// ResourceSync, or nil if none are.
+func getManagedDeviceMetadata(device *domain.Device) (*managedDeviceMetadata, error) {
+	value, ok := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationResourceSyncManagedMetadata]
+	if !ok {
+		return nil, nil
+	}
+	var managed managedDeviceMetadata
+	if err := json.Unmarshal([]byte(value), &managed); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %s of device %s: %w", domain.DeviceAnnotationResourceSyncManagedMetadata, lo.FromPtr(device.Metadata.Name), err)
+	}
+	return &managed, nil
+}
+
+// resourceKey returns the key identifying a resource within its kind.
+func resourceKey(resource GenericResourceMap) string {
+	name := genericResourceName(resource)
+	if kind, _ := resource["kind"].(string); kind == domain.CatalogItemKind {
+		meta, _ := resource["metadata"].(map[string]interface{})
+		catalog, _ := meta["catalog"].(string)
+		return catalog + "/" + name
+	}
+	return name
+}
+
+// decodeResource decodes a generic resource into its API type.
+func decodeResource[T any](resource GenericResourceMap) (*T, error) {
+	buf, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse generic resource: %w", err)
+	}
+	var obj T
+	if err := yamlutil.Unmarshal(buf, &obj); err != nil {
+		return nil, fmt.Errorf("decoding %s resource: %w", resource["kind"], err)
+	}
+	return &obj, nil
+}
+
+// ownerOf returns the owner of a resource fetched from the service, and whether it exists.
+func ownerOf[T any](resource *T, status domain.Status, owner func(*T) *string) (*string, bool, error) {
+	switch status.Code {
+	case http.StatusOK:
+		return owner(resource), true, nil
+	case http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, service.ApiStatusToErr(status)
+	}
+}
+
+// listOwnedKeys pages through a list of resources and returns the keys of all pages.
+func listOwnedKeys(list func(cont *string) ([]string, *string, domain.Status)) ([]string, error) {
+	var keys []string
+	var cont *string
+	for {
+		page, next, status := list(cont)
+		if status.Code != http.StatusOK {
+			return nil, service.ApiStatusToErr(status)
+		}
+		keys = append(keys, page...)
+		if next == nil {
+			return keys, nil
+		}
+		cont = next
+	}
+}
+
+func ownerFieldSelector(owner string) *string {
+	return lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner))
+}
+
+// resourceSyncRequestCtx returns a context for replacing a resource as if requested through the
+// API, so that the fields managed by the service are not overwritten, while allowing the
+// ResourceSync to update the resources it owns.
+func resourceSyncRequestCtx(ctx context.Context) context.Context {
+	externalCtx := context.WithValue(ctx, consts.InternalRequestCtxKey, false)
+	return context.WithValue(externalCtx, consts.ResourceSyncRequestCtxKey, true)
+}
+
+func replaceStatusToErr(kind string, status domain.Status) error {
+	if status.Code == http.StatusOK || status.Code == http.StatusCreated {
+		return nil
+	}
+	if status.Message == flterrors.ErrUpdatingResourceWithOwnerNotAllowed.Error() {
+		return fmt.Errorf("%s is managed by a different resource", kind)
+	}
+	return service.ApiStatusToErr(status)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := lo.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
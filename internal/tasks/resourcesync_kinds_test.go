@@ -0,0 +1,275 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newRepositoryResource(name string) GenericResourceMap {
+	return GenericResourceMap{
+		"apiVersion": "flightctl.io/v1beta1",
+		"kind":       domain.RepositoryKind,
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       map[string]interface{}{"type": "git", "url": "https://github.com/flightctl/flightctl-demos"},
+	}
+}
+
+func newDeviceResource(name string, labels, annotations map[string]interface{}) GenericResourceMap {
+	return GenericResourceMap{
+		"apiVersion": "flightctl.io/v1beta1",
+		"kind":       domain.DeviceKind,
+		"metadata":   map[string]interface{}{"name": name, "labels": labels, "annotations": annotations},
+	}
+}
+
+func newManagedDevice(t *testing.T, name string, labels map[string]string, managed managedDeviceMetadata) *domain.Device {
+	managedJson, err := json.Marshal(managed)
+	require.NoError(t, err)
+	return &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name:        lo.ToPtr(name),
+			Labels:      &labels,
+			Annotations: &map[string]string{domain.DeviceAnnotationResourceSyncManagedMetadata: string(managedJson)},
+		},
+	}
+}
+
+func TestSyncOwnedKind_Repository(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	log := logrus.New()
+	rs := NewResourceSync(mockSvc, log, nil, nil)
+
+	orgId := uuid.New()
+	resourceName := "test-rs"
+	rsObj := newTestRS(resourceName)
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "configs").Return(nil, notFoundStatus())
+	mockSvc.EXPECT().ListRepositories(gomock.Any(), orgId, gomock.Any()).
+		Return(&domain.RepositoryList{
+			Items: []domain.Repository{{Metadata: domain.ObjectMeta{Name: lo.ToPtr("stale"), Owner: owner}}},
+		}, okStatus())
+
+	// The repository is created without an owner, which is then set by a second replace
+	mockSvc.EXPECT().ReplaceRepository(gomock.Any(), orgId, "configs", gomock.Any()).
+		Return(&domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("configs")}}, createdStatus())
+	mockSvc.EXPECT().ReplaceRepository(gomock.Any(), orgId, "configs", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, repo domain.Repository) (*domain.Repository, domain.Status) {
+			require.Equal(*owner, lo.FromPtr(repo.Metadata.Owner))
+			return &repo, okStatus()
+		})
+
+	toRemove, err := rs.syncOwnedKind(context.Background(), log, orgId, rsObj, domain.RepositoryKind, []GenericResourceMap{newRepositoryResource("configs")}, resourceName)
+	require.NoError(err)
+	require.Equal([]string{"stale"}, toRemove)
+
+	mockSvc.EXPECT().DeleteRepository(gomock.Any(), orgId, "stale").Return(okStatus())
+	require.NoError(rs.deleteStaleOwnedKind(context.Background(), log, orgId, rsObj, domain.RepositoryKind, toRemove, resourceName))
+}
+
+func TestSyncOwnedKind_ConflictingOwner(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	log := logrus.New()
+	rs := NewResourceSync(mockSvc, log, nil, nil)
+
+	orgId := uuid.New()
+	rsObj := newTestRS("test-rs")
+
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "configs").
+		Return(&domain.Repository{Metadata: domain.ObjectMeta{
+			Name:  lo.ToPtr("configs"),
+			Owner: util.SetResourceOwner(domain.ResourceSyncKind, "other-rs"),
+		}}, okStatus())
+
+	_, err := rs.syncOwnedKind(context.Background(), log, orgId, rsObj, domain.RepositoryKind, []GenericResourceMap{newRepositoryResource("configs")}, "test-rs")
+	require.ErrorContains(err, "conflict with existing resources")
+	require.True(domain.IsStatusConditionFalse(rsObj.Status.Conditions, domain.ConditionTypeResourceSyncSynced))
+}
+
+func TestSyncOwnedKind_UnownedResource(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	log := logrus.New()
+	rs := NewResourceSync(mockSvc, log, nil, nil)
+
+	orgId := uuid.New()
+	rsObj := newTestRS("test-rs")
+
+	// A repository created through the API is not taken over by the ResourceSync
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "configs").
+		Return(&domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("configs")}}, okStatus())
+
+	_, err := rs.syncOwnedKind(context.Background(), log, orgId, rsObj, domain.RepositoryKind, []GenericResourceMap{newRepositoryResource("configs")}, "test-rs")
+	require.ErrorContains(err, "conflict with existing resources")
+	require.True(domain.IsStatusConditionFalse(rsObj.Status.Conditions, domain.ConditionTypeResourceSyncSynced))
+}
+
+func TestApplyDeviceMetadata(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	owner := util.ResourceOwner(domain.ResourceSyncKind, "test-rs")
+
+	// "site" and "rack" were set by the ResourceSync, "owner" by a user
+	device := newManagedDevice(t, "dev1",
+		map[string]string{"site": "a", "rack": "r1", "owner": "alice"},
+		managedDeviceMetadata{Owner: owner, Labels: []string{"rack", "site"}, Annotations: []string{"old-note"}})
+	mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev1").Return(device, okStatus())
+
+	mockSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			require.Len(patch, 1)
+			require.Equal("/metadata/labels", patch[0].Path)
+			require.Equal(map[string]string{"site": "b", "owner": "alice"}, patch[0].Value)
+			return device, okStatus()
+		})
+	mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "dev1", gomock.Any(), []string{"old-note"}).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, annotations map[string]string, _ []string) domain.Status {
+			require.Equal("hello", annotations["note"])
+			var managed managedDeviceMetadata
+			require.NoError(json.Unmarshal([]byte(annotations[domain.DeviceAnnotationResourceSyncManagedMetadata]), &managed))
+			require.Equal(managedDeviceMetadata{Owner: owner, Labels: []string{"site"}, Annotations: []string{"note"}}, managed)
+			return okStatus()
+		})
+
+	resource := newDeviceResource("dev1", map[string]interface{}{"site": "b"}, map[string]interface{}{"note": "hello"})
+	require.NoError(rs.applyDeviceMetadata(context.Background(), orgId, owner, resource))
+}
+
+func TestRemoveDeviceMetadata(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	rs := NewResourceSync(mockSvc, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	owner := util.ResourceOwner(domain.ResourceSyncKind, "test-rs")
+
+	device := newManagedDevice(t, "dev1",
+		map[string]string{"site": "a", "owner": "alice"},
+		managedDeviceMetadata{Owner: owner, Labels: []string{"site"}, Annotations: []string{"note"}})
+	mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev1").Return(device, okStatus())
+	mockSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			require.Equal(map[string]string{"owner": "alice"}, patch[0].Value)
+			return device, okStatus()
+		})
+	mockSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "dev1", gomock.Nil(),
+		[]string{"note", domain.DeviceAnnotationResourceSyncManagedMetadata}).Return(okStatus())
+
+	require.NoError(rs.removeDeviceMetadata(context.Background(), orgId, "dev1"))
+}
+
+func TestValidateDeviceMetadata(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(validateDeviceMetadata(context.Background(),
+		newDeviceResource("dev1", map[string]interface{}{"site": "a"}, map[string]interface{}{"note": "hello"})))
+
+	err := validateDeviceMetadata(context.Background(),
+		newDeviceResource("dev1", nil, map[string]interface{}{"fleet-controller/templateVersion": "v1"}))
+	require.ErrorContains(err, "is managed by the service")
+}
+
+func TestPlanResources(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mockSvc := service.NewMockService(ctrl)
+	log := logrus.New()
+	rs := NewResourceSync(mockSvc, log, nil, nil)
+
+	orgId := uuid.New()
+	resourceName := "test-rs"
+	rsObj := newTestRS(resourceName)
+	rsObj.Spec.DryRun = lo.ToPtr(true)
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	resources := []GenericResourceMap{
+		{"kind": domain.FleetKind, "metadata": map[string]interface{}{"name": "fleet1"}},
+		newRepositoryResource("configs"),
+		{"kind": domain.EnrollmentApprovalPolicyKind, "metadata": map[string]interface{}{"name": "auto-approve"}},
+		newDeviceResource("dev1", map[string]interface{}{"site": "a"}, nil),
+		newDeviceResource("missing", map[string]interface{}{"site": "a"}, nil),
+	}
+
+	mockSvc.EXPECT().GetFleet(gomock.Any(), orgId, "fleet1", gomock.Any()).
+		Return(&domain.Fleet{Metadata: domain.ObjectMeta{Name: lo.ToPtr("fleet1"), Owner: owner}}, okStatus())
+	mockSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Any()).
+		Return(&domain.FleetList{Items: []domain.Fleet{
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr("fleet1"), Owner: owner}},
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr("fleet2"), Owner: owner}},
+		}}, okStatus())
+	mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, "configs").Return(nil, notFoundStatus())
+	mockSvc.EXPECT().ListRepositories(gomock.Any(), orgId, gomock.Any()).Return(&domain.RepositoryList{}, okStatus())
+	mockSvc.EXPECT().ListSecretStores(gomock.Any(), orgId, gomock.Any()).Return(&domain.SecretStoreList{}, okStatus())
+	mockSvc.EXPECT().ListAuthProviders(gomock.Any(), orgId, gomock.Any()).Return(&domain.AuthProviderList{}, okStatus())
+	mockSvc.EXPECT().GetEnrollmentApprovalPolicy(gomock.Any(), orgId, "auto-approve").Return(nil, notFoundStatus())
+	mockSvc.EXPECT().ListEnrollmentApprovalPolicies(gomock.Any(), orgId, gomock.Any()).Return(&domain.EnrollmentApprovalPolicyList{}, okStatus())
+	mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev1").
+		Return(&domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev1")}}, okStatus())
+	mockSvc.EXPECT().GetDevice(gomock.Any(), orgId, "missing").Return(nil, notFoundStatus())
+	mockSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).Return(&domain.DeviceList{}, okStatus())
+
+	require.NoError(rs.planResources(context.Background(), log, orgId, rsObj, resources, resourceName))
+
+	plan := rsObj.Status.Plan
+	require.NotNil(plan)
+	require.Equal([]domain.ResourceSyncPlannedChange{
+		{Kind: domain.RepositoryKind, Name: "configs"},
+		{Kind: domain.EnrollmentApprovalPolicyKind, Name: "auto-approve"},
+	}, plan.Creates)
+	require.Equal([]domain.ResourceSyncPlannedChange{
+		{Kind: domain.FleetKind, Name: "fleet1"},
+		{Kind: domain.DeviceKind, Name: "dev1"},
+	}, plan.Updates)
+	require.Equal([]domain.ResourceSyncPlannedChange{{Kind: domain.FleetKind, Name: "fleet2"}}, plan.Deletes)
+
+	cond := domain.FindStatusCondition(rsObj.Status.Conditions, domain.ConditionTypeResourceSyncSynced)
+	require.NotNil(cond)
+	require.Equal(domain.ResourceSyncDryRunReason, cond.Reason)
+	require.Equal(domain.ConditionStatusFalse, cond.Status)
+}
+
+func TestFilterManagedKinds(t *testing.T) {
+	require := require.New(t)
+
+	resources := []GenericResourceMap{
+		{"kind": domain.FleetKind},
+		newRepositoryResource("configs"),
+		{"kind": domain.CatalogKind},
+	}
+
+	require.Equal([]string{domain.CatalogKind}, unexpectedKinds(filterByKinds(resources, otherTypesDefaultKinds(domain.ResourceSyncTypeFleet)...)))
+	require.Equal([]string{domain.FleetKind}, unexpectedKinds(filterByKinds(resources, otherTypesDefaultKinds(domain.ResourceSyncTypeCatalog)...)))
+	require.Empty(unexpectedKinds(resources, append(domain.ResourceSyncTypeFleet.ManageableKinds(), domain.CatalogKind)...))
+	require.Equal([]string{"NotificationSink"}, unexpectedKinds(append(resources, GenericResourceMap{"kind": "NotificationSink"}), append(domain.ResourceSyncTypeFleet.ManageableKinds(), domain.CatalogKind)...))
+
+	spec := domain.ResourceSyncSpec{}
+	require.Len(filterByKinds(resources, spec.ManagedKinds()...), 1)
+	spec.Kinds = &[]string{domain.FleetKind, domain.RepositoryKind}
+	require.Len(filterByKinds(resources, spec.ManagedKinds()...), 2)
+}
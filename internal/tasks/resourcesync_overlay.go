@@ -0,0 +1,314 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/util"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// maxRenderedResourcesSize is the maximum size of the rendered resources kept in the status of a ResourceSync.
+const maxRenderedResourcesSize = 64 * 1024
+
+// maxKustomizationDepth limits how deeply kustomizations can reference other kustomizations.
+const maxKustomizationDepth = 10
+
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// kustomization is the subset of the kustomize Kustomization file supported by ResourceSync.
+type kustomization struct {
+	ApiVersion            string               `json:"apiVersion,omitempty"`
+	Kind                  string               `json:"kind,omitempty"`
+	Resources             []string             `json:"resources,omitempty"`
+	Bases                 []string             `json:"bases,omitempty"`
+	Patches               []kustomizationPatch `json:"patches,omitempty"`
+	PatchesStrategicMerge []string             `json:"patchesStrategicMerge,omitempty"`
+	PatchesJson6902       []kustomizationPatch `json:"patchesJson6902,omitempty"`
+}
+
+// kustomizationPatch is a patch read from a file or inline. A patch that is a list of
+// operations is a JSON patch (RFC 6902) and requires a target. Any other patch is a JSON
+// merge patch (RFC 7386), applied to the resources it targets, or to the resources with its
+// kind and name if no target is given. Strategic merge patches aren't supported, as the
+// resources don't define the keys by which the items of their lists are merged.
+type kustomizationPatch struct {
+	Path   string                    `json:"path,omitempty"`
+	Patch  string                    `json:"patch,omitempty"`
+	Target *kustomizationPatchTarget `json:"target,omitempty"`
+}
+
+type kustomizationPatchTarget struct {
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func (t *kustomizationPatchTarget) matches(resource GenericResourceMap) bool {
+	kind, _ := resource["kind"].(string)
+	return (t.Kind == "" || t.Kind == kind) && (t.Name == "" || t.Name == genericResourceName(resource))
+}
+
+// findKustomization returns the path of the kustomization file in the given directory, if any.
+func findKustomization(mfs billy.Filesystem, dir string) (string, bool) {
+	for _, name := range kustomizationFileNames {
+		if info, err := mfs.Stat(mfs.Join(dir, name)); err == nil && !info.IsDir() {
+			return mfs.Join(dir, name), true
+		}
+	}
+	return "", false
+}
+
+func isKustomizationFile(name string) bool {
+	return slices.Contains(kustomizationFileNames, name)
+}
+
+// renderKustomization renders the resources listed by the kustomization file in the given
+// directory, with its patches applied.
+func (r *ResourceSync) renderKustomization(mfs billy.Filesystem, dir string) ([]GenericResourceMap, error) {
+	return r.renderKustomizationDir(mfs, dir, 0)
+}
+
+func (r *ResourceSync) renderKustomizationDir(mfs billy.Filesystem, dir string, depth int) ([]GenericResourceMap, error) {
+	if depth > maxKustomizationDepth {
+		return nil, fmt.Errorf("kustomization at '%s': too many nested kustomizations", dir)
+	}
+	kustomizationPath, _ := findKustomization(mfs, dir)
+	contents, err := util.ReadFile(mfs, kustomizationPath)
+	if err != nil {
+		return nil, err
+	}
+	var k kustomization
+	if err := yaml.UnmarshalStrict(contents, &k); err != nil {
+		return nil, fmt.Errorf("invalid kustomization at '%s': %w", kustomizationPath, err)
+	}
+
+	resources := []GenericResourceMap{}
+	for _, ref := range append(slices.Clone(k.Bases), k.Resources...) {
+		refPath := mfs.Join(dir, ref)
+		info, err := mfs.Stat(refPath)
+		if err != nil {
+			return nil, fmt.Errorf("kustomization at '%s': resource '%s' not found", kustomizationPath, ref)
+		}
+		var refResources []GenericResourceMap
+		switch {
+		case !info.IsDir():
+			refResources, err = decodeResourceFile(mfs, refPath)
+		case hasKustomization(mfs, refPath):
+			refResources, err = r.renderKustomizationDir(mfs, refPath, depth+1)
+		default:
+			refResources, err = decodeResourceDir(mfs, refPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, refResources...)
+	}
+
+	if len(k.PatchesStrategicMerge) > 0 {
+		return nil, fmt.Errorf("kustomization at '%s': patchesStrategicMerge is not supported, use patches with a JSON merge patch instead", kustomizationPath)
+	}
+	patches := append(slices.Clone(k.Patches), k.PatchesJson6902...)
+	for i, p := range patches {
+		if err := applyKustomizationPatch(mfs, dir, p, resources); err != nil {
+			return nil, fmt.Errorf("kustomization at '%s': patch %d: %w", kustomizationPath, i, err)
+		}
+	}
+	return resources, nil
+}
+
+func hasKustomization(mfs billy.Filesystem, dir string) bool {
+	_, ok := findKustomization(mfs, dir)
+	return ok
+}
+
+func applyKustomizationPatch(mfs billy.Filesystem, dir string, p kustomizationPatch, resources []GenericResourceMap) error {
+	var contents []byte
+	switch {
+	case p.Path != "" && p.Patch != "":
+		return errors.New("only one of path and patch may be set")
+	case p.Path != "":
+		var err error
+		if contents, err = util.ReadFile(mfs, mfs.Join(dir, p.Path)); err != nil {
+			return fmt.Errorf("reading '%s': %w", p.Path, err)
+		}
+	case p.Patch != "":
+		contents = []byte(p.Patch)
+	default:
+		return errors.New("one of path and patch must be set")
+	}
+
+	docs, err := decodeYAMLDocuments(contents)
+	if err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		if bytes.HasPrefix(bytes.TrimSpace(doc), []byte("[")) {
+			if err := applyJSONPatch(doc, p.Target, resources); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := applyMergePatch(doc, p.Target, resources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyJSONPatch(doc []byte, target *kustomizationPatchTarget, resources []GenericResourceMap) error {
+	if target == nil {
+		return errors.New("a JSON patch requires a target")
+	}
+	patch, err := jsonpatch.DecodePatch(doc)
+	if err != nil {
+		return fmt.Errorf("invalid JSON patch: %w", err)
+	}
+	return patchResources(target, resources, func(resource []byte) ([]byte, error) {
+		return patch.Apply(resource)
+	})
+}
+
+func applyMergePatch(doc []byte, target *kustomizationPatchTarget, resources []GenericResourceMap) error {
+	if target == nil {
+		var patch GenericResourceMap
+		if err := json.Unmarshal(doc, &patch); err != nil {
+			return fmt.Errorf("invalid patch: %w", err)
+		}
+		kind, _ := patch["kind"].(string)
+		name := genericResourceName(patch)
+		if kind == "" || name == "" {
+			return errors.New("a patch without a target must set kind and metadata.name")
+		}
+		target = &kustomizationPatchTarget{Kind: kind, Name: name}
+	}
+	return patchResources(target, resources, func(resource []byte) ([]byte, error) {
+		return jsonpatch.MergePatch(resource, doc)
+	})
+}
+
+// patchResources applies a patch to the resources matching the target, and fails if none does.
+func patchResources(target *kustomizationPatchTarget, resources []GenericResourceMap, apply func([]byte) ([]byte, error)) error {
+	matched := false
+	for i, resource := range resources {
+		if !target.matches(resource) {
+			continue
+		}
+		matched = true
+		doc, err := json.Marshal(resource)
+		if err != nil {
+			return err
+		}
+		patched, err := apply(doc)
+		if err != nil {
+			return fmt.Errorf("applying patch to %s %s: %w", resource["kind"], genericResourceName(resource), err)
+		}
+		var result GenericResourceMap
+		if err := json.Unmarshal(patched, &result); err != nil {
+			return err
+		}
+		resources[i] = result
+	}
+	if !matched {
+		return fmt.Errorf("no resource matches target kind %q and name %q", target.Kind, target.Name)
+	}
+	return nil
+}
+
+// decodeYAMLDocuments splits YAML or JSON contents into JSON documents.
+func decodeYAMLDocuments(contents []byte) ([][]byte, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(contents), 100)
+	var docs [][]byte
+	for {
+		var doc json.RawMessage
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+			return nil, err
+		}
+		if len(doc) > 0 && string(doc) != "null" {
+			docs = append(docs, doc)
+		}
+	}
+}
+
+// renderedResourcesToYAML returns the rendered resources as a multi-document YAML, truncated
+// to fit in the status of a ResourceSync. Since the status can be read by anyone allowed to
+// read the ResourceSync, sensitive fields such as credentials are masked.
+func renderedResourcesToYAML(resources []GenericResourceMap) string {
+	var buf bytes.Buffer
+	for _, resource := range resources {
+		out, err := yaml.Marshal(hideSensitiveResourceData(resource))
+		if err != nil {
+			continue
+		}
+		buf.WriteString("---\n")
+		buf.Write(out)
+	}
+	if buf.Len() > maxRenderedResourcesSize {
+		return buf.String()[:maxRenderedResourcesSize] + "\n# truncated\n"
+	}
+	return buf.String()
+}
+
+// hideSensitiveResourceData returns a copy of the resource with its sensitive fields masked.
+// A resource that can't be decoded is reduced to its kind and name.
+func hideSensitiveResourceData(resource GenericResourceMap) GenericResourceMap {
+	var (
+		hidden GenericResourceMap
+		err    error
+	)
+	switch resource["kind"] {
+	case domain.RepositoryKind:
+		hidden, err = hideSensitiveData[domain.Repository](resource)
+	case domain.SecretStoreKind:
+		hidden, err = hideSensitiveData[domain.SecretStore](resource)
+	case domain.AuthProviderKind:
+		hidden, err = hideSensitiveData[domain.AuthProvider](resource)
+	default:
+		return resource
+	}
+	if err != nil {
+		return GenericResourceMap{
+			"kind":     resource["kind"],
+			"metadata": map[string]interface{}{"name": genericResourceName(resource)},
+		}
+	}
+	return hidden
+}
+
+func hideSensitiveData[T any, PT interface {
+	*T
+	domain.SensitiveDataHider
+}](resource GenericResourceMap) (GenericResourceMap, error) {
+	obj, err := decodeResource[T](resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := PT(obj).HideSensitiveData(); err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var hidden GenericResourceMap
+	if err := json.Unmarshal(buf, &hidden); err != nil {
+		return nil, err
+	}
+	return hidden, nil
+}
+
+func genericResourceName(resource GenericResourceMap) string {
+	meta, _ := resource["metadata"].(map[string]interface{})
+	name, _ := meta["name"].(string)
+	return name
+}
@@ -0,0 +1,143 @@
+package tasks
+
+import (
+	"embed"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	billy "github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed testdata/overlay/*
+var overlayFS embed.FS
+
+func loadOverlayFixtures(t *testing.T) billy.Filesystem {
+	t.Helper()
+	mfs := memfs.New()
+	require.NoError(t, copyEmbedToMemfs(overlayFS, "testdata/overlay", mfs, "/repo"))
+	return mfs
+}
+
+func TestRenderKustomization(t *testing.T) {
+	require := require.New(t)
+	mfs := loadOverlayFixtures(t)
+	rs := NewResourceSync(nil, logrus.New(), nil, nil)
+
+	resources, err := rs.renderKustomization(mfs, "/repo/site-a")
+	require.NoError(err)
+	require.Len(resources, 1)
+
+	fleets, err := rs.parseFleets(resources)
+	require.NoError(err)
+	require.Len(fleets, 1)
+	fleet := fleets[0]
+	require.Equal("edge-site-a", lo.FromPtr(fleet.Metadata.Name))
+	require.Equal(map[string]string{"tier": "edge", "site": "site-a"}, lo.FromPtr(fleet.Metadata.Labels))
+	require.Equal(map[string]string{"fleet": "edge", "site": "site-a"}, lo.FromPtr(fleet.Spec.Selector.MatchLabels))
+	require.Equal("quay.io/example/edge-os:v1", fleet.Spec.Template.Spec.Os.Image)
+}
+
+func TestRenderKustomization_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		kustomization string
+		wantErr       string
+	}{
+		{
+			name:          "unsupported field",
+			kustomization: "resources: [../base]\nnamePrefix: site-\n",
+			wantErr:       "invalid kustomization",
+		},
+		{
+			name:          "strategic merge patch",
+			kustomization: "resources: [../base]\npatchesStrategicMerge: [site.yaml]\n",
+			wantErr:       "patchesStrategicMerge is not supported",
+		},
+		{
+			name:          "missing resource",
+			kustomization: "resources: [../missing]\n",
+			wantErr:       "not found",
+		},
+		{
+			name:          "JSON patch without target",
+			kustomization: "resources: [../base]\npatches:\n- patch: '[{\"op\": \"remove\", \"path\": \"/metadata/labels\"}]'\n",
+			wantErr:       "requires a target",
+		},
+		{
+			name:          "patch matching no resource",
+			kustomization: "resources: [../base]\npatches:\n- patch: '{\"kind\": \"Fleet\", \"metadata\": {\"name\": \"other\"}}'\n",
+			wantErr:       "no resource matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mfs := loadOverlayFixtures(t)
+			require.NoError(t, util.WriteFile(mfs, "/repo/site-b/kustomization.yaml", []byte(tt.kustomization), 0o644))
+			rs := NewResourceSync(nil, logrus.New(), nil, nil)
+
+			_, err := rs.renderKustomization(mfs, "/repo/site-b")
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseAndValidateResources_Kustomization(t *testing.T) {
+	require := require.New(t)
+	mfs := loadOverlayFixtures(t)
+	rs := NewResourceSync(nil, logrus.New(), nil, nil)
+	cloneRepo := func(*domain.Repository, *string, *int, *config.Config) (billy.Filesystem, string, error) {
+		return mfs, "abc123", nil
+	}
+
+	rsObj := newTestRS("test-rs")
+	rsObj.Spec.Path = "/repo/site-a"
+	resources, err := rs.parseAndValidateResources(rsObj, &domain.Repository{}, cloneRepo)
+	require.NoError(err)
+	require.Len(resources, 1)
+	require.Contains(lo.FromPtr(rsObj.Status.RenderedResources), "name: edge-site-a")
+
+	// The base directory is read verbatim, skipping its kustomization file
+	rsObj = newTestRS("test-rs")
+	rsObj.Spec.Path = "/repo"
+	resources, err = rs.parseAndValidateResources(rsObj, &domain.Repository{}, cloneRepo)
+	require.NoError(err)
+	require.Len(resources, 2)
+	require.Nil(rsObj.Status.RenderedResources)
+}
+
+func TestRenderedResourcesToYAML_HidesSensitiveData(t *testing.T) {
+	require := require.New(t)
+
+	resources := []GenericResourceMap{
+		{"kind": domain.FleetKind, "metadata": map[string]interface{}{"name": "edge"}},
+		{
+			"apiVersion": "flightctl.io/v1beta1",
+			"kind":       domain.RepositoryKind,
+			"metadata":   map[string]interface{}{"name": "configs"},
+			"spec": map[string]interface{}{
+				"type": "git",
+				"url":  "https://example.com/configs.git",
+				"httpConfig": map[string]interface{}{
+					"username": "admin",
+					"password": "hunter2",
+				},
+			},
+		},
+		{"kind": domain.SecretStoreKind, "metadata": map[string]interface{}{"name": "vault"}, "spec": "invalid"},
+	}
+
+	rendered := renderedResourcesToYAML(resources)
+	require.Contains(rendered, "name: edge")
+	require.Contains(rendered, "name: configs")
+	require.Contains(rendered, "*****")
+	require.NotContains(rendered, "hunter2")
+	require.Contains(rendered, "name: vault")
+	require.NotContains(rendered, "invalid")
+}
@@ -0,0 +1,257 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultSecretStoreMountPath        = "secret"
+	defaultSecretStoreAppRoleMountPath = "approle"
+	secretStoreRequestTimeout          = 30 * time.Second
+)
+
+// SecretStoreClient reads secrets from a HashiCorp Vault or OpenBao compatible key/value secrets engine.
+type SecretStoreClient interface {
+	// TestAccess verifies that the store is reachable and that its credentials are accepted.
+	TestAccess(ctx context.Context) error
+	// ReadSecret returns the key/value pairs of the latest version of the secret at path, together
+	// with that version. The version is empty for stores that don't version their secrets.
+	ReadSecret(ctx context.Context, path string) (map[string]string, string, error)
+}
+
+// SecretStoreClientFactory creates a SecretStoreClient for the given SecretStore.
+type SecretStoreClientFactory func(secretStore *domain.SecretStore) (SecretStoreClient, error)
+
+type vaultClient struct {
+	client    *http.Client
+	address   string
+	namespace string
+	mountPath string
+	kvVersion domain.SecretStoreSpecKvVersion
+	auth      domain.SecretStoreAuth
+	token     string
+}
+
+// NewSecretStoreClient creates a SecretStoreClient that talks to the Vault HTTP API.
+func NewSecretStoreClient(secretStore *domain.SecretStore) (SecretStoreClient, error) {
+	spec := secretStore.Spec
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if spec.Tls != nil {
+		if spec.Tls.SkipServerVerification != nil {
+			tlsConfig.InsecureSkipVerify = *spec.Tls.SkipServerVerification //nolint:gosec
+		}
+		if spec.Tls.CaCrt != nil {
+			ca, err := base64.StdEncoding.DecodeString(*spec.Tls.CaCrt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode CA certificate: %w", err)
+			}
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil {
+				rootCAs = x509.NewCertPool()
+			}
+			rootCAs.AppendCertsFromPEM(ca)
+			tlsConfig.RootCAs = rootCAs
+		}
+	}
+
+	return &vaultClient{
+		client: &http.Client{
+			Timeout:   secretStoreRequestTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		address:   strings.TrimSuffix(spec.Address, "/"),
+		namespace: lo.FromPtr(spec.Namespace),
+		mountPath: strings.Trim(lo.FromPtrOr(spec.MountPath, defaultSecretStoreMountPath), "/"),
+		kvVersion: lo.FromPtrOr(spec.KvVersion, domain.SecretStoreKvVersion2),
+		auth:      spec.Auth,
+	}, nil
+}
+
+func (c *vaultClient) TestAccess(ctx context.Context) error {
+	if err := c.login(ctx); err != nil {
+		return err
+	}
+	// Every token is allowed to look itself up, so this only verifies that the token is valid
+	_, err := c.do(ctx, http.MethodGet, "auth/token/lookup-self", nil)
+	return err
+}
+
+func (c *vaultClient) ReadSecret(ctx context.Context, path string) (map[string]string, string, error) {
+	if err := c.login(ctx); err != nil {
+		return nil, "", err
+	}
+
+	path = strings.Trim(path, "/")
+	if c.kvVersion == domain.SecretStoreKvVersion1 {
+		var resp struct {
+			Data map[string]any `json:"data"`
+		}
+		if err := c.get(ctx, c.mountPath+"/"+path, &resp); err != nil {
+			return nil, "", err
+		}
+		data, err := secretDataToStrings(resp.Data)
+		return data, "", err
+	}
+
+	var resp struct {
+		Data struct {
+			Data     map[string]any `json:"data"`
+			Metadata struct {
+				Version int64 `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, c.mountPath+"/data/"+path, &resp); err != nil {
+		return nil, "", err
+	}
+	if resp.Data.Data == nil {
+		// Latest version of the secret was deleted
+		return nil, "", fmt.Errorf("secret %q has no data", path)
+	}
+	data, err := secretDataToStrings(resp.Data.Data)
+	return data, strconv.FormatInt(resp.Data.Metadata.Version, 10), err
+}
+
+// login obtains a client token for the configured auth method, if it doesn't have one yet.
+func (c *vaultClient) login(ctx context.Context) error {
+	if c.token != "" {
+		return nil
+	}
+
+	method, err := c.auth.Discriminator()
+	if err != nil {
+		return fmt.Errorf("failed to get secret store auth method: %w", err)
+	}
+	switch domain.SecretStoreAuthMethod(method) {
+	case domain.SecretStoreAuthMethodToken:
+		tokenAuth, err := c.auth.AsTokenSecretStoreAuth()
+		if err != nil {
+			return fmt.Errorf("failed to parse token auth: %w", err)
+		}
+		c.token = tokenAuth.Token
+	case domain.SecretStoreAuthMethodAppRole:
+		appRoleAuth, err := c.auth.AsAppRoleSecretStoreAuth()
+		if err != nil {
+			return fmt.Errorf("failed to parse AppRole auth: %w", err)
+		}
+		body, err := json.Marshal(map[string]string{
+			"role_id":   appRoleAuth.RoleId,
+			"secret_id": appRoleAuth.SecretId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal AppRole login request: %w", err)
+		}
+		mountPath := strings.Trim(lo.FromPtrOr(appRoleAuth.MountPath, defaultSecretStoreAppRoleMountPath), "/")
+		respBody, err := c.do(ctx, http.MethodPost, "auth/"+mountPath+"/login", body)
+		if err != nil {
+			return fmt.Errorf("failed to log in with AppRole: %w", err)
+		}
+		var resp struct {
+			Auth struct {
+				ClientToken string `json:"client_token"`
+			} `json:"auth"`
+		}
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			return fmt.Errorf("failed to parse AppRole login response: %w", err)
+		}
+		if resp.Auth.ClientToken == "" {
+			return fmt.Errorf("AppRole login response did not include a client token")
+		}
+		c.token = resp.Auth.ClientToken
+	default:
+		return fmt.Errorf("unsupported secret store auth method: %s", method)
+	}
+	return nil
+}
+
+func (c *vaultClient) get(ctx context.Context, apiPath string, out any) error {
+	body, err := c.do(ctx, http.MethodGet, apiPath, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+func (c *vaultClient) do(ctx context.Context, method string, apiPath string, body []byte) ([]byte, error) {
+	target, err := url.JoinPath(c.address, "v1", apiPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request URL: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to secret store: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from secret store: %s", resp.StatusCode, vaultErrors(resp.StatusCode, respBody))
+	}
+	return respBody, nil
+}
+
+// vaultErrors extracts the error messages from a Vault error response.
+func vaultErrors(statusCode int, body []byte) string {
+	var resp struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Errors) == 0 {
+		return http.StatusText(statusCode)
+	}
+	return strings.Join(resp.Errors, "; ")
+}
+
+// secretDataToStrings converts the values of a secret to strings. Non-string values are
+// JSON-encoded.
+func secretDataToStrings(data map[string]any) (map[string]string, error) {
+	result := make(map[string]string, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			result[k] = s
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode value of key %q: %w", k, err)
+		}
+		result[k] = string(b)
+	}
+	return result, nil
+}
@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSecretStore(address string, auth domain.SecretStoreAuth, kvVersion *domain.SecretStoreSpecKvVersion) *domain.SecretStore {
+	return &domain.SecretStore{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("vault")},
+		Spec: domain.SecretStoreSpec{
+			Address:   address,
+			Auth:      auth,
+			KvVersion: kvVersion,
+			Namespace: lo.ToPtr("team-a"),
+		},
+	}
+}
+
+func newTestTokenAuth(t *testing.T, token string) domain.SecretStoreAuth {
+	auth := domain.SecretStoreAuth{}
+	require.NoError(t, auth.FromTokenSecretStoreAuth(domain.TokenSecretStoreAuth{Method: domain.SecretStoreAuthMethodToken, Token: token}))
+	return auth
+}
+
+func writeVaultResponse(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// newTestVaultServer serves a KV version 2 secret at secret/apps/myapp and a KV version 1 secret at
+// the same path, accepting the token "s.token" or the one obtained by logging in with AppRole.
+func newTestVaultServer(t *testing.T, requests *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/v1/auth/approle/login" {
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["role_id"] != "my-role" || body["secret_id"] != "my-secret-id" {
+				writeVaultResponse(w, http.StatusBadRequest, map[string]any{"errors": []string{"invalid role or secret ID"}})
+				return
+			}
+			writeVaultResponse(w, http.StatusOK, map[string]any{"auth": map[string]any{"client_token": "s.approle"}})
+			return
+		}
+
+		if r.Header.Get("X-Vault-Namespace") != "team-a" {
+			writeVaultResponse(w, http.StatusBadRequest, map[string]any{"errors": []string{"missing namespace"}})
+			return
+		}
+		if token := r.Header.Get("X-Vault-Token"); token != "s.token" && token != "s.approle" {
+			writeVaultResponse(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			writeVaultResponse(w, http.StatusOK, map[string]any{"data": map[string]any{}})
+		case "/v1/secret/data/apps/myapp":
+			writeVaultResponse(w, http.StatusOK, map[string]any{"data": map[string]any{
+				"data":     map[string]any{"username": "admin", "port": 5432},
+				"metadata": map[string]any{"version": 3},
+			}})
+		case "/v1/secret/apps/myapp":
+			writeVaultResponse(w, http.StatusOK, map[string]any{"data": map[string]any{"username": "admin"}})
+		default:
+			writeVaultResponse(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSecretStoreClient_ReadSecretKvV2(t *testing.T) {
+	require := require.New(t)
+	var requests []string
+	server := newTestVaultServer(t, &requests)
+
+	client, err := NewSecretStoreClient(newTestSecretStore(server.URL, newTestTokenAuth(t, "s.token"), nil))
+	require.NoError(err)
+
+	data, version, err := client.ReadSecret(context.Background(), "/apps/myapp")
+	require.NoError(err)
+	require.Equal(map[string]string{"username": "admin", "port": "5432"}, data)
+	require.Equal("3", version)
+}
+
+func TestSecretStoreClient_ReadSecretKvV1(t *testing.T) {
+	require := require.New(t)
+	var requests []string
+	server := newTestVaultServer(t, &requests)
+
+	client, err := NewSecretStoreClient(newTestSecretStore(server.URL, newTestTokenAuth(t, "s.token"), lo.ToPtr(domain.SecretStoreKvVersion1)))
+	require.NoError(err)
+
+	data, version, err := client.ReadSecret(context.Background(), "apps/myapp")
+	require.NoError(err)
+	require.Equal(map[string]string{"username": "admin"}, data)
+	require.Empty(version)
+}
+
+func TestSecretStoreClient_AppRoleLogin(t *testing.T) {
+	require := require.New(t)
+	var requests []string
+	server := newTestVaultServer(t, &requests)
+
+	auth := domain.SecretStoreAuth{}
+	require.NoError(auth.FromAppRoleSecretStoreAuth(domain.AppRoleSecretStoreAuth{
+		Method:   domain.SecretStoreAuthMethodAppRole,
+		RoleId:   "my-role",
+		SecretId: "my-secret-id",
+	}))
+	client, err := NewSecretStoreClient(newTestSecretStore(server.URL, auth, nil))
+	require.NoError(err)
+
+	require.NoError(client.TestAccess(context.Background()))
+	_, _, err = client.ReadSecret(context.Background(), "apps/myapp")
+	require.NoError(err)
+	// The client token is reused after the first login
+	require.Equal([]string{
+		"POST /v1/auth/approle/login",
+		"GET /v1/auth/token/lookup-self",
+		"GET /v1/secret/data/apps/myapp",
+	}, requests)
+}
+
+func TestSecretStoreClient_Errors(t *testing.T) {
+	require := require.New(t)
+	var requests []string
+	server := newTestVaultServer(t, &requests)
+
+	client, err := NewSecretStoreClient(newTestSecretStore(server.URL, newTestTokenAuth(t, "s.bad"), nil))
+	require.NoError(err)
+	err = client.TestAccess(context.Background())
+	require.ErrorContains(err, "permission denied")
+
+	client, err = NewSecretStoreClient(newTestSecretStore(server.URL, newTestTokenAuth(t, "s.token"), nil))
+	require.NoError(err)
+	_, _, err = client.ReadSecret(context.Background(), "apps/missing")
+	require.ErrorContains(err, "404")
+}
+
+func TestSecretStoreClient_MinTLSVersion(t *testing.T) {
+	require := require.New(t)
+
+	client, err := NewSecretStoreClient(newTestSecretStore("https://vault.example.com", newTestTokenAuth(t, "s.token"), nil))
+	require.NoError(err)
+	transport := client.(*vaultClient).client.Transport.(*http.Transport)
+	require.Equal(uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+}
+
+func TestHashRenderedWithVersions(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("abc", hashRenderedWithVersions("abc", nil))
+
+	v1 := hashRenderedWithVersions("abc", map[string]string{"vault:apps/myapp": "1"})
+	v2 := hashRenderedWithVersions("abc", map[string]string{"vault:apps/myapp": "2"})
+	require.NotEqual("abc", v1)
+	require.NotEqual(v1, v2)
+}
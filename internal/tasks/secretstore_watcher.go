@@ -0,0 +1,190 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The SecretStoreWatcher periodically tests access to each SecretStore of an organization and
+// sets its Accessible condition. It then checks the secrets referenced by the organization's
+// devices for new versions. The last version seen of each secret is kept in the KV store, and
+// when a newer version is found, a ReferencedSecretUpdated event is emitted for every device
+// referencing the secret so that the device is rendered again.
+//
+// Only versioned (KV version 2) secrets are watched. The first time a secret is seen its version
+// is only recorded, as the devices referencing it already rendered the version current at the time.
+type SecretStoreWatcher struct {
+	log               logrus.FieldLogger
+	serviceHandler    service.Service
+	kvStore           kvstore.KVStore
+	secretStoreClient SecretStoreClientFactory
+}
+
+func NewSecretStoreWatcher(log logrus.FieldLogger, serviceHandler service.Service, kvStore kvstore.KVStore, secretStoreClient SecretStoreClientFactory) *SecretStoreWatcher {
+	if secretStoreClient == nil {
+		secretStoreClient = NewSecretStoreClient
+	}
+	return &SecretStoreWatcher{
+		log:               log,
+		serviceHandler:    serviceHandler,
+		kvStore:           kvStore,
+		secretStoreClient: secretStoreClient,
+	}
+}
+
+type secretRef struct {
+	secretStore string
+	path        string
+}
+
+func (w *SecretStoreWatcher) Poll(ctx context.Context, orgId uuid.UUID) {
+	log := log.WithReqIDFromCtx(ctx, w.log)
+
+	log.Info("Running SecretStoreWatcher")
+
+	clients := w.testSecretStores(ctx, log, orgId)
+	if len(clients) == 0 {
+		return
+	}
+
+	referers, err := w.listSecretReferers(ctx, orgId)
+	if err != nil {
+		log.Errorf("error fetching devices: %v", err)
+		return
+	}
+
+	refs := lo.Keys(referers)
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].secretStore+":"+refs[i].path < refs[j].secretStore+":"+refs[j].path
+	})
+	for _, ref := range refs {
+		client, ok := clients[ref.secretStore]
+		if !ok {
+			continue
+		}
+		if w.secretVersionChanged(ctx, log, orgId, client, ref) {
+			for _, deviceName := range referers[ref] {
+				w.serviceHandler.CreateEvent(ctx, orgId, servicecommon.GetReferencedSecretUpdatedEvent(ctx, deviceName, ref.secretStore, ref.path))
+			}
+		}
+	}
+}
+
+// testSecretStores tests access to every SecretStore of the organization, updates their
+// Accessible condition, and returns clients for the accessible ones keyed by store name.
+func (w *SecretStoreWatcher) testSecretStores(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) map[string]SecretStoreClient {
+	clients := map[string]SecretStoreClient{}
+	listParams := domain.ListSecretStoresParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		secretStores, status := w.serviceHandler.ListSecretStores(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			log.Errorf("error fetching secret stores: %s", status.Message)
+			return clients
+		}
+
+		for i := range secretStores.Items {
+			secretStore := secretStores.Items[i]
+			name := lo.FromPtr(secretStore.Metadata.Name)
+
+			client, accessErr := w.secretStoreClient(&secretStore)
+			if accessErr == nil {
+				accessErr = client.TestAccess(ctx)
+			}
+			if accessErr == nil {
+				clients[name] = client
+			}
+
+			if secretStore.Status == nil {
+				secretStore.Status = &domain.SecretStoreStatus{Conditions: []domain.Condition{}}
+			}
+			if _, status := w.serviceHandler.ReplaceSecretStoreStatusByError(ctx, orgId, name, secretStore, accessErr); status.Code != http.StatusOK {
+				log.Errorf("Failed to update secret store status for %s: %s", name, status.Message)
+			}
+		}
+
+		if secretStores.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = secretStores.Metadata.Continue
+	}
+	return clients
+}
+
+// listSecretReferers returns the names of the devices referencing each secret.
+func (w *SecretStoreWatcher) listSecretReferers(ctx context.Context, orgId uuid.UUID) (map[secretRef][]string, error) {
+	referers := map[secretRef][]string{}
+	listParams := domain.ListDevicesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		devices, status := w.serviceHandler.ListDevices(ctx, orgId, listParams, nil)
+		if status.Code != http.StatusOK {
+			return nil, service.ApiStatusToErr(status)
+		}
+
+		for _, device := range devices.Items {
+			if device.Spec == nil || device.Spec.Config == nil {
+				continue
+			}
+			for _, configItem := range *device.Spec.Config {
+				configType, err := configItem.Type()
+				if err != nil || configType != domain.SecretStoreConfigProviderType {
+					continue
+				}
+				secretSpec, err := configItem.AsSecretConfigProviderSpec()
+				if err != nil {
+					continue
+				}
+				ref := secretRef{secretStore: secretSpec.SecretStoreRef.SecretStore, path: secretSpec.SecretStoreRef.Path}
+				referers[ref] = append(referers[ref], lo.FromPtr(device.Metadata.Name))
+			}
+		}
+
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+	return referers, nil
+}
+
+// secretVersionChanged reads the current version of the secret, records it as the last version seen,
+// and returns true if it is newer than the previously recorded version.
+func (w *SecretStoreWatcher) secretVersionChanged(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, client SecretStoreClient, ref secretRef) bool {
+	_, version, err := client.ReadSecret(ctx, ref.path)
+	if err != nil {
+		log.Warnf("failed reading secret %s/%s: %v", ref.secretStore, ref.path, err)
+		return false
+	}
+	if version == "" {
+		// Unversioned secrets can't be watched
+		return false
+	}
+	versionNum, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		log.Warnf("unexpected version %q of secret %s/%s", version, ref.secretStore, ref.path)
+		return false
+	}
+
+	key := kvstore.SecretStoreVersionKey{OrgID: orgId, SecretStore: ref.secretStore, Path: ref.path}
+	lastSeen, err := w.kvStore.Get(ctx, key.ComposeKey())
+	if err != nil {
+		log.Errorf("failed fetching last seen version of secret %s/%s: %v", ref.secretStore, ref.path, err)
+		return false
+	}
+	updated, err := w.kvStore.SetIfGreater(ctx, key.ComposeKey(), versionNum)
+	if err != nil {
+		log.Errorf("failed storing version of secret %s/%s: %v", ref.secretStore, ref.path, err)
+		return false
+	}
+	return updated && lastSeen != nil
+}
@@ -9,11 +9,20 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 )
 
-func NewFactory() processor.Factory {
+// NewFactory creates the factory of the processor labeling telemetry with the attributes of the
+// device sending it. Logs are also labeled with the name, fleet and labels of the device returned
+// by lookup, if not nil.
+func NewFactory(lookup DeviceLookup) processor.Factory {
 	return processor.NewFactory(
 		component.MustNewType("deviceattrs"),
 		createDefaultConfig,
 		processor.WithMetrics(createMetricsProcessor, component.StabilityLevelAlpha),
+		processor.WithLogs(func(ctx context.Context, set processor.Settings, cfg component.Config, next consumer.Logs) (processor.Logs, error) {
+			p := &deviceattrs{lookup: lookup, logger: set.Logger}
+			return processorhelper.NewLogs(
+				ctx, set, cfg, next, p.processLogs, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+			)
+		}, component.StabilityLevelAlpha),
 	)
 }
 
@@ -0,0 +1,120 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+)
+
+// DefaultDeviceCacheTTL is how long the attributes of a device are cached by default.
+const DefaultDeviceCacheTTL = time.Minute
+
+// maxCachedDevices is the number of cached devices past which expired entries are evicted.
+const maxCachedDevices = 100000
+
+// DeviceInfo holds the attributes of a device added to its telemetry.
+type DeviceInfo struct {
+	Name   string
+	Fleet  string
+	Labels map[string]string
+}
+
+// DeviceLookup returns the attributes of the device of an organization, or nil if it does not exist.
+type DeviceLookup interface {
+	Lookup(ctx context.Context, orgID uuid.UUID, name string) (*DeviceInfo, error)
+}
+
+// DeviceGetter reads a device, as implemented by the device store.
+type DeviceGetter interface {
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error)
+}
+
+type cacheKey struct {
+	orgID uuid.UUID
+	name  string
+}
+
+type cacheEntry struct {
+	info    *DeviceInfo
+	expires time.Time
+}
+
+type deviceCache struct {
+	devices DeviceGetter
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+// NewDeviceCache returns a DeviceLookup reading devices with the getter, and caching their
+// attributes for ttl so that devices sending telemetry frequently do not each hit the database.
+func NewDeviceCache(devices DeviceGetter, ttl time.Duration) DeviceLookup {
+	if ttl <= 0 {
+		ttl = DefaultDeviceCacheTTL
+	}
+	return &deviceCache{
+		devices: devices,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[cacheKey]cacheEntry),
+	}
+}
+
+func (c *deviceCache) Lookup(ctx context.Context, orgID uuid.UUID, name string) (*DeviceInfo, error) {
+	key := cacheKey{orgID: orgID, name: name}
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.info, nil
+	}
+
+	device, err := c.devices.Get(ctx, orgID, name)
+	if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, fmt.Errorf("getting device %s: %w", name, err)
+	}
+	var info *DeviceInfo
+	if device != nil {
+		info = deviceInfo(device)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCachedDevices {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCachedDevices {
+			return info, nil
+		}
+	}
+	c.entries[key] = cacheEntry{info: info, expires: now.Add(c.ttl)}
+	return info, nil
+}
+
+func deviceInfo(device *domain.Device) *DeviceInfo {
+	info := &DeviceInfo{}
+	if device.Metadata.Name != nil {
+		info.Name = *device.Metadata.Name
+	}
+	if device.Metadata.Labels != nil {
+		info.Labels = *device.Metadata.Labels
+	}
+	if kind, name, err := util.GetResourceOwner(device.Metadata.Owner); err == nil && kind == domain.FleetKind {
+		info.Fleet = name
+	}
+	return info
+}
@@ -0,0 +1,88 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDevices struct {
+	devices map[string]*domain.Device
+	err     error
+	calls   int
+}
+
+func (f *fakeDevices) Get(_ context.Context, _ uuid.UUID, name string) (*domain.Device, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	device, ok := f.devices[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return device, nil
+}
+
+func newDevice(name string, labels map[string]string, owner *string) *domain.Device {
+	return &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name:   &name,
+			Labels: &labels,
+			Owner:  owner,
+		},
+	}
+}
+
+func TestDeviceCache(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgID := uuid.New()
+
+	devices := &fakeDevices{devices: map[string]*domain.Device{
+		"dev1": newDevice("dev1", map[string]string{"site": "a"}, util.SetResourceOwner(domain.FleetKind, "fleet1")),
+		"dev2": newDevice("dev2", map[string]string{}, nil),
+	}}
+	now := time.Now()
+	cache := NewDeviceCache(devices, time.Minute).(*deviceCache)
+	cache.now = func() time.Time { return now }
+
+	info, err := cache.Lookup(ctx, orgID, "dev1")
+	require.NoError(err)
+	require.Equal(&DeviceInfo{Name: "dev1", Fleet: "fleet1", Labels: map[string]string{"site": "a"}}, info)
+
+	info, err = cache.Lookup(ctx, orgID, "dev2")
+	require.NoError(err)
+	require.Equal("dev2", info.Name)
+	require.Empty(info.Fleet)
+
+	// missing devices are cached too
+	info, err = cache.Lookup(ctx, orgID, "missing")
+	require.NoError(err)
+	require.Nil(info)
+
+	_, _ = cache.Lookup(ctx, orgID, "dev1")
+	_, _ = cache.Lookup(ctx, orgID, "missing")
+	require.Equal(3, devices.calls)
+
+	// entries are read again once expired
+	now = now.Add(2 * time.Minute)
+	_, err = cache.Lookup(ctx, orgID, "dev1")
+	require.NoError(err)
+	require.Equal(4, devices.calls)
+
+	// errors are not cached
+	devices.err = errors.New("connection refused")
+	_, err = cache.Lookup(ctx, orgID, "dev2")
+	require.Error(err)
+	_, err = cache.Lookup(ctx, orgID, "dev2")
+	require.Error(err)
+	require.Equal(6, devices.calls)
+}
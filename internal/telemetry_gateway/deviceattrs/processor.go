@@ -8,17 +8,23 @@ import (
 	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceauth"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
 )
 
-type deviceattrs struct{}
+type deviceattrs struct {
+	lookup DeviceLookup
+	logger *zap.Logger
+}
 
 var (
 	// Signals the processor refuses to process unauthenticated data.
 	ErrUnauthenticated = errors.New("device unauthenticated")
 )
 
-func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+// deviceIdentity returns the device and organization IDs set in the context by the device authenticator.
+func deviceIdentity(ctx context.Context) (string, string, error) {
 	var devID, orgID string
 
 	if v := ctx.Value(deviceauth.DeviceIDKey); v != nil {
@@ -28,8 +34,7 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 	}
 
 	if devID == "" {
-		return pmetric.NewMetrics(),
-			consumererror.NewPermanent(fmt.Errorf("%w: missing device_id in context", ErrUnauthenticated))
+		return "", "", consumererror.NewPermanent(fmt.Errorf("%w: missing device_id in context", ErrUnauthenticated))
 	}
 
 	if v := ctx.Value(deviceauth.DeviceOrgIDKey); v != nil {
@@ -42,8 +47,15 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 	}
 
 	if orgID == "" {
-		return pmetric.NewMetrics(),
-			consumererror.NewPermanent(fmt.Errorf("%w: missing org_id in context", ErrUnauthenticated))
+		return "", "", consumererror.NewPermanent(fmt.Errorf("%w: missing org_id in context", ErrUnauthenticated))
+	}
+	return devID, orgID, nil
+}
+
+func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	devID, orgID, err := deviceIdentity(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
 	}
 
 	rms := md.ResourceMetrics()
@@ -100,3 +112,51 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 	}
 	return md, nil
 }
+
+// deviceInfoAttributes are the resource attributes of logs set from the device looked up in the
+// service. Since the logs are routed by the labels of their device, these attributes are removed
+// from the logs sent by the device, so that a device can't claim another name, fleet or labels.
+var deviceInfoAttributes = []string{"device_name", "fleet", "labels"}
+
+// processLogs labels logs with the identity of the device, and with its name, fleet and labels if
+// the device can be looked up.
+func (p *deviceattrs) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	devID, orgID, err := deviceIdentity(ctx)
+	if err != nil {
+		return plog.NewLogs(), err
+	}
+
+	var info *DeviceInfo
+	if p.lookup != nil {
+		if orgUUID, parseErr := uuid.Parse(orgID); parseErr == nil {
+			info, err = p.lookup.Lookup(ctx, orgUUID, devID)
+			if err != nil {
+				// logs are still accepted, only without the attributes of the device
+				p.logger.Warn("failed to look up device attributes", zap.String("device_id", devID), zap.Error(err))
+			}
+		}
+	}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		ra := rls.At(i).Resource().Attributes()
+		for _, key := range deviceInfoAttributes {
+			ra.Remove(key)
+		}
+		ra.PutStr("device_id", devID)
+		ra.PutStr("org_id", orgID)
+		if info == nil {
+			continue
+		}
+		ra.PutStr("device_name", info.Name)
+		if info.Fleet != "" {
+			ra.PutStr("fleet", info.Fleet)
+		}
+		labels := ra.PutEmptyMap("labels")
+		labels.EnsureCapacity(len(info.Labels))
+		for k, v := range info.Labels {
+			labels.PutStr(k, v)
+		}
+	}
+	return ld, nil
+}
@@ -0,0 +1,126 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+type fakeLookup struct {
+	info *DeviceInfo
+	err  error
+}
+
+func (f *fakeLookup) Lookup(context.Context, uuid.UUID, string) (*DeviceInfo, error) {
+	return f.info, f.err
+}
+
+// newLogs returns logs whose resource attributes claim the identity of another device.
+func newLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	ra := rl.Resource().Attributes()
+	ra.PutStr("device_id", "other")
+	ra.PutStr("device_name", "other")
+	ra.PutStr("fleet", "other-fleet")
+	ra.PutEmptyMap("labels").PutStr("site", "other")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	return ld
+}
+
+func TestProcessLogs(t *testing.T) {
+	orgID := uuid.New()
+	authenticated := context.WithValue(context.WithValue(context.Background(), deviceauth.DeviceIDKey, "dev1"), deviceauth.DeviceOrgIDKey, orgID)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		lookup      DeviceLookup
+		wantErr     error
+		wantAttrs   map[string]any
+		wantNoAttrs []string
+	}{
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name: "device found",
+			ctx:  authenticated,
+			lookup: &fakeLookup{info: &DeviceInfo{
+				Name:   "dev1",
+				Fleet:  "fleet1",
+				Labels: map[string]string{"site": "a"},
+			}},
+			wantAttrs: map[string]any{
+				"device_id":   "dev1",
+				"org_id":      orgID.String(),
+				"device_name": "dev1",
+				"fleet":       "fleet1",
+				"labels":      map[string]any{"site": "a"},
+			},
+		},
+		{
+			name: "device without fleet",
+			ctx:  authenticated,
+			lookup: &fakeLookup{info: &DeviceInfo{
+				Name:   "dev1",
+				Labels: map[string]string{"site": "a"},
+			}},
+			wantAttrs: map[string]any{
+				"device_id":   "dev1",
+				"device_name": "dev1",
+				"labels":      map[string]any{"site": "a"},
+			},
+			wantNoAttrs: []string{"fleet"},
+		},
+		{
+			name:        "device not found",
+			ctx:         authenticated,
+			lookup:      &fakeLookup{},
+			wantAttrs:   map[string]any{"device_id": "dev1", "org_id": orgID.String()},
+			wantNoAttrs: []string{"device_name", "fleet", "labels"},
+		},
+		{
+			name:        "lookup error",
+			ctx:         authenticated,
+			lookup:      &fakeLookup{err: errors.New("connection refused")},
+			wantAttrs:   map[string]any{"device_id": "dev1", "org_id": orgID.String()},
+			wantNoAttrs: []string{"device_name", "fleet", "labels"},
+		},
+		{
+			name:        "no lookup",
+			ctx:         authenticated,
+			wantAttrs:   map[string]any{"device_id": "dev1", "org_id": orgID.String()},
+			wantNoAttrs: []string{"device_name", "fleet", "labels"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			p := &deviceattrs{lookup: tt.lookup, logger: zap.NewNop()}
+
+			ld, err := p.processLogs(tt.ctx, newLogs())
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+
+			attrs := ld.ResourceLogs().At(0).Resource().Attributes().AsRaw()
+			for k, v := range tt.wantAttrs {
+				require.Equal(v, attrs[k], k)
+			}
+			for _, k := range tt.wantNoAttrs {
+				require.NotContains(attrs, k)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package deviceauth
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/config"
@@ -134,8 +136,10 @@ func (d *deviceAuth) authenticate(state tls.ConnectionState) (CertInfo, error) {
 	}
 
 	expectedSigner := ""
+	var managementSigners []string
 	if d.cfg != nil && d.cfg.AppCfg != nil {
 		expectedSigner = d.cfg.AppCfg.CA.DeviceSvcClientSignerName
+		managementSigners = []string{d.cfg.AppCfg.CA.DeviceManagementSignerName, d.cfg.AppCfg.CA.DeviceManagementRenewalSignerName}
 	}
 
 	for i, cert := range state.PeerCertificates {
@@ -150,6 +154,11 @@ func (d *deviceAuth) authenticate(state tls.ConnectionState) (CertInfo, error) {
 			continue
 		}
 
+		// The agent forwards its own telemetry with its management certificate.
+		if slices.Contains(managementSigners, signerName) {
+			return d.authenticateManagementCert(i, cert)
+		}
+
 		if signerName != expectedSigner {
 			d.logger.Debug("skipping cert: signer mismatch",
 				zap.Int("index", i),
@@ -186,18 +195,9 @@ func (d *deviceAuth) authenticate(state tls.ConnectionState) (CertInfo, error) {
 			return CertInfo{}, fmt.Errorf("device ID mismatch: CN-derived=%s, extension=%s", deviceID, deviceFingerprint)
 		}
 
-		orgID, hasOrg, err := signer.GetOrgIDExtensionFromCert(cert)
+		orgID, err := d.orgID(i, cert)
 		if err != nil {
-			d.logger.Error("failed to extract org ID from extension",
-				zap.Int("index", i),
-				zap.String("cn", cn),
-				zap.String("error", err.Error()))
-			return CertInfo{}, fmt.Errorf("device orgid from extension: %w", err)
-		}
-		if !hasOrg {
-			d.logger.Warn("missing org ID extension",
-				zap.Int("index", i),
-				zap.String("cn", cn))
+			return CertInfo{}, err
 		}
 
 		return CertInfo{
@@ -211,3 +211,61 @@ func (d *deviceAuth) authenticate(state tls.ConnectionState) (CertInfo, error) {
 		zap.String("expected_signer", expectedSigner))
 	return CertInfo{}, fmt.Errorf("no certificate found with expected signer %q", expectedSigner)
 }
+
+// authenticateManagementCert validates a device management certificate. Its CN is the device name
+// with the device common name prefix, and its fingerprint extension holds the full CN.
+func (d *deviceAuth) authenticateManagementCert(index int, cert *x509.Certificate) (CertInfo, error) {
+	cn := cert.Subject.CommonName
+	deviceID, err := signer.DeviceFingerprintFromCN(d.cfg.AppCfg.CA, cn)
+	if err != nil {
+		d.logger.Warn("invalid management certificate CN",
+			zap.Int("index", index),
+			zap.String("cn", cn),
+			zap.String("error", err.Error()))
+		return CertInfo{}, fmt.Errorf("invalid CN format: %w", err)
+	}
+
+	deviceFingerprint, err := signer.GetDeviceFingerprintExtension(cert)
+	if err != nil {
+		d.logger.Error("failed to extract device fingerprint from extension",
+			zap.Int("index", index),
+			zap.String("cn", cn),
+			zap.String("error", err.Error()))
+		return CertInfo{}, fmt.Errorf("device fingerprint from extension: %w", err)
+	}
+	if deviceFingerprint != cn {
+		d.logger.Warn("device ID mismatch",
+			zap.Int("index", index),
+			zap.String("cn", cn),
+			zap.String("device_id_ext", deviceFingerprint))
+		return CertInfo{}, fmt.Errorf("device ID mismatch: CN=%s, extension=%s", cn, deviceFingerprint)
+	}
+
+	orgID, err := d.orgID(index, cert)
+	if err != nil {
+		return CertInfo{}, err
+	}
+
+	return CertInfo{
+		CommonName: cn,
+		DeviceID:   deviceID,
+		OrgID:      orgID,
+	}, nil
+}
+
+func (d *deviceAuth) orgID(index int, cert *x509.Certificate) (uuid.UUID, error) {
+	orgID, hasOrg, err := signer.GetOrgIDExtensionFromCert(cert)
+	if err != nil {
+		d.logger.Error("failed to extract org ID from extension",
+			zap.Int("index", index),
+			zap.String("cn", cert.Subject.CommonName),
+			zap.String("error", err.Error()))
+		return uuid.Nil, fmt.Errorf("device orgid from extension: %w", err)
+	}
+	if !hasOrg {
+		d.logger.Warn("missing org ID extension",
+			zap.Int("index", index),
+			zap.String("cn", cert.Subject.CommonName))
+	}
+	return orgID, nil
+}
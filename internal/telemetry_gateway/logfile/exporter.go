@@ -0,0 +1,50 @@
+package logfile
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type fileExporter struct {
+	mu        sync.Mutex
+	writer    *lumberjack.Logger
+	marshaler plog.JSONMarshaler
+}
+
+func newFileExporter(cfg *Config) *fileExporter {
+	return &fileExporter{
+		writer: &lumberjack.Logger{
+			Filename:   cfg.Path,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   cfg.Compress,
+		},
+	}
+}
+
+// consumeLogs writes the logs as a single line of OTLP JSON.
+func (e *fileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	data, err := e.marshaler.MarshalLogs(ld)
+	if err != nil {
+		return fmt.Errorf("marshaling logs: %w", err)
+	}
+	data = append(data, '\n')
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.writer.Write(data); err != nil {
+		return fmt.Errorf("writing logs: %w", err)
+	}
+	return nil
+}
+
+func (e *fileExporter) shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.writer.Close()
+}
@@ -0,0 +1,52 @@
+package logfile
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestConsumeLogs(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "logs", "devices.jsonl")
+	e := newFileExporter(&Config{Path: path, MaxSizeMB: DefaultMaxSizeMB, MaxBackups: DefaultMaxBackups})
+
+	for _, body := range []string{"first", "second"} {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("device_id", "dev1")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(body)
+		require.NoError(e.consumeLogs(context.Background(), ld))
+	}
+	require.NoError(e.shutdown(context.Background()))
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+
+	var unmarshaler plog.JSONUnmarshaler
+	var bodies []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		ld, err := unmarshaler.UnmarshalLogs(scanner.Bytes())
+		require.NoError(err)
+		rl := ld.ResourceLogs().At(0)
+		deviceID, _ := rl.Resource().Attributes().Get("device_id")
+		require.Equal("dev1", deviceID.Str())
+		bodies = append(bodies, rl.ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	}
+	require.NoError(scanner.Err())
+	require.Equal([]string{"first", "second"}, bodies)
+}
+
+func TestConfigValidate(t *testing.T) {
+	require := require.New(t)
+	require.NoError((&Config{Path: "/var/log/devices.jsonl"}).Validate())
+	require.Error((&Config{}).Validate())
+	require.Error((&Config{Path: "/var/log/devices.jsonl", MaxBackups: -1}).Validate())
+}
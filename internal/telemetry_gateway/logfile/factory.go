@@ -0,0 +1,63 @@
+package logfile
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// DefaultMaxSizeMB is the default size in megabytes past which the file is rotated.
+	DefaultMaxSizeMB = 100
+	// DefaultMaxBackups is the default number of rotated files kept.
+	DefaultMaxBackups = 5
+)
+
+// Config configures the file logs are written to.
+type Config struct {
+	// Path is the path of the file.
+	Path string `mapstructure:"path"`
+	// MaxSizeMB is the size in megabytes past which the file is rotated.
+	MaxSizeMB int `mapstructure:"max_size_mb"`
+	// MaxBackups is the number of rotated files kept.
+	MaxBackups int `mapstructure:"max_backups"`
+	// MaxAgeDays is the number of days rotated files are kept. Rotated files are not removed by age if 0.
+	MaxAgeDays int `mapstructure:"max_age_days"`
+	// Compress compresses rotated files with gzip.
+	Compress bool `mapstructure:"compress"`
+}
+
+func (c *Config) Validate() error {
+	if c.Path == "" {
+		return errors.New("path is required")
+	}
+	if c.MaxSizeMB < 0 || c.MaxBackups < 0 || c.MaxAgeDays < 0 {
+		return errors.New("max_size_mb, max_backups and max_age_days cannot be negative")
+	}
+	return nil
+}
+
+// NewFactory creates the factory of the exporter writing logs as OTLP JSON lines to a local file
+// rotated by size.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		component.MustNewType("logfile"),
+		func() component.Config {
+			return &Config{
+				MaxSizeMB:  DefaultMaxSizeMB,
+				MaxBackups: DefaultMaxBackups,
+			}
+		},
+		exporter.WithLogs(createLogsExporter, component.StabilityLevelAlpha),
+	)
+}
+
+func createLogsExporter(ctx context.Context, set exporter.Settings, cfg component.Config) (exporter.Logs, error) {
+	e := newFileExporter(cfg.(*Config))
+	return exporterhelper.NewLogs(ctx, set, cfg, e.consumeLogs,
+		exporterhelper.WithShutdown(e.shutdown),
+	)
+}
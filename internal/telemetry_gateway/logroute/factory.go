@@ -0,0 +1,70 @@
+package logroute
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Config selects the logs of a route by the labels of the devices sending them.
+type Config struct {
+	// Match is the label selector of the route. Empty for the default route.
+	Match string `mapstructure:"match"`
+	// Exclude are the label selectors of the routes taking precedence over this route.
+	Exclude []string `mapstructure:"exclude"`
+}
+
+// Validate checks that the label selectors of the route can be parsed.
+func (c *Config) Validate() error {
+	_, _, err := c.selectors()
+	return err
+}
+
+func (c *Config) selectors() (labels.Selector, []labels.Selector, error) {
+	var match labels.Selector
+	if c.Match != "" {
+		var err error
+		if match, err = labels.Parse(c.Match); err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector %q: %w", c.Match, err)
+		}
+	}
+	exclude := make([]labels.Selector, 0, len(c.Exclude))
+	for _, s := range c.Exclude {
+		selector, err := labels.Parse(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector %q: %w", s, err)
+		}
+		exclude = append(exclude, selector)
+	}
+	return match, exclude, nil
+}
+
+// NewFactory creates the factory of the processor keeping the logs of the devices of a route.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		component.MustNewType("logroute"),
+		func() component.Config { return &Config{} },
+		processor.WithLogs(createLogsProcessor, component.StabilityLevelAlpha),
+	)
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	next consumer.Logs,
+) (processor.Logs, error) {
+	match, exclude, err := cfg.(*Config).selectors()
+	if err != nil {
+		return nil, err
+	}
+	p := &logroute{match: match, exclude: exclude}
+	return processorhelper.NewLogs(
+		ctx, set, cfg, next, p.processLogs, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+	)
+}
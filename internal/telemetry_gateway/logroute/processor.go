@@ -0,0 +1,52 @@
+package logroute
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// labelsAttribute is the resource attribute holding the labels of the device, set by deviceattrs.
+const labelsAttribute = "labels"
+
+type logroute struct {
+	match   labels.Selector
+	exclude []labels.Selector
+}
+
+// processLogs keeps the logs of the devices whose labels match the route, and none of the routes
+// taking precedence over it.
+func (p *logroute) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		return !p.matches(deviceLabels(rl.Resource().Attributes()))
+	})
+	if ld.ResourceLogs().Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+func (p *logroute) matches(set labels.Set) bool {
+	for _, selector := range p.exclude {
+		if selector.Matches(set) {
+			return false
+		}
+	}
+	return p.match == nil || p.match.Matches(set)
+}
+
+func deviceLabels(attrs pcommon.Map) labels.Set {
+	set := labels.Set{}
+	value, ok := attrs.Get(labelsAttribute)
+	if !ok || value.Type() != pcommon.ValueTypeMap {
+		return set
+	}
+	value.Map().Range(func(k string, v pcommon.Value) bool {
+		set[k] = v.AsString()
+		return true
+	})
+	return set
+}
@@ -0,0 +1,96 @@
+package logroute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+)
+
+func newLogs(devices map[string]map[string]string) plog.Logs {
+	ld := plog.NewLogs()
+	for name, deviceLabels := range devices {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("device_id", name)
+		if deviceLabels != nil {
+			m := rl.Resource().Attributes().PutEmptyMap(labelsAttribute)
+			for k, v := range deviceLabels {
+				m.PutStr(k, v)
+			}
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	}
+	return ld
+}
+
+func deviceIDs(ld plog.Logs) []string {
+	var ids []string
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		v, _ := ld.ResourceLogs().At(i).Resource().Attributes().Get("device_id")
+		ids = append(ids, v.Str())
+	}
+	return ids
+}
+
+func TestProcessLogs(t *testing.T) {
+	devices := map[string]map[string]string{
+		"prod-a": {"env": "prod", "site": "a"},
+		"prod-b": {"env": "prod", "site": "b"},
+		"dev":    {"env": "dev"},
+		"none":   nil,
+	}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "match",
+			cfg:  Config{Match: "env=prod"},
+			want: []string{"prod-a", "prod-b"},
+		},
+		{
+			name: "match excluding previous routes",
+			cfg:  Config{Match: "env=prod", Exclude: []string{"site=a"}},
+			want: []string{"prod-b"},
+		},
+		{
+			name: "default route",
+			cfg:  Config{Exclude: []string{"env=prod"}},
+			want: []string{"dev", "none"},
+		},
+		{
+			name:    "nothing matches",
+			cfg:     Config{Match: "env=staging"},
+			wantErr: processorhelper.ErrSkipProcessingData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.NoError(tt.cfg.Validate())
+			match, exclude, err := tt.cfg.selectors()
+			require.NoError(err)
+			p := &logroute{match: match, exclude: exclude}
+
+			ld, err := p.processLogs(context.Background(), newLogs(devices))
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			require.ElementsMatch(tt.want, deviceIDs(ld))
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	require := require.New(t)
+	require.NoError((&Config{}).Validate())
+	require.Error((&Config{Match: "env in (prod"}).Validate())
+	require.Error((&Config{Exclude: []string{"=prod"}}).Validate())
+}
@@ -0,0 +1,73 @@
+package orgratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"golang.org/x/time/rate"
+)
+
+// Config limits the log records accepted from the devices of each organization.
+type Config struct {
+	// RecordsPerSecond is the average number of log records accepted per second and organization.
+	RecordsPerSecond float64 `mapstructure:"records_per_second"`
+	// Burst is the number of log records accepted at once. Defaults to RecordsPerSecond.
+	Burst int `mapstructure:"burst"`
+}
+
+func (c *Config) Validate() error {
+	if c.RecordsPerSecond <= 0 {
+		return errors.New("records_per_second must be positive")
+	}
+	if c.Burst < 0 {
+		return errors.New("burst cannot be negative")
+	}
+	return nil
+}
+
+// NewFactory creates the factory of the processor rate limiting logs per organization. The limits
+// are shared by all the instances of the processor created by the factory, so that the logs of an
+// organization routed to several pipelines are limited together.
+func NewFactory() processor.Factory {
+	limiters := &limiters{byOrg: map[string]*rate.Limiter{}}
+	return processor.NewFactory(
+		component.MustNewType("orgratelimit"),
+		func() component.Config { return &Config{} },
+		processor.WithLogs(func(ctx context.Context, set processor.Settings, cfg component.Config, next consumer.Logs) (processor.Logs, error) {
+			c := cfg.(*Config)
+			p := &orgratelimit{
+				limiters: limiters,
+				limit:    rate.Limit(c.RecordsPerSecond),
+				burst:    c.Burst,
+				logger:   set.Logger,
+			}
+			if p.burst == 0 {
+				p.burst = max(1, int(c.RecordsPerSecond))
+			}
+			return processorhelper.NewLogs(
+				ctx, set, cfg, next, p.processLogs, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+			)
+		}, component.StabilityLevelAlpha),
+	)
+}
+
+type limiters struct {
+	mu    sync.Mutex
+	byOrg map[string]*rate.Limiter
+}
+
+func (l *limiters) get(orgID string, limit rate.Limit, burst int) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.byOrg[orgID]
+	if !ok {
+		limiter = rate.NewLimiter(limit, burst)
+		l.byOrg[orgID] = limiter
+	}
+	return limiter
+}
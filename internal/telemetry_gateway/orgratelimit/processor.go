@@ -0,0 +1,73 @@
+package orgratelimit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// orgIDAttribute is the resource attribute holding the organization of the device, set by deviceattrs.
+const orgIDAttribute = "org_id"
+
+// warnInterval is the minimum interval between two warnings about dropped log records.
+const warnInterval = time.Minute
+
+type orgratelimit struct {
+	limiters *limiters
+	limit    rate.Limit
+	burst    int
+	logger   *zap.Logger
+
+	dropped  atomic.Int64
+	lastWarn atomic.Int64
+}
+
+// processLogs drops the log records exceeding the rate limit of the organization of the device.
+func (p *orgratelimit) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	now := time.Now()
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		orgID := ""
+		if v, ok := rl.Resource().Attributes().Get(orgIDAttribute); ok {
+			orgID = v.Str()
+		}
+		limiter := p.limiters.get(orgID, p.limit, p.burst)
+
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sls.At(j).LogRecords().RemoveIf(func(plog.LogRecord) bool {
+				if limiter.AllowN(now, 1) {
+					return false
+				}
+				p.dropped.Add(1)
+				return true
+			})
+		}
+	}
+	p.warnDropped(now)
+
+	rls.RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool { return sl.LogRecords().Len() == 0 })
+		return rl.ScopeLogs().Len() == 0
+	})
+	if rls.Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+func (p *orgratelimit) warnDropped(now time.Time) {
+	last := p.lastWarn.Load()
+	if now.UnixNano()-last < int64(warnInterval) || !p.lastWarn.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	if dropped := p.dropped.Swap(0); dropped > 0 {
+		p.logger.Warn("dropped log records exceeding the rate limit", zap.Int64("records", dropped))
+	}
+}
@@ -0,0 +1,56 @@
+package orgratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+func newLogs(orgID string, records int) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(orgIDAttribute, orgID)
+	lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < records; i++ {
+		lrs.AppendEmpty().Body().SetInt(int64(i))
+	}
+	return ld
+}
+
+func TestProcessLogs(t *testing.T) {
+	require := require.New(t)
+	shared := &limiters{byOrg: map[string]*rate.Limiter{}}
+	newProcessor := func() *orgratelimit {
+		return &orgratelimit{limiters: shared, limit: rate.Limit(0.001), burst: 3, logger: zap.NewNop()}
+	}
+	p1, p2 := newProcessor(), newProcessor()
+
+	ld, err := p1.processLogs(context.Background(), newLogs("org1", 2))
+	require.NoError(err)
+	require.Equal(2, ld.LogRecordCount())
+
+	// the burst of an organization is shared by the processors of all pipelines
+	ld, err = p2.processLogs(context.Background(), newLogs("org1", 2))
+	require.NoError(err)
+	require.Equal(1, ld.LogRecordCount())
+
+	_, err = p1.processLogs(context.Background(), newLogs("org1", 1))
+	require.ErrorIs(err, processorhelper.ErrSkipProcessingData)
+
+	// other organizations are limited separately
+	ld, err = p1.processLogs(context.Background(), newLogs("org2", 5))
+	require.NoError(err)
+	require.Equal(3, ld.LogRecordCount())
+}
+
+func TestConfigValidate(t *testing.T) {
+	require := require.New(t)
+	require.NoError((&Config{RecordsPerSecond: 10}).Validate())
+	require.Error((&Config{}).Validate())
+	require.Error((&Config{RecordsPerSecond: 10, Burst: -1}).Validate())
+}
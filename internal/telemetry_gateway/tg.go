@@ -4,15 +4,22 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceattrs"
 	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceauth"
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/logfile"
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/logroute"
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/orgratelimit"
 	"github.com/flightctl/flightctl/pkg/version"
 	"github.com/goccy/go-yaml"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/provider/envprovider"
@@ -23,14 +30,19 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
+// defaultLogsRoute is the route of the logs of the devices matching no route.
+const defaultLogsRoute = "default"
+
 // Option configures how Run builds both the Collector and the OTEL config.
 type Option func(*runOptions)
 
 type runOptions struct {
 	settingsMutators []func(*otelcol.CollectorSettings)
 	cfgMutators      []OTelConfigMutator
+	deviceLookup     deviceattrs.DeviceLookup
 }
 
 // WithCollectorSettings lets callers tweak CollectorSettings before NewCollector.
@@ -43,6 +55,12 @@ func WithSkipSettingGRPCLogger(skip bool) Option {
 	return WithCollectorSettings(func(s *otelcol.CollectorSettings) { s.SkipSettingGRPCLogger = skip })
 }
 
+// WithDeviceLookup labels logs with the name, fleet and labels of the devices returned by lookup,
+// which logs are routed by.
+func WithDeviceLookup(lookup deviceattrs.DeviceLookup) Option {
+	return func(ro *runOptions) { ro.deviceLookup = lookup }
+}
+
 // WithOTelYAMLOverlay merges a YAML snippet into the generated config (deep-merge).
 func WithOTelYAMLOverlay(snippet string) Option {
 	return WithOTelConfigMutator(func(root map[string]any) error {
@@ -132,10 +150,13 @@ func Run(ctx context.Context, cfg *config.Config, opts ...Option) error {
 					component.MustNewType("prometheus"): prometheusreceiver.NewFactory(),
 				},
 				Processors: map[component.Type]processor.Factory{
-					component.MustNewType("deviceattrs"): deviceattrs.NewFactory(),
+					component.MustNewType("deviceattrs"):  deviceattrs.NewFactory(ro.deviceLookup),
+					component.MustNewType("logroute"):     logroute.NewFactory(),
+					component.MustNewType("orgratelimit"): orgratelimit.NewFactory(),
 				},
 				Exporters: map[component.Type]exporter.Factory{
 					component.MustNewType("otlp"):                  otlpexporter.NewFactory(),
+					component.MustNewType("logfile"):               logfile.NewFactory(),
 					component.MustNewType("prometheus"):            prometheusexporter.NewFactory(),
 					component.MustNewType("prometheusremotewrite"): prometheusremotewriteexporter.NewFactory(),
 				},
@@ -167,6 +188,10 @@ func Run(ctx context.Context, cfg *config.Config, opts ...Option) error {
 func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 	exporterNames := []string{}
 	exporters := map[string]any{}
+	processors := map[string]any{
+		"deviceattrs": map[string]any{},
+	}
+	pipelines := map[string]any{}
 
 	if cfg.TelemetryGateway.Export != nil && cfg.TelemetryGateway.Export.Prometheus != "" {
 		exporters["prometheus"] = map[string]any{
@@ -175,31 +200,22 @@ func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 		exporterNames = append(exporterNames, "prometheus")
 	}
 	if cfg.TelemetryGateway.Forward != nil && cfg.TelemetryGateway.Forward.Endpoint != "" {
-		otlp := map[string]any{
-			"endpoint": cfg.TelemetryGateway.Forward.Endpoint,
+		exporters["otlp"] = otlpExporterConfig(cfg.TelemetryGateway.Forward)
+		exporterNames = append(exporterNames, "otlp")
+	}
+	if len(exporterNames) > 0 {
+		pipelines["metrics"] = map[string]any{
+			"receivers":  []string{"otlp/device"},
+			"processors": []string{"deviceattrs"},
+			"exporters":  exporterNames,
 		}
-		if cfg.TelemetryGateway.Forward.TLS != nil {
-			tls := map[string]any{}
-			if cfg.TelemetryGateway.Forward.TLS.InsecureSkipTlsVerify {
-				tls["insecure_skip_verify"] = true
-			}
-			if v := cfg.TelemetryGateway.Forward.TLS.CertFile; v != "" {
-				tls["cert_file"] = v
-			}
-			if v := cfg.TelemetryGateway.Forward.TLS.KeyFile; v != "" {
-				tls["key_file"] = v
-			}
-			if v := cfg.TelemetryGateway.Forward.TLS.CAFile; v != "" {
-				tls["ca_file"] = v
-			}
-			if len(tls) > 0 {
-				otlp["tls"] = tls
-			}
+	}
+	if cfg.TelemetryGateway.Logs != nil {
+		if err := addLogsPipelines(cfg.TelemetryGateway.Logs, exporters, processors, pipelines); err != nil {
+			return nil, err
 		}
-		exporters["otlp"] = otlp
-		exporterNames = append(exporterNames, "otlp")
 	}
-	if len(exporterNames) == 0 {
+	if len(pipelines) == 0 {
 		return nil, fmt.Errorf("no exporters configured")
 	}
 
@@ -219,20 +235,12 @@ func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 				},
 			},
 		},
-		"processors": map[string]any{
-			"deviceattrs": map[string]any{},
-		},
+		"processors": processors,
 		"exporters":  exporters,
 		"extensions": map[string]any{"deviceauth": map[string]any{}},
 		"service": map[string]any{
 			"extensions": []string{"deviceauth"},
-			"pipelines": map[string]any{
-				"metrics": map[string]any{
-					"receivers":  []string{"otlp/device"},
-					"processors": []string{"deviceattrs"},
-					"exporters":  exporterNames,
-				},
-			},
+			"pipelines":  pipelines,
 			"telemetry": map[string]any{
 				"logs": map[string]any{"level": cfg.TelemetryGateway.LogLevel},
 			},
@@ -240,3 +248,119 @@ func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 	}
 	return root, nil
 }
+
+func otlpExporterConfig(forward *config.TelemetryGatewayForward) map[string]any {
+	otlp := map[string]any{
+		"endpoint": forward.Endpoint,
+	}
+	if forward.TLS != nil {
+		tls := map[string]any{}
+		if forward.TLS.InsecureSkipTlsVerify {
+			tls["insecure_skip_verify"] = true
+		}
+		if v := forward.TLS.CertFile; v != "" {
+			tls["cert_file"] = v
+		}
+		if v := forward.TLS.KeyFile; v != "" {
+			tls["key_file"] = v
+		}
+		if v := forward.TLS.CAFile; v != "" {
+			tls["ca_file"] = v
+		}
+		if len(tls) > 0 {
+			otlp["tls"] = tls
+		}
+	}
+	return otlp
+}
+
+var routeNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`)
+
+// addLogsPipelines adds a logs pipeline per route, keeping the logs of the devices matching the
+// label selector of the route and none of the previous routes, and a default pipeline for the logs
+// of the devices matching no route. As routes are disjoint, the rate limit of an organization
+// applies once to each log record.
+func addLogsPipelines(logs *config.TelemetryGatewayLogs, exporters, processors, pipelines map[string]any) error {
+	if logs.RateLimit != nil {
+		if logs.RateLimit.RecordsPerSecond <= 0 {
+			return fmt.Errorf("telemetryGateway.logs.rateLimit.recordsPerSecond must be positive")
+		}
+		processors["orgratelimit"] = map[string]any{
+			"records_per_second": logs.RateLimit.RecordsPerSecond,
+			"burst":              logs.RateLimit.Burst,
+		}
+	}
+
+	files := map[string]string{}
+	addPipeline := func(name, match string, exclude []string, file *config.TelemetryGatewayLogsFile, forward *config.TelemetryGatewayForward) error {
+		var exporterNames []string
+		if file != nil {
+			if file.Path == "" {
+				return fmt.Errorf("telemetryGateway.logs: file of route %q has no path", name)
+			}
+			if other, ok := files[file.Path]; ok {
+				return fmt.Errorf("telemetryGateway.logs: routes %q and %q write to the same file %q", other, name, file.Path)
+			}
+			files[file.Path] = name
+			exporters["logfile/"+name] = map[string]any{
+				"path":         file.Path,
+				"max_size_mb":  lo.Ternary(file.MaxSizeMB > 0, file.MaxSizeMB, logfile.DefaultMaxSizeMB),
+				"max_backups":  lo.Ternary(file.MaxBackups > 0, file.MaxBackups, logfile.DefaultMaxBackups),
+				"max_age_days": file.MaxAgeDays,
+				"compress":     file.Compress,
+			}
+			exporterNames = append(exporterNames, "logfile/"+name)
+		}
+		if forward != nil && forward.Endpoint != "" {
+			exporters["otlp/logs-"+name] = otlpExporterConfig(forward)
+			exporterNames = append(exporterNames, "otlp/logs-"+name)
+		}
+		if len(exporterNames) == 0 {
+			return fmt.Errorf("telemetryGateway.logs: route %q has no file or forward exporter", name)
+		}
+
+		processors["logroute/"+name] = map[string]any{
+			"match":   match,
+			"exclude": exclude,
+		}
+		pipelineProcessors := []string{"deviceattrs", "logroute/" + name}
+		if logs.RateLimit != nil {
+			pipelineProcessors = append(pipelineProcessors, "orgratelimit")
+		}
+		pipelines["logs/"+name] = map[string]any{
+			"receivers":  []string{"otlp/device"},
+			"processors": pipelineProcessors,
+			"exporters":  exporterNames,
+		}
+		return nil
+	}
+
+	selectors := []string{}
+	for _, route := range logs.Routes {
+		if !routeNamePattern.MatchString(route.Name) || route.Name == defaultLogsRoute {
+			return fmt.Errorf("telemetryGateway.logs: invalid route name %q", route.Name)
+		}
+		if _, ok := pipelines["logs/"+route.Name]; ok {
+			return fmt.Errorf("telemetryGateway.logs: duplicate route name %q", route.Name)
+		}
+		if strings.TrimSpace(route.LabelSelector) == "" {
+			return fmt.Errorf("telemetryGateway.logs: route %q has no label selector", route.Name)
+		}
+		if _, err := labels.Parse(route.LabelSelector); err != nil {
+			return fmt.Errorf("telemetryGateway.logs: route %q: invalid label selector: %w", route.Name, err)
+		}
+		if err := addPipeline(route.Name, route.LabelSelector, slices.Clone(selectors), route.File, route.Forward); err != nil {
+			return err
+		}
+		selectors = append(selectors, route.LabelSelector)
+	}
+
+	if logs.File != nil || logs.Forward != nil {
+		if err := addPipeline(defaultLogsRoute, "", selectors, logs.File, logs.Forward); err != nil {
+			return err
+		}
+	} else if len(logs.Routes) == 0 {
+		return fmt.Errorf("telemetryGateway.logs: no file, forward or routes configured")
+	}
+	return nil
+}
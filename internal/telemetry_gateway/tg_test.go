@@ -0,0 +1,194 @@
+package telemetrygateway
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildOTelConfigMapLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		logs           *config.TelemetryGatewayLogs
+		wantErr        string
+		wantPipelines  map[string][]string
+		wantProcessors map[string]any
+	}{
+		{
+			name:    "no exporters",
+			wantErr: "no exporters configured",
+		},
+		{
+			name: "default file",
+			logs: &config.TelemetryGatewayLogs{
+				File: &config.TelemetryGatewayLogsFile{Path: "/var/log/devices.jsonl"},
+			},
+			wantPipelines: map[string][]string{
+				"logs/default": {"logfile/default"},
+			},
+			wantProcessors: map[string]any{
+				"logroute/default": map[string]any{"match": "", "exclude": []string{}},
+			},
+		},
+		{
+			name: "routes with rate limit",
+			logs: &config.TelemetryGatewayLogs{
+				Forward:   &config.TelemetryGatewayForward{Endpoint: "collector:4317"},
+				RateLimit: &config.TelemetryGatewayLogsRateLimit{RecordsPerSecond: 100},
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{
+						Name:          "prod",
+						LabelSelector: "env=prod",
+						File:          &config.TelemetryGatewayLogsFile{Path: "/var/log/prod.jsonl"},
+						Forward:       &config.TelemetryGatewayForward{Endpoint: "prod-collector:4317"},
+					},
+					{
+						Name:          "site-a",
+						LabelSelector: "site in (a)",
+						File:          &config.TelemetryGatewayLogsFile{Path: "/var/log/site-a.jsonl"},
+					},
+				},
+			},
+			wantPipelines: map[string][]string{
+				"logs/prod":    {"logfile/prod", "otlp/logs-prod"},
+				"logs/site-a":  {"logfile/site-a"},
+				"logs/default": {"otlp/logs-default"},
+			},
+			wantProcessors: map[string]any{
+				"logroute/prod":    map[string]any{"match": "env=prod", "exclude": []string{}},
+				"logroute/site-a":  map[string]any{"match": "site in (a)", "exclude": []string{"env=prod"}},
+				"logroute/default": map[string]any{"match": "", "exclude": []string{"env=prod", "site in (a)"}},
+				"orgratelimit":     map[string]any{"records_per_second": 100.0, "burst": 0},
+			},
+		},
+		{
+			name: "routes only",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", LabelSelector: "env=prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/prod.jsonl"}},
+				},
+			},
+			wantPipelines: map[string][]string{
+				"logs/prod": {"logfile/prod"},
+			},
+		},
+		{
+			name:    "nothing configured",
+			logs:    &config.TelemetryGatewayLogs{},
+			wantErr: "no file, forward or routes configured",
+		},
+		{
+			name: "duplicate route",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", LabelSelector: "env=prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"}},
+					{Name: "prod", LabelSelector: "env=dev", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/b.jsonl"}},
+				},
+			},
+			wantErr: "duplicate route name",
+		},
+		{
+			name: "reserved route name",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "default", LabelSelector: "env=prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"}},
+				},
+			},
+			wantErr: "invalid route name",
+		},
+		{
+			name: "invalid label selector",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", LabelSelector: "env in (prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"}},
+				},
+			},
+			wantErr: "invalid label selector",
+		},
+		{
+			name: "missing label selector",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"}},
+				},
+			},
+			wantErr: "has no label selector",
+		},
+		{
+			name: "route without exporters",
+			logs: &config.TelemetryGatewayLogs{
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", LabelSelector: "env=prod"},
+				},
+			},
+			wantErr: "has no file or forward exporter",
+		},
+		{
+			name: "same file",
+			logs: &config.TelemetryGatewayLogs{
+				File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"},
+				Routes: []config.TelemetryGatewayLogsRoute{
+					{Name: "prod", LabelSelector: "env=prod", File: &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"}},
+				},
+			},
+			wantErr: "write to the same file",
+		},
+		{
+			name: "invalid rate limit",
+			logs: &config.TelemetryGatewayLogs{
+				File:      &config.TelemetryGatewayLogsFile{Path: "/var/log/a.jsonl"},
+				RateLimit: &config.TelemetryGatewayLogsRateLimit{},
+			},
+			wantErr: "recordsPerSecond must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			cfg := config.NewDefault()
+			cfg.TelemetryGateway.Logs = tt.logs
+
+			root, err := buildOTelConfigMap(cfg)
+			if tt.wantErr != "" {
+				require.ErrorContains(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+
+			pipelines := root["service"].(map[string]any)["pipelines"].(map[string]any)
+			require.Len(pipelines, len(tt.wantPipelines))
+			exporters := root["exporters"].(map[string]any)
+			for name, wantExporters := range tt.wantPipelines {
+				require.Contains(pipelines, name)
+				pipeline := pipelines[name].(map[string]any)
+				require.Equal([]string{"otlp/device"}, pipeline["receivers"])
+				require.Equal(wantExporters, pipeline["exporters"])
+				for _, exporter := range wantExporters {
+					require.Contains(exporters, exporter)
+				}
+			}
+			processors := root["processors"].(map[string]any)
+			for name, want := range tt.wantProcessors {
+				require.Equal(want, processors[name], name)
+			}
+		})
+	}
+}
+
+func TestBuildOTelConfigMapMetrics(t *testing.T) {
+	require := require.New(t)
+	cfg := config.NewDefault()
+	cfg.TelemetryGateway.Forward = &config.TelemetryGatewayForward{Endpoint: "collector:4317"}
+
+	root, err := buildOTelConfigMap(cfg)
+	require.NoError(err)
+	pipelines := root["service"].(map[string]any)["pipelines"].(map[string]any)
+	require.Equal(map[string]any{
+		"metrics": map[string]any{
+			"receivers":  []string{"otlp/device"},
+			"processors": []string{"deviceattrs"},
+			"exporters":  []string{"otlp"},
+		},
+	}, pipelines)
+}
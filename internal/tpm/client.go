@@ -33,6 +33,7 @@ type connFactory func() (io.ReadWriteCloser, error)
 // and attestation data for CSR generation.
 type client struct {
 	session       Session
+	storage       Storage
 	connFactory   connFactory
 	sessionOpts   []SessionOption
 	log           *log.PrefixLogger
@@ -74,6 +75,7 @@ func newClientWithConnection(conFact connFactory, log *log.PrefixLogger, rw file
 	storage := NewFileStorage(rw, config.TPM.StorageFilePath, log)
 	c := &client{
 		log:         log,
+		storage:     storage,
 		connFactory: conFact,
 		sessionOpts: []SessionOption{
 			WithAuth(config.TPM.AuthEnabled),
@@ -297,6 +299,111 @@ func (c *client) CreateApplicationKey(name string) ([]byte, []byte, error) {
 	return tcgCSR, exported, nil
 }
 
+// openSession opens a new connection and session for operations that must not share the client session.
+// The returned function closes both and must be called once the session is no longer needed.
+func (c *client) openSession(op string) (Session, func(), error) {
+	conn, err := c.connFactory()
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating conn: %w", err)
+	}
+
+	session, err := NewSession(conn, c.log, c.sessionOpts...)
+	if err != nil {
+		err = fmt.Errorf("creating session: %w", err)
+		if closeErr := conn.Close(); closeErr != nil {
+			err = fmt.Errorf("closing conn: %w", closeErr)
+		}
+		return nil, nil, err
+	}
+
+	return session, func() {
+		if err := session.Close(); err != nil {
+			c.log.Errorf("[%s] Failed to close session: %v", op, err)
+		}
+	}, nil
+}
+
+// CreateCertificateKey generates a new non-exportable key under the storage hierarchy for the named certificate
+// and returns a PEM encoded CSR signed with it. The key is kept as pending so that the certificate in use keeps
+// its active key until the new certificate has been issued and CommitCertificateKey is called.
+func (c *client) CreateCertificateKey(name string, commonName string, opts ...fccrypto.CSROption) ([]byte, error) {
+	session, closeSession, err := c.openSession("CreateCertificateKey")
+	if err != nil {
+		return nil, err
+	}
+	defer closeSession()
+
+	key, err := session.CreateCertificateKey(name)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate key: %w", err)
+	}
+	defer func() {
+		if err := key.Close(); err != nil {
+			c.log.Errorf("[CreateCertificateKey] Failed to close key: %q: %v", name, err)
+		}
+	}()
+
+	csr, err := fccrypto.MakeCSR(key, commonName, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating CSR: %w", err)
+	}
+	return csr, nil
+}
+
+// CommitCertificateKey makes the pending key of the named certificate its active key.
+func (c *client) CommitCertificateKey(name string) error {
+	return c.storage.CommitCertificateKey(name)
+}
+
+// CertificateSigner returns a signer backed by the active key of the named certificate.
+// The key is loaded into the TPM for each signing operation and flushed afterwards.
+func (c *client) CertificateSigner(name string) (crypto.Signer, error) {
+	public, _, err := c.storage.GetCertificateKey(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting certificate key: %w", err)
+	}
+	pub, err := convertTPM2BPublicToPublicKey(public)
+	if err != nil {
+		return nil, fmt.Errorf("converting certificate public key: %w", err)
+	}
+	return &certificateSigner{client: c, name: name, public: pub}, nil
+}
+
+// RemoveCertificateKey removes the keys of the named certificate.
+func (c *client) RemoveCertificateKey(name string) error {
+	return c.storage.ClearCertificateKey(name)
+}
+
+type certificateSigner struct {
+	client *client
+	name   string
+	public crypto.PublicKey
+}
+
+func (s *certificateSigner) Public() crypto.PublicKey {
+	return s.public
+}
+
+func (s *certificateSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	session, closeSession, err := s.client.openSession("CertificateSigner")
+	if err != nil {
+		return nil, err
+	}
+	defer closeSession()
+
+	key, err := session.LoadCertificateKey(s.name)
+	if err != nil {
+		return nil, fmt.Errorf("loading certificate key: %w", err)
+	}
+	defer func() {
+		if err := key.Close(); err != nil {
+			s.client.log.Errorf("[CertificateSigner] Failed to close key: %q: %v", s.name, err)
+		}
+	}()
+
+	return key.Sign(rand, digest, opts)
+}
+
 // GetSigner returns the crypto.Signer interface for this client
 func (c *client) GetSigner() crypto.Signer {
 	return c
@@ -10,6 +10,7 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -20,6 +21,7 @@ import (
 
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/go-tpm-tools/simulator"
 	legacy "github.com/google/go-tpm/legacy/tpm2"
@@ -1579,6 +1581,89 @@ func TestClient_CreateApplicationKeys(t *testing.T) {
 	}
 }
 
+func TestClient_CertificateKeys(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := simulator.Get()
+	require.NoError(err)
+	defer sim.Close()
+
+	require.NoError(setupFakeRSAEKCertificate(sim))
+
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+
+	connFactory := func() (io.ReadWriteCloser, error) {
+		if err := sim.Reset(); err != nil {
+			return nil, err
+		}
+		return simConn{sim: sim}, nil
+	}
+
+	c, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, &agent_config.Config{
+		TPM: agent_config.TPM{
+			Enabled:         true,
+			DevicePath:      agent_config.DefaultTPMDevicePath,
+			StorageFilePath: agent_config.DefaultTPMKeyFile,
+		},
+	}, "test-model", "test-serial")
+	require.NoError(err)
+
+	const name = "web"
+	_, err = c.CertificateSigner(name)
+	require.ErrorIs(err, ErrNotFound)
+
+	createCSR := func() *x509.CertificateRequest {
+		csrPEM, err := c.CreateCertificateKey(name, "web.example.com", fccrypto.WithDNSNames("web.example.com"))
+		require.NoError(err)
+		block, _ := pem.Decode(csrPEM)
+		require.NotNil(block)
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		require.NoError(err)
+		require.NoError(csr.CheckSignature())
+		require.Equal("web.example.com", csr.Subject.CommonName)
+		require.Equal([]string{"web.example.com"}, csr.DNSNames)
+		return csr
+	}
+
+	// the new key stays pending until committed
+	first := createCSR()
+	_, err = c.CertificateSigner(name)
+	require.ErrorIs(err, ErrNotFound)
+	require.NoError(c.CommitCertificateKey(name))
+
+	signer, err := c.CertificateSigner(name)
+	require.NoError(err)
+	require.True(signer.Public().(*ecdsa.PublicKey).Equal(first.PublicKey))
+
+	digest := sha256.Sum256([]byte("payload"))
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(err)
+	require.True(ecdsa.VerifyASN1(first.PublicKey.(*ecdsa.PublicKey), digest[:], sig))
+
+	// renewing keeps the active key until the new one is committed
+	second := createCSR()
+	signer, err = c.CertificateSigner(name)
+	require.NoError(err)
+	require.True(signer.Public().(*ecdsa.PublicKey).Equal(first.PublicKey))
+	require.NoError(c.CommitCertificateKey(name))
+	require.ErrorIs(c.CommitCertificateKey(name), ErrNotFound)
+
+	signer, err = c.CertificateSigner(name)
+	require.NoError(err)
+	require.True(signer.Public().(*ecdsa.PublicKey).Equal(second.PublicKey))
+	sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(err)
+	require.True(ecdsa.VerifyASN1(second.PublicKey.(*ecdsa.PublicKey), digest[:], sig))
+
+	require.NoError(c.RemoveCertificateKey(name))
+	_, err = c.CertificateSigner(name)
+	require.ErrorIs(err, ErrNotFound)
+}
+
 // closes the session in a way that doesn't close the underlying connection so that it can be reused
 // for a testing purposes
 func safeCloseSession(session Session) error {
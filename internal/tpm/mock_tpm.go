@@ -15,6 +15,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	crypto0 "github.com/flightctl/flightctl/pkg/crypto"
 	tpm2 "github.com/google/go-tpm/tpm2"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +43,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CertificateSigner mocks base method.
+func (m *MockClient) CertificateSigner(name string) (crypto.Signer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateSigner", name)
+	ret0, _ := ret[0].(crypto.Signer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertificateSigner indicates an expected call of CertificateSigner.
+func (mr *MockClientMockRecorder) CertificateSigner(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateSigner", reflect.TypeOf((*MockClient)(nil).CertificateSigner), name)
+}
+
 // Clear mocks base method.
 func (m *MockClient) Clear() error {
 	m.ctrl.T.Helper()
@@ -70,6 +86,20 @@ func (mr *MockClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
 }
 
+// CommitCertificateKey mocks base method.
+func (m *MockClient) CommitCertificateKey(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitCertificateKey", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitCertificateKey indicates an expected call of CommitCertificateKey.
+func (mr *MockClientMockRecorder) CommitCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitCertificateKey", reflect.TypeOf((*MockClient)(nil).CommitCertificateKey), name)
+}
+
 // CreateApplicationKey mocks base method.
 func (m *MockClient) CreateApplicationKey(name string) ([]byte, []byte, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +116,26 @@ func (mr *MockClientMockRecorder) CreateApplicationKey(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationKey", reflect.TypeOf((*MockClient)(nil).CreateApplicationKey), name)
 }
 
+// CreateCertificateKey mocks base method.
+func (m *MockClient) CreateCertificateKey(name, commonName string, opts ...crypto0.CSROption) ([]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name, commonName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCertificateKey", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCertificateKey indicates an expected call of CreateCertificateKey.
+func (mr *MockClientMockRecorder) CreateCertificateKey(name, commonName any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name, commonName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateKey", reflect.TypeOf((*MockClient)(nil).CreateCertificateKey), varargs...)
+}
+
 // GetSigner mocks base method.
 func (m *MockClient) GetSigner() crypto.Signer {
 	m.ctrl.T.Helper()
@@ -129,6 +179,20 @@ func (mr *MockClientMockRecorder) Public() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Public", reflect.TypeOf((*MockClient)(nil).Public))
 }
 
+// RemoveCertificateKey mocks base method.
+func (m *MockClient) RemoveCertificateKey(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCertificateKey", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCertificateKey indicates an expected call of RemoveCertificateKey.
+func (mr *MockClientMockRecorder) RemoveCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertificateKey", reflect.TypeOf((*MockClient)(nil).RemoveCertificateKey), name)
+}
+
 // SolveChallenge mocks base method.
 func (m *MockClient) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +287,34 @@ func (mr *MockStorageMockRecorder) ClearApplicationKeys() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearApplicationKeys", reflect.TypeOf((*MockStorage)(nil).ClearApplicationKeys))
 }
 
+// ClearCertificateKey mocks base method.
+func (m *MockStorage) ClearCertificateKey(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCertificateKey", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCertificateKey indicates an expected call of ClearCertificateKey.
+func (mr *MockStorageMockRecorder) ClearCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCertificateKey", reflect.TypeOf((*MockStorage)(nil).ClearCertificateKey), name)
+}
+
+// ClearCertificateKeys mocks base method.
+func (m *MockStorage) ClearCertificateKeys() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCertificateKeys")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearCertificateKeys indicates an expected call of ClearCertificateKeys.
+func (mr *MockStorageMockRecorder) ClearCertificateKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCertificateKeys", reflect.TypeOf((*MockStorage)(nil).ClearCertificateKeys))
+}
+
 // ClearKey mocks base method.
 func (m *MockStorage) ClearKey(keyType KeyType) error {
 	m.ctrl.T.Helper()
@@ -265,6 +357,20 @@ func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
+// CommitCertificateKey mocks base method.
+func (m *MockStorage) CommitCertificateKey(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitCertificateKey", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitCertificateKey indicates an expected call of CommitCertificateKey.
+func (mr *MockStorageMockRecorder) CommitCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitCertificateKey", reflect.TypeOf((*MockStorage)(nil).CommitCertificateKey), name)
+}
+
 // GetApplicationKey mocks base method.
 func (m *MockStorage) GetApplicationKey(arg0 string) (*AppKeyStoreData, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +386,22 @@ func (mr *MockStorageMockRecorder) GetApplicationKey(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationKey", reflect.TypeOf((*MockStorage)(nil).GetApplicationKey), arg0)
 }
 
+// GetCertificateKey mocks base method.
+func (m *MockStorage) GetCertificateKey(name string) (*tpm2.TPM2BPublic, *tpm2.TPM2BPrivate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateKey", name)
+	ret0, _ := ret[0].(*tpm2.TPM2BPublic)
+	ret1, _ := ret[1].(*tpm2.TPM2BPrivate)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCertificateKey indicates an expected call of GetCertificateKey.
+func (mr *MockStorageMockRecorder) GetCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateKey", reflect.TypeOf((*MockStorage)(nil).GetCertificateKey), name)
+}
+
 // GetKey mocks base method.
 func (m *MockStorage) GetKey(keyType KeyType) (*tpm2.TPM2BPublic, *tpm2.TPM2BPrivate, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +475,20 @@ func (mr *MockStorageMockRecorder) StorePassword(password any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePassword", reflect.TypeOf((*MockStorage)(nil).StorePassword), password)
 }
 
+// StorePendingCertificateKey mocks base method.
+func (m *MockStorage) StorePendingCertificateKey(name string, public tpm2.TPM2BPublic, private tpm2.TPM2BPrivate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePendingCertificateKey", name, public, private)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePendingCertificateKey indicates an expected call of StorePendingCertificateKey.
+func (mr *MockStorageMockRecorder) StorePendingCertificateKey(name, public, private any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePendingCertificateKey", reflect.TypeOf((*MockStorage)(nil).StorePendingCertificateKey), name, public, private)
+}
+
 // MockCertifiable is a mock of Certifiable interface.
 type MockCertifiable struct {
 	ctrl     *gomock.Controller
@@ -676,6 +812,21 @@ func (mr *MockSessionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSession)(nil).Close))
 }
 
+// CreateCertificateKey mocks base method.
+func (m *MockSession) CreateCertificateKey(name string) (DeviceID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertificateKey", name)
+	ret0, _ := ret[0].(DeviceID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCertificateKey indicates an expected call of CreateCertificateKey.
+func (mr *MockSessionMockRecorder) CreateCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateKey", reflect.TypeOf((*MockSession)(nil).CreateCertificateKey), name)
+}
+
 // CreateKey mocks base method.
 func (m *MockSession) CreateKey(keyType KeyType) (*tpm2.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -752,6 +903,21 @@ func (mr *MockSessionMockRecorder) LoadApplicationKey(appName any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadApplicationKey", reflect.TypeOf((*MockSession)(nil).LoadApplicationKey), appName)
 }
 
+// LoadCertificateKey mocks base method.
+func (m *MockSession) LoadCertificateKey(name string) (DeviceID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadCertificateKey", name)
+	ret0, _ := ret[0].(DeviceID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadCertificateKey indicates an expected call of LoadCertificateKey.
+func (mr *MockSessionMockRecorder) LoadCertificateKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadCertificateKey", reflect.TypeOf((*MockSession)(nil).LoadCertificateKey), name)
+}
+
 // LoadKey mocks base method.
 func (m *MockSession) LoadKey(keyType KeyType) (*tpm2.NamedHandle, error) {
 	m.ctrl.T.Helper()
@@ -525,6 +525,7 @@ func (s *tpmSession) clearStoredKeys() error {
 	}
 
 	_ = s.storage.ClearApplicationKeys()
+	_ = s.storage.ClearCertificateKeys()
 
 	return nil
 }
@@ -1271,6 +1272,74 @@ func (s *tpmSession) LoadApplicationKey(appName string) (ExportableDeviceID, err
 	}
 	return key, nil
 }
+
+// CreateCertificateKey creates a new non-exportable signing key under the SRK for the named certificate and
+// stores it as the certificate's pending key. The returned key is loaded and must be closed by the caller.
+func (s *tpmSession) CreateCertificateKey(name string) (DeviceID, error) {
+	if err := s.ensureSRKIsLoaded(); err != nil {
+		return nil, fmt.Errorf("ensuring SRK is loaded: %w", err)
+	}
+
+	template, err := LDevIDTemplate(s.keyAlgo)
+	if err != nil {
+		return nil, fmt.Errorf("getting certificate key template: %w", err)
+	}
+
+	createCmd := tpm2.Create{
+		ParentHandle: *s.handles[SRK],
+		InPublic:     tpm2.New2B(template),
+	}
+	createRsp, err := createCmd.Execute(transport.FromReadWriter(s.conn))
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate key %s: %w", name, err)
+	}
+
+	if err := s.storage.StorePendingCertificateKey(name, createRsp.OutPublic, createRsp.OutPrivate); err != nil {
+		return nil, fmt.Errorf("storing certificate key %s: %w", name, err)
+	}
+
+	return s.loadCertificateKey(createRsp.OutPublic, createRsp.OutPrivate)
+}
+
+// LoadCertificateKey loads the active key of the named certificate. The returned key must be closed by the caller.
+func (s *tpmSession) LoadCertificateKey(name string) (DeviceID, error) {
+	public, private, err := s.storage.GetCertificateKey(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting certificate key: %w", err)
+	}
+
+	if err := s.ensureSRKIsLoaded(); err != nil {
+		return nil, fmt.Errorf("ensuring SRK is loaded: %w", err)
+	}
+	return s.loadCertificateKey(*public, *private)
+}
+
+func (s *tpmSession) loadCertificateKey(public tpm2.TPM2BPublic, private tpm2.TPM2BPrivate) (DeviceID, error) {
+	srk := s.handles[SRK]
+	loadCmd := tpm2.Load{
+		ParentHandle: *srk,
+		InPrivate:    private,
+		InPublic:     public,
+	}
+	loadRsp, err := loadCmd.Execute(transport.FromReadWriter(s.conn))
+	if err != nil {
+		return nil, fmt.Errorf("loading certificate key: %w", err)
+	}
+
+	return &exportableDeviceID{
+		log:          s.log,
+		parentHandle: srk.Handle,
+		pub:          public,
+		priv:         private,
+		loadedHandle: tpm2.AuthHandle{
+			Handle: loadRsp.ObjectHandle,
+			Name:   loadRsp.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		conn: s.conn,
+	}, nil
+}
+
 func (s *tpmSession) RemoveApplicationKey(appName string) error {
 	handle, err := s.loadAppKey(appName)
 	if err != nil {
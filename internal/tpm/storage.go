@@ -23,6 +23,7 @@ type storageData struct {
 	LAK             *keyData                       `json:"lak,omitempty"`
 	SealedPassword  *passwordData                  `json:"sealed_password,omitempty"`
 	ApplicationKeys map[string]*applicationKeyData `json:"app_keys,omitempty"`
+	CertificateKeys map[string]*certificateKeyData `json:"cert_keys,omitempty"`
 }
 
 // keyData represents persisted key information
@@ -38,6 +39,14 @@ type applicationKeyData struct {
 	ParentPassword *string `json:"parent_password,omitempty"`
 }
 
+// certificateKeyData represents the persisted keys of a managed certificate. Both keys are children of the SRK.
+// A new key is kept pending until the certificate issued for it is stored, so that the key of the current
+// certificate remains usable if issuing the new certificate fails.
+type certificateKeyData struct {
+	Active  *keyData `json:"active,omitempty"`
+	Pending *keyData `json:"pending,omitempty"`
+}
+
 // passwordData represents persisted password information
 type passwordData struct {
 	EncodedPassword string `json:"encoded_password"`
@@ -355,6 +364,107 @@ func (s *fileStorage) ClearApplicationKey(appName string) error {
 	return s.writeData(data)
 }
 
+func (s *fileStorage) GetCertificateKey(name string) (*tpm2.TPM2BPublic, *tpm2.TPM2BPrivate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.readData()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, ok := data.CertificateKeys[name]
+	if !ok || key.Active == nil {
+		return nil, nil, fmt.Errorf("certificate key %s %w", name, ErrNotFound)
+	}
+
+	public, err := key.Active.Public()
+	if err != nil {
+		return nil, nil, fmt.Errorf("public key for certificate %s from storage: %w", name, err)
+	}
+	private, err := key.Active.Private()
+	if err != nil {
+		return nil, nil, fmt.Errorf("private key for certificate %s from storage: %w", name, err)
+	}
+	return public, private, nil
+}
+
+func (s *fileStorage) StorePendingCertificateKey(name string, public tpm2.TPM2BPublic, private tpm2.TPM2BPrivate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log.Debugf("Storing pending certificate key %s to disk", name)
+
+	data, err := s.readData()
+	if err != nil {
+		return fmt.Errorf("reading storage data: %w", err)
+	}
+
+	if data.CertificateKeys == nil {
+		data.CertificateKeys = make(map[string]*certificateKeyData)
+	}
+	entry, ok := data.CertificateKeys[name]
+	if !ok {
+		entry = &certificateKeyData{}
+		data.CertificateKeys[name] = entry
+	}
+
+	entry.Pending = &keyData{}
+	if err := entry.Pending.Update(public, private); err != nil {
+		return fmt.Errorf("updating key data: %s : %w", name, err)
+	}
+
+	if err := s.writeData(data); err != nil {
+		return fmt.Errorf("writing key data to disk: %w", err)
+	}
+	return nil
+}
+
+func (s *fileStorage) CommitCertificateKey(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.readData()
+	if err != nil {
+		return err
+	}
+
+	entry, ok := data.CertificateKeys[name]
+	if !ok || entry.Pending == nil {
+		return fmt.Errorf("pending certificate key %s %w", name, ErrNotFound)
+	}
+	entry.Active = entry.Pending
+	entry.Pending = nil
+
+	return s.writeData(data)
+}
+
+func (s *fileStorage) ClearCertificateKey(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.readData()
+	if err != nil {
+		return err
+	}
+	delete(data.CertificateKeys, name)
+
+	return s.writeData(data)
+}
+
+func (s *fileStorage) ClearCertificateKeys() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.readData()
+	if err != nil {
+		return err
+	}
+	data.CertificateKeys = make(map[string]*certificateKeyData)
+
+	return s.writeData(data)
+}
+
 func (s *fileStorage) GetPassword() ([]byte, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -5,6 +5,7 @@ import (
 	"crypto"
 	"regexp"
 
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	legacy "github.com/google/go-tpm/legacy/tpm2"
 	"github.com/google/go-tpm/tpm2"
 )
@@ -29,6 +30,15 @@ type Client interface {
 	VendorInfoCollector(ctx context.Context) string
 	// CreateApplicationKey generates a TCG CSR IDEVID bundle and a TSS2 PEM encoded file for the specified application
 	CreateApplicationKey(name string) ([]byte, []byte, error)
+	// CreateCertificateKey generates a new non-exportable key for the named certificate and returns a PEM encoded
+	// CSR signed with it. The key stays pending until CommitCertificateKey is called.
+	CreateCertificateKey(name string, commonName string, opts ...fccrypto.CSROption) ([]byte, error)
+	// CommitCertificateKey makes the pending key of the named certificate its active key
+	CommitCertificateKey(name string) error
+	// CertificateSigner returns a signer backed by the active key of the named certificate
+	CertificateSigner(name string) (crypto.Signer, error)
+	// RemoveCertificateKey removes the keys of the named certificate
+	RemoveCertificateKey(name string) error
 }
 
 const (
@@ -89,6 +99,16 @@ type Storage interface {
 	ClearApplicationKey(string) error
 	// ClearApplicationKeys removes all application keys
 	ClearApplicationKeys() error
+	// GetCertificateKey retrieves the active key of the named certificate
+	GetCertificateKey(name string) (*tpm2.TPM2BPublic, *tpm2.TPM2BPrivate, error)
+	// StorePendingCertificateKey stores a new key of the named certificate, pending until committed
+	StorePendingCertificateKey(name string, public tpm2.TPM2BPublic, private tpm2.TPM2BPrivate) error
+	// CommitCertificateKey makes the pending key of the named certificate its active key
+	CommitCertificateKey(name string) error
+	// ClearCertificateKey removes the keys of the named certificate
+	ClearCertificateKey(name string) error
+	// ClearCertificateKeys removes the keys of all certificates
+	ClearCertificateKeys() error
 	// GetPassword retrieves the stored storage hierarchy password
 	GetPassword() ([]byte, error)
 	// StorePassword stores the storage hierarchy password
@@ -136,6 +156,10 @@ type Session interface {
 	LoadApplicationKey(appName string) (ExportableDeviceID, error)
 	// RemoveApplicationKey removes the key for the specified application
 	RemoveApplicationKey(appName string) error
+	// CreateCertificateKey creates a new pending key for the named certificate and returns it loaded
+	CreateCertificateKey(name string) (DeviceID, error)
+	// LoadCertificateKey loads the active key of the named certificate
+	LoadCertificateKey(name string) (DeviceID, error)
 	// Sign signs data with the specified key
 	Sign(keyType KeyType, digest []byte) ([]byte, error)
 	// GetPublicKey gets the public key for a key type
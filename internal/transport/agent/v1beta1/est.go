@@ -0,0 +1,295 @@
+package agenttransportv1beta1
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/api_server/middleware"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// EST (RFC 7030) endpoints, served next to the agent API so that devices that do not run the
+// flightctl agent can obtain certificates from the same PKI. Enrollments surface as
+// EnrollmentRequests subject to the same approval as the agent enrollments, and re-enrollments
+// as CertificateSigningRequests for the device management renewal signer.
+const (
+	ESTPath = "/.well-known/est"
+
+	estCACertsPath        = "/cacerts"
+	estSimpleEnrollPath   = "/simpleenroll"
+	estSimpleReenrollPath = "/simplereenroll"
+	estCSRAttrsPath       = "/csrattrs"
+
+	// estRetryAfterSeconds is the delay after which clients are told to retry enrollments that
+	// are pending approval.
+	estRetryAfterSeconds = 60
+
+	// maxESTRequestSize is the maximum size of the base64 encoded certificate request of an
+	// enrollment, well above that of a request for an RSA 8192 key.
+	maxESTRequestSize = 16 * 1024
+)
+
+var oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+
+// IsESTEnrollmentRequest returns whether the request is an EST operation authenticated with an
+// enrollment certificate, that is any operation but re-enrollment, which is authenticated with
+// the device management certificate being renewed.
+func IsESTEnrollmentRequest(r *http.Request) bool {
+	path := r.URL.Path
+	return strings.HasPrefix(path, ESTPath+"/") && path != ESTPath+estSimpleReenrollPath
+}
+
+// RegisterESTRoutes registers the EST endpoints on the router.
+func (s *AgentTransportHandler) RegisterESTRoutes(r chi.Router) {
+	r.Route(ESTPath, func(r chi.Router) {
+		r.Get(estCACertsPath, s.ESTCACerts)
+		r.Get(estCSRAttrsPath, s.ESTCSRAttrs)
+		r.Post(estSimpleEnrollPath, s.ESTSimpleEnroll)
+		r.Post(estSimpleReenrollPath, s.ESTSimpleReenroll)
+	})
+}
+
+// (GET /.well-known/est/cacerts)
+func (s *AgentTransportHandler) ESTCACerts(w http.ResponseWriter, r *http.Request) {
+	s.writeESTCertificates(w, s.ca.GetCABundleX509()...)
+}
+
+// (GET /.well-known/est/csrattrs)
+func (s *AgentTransportHandler) ESTCSRAttrs(w http.ResponseWriter, r *http.Request) {
+	// The certificates are requested for ECDSA P-256 keys, as the ones of the flightctl agents.
+	attrs, err := asn1.Marshal([]asn1.ObjectIdentifier{oidECDSAWithSHA256})
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/csrattrs")
+	w.Header().Set("Content-Transfer-Encoding", "base64")
+	_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(attrs)))
+}
+
+// (POST /.well-known/est/simpleenroll)
+func (s *AgentTransportHandler) ESTSimpleEnroll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if _, ok := ctx.Value(consts.IdentityCtxKey).(*middleware.EnrollmentIdentity); !ok {
+		s.log.Error("enrollment identity is missing from context")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	orgId := transport.OrgIDFromContext(ctx)
+
+	csr, csrPEM, err := readESTCertificateRequest(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), estRequestErrorStatus(err))
+		return
+	}
+	// The enrollment request is named after the device fingerprint, which clients supply as the
+	// CN of the request, optionally with the device CN prefix.
+	name := strings.TrimPrefix(csr.Subject.CommonName, s.ca.Cfg.DeviceCommonNamePrefix)
+	if _, err := signer.CNFromDeviceFingerprint(s.ca.Cfg, name); err != nil {
+		http.Error(w, fmt.Sprintf("invalid CN supplied in CSR: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// Clients resubmit the same request until it is approved, so the enrollment request is only
+	// created the first time.
+	er, status := s.serviceHandler.GetEnrollmentRequest(ctx, orgId, name)
+	switch status.Code {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		er, status = s.serviceHandler.CreateEnrollmentRequest(ctx, orgId, api.EnrollmentRequest{
+			ApiVersion: api.EnrollmentRequestAPIVersion,
+			Kind:       api.EnrollmentRequestKind,
+			Metadata:   api.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec: api.EnrollmentRequestSpec{
+				Csr:          string(csrPEM),
+				DeviceStatus: lo.ToPtr(api.NewDeviceStatus()),
+			},
+		})
+		if status.Code != http.StatusCreated {
+			http.Error(w, status.Message, int(status.Code))
+			return
+		}
+		s.log.Infof("created enrollment request %s/%s for EST enrollment", orgId, name)
+	default:
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+
+	if existing, err := fccrypto.ParseCSR([]byte(er.Spec.Csr)); err == nil && !samePublicKey(existing.PublicKey, csr.PublicKey) {
+		http.Error(w, fmt.Sprintf("an enrollment request for %q was already submitted with another key", name), http.StatusConflict)
+		return
+	}
+
+	if er.Status == nil || !api.IsStatusConditionTrue(er.Status.Conditions, api.ConditionTypeEnrollmentRequestApproved) || er.Status.Certificate == nil {
+		s.writeESTPending(w)
+		return
+	}
+	cert, err := fccrypto.ParseCertificatePEM([]byte(*er.Status.Certificate))
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to parse the certificate of enrollment request %s/%s", orgId, name)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !samePublicKey(cert.PublicKey, csr.PublicKey) {
+		http.Error(w, fmt.Sprintf("the certificate issued for %q was requested with another key", name), http.StatusConflict)
+		return
+	}
+	s.writeESTCertificates(w, cert)
+}
+
+// (POST /.well-known/est/simplereenroll)
+func (s *AgentTransportHandler) ESTSimpleReenroll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	identity, ok := ctx.Value(consts.IdentityCtxKey).(*middleware.AgentIdentity)
+	if !ok {
+		s.log.Error("agent identity is missing from context")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	fingerprint := identity.GetUsername()
+	orgId := transport.OrgIDFromContext(ctx)
+
+	device, st := s.serviceHandler.GetDevice(ctx, orgId, fingerprint)
+	if st.Code != http.StatusOK {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	if device.Status != nil && api.FindStatusCondition(device.Status.Conditions, api.ConditionTypeDeviceDecommissioning) != nil {
+		s.log.WithField("device", fingerprint).Warn("device is decommissioning; rejecting EST re-enrollment")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	_, csrPEM, err := readESTCertificateRequest(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), estRequestErrorStatus(err))
+		return
+	}
+
+	request := api.CertificateSigningRequest{
+		ApiVersion: api.CertificateSigningRequestAPIVersion,
+		Kind:       api.CertificateSigningRequestKind,
+		Metadata: api.ObjectMeta{
+			Name:  lo.ToPtr(fmt.Sprintf("%s-%s", fingerprint, uuid.NewString()[:8])),
+			Owner: util.SetResourceOwner(api.DeviceKind, fingerprint),
+		},
+		Spec: api.CertificateSigningRequestSpec{
+			Request:    csrPEM,
+			SignerName: s.ca.Cfg.DeviceManagementRenewalSignerName,
+			Usages:     &[]string{"clientAuth", "CA:false"},
+		},
+	}
+	csr, status := s.serviceHandler.CreateCertificateSigningRequest(context.WithValue(ctx, consts.InternalRequestCtxKey, true), orgId, request)
+	if status.Code != http.StatusCreated && status.Code != http.StatusOK {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+
+	// Same as the renewals requested by the agents, re-enrollments are approved unless the TPM
+	// verification explicitly failed, in which case a manual approval is required.
+	if api.IsStatusConditionFalse(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestTPMVerified) {
+		s.writeESTPending(w)
+		return
+	}
+	if _, status = s.autoApprove(ctx, csr); status.Code != http.StatusOK {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	// The certificate is issued when the request is approved
+	if csr, status = s.serviceHandler.GetCertificateSigningRequest(ctx, orgId, *request.Metadata.Name); status.Code != http.StatusOK {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+	if csr.Status == nil || csr.Status.Certificate == nil {
+		if csr.Status != nil {
+			if c := api.FindStatusCondition(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestFailed); c != nil {
+				http.Error(w, c.Message, http.StatusBadRequest)
+				return
+			}
+		}
+		s.writeESTPending(w)
+		return
+	}
+
+	cert, err := fccrypto.ParseCertificatePEM(*csr.Status.Certificate)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to parse the certificate of certificate signing request %s/%s", orgId, *request.Metadata.Name)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	s.writeESTCertificates(w, cert)
+}
+
+// readESTCertificateRequest reads the base64 encoded PKCS#10 certificate request of an EST
+// enrollment, returning it parsed and PEM encoded.
+func readESTCertificateRequest(w http.ResponseWriter, r *http.Request) (*x509.CertificateRequest, []byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxESTRequestSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	der, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(body), nil)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("request body is not a base64 encoded certificate request: %w", err)
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse certificate request: %w", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, nil, fmt.Errorf("invalid certificate request signature: %w", err)
+	}
+	return csr, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+// estRequestErrorStatus returns the HTTP status of a request whose certificate request can't be read.
+func estRequestErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+func samePublicKey(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
+
+func (s *AgentTransportHandler) writeESTPending(w http.ResponseWriter) {
+	w.Header().Set("Retry-After", strconv.Itoa(estRetryAfterSeconds))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *AgentTransportHandler) writeESTCertificates(w http.ResponseWriter, certs ...*x509.Certificate) {
+	der, err := fccrypto.EncodeCertsOnlyPKCS7(certs...)
+	if err != nil {
+		s.log.WithError(err).Error("failed to encode certificates")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkcs7-mime; smime-type=certs-only")
+	w.Header().Set("Content-Transfer-Encoding", "base64")
+	_, _ = w.Write([]byte(base64.StdEncoding.EncodeToString(der)))
+}
@@ -0,0 +1,166 @@
+package agenttransportv1beta1
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	convertv1beta1 "github.com/flightctl/flightctl/internal/api/convert/v1beta1"
+	"github.com/flightctl/flightctl/internal/api_server/middleware"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const estTestDeviceName = "est-device-fingerprint"
+
+func newESTTestHandler(t *testing.T) (*AgentTransportHandler, *service.MockService, *crypto.CAClient) {
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(t, err)
+	mockService := service.NewMockService(gomock.NewController(t))
+	return NewAgentTransportHandler(mockService, convertv1beta1.NewConverter(), caClient, logrus.New()), mockService, caClient
+}
+
+func newESTCertificateRequest(t *testing.T, commonName string) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	require.NoError(t, err)
+	return key, der
+}
+
+func serveEST(h *AgentTransportHandler, method, path string, body []byte) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	h.RegisterESTRoutes(router)
+	req := httptest.NewRequest(method, ESTPath+path, strings.NewReader(base64.StdEncoding.EncodeToString(body)))
+	ctx := context.WithValue(req.Context(), consts.IdentityCtxKey, &middleware.EnrollmentIdentity{})
+	ctx = context.WithValue(ctx, consts.OrganizationIDCtxKey, store.NullOrgId)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req.WithContext(ctx))
+	return rec
+}
+
+func parseESTCertificates(t *testing.T, rec *httptest.ResponseRecorder) []*x509.Certificate {
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/pkcs7-mime; smime-type=certs-only", rec.Header().Get("Content-Type"))
+	der, err := base64.StdEncoding.DecodeString(rec.Body.String())
+	require.NoError(t, err)
+	certs, err := fccrypto.ParseCertsOnlyPKCS7(der)
+	require.NoError(t, err)
+	return certs
+}
+
+func TestESTCACerts(t *testing.T) {
+	h, _, caClient := newESTTestHandler(t)
+
+	certs := parseESTCertificates(t, serveEST(h, http.MethodGet, "/cacerts", nil))
+	require.Len(t, certs, len(caClient.GetCABundleX509()))
+	require.Equal(t, caClient.GetCABundleX509()[0].Raw, certs[0].Raw)
+}
+
+func TestESTSimpleEnrollCreatesEnrollmentRequest(t *testing.T) {
+	h, mockService, _ := newESTTestHandler(t)
+	_, csr := newESTCertificateRequest(t, estTestDeviceName)
+
+	mockService.EXPECT().GetEnrollmentRequest(gomock.Any(), store.NullOrgId, estTestDeviceName).
+		Return(nil, domain.StatusResourceNotFound(domain.EnrollmentRequestKind, estTestDeviceName))
+	mockService.EXPECT().CreateEnrollmentRequest(gomock.Any(), store.NullOrgId, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, orgId any, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
+			require.Equal(t, estTestDeviceName, lo.FromPtr(er.Metadata.Name))
+			er.Status = &domain.EnrollmentRequestStatus{}
+			return &er, domain.StatusCreated()
+		})
+
+	rec := serveEST(h, http.MethodPost, "/simpleenroll", csr)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+}
+
+func TestESTSimpleEnrollReturnsApprovedCertificate(t *testing.T) {
+	h, mockService, caClient := newESTTestHandler(t)
+	key, csr := newESTCertificateRequest(t, estTestDeviceName)
+
+	x509CSR, err := x509.ParseCertificateRequest(csr)
+	require.NoError(t, err)
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), x509CSR, 3600)
+	require.NoError(t, err)
+	certPEM, err := fccrypto.EncodeCertificatePEM(cert)
+	require.NoError(t, err)
+	csrPEM, err := fccrypto.MakeCSR(key, estTestDeviceName)
+	require.NoError(t, err)
+
+	er := &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr(estTestDeviceName)},
+		Spec:     domain.EnrollmentRequestSpec{Csr: string(csrPEM)},
+		Status: &domain.EnrollmentRequestStatus{
+			Certificate: lo.ToPtr(string(certPEM)),
+			Conditions: []domain.Condition{{
+				Type:   domain.ConditionTypeEnrollmentRequestApproved,
+				Status: domain.ConditionStatusTrue,
+			}},
+		},
+	}
+	mockService.EXPECT().GetEnrollmentRequest(gomock.Any(), store.NullOrgId, estTestDeviceName).Return(er, domain.StatusOK()).Times(2)
+
+	certs := parseESTCertificates(t, serveEST(h, http.MethodPost, "/simpleenroll", csr))
+	require.Len(t, certs, 1)
+	require.Equal(t, cert.Raw, certs[0].Raw)
+
+	// Another key cannot retrieve the certificate issued for the device
+	_, otherCSR := newESTCertificateRequest(t, estTestDeviceName)
+	rec := serveEST(h, http.MethodPost, "/simpleenroll", otherCSR)
+	require.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestESTSimpleEnrollRejectsInvalidRequest(t *testing.T) {
+	h, _, _ := newESTTestHandler(t)
+
+	rec := serveEST(h, http.MethodPost, "/simpleenroll", []byte("not a certificate request"))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	_, csr := newESTCertificateRequest(t, "short")
+	rec = serveEST(h, http.MethodPost, "/simpleenroll", csr)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestESTSimpleEnrollRejectsOversizedRequest(t *testing.T) {
+	h, _, _ := newESTTestHandler(t)
+
+	rec := serveEST(h, http.MethodPost, "/simpleenroll", bytes.Repeat([]byte("a"), maxESTRequestSize))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestIsESTEnrollmentRequest(t *testing.T) {
+	require.True(t, IsESTEnrollmentRequest(httptest.NewRequest(http.MethodPost, ESTPath+"/simpleenroll", nil)))
+	require.True(t, IsESTEnrollmentRequest(httptest.NewRequest(http.MethodGet, ESTPath+"/cacerts", nil)))
+	require.False(t, IsESTEnrollmentRequest(httptest.NewRequest(http.MethodPost, ESTPath+"/simplereenroll", nil)))
+	require.False(t, IsESTEnrollmentRequest(httptest.NewRequest(http.MethodGet, "/api/v1/enrollmentrequests/foo", nil)))
+}
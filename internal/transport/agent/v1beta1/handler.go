@@ -263,10 +263,12 @@ func (s *AgentTransportHandler) CreateCertificateSigningRequest(w http.ResponseW
 
 	failedTPMVerification := api.IsStatusConditionFalse(csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestTPMVerified)
 
-	// Auto-approve for renewal and device-svc-client CSRs if signer verification passed.
+	// Auto-approve for renewal, device-svc-client and device-workload CSRs if signer verification passed.
+	// Device-workload CSRs are only accepted for certificates declared in the device spec.
 	// If TPM verification explicitly fails, a manual approval is required.
 	if (csr.Spec.SignerName == s.ca.Cfg.DeviceManagementRenewalSignerName ||
-		csr.Spec.SignerName == s.ca.Cfg.DeviceSvcClientSignerName) && !failedTPMVerification {
+		csr.Spec.SignerName == s.ca.Cfg.DeviceSvcClientSignerName ||
+		csr.Spec.SignerName == s.ca.Cfg.DeviceWorkloadSignerName) && !failedTPMVerification {
 		if _, status := s.autoApprove(ctx, csr); status.Code != http.StatusOK {
 			status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
 			s.SetResponse(w, status, status)
@@ -0,0 +1,16 @@
+package transportv1beta1
+
+import (
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (GET /api/v1/auditevents)
+func (h *TransportHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListAuditEventsParams) {
+	domainParams := h.converter.AuditEvent().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListAuditEvents(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.AuditEvent().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,31 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (GET /api/v1/certificaterevocations)
+func (h *TransportHandler) ListCertificateRevocations(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListCertificateRevocationsParams) {
+	domainParams := h.converter.CertificateRevocation().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListCertificateRevocations(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.CertificateRevocation().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (POST /api/v1/certificaterevocations)
+func (h *TransportHandler) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+	var request apiv1beta1.CertificateRevocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRequest := h.converter.CertificateRevocation().RequestToDomain(request)
+	body, status := h.serviceHandler.RevokeCertificates(r.Context(), transport.OrgIDFromContext(r.Context()), domainRequest)
+	apiResult := h.converter.CertificateRevocation().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,45 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
+)
+
+// (POST /api/v1/devicebulkoperations)
+func (h *TransportHandler) CreateDeviceBulkOperation(w http.ResponseWriter, r *http.Request, params apiv1beta1.CreateDeviceBulkOperationParams) {
+	var operation apiv1beta1.DeviceBulkOperation
+	if err := json.NewDecoder(r.Body).Decode(&operation); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainOperation := h.converter.DeviceBulkOperation().ToDomain(operation)
+	body, status := h.serviceHandler.CreateDeviceBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), domainOperation, lo.FromPtr(params.DryRun))
+	apiResult := h.converter.DeviceBulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/devicebulkoperations)
+func (h *TransportHandler) ListDeviceBulkOperations(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDeviceBulkOperationsParams) {
+	domainParams := h.converter.DeviceBulkOperation().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListDeviceBulkOperations(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.DeviceBulkOperation().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/devicebulkoperations/{name})
+func (h *TransportHandler) GetDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetDeviceBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.DeviceBulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/devicebulkoperations/{name})
+func (h *TransportHandler) DeleteDeviceBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteDeviceBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -0,0 +1,72 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/roles)
+func (h *TransportHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var role apiv1beta1.Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRole := h.converter.Role().ToDomain(role)
+	body, status := h.serviceHandler.CreateRole(r.Context(), transport.OrgIDFromContext(r.Context()), domainRole)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/roles)
+func (h *TransportHandler) ListRoles(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListRolesParams) {
+	domainParams := h.converter.Role().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListRoles(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.Role().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/roles/{name})
+func (h *TransportHandler) GetRole(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetRole(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/roles/{name})
+func (h *TransportHandler) ReplaceRole(w http.ResponseWriter, r *http.Request, name string) {
+	var role apiv1beta1.Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRole := h.converter.Role().ToDomain(role)
+	body, status := h.serviceHandler.ReplaceRole(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRole)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/roles/{name})
+func (h *TransportHandler) DeleteRole(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteRole(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/roles/{name})
+func (h *TransportHandler) PatchRole(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchRole(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,72 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/rolebindings)
+func (h *TransportHandler) CreateRoleBinding(w http.ResponseWriter, r *http.Request) {
+	var binding apiv1beta1.RoleBinding
+	if err := json.NewDecoder(r.Body).Decode(&binding); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainBinding := h.converter.RoleBinding().ToDomain(binding)
+	body, status := h.serviceHandler.CreateRoleBinding(r.Context(), transport.OrgIDFromContext(r.Context()), domainBinding)
+	apiResult := h.converter.RoleBinding().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/rolebindings)
+func (h *TransportHandler) ListRoleBindings(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListRoleBindingsParams) {
+	domainParams := h.converter.RoleBinding().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListRoleBindings(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.RoleBinding().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/rolebindings/{name})
+func (h *TransportHandler) GetRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetRoleBinding(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.RoleBinding().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/rolebindings/{name})
+func (h *TransportHandler) ReplaceRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	var binding apiv1beta1.RoleBinding
+	if err := json.NewDecoder(r.Body).Decode(&binding); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainBinding := h.converter.RoleBinding().ToDomain(binding)
+	body, status := h.serviceHandler.ReplaceRoleBinding(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainBinding)
+	apiResult := h.converter.RoleBinding().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/rolebindings/{name})
+func (h *TransportHandler) DeleteRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteRoleBinding(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/rolebindings/{name})
+func (h *TransportHandler) PatchRoleBinding(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchRoleBinding(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.RoleBinding().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
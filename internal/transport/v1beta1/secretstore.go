@@ -0,0 +1,72 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/secretstores)
+func (h *TransportHandler) CreateSecretStore(w http.ResponseWriter, r *http.Request) {
+	var secretStore apiv1beta1.SecretStore
+	if err := json.NewDecoder(r.Body).Decode(&secretStore); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSecretStore := h.converter.SecretStore().ToDomain(secretStore)
+	body, status := h.serviceHandler.CreateSecretStore(r.Context(), transport.OrgIDFromContext(r.Context()), domainSecretStore)
+	apiResult := h.converter.SecretStore().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/secretstores)
+func (h *TransportHandler) ListSecretStores(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListSecretStoresParams) {
+	domainParams := h.converter.SecretStore().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListSecretStores(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.SecretStore().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/secretstores/{name})
+func (h *TransportHandler) GetSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetSecretStore(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.SecretStore().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/secretstores/{name})
+func (h *TransportHandler) ReplaceSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	var secretStore apiv1beta1.SecretStore
+	if err := json.NewDecoder(r.Body).Decode(&secretStore); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainSecretStore := h.converter.SecretStore().ToDomain(secretStore)
+	body, status := h.serviceHandler.ReplaceSecretStore(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainSecretStore)
+	apiResult := h.converter.SecretStore().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/secretstores/{name})
+func (h *TransportHandler) DeleteSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteSecretStore(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/secretstores/{name})
+func (h *TransportHandler) PatchSecretStore(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchSecretStore(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.SecretStore().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
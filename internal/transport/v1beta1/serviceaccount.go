@@ -0,0 +1,99 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/serviceaccounts)
+func (h *TransportHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var serviceAccount apiv1beta1.ServiceAccount
+	if err := json.NewDecoder(r.Body).Decode(&serviceAccount); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainServiceAccount := h.converter.ServiceAccount().ToDomain(serviceAccount)
+	body, status := h.serviceHandler.CreateServiceAccount(r.Context(), transport.OrgIDFromContext(r.Context()), domainServiceAccount)
+	apiResult := h.converter.ServiceAccount().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/serviceaccounts)
+func (h *TransportHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListServiceAccountsParams) {
+	domainParams := h.converter.ServiceAccount().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListServiceAccounts(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.ServiceAccount().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/serviceaccounts/{name})
+func (h *TransportHandler) GetServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetServiceAccount(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.ServiceAccount().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/serviceaccounts/{name})
+func (h *TransportHandler) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	var serviceAccount apiv1beta1.ServiceAccount
+	if err := json.NewDecoder(r.Body).Decode(&serviceAccount); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainServiceAccount := h.converter.ServiceAccount().ToDomain(serviceAccount)
+	body, status := h.serviceHandler.ReplaceServiceAccount(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainServiceAccount)
+	apiResult := h.converter.ServiceAccount().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/serviceaccounts/{name})
+func (h *TransportHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteServiceAccount(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/serviceaccounts/{name})
+func (h *TransportHandler) PatchServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	var patch apiv1beta1.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
+	body, status := h.serviceHandler.PatchServiceAccount(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	apiResult := h.converter.ServiceAccount().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/serviceaccounts/{serviceaccount}/tokens)
+func (h *TransportHandler) ListServiceAccountTokens(w http.ResponseWriter, r *http.Request, serviceaccount string) {
+	body, status := h.serviceHandler.ListServiceAccountTokens(r.Context(), transport.OrgIDFromContext(r.Context()), serviceaccount)
+	apiResult := h.converter.ServiceAccount().TokenListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (POST /api/v1/serviceaccounts/{serviceaccount}/tokens)
+func (h *TransportHandler) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string) {
+	var token apiv1beta1.ServiceAccountToken
+	if err := json.NewDecoder(r.Body).Decode(&token); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainToken := h.converter.ServiceAccount().TokenToDomain(token)
+	body, status := h.serviceHandler.CreateServiceAccountToken(r.Context(), transport.OrgIDFromContext(r.Context()), serviceaccount, domainToken)
+	apiResult := h.converter.ServiceAccount().TokenFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/serviceaccounts/{serviceaccount}/tokens/{name})
+func (h *TransportHandler) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, serviceaccount string, name string) {
+	status := h.serviceHandler.DeleteServiceAccountToken(r.Context(), transport.OrgIDFromContext(r.Context()), serviceaccount, name)
+	h.SetResponse(w, nil, status)
+}
@@ -205,6 +205,28 @@ func ValidateOciImageReferenceWithTemplates(s *string, path string) []error {
 	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciImageReferenceWithTemplatesRegexp, OciImageReferenceWithTemplatesFmt, "quay.io/flightctl/device:{{ .metadata.labels.version }}")
 }
 
+const (
+	// The name of an image or artifact within a registry, without the registry's domain
+	OciRepositoryPathFmt string = ociNameCompFmt + `(?:\/` + ociNameCompFmt + `)*`
+	// A tag or a digest, as found after the name in an OCI image reference
+	OciTagOrDigestFmt string = `(?:` + OciImageTagFmt + `|` + OciImageDigestFmt + `)`
+)
+
+var (
+	OciRepositoryPathRegexp = regexp.MustCompile("^" + OciRepositoryPathFmt + "$")
+	OciTagOrDigestRegexp    = regexp.MustCompile("^" + OciTagOrDigestFmt + "$")
+)
+
+// Validates the name of an image or artifact within a registry (e.g., "myorg/config-bundle").
+func ValidateOciRepositoryPath(s *string, path string) []error {
+	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciRepositoryPathRegexp, OciRepositoryPathFmt, "myorg/config-bundle")
+}
+
+// Validates an OCI tag or digest.
+func ValidateOciTagOrDigest(s *string, path string) []error {
+	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciTagOrDigestRegexp, OciTagOrDigestFmt, "v1.0", "sha256:<hex digest>")
+}
+
 const (
 	// as per https://docs.github.com/en/get-started/using-git/dealing-with-special-characters-in-branch-and-tag-names#naming-branches-and-tags
 	GitRevisionFmt string = `[a-zA-Z0-9]([a-zA-Z0-9\.\-\_\/])*`
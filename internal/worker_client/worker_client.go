@@ -73,15 +73,19 @@ func (t *workerClient) EmitEvent(ctx context.Context, orgId uuid.UUID, event *do
 
 // eventReasons contains all event reasons that should be sent to the workers
 var eventReasons = map[domain.EventReason]struct{}{
-	domain.EventReasonResourceCreated:             {},
-	domain.EventReasonResourceUpdated:             {},
-	domain.EventReasonResourceDeleted:             {},
-	domain.EventReasonFleetRolloutStarted:         {},
-	domain.EventReasonReferencedRepositoryUpdated: {},
-	domain.EventReasonFleetRolloutDeviceSelected:  {},
-	domain.EventReasonFleetRolloutBatchDispatched: {},
-	domain.EventReasonDeviceConflictResolved:      {},
-	domain.EventReasonDeviceDecommissioned:        {},
+	domain.EventReasonResourceCreated:               {},
+	domain.EventReasonResourceUpdated:               {},
+	domain.EventReasonResourceDeleted:               {},
+	domain.EventReasonFleetRolloutStarted:           {},
+	domain.EventReasonReferencedRepositoryUpdated:   {},
+	domain.EventReasonReferencedSecretUpdated:       {},
+	domain.EventReasonRepositoryPushReceived:        {},
+	domain.EventReasonFleetRolloutDeviceSelected:    {},
+	domain.EventReasonFleetRolloutBatchDispatched:   {},
+	domain.EventReasonDeviceConflictResolved:        {},
+	domain.EventReasonDeviceDecommissioned:          {},
+	domain.EventReasonDeviceRenderRequested:         {},
+	domain.EventReasonDeviceBulkOperationProgressed: {},
 }
 
 func shouldEmitEvent(reason domain.EventReason) bool {
@@ -584,6 +584,7 @@ chown -R flightctl:flightctl ~flightctl/{.config,.local}
     %{_datadir}/containers/systemd/flightctl-alertmanager.volume
     %{_datadir}/containers/systemd/flightctl-cli-artifacts-certs.volume
     %{_datadir}/containers/systemd/flightctl-telemetry-gateway.container
+    %{_datadir}/containers/systemd/flightctl-telemetry-gateway-logs.volume
     %{_datadir}/containers/systemd/flightctl.network
 
     # Handle permissions for scripts setting host config
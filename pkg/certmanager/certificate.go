@@ -24,6 +24,8 @@ type certificate struct {
 	Config CertificateConfig `json:"config"`
 	// Certificate metadata and validity information
 	Info CertificateInfo `json:"info,omitempty"`
+	// Error of the last failed provisioning attempt, cleared once the certificate is stored
+	LastError string `json:"last_error,omitempty"`
 }
 
 // CertificateInfo contains parsed certificate metadata.
@@ -34,6 +36,16 @@ type CertificateInfo struct {
 	NotAfter *time.Time `json:"not_after,omitempty"`
 }
 
+// CertificateStatus is a point-in-time snapshot of a managed certificate.
+type CertificateStatus struct {
+	// Certificate name/identifier
+	Name string
+	// Validity of the currently stored certificate, if any
+	Info CertificateInfo
+	// Error of the last failed provisioning attempt, if any
+	Error string
+}
+
 // certificateProvider groups certificates from a single (namespaced) configuration provider key.
 type certificateProvider struct {
 	// Map of certificate name to certificate object
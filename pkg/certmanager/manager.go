@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -239,6 +240,34 @@ func (cm *CertManager) SyncBundle(ctx context.Context, bundleName string) error
 	return nil
 }
 
+// BundleCertificates returns a snapshot of the certificates managed by the given bundle, sorted by name.
+func (cm *CertManager) BundleCertificates(bundleName string) ([]CertificateStatus, error) {
+	providers, err := cm.certificates.ListProviderKeys()
+	if err != nil {
+		return nil, fmt.Errorf("list provider keys: %w", err)
+	}
+
+	var statuses []CertificateStatus
+	prefix := providerKeyPrefix(strings.TrimSpace(bundleName))
+	for _, pk := range providers {
+		if !strings.HasPrefix(pk, prefix) {
+			continue
+		}
+		certs, err := cm.certificates.ReadCertificates(pk)
+		if err != nil {
+			return nil, fmt.Errorf("read certificates for providerKey %q: %w", pk, err)
+		}
+		for _, cert := range certs {
+			cert.mu.Lock()
+			statuses = append(statuses, CertificateStatus{Name: cert.Name, Info: cert.Info, Error: cert.LastError})
+			cert.mu.Unlock()
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
+
 func (cm *CertManager) sync(ctx context.Context) error {
 	var errs []error
 
@@ -460,6 +489,7 @@ func (cm *CertManager) ensureCertificate(ctx context.Context, bundleName, pk str
 	retryDelay, err := cm.ensureCertificate_do(ctx, b, cert, cfg, attempt)
 	if err != nil {
 		cm.log.Errorf("failed to ensure certificate %q from providerKey %q: %v", cert.Name, pk, err)
+		cert.LastError = err.Error()
 
 		// On failure, reset provisioner and storage to force re-init next time.
 		cert.Provisioner = nil
@@ -547,6 +577,7 @@ func (cm *CertManager) ensureCertificate_do(ctx context.Context, b *bundleRegist
 
 	// Update last-applied config only on successful write.
 	cert.Config = config
+	cert.LastError = ""
 	cert.Provisioner = nil
 	cert.Storage = nil
 	return nil, nil
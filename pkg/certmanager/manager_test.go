@@ -1,8 +1,12 @@
 package certmanager
 
 import (
+	"context"
+	"crypto/x509"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestProviderKeyPrefixInvariant(t *testing.T) {
@@ -13,3 +17,119 @@ func TestProviderKeyPrefixInvariant(t *testing.T) {
 		t.Fatalf("providerKey must share prefix with providerKeyPrefix")
 	}
 }
+
+type testLogger struct{}
+
+func (testLogger) Info(args ...any)                  {}
+func (testLogger) Warn(args ...any)                  {}
+func (testLogger) Error(args ...any)                 {}
+func (testLogger) Debug(args ...any)                 {}
+func (testLogger) Infof(format string, args ...any)  {}
+func (testLogger) Warnf(format string, args ...any)  {}
+func (testLogger) Errorf(format string, args ...any) {}
+func (testLogger) Debugf(format string, args ...any) {}
+
+type staticConfigProvider struct {
+	configs []CertificateConfig
+}
+
+func (p *staticConfigProvider) Name() string { return "static" }
+func (p *staticConfigProvider) GetCertificateConfigs() ([]CertificateConfig, error) {
+	return p.configs, nil
+}
+
+type failingProvisionerFactory struct{}
+
+func (failingProvisionerFactory) Type() string                                    { return "failing" }
+func (failingProvisionerFactory) Validate(log Logger, cc CertificateConfig) error { return nil }
+func (failingProvisionerFactory) New(log Logger, cc CertificateConfig) (ProvisionerProvider, error) {
+	return failingProvisioner{}, nil
+}
+
+type failingProvisioner struct{}
+
+func (failingProvisioner) Provision(ctx context.Context, req ProvisionRequest) (*ProvisionResult, error) {
+	return nil, errors.New("issuer unavailable")
+}
+
+type noopStorageFactory struct{}
+
+func (noopStorageFactory) Type() string                                    { return "noop" }
+func (noopStorageFactory) Validate(log Logger, cc CertificateConfig) error { return nil }
+func (noopStorageFactory) New(log Logger, cc CertificateConfig) (StorageProvider, error) {
+	return noopStorage{}, nil
+}
+
+type noopStorage struct{}
+
+func (noopStorage) Store(ctx context.Context, req StoreRequest) error { return nil }
+func (noopStorage) LoadCertificate(ctx context.Context) (*x509.Certificate, error) {
+	return nil, errors.New("not found")
+}
+
+func TestBundleCertificatesReportsProvisioningErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bundle, err := NewBundle("workload",
+		WithConfigProvider(&staticConfigProvider{configs: []CertificateConfig{
+			{Name: "web", Provisioner: ProvisionerConfig{Type: "failing"}, Storage: StorageConfig{Type: "noop"}},
+		}}),
+		WithProvisionerFactory(failingProvisionerFactory{}),
+		WithStorageFactory(noopStorageFactory{}),
+	)
+	if err != nil {
+		t.Fatalf("NewBundle: %v", err)
+	}
+	cm, err := NewManager(ctx, testLogger{}, WithBundleProvider(bundle))
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	if err := cm.SyncBundle(ctx, "workload"); err != nil {
+		t.Fatalf("SyncBundle: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		statuses, err := cm.BundleCertificates("workload")
+		if err != nil {
+			t.Fatalf("BundleCertificates: %v", err)
+		}
+		if len(statuses) != 1 || statuses[0].Name != "web" {
+			t.Fatalf("unexpected certificate statuses: %+v", statuses)
+		}
+		if statuses[0].Error != "" {
+			if !strings.Contains(statuses[0].Error, "issuer unavailable") {
+				t.Fatalf("unexpected certificate error: %q", statuses[0].Error)
+			}
+			if statuses[0].Info.NotAfter != nil {
+				t.Fatalf("unexpected certificate validity: %+v", statuses[0].Info)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the provisioning error to be reported")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBundleCertificatesIgnoresOtherBundles(t *testing.T) {
+	cm := &CertManager{certificates: newCertStorage()}
+	for _, pk := range []string{providerKey("workload", "static"), providerKey("management", "static")} {
+		if err := cm.certificates.EnsureProvider(pk); err != nil {
+			t.Fatalf("EnsureProvider: %v", err)
+		}
+		if _, _, err := cm.certificates.GetOrCreateCertificate(pk, "cert", func() *certificate { return &certificate{Name: "cert"} }); err != nil {
+			t.Fatalf("GetOrCreateCertificate: %v", err)
+		}
+	}
+
+	statuses, err := cm.BundleCertificates("workload")
+	if err != nil {
+		t.Fatalf("BundleCertificates: %v", err)
+	}
+	if len(statuses) != 1 {
+		t.Fatalf("expected 1 certificate, got %d", len(statuses))
+	}
+}
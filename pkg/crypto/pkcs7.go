@@ -0,0 +1,79 @@
+package crypto
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+)
+
+var (
+	oidPKCS7Data       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidPKCS7SignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+)
+
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"optional"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      pkcs7ContentInfo
+	Certificates     asn1.RawValue `asn1:"optional"`
+	SignerInfos      asn1.RawValue
+}
+
+var emptyASN1Set = asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true}
+
+// EncodeCertsOnlyPKCS7 encodes certificates into a degenerate, unsigned PKCS#7 SignedData
+// structure, the "certs-only" format used to distribute certificates by protocols such as EST
+// (RFC 7030).
+func EncodeCertsOnlyPKCS7(certs ...*x509.Certificate) ([]byte, error) {
+	var raw []byte
+	for _, cert := range certs {
+		if cert == nil {
+			return nil, errors.New("certificate is nil")
+		}
+		raw = append(raw, cert.Raw...)
+	}
+
+	signedData, err := asn1.Marshal(pkcs7SignedData{
+		Version:          1,
+		DigestAlgorithms: emptyASN1Set,
+		ContentInfo:      pkcs7ContentInfo{ContentType: oidPKCS7Data},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: raw},
+		SignerInfos:      emptyASN1Set,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding PKCS#7 signed data: %w", err)
+	}
+	return asn1.Marshal(pkcs7ContentInfo{
+		ContentType: oidPKCS7SignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+}
+
+// ParseCertsOnlyPKCS7 returns the certificates of a PKCS#7 SignedData structure, ignoring its
+// signatures.
+func ParseCertsOnlyPKCS7(der []byte) ([]*x509.Certificate, error) {
+	var contentInfo pkcs7ContentInfo
+	if rest, err := asn1.Unmarshal(der, &contentInfo); err != nil {
+		return nil, fmt.Errorf("parsing PKCS#7 content info: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after PKCS#7 content info")
+	}
+	if !contentInfo.ContentType.Equal(oidPKCS7SignedData) {
+		return nil, fmt.Errorf("unexpected PKCS#7 content type %s", contentInfo.ContentType)
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, fmt.Errorf("parsing PKCS#7 signed data: %w", err)
+	}
+	if len(signedData.Certificates.Bytes) == 0 {
+		return nil, nil
+	}
+	return x509.ParseCertificates(signedData.Certificates.Bytes)
+}
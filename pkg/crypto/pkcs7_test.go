@@ -0,0 +1,46 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+)
+
+// TestEncodeParseCertsOnlyPKCS7 ensures EncodeCertsOnlyPKCS7 and ParseCertsOnlyPKCS7 are exact
+// inverses of each other.
+func TestEncodeParseCertsOnlyPKCS7(t *testing.T) {
+	first, err := generateSelfSignedCertificate()
+	if err != nil {
+		t.Fatalf("failed to generate test certificate: %v", err)
+	}
+	second, err := generateSelfSignedCertificate()
+	if err != nil {
+		t.Fatalf("failed to generate test certificate: %v", err)
+	}
+
+	der, err := EncodeCertsOnlyPKCS7(first, second)
+	if err != nil {
+		t.Fatalf("EncodeCertsOnlyPKCS7 returned error: %v", err)
+	}
+
+	certs, err := ParseCertsOnlyPKCS7(der)
+	if err != nil {
+		t.Fatalf("ParseCertsOnlyPKCS7 returned error: %v", err)
+	}
+
+	if len(certs) != 2 || !bytes.Equal(certs[0].Raw, first.Raw) || !bytes.Equal(certs[1].Raw, second.Raw) {
+		t.Fatalf("original and parsed certificates differ")
+	}
+}
+
+func TestEncodeCertsOnlyPKCS7_NilInput(t *testing.T) {
+	if _, err := EncodeCertsOnlyPKCS7((*x509.Certificate)(nil)); err == nil {
+		t.Fatalf("expected error for nil certificate, got nil")
+	}
+}
+
+func TestParseCertsOnlyPKCS7_InvalidInput(t *testing.T) {
+	if _, err := ParseCertsOnlyPKCS7([]byte("not a pkcs7 structure")); err == nil {
+		t.Fatalf("expected error for invalid input, got nil")
+	}
+}
@@ -556,6 +556,28 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Entry("multiple devices some selected devices with template version and mixed completion reasons - all complete", 10, bnds(6), bnds(6), bnds(6), bnds(2), bnds(2, 2), bnds(4, 2), 33),
 			Entry("multiple devices some selected devices with template version and mixed completion reasons - one complete out of selected range", 10, bnds(6), bnds(7), bnds(7), bnds(2), bnds(2, 2), bnds(5, 2), 33),
 		)
+		It("doesn't count devices with unhealthy applications as successful", func() {
+			selector := setupCompletion(2, bnds(2), bnds(2), bnds(2), bnds(2), nil, nil)
+			var devices []*model.Device
+			Expect(db.WithContext(ctx).Order("name").Find(&devices).Error).ToNot(HaveOccurred())
+			Expect(db.WithContext(ctx).Model(&model.Device{}).Where("name = ?", devices[0].Name).Update("status",
+				gorm.Expr(`jsonb_set(status, '{applications}', '[{"name": "app", "probes": {"readiness": {"result": "Failure", "consecutiveFailures": 3}}}]')`)).Error).ToNot(HaveOccurred())
+
+			selection, err := selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			isComplete, err := selection.IsComplete(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isComplete).To(BeFalse())
+
+			Expect(selection.SetCompletionReport(ctx)).ToNot(HaveOccurred())
+			fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			val, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationLastBatchCompletionReport)
+			Expect(exists).To(BeTrue())
+			var report api.RolloutBatchCompletionReport
+			Expect(json.Unmarshal([]byte(val), &report)).ToNot(HaveOccurred())
+			Expect(report.SuccessPercentage).To(Equal(int64(50)))
+		})
 	})
 	Context("reconciler", func() {
 		BeforeEach(func() {
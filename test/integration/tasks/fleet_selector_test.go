@@ -474,6 +474,80 @@ var _ = Describe("FleetSelector", func() {
 			}
 		})
 
+		It("Fleet field selector matches devices by status fields", func() {
+			noopCallback := store.EventCallback(func(context.Context, api.ResourceKind, uuid.UUID, string, interface{}, interface{}, bool, error) {})
+			testutil.CreateTestFleet(ctx, fleetStore, orgId, "fleet", &map[string]string{"key": "value"}, nil)
+			testutil.CreateTestFleet(ctx, fleetStore, orgId, "otherfleet", &map[string]string{"key": "value"}, nil)
+			for name, architecture := range map[string]string{"fleet": "arm64", "otherfleet": "amd64"} {
+				fleet, err := fleetStore.Get(ctx, orgId, name)
+				Expect(err).ToNot(HaveOccurred())
+				fleet.Spec.FieldSelector = &api.FleetFieldSelector{MatchFields: map[string]string{"status.systemInfo.architecture": architecture}}
+				_, err = fleetStore.Update(ctx, orgId, fleet, nil, true, noopCallback)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			setArchitecture := func(name, architecture string) {
+				device, err := deviceStore.Get(ctx, orgId, name)
+				Expect(err).ToNot(HaveOccurred())
+				device.Status.SystemInfo.Architecture = architecture
+				_, err = deviceStore.UpdateStatus(ctx, orgId, device, noopCallback)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// This device matches the labels and the status field of "fleet"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "arm-device", nil, nil, &map[string]string{"key": "value"})
+			setArchitecture("arm-device", "arm64")
+			// This device is owned by "fleet" but its status field matches "otherfleet"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "amd-device", lo.ToPtr("Fleet/fleet"), nil, &map[string]string{"key": "value"})
+			setArchitecture("amd-device", "amd64")
+			// This device does not report its architecture and matches no fleet
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "unknown-device", lo.ToPtr("Fleet/fleet"), nil, &map[string]string{"key": "value"})
+
+			err := logic.FleetSelectorUpdated(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, name := range []string{"amd-device", "unknown-device"} {
+				event := api.Event{
+					Reason:         api.EventReasonResourceUpdated,
+					InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: name},
+				}
+				deviceLogic := tasks.NewFleetSelectorMatchingLogic(log, serviceHandler, orgId, event)
+				Expect(deviceLogic.DeviceLabelsUpdated(ctx)).To(Succeed())
+			}
+
+			device, err := deviceStore.Get(ctx, orgId, "arm-device")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/fleet"))
+
+			device, err = deviceStore.Get(ctx, orgId, "amd-device")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/otherfleet"))
+			validateResourceUpdatedEvent("amd-device", getEventsForResource(api.DeviceKind, "amd-device"), lo.ToPtr("Fleet/fleet"), lo.ToPtr("Fleet/otherfleet"))
+
+			device, err = deviceStore.Get(ctx, orgId, "unknown-device")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(device.Metadata.Owner).To(BeNil())
+
+			// Selecting the same architecture in "otherfleet" makes arm-device match both fleets
+			otherFleet, err := fleetStore.Get(ctx, orgId, "otherfleet")
+			Expect(err).ToNot(HaveOccurred())
+			otherFleet.Spec.FieldSelector.MatchFields["status.systemInfo.architecture"] = "arm64"
+			_, err = fleetStore.Update(ctx, orgId, otherFleet, nil, true, noopCallback)
+			Expect(err).ToNot(HaveOccurred())
+			event := api.Event{
+				Reason:         api.EventReasonResourceUpdated,
+				InvolvedObject: api.ObjectReference{Kind: api.FleetKind, Name: "otherfleet"},
+			}
+			otherLogic := tasks.NewFleetSelectorMatchingLogic(log, serviceHandler, orgId, event)
+			Expect(otherLogic.FleetSelectorUpdated(ctx)).To(Succeed())
+
+			device, err = deviceStore.Get(ctx, orgId, "arm-device")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/fleet"))
+			Expect(api.IsStatusConditionTrue(device.Status.Conditions, api.ConditionTypeDeviceMultipleOwners)).To(BeTrue())
+			validateDeviceMultipleOwnersDetectedEvent("arm-device", getEventsForResource(api.DeviceKind, "arm-device"), []string{"fleet", "otherfleet"})
+		})
+
 		It("Should skip updating decommissioning devices", func() {
 			testutil.CreateTestFleet(ctx, fleetStore, orgId, "fleet", &map[string]string{"key": "value"}, nil)
 